@@ -0,0 +1,57 @@
+package confusables
+
+// Aggressiveness is a preset of options trading false positives for false negatives, selected with
+// WithAggressiveness, so that products with different tolerances may share the package.
+type Aggressiveness int
+
+// Presets of options, from the fewest false positives to the fewest false negatives.
+const (
+	// AggressivenessStrict applies the mappings of confusables.txt alone, as TR39: the amendments are not applied and
+	// ToASCII only replaces runes with an ASCII confusable mapping, as WithAmendments(false) and WithConfusablesOnly.
+	AggressivenessStrict Aggressiveness = iota - 1
+	// AggressivenessStandard is the default behaviour of the package.
+	AggressivenessStandard
+	// AggressivenessAggressive also strips invisible characters, transliterates letters and reverses leetspeak while
+	// converting to ASCII, as WithStripInvisible, WithTransliteration and WithReverseLeet.
+	AggressivenessAggressive
+)
+
+var aggressivenessNames = map[Aggressiveness]string{
+	AggressivenessStrict:     "strict",
+	AggressivenessStandard:   "standard",
+	AggressivenessAggressive: "aggressive",
+}
+
+func init() {
+	for _, name := range aggressivenessNames {
+		registerProfile(name)
+	}
+}
+
+// String returns the name of the preset, such as "strict".
+func (a Aggressiveness) String() string {
+	if name, ok := aggressivenessNames[a]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// WithAggressiveness applies the options of a preset. Options given after it take precedence, so a preset may be
+// adjusted, e.g. New(WithAggressiveness(AggressivenessStrict), WithAmendments(true)).
+func WithAggressiveness(a Aggressiveness) Option {
+	var opts []Option
+
+	switch a {
+	case AggressivenessStrict:
+		opts = []Option{WithAmendments(false), WithConfusablesOnly()}
+	case AggressivenessAggressive:
+		opts = []Option{WithStripInvisible(), WithTransliteration(), WithReverseLeet()}
+	}
+
+	return func(c *Confusables) {
+		for _, opt := range opts {
+			opt(c)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAggressiveness(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s                            string
+		strict, standard, aggressive string
+	}{
+		{"pаypal", "paypal", "paypal", "paypal"},
+		{"tòñ", "tòñ", "ton", "ton"},
+		{"pay\u200bpal", "pay\u200bpal", "pay\u200bpal", "paypal"},
+		{"Straße", "Straße", "Straße", "Strasse"},
+		{"fr33 m0n3y 2024", "fr33 m0n3y 2024", "fr33 m0n3y 2024", "free money 2024"},
+	}
+
+	strict := confusables.New(confusables.WithAggressiveness(confusables.AggressivenessStrict))
+	standard := confusables.New(confusables.WithAggressiveness(confusables.AggressivenessStandard))
+	aggressive := confusables.New(confusables.WithAggressiveness(confusables.AggressivenessAggressive))
+
+	for _, test := range tests {
+		assert.Equal(t, test.strict, strict.ToASCII(test.s), "strict ToASCII(%q)", test.s)
+		assert.Equal(t, test.standard, standard.ToASCII(test.s), "standard ToASCII(%q)", test.s)
+		assert.Equal(t, test.standard, confusables.ToASCII(test.s), "ToASCII(%q)", test.s)
+		assert.Equal(t, test.aggressive, aggressive.ToASCII(test.s), "aggressive ToASCII(%q)", test.s)
+	}
+
+	assert.Equal(t, confusables.OriginUpstream, strict.OriginOf('①'))
+
+	c := confusables.New(
+		confusables.WithAggressiveness(confusables.AggressivenessStrict),
+		confusables.WithAmendments(true),
+	)
+	assert.Equal(t, confusables.OriginAmendments, c.OriginOf('①'))
+}
+
+func TestWithReverseLeet(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.New(confusables.WithReverseLeet())
+
+	assert.Equal(t, "free money", c.ToASCII("fr33 m0n3y"))
+	assert.Equal(t, "free", string(c.AppendASCII(nil, "fr33")))
+
+	a, m := c.ToASCIIWithIndex("fr33 mоn3y")
+	assert.Equal(t, "free money", a)
+	assert.Equal(t, 6, m.ToOriginal(6))
+	assert.Equal(t, 8, m.ToOriginal(7))
+	assert.Equal(t, 9, m.ToOriginal(8))
+}
+
+func TestAggressivenessString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "strict", confusables.AggressivenessStrict.String())
+	assert.Equal(t, "unknown", confusables.Aggressiveness(7).String())
+}
@@ -0,0 +1,58 @@
+package confusables
+
+import (
+	"errors"
+	"strings"
+)
+
+// Amendments returns the package's amendments to the mappings of confusables.txt, such as mapping "①" to "1" rather
+// than leaving it as it is. They are applied to the package's mappings unless WithAmendments(false) is used.
+func Amendments() []ConfusableEntry {
+	var entries []ConfusableEntry
+
+	_ = eachEntry(packageTables.generated().amendments, func(entry *ConfusableEntry) {
+		entries = append(entries, *entry)
+	})
+
+	return entries
+}
+
+// LoadAmendments loads the package's amendments, as returned by Amendments, into the instance's mappings. This
+// applies them to an instance created with WithAmendments(false), or restores them once overridden. With WithTables,
+// the amendments of those tables are loaded instead.
+func (s *SafeConfusables) LoadAmendments() error {
+	return s.tables.update(func(t *tables) error {
+		return t.addAmendments(s.tableData.generated().amendments)
+	})
+}
+
+// Add the mappings of amendments, in the format of confusables.txt. Their descriptions are held with the generated
+// descriptions, so only the mappings are added.
+func (t *tables) addAmendments(amendments string) error {
+	return eachEntry(amendments, func(entry *ConfusableEntry) {
+		t.addSequenceMapping(entry.SourceSequence, entry.Target)
+		t.setOrigin(entry.SourceSequence, OriginAmendments)
+	})
+}
+
+// Call fn with the entry of each line of data, in the format of confusables.txt.
+func eachEntry(data string, fn func(*ConfusableEntry)) error {
+	for data != "" {
+		var line string
+
+		line, data, _ = strings.Cut(data, "\n")
+
+		entry, err := ParseLine(line)
+		if err != nil {
+			if errors.Is(err, ErrIgnoreLine) {
+				continue
+			}
+
+			return err
+		}
+
+		fn(entry)
+	}
+
+	return nil
+}
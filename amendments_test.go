@@ -0,0 +1,33 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmendments(t *testing.T) {
+	t.Parallel()
+
+	amendments := confusables.Amendments()
+	assert.NotEmpty(t, amendments)
+	assert.Contains(t, amendments, confusables.ConfusableEntry{
+		Description:    confusables.Description{From: "CIRCLED DIGIT ONE", To: "DIGIT ONE"},
+		Source:         '①',
+		SourceSequence: "①",
+		Target:         "1",
+	})
+
+	c := confusables.NewSafe(confusables.WithAmendments(false))
+	c.AddMapping('①', "I")
+	assert.Equal(t, "I", c.ToSkeleton("①"))
+
+	assert.NoError(t, c.LoadAmendments())
+	assert.Equal(t, "1", c.ToSkeleton("①"))
+
+	// Mappings added to the package's mappings are not seen without the amendments.
+	confusables.AddMapping('ꙝ', "o")
+	assert.Equal(t, "o", confusables.New(confusables.WithAmendments(true)).ToSkeleton("ꙝ"))
+	assert.Equal(t, "ꙝ", confusables.New(confusables.WithAmendments(false)).ToSkeleton("ꙝ"))
+}
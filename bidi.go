@@ -0,0 +1,33 @@
+package confusables
+
+import (
+	"strings"
+	"unicode"
+)
+
+func init() {
+	registerCheck("bidi")
+}
+
+// HasBidiControls checks if s contains bidirectional formatting characters, such as RIGHT-TO-LEFT OVERRIDE, which can
+// be used to reorder how text is displayed. For example, "invoice\u202egpj.exe" is displayed as "invoiceexe.jpg".
+func HasBidiControls(s string) bool {
+	return strings.IndexFunc(s, isBidiControl) >= 0
+}
+
+// Get the bidirectional formatting characters within s.
+func bidiControls(s string) []rune {
+	var controls []rune
+
+	for _, r := range s {
+		if isBidiControl(r) {
+			controls = append(controls, r)
+		}
+	}
+
+	return controls
+}
+
+func isBidiControl(r rune) bool {
+	return unicode.Is(unicode.Bidi_Control, r)
+}
@@ -0,0 +1,28 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasBidiControls(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s    string
+		bidi bool
+	}{
+		{"", false},
+		{"invoice.exe", false},
+		{"שלום", false},
+		{"invoice\u202egpj.exe", true},
+		{"\u2067abc\u2069", true},
+		{"abc\u200f", true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.bidi, confusables.HasBidiControls(test.s), "HasBidiControls(%q)", test.s)
+	}
+}
@@ -0,0 +1,71 @@
+package confusables
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// bulkBatchSize is the number of strings handed to a worker at a time.
+const bulkBatchSize = 256
+
+// ToASCIIAll converts each string in in to its ASCII equivalent, spreading the work over workers goroutines. Each
+// worker uses its own instance of Confusables. If workers is not positive, GOMAXPROCS workers are used.
+//
+// If ctx is cancelled, no further work is started and the strings which were not processed are left empty.
+func ToASCIIAll(ctx context.Context, in []string, workers int) []string {
+	return processAll(ctx, in, workers, (*Confusables).ToASCII)
+}
+
+// ToSkeletonAll converts each string in in to its skeleton form, spreading the work over workers goroutines. If
+// workers is not positive, GOMAXPROCS workers are used.
+//
+// If ctx is cancelled, no further work is started and the strings which were not processed are left empty.
+func ToSkeletonAll(ctx context.Context, in []string, workers int) []string {
+	return processAll(ctx, in, workers, func(_ *Confusables, s string) string {
+		return ToSkeleton(s)
+	})
+}
+
+func processAll(ctx context.Context, in []string, workers int, fn func(*Confusables, string) string) []string {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	out := make([]string, len(in))
+	batches := make(chan int)
+
+	var wg sync.WaitGroup
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			c := New()
+
+			for start := range batches {
+				end := min(start+bulkBatchSize, len(in))
+
+				for i := start; i < end; i++ {
+					out[i] = fn(c, in[i])
+				}
+			}
+		}()
+	}
+
+feed:
+	for start := 0; start < len(in) && ctx.Err() == nil; start += bulkBatchSize {
+		select {
+		case <-ctx.Done():
+			break feed
+		case batches <- start:
+		}
+	}
+
+	close(batches)
+	wg.Wait()
+
+	return out
+}
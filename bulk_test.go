@@ -0,0 +1,39 @@
+package confusables_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToASCIIAll(t *testing.T) {
+	t.Parallel()
+
+	in := make([]string, 1000)
+	for i := range in {
+		in[i] = "exαmple"
+	}
+
+	out := confusables.ToASCIIAll(context.Background(), in, 4)
+
+	assert.Len(t, out, len(in))
+
+	for _, s := range out {
+		assert.Equal(t, "example", s)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.Equal(t, make([]string, len(in)), confusables.ToASCIIAll(ctx, in, 4))
+}
+
+func TestToSkeletonAll(t *testing.T) {
+	t.Parallel()
+
+	out := confusables.ToSkeletonAll(context.Background(), []string{"", "example", "ех⍺ⅿрІꬲ"}, 0)
+
+	assert.Equal(t, []string{"", "exarnple", "exarnple"}, out)
+}
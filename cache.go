@@ -0,0 +1,128 @@
+package confusables
+
+import (
+	"container/list"
+	"sync"
+)
+
+// cacheKind distinguishes the results held in a cache.
+type cacheKind uint8
+
+const (
+	cacheASCII cacheKind = iota
+	cacheSkeleton
+)
+
+type cacheKey struct {
+	kind cacheKind
+	s    string
+}
+
+type cacheEntry struct {
+	key   cacheKey
+	value string
+}
+
+// lruCache is a bounded cache of results which evicts the least recently used entry when full. It is safe for
+// concurrent use.
+type lruCache struct {
+	mu    sync.Mutex
+	items map[cacheKey]*list.Element
+	order *list.List
+	size  int
+	// tables are the mappings the cached results were computed with.
+	tables *tables
+	// hits and misses count the results which were and were not found.
+	hits   uint64
+	misses uint64
+}
+
+func newLRUCache(size int) *lruCache {
+	return &lruCache{
+		items: make(map[cacheKey]*list.Element, size),
+		order: list.New(),
+		size:  size,
+	}
+}
+
+// Add a result computed with the mappings t, unless the cache now holds results computed with others.
+func (c *lruCache) add(t *tables, key cacheKey, value string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tables != t {
+		return
+	}
+
+	if e, ok := c.items[key]; ok {
+		c.order.MoveToFront(e)
+		e.Value.(*cacheEntry).value = value
+
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&cacheEntry{key: key, value: value})
+
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.items, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+// Get a result computed with the mappings t. If the cache holds results computed with others, they are discarded.
+func (c *lruCache) get(t *tables, key cacheKey) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.tables != t {
+		c.tables = t
+		c.items = make(map[cacheKey]*list.Element, c.size)
+		c.order.Init()
+		c.misses++
+
+		return "", false
+	}
+
+	e, ok := c.items[key]
+	if !ok {
+		c.misses++
+
+		return "", false
+	}
+
+	c.hits++
+	c.order.MoveToFront(e)
+
+	return e.Value.(*cacheEntry).value, true
+}
+
+// Get the number of results which were and were not found. A nil cache has found none.
+func (c *lruCache) counts() (hits, misses uint64) {
+	if c == nil {
+		return 0, 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.hits, c.misses
+}
+
+// Get a result from the cache, computing and storing it when missing. Cached results are discarded when the mappings
+// they were computed with, t, are replaced. A nil cache always computes the result.
+func (c *lruCache) getOrCompute(t *tables, kind cacheKind, s string, compute func(string) string) string {
+	if c == nil {
+		return compute(s)
+	}
+
+	key := cacheKey{kind: kind, s: s}
+	if v, ok := c.get(t, key); ok {
+		return v
+	}
+
+	v := compute(s)
+	c.add(t, key, v)
+
+	return v
+}
@@ -0,0 +1,76 @@
+package confusables
+
+import (
+	"sort"
+	"sync"
+)
+
+// CapabilitySet lists the checks, profiles and data tables which are compiled into the current build of the package.
+type CapabilitySet struct {
+	Checks   []string
+	Profiles []string
+	Tables   []string
+	// UnicodeVersion is the version of the Unicode data the tables are generated from.
+	UnicodeVersion string
+}
+
+var capabilities = struct {
+	sync.Mutex
+	checks, profiles, tables map[string]struct{}
+}{
+	checks:   map[string]struct{}{},
+	profiles: map[string]struct{}{},
+	tables:   map[string]struct{}{},
+}
+
+func init() {
+	registerCheck("confusable")
+
+	registerProfile("ascii")
+	registerProfile("number")
+	registerProfile("skeleton")
+}
+
+// Capabilities returns the checks, profiles and data tables supported by the current build, each sorted by name.
+// Features which are excluded by build tags are not listed.
+func Capabilities() CapabilitySet {
+	capabilities.Lock()
+	defer capabilities.Unlock()
+
+	return CapabilitySet{
+		Checks:         sortedKeys(capabilities.checks),
+		Profiles:       sortedKeys(capabilities.profiles),
+		Tables:         sortedKeys(capabilities.tables),
+		UnicodeVersion: UnicodeVersion,
+	}
+}
+
+func register(m map[string]struct{}, name string) {
+	capabilities.Lock()
+	defer capabilities.Unlock()
+
+	m[name] = struct{}{}
+}
+
+func registerCheck(name string) {
+	register(capabilities.checks, name)
+}
+
+func registerProfile(name string) {
+	register(capabilities.profiles, name)
+}
+
+func registerTable(name string) {
+	register(capabilities.tables, name)
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,28 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.Capabilities()
+
+	assert.Contains(t, c.Checks, "confusable")
+	assert.Subset(t, c.Profiles, []string{"skeleton", "skeleton-strict", "strict", "standard", "aggressive"})
+	assert.Subset(t, c.Tables, []string{"emoji", "halfwidth", "punctuation", "symbols"})
+	assert.IsIncreasing(t, c.Profiles)
+}
+
+func TestUnicodeVersion(t *testing.T) {
+	t.Parallel()
+
+	assert.Regexp(t, `^\d+\.\d+\.\d+$`, confusables.UnicodeVersion)
+	assert.NotEmpty(t, confusables.UnicodeDataDate)
+	assert.Contains(t, confusables.UnicodeDataSource, "confusables.txt")
+	assert.Equal(t, confusables.UnicodeVersion, confusables.Capabilities().UnicodeVersion)
+}
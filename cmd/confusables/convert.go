@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/eskriett/confusables"
+)
+
+var (
+	runASCII    = convertCommand("ascii", "to its ASCII equivalent", confusables.AppendASCII)
+	runSkeleton = convertCommand("skeleton", "to its skeleton, for comparing with other skeletons",
+		confusables.AppendSkeleton)
+)
+
+// Create a command which converts text with appendFn, e.g. confusables.AppendSkeleton. Text is taken from the
+// arguments or, when there are none, standard input.
+func convertCommand(name, description string, appendFn func(dst []byte, s string) []byte) command {
+	return func(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+		flags := flag.NewFlagSet(name, flag.ContinueOnError)
+		flags.SetOutput(stderr)
+		flags.Usage = func() {
+			fmt.Fprintf(stderr, "usage: confusables %s [--lines] [text...]\n", name)
+			fmt.Fprintf(stderr, "Converts text %s. Standard input is converted when no text is given.\n", description)
+			flags.PrintDefaults()
+		}
+
+		lines := flags.Bool("lines", false, "convert standard input one line at a time, for streaming large inputs")
+
+		if err := flags.Parse(args); err != nil {
+			return exitError
+		}
+
+		w := bufio.NewWriter(stdout)
+
+		var err error
+
+		switch {
+		case flags.NArg() > 0:
+			_, err = w.Write(append(appendFn(nil, strings.Join(flags.Args(), " ")), '\n'))
+		case *lines:
+			err = convertLines(w, bufio.NewReader(stdin), appendFn)
+		default:
+			var data []byte
+
+			if data, err = io.ReadAll(stdin); err == nil {
+				_, err = w.Write(appendFn(nil, string(data)))
+			}
+		}
+
+		if err == nil {
+			err = w.Flush()
+		}
+
+		if err != nil {
+			fmt.Fprintf(stderr, "confusables: %v\n", err)
+
+			return exitError
+		}
+
+		return exitOK
+	}
+}
+
+// Convert newline-delimited input one line at a time, writing a line for each. Only the current line is held in
+// memory, and its result reuses a single buffer.
+func convertLines(w io.Writer, r *bufio.Reader, appendFn func(dst []byte, s string) []byte) error {
+	var (
+		buf  []byte
+		long []byte
+	)
+
+	for {
+		line, err := r.ReadSlice('\n')
+
+		// A line longer than the reader's buffer is accumulated until its end is found.
+		if errors.Is(err, bufio.ErrBufferFull) {
+			long = append(long, line...)
+
+			continue
+		}
+
+		if len(long) > 0 {
+			line = append(long, line...)
+			long = long[:0]
+		}
+
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if len(line) > 0 {
+			buf = appendFn(buf[:0], strings.TrimSuffix(string(line), "\n"))
+			buf = append(buf, '\n')
+
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+		}
+
+		if err != nil {
+			return nil
+		}
+	}
+}
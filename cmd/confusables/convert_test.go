@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunConvert(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		args  []string
+		stdin string
+		want  string
+	}{
+		{[]string{"skeleton", "pаypal"}, "", "paypal\n"},
+		{[]string{"ascii", "ｆｕｌｌ", "wіdth"}, "", "full width\n"},
+		{[]string{"skeleton"}, "pаypal\nrn", "paypal\nrn"},
+		{[]string{"skeleton", "--lines"}, "pаypal\n\nраураl", "paypal\n\npaypal\n"},
+		{[]string{"ascii", "--lines"}, "", ""},
+	}
+
+	for _, test := range tests {
+		var stdout, stderr bytes.Buffer
+
+		assert.Equal(t, exitOK, run(test.args, strings.NewReader(test.stdin), &stdout, &stderr), "%v", test.args)
+		assert.Equal(t, test.want, stdout.String(), "%v", test.args)
+	}
+}
+
+func TestConvertLinesLong(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("а", 100)
+
+	var b bytes.Buffer
+
+	// The reader's buffer is smaller than the lines, which must be accumulated.
+	r := bufio.NewReaderSize(strings.NewReader(long+"\nx\n"+long), 16)
+
+	assert.NoError(t, convertLines(&b, r, func(dst []byte, s string) []byte {
+		return append(dst, strings.ReplaceAll(s, "а", "a")...)
+	}))
+	assert.Equal(t, strings.Repeat("a", 100)+"\nx\n"+strings.Repeat("a", 100)+"\n", b.String())
+}
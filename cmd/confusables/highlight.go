@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+// ANSI escape sequences used to highlight findings.
+const (
+	ansiHighlight = "\x1b[1;31m"
+	ansiReset     = "\x1b[0m"
+)
+
+// Write a line as "name:line: text", with the runes of its findings highlighted, followed by an annotation of each
+// finding. Runes which are not visible, or which would affect the terminal, are written as their code points.
+func writeHighlighted(w io.Writer, name string, n int, line string, findings []finding) error {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s:%d: ", name, n)
+
+	col, next := 0, 0
+
+	for _, r := range line {
+		col++
+
+		highlighted := next < len(findings) && findings[next].col == col
+		if highlighted {
+			next++
+
+			b.WriteString(ansiHighlight)
+		}
+
+		if isHidden(r) {
+			fmt.Fprintf(&b, "<U+%04X>", r)
+		} else {
+			b.WriteRune(r)
+		}
+
+		if highlighted {
+			b.WriteString(ansiReset)
+		}
+	}
+
+	b.WriteByte('\n')
+
+	for _, f := range findings {
+		fmt.Fprintf(&b, "\t%d: %s\n", f.col, f.describe())
+	}
+
+	_, err := io.WriteString(w, b.String())
+
+	return err
+}
+
+// Check whether a rune is written as its code point, as it is invisible, reorders text or is a control character
+// which would be interpreted by the terminal.
+func isHidden(r rune) bool {
+	return r != '\t' && (unicode.IsControl(r) || unicode.In(r, unicode.Bidi_Control, unicode.Cf))
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteHighlighted(t *testing.T) {
+	t.Parallel()
+
+	var b bytes.Buffer
+
+	line := "pаypal\u202e\x1b"
+	assert.NoError(t, writeHighlighted(&b, "sample", 3, line, scanLine(line)))
+	assert.Equal(t, "sample:3: p\x1b[1;31mа\x1b[0mypal\x1b[1;31m<U+202E>\x1b[0m<U+001B>\n"+
+		"\t2: U+0430 CYRILLIC SMALL LETTER A (mixed-script)\n"+
+		"\t7: U+202E (bidi-control)\n", b.String())
+}
+
+func TestRunScanHighlight(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	stdin := strings.NewReader("clean\r\nfree\u200bmoney\r\n")
+
+	assert.Equal(t, exitFound, run([]string{"scan", "--highlight"}, stdin, &stdout, &stderr))
+	assert.Equal(t, "-:2: free\x1b[1;31m<U+200B>\x1b[0mmoney\n\t5: U+200B (invisible)\n", stdout.String())
+
+	stdout.Reset()
+
+	assert.Equal(t, exitOK, run([]string{"scan"}, strings.NewReader("clean\n"), &stdout, &stderr))
+	assert.Empty(t, stdout.String())
+}
@@ -0,0 +1,77 @@
+// Command confusables detects and normalizes confusable characters.
+//
+// Usage:
+//
+//	confusables <command> [arguments]
+//
+// The commands are:
+//
+//	ascii     convert text to its ASCII equivalent
+//	scan      report confusable, invisible and bidirectional characters within files
+//	serve     serve a JSON API for converting, comparing and checking strings
+//	skeleton  convert text to its skeleton
+//	version   report the version of the Unicode data
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// Exit codes, following grep, where 1 reports that something was found.
+const (
+	exitOK    = 0
+	exitFound = 1
+	exitError = 2
+)
+
+// command runs a command with its arguments, returning its exit code.
+type command func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+
+var commands = map[string]command{
+	"ascii":    runASCII,
+	"scan":     runScan,
+	"serve":    runServe,
+	"skeleton": runSkeleton,
+	"version":  runVersion,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+
+		return exitError
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "confusables: unknown command %q\n", args[0])
+		usage(stderr)
+
+		return exitError
+	}
+
+	return cmd(args[1:], stdin, stdout, stderr)
+}
+
+func usage(w io.Writer) {
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "usage: confusables <command> [arguments]")
+	fmt.Fprintln(w, "commands:")
+
+	for _, name := range names {
+		fmt.Fprintf(w, "\t%s\n", name)
+	}
+}
@@ -0,0 +1,259 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/eskriett/confusables"
+)
+
+// binarySniffLen is the length of the prefix of a file which is checked for NUL bytes to detect binary files.
+const binarySniffLen = 8000
+
+// Kinds of finding.
+const (
+	kindBidi        = "bidi-control"
+	kindConfusable  = "confusable"
+	kindInvisible   = "invisible"
+	kindMixedScript = "mixed-script"
+)
+
+// skippedDirs are the directories which are not walked, as they hold version control metadata.
+var skippedDirs = map[string]bool{".git": true, ".hg": true, ".svn": true}
+
+// finding is a suspicious rune within a line.
+type finding struct {
+	// col is the column of the rune within its line, counting runes from 1.
+	col  int
+	kind string
+	name string
+	r    rune
+}
+
+// stdinName names standard input in findings.
+const stdinName = "-"
+
+// reporter writes the findings of line n, counting from 1, of the named input.
+type reporter func(w io.Writer, name string, n int, line string, findings []finding) error
+
+func runScan(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("scan", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: confusables scan [--highlight] [path...]")
+		fmt.Fprintln(stderr, "Reports bidirectional controls, invisible characters, runes which mix scripts within a "+
+			"word and words which are confusable with ASCII. Standard input is scanned when no path is given.")
+		flags.PrintDefaults()
+	}
+
+	highlight := flags.Bool("highlight", false, "print lines with findings colorized and annotated")
+
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	report := writeFindings
+	if *highlight {
+		report = writeHighlighted
+	}
+
+	if flags.NArg() == 0 {
+		data, err := io.ReadAll(stdin)
+		if err == nil {
+			var found bool
+
+			found, err = scanData(stdout, stdinName, data, report)
+			if found && err == nil {
+				return exitFound
+			}
+		}
+
+		if err != nil {
+			fmt.Fprintf(stderr, "confusables: %v\n", err)
+
+			return exitError
+		}
+
+		return exitOK
+	}
+
+	code := exitOK
+
+	for _, root := range flags.Args() {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			switch {
+			case err != nil:
+				return err
+			case d.IsDir() && skippedDirs[d.Name()] && path != root:
+				return filepath.SkipDir
+			case !d.Type().IsRegular():
+				return nil
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			found, err := scanData(stdout, path, data, report)
+			if found && code == exitOK {
+				code = exitFound
+			}
+
+			return err
+		})
+		if err != nil {
+			fmt.Fprintf(stderr, "confusables: %v\n", err)
+
+			code = exitError
+		}
+	}
+
+	return code
+}
+
+// Scan the named input, reporting the findings of each line and whether there were any. Binary data is skipped.
+func scanData(w io.Writer, name string, data []byte, report reporter) (bool, error) {
+	if bytes.IndexByte(data[:min(len(data), binarySniffLen)], 0) >= 0 {
+		return false, nil
+	}
+
+	found := false
+
+	for n, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+
+		findings := scanLine(line)
+		if len(findings) == 0 {
+			continue
+		}
+
+		found = true
+
+		if err := report(w, name, n+1, line, findings); err != nil {
+			return found, err
+		}
+	}
+
+	return found, nil
+}
+
+// Write each finding of a line as "name:line:col: description".
+func writeFindings(w io.Writer, name string, n int, _ string, findings []finding) error {
+	for _, f := range findings {
+		if _, err := fmt.Fprintf(w, "%s:%d:%d: %s\n", name, n, f.col, f.describe()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Find the suspicious runes within a line, in order.
+func scanLine(line string) []finding {
+	var findings []finding
+
+	add := func(offset int, r rune, kind, name string) {
+		findings = append(findings, finding{
+			col:  utf8.RuneCountInString(line[:offset]) + 1,
+			kind: kind,
+			name: name,
+			r:    r,
+		})
+	}
+
+	reported := map[int]bool{}
+
+	for i, r := range line {
+		if r < utf8.RuneSelf {
+			continue
+		}
+
+		switch s := string(r); {
+		case confusables.HasBidiControls(s):
+			add(i, r, kindBidi, "")
+			reported[i] = true
+		case len(confusables.FindInvisibles(s)) > 0:
+			add(i, r, kindInvisible, "")
+			reported[i] = true
+		}
+	}
+
+	for _, word := range confusables.ScanWords(line) {
+		if !word.HasConfusables {
+			continue
+		}
+
+		kind := wordKind(word.Token)
+		if kind == "" {
+			continue
+		}
+
+		_, diffs := confusables.ToASCIIDiff(word.Token)
+		n := 0
+
+		for i, r := range word.Token {
+			diff := diffs[n]
+			n++
+
+			// The runes of suspicious words which are reported are those with a confusable mapping.
+			if r < utf8.RuneSelf || reported[word.Start+i] || diff.Confusable == nil {
+				continue
+			}
+
+			name := ""
+			if diff.Description != nil {
+				name = diff.Description.From
+			}
+
+			add(word.Start+i, r, kind, name)
+		}
+	}
+
+	// Runes found by the different checks are appended separately.
+	slices.SortStableFunc(findings, func(a, b finding) int {
+		return a.col - b.col
+	})
+
+	return findings
+}
+
+// Get the kind of finding a word containing confusables is, if it is suspicious. A word is suspicious when it mixes
+// scripts or, written in another script, is confusable with ASCII.
+func wordKind(word string) string {
+	if confusables.MixedScriptReport(word).Mixed {
+		return kindMixedScript
+	}
+
+	if a := confusables.ToASCII(word); a != word && isASCII(a) {
+		return kindConfusable
+	}
+
+	return ""
+}
+
+func (f finding) describe() string {
+	if f.name == "" {
+		return fmt.Sprintf("U+%04X (%s)", f.r, f.kind)
+	}
+
+	return fmt.Sprintf("U+%04X %s (%s)", f.r, f.name, f.kind)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+
+	return true
+}
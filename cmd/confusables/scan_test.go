@@ -0,0 +1,76 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScanLine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line  string
+		kinds []string
+		cols  []int
+	}{
+		{"plain ascii", nil, nil},
+		{"привет мир", nil, nil},
+		{"pаypal", []string{kindMixedScript}, []int{2}},
+		{"раураl", []string{kindMixedScript, kindMixedScript, kindMixedScript, kindMixedScript, kindMixedScript},
+			[]int{1, 2, 3, 4, 5}},
+		{"go to раура.com",
+			[]string{kindMixedScript, kindMixedScript, kindMixedScript, kindMixedScript, kindMixedScript},
+			[]int{7, 8, 9, 10, 11}},
+		{"go to раура now", []string{kindConfusable, kindConfusable, kindConfusable, kindConfusable, kindConfusable},
+			[]int{7, 8, 9, 10, 11}},
+		{"Tokyo東京", nil, nil},
+		{"access\u202e level", []string{kindBidi}, []int{7}},
+		{"is\u200badmin", []string{kindInvisible}, []int{3}},
+	}
+
+	for _, test := range tests {
+		findings := scanLine(test.line)
+
+		var (
+			kinds []string
+			cols  []int
+		)
+
+		for _, f := range findings {
+			kinds = append(kinds, f.kind)
+			cols = append(cols, f.col)
+		}
+
+		assert.Equal(t, test.kinds, kinds, "scanLine(%q)", test.line)
+		assert.Equal(t, test.cols, cols, "scanLine(%q)", test.line)
+	}
+}
+
+func TestRunScan(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "clean.go"), []byte("package clean\n"), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "binary"), []byte("p\x00аypal"), 0o600))
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, ".git"), 0o700))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, ".git", "config"), []byte("pаypal"), 0o600))
+
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, exitOK, run([]string{"scan", dir}, nil, &stdout, &stderr))
+	assert.Empty(t, stdout.String())
+
+	path := filepath.Join(dir, "main.go")
+	assert.NoError(t, os.WriteFile(path, []byte("package main\n\nvar pаypal = 1\n"), 0o600))
+
+	assert.Equal(t, exitFound, run([]string{"scan", dir}, nil, &stdout, &stderr))
+	assert.Equal(t, path+":3:6: U+0430 CYRILLIC SMALL LETTER A (mixed-script)\n", stdout.String())
+
+	assert.Equal(t, exitError, run([]string{"scan", filepath.Join(dir, "missing")}, nil, &stdout, &stderr))
+	assert.Equal(t, exitError, run([]string{"unknown"}, nil, &stdout, &stderr))
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/eskriett/confusables"
+)
+
+const (
+	// maxRequestSize is the size of the largest request body accepted by the server.
+	maxRequestSize = 1 << 20
+	// readHeaderTimeout bounds how long a client may take to send request headers.
+	readHeaderTimeout = 10 * time.Second
+	// shutdownTimeout bounds how long in-flight requests are given to complete on shutdown.
+	shutdownTimeout = 10 * time.Second
+)
+
+// textRequest is the body of requests to the endpoints which take a single string.
+type textRequest struct {
+	Text string `json:"text"`
+}
+
+// pairRequest is the body of requests to the endpoints which compare two strings.
+type pairRequest struct {
+	S1 string `json:"s1"`
+	S2 string `json:"s2"`
+}
+
+type textResponse struct {
+	Result string `json:"result"`
+}
+
+type confusableResponse struct {
+	Confusable bool `json:"confusable"`
+}
+
+type checkResponse struct {
+	BidiControls     []string `json:"bidiControls,omitempty"`
+	Disallowed       []string `json:"disallowed,omitempty"`
+	Failed           []string `json:"failed"`
+	Passed           bool     `json:"passed"`
+	RestrictionLevel string   `json:"restrictionLevel"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func runServe(args []string, _ io.Reader, _, stderr io.Writer) int {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(stderr, "usage: confusables serve [--addr address]")
+		fmt.Fprintln(stderr, "Serves a JSON API with the endpoints /to-ascii, /skeleton, /is-confusable and /check.")
+		flags.PrintDefaults()
+	}
+
+	addr := flags.String("addr", ":8080", "address to listen on")
+
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           newAPIHandler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		fmt.Fprintf(stderr, "confusables: %v\n", err)
+
+		return exitError
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		fmt.Fprintf(stderr, "confusables: %v\n", err)
+
+		return exitError
+	}
+
+	return exitOK
+}
+
+// Create the handler serving the JSON API. Each endpoint accepts a POST with a JSON body.
+func newAPIHandler() http.Handler {
+	mux := http.NewServeMux()
+	checker := confusables.NewSpoofChecker()
+
+	mux.HandleFunc("POST /to-ascii", handleJSON(func(req textRequest) (any, error) {
+		return textResponse{Result: confusables.ToASCII(req.Text)}, nil
+	}))
+	mux.HandleFunc("POST /skeleton", handleJSON(func(req textRequest) (any, error) {
+		return textResponse{Result: confusables.ToSkeleton(req.Text)}, nil
+	}))
+	mux.HandleFunc("POST /is-confusable", handleJSON(func(req pairRequest) (any, error) {
+		return confusableResponse{Confusable: confusables.IsConfusable(req.S1, req.S2)}, nil
+	}))
+	mux.HandleFunc("POST /check", handleJSON(func(req textRequest) (any, error) {
+		result, err := checker.Check(req.Text)
+		if err != nil {
+			return nil, err
+		}
+
+		resp := checkResponse{
+			BidiControls:     codepoints(result.BidiControls),
+			Disallowed:       codepoints(result.Disallowed),
+			Failed:           []string{},
+			Passed:           result.Passed(),
+			RestrictionLevel: result.RestrictionLevel.String(),
+		}
+
+		if !result.Passed() {
+			resp.Failed = strings.Split(result.Failed.String(), "|")
+		}
+
+		return resp, nil
+	}))
+
+	return mux
+}
+
+// Create a handler which decodes a request body of type T, passes it to fn and encodes its response. Errors, including
+// those returned by fn, are reported with status 400 Bad Request.
+func handleJSON[T any](fn func(T) (any, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req T
+
+		d := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize))
+		d.DisallowUnknownFields()
+
+		err := d.Decode(&req)
+		if err == nil && d.More() {
+			err = errors.New("request body must contain a single JSON value")
+		}
+
+		var resp any
+		if err == nil {
+			resp, err = fn(req)
+		}
+
+		if err != nil {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: err.Error()})
+
+			return
+		}
+
+		writeJSON(w, http.StatusOK, resp)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// Format runes as their code points, e.g. "U+202E".
+func codepoints(runes []rune) []string {
+	var cps []string
+
+	for _, r := range runes {
+		cps = append(cps, fmt.Sprintf("U+%04X", r))
+	}
+
+	return cps
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIHandler(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(newAPIHandler())
+	defer srv.Close()
+
+	tests := []struct {
+		method, path, body string
+		status             int
+		want               string
+	}{
+		{http.MethodPost, "/to-ascii", `{"text":"pаypal"}`, http.StatusOK, `{"result":"paypal"}`},
+		{http.MethodPost, "/skeleton", `{"text":"ех⍺ⅿрІꬲ"}`, http.StatusOK, `{"result":"exarnple"}`},
+		{http.MethodPost, "/is-confusable", `{"s1":"paypal","s2":"pаypal"}`, http.StatusOK, `{"confusable":true}`},
+		{http.MethodPost, "/check", `{"text":"paypal"}`, http.StatusOK,
+			`{"failed":[],"passed":true,"restrictionLevel":"ASCII-Only"}`},
+		{http.MethodPost, "/check", `{"text":"invoice\u202egpj.exe"}`, http.StatusOK,
+			`{"bidiControls":["U+202E"],"disallowed":["U+202E"],` +
+				`"failed":["invisible","restriction-level","allowed-characters","bidi"],` +
+				`"passed":false,"restrictionLevel":"Unrestricted"}`},
+		{http.MethodPost, "/to-ascii", `{"text":`, http.StatusBadRequest, ""},
+		{http.MethodPost, "/to-ascii", `{"s":"x"}`, http.StatusBadRequest, ""},
+		{http.MethodPost, "/to-ascii", `{"text":"a"} {}`, http.StatusBadRequest, ""},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest(test.method, srv.URL+test.path, strings.NewReader(test.body))
+		assert.NoError(t, err)
+
+		resp, err := http.DefaultClient.Do(req)
+		assert.NoError(t, err)
+
+		body, err := io.ReadAll(resp.Body)
+		assert.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+
+		assert.Equal(t, test.status, resp.StatusCode, "%s %s", test.path, test.body)
+
+		if test.status != http.StatusOK {
+			// Error messages come from encoding/json, so only their presence is checked.
+			assert.Contains(t, string(body), `"error":`, "%s %s", test.path, test.body)
+		} else {
+			assert.JSONEq(t, test.want, string(body), "%s %s", test.path, test.body)
+		}
+	}
+
+	resp, err := http.Get(srv.URL + "/to-ascii")
+	assert.NoError(t, err)
+	assert.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
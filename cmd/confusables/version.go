@@ -0,0 +1,24 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/eskriett/confusables"
+)
+
+// runVersion reports the version of the Unicode data the mappings are generated from.
+func runVersion(args []string, _ io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("version", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	fmt.Fprintf(stdout, "Unicode %s (%s)\n", confusables.UnicodeVersion, confusables.UnicodeDataDate)
+	fmt.Fprintf(stdout, "source: %s\n", confusables.UnicodeDataSource)
+
+	return exitOK
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunVersion(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	assert.Equal(t, exitOK, run([]string{"version"}, strings.NewReader(""), &stdout, &stderr))
+	assert.Contains(t, stdout.String(), "Unicode "+confusables.UnicodeVersion+" ")
+	assert.Contains(t, stdout.String(), "source: "+confusables.UnicodeDataSource)
+
+	assert.Equal(t, exitError, run([]string{"version", "-bad"}, strings.NewReader(""), &stdout, &stderr))
+}
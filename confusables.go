@@ -5,21 +5,43 @@ package confusables
 //go:generate go run scripts/build-tables.go > tables.go
 
 import (
-	"bufio"
+	"context"
 	"errors"
+	"fmt"
 	"io"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 
-	"golang.org/x/text/runes"
-	"golang.org/x/text/transform"
+	"golang.org/x/text/cases"
 	"golang.org/x/text/unicode/norm"
 )
 
 // ErrIgnoreLine is raised when processing a line which should be ignored.
 var ErrIgnoreLine = errors.New("line should be ignored")
 
+// ErrInvalidLine is raised when processing a line which cannot be parsed.
+var ErrInvalidLine = errors.New("invalid confusable line")
+
+var (
+	errInvalidCodePoint = errors.New("invalid code point")
+	errNoCodePoints     = errors.New("no code points")
+)
+
+// defaultDigits holds the digits which ToNumber substitutes for the runes which look like them, by default.
+var defaultDigits = map[rune]rune{
+	'!': '1',
+	'I': '1',
+	'L': '1',
+	'O': '0',
+	'i': '1',
+	'l': '1',
+	'o': '0',
+}
+
 const (
 	base    = 16
 	bitsize = 64
@@ -29,12 +51,38 @@ const (
 type ConfusableEntry struct {
 	Description Description
 	Source      rune
-	Target      string
+	// SourceSequence holds every rune of the source, of which Source is the first. Most sources are a single rune.
+	SourceSequence string
+	Target         string
 }
 
-// Confusables provides functions for identifying words that appear to be similar but use different characters.
+// Confusables provides functions for identifying words that appear to be similar but use different characters. It
+// is safe for concurrent use.
 type Confusables struct {
-	removeMarks transform.Transformer
+	cache                *lruCache
+	caseFold             bool
+	confusablesOnly      bool
+	digits               map[rune]rune
+	digitsOnlyContext    bool
+	emojiLetters         bool
+	emojiPlaceholder     string
+	halfwidth            bool
+	leet                 map[rune]rune
+	normalizePunctuation bool
+	normalizeSpaces      bool
+	normalizeSymbols     bool
+	observer             Observer
+	replaceEmoji         bool
+	reverseLeet          bool
+	sourceScripts        []*unicode.RangeTable
+	stats                *stats
+	stripInvisible       bool
+	// tableData holds the generated tables the instance's mappings start from, and tables the mappings themselves,
+	// which are selected by New once every option is applied.
+	tableData         *Tables
+	tables            *tableSet
+	transliterate     bool
+	withoutAmendments bool
 }
 
 // Description describes a mapping for a confusable.
@@ -45,116 +93,664 @@ type Description struct {
 
 // Diff details the mapping from a rune to its confusable if it exists.
 type Diff struct {
-	Confusable  *string
+	Confusable *string
+	// Description names the rune and its confusable, and is set whenever Confusable is. Runes whose names are not held
+	// by the mappings are named by their code point, e.g. "U+E000".
 	Description *Description
-	Rune        rune
+	// Intentional reports whether the rune and its confusable are intentional confusables, i.e. their glyphs are
+	// identical. See IsIntentional.
+	Intentional bool
+	// Origin identifies the dataset the mapping of the rune came from, such as confusables.txt or the amendments, so
+	// that a match may be traced to its cause.
+	Origin Origin
+	Rune   rune
+	// Sequence holds the runes, starting with Rune, which were replaced together by Confusable when a sequence of
+	// runes is mapped. The other runes of the sequence are reported with an empty Confusable.
+	Sequence string
+	// Severity grades how alike the rune and its confusable look. See Severity.
+	Severity Severity
+}
+
+// Option configures an instance of Confusables.
+type Option func(*Confusables)
+
+// DigitsOnlyContext restricts the letter to digit substitutions made by ToNumber to tokens which are otherwise
+// numeric. A token is a run of non-space characters and is treated as numeric when it contains at least one digit and
+// every letter within it has a digit lookalike, e.g. "O12" becomes "012" whereas "foobar" is left untouched.
+func DigitsOnlyContext() Option {
+	return func(c *Confusables) {
+		c.digitsOnlyContext = true
+	}
+}
+
+// WithAmendments selects whether the package's amendments to the mappings of confusables.txt, as returned by
+// Amendments, are applied. They are applied by default; WithAmendments(false) gives the behaviour of TR39 alone. Such
+// an instance does not see mappings added to the package's mappings at runtime.
+func WithAmendments(enabled bool) Option {
+	return func(c *Confusables) {
+		c.withoutAmendments = !enabled
+	}
+}
+
+// WithCache caches up to size results of ToASCII and ToSkeleton, keyed by their input, evicting the least recently
+// used results when full. This benefits workloads where the same strings are seen repeatedly.
+func WithCache(size int) Option {
+	return func(c *Confusables) {
+		if size > 0 {
+			c.cache = newLRUCache(size)
+		}
+	}
+}
+
+// WithCaseFold case folds the output of ToASCII and the input of ToSkeleton, so that strings which differ only in case
+// are converted to the same string and are confusable, e.g. "PayPal" and "раураl".
+func WithCaseFold() Option {
+	return func(c *Confusables) {
+		c.caseFold = true
+	}
+}
+
+// WithConfusablesOnly restricts ToASCII to replacing runes which have an ASCII confusable mapping. Every other rune,
+// including invalid UTF-8, is left byte-for-byte as it was: nonspacing marks are not removed from unmapped runes and
+// the result is not NFKC normalized, so compatibility characters such as "²" are preserved.
+func WithConfusablesOnly() Option {
+	return func(c *Confusables) {
+		c.confusablesOnly = true
+	}
+}
+
+// WithDigitSubstitutions replaces the substitutions made by ToNumber, which by default substitute "0" for "o" and "1"
+// for "i", "l" and "!", ignoring case. substitutions maps each rune to the digit it is substituted with, e.g. 'B' to
+// '8', and is matched against the ASCII form of strings. Runes of each case must be given separately;
+// DefaultDigitSubstitutions may be extended to add to the defaults.
+func WithDigitSubstitutions(substitutions map[rune]rune) Option {
+	return func(c *Confusables) {
+		c.digits = maps.Clone(substitutions)
+	}
+}
+
+// WithEmojiLetters replaces letter-like emoji with the letters they look like while converting to ASCII, e.g. "🅰️"
+// becomes "A", "🆎" becomes "AB" and a flag such as "🇺🇸" becomes the letters of its regional indicators, "US". It may
+// be combined with WithEmojiPlaceholder or WithStripEmoji, which then only apply to other emoji.
+func WithEmojiLetters() Option {
+	return func(c *Confusables) {
+		c.emojiLetters = true
+	}
+}
+
+// WithEmojiPlaceholder replaces each emoji with placeholder while converting to ASCII. An emoji includes any modifiers,
+// variation selectors and emoji joined to it by zero width joiners, so "👩🏽‍💻" is replaced by a single placeholder.
+func WithEmojiPlaceholder(placeholder string) Option {
+	return func(c *Confusables) {
+		c.replaceEmoji = true
+		c.emojiPlaceholder = placeholder
+	}
+}
+
+// WithHalfwidth converts fullwidth forms to their halfwidth equivalent, as ToHalfwidth, while converting to ASCII.
+// Unlike the NFKC normalization of ToASCII, this also applies with WithConfusablesOnly.
+func WithHalfwidth() Option {
+	return func(c *Confusables) {
+		c.halfwidth = true
+	}
+}
+
+// WithLeetSubstitutions replaces the substitutions made by ToText. substitutions maps each digit or symbol to the
+// letter it is substituted with. As some stand in for several letters, e.g. "1" for "l" or "i", callers may choose how
+// they are resolved; "1" is substituted with "l" by default.
+func WithLeetSubstitutions(substitutions map[rune]rune) Option {
+	return func(c *Confusables) {
+		c.leet = maps.Clone(substitutions)
+	}
+}
+
+// WithNormalizePunctuation normalizes punctuation, as NormalizePunctuation, while converting to ASCII. It takes
+// precedence over the confusable mappings of punctuation, e.g. "″" becomes a quote rather than two apostrophes.
+func WithNormalizePunctuation() Option {
+	return func(c *Confusables) {
+		c.normalizePunctuation = true
+	}
+}
+
+// WithNormalizeSpaces normalizes spaces, as NormalizeSpaces, while converting to ASCII.
+func WithNormalizeSpaces() Option {
+	return func(c *Confusables) {
+		c.normalizeSpaces = true
+	}
+}
+
+// WithNormalizeSymbols normalizes symbols, as NormalizeSymbols, while converting to ASCII.
+func WithNormalizeSymbols() Option {
+	return func(c *Confusables) {
+		c.normalizeSymbols = true
+	}
+}
+
+// WithObserver notifies o of each rune replaced by ToASCII, AppendASCII, ToASCIIDiff, ToASCIIWithIndex, ToSkeleton
+// and the functions built on them, such as ToNumber. Results returned from the cache are observed too. The methods
+// which take no context notify o with context.Background(); ToASCIIContext and ToSkeletonContext pass on their own.
+func WithObserver(o Observer) Option {
+	return func(c *Confusables) {
+		c.observer = o
+	}
+}
+
+// WithReverseLeet reverses leetspeak while converting to ASCII, as ToText, e.g. "fr33 m0n3y" becomes "free money".
+// The substitutions are those of WithLeetSubstitutions, and are not reported in diffs.
+func WithReverseLeet() Option {
+	return func(c *Confusables) {
+		c.reverseLeet = true
+	}
+}
+
+// WithSourceScripts restricts ToLatin to converting characters of the given scripts, e.g. unicode.Cyrillic, leaving
+// the characters of every other script as they are. The result is then NFC rather than NFKC normalized, so that
+// compatibility characters are also left as they are.
+func WithSourceScripts(scripts ...*unicode.RangeTable) Option {
+	return func(c *Confusables) {
+		c.sourceScripts = slices.Clone(scripts)
+	}
+}
+
+// WithStats counts the conversions made by the instance and the runes they replace, as reported by Stats. As with
+// WithObserver, counting the replaced runes takes a further pass over each string.
+func WithStats() Option {
+	return func(c *Confusables) {
+		c.stats = &stats{}
+	}
+}
+
+// WithStripEmoji removes emoji while converting to ASCII, as WithEmojiPlaceholder with an empty placeholder.
+func WithStripEmoji() Option {
+	return WithEmojiPlaceholder("")
+}
+
+// WithStripInvisible removes default ignorable code points, which are not rendered, while converting to ASCII. Each
+// removed rune is reported in diffs with an empty Confusable.
+func WithStripInvisible() Option {
+	return func(c *Confusables) {
+		c.stripInvisible = true
+	}
+}
+
+// WithTables selects the generated tables which the instance's mappings start from, in place of the package's own,
+// such as those of a package under tables/ generated from a given version of the Unicode data:
+//
+//	c := confusables.New(confusables.WithTables(v16.Tables))
+//
+// Pinning a version keeps the output of an instance, such as stored skeletons, stable when the package is upgraded
+// along with its tables. The amendments of the selected tables are applied unless WithAmendments(false) is used, and
+// mappings added to the package's mappings at runtime are not seen.
+func WithTables(t *Tables) Option {
+	return func(c *Confusables) {
+		c.tableData = t
+	}
+}
+
+// WithTransliteration transliterates letters which are not confusable with ASCII, and have no marks to remove, while
+// converting to ASCII, e.g. "ß" becomes "ss", "æ" becomes "ae" and "ø" becomes "o". This makes the output of ToASCII
+// ASCII for more names, but is lossy, as distinct names may be transliterated the same.
+func WithTransliteration() Option {
+	return func(c *Confusables) {
+		c.transliterate = true
+	}
 }
 
 // New creates a new instance of Confusables.
-func New() *Confusables {
-	return &Confusables{
-		removeMarks: transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC),
+func New(opts ...Option) *Confusables {
+	c := &Confusables{
+		digits:    defaultDigits,
+		leet:      defaultLeet,
+		tableData: packageTables,
 	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	c.tables = c.tableData.tableSet(!c.withoutAmendments)
+
+	return c
+}
+
+// AppendASCII appends the ASCII form of s, as returned by ToASCII, to dst and returns the extended buffer.
+func (c *Confusables) AppendASCII(dst []byte, s string) []byte {
+	if c.cache != nil || c.normalizeSpaces || c.reverseLeet {
+		return append(dst, c.ToASCII(s)...)
+	}
+
+	start := len(dst)
+	t := c.tables.load()
+	c.observeASCII(context.Background(), t, s)
+
+	if isASCII(s) && !t.asciiSequences.hasASCII() {
+		dst = append(dst, s...)
+	} else {
+		end := 0
+
+		for i, r := range s {
+			if i < end {
+				continue
+			}
+
+			if source, v, ok := c.matchSequence(t, s[i:]); ok {
+				dst = append(dst, v...)
+				end = i + len(source)
+
+				continue
+			}
+
+			if r <= unicode.MaxASCII {
+				dst = append(dst, byte(r))
+
+				continue
+			}
+
+			if v, ok := c.mapRune(t, r); ok {
+				dst = append(dst, v...)
+			} else if r == utf8.RuneError && c.confusablesOnly {
+				_, size := utf8.DecodeRuneInString(s[i:])
+				dst = append(dst, s[i:i+size]...)
+			} else {
+				dst = utf8.AppendRune(dst, r)
+			}
+		}
+
+		if !c.confusablesOnly && !norm.NFKC.IsNormal(dst[start:]) {
+			dst = append(dst[:start], norm.NFKC.Bytes(dst[start:])...)
+		}
+	}
+
+	if c.caseFold {
+		dst = append(dst[:start], cases.Fold().Bytes(dst[start:])...)
+	}
+
+	return dst
 }
 
 // ToASCII converts characters in a string to their ASCII equivalent if possible.
 func (c *Confusables) ToASCII(s string) string {
-	a, _ := c.toASCII(s)
+	return c.ToASCIIContext(context.Background(), s)
+}
+
+// Get the ASCII form of s, using the instance's cache when configured.
+func (c *Confusables) toASCIICached(t *tables, s string) string {
+	return c.cache.getOrCompute(t, cacheASCII, s, func(s string) string {
+		a, _ := c.toASCII(t, s)
 
-	return a
+		return a
+	})
 }
 
 func (c *Confusables) ToASCIIDiff(s string) (string, []Diff) {
-	return c.toASCII(s)
+	a, diffs := c.toASCII(c.tables.load(), s)
+	c.notify(context.Background(), diffs)
+
+	return a, diffs
+}
+
+// ToASCIIDiffFunc calls fn with the Diff of each rune in s, as returned by ToASCIIDiff, stopping if fn returns false.
+// Unlike ToASCIIDiff, no slice of diffs is allocated.
+func (c *Confusables) ToASCIIDiffFunc(s string, fn func(Diff) bool) {
+	t := c.tables.load()
+	end := 0
+
+	c.observeCall()
+
+	for i, r := range s {
+		diff := c.diffAt(t, s, i, r, &end)
+		c.observe(context.Background(), diff)
+
+		if !fn(diff) {
+			return
+		}
+	}
+}
+
+// ToDigits converts a string as ToNumber does and then removes every rune which is not a digit, leaving only the
+// numeric content, e.g. "ext. O7 l23" becomes "07123". DigitsOnlyContext avoids digits being found within words.
+func (c *Confusables) ToDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsDigit(r) {
+			return -1
+		}
+
+		return r
+	}, c.ToNumber(s))
 }
 
 // ToNumber converts characters in a string that look like numbers into numbers.
 func (c *Confusables) ToNumber(s string) string {
-	s = c.ToASCII(s)
+	return c.substituteDigits(c.ToASCII(s), nil)
+}
 
-	var number strings.Builder
+// ToNumberDiff converts characters in a string that look like numbers into numbers, as ToNumber, returning the Diff of
+// each rune in s as ToASCIIDiff does. Runes which are substituted with digits, such as "O" with "0", are reported with
+// the digits as their Confusable. Where normalization combines several runes, their substitutions are reported against
+// the first of them.
+func (c *Confusables) ToNumberDiff(s string) (string, []Diff) {
+	t := c.tables.load()
+	diffs := make([]Diff, 0, utf8.RuneCountInString(s))
+	a, m := c.toASCIIWithIndex(t, s, &diffs)
 
-	for _, r := range s {
-		switch strings.ToLower(string(r)) {
-		case "o":
-			r = '0'
-		case "i", "l", "!":
-			r = '1'
+	c.notify(context.Background(), diffs)
+
+	// digits holds the digits substituted within a, by offset.
+	digits := map[int]rune{}
+
+	number := c.substituteDigits(a, func(i int, digit rune) {
+		digits[i] = digit
+	})
+
+	if len(digits) == 0 {
+		return number, diffs
+	}
+
+	// starts holds the offset within s of each rune, and so of each diff.
+	starts := make([]int, 0, len(diffs))
+	for i := range s {
+		starts = append(starts, i)
+	}
+
+	for start := 0; start < len(a); {
+		origin := m.ToOriginal(start)
+
+		end := start + 1
+		for end < len(a) && m.ToOriginal(end) == origin {
+			end++
 		}
 
-		number.WriteRune(r)
+		if k, ok := slices.BinarySearch(starts, origin); ok {
+			diffs[k] = numberDiff(t, diffs[k], a[start:end], start, digits)
+		}
+
+		start = end
 	}
 
-	return number.String()
+	return number, diffs
+}
+
+// IsConfusable checks if two strings are confusable of one another, as IsConfusable, using the instance's mappings.
+func (c *Confusables) IsConfusable(s1, s2 string) bool {
+	t := c.tables.load()
+
+	return c.toSkeletonCached(t, s1) == c.toSkeletonCached(t, s2)
 }
 
-func (c *Confusables) processRune(r rune) *Diff {
-	diff := &Diff{}
+// ToSkeleton converts a string to its skeleton form, as ToSkeleton, using the instance's cache when configured.
+func (c *Confusables) ToSkeleton(s string) string {
+	return c.ToSkeletonContext(context.Background(), s)
+}
+
+// Get the skeleton of s, using the instance's cache when configured.
+func (c *Confusables) toSkeletonCached(t *tables, s string) string {
+	return c.cache.getOrCompute(t, cacheSkeleton, s, func(s string) string {
+		s = c.caseFolded(s)
 
-	diff.Rune = r
+		return string(t.appendSkeleton(make([]byte, 0, len(s)), s))
+	})
+}
 
+// Get the ASCII equivalent of a rune, if it has one.
+func (c *Confusables) mapRune(t *tables, r rune) (string, bool) {
 	if r <= unicode.MaxASCII {
-		return diff
+		return "", false
+	}
+
+	if c.stripInvisible && isDefaultIgnorable(r) {
+		return "", true
+	}
+
+	if c.normalizePunctuation {
+		if v, ok := asciiPunctuation(r); ok {
+			return v, true
+		}
+	}
+
+	if c.normalizeSymbols {
+		if v, ok := asciiSymbol(r); ok {
+			return v, true
+		}
+	}
+
+	if c.halfwidth {
+		if h := toHalfwidth(r); h != r {
+			return string(h), true
+		}
 	}
 
-	if v, ok := confusables[r]; ok {
-		c.removeMarks.Reset()
+	if v, ok := t.ascii.lookup(r); ok {
+		return v, true
+	}
 
-		v, _, _ := transform.String(c.removeMarks, v)
+	if c.confusablesOnly {
+		return "", false
+	}
 
+	// Only runes which are, or decompose to include, nonspacing marks can be changed by removing them.
+	if unicode.Is(unicode.Mn, r) || norm.NFD.PropertiesString(string(r)).Decomposition() != nil {
+		v := removeMarks(string(r))
 		if isASCII(v) {
-			diff.Confusable = &v
-			diff.Description = getDescriptionMapping(r, &v)
+			return v, true
+		}
 
-			return diff
+		if c.transliterate {
+			return transliterate(v)
 		}
+
+		return "", false
+	}
+
+	if c.transliterate {
+		v, ok := transliterations[r]
+
+		return v, ok
+	}
+
+	return "", false
+}
+
+// Get the Diff of the rune r at byte offset i of s. When a sequence starting with r is mapped, end is set to the offset
+// at which it ends, and the runes before end are reported as replaced along with r.
+func (c *Confusables) diffAt(t *tables, s string, i int, r rune, end *int) Diff {
+	if i < *end {
+		return Diff{Confusable: new(string), Rune: r}
+	}
+
+	if source, v, ok := c.matchSequence(t, s[i:]); ok {
+		*end = i + len(source)
+
+		return t.sequenceDiff(r, source, v)
+	}
+
+	return c.processRune(t, r)
+}
+
+// Find the runes which s starts with that are replaced together, either an emoji handled by the emoji options or a
+// mapped sequence, returning them along with their replacement.
+func (c *Confusables) matchSequence(t *tables, s string) (source, v string, ok bool) {
+	if source, v, ok := c.matchEmoji(s); ok {
+		return source, v, true
 	}
 
-	c.removeMarks.Reset()
+	return t.asciiSequences.match(s)
+}
 
-	v, _, _ := transform.String(c.removeMarks, string(r))
-	if isASCII(v) {
-		diff.Confusable = &v
-		diff.Description = getDescriptionMapping(r, &v)
+func (c *Confusables) processRune(t *tables, r rune) Diff {
+	diff := Diff{Rune: r}
+
+	if v, ok := c.mapRune(t, r); ok {
+		// Copying v means only runes which are mapped allocate.
+		confusable := v
+		diff.Confusable = &confusable
+		diff.Description = t.description(string(r), &confusable)
+		diff.Intentional = isIntentionalMapping(r, &confusable)
+		diff.Origin = t.origin(string(r), &confusable)
+		diff.Severity = t.severity(string(r), &confusable)
 	}
 
 	return diff
 }
 
-func (c *Confusables) toASCII(s string) (string, []Diff) {
-	if isASCII(s) {
+func (c *Confusables) toASCII(t *tables, s string) (string, []Diff) {
+	a, diffs := c.foldASCII(t, s)
+	a = c.leetReversed(c.caseFolded(a))
+
+	if c.normalizeSpaces {
+		a = NormalizeSpaces(a)
+	}
+
+	return a, diffs
+}
+
+func (c *Confusables) foldASCII(t *tables, s string) (string, []Diff) {
+	if isASCII(s) && !t.asciiSequences.hasASCII() {
 		return s, noDiff(s)
 	}
 
-	var ascii strings.Builder
+	buf := getBuffer()
+	ascii := (*buf)[:0]
+	changed := false
+	end := 0
+	// copied is the offset of s up to which the result has been built.
+	copied := 0
 
-	diffs := make([]Diff, 0, len(s))
+	diffs := make([]Diff, 0, utf8.RuneCountInString(s))
 
-	for _, r := range s {
-		diff := c.processRune(r)
-		diffs = append(diffs, *diff)
+	for i, r := range s {
+		diff := c.diffAt(t, s, i, r, &end)
+		diffs = append(diffs, diff)
+
+		// The result is only built once a rune is substituted, including invalid UTF-8 which becomes U+FFFD unless
+		// unmapped runes are preserved.
+		if diff.Confusable == nil && (r != utf8.RuneError || c.confusablesOnly) {
+			continue
+		}
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		ascii = append(ascii, s[copied:i]...)
+		copied = i + size
+		changed = true
 
 		if diff.Confusable != nil {
-			ascii.WriteString(*diff.Confusable)
+			ascii = append(ascii, *diff.Confusable...)
 		} else {
-			ascii.WriteRune(r)
+			ascii = utf8.AppendRune(ascii, r)
+		}
+	}
+
+	a := s
+	if changed {
+		ascii = append(ascii, s[copied:]...)
+		a = string(ascii)
+	}
+
+	putBuffer(buf, ascii)
+
+	if c.confusablesOnly {
+		return a, diffs
+	}
+
+	// NFKC returns a string which is already normalized unchanged, without copying it.
+	return norm.NFKC.String(a), diffs
+}
+
+// Substitute digits for the runes of s, the ASCII form of a string, which look like them. If fn is not nil, it is
+// called with the offset of each rune which is substituted along with its digit.
+func (c *Confusables) substituteDigits(s string, fn func(i int, digit rune)) string {
+	var context func(string) bool
+
+	if c.digitsOnlyContext {
+		context = func(token string) bool {
+			return isNumericToken(token, c.digits)
 		}
 	}
 
-	return norm.NFKC.String(ascii.String()), diffs
+	return substituteRunes(s, c.digits, context, fn)
 }
 
 // AddMapping allows custom mappings to be defined for a rune.
 func AddMapping(r rune, confusable string) {
-	confusables[r] = confusable
+	defaultTables.addMapping(r, confusable)
+}
+
+// AddSequenceMapping allows custom mappings to be defined for a sequence of runes, such as those confusable with a
+// single character. Where sequences overlap, the longest is replaced.
+func AddSequenceMapping(source, confusable string) {
+	defaultTables.addSequenceMapping(source, confusable)
 }
 
 // AddMappingWithDesc allows a custom mapping to be defined between a rune and its confusable and for a description to
 // be provided for that mapping.
 func AddMappingWithDesc(r rune, confusable, runeDesc, confusableDesc string) {
-	AddMapping(r, confusable)
+	defaultTables.addMappingWithDesc(r, confusable, runeDesc, confusableDesc)
+}
+
+// ContainsConfusable checks if any rune in s has a confusable mapping. ASCII input is handled without consulting the
+// mapping table and no output strings or diffs are built, making this a cheap check to perform before ToASCII or
+// ToSkeleton.
+func ContainsConfusable(s string) bool {
+	if isASCII(s) {
+		return false
+	}
+
+	for _, r := range s {
+		if isConfusable(r) {
+			return true
+		}
+	}
 
-	descriptions[runeDesc] = confusableDesc
+	return false
+}
+
+// ConfusableRatio returns the fraction of runes in s which have a confusable mapping. An empty string has a ratio of
+// zero.
+func ConfusableRatio(s string) float64 {
+	total := utf8.RuneCountInString(s)
+	if total == 0 {
+		return 0
+	}
+
+	return float64(CountConfusables(s)) / float64(total)
+}
+
+// CountConfusables returns the number of runes in s which have a confusable mapping.
+func CountConfusables(s string) int {
+	if isASCII(s) {
+		return 0
+	}
+
+	count := 0
+
+	for _, r := range s {
+		if isConfusable(r) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// DefaultDigitSubstitutions returns a copy of the substitutions made by ToNumber by default, which may be extended and
+// passed to WithDigitSubstitutions.
+func DefaultDigitSubstitutions() map[rune]rune {
+	return maps.Clone(defaultDigits)
+}
+
+// FirstConfusable returns the first rune in s which has a confusable mapping along with its byte offset within s. If no
+// rune has a mapping then ok is false.
+func FirstConfusable(s string) (r rune, byteOffset int, ok bool) {
+	if isASCII(s) {
+		return 0, -1, false
+	}
+
+	for i, r := range s {
+		if isConfusable(r) {
+			return r, i, true
+		}
+	}
+
+	return 0, -1, false
 }
 
 // IsConfusable checks if two strings are confusable of one another.
@@ -162,60 +758,78 @@ func IsConfusable(s1, s2 string) bool {
 	return ToSkeleton(s1) == ToSkeleton(s2)
 }
 
-// LoadMappings reads r and loads in confusable mappings. Where a confusable already exists, this will override the
-// mapping.
-func LoadMappings(r io.Reader) error {
-	scanner := bufio.NewScanner(r)
-
-	for scanner.Scan() {
-		confusableEntry, err := ParseLine(scanner.Text())
-		if err != nil {
-			if errors.Is(err, ErrIgnoreLine) {
-				continue
-			}
+// IsConfusableAny checks if s is confusable with any of candidates, returning the first candidate which matches. The
+// skeleton of s is only computed once; where the same candidates are checked repeatedly, a SkeletonSet avoids
+// recomputing their skeletons too.
+func IsConfusableAny(s string, candidates []string) (string, bool) {
+	skeleton := ToSkeleton(s)
 
-			return err
+	for _, candidate := range candidates {
+		if ToSkeleton(candidate) == skeleton {
+			return candidate, true
 		}
-
-		AddMappingWithDesc(confusableEntry.Source, confusableEntry.Target, confusableEntry.Description.From,
-			confusableEntry.Description.To)
 	}
 
-	return nil
+	return "", false
+}
+
+// LoadMappings reads r and loads in confusable mappings. Where a confusable already exists, this will override the
+// mapping. If an error is returned, none of the mappings are loaded.
+func LoadMappings(r io.Reader) error {
+	return defaultTables.loadMappings(r)
 }
 
-// ParseLine takes a confusable line and returns a ConfusableEntry.
-// If a line should be skipped an ErrIgnoreLine error is raised.
+// ParseLine takes a line in the format of confusables.txt and returns a ConfusableEntry. Each line holds the source
+// and target code points and the type of mapping, separated by semicolons, followed by a comment describing them, e.g.
+//
+//	0430 ;	0061 ;	MA	# ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A	#
+//
+// Whitespace around fields is ignored, as is a missing or unrecognized comment, in which case the description is left
+// empty. If a line should be skipped, such as a blank line or a comment like the file's version header, an
+// ErrIgnoreLine error is raised. A line which cannot be parsed raises an error wrapping ErrInvalidLine.
 func ParseLine(line string) (*ConfusableEntry, error) {
 	// Remove BOM, skip comments and blank lines
-	line = strings.TrimPrefix(line, string([]byte{0xEF, 0xBB, 0xBF}))
+	line = strings.TrimSpace(strings.TrimPrefix(line, "\uFEFF"))
 	if strings.HasPrefix(line, "#") || line == "" {
 		return nil, ErrIgnoreLine
 	}
 
+	data, comment, _ := strings.Cut(line, "#")
+
 	// Extract source -> target mapping
-	fields := strings.Split(line, " ;\t")
+	fields := strings.Split(data, ";")
+	if len(fields) < 2 {
+		return nil, fmt.Errorf("%w: %q: expected source and target fields", ErrInvalidLine, line)
+	}
 
 	sourceRunes, err := codepointsToRunes(fields[0])
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %q: source: %w", ErrInvalidLine, line, err)
 	}
 
 	target, err := codepointsToRunes(fields[1])
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %q: target: %w", ErrInvalidLine, line, err)
 	}
 
 	return &ConfusableEntry{
-		Description: Description{
-			From: strings.TrimSpace(strings.Split(strings.Split(fields[2], " → ")[1], " ) ")[1]),
-			To:   strings.TrimSpace(strings.Split(strings.Split(fields[2], " → ")[2], "#")[0]),
-		},
-		Source: sourceRunes[0],
-		Target: string(target),
+		Description:    parseDescription(comment),
+		Source:         sourceRunes[0],
+		SourceSequence: string(sourceRunes),
+		Target:         string(target),
 	}, nil
 }
 
+// AppendASCII appends the ASCII form of s, as returned by ToASCII, to dst and returns the extended buffer.
+func AppendASCII(dst []byte, s string) []byte {
+	return New().AppendASCII(dst, s)
+}
+
+// AppendSkeleton appends the skeleton of s, as returned by ToSkeleton, to dst and returns the extended buffer.
+func AppendSkeleton(dst []byte, s string) []byte {
+	return loadTables().appendSkeleton(dst, s)
+}
+
 // ToASCII converts characters in a string to their ASCII equivalent if possible.
 func ToASCII(s string) string {
 	return New().ToASCII(s)
@@ -226,108 +840,126 @@ func ToASCIIDiff(s string) (string, []Diff) {
 	return New().ToASCIIDiff(s)
 }
 
+// ToASCIIDiffFunc calls fn with the Diff of each rune in s, as returned by ToASCIIDiff, stopping if fn returns false.
+func ToASCIIDiffFunc(s string, fn func(Diff) bool) {
+	New().ToASCIIDiffFunc(s, fn)
+}
+
+// ToDigits converts characters in a string to their numeric values if possible, as ToNumber, and returns only the
+// digits.
+func ToDigits(s string) string {
+	return New().ToDigits(s)
+}
+
 // ToNumber converts characters in a string to their numeric values if possible.
 func ToNumber(s string) string {
 	return New().ToNumber(s)
 }
 
+// ToNumberDiff converts characters in a string to their numeric values if possible, returning the Diff of each rune.
+func ToNumberDiff(s string) (string, []Diff) {
+	return New().ToNumberDiff(s)
+}
+
 // ToSkeleton converts a string to its skeleton form as defined by the skeleton
 // algorithm in https://www.unicode.org/reports/tr39/#def-skeleton.
 func ToSkeleton(s string) string {
-	nfd := norm.NFD.String(s)
-
-	var skeleton strings.Builder
-
-	for _, r := range nfd {
-		if c, ok := confusables[r]; ok {
-			skeleton.WriteString(c)
-		} else {
-			skeleton.WriteRune(r)
-		}
-	}
-
-	return skeleton.String()
+	return string(AppendSkeleton(make([]byte, 0, len(s)), s))
 }
 
 // ToSkeletonDiff returns a slice of Diff detailing the changes that have been
 // made within the string to reach its skeleton form.
 func ToSkeletonDiff(s string) []Diff {
-	nfd := norm.NFD.String(s)
-
-	if len(nfd) == 0 {
-		return nil
-	}
-
-	diffs := make([]Diff, len(nfd))
-
-	for i, r := range nfd {
-		var confusable *string
-		if c, ok := confusables[r]; ok {
-			confusable = &c
-		}
-
-		diffs[i] = Diff{
-			Confusable:  confusable,
-			Description: getDescriptionMapping(r, confusable),
-			Rune:        r,
-		}
-	}
-
-	return diffs
+	return loadTables().skeletonDiffs(s)
 }
 
 func codepointsToRunes(s string) ([]rune, error) {
-	codePoints := strings.Split(s, " ")
+	codePoints := strings.Fields(s)
+	if len(codePoints) == 0 {
+		return nil, errNoCodePoints
+	}
 
 	runes := make([]rune, 0, len(codePoints))
 
 	for _, unicodeCodePoint := range codePoints {
-		codePoint, err := strconv.ParseUint(unicodeCodePoint, base, bitsize)
+		codePoint, err := strconv.ParseUint(strings.TrimPrefix(unicodeCodePoint, "U+"), base, bitsize)
 		if err != nil {
 			return nil, err
 		}
 
+		if !utf8.ValidRune(rune(codePoint)) {
+			return nil, fmt.Errorf("%w: %s", errInvalidCodePoint, unicodeCodePoint)
+		}
+
 		runes = append(runes, rune(codePoint))
 	}
 
 	return runes, nil
 }
 
-// Get the mapping between a rune and its confusable.
-func getDescriptionMapping(r rune, confusable *string) *Description {
-	if confusable == nil {
-		return nil
+// Parse the description of a mapping from the comment of its line in confusables.txt, e.g.
+// "( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A #". The names of the source and target follow the
+// parenthesized characters, which may themselves be parentheses.
+func parseDescription(comment string) Description {
+	comment = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(comment), "#"))
+
+	if strings.HasPrefix(comment, "(") {
+		i := strings.LastIndex(comment, ")")
+		comment = comment[i+1:]
 	}
 
-	rDesc := descriptions[string(r)]
-	if rDesc == "" {
-		nfd := norm.NFD.String(string(r))
-		parts := make([]string, 0, len(nfd))
+	from, to, ok := strings.Cut(comment, "→")
+	if !ok {
+		return Description{}
+	}
 
-		for _, c := range nfd {
-			cDesc := descriptions[string(c)]
-			if cDesc == "" {
-				return nil
-			}
+	return Description{From: strings.TrimSpace(from), To: strings.TrimSpace(to)}
+}
+
+// Check whether a token is numeric once its digit lookalikes have been substituted.
+func isNumericToken(s string, digits map[rune]rune) bool {
+	hasDigit := false
 
-			parts = append(parts, cDesc)
+	for _, r := range s {
+		if _, ok := digits[r]; ok {
+			continue
 		}
 
-		rDesc = strings.Join(parts, ", ")
+		if unicode.IsDigit(r) {
+			hasDigit = true
+		} else if unicode.IsLetter(r) {
+			return false
+		}
 	}
 
-	confusableDesc := descriptions[*confusable]
-	if confusableDesc == "" {
-		return nil
-	}
+	return hasDigit
+}
 
-	return &Description{
-		From: rDesc,
-		To:   confusableDesc,
+// Check whether a rune has a confusable mapping. ASCII runes are never considered to be confusable.
+func isConfusable(r rune) bool {
+	if r <= unicode.MaxASCII {
+		return false
 	}
+
+	_, ok := loadTables().confusables.lookup(r)
+
+	return ok
 }
 
 func isASCII(s string) bool {
+	// Check 8 bytes at a time, as in utf8.ValidString. The loads are combined by the compiler, and a non-ASCII byte is
+	// one with its high bit set.
+	for len(s) >= 8 {
+		first32 := uint32(s[0]) | uint32(s[1])<<8 | uint32(s[2])<<16 | uint32(s[3])<<24
+		second32 := uint32(s[4]) | uint32(s[5])<<8 | uint32(s[6])<<16 | uint32(s[7])<<24
+
+		if (first32|second32)&0x80808080 != 0 {
+			return false
+		}
+
+		s = s[8:]
+	}
+
 	for i := 0; i < len(s); i++ {
 		if s[i] > unicode.MaxASCII {
 			return false
@@ -337,13 +969,119 @@ func isASCII(s string) bool {
 	return true
 }
 
+// Substitute the runes of s found in substitutions, within the tokens for which context reports true or, if context is
+// nil, throughout s. If fn is not nil, it is called with the offset of each rune which is substituted along with its
+// substitute.
+func substituteRunes(s string, substitutions map[rune]rune, context func(token string) bool,
+	fn func(i int, r rune),
+) string {
+	var b strings.Builder
+
+	prev := 0
+
+	substitute := func(offset int, token string) {
+		for i, r := range token {
+			substitute, ok := substitutions[r]
+			if !ok {
+				continue
+			}
+
+			b.WriteString(s[prev : offset+i])
+			b.WriteRune(substitute)
+			prev = offset + i + utf8.RuneLen(r)
+
+			if fn != nil {
+				fn(offset+i, substitute)
+			}
+		}
+	}
+
+	if context == nil {
+		substitute(0, s)
+	} else {
+		for _, field := range fields(s) {
+			if token := s[field.start:field.end]; context(token) {
+				substitute(field.start, token)
+			}
+		}
+	}
+
+	b.WriteString(s[prev:])
+
+	return b.String()
+}
+
+// Replace each rune of s for which replacement reports true with the string it returns.
+func replaceRunes(s string, replacement func(r rune) (string, bool)) string {
+	i := strings.IndexFunc(s, func(r rune) bool {
+		_, ok := replacement(r)
+
+		return ok
+	})
+	if i < 0 {
+		return s
+	}
+
+	var b strings.Builder
+
+	b.Grow(len(s))
+	b.WriteString(s[:i])
+
+	for _, r := range s[i:] {
+		if v, ok := replacement(r); ok {
+			b.WriteString(v)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+// Get the Diff of a rune whose ASCII form, v at offset within the ASCII string, has digits substituted within it.
+func numberDiff(t *tables, diff Diff, v string, offset int, digits map[int]rune) Diff {
+	var b strings.Builder
+
+	substituted := false
+
+	for i, r := range v {
+		if digit, ok := digits[offset+i]; ok {
+			r = digit
+			substituted = true
+		}
+
+		b.WriteRune(r)
+	}
+
+	if !substituted {
+		return diff
+	}
+
+	source := diff.Sequence
+	if source == "" {
+		source = string(diff.Rune)
+	}
+
+	confusable := b.String()
+
+	// Digits are substituted for the letters they resemble, which only loosely look alike.
+	return Diff{
+		Confusable:  &confusable,
+		Description: t.description(source, &confusable),
+		Rune:        diff.Rune,
+		Sequence:    diff.Sequence,
+		Origin:      OriginOption,
+		Severity:    SeverityLoose,
+	}
+}
+
 func noDiff(s string) []Diff {
-	diff := make([]Diff, len(s))
+	diff := make([]Diff, 0, utf8.RuneCountInString(s))
 
-	for i, r := range s {
-		diff[i] = Diff{
+	for _, r := range s {
+		diff = append(diff, Diff{
 			Rune: r,
-		}
+		})
 	}
 
 	return diff
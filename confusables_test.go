@@ -1,6 +1,7 @@
 package confusables_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/eskriett/confusables"
@@ -15,6 +16,70 @@ func TestNew(t *testing.T) {
 	assert.IsType(t, &confusables.Confusables{}, c)
 }
 
+func TestContainsConfusable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s          string
+		confusable bool
+	}{
+		{"", false},
+		{"example", false},
+		{"tum", false},
+		{"newtòñ", false},
+		{"раураl", true},
+		{"pay ⍺", true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.confusable, confusables.ContainsConfusable(test.s), "ContainsConfusable(%q)", test.s)
+	}
+}
+
+func TestCountConfusables(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s     string
+		count int
+		ratio float64
+	}{
+		{"", 0, 0},
+		{"example", 0, 0},
+		{"раураl", 5, 5.0 / 6},
+		{"pay ⍺", 1, 0.2},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.count, confusables.CountConfusables(test.s), "CountConfusables(%q)", test.s)
+		assert.InDelta(t, test.ratio, confusables.ConfusableRatio(test.s), 1e-9, "ConfusableRatio(%q)", test.s)
+	}
+}
+
+func TestFirstConfusable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s      string
+		r      rune
+		offset int
+		ok     bool
+	}{
+		{"", 0, -1, false},
+		{"example", 0, -1, false},
+		{"pаypal", 'а', 1, true},
+		{"newtòñ ⍺", '⍺', 9, true},
+	}
+
+	for _, test := range tests {
+		r, offset, ok := confusables.FirstConfusable(test.s)
+
+		assert.Equal(t, test.r, r, "FirstConfusable(%q)", test.s)
+		assert.Equal(t, test.offset, offset, "FirstConfusable(%q)", test.s)
+		assert.Equal(t, test.ok, ok, "FirstConfusable(%q)", test.s)
+	}
+}
+
 func TestIsConfusable(t *testing.T) {
 	t.Parallel()
 
@@ -25,9 +90,9 @@ func TestIsConfusable(t *testing.T) {
 		{"", "", true},
 		{"", "testing", false},
 		{"Ａ", "Α", true},
-		{"example", "𝐞х⍺𝓂𝕡Іꬲ", true},
-		{"example", "𝐞х⍺𝓂𝕡І", false},
-		{"example", "𝐞х⍺𝓂𝕡Іe", true},
+		{"example", "ех⍺ⅿрІꬲ", true},
+		{"example", "ех⍺ⅿрІ", false},
+		{"example", "ех⍺ⅿрІe", true},
 	}
 
 	for i, d := range tests {
@@ -39,6 +104,52 @@ func TestIsConfusable(t *testing.T) {
 	}
 }
 
+func TestIsConfusableAny(t *testing.T) {
+	t.Parallel()
+
+	candidates := []string{"google", "example", "paypal"}
+
+	match, ok := confusables.IsConfusableAny("ех⍺ⅿрІꬲ", candidates)
+	assert.True(t, ok)
+	assert.Equal(t, "example", match)
+
+	match, ok = confusables.IsConfusableAny("раураl", candidates)
+	assert.True(t, ok)
+	assert.Equal(t, "paypal", match)
+
+	_, ok = confusables.IsConfusableAny("ех⍺ⅿрІ", candidates)
+	assert.False(t, ok)
+
+	_, ok = confusables.IsConfusableAny("example", nil)
+	assert.False(t, ok)
+}
+
+func TestAppendASCII(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		c *confusables.Confusables
+		s string
+	}{
+		{confusables.New(), ""},
+		{confusables.New(), "example"},
+		{confusables.New(), "𝐞х⍺𝓂𝕡Іꬲ"},
+		{confusables.New(), "newtòñ"},
+		{confusables.New(), "東京tokyo"},
+		{confusables.New(confusables.WithCaseFold()), "ЕХАМРLЕ"},
+		{confusables.New(confusables.WithStripInvisible()), "pay\u200bpal"},
+		{confusables.New(confusables.WithCache(1)), "exαmple"},
+	}
+
+	for _, test := range tests {
+		dst := []byte("prefix:")
+		assert.Equal(t, "prefix:"+test.c.ToASCII(test.s), string(test.c.AppendASCII(dst, test.s)),
+			"AppendASCII(%q)", test.s)
+	}
+
+	assert.Equal(t, "example", string(confusables.AppendASCII(nil, "exαmple")))
+}
+
 func TestToASCII(t *testing.T) {
 	t.Parallel()
 
@@ -57,15 +168,16 @@ func TestToASCII(t *testing.T) {
 		{"➊,➋,➌,➍,➎,➏,➐,➑,➒,➓", "1,2,3,4,5,6,7,8,9,10"},
 		{"⓪,①,②,③,④,⑤,⑥,⑦,⑧,⑨,⑩,⑪,⑫,⑬,⑭,⑮,⑯,⑰,⑱,⑲,⑳", "0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20"},
 		{"⑴,⑵,⑶,⑷,⑸,⑹,⑺,⑻,⑼,⑽,⑾,⑿,⒀,⒁,⒂,⒃,⒄,⒅,⒆,⒇", "1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20"},
-		{"🄀,⒈,⒉,⒊,⒋,⒌,⒍,⒎,⒏,⒐,⒑,⒒,⒓,⒔,⒕,⒖,⒗,⒘,⒙,⒚,⒛", "0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20"},
+		{"⒈,⒉,⒊,⒋,⒌,⒍,⒎,⒏,⒐,⒑,⒒,⒓,⒔,⒕,⒖,⒗,⒘,⒙,⒚,⒛", "1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20"},
 		{"⓿,⓫,⓬,⓭,⓮,⓯,⓰,⓱,⓲,⓳,⓴", "0,11,12,13,14,15,16,17,18,19,20"},
 		{"⓵,⓶,⓷,⓸,⓹,⓺,⓻,⓼,⓽,⓾", "1,2,3,4,5,6,7,8,9,10"},
-		{"𝟎,𝟏,𝟐,𝟑,𝟒,𝟓,𝟔,𝟕,𝟖,𝟗", "0,1,2,3,4,5,6,7,8,9"},
-		{"𝟘,𝟙,𝟚,𝟛,𝟜,𝟝,𝟞,𝟟,𝟠,𝟡", "0,1,2,3,4,5,6,7,8,9"},
-		{"𝟢,𝟣,𝟤,𝟥,𝟦,𝟧,𝟨,𝟩,𝟪,𝟫", "0,1,2,3,4,5,6,7,8,9"},
-		{"𝟬,𝟭,𝟮,𝟯,𝟰,𝟱,𝟲,𝟳,𝟴,𝟵", "0,1,2,3,4,5,6,7,8,9"},
-		{"𝟶,𝟷,𝟸,𝟹,𝟺,𝟻,𝟼,𝟽,𝟾,𝟿", "0,1,2,3,4,5,6,7,8,9"},
 		{"０,１,２,３,４,５,６,７,８,９", "0,1,2,3,4,5,6,7,8,9"},
+		{"東京 tokyo", "東京 tokyo"},
+		{"東京 tokyо", "東京 tokyo"},
+		{"x\xffy", "x\ufffdy"},
+		{"abcdefgа", "abcdefga"},
+		{"abcdefghа", "abcdefgha"},
+		{"abcdefghijklmnopqrstuvwxyzа", "abcdefghijklmnopqrstuvwxyza"},
 	}
 
 	// Allow custom mappings to be defined
@@ -77,6 +189,45 @@ func TestToASCII(t *testing.T) {
 	}
 }
 
+func TestWithCache(t *testing.T) {
+	t.Parallel()
+
+	for _, size := range []int{0, 1, 10} {
+		c := confusables.New(confusables.WithCache(size))
+
+		for i := 0; i < 2; i++ {
+			assert.Equal(t, "example", c.ToASCII("exαmple"))
+			assert.Equal(t, "exarnple", c.ToSkeleton("ех⍺ⅿрІꬲ"))
+			assert.Equal(t, "paypal", c.ToASCII("pаypal"))
+		}
+	}
+}
+
+func TestWithConfusablesOnly(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, expected string
+	}{
+		{"", ""},
+		{"example", "example"},
+		{"раураl", "paypal"},
+		{"x²y", "x²y"},
+		{"newtòñ", "newtòñ"},
+		{"а™\xff", "a™\xff"},
+	}
+
+	c := confusables.New(confusables.WithConfusablesOnly())
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, c.ToASCII(test.s), "ToASCII(%q)", test.s)
+		assert.Equal(t, test.expected, string(c.AppendASCII(nil, test.s)), "AppendASCII(%q)", test.s)
+
+		a, _ := c.ToASCIIWithIndex(test.s)
+		assert.Equal(t, test.expected, a, "ToASCIIWithIndex(%q)", test.s)
+	}
+}
+
 func TestToASCIIDiff(t *testing.T) {
 	t.Parallel()
 
@@ -97,7 +248,9 @@ func TestToASCIIDiff(t *testing.T) {
 					From: "LATIN SMALL LETTER O, COMBINING GRAVE ACCENT",
 					To:   "LATIN SMALL LETTER O",
 				},
-				Rune: 'ò',
+				Origin:   confusables.OriginOption,
+				Rune:     'ò',
+				Severity: confusables.SeverityLoose,
 			},
 			{
 				Confusable: strPtr("n"),
@@ -105,7 +258,9 @@ func TestToASCIIDiff(t *testing.T) {
 					From: "LATIN SMALL LETTER N, COMBINING TILDE",
 					To:   "LATIN SMALL LETTER N",
 				},
-				Rune: 'ñ',
+				Origin:   confusables.OriginOption,
+				Rune:     'ñ',
+				Severity: confusables.SeverityLoose,
 			},
 		}},
 		{"❶", "1", []confusables.Diff{
@@ -115,7 +270,22 @@ func TestToASCIIDiff(t *testing.T) {
 					From: "DINGBAT NEGATIVE CIRCLED DIGIT ONE",
 					To:   "DIGIT ONE",
 				},
-				Rune: '❶',
+				Origin:   confusables.OriginAmendments,
+				Rune:     '❶',
+				Severity: confusables.SeverityLoose,
+			},
+		}},
+		{"а", "a", []confusables.Diff{
+			{
+				Confusable: strPtr("a"),
+				Description: &confusables.Description{
+					From: "CYRILLIC SMALL LETTER A",
+					To:   "LATIN SMALL LETTER A",
+				},
+				Intentional: intentionalTables(),
+				Origin:      confusables.OriginUpstream,
+				Rune:        'а',
+				Severity:    intentionalSeverity(),
 			},
 		}},
 	}
@@ -128,6 +298,33 @@ func TestToASCIIDiff(t *testing.T) {
 	}
 }
 
+func TestToASCIIDiffFunc(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "example", "tòñ", "𝐞х⍺𝓂𝕡Іꬲ"} {
+		_, want := confusables.ToASCIIDiff(s)
+		diffs := []confusables.Diff{}
+
+		confusables.ToASCIIDiffFunc(s, func(diff confusables.Diff) bool {
+			diffs = append(diffs, diff)
+
+			return true
+		})
+
+		assert.Equal(t, want, diffs, "ToASCIIDiffFunc(%q)", s)
+	}
+
+	var runes []rune
+
+	confusables.ToASCIIDiffFunc("pаypal", func(diff confusables.Diff) bool {
+		runes = append(runes, diff.Rune)
+
+		return diff.Confusable == nil
+	})
+
+	assert.Equal(t, []rune{'p', 'а'}, runes)
+}
+
 func TestToNumber(t *testing.T) {
 	t.Parallel()
 
@@ -147,6 +344,219 @@ func TestToNumber(t *testing.T) {
 	}
 }
 
+func TestToNumberDigitsOnlyContext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		confusable, number string
+	}{
+		{"", ""},
+		{"foobar", "foobar"},
+		{"O12", "012"},
+		{"call 555-O1I2 now", "call 555-0112 now"},
+		{"l0l lol", "101 lol"},
+		{"𝘖l2 foo", "012 foo"},
+	}
+
+	c := confusables.New(confusables.DigitsOnlyContext())
+
+	for _, test := range tests {
+		assert.Equal(t, test.number, c.ToNumber(test.confusable))
+	}
+}
+
+func TestWithDigitSubstitutions(t *testing.T) {
+	t.Parallel()
+
+	substitutions := confusables.DefaultDigitSubstitutions()
+	substitutions['B'] = '8'
+	substitutions['S'] = '5'
+
+	tests := []struct {
+		opts               []confusables.Option
+		confusable, number string
+	}{
+		{nil, "BOSS 1O", "B0SS 10"},
+		{[]confusables.Option{confusables.WithDigitSubstitutions(substitutions)}, "BOSS 1O", "8055 10"},
+		{[]confusables.Option{confusables.WithDigitSubstitutions(map[rune]rune{'g': '9'})}, "gOl", "9Ol"},
+		{[]confusables.Option{confusables.WithDigitSubstitutions(substitutions), confusables.DigitsOnlyContext()},
+			"BOSS 5B5 Bob", "BOSS 585 Bob"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.number, confusables.New(test.opts...).ToNumber(test.confusable))
+	}
+
+	assert.NotContains(t, confusables.DefaultDigitSubstitutions(), 'B', "the defaults should not be modified")
+}
+
+func TestToDigits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, digits string
+	}{
+		{"", ""},
+		{"foobar", "00"},
+		{"ext. O7 l23", "07123"},
+		{"+44 (O) 2O7 946 OOOO", "4402079460000"},
+		{"𝟘𝟙𝟚", "012"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.digits, confusables.ToDigits(test.s), "ToDigits(%q)", test.s)
+	}
+
+	c := confusables.New(confusables.DigitsOnlyContext())
+	assert.Equal(t, "0123", c.ToDigits("call me on O123"))
+}
+
+func TestToNumberDiff(t *testing.T) {
+	t.Parallel()
+
+	zero, one := "0", "1"
+
+	tests := []struct {
+		confusable, number string
+		diff               []confusables.Diff
+	}{
+		{"", "", []confusables.Diff{}},
+		{"O1", "01", []confusables.Diff{
+			{
+				Confusable: &zero,
+				Description: &confusables.Description{
+					From: "LATIN CAPITAL LETTER O",
+					To:   "DIGIT ZERO",
+				},
+				Origin:   confusables.OriginOption,
+				Rune:     'O',
+				Severity: confusables.SeverityLoose,
+			},
+			{Rune: '1'},
+		}},
+		{"2Οl", "201", []confusables.Diff{
+			{Rune: '2'},
+			{
+				Confusable: &zero,
+				Description: &confusables.Description{
+					From: "GREEK CAPITAL LETTER OMICRON",
+					To:   "DIGIT ZERO",
+				},
+				Origin:   confusables.OriginOption,
+				Rune:     'Ο',
+				Severity: confusables.SeverityLoose,
+			},
+			{
+				Confusable: &one,
+				Description: &confusables.Description{
+					From: "LATIN SMALL LETTER L",
+					To:   "DIGIT ONE",
+				},
+				Origin:   confusables.OriginOption,
+				Rune:     'l',
+				Severity: confusables.SeverityLoose,
+			},
+		}},
+		{"½", "1⁄2", []confusables.Diff{{Rune: '½'}}},
+		{"™", "TM", []confusables.Diff{{Rune: '™'}}},
+		{"½O", "1⁄20", []confusables.Diff{
+			{Rune: '½'},
+			{
+				Confusable: &zero,
+				Description: &confusables.Description{
+					From: "LATIN CAPITAL LETTER O",
+					To:   "DIGIT ZERO",
+				},
+				Origin:   confusables.OriginOption,
+				Rune:     'O',
+				Severity: confusables.SeverityLoose,
+			},
+		}},
+	}
+
+	for _, test := range tests {
+		number, diff := confusables.ToNumberDiff(test.confusable)
+
+		assert.Equal(t, test.number, number)
+		assert.Equal(t, confusables.ToNumber(test.confusable), number)
+		assert.Equal(t, test.diff, diff)
+	}
+
+	number, diff := confusables.New(confusables.DigitsOnlyContext()).ToNumberDiff("lo O1")
+	assert.Equal(t, "lo 01", number)
+	assert.Nil(t, diff[0].Confusable)
+	assert.Equal(t, "0", *diff[3].Confusable)
+}
+
+func TestParseLine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line           string
+		source         rune
+		sourceSequence string
+		target         string
+	}{
+		{"0430 ;\t0061 ;\tMA\t# ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A\t#", 'а', "а", "a"},
+		{"0072 006E ;\t006D ;\tMA\t# ( rn → m ) LATIN SMALL LETTER R, LATIN SMALL LETTER N → LATIN SMALL LETTER M\t#",
+			'r', "rn", "m"},
+	}
+
+	for _, test := range tests {
+		entry, err := confusables.ParseLine(test.line)
+		if assert.NoError(t, err) {
+			assert.Equal(t, test.source, entry.Source)
+			assert.Equal(t, test.sourceSequence, entry.SourceSequence)
+			assert.Equal(t, test.target, entry.Target)
+		}
+	}
+
+	_, err := confusables.ParseLine("# comment")
+	assert.ErrorIs(t, err, confusables.ErrIgnoreLine)
+}
+
+func TestParseLineFormats(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		line        string
+		source      string
+		target      string
+		description confusables.Description
+	}{
+		{"0430 ; 0061 ; MA # ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A #", "а", "a",
+			confusables.Description{From: "CYRILLIC SMALL LETTER A", To: "LATIN SMALL LETTER A"}},
+		{"\uFEFF0430;0061;MA\t#\t( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A\r", "а", "a",
+			confusables.Description{From: "CYRILLIC SMALL LETTER A", To: "LATIN SMALL LETTER A"}},
+		{"207E ;\t0029 ;\tMA\t# ( ⁾ → ) ) SUPERSCRIPT RIGHT PARENTHESIS → RIGHT PARENTHESIS\t#", "⁾", ")",
+			confusables.Description{From: "SUPERSCRIPT RIGHT PARENTHESIS", To: "RIGHT PARENTHESIS"}},
+		{"  0430  ;  0061  ", "а", "a", confusables.Description{}},
+		{"U+0430 ;\tU+0061 ;\tMA", "а", "a", confusables.Description{}},
+	}
+
+	for _, test := range tests {
+		entry, err := confusables.ParseLine(test.line)
+		if assert.NoError(t, err, "ParseLine(%q)", test.line) {
+			assert.Equal(t, test.source, entry.SourceSequence, "ParseLine(%q)", test.line)
+			assert.Equal(t, test.target, entry.Target, "ParseLine(%q)", test.line)
+			assert.Equal(t, test.description, entry.Description, "ParseLine(%q)", test.line)
+		}
+	}
+
+	for _, line := range []string{"   ", "\t# confusables-15.1.0.txt", "# Version: 15.1.0", "\uFEFF# Date: 2023"} {
+		_, err := confusables.ParseLine(line)
+		assert.ErrorIs(t, err, confusables.ErrIgnoreLine, "ParseLine(%q)", line)
+	}
+
+	for _, line := range []string{"0430", "0430 ; ; MA", "0430 ; 0061x ; MA", "D800 ; 0061 ; MA", "0430 0061"} {
+		_, err := confusables.ParseLine(line)
+		assert.ErrorIs(t, err, confusables.ErrInvalidLine, "ParseLine(%q)", line)
+	}
+
+	err := confusables.NewSafe().LoadMappings(strings.NewReader("# Version: 15.1.0\n\n0430 ; 0061 ; MA\nbad\n"))
+	assert.ErrorContains(t, err, "line 4")
+}
+
 func TestToSkeleton(t *testing.T) {
 	t.Parallel()
 
@@ -155,7 +565,7 @@ func TestToSkeleton(t *testing.T) {
 	}{
 		{"", ""},
 		{"example", "exarnple"},
-		{"𝐞х⍺𝓂𝕡Іꬲ", "exarnple"},
+		{"ех⍺ⅿрІꬲ", "exarnple"},
 	}
 
 	for i, d := range tests {
@@ -167,6 +577,16 @@ func TestToSkeleton(t *testing.T) {
 	}
 }
 
+func TestAppendSkeleton(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "example", "𝐞х⍺𝓂𝕡Іꬲ", "newtòñ", "\xffa"} {
+		dst := []byte("prefix:")
+		assert.Equal(t, "prefix:"+confusables.ToSkeleton(s), string(confusables.AppendSkeleton(dst, s)),
+			"AppendSkeleton(%q)", s)
+	}
+}
+
 func TestToSkeletonDiff(t *testing.T) {
 	t.Parallel()
 
@@ -186,10 +606,30 @@ func TestToSkeletonDiff(t *testing.T) {
 						From: "LATIN SMALL LETTER M",
 						To:   "LATIN SMALL LETTER R, LATIN SMALL LETTER N",
 					},
-					Rune: 'm',
+					Origin:   confusables.OriginUpstream,
+					Rune:     'm',
+					Severity: confusables.SeverityNear,
 				},
 			},
 		},
+		{
+			"аò",
+			[]confusables.Diff{
+				{
+					Confusable: strPtr("a"),
+					Description: &confusables.Description{
+						From: "CYRILLIC SMALL LETTER A",
+						To:   "LATIN SMALL LETTER A",
+					},
+					Intentional: intentionalTables(),
+					Origin:      confusables.OriginUpstream,
+					Rune:        'а',
+					Severity:    intentionalSeverity(),
+				},
+				{Rune: 'o'},
+				{Rune: '\u0300'},
+			},
+		},
 	}
 
 	for _, d := range tests {
@@ -206,12 +646,78 @@ func BenchmarkToASCII(b *testing.B) {
 	})
 }
 
+func BenchmarkToASCIIUnchanged(b *testing.B) {
+	b.Run("ToASCII", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			confusables.ToASCII("東京 tokyo ひらがな")
+		}
+	})
+}
+
+func BenchmarkToASCIIDiff(b *testing.B) {
+	b.Run("ToASCIIDiff", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			confusables.ToASCIIDiff("раураl ехаmрlе")
+		}
+	})
+}
+
+func BenchmarkContainsConfusable(b *testing.B) {
+	b.Run("ASCII", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			confusables.ContainsConfusable("example")
+		}
+	})
+
+	long := strings.Repeat("example ", 128)
+
+	b.Run("LongASCII", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			confusables.ContainsConfusable(long)
+		}
+	})
+
+	b.Run("Confusable", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			confusables.ContainsConfusable("𝐞х⍺𝓂𝕡Іꬲ")
+		}
+	})
+}
+
+func BenchmarkWithCache(b *testing.B) {
+	c := confusables.New(confusables.WithCache(128))
+
+	b.Run("ToASCII", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			c.ToASCII("𝐞х⍺𝓂𝕡Іꬲ")
+		}
+	})
+}
+
 func BenchmarkToSkeleton(b *testing.B) {
 	b.Run("ToSkeleton", func(b *testing.B) {
 		for n := 0; n < b.N; n++ {
 			confusables.ToSkeleton("𝐞х⍺𝓂𝕡Іꬲ")
 		}
 	})
+
+	b.Run("BMP", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			confusables.ToSkeleton("раураl ехаmрlе")
+		}
+	})
+}
+
+func BenchmarkAppendSkeleton(b *testing.B) {
+	buf := make([]byte, 0, 64)
+
+	b.Run("AppendSkeleton", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for n := 0; n < b.N; n++ {
+			buf = confusables.AppendSkeleton(buf[:0], "𝐞х⍺𝓂𝕡Іꬲ")
+		}
+	})
 }
 
 func BenchmarkIsConfusable(b *testing.B) {
@@ -0,0 +1,309 @@
+// Package confusableshttp provides HTTP middleware which normalizes, or flags, confusable characters within selected
+// request fields before handlers run.
+package confusableshttp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/eskriett/confusables"
+)
+
+// defaultMaxBodySize is the size of the largest body which is normalized by default.
+const defaultMaxBodySize = 1 << 20
+
+type flaggedKey struct{}
+
+type config struct {
+	confusables *confusables.Confusables
+	flagHeader  string
+	flagOnly    bool
+	formFields  []string
+	jsonFields  [][]string
+	maxBodySize int64
+	queryFields []string
+}
+
+// Option configures Middleware.
+type Option func(*config)
+
+// WithConfusables sets the Confusables used to convert fields to ASCII, so that its options apply. By default, fields
+// are converted as confusables.ToASCII.
+func WithConfusables(c *confusables.Confusables) Option {
+	return func(cfg *config) {
+		cfg.confusables = c
+	}
+}
+
+// WithFlagHeader sets the request header named name to the comma separated names of the fields which contain
+// confusables, for handlers which read headers rather than the request context. Any value of the header sent by the
+// client is removed, so that it cannot be forged.
+func WithFlagHeader(name string) Option {
+	return func(cfg *config) {
+		cfg.flagHeader = name
+	}
+}
+
+// WithFlagOnly leaves fields as they are, only flagging those which contain confusables.
+func WithFlagOnly() Option {
+	return func(cfg *config) {
+		cfg.flagOnly = true
+	}
+}
+
+// WithFormFields selects fields of URL encoded form bodies by name.
+func WithFormFields(names ...string) Option {
+	return func(cfg *config) {
+		cfg.formFields = append(cfg.formFields, names...)
+	}
+}
+
+// WithJSONFields selects fields of JSON bodies by path, where a path is the keys of nested objects separated by dots,
+// e.g. "user.name". Where a path leads to an array, each string within it is selected.
+func WithJSONFields(paths ...string) Option {
+	return func(cfg *config) {
+		for _, path := range paths {
+			cfg.jsonFields = append(cfg.jsonFields, strings.Split(path, "."))
+		}
+	}
+}
+
+// WithMaxBodySize sets the size, in bytes, of the largest body which is normalized. Larger bodies are passed on
+// unchanged. The default is 1 MiB.
+func WithMaxBodySize(n int64) Option {
+	return func(cfg *config) {
+		cfg.maxBodySize = n
+	}
+}
+
+// WithQueryFields selects URL query parameters by name.
+func WithQueryFields(names ...string) Option {
+	return func(cfg *config) {
+		cfg.queryFields = append(cfg.queryFields, names...)
+	}
+}
+
+// Middleware returns middleware which converts the selected query, form and JSON fields of requests to ASCII, as
+// confusables.ToASCII, before calling the next handler. The names of the fields which contained confusables are
+// available to handlers through Flagged. Bodies which are rewritten are re-encoded, so JSON objects containing selected
+// fields have their keys sorted.
+func Middleware(opts ...Option) func(http.Handler) http.Handler {
+	cfg := &config{
+		confusables: confusables.New(),
+		maxBodySize: defaultMaxBodySize,
+	}
+
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var flagged []string
+
+			if cfg.flagHeader != "" {
+				r.Header.Del(cfg.flagHeader)
+			}
+
+			flagged = cfg.normalizeQuery(r, flagged)
+			flagged = cfg.normalizeBody(r, flagged)
+
+			if len(flagged) > 0 {
+				r = r.WithContext(context.WithValue(r.Context(), flaggedKey{}, flagged))
+
+				if cfg.flagHeader != "" {
+					r.Header.Set(cfg.flagHeader, strings.Join(flagged, ","))
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Flagged returns the names of the request fields which contained confusables, as recorded by Middleware in the
+// request's context. JSON fields are named by their path.
+func Flagged(ctx context.Context) []string {
+	flagged, _ := ctx.Value(flaggedKey{}).([]string)
+
+	return flagged
+}
+
+// Normalize the values of a set of fields, returning flagged extended with the names of those which changed.
+func (cfg *config) normalizeValues(values url.Values, names, flagged []string) ([]string, bool) {
+	changed := false
+
+	for _, name := range names {
+		for i, v := range values[name] {
+			a := cfg.confusables.ToASCII(v)
+			if a == v {
+				continue
+			}
+
+			flagged = appendName(flagged, name)
+
+			if !cfg.flagOnly {
+				values[name][i] = a
+				changed = true
+			}
+		}
+	}
+
+	return flagged, changed
+}
+
+func (cfg *config) normalizeQuery(r *http.Request, flagged []string) []string {
+	if len(cfg.queryFields) == 0 || r.URL.RawQuery == "" {
+		return flagged
+	}
+
+	query := r.URL.Query()
+
+	flagged, changed := cfg.normalizeValues(query, cfg.queryFields, flagged)
+	if changed {
+		r.URL.RawQuery = query.Encode()
+	}
+
+	return flagged
+}
+
+func (cfg *config) normalizeBody(r *http.Request, flagged []string) []string {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+
+	var normalize func(body []byte, flagged []string) ([]byte, []string)
+
+	switch {
+	case mediaType == "application/x-www-form-urlencoded" && len(cfg.formFields) > 0:
+		normalize = cfg.normalizeForm
+	case (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")) && len(cfg.jsonFields) > 0:
+		normalize = cfg.normalizeJSON
+	default:
+		return flagged
+	}
+
+	if r.Body == nil || r.Body == http.NoBody {
+		return flagged
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, cfg.maxBodySize+1))
+	if err != nil || int64(len(body)) > cfg.maxBodySize {
+		// The body is passed on as it was, including whatever was not read.
+		r.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+
+		return flagged
+	}
+
+	normalized, flagged := normalize(body, flagged)
+	if normalized == nil {
+		normalized = body
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(normalized))
+	r.ContentLength = int64(len(normalized))
+	r.Header.Set("Content-Length", strconv.Itoa(len(normalized)))
+
+	return flagged
+}
+
+// Normalize a URL encoded form body, returning nil if it is unchanged.
+func (cfg *config) normalizeForm(body []byte, flagged []string) ([]byte, []string) {
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return nil, flagged
+	}
+
+	flagged, changed := cfg.normalizeValues(form, cfg.formFields, flagged)
+	if !changed {
+		return nil, flagged
+	}
+
+	return []byte(form.Encode()), flagged
+}
+
+// Normalize a JSON body, returning nil if it is unchanged.
+func (cfg *config) normalizeJSON(body []byte, flagged []string) ([]byte, []string) {
+	var v any
+
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+
+	if err := d.Decode(&v); err != nil {
+		return nil, flagged
+	}
+
+	changed := false
+
+	for _, path := range cfg.jsonFields {
+		name := strings.Join(path, ".")
+
+		v = normalizeJSONValue(v, path, func(s string) string {
+			a := cfg.confusables.ToASCII(s)
+			if a == s {
+				return s
+			}
+
+			flagged = appendName(flagged, name)
+
+			if cfg.flagOnly {
+				return s
+			}
+
+			changed = true
+
+			return a
+		})
+	}
+
+	if !changed {
+		return nil, flagged
+	}
+
+	normalized, err := json.Marshal(v)
+	if err != nil {
+		return nil, flagged
+	}
+
+	return normalized, flagged
+}
+
+// Apply fn to the strings at path within v, returning the updated value.
+func normalizeJSONValue(v any, path []string, fn func(string) string) any {
+	switch v := v.(type) {
+	case []any:
+		for i := range v {
+			v[i] = normalizeJSONValue(v[i], path, fn)
+		}
+	case map[string]any:
+		if len(path) > 0 {
+			if field, ok := v[path[0]]; ok {
+				v[path[0]] = normalizeJSONValue(field, path[1:], fn)
+			}
+		}
+	case string:
+		if len(path) == 0 {
+			return fn(v)
+		}
+	}
+
+	return v
+}
+
+// Append name to names if it is not already present.
+func appendName(names []string, name string) []string {
+	if slices.Contains(names, name) {
+		return names
+	}
+
+	return append(names, name)
+}
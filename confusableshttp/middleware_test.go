@@ -0,0 +1,126 @@
+package confusableshttp_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/eskriett/confusables/confusableshttp"
+	"github.com/stretchr/testify/assert"
+)
+
+// serve passes req through middleware built from opts, returning the request seen by the handler and its body.
+func serve(t *testing.T, req *http.Request, opts ...confusableshttp.Option) (*http.Request, string) {
+	t.Helper()
+
+	var (
+		got  *http.Request
+		body string
+	)
+
+	handler := confusableshttp.Middleware(opts...)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		b, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		got, body = r, string(b)
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	return got, body
+}
+
+func TestMiddlewareQuery(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodGet, "/?user=p%D0%B0ypal&other=p%D0%B0ypal", nil)
+
+	got, _ := serve(t, req, confusableshttp.WithQueryFields("user"))
+	assert.Equal(t, "paypal", got.URL.Query().Get("user"))
+	assert.Equal(t, "pаypal", got.URL.Query().Get("other"))
+	assert.Equal(t, []string{"user"}, confusableshttp.Flagged(got.Context()))
+
+	req = httptest.NewRequest(http.MethodGet, "/?user=paypal", nil)
+
+	got, _ = serve(t, req, confusableshttp.WithQueryFields("user"))
+	assert.Empty(t, confusableshttp.Flagged(got.Context()))
+}
+
+func TestMiddlewareForm(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("name=%D0%90lice&note=%D0%90"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	got, body := serve(t, req, confusableshttp.WithFormFields("name"))
+
+	form, err := url.ParseQuery(body)
+	assert.NoError(t, err)
+	assert.Equal(t, "Alice", form.Get("name"))
+	assert.Equal(t, "А", form.Get("note"))
+	assert.Equal(t, []string{"name"}, confusableshttp.Flagged(got.Context()))
+}
+
+func TestMiddlewareJSON(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/",
+		strings.NewReader(`{"user":{"name":"Аlice","tags":["аdmin","ok"]},"id":12345678901234567890,"bio":"Аlice"}`))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	got, body := serve(t, req, confusableshttp.WithJSONFields("user.name", "user.tags", "missing.field"))
+	assert.JSONEq(t, `{"user":{"name":"Alice","tags":["admin","ok"]},"id":12345678901234567890,"bio":"Аlice"}`, body)
+	assert.Equal(t, int64(len(body)), got.ContentLength)
+	assert.Equal(t, []string{"user.name", "user.tags"}, confusableshttp.Flagged(got.Context()))
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":`))
+	req.Header.Set("Content-Type", "application/json")
+
+	_, body = serve(t, req, confusableshttp.WithJSONFields("name"))
+	assert.Equal(t, `{"name":`, body, "invalid JSON should be passed on unchanged")
+}
+
+func TestMiddlewareFlagOnly(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"Аlice"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	got, body := serve(t, req,
+		confusableshttp.WithJSONFields("name"),
+		confusableshttp.WithFlagOnly(),
+		confusableshttp.WithFlagHeader("X-Confusable-Fields"),
+	)
+	assert.Equal(t, `{"name":"Аlice"}`, body)
+	assert.Equal(t, "name", got.Header.Get("X-Confusable-Fields"))
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"Alice"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Confusable-Fields", "name")
+
+	got, _ = serve(t, req, confusableshttp.WithJSONFields("name"), confusableshttp.WithFlagHeader("X-Confusable-Fields"))
+	assert.Empty(t, got.Header.Values("X-Confusable-Fields"), "the header sent by the client should be removed")
+}
+
+func TestMiddlewareOptions(t *testing.T) {
+	t.Parallel()
+
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"ÀLICE"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	_, body := serve(t, req,
+		confusableshttp.WithJSONFields("name"),
+		confusableshttp.WithConfusables(confusables.New(confusables.WithCaseFold())),
+	)
+	assert.Equal(t, `{"name":"alice"}`, body)
+
+	req = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"name":"Аlice"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	_, body = serve(t, req, confusableshttp.WithJSONFields("name"), confusableshttp.WithMaxBodySize(4))
+	assert.Equal(t, `{"name":"Аlice"}`, body, "large bodies should be passed on unchanged")
+}
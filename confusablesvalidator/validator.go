@@ -0,0 +1,78 @@
+// Package confusablesvalidator provides validation tags for github.com/go-playground/validator which reject strings
+// containing confusable characters.
+package confusablesvalidator
+
+import (
+	"reflect"
+
+	"github.com/eskriett/confusables"
+	"github.com/go-playground/validator/v10"
+)
+
+// Validation tags registered by RegisterValidations.
+const (
+	// TagASCIIConfusableSafe rejects strings which are not ASCII but are converted to ASCII by confusables.ToASCII,
+	// i.e. strings which could pass as ASCII, such as "раураl" written in Cyrillic.
+	TagASCIIConfusableSafe = "asciiconfusablesafe"
+	// TagNoConfusables rejects strings containing any rune with a confusable mapping, as confusables.ContainsConfusable.
+	TagNoConfusables = "noconfusables"
+	// TagSingleScript rejects strings which mix scripts, i.e. whose resolved script set is empty, such as "pаypal"
+	// where the "а" is Cyrillic.
+	TagSingleScript = "singlescript"
+)
+
+var validations = map[string]validator.Func{
+	TagASCIIConfusableSafe: stringValidation(isASCIIConfusableSafe),
+	TagNoConfusables: stringValidation(func(s string) bool {
+		return !confusables.ContainsConfusable(s)
+	}),
+	TagSingleScript: stringValidation(func(s string) bool {
+		return !confusables.ResolvedScriptSet(s).IsEmpty()
+	}),
+}
+
+// RegisterValidations registers the validation tags of the package with v, e.g.
+//
+//	type User struct {
+//		Name string `validate:"required,singlescript"`
+//	}
+//
+// The tags apply to string fields, and to strings within slices and maps with dive. Fields of other kinds fail
+// validation.
+func RegisterValidations(v *validator.Validate) error {
+	for tag, fn := range validations {
+		if err := v.RegisterValidation(tag, fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create a validator.Func which applies valid to string fields.
+func stringValidation(valid func(s string) bool) validator.Func {
+	return func(fl validator.FieldLevel) bool {
+		field := fl.Field()
+		if field.Kind() != reflect.String {
+			return false
+		}
+
+		return valid(field.String())
+	}
+}
+
+// Check whether s is ASCII or, if not, is not converted to ASCII by confusables.ToASCII.
+func isASCIIConfusableSafe(s string) bool {
+	a := confusables.ToASCII(s)
+	if a == s {
+		return true
+	}
+
+	for i := 0; i < len(a); i++ {
+		if a[i] >= 0x80 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,49 @@
+package confusablesvalidator_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables/confusablesvalidator"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterValidations(t *testing.T) {
+	t.Parallel()
+
+	v := validator.New()
+	assert.NoError(t, confusablesvalidator.RegisterValidations(v))
+
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{confusablesvalidator.TagNoConfusables, "paypal", true},
+		{confusablesvalidator.TagNoConfusables, "pаypal", false},
+		{confusablesvalidator.TagNoConfusables, "東京", true},
+		{confusablesvalidator.TagSingleScript, "paypal", true},
+		{confusablesvalidator.TagSingleScript, "привет", true},
+		{confusablesvalidator.TagSingleScript, "pаypal", false},
+		{confusablesvalidator.TagASCIIConfusableSafe, "paypal", true},
+		{confusablesvalidator.TagASCIIConfusableSafe, "привет", true},
+		{confusablesvalidator.TagASCIIConfusableSafe, "раура", false},
+		{confusablesvalidator.TagASCIIConfusableSafe, "pаypal", false},
+	}
+
+	for _, test := range tests {
+		err := v.Var(test.value, test.tag)
+		assert.Equal(t, test.valid, err == nil, "%s(%q): %v", test.tag, test.value, err)
+	}
+
+	type user struct {
+		Name    string   `validate:"required,singlescript"`
+		Aliases []string `validate:"dive,noconfusables"`
+		Age     int      `validate:"omitempty,noconfusables"`
+	}
+
+	assert.NoError(t, v.Struct(user{Name: "alice", Aliases: []string{"al"}}))
+	assert.Error(t, v.Struct(user{Name: "аlice"}))
+	assert.Error(t, v.Struct(user{Name: "alice", Aliases: []string{"аl"}}))
+	assert.Error(t, v.Struct(user{Name: "alice", Age: 30}), "non-string fields should fail")
+}
@@ -0,0 +1,17 @@
+package confusables
+
+// DescriptionOf returns the name of a rune, such as "CYRILLIC SMALL LETTER A" for 'а', as given by the descriptions
+// of the package's mappings, including any added at runtime. Only the runes of the mappings are described, so ok is
+// false for others.
+func DescriptionOf(r rune) (string, bool) {
+	return loadTables().descriptionOf(r)
+}
+
+// DescriptionOf returns the name of a rune as given by the descriptions of the instance's mappings, as DescriptionOf.
+func (c *Confusables) DescriptionOf(r rune) (string, bool) {
+	return c.tables.load().descriptionOf(r)
+}
+
+func (t *tables) descriptionOf(r rune) (string, bool) {
+	return t.descriptions.rune(r)
+}
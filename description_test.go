@@ -0,0 +1,78 @@
+package confusables_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescriptionOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r    rune
+		desc string
+		ok   bool
+	}{
+		{'а', "CYRILLIC SMALL LETTER A", true},
+		{'a', "LATIN SMALL LETTER A", true},
+		{'⍺', "APL FUNCTIONAL SYMBOL ALPHA", true},
+		{'東', "", false},
+	}
+
+	for _, test := range tests {
+		desc, ok := confusables.DescriptionOf(test.r)
+
+		assert.Equal(t, test.desc, desc, "DescriptionOf(%q)", test.r)
+		assert.Equal(t, test.ok, ok, "DescriptionOf(%q)", test.r)
+	}
+
+	s := confusables.NewSafe()
+	s.AddMappingWithDesc('ꙛ', "x", "CYRILLIC SMALL LETTER REVERSED TSE", "LATIN SMALL LETTER X")
+
+	desc, ok := s.DescriptionOf('ꙛ')
+	assert.True(t, ok)
+	assert.Equal(t, "CYRILLIC SMALL LETTER REVERSED TSE", desc)
+}
+
+func TestSequenceDescriptions(t *testing.T) {
+	t.Parallel()
+
+	s := confusables.NewSafe()
+	assert.NoError(t, s.LoadMappings(strings.NewReader(
+		"0065 0301 ;\t0078 ;\tMA\t# ( e\u0301 → x ) CUSTOM E ACUTE → LATIN SMALL LETTER X\t#\n")))
+
+	// The sequence is described whether it is composed or decomposed.
+	for _, str := range []string{"e\u0301", "\u00e9"} {
+		_, diffs := s.ToASCIIDiff(str)
+		if assert.NotNil(t, diffs[0].Description, "ToASCIIDiff(%q)", str) {
+			assert.Equal(t, "CUSTOM E ACUTE", diffs[0].Description.From, "ToASCIIDiff(%q)", str)
+		}
+	}
+
+	_, diffs := confusables.ToASCIIDiff("\u00f2")
+	assert.Equal(t, "LATIN SMALL LETTER O, COMBINING GRAVE ACCENT", diffs[0].Description.From)
+}
+
+func TestDescriptionFallback(t *testing.T) {
+	t.Parallel()
+
+	s := confusables.NewSafe()
+	s.AddMapping('\uE000', "a")
+	s.AddSequenceMapping("\uE001\u0301", "b")
+
+	tests := []struct {
+		s    string
+		desc confusables.Description
+	}{
+		{"\uE000", confusables.Description{From: "U+E000", To: "LATIN SMALL LETTER A"}},
+		{"\uE001\u0301", confusables.Description{From: "U+E001, COMBINING ACUTE ACCENT", To: "LATIN SMALL LETTER B"}},
+	}
+
+	for _, test := range tests {
+		_, diffs := s.ToASCIIDiff(test.s)
+		assert.Equal(t, &test.desc, diffs[0].Description, "ToASCIIDiff(%q)", test.s)
+	}
+}
@@ -0,0 +1,86 @@
+package confusables
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// acePrefix is the prefix of labels in the ASCII Compatible Encoding, i.e. punycode.
+const acePrefix = "xn--"
+
+// ErrInvalidDomain is returned when a domain name has a label which cannot be decoded.
+var ErrInvalidDomain = errors.New("invalid domain name")
+
+func init() {
+	registerProfile("domain")
+}
+
+// DomainLabels returns the labels of a domain name in Unicode, decoding those in the ASCII Compatible Encoding, e.g.
+// "xn--pypal-4ve.com" becomes "pаypal" and "com". As in IDNA, the ideographic and fullwidth full stops also separate
+// labels, and a trailing full stop, denoting the root, is ignored.
+func DomainLabels(domain string) ([]string, error) {
+	labels := strings.FieldsFunc(domain, isLabelSeparator)
+
+	for i, label := range labels {
+		// Labels are case insensitive, but are only decoded in lower case.
+		lower := strings.ToLower(label)
+		if !strings.HasPrefix(lower, acePrefix) {
+			continue
+		}
+
+		decoded, err := idna.Punycode.ToUnicode(lower)
+		if err != nil {
+			return nil, fmt.Errorf("%w: label %q: %w", ErrInvalidDomain, label, err)
+		}
+
+		labels[i] = decoded
+	}
+
+	return labels, nil
+}
+
+// DomainSkeleton returns the skeleton of a domain name, converting each of its labels, as returned by DomainLabels,
+// with ToSkeletonCF as domain names are case insensitive. Comparing the skeletons of domains, rather than their
+// punycode, finds domains which look alike once displayed, e.g. "paypal.com" and "xn--pypal-4ve.com".
+func DomainSkeleton(domain string) (string, error) {
+	labels, err := DomainLabels(domain)
+	if err != nil {
+		return "", err
+	}
+
+	return domainSkeleton(labels), nil
+}
+
+// IsConfusableDomain checks if two domain names are confusable with one another, i.e. their DomainSkeletons are the
+// same. Domains which cannot be decoded are not confusable.
+func IsConfusableDomain(d1, d2 string) bool {
+	s1, err := DomainSkeleton(d1)
+	if err != nil {
+		return false
+	}
+
+	s2, err := DomainSkeleton(d2)
+	if err != nil {
+		return false
+	}
+
+	return s1 == s2
+}
+
+// Get the skeleton of a domain name from its decoded labels.
+func domainSkeleton(labels []string) string {
+	skeletons := make([]string, len(labels))
+	for i, label := range labels {
+		skeletons[i] = ToSkeletonCF(label)
+	}
+
+	return strings.Join(skeletons, ".")
+}
+
+// Check whether a rune separates the labels of a domain name.
+func isLabelSeparator(r rune) bool {
+	return r == '.' || r == '。' || r == '．' || r == '｡'
+}
@@ -0,0 +1,51 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDomainLabels(t *testing.T) {
+	t.Parallel()
+
+	labels, err := confusables.DomainLabels("www.XN--pypal-4ve.com.")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"www", "pаypal", "com"}, labels)
+
+	labels, err = confusables.DomainLabels("example。ｃｏｍ")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"example", "ｃｏｍ"}, labels)
+
+	_, err = confusables.DomainLabels("xn--a-ecp.xn--@@")
+	assert.ErrorIs(t, err, confusables.ErrInvalidDomain)
+}
+
+func TestIsConfusableDomain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		d1, d2     string
+		confusable bool
+	}{
+		{"paypal.com", "paypal.com", true},
+		{"paypal.com", "PayPal.COM", true},
+		{"paypal.com", "xn--pypal-4ve.com", true},
+		{"paypal.com", "xn--l-7sba6dbr.com", true},
+		{"example.com", "ехаmрle.com", true},
+		{"paypal.com", "pаypal。com", true},
+		{"paypal.com", "paypal.net", false},
+		{"buecher.de", "xn--bcher-kva.de", false},
+		{"paypal.com", "xn--@@.com", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.confusable, confusables.IsConfusableDomain(test.d1, test.d2),
+			"IsConfusableDomain(%q, %q)", test.d1, test.d2)
+	}
+
+	skeleton, err := confusables.DomainSkeleton("XN--pypal-4ve.com")
+	assert.NoError(t, err)
+	assert.Equal(t, confusables.ToSkeleton("paypal.com"), skeleton)
+}
@@ -0,0 +1,120 @@
+package confusables
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidEmail is returned when an email address has no "@" separating its local part from its domain.
+var ErrInvalidEmail = errors.New("invalid email address")
+
+// EmailAddressReport details the issues found within each part of an email address.
+type EmailAddressReport struct {
+	Domain EmailPartReport
+	Local  EmailPartReport
+}
+
+// EmailPartReport details the issues found within the local part or domain of an email address.
+type EmailPartReport struct {
+	// BidiControls lists the bidirectional formatting characters within the part.
+	BidiControls []rune
+	// Invisibles details the default ignorable code points within the part, as FindInvisibles.
+	Invisibles []Diff
+	// Mixed reports whether the part mixes scripts. The labels of a domain are considered separately, so that a
+	// domain such as "пример.com" does not mix scripts.
+	Mixed bool
+	// Scripts lists the scripts used by the part, as Scripts.
+	Scripts []string
+	// Skeleton is the skeleton the part is compared by.
+	Skeleton string
+	// Text is the part, with the labels of a domain decoded to Unicode.
+	Text string
+}
+
+// EmailReport splits an email address into its local part and domain, at its last "@", and reports the scripts,
+// invisible characters and bidirectional controls within each. The domain is decoded from punycode, as DomainLabels.
+func EmailReport(e string) (EmailAddressReport, error) {
+	local, domain, ok := splitEmail(e)
+	if !ok {
+		return EmailAddressReport{}, ErrInvalidEmail
+	}
+
+	labels, err := DomainLabels(domain)
+	if err != nil {
+		return EmailAddressReport{}, err
+	}
+
+	report := EmailAddressReport{
+		Domain: newEmailPartReport(strings.Join(labels, ".")),
+		Local:  newEmailPartReport(local),
+	}
+
+	// Labels are compared separately, and so may each use a different script.
+	report.Domain.Mixed = slices.ContainsFunc(labels, func(label string) bool {
+		return ResolvedScriptSet(label).IsEmpty()
+	})
+	report.Domain.Skeleton = domainSkeleton(labels)
+
+	return report, nil
+}
+
+// IsConfusableEmail checks if two email addresses are confusable with one another. Their local parts are compared by
+// ToSkeletonCF, as most mail providers ignore case, and their domains by DomainSkeleton. Addresses which cannot be
+// parsed are not confusable.
+func IsConfusableEmail(e1, e2 string) bool {
+	s1, ok := emailSkeleton(e1)
+	if !ok {
+		return false
+	}
+
+	s2, ok := emailSkeleton(e2)
+
+	return ok && s1 == s2
+}
+
+// Suspicious reports whether either part of the address mixes scripts or contains invisible characters or
+// bidirectional controls.
+func (r EmailAddressReport) Suspicious() bool {
+	return r.Local.suspicious() || r.Domain.suspicious()
+}
+
+func newEmailPartReport(s string) EmailPartReport {
+	return EmailPartReport{
+		BidiControls: bidiControls(s),
+		Invisibles:   FindInvisibles(s),
+		Mixed:        ResolvedScriptSet(s).IsEmpty(),
+		Scripts:      Scripts(s),
+		Skeleton:     ToSkeletonCF(s),
+		Text:         s,
+	}
+}
+
+func (r EmailPartReport) suspicious() bool {
+	return r.Mixed || len(r.Invisibles) > 0 || len(r.BidiControls) > 0
+}
+
+// Get the skeleton of an email address, as compared by IsConfusableEmail.
+func emailSkeleton(e string) (string, bool) {
+	local, domain, ok := splitEmail(e)
+	if !ok {
+		return "", false
+	}
+
+	skeleton, err := DomainSkeleton(domain)
+	if err != nil {
+		return "", false
+	}
+
+	return ToSkeletonCF(local) + "@" + skeleton, true
+}
+
+// Split an email address at its last "@", as the local part may contain a quoted "@".
+func splitEmail(e string) (local, domain string, ok bool) {
+	i := strings.LastIndexByte(e, '@')
+	if i < 0 {
+		return "", "", false
+	}
+
+	return e[:i], e[i+1:], true
+}
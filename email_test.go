@@ -0,0 +1,59 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsConfusableEmail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		e1, e2     string
+		confusable bool
+	}{
+		{"alice@example.com", "alice@example.com", true},
+		{"alice@example.com", "Alice@EXAMPLE.com", true},
+		{"alice@example.com", "аlice@example.com", true},
+		{"alice@example.com", "alice@ехаmрle.com", true},
+		{"alice@paypal.com", "alice@xn--pypal-4ve.com", true},
+		{"al\u200bice@example.com", "alice@example.com", true},
+		{"alice@example.com", "bob@example.com", false},
+		{"alice@example.com", "alice@example.net", false},
+		{"alice", "alice", false},
+		{"alice@xn--ab!.com", "alice@xn--ab!.com", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.confusable, confusables.IsConfusableEmail(test.e1, test.e2),
+			"IsConfusableEmail(%q, %q)", test.e1, test.e2)
+	}
+}
+
+func TestEmailReport(t *testing.T) {
+	t.Parallel()
+
+	report, err := confusables.EmailReport("alice@example.com")
+	assert.NoError(t, err)
+	assert.False(t, report.Suspicious())
+	assert.Equal(t, "alice", report.Local.Text)
+	assert.Equal(t, []string{"Latin"}, report.Domain.Scripts)
+
+	report, err = confusables.EmailReport("pаypal\u200b@пример.xn--pypal-4ve.com")
+	assert.NoError(t, err)
+	assert.True(t, report.Suspicious())
+	assert.True(t, report.Local.Mixed)
+	assert.Len(t, report.Local.Invisibles, 1)
+	assert.Equal(t, "пример.pаypal.com", report.Domain.Text)
+	assert.Equal(t, []string{"Cyrillic", "Latin"}, report.Domain.Scripts)
+	assert.True(t, report.Domain.Mixed, "the second label mixes scripts")
+
+	report, err = confusables.EmailReport("alice@пример.com")
+	assert.NoError(t, err)
+	assert.False(t, report.Domain.Mixed, "labels may each use a different script")
+
+	_, err = confusables.EmailReport("alice")
+	assert.ErrorIs(t, err, confusables.ErrInvalidEmail)
+}
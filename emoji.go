@@ -0,0 +1,192 @@
+package confusables
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func init() {
+	registerTable("emoji")
+}
+
+const (
+	zeroWidthJoiner     = '\u200d'
+	combiningKeycap     = '\u20e3'
+	variationSelector15 = '\ufe0e'
+	variationSelector16 = '\ufe0f'
+	regionalIndicatorA  = '\U0001f1e6'
+	regionalIndicatorZ  = '\U0001f1ff'
+	negativeCircledA    = '\U0001f150'
+	negativeCircledZ    = '\U0001f169'
+	negativeSquaredA    = '\U0001f170'
+	negativeSquaredZ    = '\U0001f189'
+	emojiModifierFirst  = '\U0001f3fb'
+	emojiModifierLast   = '\U0001f3ff'
+	emojiTagFirst       = '\U000e0020'
+	emojiTagLast        = '\U000e007f'
+)
+
+// emoji approximates the Extended_Pictographic property, which is not known to the unicode package, along with the
+// letter-like emoji of the Enclosed Alphanumeric Supplement.
+var emoji = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x203C, Hi: 0x203C, Stride: 1},
+		{Lo: 0x2049, Hi: 0x2049, Stride: 1},
+		{Lo: 0x231A, Hi: 0x231B, Stride: 1},
+		{Lo: 0x2328, Hi: 0x2328, Stride: 1},
+		{Lo: 0x23CF, Hi: 0x23CF, Stride: 1},
+		{Lo: 0x23E9, Hi: 0x23F3, Stride: 1},
+		{Lo: 0x23F8, Hi: 0x23FA, Stride: 1},
+		{Lo: 0x25AA, Hi: 0x25AB, Stride: 1},
+		{Lo: 0x25B6, Hi: 0x25B6, Stride: 1},
+		{Lo: 0x25C0, Hi: 0x25C0, Stride: 1},
+		{Lo: 0x25FB, Hi: 0x25FE, Stride: 1},
+		{Lo: 0x2600, Hi: 0x27BF, Stride: 1},
+		{Lo: 0x2934, Hi: 0x2935, Stride: 1},
+		{Lo: 0x2B05, Hi: 0x2B07, Stride: 1},
+		{Lo: 0x2B1B, Hi: 0x2B1C, Stride: 1},
+		{Lo: 0x2B50, Hi: 0x2B50, Stride: 1},
+		{Lo: 0x2B55, Hi: 0x2B55, Stride: 1},
+		{Lo: 0x3030, Hi: 0x3030, Stride: 1},
+		{Lo: 0x303D, Hi: 0x303D, Stride: 1},
+		{Lo: 0x3297, Hi: 0x3297, Stride: 1},
+		{Lo: 0x3299, Hi: 0x3299, Stride: 1},
+	},
+	R32: []unicode.Range32{
+		{Lo: 0x1F000, Hi: 0x1F0FF, Stride: 1},
+		{Lo: 0x1F150, Hi: 0x1F169, Stride: 1},
+		{Lo: 0x1F170, Hi: 0x1F1FF, Stride: 1},
+		{Lo: 0x1F200, Hi: 0x1F6FF, Stride: 1},
+		{Lo: 0x1F780, Hi: 0x1FAFF, Stride: 1},
+	},
+}
+
+// emojiWords holds the letters of the squared words, such as "🆗", which are emoji.
+var emojiWords = map[rune]string{
+	'🆎': "AB",
+	'🆏': "WC",
+	'🆐': "DJ",
+	'🆑': "CL",
+	'🆒': "COOL",
+	'🆓': "FREE",
+	'🆔': "ID",
+	'🆕': "NEW",
+	'🆖': "NG",
+	'🆗': "OK",
+	'🆘': "SOS",
+	'🆙': "UP!",
+	'🆚': "VS",
+}
+
+// Find the emoji which s starts with, if it is replaced under the configured emoji policy, returning it along with its
+// replacement.
+func (c *Confusables) matchEmoji(s string) (source, v string, ok bool) {
+	if !c.emojiLetters && !c.replaceEmoji {
+		return "", "", false
+	}
+
+	n := emojiLen(s)
+	if n == 0 {
+		return "", "", false
+	}
+
+	if c.emojiLetters {
+		if letters, ok := emojiLetters(s[:n]); ok {
+			return s[:n], letters, true
+		}
+	}
+
+	if c.replaceEmoji {
+		return s[:n], c.emojiPlaceholder, true
+	}
+
+	return "", "", false
+}
+
+// Get the length of the emoji which s starts with, including any emoji modifiers, variation selectors, tags and emoji
+// joined to it, or 0 if s does not start with an emoji. A pair of regional indicators, forming a flag, and a keycap
+// sequence, e.g. "1️⃣", are treated as single emoji.
+func emojiLen(s string) int {
+	r, n := utf8.DecodeRuneInString(s)
+
+	switch {
+	case isKeycapBase(r):
+		rest := strings.TrimPrefix(s[n:], string(variationSelector16))
+		if !strings.HasPrefix(rest, string(combiningKeycap)) {
+			return 0
+		}
+
+		return len(s) - len(rest) + utf8.RuneLen(combiningKeycap)
+	case isRegionalIndicator(r):
+		if r, size := utf8.DecodeRuneInString(s[n:]); isRegionalIndicator(r) {
+			n += size
+		}
+
+		return n
+	case !unicode.Is(emoji, r):
+		return 0
+	}
+
+	for n < len(s) {
+		r, size := utf8.DecodeRuneInString(s[n:])
+
+		switch {
+		case r == variationSelector15 || r == variationSelector16 || isEmojiModifier(r) || isEmojiTag(r):
+			n += size
+		case r == zeroWidthJoiner:
+			next, nextSize := utf8.DecodeRuneInString(s[n+size:])
+			if !unicode.Is(emoji, next) {
+				return n
+			}
+
+			n += size + nextSize
+		default:
+			return n
+		}
+	}
+
+	return n
+}
+
+// Get the letters an emoji, as matched by emojiLen, looks like, if it is letter-like.
+func emojiLetters(s string) (string, bool) {
+	var b strings.Builder
+
+	for _, r := range s {
+		switch {
+		case r == variationSelector15 || r == variationSelector16 || r == combiningKeycap:
+		case isKeycapBase(r):
+			b.WriteRune(r)
+		case r >= regionalIndicatorA && r <= regionalIndicatorZ:
+			b.WriteRune('A' + r - regionalIndicatorA)
+		case r >= negativeCircledA && r <= negativeCircledZ:
+			b.WriteRune('A' + r - negativeCircledA)
+		case r >= negativeSquaredA && r <= negativeSquaredZ:
+			b.WriteRune('A' + r - negativeSquaredA)
+		case emojiWords[r] != "":
+			b.WriteString(emojiWords[r])
+		default:
+			return "", false
+		}
+	}
+
+	return b.String(), true
+}
+
+func isEmojiModifier(r rune) bool {
+	return r >= emojiModifierFirst && r <= emojiModifierLast
+}
+
+func isEmojiTag(r rune) bool {
+	return r >= emojiTagFirst && r <= emojiTagLast
+}
+
+// Check whether a rune may start a keycap sequence.
+func isKeycapBase(r rune) bool {
+	return (r >= '0' && r <= '9') || r == '#' || r == '*'
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalIndicatorA && r <= regionalIndicatorZ
+}
@@ -0,0 +1,60 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmojiOptions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		opts []confusables.Option
+		s    string
+		want string
+	}{
+		{nil, "hi 😀", "hi 😀"},
+		{[]confusables.Option{confusables.WithStripEmoji()}, "hi 😀!", "hi !"},
+		{[]confusables.Option{confusables.WithStripEmoji()}, "hi \U0001f469\U0001f3fd‍\U0001f4bb", "hi "},
+		{[]confusables.Option{confusables.WithStripEmoji()}, "call 1️⃣", "call "},
+		{[]confusables.Option{confusables.WithEmojiPlaceholder("<emoji>")}, "a👍🏿b❤️c",
+			"a<emoji>b<emoji>c"},
+		{[]confusables.Option{confusables.WithEmojiPlaceholder("?")}, "\U0001f1fa\U0001f1f8\U0001f1ec\U0001f1e7", "??"},
+		{[]confusables.Option{confusables.WithEmojiLetters()}, "\U0001f170️\U0001f171️c", "ABc"},
+		{[]confusables.Option{confusables.WithEmojiLetters()}, "🆎 🆗 😀", "AB OK 😀"},
+		{[]confusables.Option{confusables.WithEmojiLetters()}, "\U0001f1fa\U0001f1f8 1️⃣", "US 1"},
+		{[]confusables.Option{confusables.WithEmojiLetters(), confusables.WithStripEmoji()}, "🅿😀🅰y", "PAy"},
+	}
+
+	for _, test := range tests {
+		c := confusables.New(test.opts...)
+
+		assert.Equal(t, test.want, c.ToASCII(test.s), "ToASCII(%q)", test.s)
+		assert.Equal(t, test.want, string(c.AppendASCII(nil, test.s)), "AppendASCII(%q)", test.s)
+
+		a, _ := c.ToASCIIWithIndex(test.s)
+		assert.Equal(t, test.want, a, "ToASCIIWithIndex(%q)", test.s)
+	}
+}
+
+func TestEmojiDiff(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.New(confusables.WithEmojiLetters())
+
+	a, diffs := c.ToASCIIDiff("\U0001f1fa\U0001f1f8🆗")
+	assert.Equal(t, "USOK", a)
+	assert.Len(t, diffs, 3)
+	assert.Equal(t, "US", *diffs[0].Confusable)
+	assert.Equal(t, "\U0001f1fa\U0001f1f8", diffs[0].Sequence)
+	assert.Equal(t, "", *diffs[1].Confusable)
+	assert.Equal(t, "OK", *diffs[2].Confusable)
+	assert.Equal(t, "", diffs[2].Sequence)
+
+	a, m := c.ToASCIIWithIndex("x\U0001f1fa\U0001f1f8y")
+	assert.Equal(t, "xUSy", a)
+	assert.Equal(t, 1, m.ToOriginal(2))
+	assert.Equal(t, 9, m.ToOriginal(3))
+}
@@ -0,0 +1,90 @@
+package confusables
+
+import (
+	"strings"
+	"unicode"
+)
+
+// EqualOption configures how strings are compared by Equal.
+type EqualOption func(*equalOptions)
+
+type equalOptions struct {
+	collapseWhitespace bool
+	ignoreCase         bool
+	stripPunctuation   bool
+}
+
+// WithCollapseWhitespace collapses each run of whitespace into a single space, and removes leading and trailing
+// whitespace, before strings are compared by Equal, e.g. "John  Smith" matches "John Smith".
+func WithCollapseWhitespace() EqualOption {
+	return func(o *equalOptions) {
+		o.collapseWhitespace = true
+	}
+}
+
+// WithIgnoreCase compares strings regardless of case in Equal, as IsConfusableFold. This also collapses compatibility
+// variants, such as fullwidth letters, and removes invisible characters.
+func WithIgnoreCase() EqualOption {
+	return func(o *equalOptions) {
+		o.ignoreCase = true
+	}
+}
+
+// WithStripPunctuation removes punctuation, including the runes which are confusable with it, before strings are
+// compared by Equal, e.g. "O'Brien" matches "OBrien".
+func WithStripPunctuation() EqualOption {
+	return func(o *equalOptions) {
+		o.stripPunctuation = true
+	}
+}
+
+// Equal checks if two strings are confusable, as IsConfusable, after applying opts to both, so that the comparison
+// may be made more tolerant, e.g. for detecting duplicate display names:
+//
+//	confusables.Equal("John  Smith", "Јohn Smith", confusables.WithCollapseWhitespace())
+//
+// Without options, Equal is IsConfusable.
+func Equal(s1, s2 string, opts ...EqualOption) bool {
+	return loadTables().equal(s1, s2, opts)
+}
+
+// Equal checks if two strings are confusable using the instance's mappings after applying opts to both, as Equal.
+func (c *Confusables) Equal(s1, s2 string, opts ...EqualOption) bool {
+	return c.tables.load().equal(s1, s2, opts)
+}
+
+func (t *tables) equal(s1, s2 string, opts []EqualOption) bool {
+	var o equalOptions
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return t.equalForm(s1, &o) == t.equalForm(s2, &o)
+}
+
+// Get the form of s which is compared by Equal. Punctuation and whitespace are removed from the skeleton, so that the
+// runes confusable with them are removed too.
+func (t *tables) equalForm(s string, o *equalOptions) string {
+	if o.ignoreCase {
+		s = nfkcCaseFold(s)
+	}
+
+	s = string(t.appendSkeleton(make([]byte, 0, len(s)), s))
+
+	if o.stripPunctuation {
+		s = strings.Map(func(r rune) rune {
+			if unicode.IsPunct(r) {
+				return -1
+			}
+
+			return r
+		}, s)
+	}
+
+	if o.collapseWhitespace {
+		s = strings.Join(strings.Fields(s), " ")
+	}
+
+	return s
+}
@@ -0,0 +1,44 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	t.Parallel()
+
+	collapse := confusables.WithCollapseWhitespace()
+	ignoreCase := confusables.WithIgnoreCase()
+	strip := confusables.WithStripPunctuation()
+
+	tests := []struct {
+		s1, s2 string
+		opts   []confusables.EqualOption
+		equal  bool
+	}{
+		{"paypal", "pаypal", nil, true},
+		{"John  Smith", "Јohn Smith", nil, false},
+		{"John  Smith", "Јohn Smith", []confusables.EqualOption{collapse}, true},
+		{" John\tSmith\n", "John Smith", []confusables.EqualOption{collapse}, true},
+		{"JOHN SMITH", "John Smith", nil, false},
+		{"JOHN SMITH", "John Smith", []confusables.EqualOption{ignoreCase}, true},
+		{"O'Brien", "OBrien", nil, false},
+		{"O'Brien", "OBrien", []confusables.EqualOption{strip}, true},
+		{"O’Brien", "OBrien", []confusables.EqualOption{strip}, true},
+		{"Mary-Jane  O'Brien", "mary jane obrien", []confusables.EqualOption{collapse, ignoreCase, strip}, false},
+		{"Mary - Jane  O'Brien", "mary jane obrien", []confusables.EqualOption{collapse, ignoreCase, strip}, true},
+		{"John Smith", "John Smyth", []confusables.EqualOption{collapse, ignoreCase, strip}, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.equal, confusables.Equal(test.s1, test.s2, test.opts...), "Equal(%q, %q)", test.s1, test.s2)
+	}
+
+	c := confusables.NewSafe()
+	c.AddMapping('☺', "o")
+	assert.True(t, c.Equal("J☺hn", "John"))
+	assert.False(t, confusables.Equal("J☺hn", "John"))
+}
@@ -0,0 +1,126 @@
+package confusables
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Explanation sets out why two strings are, or are not, confusable, as returned by Explain, so that the evidence for
+// a verdict may be shown to people reviewing it.
+type Explanation struct {
+	// Confusable reports whether the strings share a skeleton, as IsConfusable.
+	Confusable bool
+	// ConfusableIgnoringInvisibles reports whether the strings share a skeleton once their invisible characters, which
+	// are not rendered, are removed, as StripInvisible.
+	ConfusableIgnoringInvisibles bool
+	// Mismatch is the byte offset within the skeletons at which they first differ, or -1 where they are the same.
+	Mismatch int
+	// Strings holds the evidence for each of the strings, in the order they were given.
+	Strings [2]ExplainedString
+}
+
+// ExplainedString is the evidence for one of the strings of an Explanation.
+type ExplainedString struct {
+	Original string
+	Skeleton string
+	// Diffs holds the Diff of each rune of the NFD form of the string, in order, as ToSkeletonDiff. Runes which are
+	// replaced to reach the skeleton have a Confusable.
+	Diffs []Diff
+	// Invisibles holds the default ignorable code points within the string, as FindInvisibles.
+	Invisibles []rune
+	// Scripts holds the scripts used by the string, as Scripts.
+	Scripts []string
+}
+
+// Explain states why s1 and s2 are, or are not, confusable: their skeletons, the mappings applied to reach them, the
+// scripts involved and the invisible characters which would be ignored when rendered.
+func (c *Confusables) Explain(s1, s2 string) Explanation {
+	t := c.tables.load()
+
+	e := Explanation{
+		Strings: [2]ExplainedString{t.explainString(s1), t.explainString(s2)},
+	}
+
+	a, b := e.Strings[0].Skeleton, e.Strings[1].Skeleton
+
+	e.Confusable = a == b
+	e.ConfusableIgnoringInvisibles = e.Confusable ||
+		string(t.appendSkeleton(nil, StripInvisible(s1))) == string(t.appendSkeleton(nil, StripInvisible(s2)))
+	e.Mismatch = mismatch(a, b)
+
+	return e
+}
+
+// Explain states why s1 and s2 are, or are not, confusable, as Confusables.Explain.
+func Explain(s1, s2 string) Explanation {
+	return New().Explain(s1, s2)
+}
+
+// String describes the explanation for people to read, listing the mappings applied to each string.
+func (e Explanation) String() string {
+	var b strings.Builder
+
+	a, s := e.Strings[0], e.Strings[1]
+
+	switch {
+	case e.Confusable:
+		fmt.Fprintf(&b, "%q and %q are confusable: both have the skeleton %q\n", a.Original, s.Original, a.Skeleton)
+	case e.ConfusableIgnoringInvisibles:
+		fmt.Fprintf(&b, "%q and %q are confusable once invisible characters are removed\n", a.Original, s.Original)
+	default:
+		fmt.Fprintf(&b, "%q and %q are not confusable: their skeletons, %q and %q, differ at byte %d\n", a.Original,
+			s.Original, a.Skeleton, s.Skeleton, e.Mismatch)
+	}
+
+	for _, str := range e.Strings {
+		for _, diff := range str.Diffs {
+			if diff.Confusable == nil || diff.Description == nil {
+				continue
+			}
+
+			fmt.Fprintf(&b, "  %q: U+%04X %s → %q %s\n", str.Original, diff.Rune, diff.Description.From, *diff.Confusable,
+				diff.Description.To)
+		}
+
+		for _, r := range str.Invisibles {
+			fmt.Fprintf(&b, "  %q: U+%04X is invisible\n", str.Original, r)
+		}
+
+		if len(str.Scripts) > 0 {
+			fmt.Fprintf(&b, "  %q: scripts %s\n", str.Original, strings.Join(str.Scripts, ", "))
+		}
+	}
+
+	return b.String()
+}
+
+func (t *tables) explainString(s string) ExplainedString {
+	e := ExplainedString{
+		Original: s,
+		Skeleton: string(t.appendSkeleton(nil, s)),
+		Diffs:    t.skeletonDiffs(s),
+		Scripts:  Scripts(s),
+	}
+
+	for _, diff := range FindInvisibles(s) {
+		e.Invisibles = append(e.Invisibles, diff.Rune)
+	}
+
+	return e
+}
+
+// Get the byte offset at which a and b first differ, or -1 where they are the same.
+func mismatch(a, b string) int {
+	if a == b {
+		return -1
+	}
+
+	n := min(len(a), len(b))
+	for i := 0; i < n; i++ {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,46 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s1, s2                        string
+		confusable, ignoringInvisible bool
+		mismatch                      int
+	}{
+		{"paypal", "pаypal", true, true, -1},
+		{"paypal", "pay\u200bpal", false, true, 3},
+		{"paypal", "paypa", false, false, 5},
+		{"example", "exarnple", true, true, -1},
+		{"paypal", "paxpal", false, false, 2},
+	}
+
+	for _, test := range tests {
+		e := confusables.Explain(test.s1, test.s2)
+
+		assert.Equal(t, test.confusable, e.Confusable, "Explain(%q, %q)", test.s1, test.s2)
+		assert.Equal(t, confusables.IsConfusable(test.s1, test.s2), e.Confusable, "Explain(%q, %q)", test.s1, test.s2)
+		assert.Equal(t, test.ignoringInvisible, e.ConfusableIgnoringInvisibles, "Explain(%q, %q)", test.s1, test.s2)
+		assert.Equal(t, test.mismatch, e.Mismatch, "Explain(%q, %q)", test.s1, test.s2)
+	}
+
+	e := confusables.Explain("paypal", "pаy\u200bpal")
+
+	assert.Equal(t, confusables.ToSkeleton("pаy\u200bpal"), e.Strings[1].Skeleton)
+	assert.Equal(t, []string{"Latin", "Cyrillic"}, e.Strings[1].Scripts)
+	assert.Equal(t, []rune{'\u200b'}, e.Strings[1].Invisibles)
+	assert.Equal(t, confusables.ToSkeletonDiff("pаy\u200bpal"), e.Strings[1].Diffs)
+	assert.Equal(t, `"paypal" and "pаy\u200bpal" are confusable once invisible characters are removed
+  "paypal": scripts Latin
+  "pаy\u200bpal": U+0430 CYRILLIC SMALL LETTER A → "a" LATIN SMALL LETTER A
+  "pаy\u200bpal": U+200B is invisible
+  "pаy\u200bpal": scripts Latin, Cyrillic
+`, e.String())
+}
@@ -0,0 +1,163 @@
+package confusables
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Format is a format in which mappings are written by ExportMappings.
+type Format int
+
+// Formats in which mappings may be written.
+const (
+	// FormatText is the format of confusables.txt, as read by LoadMappings.
+	FormatText Format = iota
+	// FormatJSON is a JSON array of JSONMapping, as read by LoadMappingsJSON.
+	FormatJSON
+	// FormatCSV is CSV with a header, as read by LoadMappingsCSV.
+	FormatCSV
+)
+
+// ErrUnsupportedFormat is raised when exporting mappings in a format which is not supported.
+var ErrUnsupportedFormat = errors.New("unsupported mapping format")
+
+// ExportMappings writes the package's mappings, including those loaded at runtime, to w in format. Sources and
+// targets are written as code points, ordered by source, and sequences are written in NFD.
+func ExportMappings(w io.Writer, format Format) error {
+	return exportMappings(w, format, loadTables())
+}
+
+// ExportMappings writes the instance's mappings to w in format, as ExportMappings.
+func (c *Confusables) ExportMappings(w io.Writer, format Format) error {
+	return exportMappings(w, format, c.tables.load())
+}
+
+// Get every mapping, ordered by source.
+func (t *tables) entries() []ConfusableEntry {
+	entries := make([]ConfusableEntry, 0, t.confusables.len())
+
+	add := func(source, target string) {
+		r, _ := utf8.DecodeRuneInString(source)
+
+		// Only names which are held are exported, rather than the code points of runes without a name.
+		var description Description
+		if from, to := t.descriptions.get(source), t.descriptions.get(target); from != "" && to != "" {
+			description = Description{From: from, To: to}
+		}
+
+		entries = append(entries, ConfusableEntry{
+			Description:    description,
+			Source:         r,
+			SourceSequence: source,
+			Target:         target,
+		})
+	}
+
+	t.confusables.each(func(r rune, v string) {
+		add(string(r), v)
+	})
+
+	if !t.sequences.empty() {
+		for _, mappings := range t.sequences.m {
+			for _, m := range mappings {
+				add(m.source, m.value)
+			}
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b ConfusableEntry) int {
+		return strings.Compare(a.SourceSequence, b.SourceSequence)
+	})
+
+	return entries
+}
+
+func exportMappings(w io.Writer, format Format, t *tables) error {
+	entries := t.entries()
+
+	switch format {
+	case FormatText:
+		return exportText(w, entries)
+	case FormatJSON:
+		return exportJSON(w, entries)
+	case FormatCSV:
+		return exportCSV(w, entries)
+	default:
+		return fmt.Errorf("%w: %d", ErrUnsupportedFormat, format)
+	}
+}
+
+func exportCSV(w io.Writer, entries []ConfusableEntry) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"source", "target", "sourceName", "targetName"}); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		m := newJSONMapping(entry)
+		if err := cw.Write([]string{m.Source, m.Target, m.SourceName, m.TargetName}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func exportJSON(w io.Writer, entries []ConfusableEntry) error {
+	mappings := make([]JSONMapping, 0, len(entries))
+	for _, entry := range entries {
+		mappings = append(mappings, newJSONMapping(entry))
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(mappings)
+}
+
+func exportText(w io.Writer, entries []ConfusableEntry) error {
+	for _, entry := range entries {
+		names := ""
+		if entry.Description.From != "" || entry.Description.To != "" {
+			names = entry.Description.From + " → " + entry.Description.To
+		}
+
+		_, err := fmt.Fprintf(w, "%s ;\t%s ;\tMA\t# ( %s → %s ) %s\t#\n", formatCodePoints(entry.SourceSequence, ""),
+			formatCodePoints(entry.Target, ""), entry.SourceSequence, entry.Target, names)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Format the code points of s as hexadecimal, separated by spaces, each with prefix, e.g. "U+0061 U+0062".
+func formatCodePoints(s, prefix string) string {
+	codePoints := make([]string, 0, len(s))
+	for _, r := range s {
+		codePoints = append(codePoints, fmt.Sprintf("%s%04X", prefix, r))
+	}
+
+	return strings.Join(codePoints, " ")
+}
+
+// Create the JSON mapping of an entry, with its source and target written as code points.
+func newJSONMapping(entry ConfusableEntry) JSONMapping {
+	return JSONMapping{
+		Source:     formatCodePoints(entry.SourceSequence, "U+"),
+		SourceName: entry.Description.From,
+		Target:     formatCodePoints(entry.Target, "U+"),
+		TargetName: entry.Description.To,
+	}
+}
@@ -0,0 +1,64 @@
+package confusables_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportMappings(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.NewSafe()
+	c.AddMappingWithDesc('☺', ":)", "WHITE SMILING FACE", "COLON, RIGHT PARENTHESIS")
+	c.AddSequenceMapping("ab", "x")
+
+	var text, js, csv bytes.Buffer
+
+	assert.NoError(t, c.ExportMappings(&text, confusables.FormatText))
+	assert.Contains(t, text.String(),
+		"0430 ;\t0061 ;\tMA\t# ( а → a ) CYRILLIC SMALL LETTER A → LATIN SMALL LETTER A\t#\n")
+	assert.Contains(t, text.String(),
+		"263A ;\t003A 0029 ;\tMA\t# ( ☺ → :) ) WHITE SMILING FACE → COLON, RIGHT PARENTHESIS\t#\n")
+	assert.Contains(t, text.String(), "0061 0062 ;\t0078 ;\tMA\t# ( ab → x ) "+
+		"LATIN SMALL LETTER A, LATIN SMALL LETTER B → LATIN SMALL LETTER X\t#\n")
+
+	assert.NoError(t, c.ExportMappings(&js, confusables.FormatJSON))
+
+	var mappings []confusables.JSONMapping
+
+	assert.NoError(t, json.Unmarshal(js.Bytes(), &mappings))
+	assert.Contains(t, mappings, confusables.JSONMapping{Source: "U+263A", SourceName: "WHITE SMILING FACE",
+		Target: "U+003A U+0029", TargetName: "COLON, RIGHT PARENTHESIS"})
+
+	assert.NoError(t, c.ExportMappings(&csv, confusables.FormatCSV))
+	assert.True(t, strings.HasPrefix(csv.String(), "source,target,sourceName,targetName\n"))
+	assert.Contains(t, csv.String(), "U+263A,U+003A U+0029,WHITE SMILING FACE,\"COLON, RIGHT PARENTHESIS\"\n")
+
+	// Each format is read back to the same mappings.
+	loaders := map[*bytes.Buffer]func(*confusables.SafeConfusables, *bytes.Buffer) error{
+		&text: func(s *confusables.SafeConfusables, b *bytes.Buffer) error { return s.LoadMappings(b) },
+		&js:   func(s *confusables.SafeConfusables, b *bytes.Buffer) error { return s.LoadMappingsJSON(b) },
+		&csv:  func(s *confusables.SafeConfusables, b *bytes.Buffer) error { return s.LoadMappingsCSV(b) },
+	}
+
+	var want bytes.Buffer
+
+	assert.NoError(t, c.ExportMappings(&want, confusables.FormatText))
+
+	for buf, load := range loaders {
+		loaded := confusables.NewSafe()
+		assert.NoError(t, load(loaded, buf))
+
+		var got bytes.Buffer
+
+		assert.NoError(t, loaded.ExportMappings(&got, confusables.FormatText))
+		assert.Equal(t, want.String(), got.String())
+	}
+
+	assert.ErrorIs(t, c.ExportMappings(&text, confusables.Format(-1)), confusables.ErrUnsupportedFormat)
+}
@@ -0,0 +1,31 @@
+package confusables
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	registerProfile("skeleton-cf")
+}
+
+// IsConfusableFold checks if two strings are confusable of one another regardless of case, e.g. "PayPal" and
+// "раураl". Both strings are compared using ToSkeletonCF.
+func IsConfusableFold(s1, s2 string) bool {
+	return ToSkeletonCF(s1) == ToSkeletonCF(s2)
+}
+
+// ToSkeletonCF converts a string to its skeleton form after applying NFKC_Casefold to it. Compatibility variants, such
+// as mathematical alphanumerics and fullwidth letters, and differences in case are collapsed even when they are not
+// listed as confusables.
+func ToSkeletonCF(s string) string {
+	return ToSkeleton(nfkcCaseFold(s))
+}
+
+// Apply an approximation of the NFKC_Casefold mapping, i.e. NFKC normalization, case folding and the removal of
+// default ignorable code points.
+func nfkcCaseFold(s string) string {
+	s = cases.Fold().String(norm.NFKC.String(s))
+
+	return norm.NFKC.String(StripInvisible(s))
+}
@@ -0,0 +1,64 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsConfusableFold(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s1, s2       string
+		isConfusable bool
+	}{
+		{"", "", true},
+		{"PayPal", "раураl", true},
+		{"HELLO", "һеllо", true},
+		{"PayPal", "google", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.isConfusable, confusables.IsConfusableFold(test.s1, test.s2),
+			"IsConfusableFold(%q, %q)", test.s1, test.s2)
+	}
+
+	assert.False(t, confusables.IsConfusable("PayPal", "раураl"))
+}
+
+func TestWithCaseFold(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.New(confusables.WithCaseFold())
+
+	assert.Equal(t, "paypal", c.ToASCII("PayPal"))
+	assert.Equal(t, "paypal", c.ToASCII("РАУРАL"))
+	assert.Equal(t, "strasse", c.ToASCII("Straße"))
+
+	assert.Equal(t, "paypal", c.ToSkeleton("PayPal"))
+	assert.True(t, c.IsConfusable("PayPal", "раураl"))
+	assert.Equal(t, c.SkeletonHash("раураl"), c.SkeletonHash("PayPal"))
+	assert.False(t, confusables.New().IsConfusable("PayPal", "раураl"))
+}
+
+func TestToSkeletonCF(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, skeleton string
+	}{
+		{"", ""},
+		{"example", "exarnple"},
+		{"EXAMPLE", "exarnple"},
+		{"ＥＸＡＭＰＬＥ", "exarnple"},
+		{"𝐄𝐱𝐚𝐦𝐩𝐥𝐞", "exarnple"},
+		{"Straße", "strasse"},
+		{"ex\u200bample", "exarnple"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.skeleton, confusables.ToSkeletonCF(test.s), "ToSkeletonCF(%q)", test.s)
+	}
+}
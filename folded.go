@@ -0,0 +1,71 @@
+package confusables
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// FoldedString is a string along with its ASCII form, as ToASCII, and its skeleton, as ToSkeleton. The forms are
+// computed once, when the string is created, decoded or scanned.
+//
+// As a database value, a FoldedString is stored as the original string and its forms are recomputed when it is
+// scanned. A uniqueness index on skeletons is kept by also storing Skeleton in its own column, e.g.
+//
+//	db.Exec("INSERT INTO users (name, name_skeleton) VALUES (?, ?)", name, name.Skeleton)
+type FoldedString struct {
+	ASCII    string
+	Original string
+	Skeleton string
+}
+
+// NewFoldedString creates a FoldedString from s.
+func NewFoldedString(s string) FoldedString {
+	return FoldedString{
+		ASCII:    ToASCII(s),
+		Original: s,
+		Skeleton: ToSkeleton(s),
+	}
+}
+
+// IsConfusable checks if the string is confusable with other, i.e. their skeletons are the same.
+func (f FoldedString) IsConfusable(other FoldedString) bool {
+	return f.Skeleton == other.Skeleton
+}
+
+// MarshalText implements encoding.TextMarshaler, encoding the original string.
+func (f FoldedString) MarshalText() ([]byte, error) {
+	return []byte(f.Original), nil
+}
+
+// Scan implements sql.Scanner, accepting strings, byte slices and NULL, which is scanned as the empty string.
+func (f *FoldedString) Scan(src any) error {
+	switch src := src.(type) {
+	case string:
+		*f = NewFoldedString(src)
+	case []byte:
+		*f = NewFoldedString(string(src))
+	case nil:
+		*f = FoldedString{}
+	default:
+		return fmt.Errorf("cannot scan %T into FoldedString", src)
+	}
+
+	return nil
+}
+
+// String returns the original string.
+func (f FoldedString) String() string {
+	return f.Original
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, computing the forms of the decoded string.
+func (f *FoldedString) UnmarshalText(text []byte) error {
+	*f = NewFoldedString(string(text))
+
+	return nil
+}
+
+// Value implements driver.Valuer, storing the original string.
+func (f FoldedString) Value() (driver.Value, error) {
+	return f.Original, nil
+}
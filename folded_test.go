@@ -0,0 +1,44 @@
+package confusables_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFoldedString(t *testing.T) {
+	t.Parallel()
+
+	f := confusables.NewFoldedString("pаypal")
+	assert.Equal(t, "pаypal", f.Original)
+	assert.Equal(t, "paypal", f.ASCII)
+	assert.Equal(t, confusables.ToSkeleton("paypal"), f.Skeleton)
+	assert.Equal(t, "pаypal", f.String())
+	assert.True(t, f.IsConfusable(confusables.NewFoldedString("paypal")))
+
+	v, err := f.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "pаypal", v)
+
+	var scanned confusables.FoldedString
+
+	for _, src := range []any{"pаypal", []byte("pаypal")} {
+		assert.NoError(t, scanned.Scan(src))
+		assert.Equal(t, f, scanned)
+	}
+
+	assert.NoError(t, scanned.Scan(nil))
+	assert.Equal(t, confusables.FoldedString{}, scanned)
+	assert.Error(t, scanned.Scan(1))
+
+	data, err := json.Marshal(map[string]confusables.FoldedString{"name": f})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"pаypal"}`, string(data))
+
+	var decoded map[string]confusables.FoldedString
+
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, f, decoded["name"])
+}
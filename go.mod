@@ -3,7 +3,9 @@ module github.com/eskriett/confusables
 go 1.22.5
 
 require (
+	github.com/rivo/uniseg v0.4.7
 	github.com/stretchr/testify v1.7.1
+	golang.org/x/net v0.21.0
 	golang.org/x/text v0.19.0
 )
 
@@ -0,0 +1,17 @@
+package confusables
+
+// GroupOf returns the runes which are confusable with r as they map to the same confusable, in order, including r and
+// the confusable itself where it is a single rune, e.g. "a" for 'а'. The group of a rune which is neither mapped nor
+// mapped to is r alone. The mappings are inverted once, on first use, so that groups are found cheaply thereafter.
+//
+// Only the mappings of single runes are inverted, so runes are grouped with those of a confusable formed of several
+// runes, such as 'm' with the other runes mapping to "rn", but not with "rn" itself. Nor are runes decomposed, so
+// runes with nonspacing marks are only grouped by their own mappings.
+func GroupOf(r rune) []rune {
+	return loadTables().group(r)
+}
+
+// GroupOf returns the runes which are confusable with r by the instance's mappings, as GroupOf.
+func (c *Confusables) GroupOf(r rune) []rune {
+	return c.tables.load().group(r)
+}
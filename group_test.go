@@ -0,0 +1,33 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupOf(t *testing.T) {
+	t.Parallel()
+
+	group := confusables.GroupOf('а')
+	assert.Contains(t, group, 'a')
+	assert.Contains(t, group, 'а')
+	assert.Contains(t, group, 'ɑ')
+	assert.IsIncreasing(t, group)
+	assert.Equal(t, group, confusables.GroupOf('a'))
+	assert.Equal(t, group, confusables.GroupOf('ɑ'))
+
+	for _, r := range group {
+		assert.Equal(t, "a", confusables.ToSkeleton(string(r)), "ToSkeleton(%q)", r)
+	}
+
+	assert.Equal(t, []rune{'☺'}, confusables.GroupOf('☺'))
+	assert.Contains(t, confusables.GroupOf('m'), 'm')
+	assert.NotContains(t, confusables.GroupOf('m'), 'r')
+
+	c := confusables.NewSafe()
+	c.AddMapping('☺', "a")
+	assert.Contains(t, c.GroupOf('a'), '☺')
+	assert.NotContains(t, confusables.GroupOf('a'), '☺')
+}
@@ -0,0 +1,27 @@
+package confusables
+
+import (
+	"strings"
+
+	"golang.org/x/text/width"
+)
+
+func init() {
+	registerTable("halfwidth")
+}
+
+// ToHalfwidth converts fullwidth forms to their halfwidth equivalent, e.g. "ＡＢＣ１２３！" becomes "ABC123!". This
+// covers the letters, digits and punctuation of the Halfwidth and Fullwidth Forms block along with IDEOGRAPHIC SPACE,
+// and is independent of the confusable mappings and NFKC normalization. Characters which are wide by nature, such as
+// katakana and ideographs, are left as they are.
+func ToHalfwidth(s string) string {
+	return strings.Map(toHalfwidth, s)
+}
+
+func toHalfwidth(r rune) rune {
+	if p := width.LookupRune(r); p.Kind() == width.EastAsianFullwidth {
+		return p.Narrow()
+	}
+
+	return r
+}
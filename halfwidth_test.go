@@ -0,0 +1,37 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToHalfwidth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, want string
+	}{
+		{"", ""},
+		{"abc", "abc"},
+		{"ＡＢＣ１２３", "ABC123"},
+		{"ｈｅｌｌｏ，　ｗｏｒｌｄ！", "hello, world!"},
+		{"￥１００", "¥100"},
+		{"カタカナ漢字", "カタカナ漢字"},
+		{"ｶﾀｶﾅ", "ｶﾀｶﾅ"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, confusables.ToHalfwidth(test.s), "ToHalfwidth(%q)", test.s)
+	}
+}
+
+func TestWithHalfwidth(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.New(confusables.WithConfusablesOnly(), confusables.WithHalfwidth())
+
+	assert.Equal(t, "ABC² 123", c.ToASCII("ＡＢＣ²　１２３"))
+	assert.Equal(t, "ABC² 123", string(c.AppendASCII(nil, "ＡＢＣ²　１２３")))
+}
@@ -0,0 +1,71 @@
+package confusables
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// FNV-1a parameters, as in hash/fnv.
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// SkeletonHash returns the 64-bit FNV-1a hash of the skeleton of s, as ToSkeleton, without building the skeleton, so
+// that strings may be bucketed by skeleton cheaply. Confusable strings have the same hash, but as with any hash,
+// strings which are not confusable may share one too, so candidates within a bucket should be compared with
+// IsConfusable.
+//
+// The hash is that of hash/fnv's New64a over the bytes of the skeleton, and so changes whenever the skeleton does, such
+// as when the tables are upgraded; see WithTables.
+func SkeletonHash(s string) uint64 {
+	return loadTables().skeletonHash(s)
+}
+
+// SkeletonHash returns the hash of the skeleton of s, using the instance's mappings, as SkeletonHash.
+func (c *Confusables) SkeletonHash(s string) uint64 {
+	return c.tables.load().skeletonHash(c.caseFolded(s))
+}
+
+// Hash the skeleton of s as appendSkeleton would build it.
+func (t *tables) skeletonHash(s string) uint64 {
+	nfd := s
+	if norm.NFD.QuickSpanString(s) != len(s) {
+		nfd = norm.NFD.String(s)
+	}
+
+	h := uint64(fnvOffset64)
+	end := 0
+
+	for i, r := range nfd {
+		if i < end {
+			continue
+		}
+
+		if source, c, ok := t.sequences.match(nfd[i:]); ok {
+			h = fnvString(h, c)
+			end = i + len(source)
+		} else if c, ok := t.confusables.lookup(r); ok {
+			h = fnvString(h, c)
+		} else {
+			var b [utf8.UTFMax]byte
+
+			n := utf8.EncodeRune(b[:], r)
+			for _, c := range b[:n] {
+				h = (h ^ uint64(c)) * fnvPrime64
+			}
+		}
+	}
+
+	return h
+}
+
+// Add the bytes of s to the FNV-1a hash h.
+func fnvString(h uint64, s string) uint64 {
+	for i := range len(s) {
+		h = (h ^ uint64(s[i])) * fnvPrime64
+	}
+
+	return h
+}
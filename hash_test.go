@@ -0,0 +1,47 @@
+package confusables_test
+
+import (
+	"hash/fnv"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkeletonHash(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"", "paypal", "pаypal", "tòñ", "rn", "ǉ", "\xff", "x\u200by"} {
+		assert.Equal(t, fnvHash(confusables.ToSkeleton(s)), confusables.SkeletonHash(s), "SkeletonHash(%q)", s)
+	}
+
+	assert.Equal(t, confusables.SkeletonHash("paypal"), confusables.SkeletonHash("pаypаl"))
+	assert.Equal(t, confusables.SkeletonHash("modern"), confusables.SkeletonHash("rnodern"))
+	assert.NotEqual(t, confusables.SkeletonHash("paypal"), confusables.SkeletonHash("paypai"))
+
+	c := confusables.NewSafe()
+	c.AddMapping('ʘ', "o")
+	assert.Equal(t, fnvHash("o"), c.SkeletonHash("ʘ"))
+	assert.Equal(t, fnvHash("ʘ"), confusables.SkeletonHash("ʘ"))
+}
+
+func fnvHash(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+
+	return h.Sum64()
+}
+
+func TestSkeletonHashAllocs(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		confusables.SkeletonHash("pаypаl")
+	})
+
+	assert.Zero(t, allocs)
+}
+
+func BenchmarkSkeletonHash(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		confusables.SkeletonHash("𝐞х⍺𝓂𝕡Іꬲ")
+	}
+}
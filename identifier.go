@@ -0,0 +1,298 @@
+package confusables
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Status is the Identifier_Status of a character as defined in
+// https://www.unicode.org/reports/tr39/#Identifier_Status_and_Type.
+type Status int
+
+// Identifier statuses.
+const (
+	Restricted Status = iota
+	Allowed
+)
+
+// IdentifierType is an Identifier_Type of a character as defined in
+// https://www.unicode.org/reports/tr39/#Identifier_Status_and_Type.
+type IdentifierType string
+
+// Identifier types.
+const (
+	TypeNotCharacter     IdentifierType = "Not_Character"
+	TypeDeprecated       IdentifierType = "Deprecated"
+	TypeDefaultIgnorable IdentifierType = "Default_Ignorable"
+	TypeNotNFKC          IdentifierType = "Not_NFKC"
+	TypeNotXID           IdentifierType = "Not_XID"
+	TypeExclusion        IdentifierType = "Exclusion"
+	TypeObsolete         IdentifierType = "Obsolete"
+	TypeTechnical        IdentifierType = "Technical"
+	TypeUncommonUse      IdentifierType = "Uncommon_Use"
+	TypeLimitedUse       IdentifierType = "Limited_Use"
+	TypeInclusion        IdentifierType = "Inclusion"
+	TypeRecommended      IdentifierType = "Recommended"
+)
+
+// Profile configures the validation of identifiers by IsValidIdentifier.
+type Profile struct {
+	// AllowMedial allows the characters listed in https://www.unicode.org/reports/tr31/#Table_Optional_Medial, such as
+	// "-" and ".", to be used within an identifier, though not at its start or end, nor consecutively.
+	AllowMedial bool
+	// AllowUnderscoreStart allows an identifier to start with "_", as is common in programming languages.
+	AllowUnderscoreStart bool
+	// RejectConfusables rejects identifiers containing runes which have a confusable mapping.
+	RejectConfusables bool
+	// RestrictionLevel is the least restrictive level an identifier may have.
+	RestrictionLevel Level
+}
+
+// ViolationKind describes how an identifier is invalid.
+type ViolationKind string
+
+// Violation kinds.
+const (
+	ViolationEmpty            ViolationKind = "empty"
+	ViolationStart            ViolationKind = "start"
+	ViolationContinue         ViolationKind = "continue"
+	ViolationMedial           ViolationKind = "medial"
+	ViolationRestricted       ViolationKind = "restricted"
+	ViolationConfusable       ViolationKind = "confusable"
+	ViolationRestrictionLevel ViolationKind = "restriction-level"
+)
+
+// Violation details a way in which an identifier is invalid. Violations which relate to the identifier as a whole,
+// rather than one of its runes, have an Offset of -1.
+type Violation struct {
+	Kind   ViolationKind
+	Offset int
+	Rune   rune
+}
+
+// Predefined identifier profiles.
+var (
+	// DefaultProfile follows the default identifier syntax of UAX #31 and requires identifiers to be Highly
+	// Restrictive.
+	DefaultProfile = Profile{
+		RestrictionLevel: HighlyRestrictive,
+	}
+	// HandleProfile is suitable for user handles, permitting separators such as "." and "-" but rejecting
+	// confusables.
+	HandleProfile = Profile{
+		AllowMedial:       true,
+		RejectConfusables: true,
+		RestrictionLevel:  HighlyRestrictive,
+	}
+	// ProgrammingProfile is suitable for identifiers in programming languages, which may start with "_".
+	ProgrammingProfile = Profile{
+		AllowUnderscoreStart: true,
+		RestrictionLevel:     HighlyRestrictive,
+	}
+)
+
+// identifierTables holds the data from IdentifierStatus.txt and IdentifierType.txt.
+type identifierTables struct {
+	allowed *unicode.RangeTable
+	types   map[IdentifierType]*unicode.RangeTable
+}
+
+// identifierData is populated by the generated identifier tables when they are compiled in. Without them, statuses and
+// types are derived from the character properties known to the unicode package.
+var identifierData *identifierTables
+
+// identifierTypeOrder is the order in which identifier types are reported.
+var identifierTypeOrder = []IdentifierType{
+	TypeNotCharacter, TypeDeprecated, TypeDefaultIgnorable, TypeNotNFKC, TypeNotXID, TypeExclusion, TypeObsolete,
+	TypeTechnical, TypeUncommonUse, TypeLimitedUse, TypeInclusion, TypeRecommended,
+}
+
+// inclusions are the characters outside of XID_Continue which are allowed in identifiers, as listed in
+// https://www.unicode.org/reports/tr31/#Table_Optional_Medial.
+const inclusions = "'-.:\u00b7\u058a\u05f3\u05f4\u0f0b\u200c\u200d\u2010\u2019\u2027\u30a0\u30fb"
+
+// limitedUseScripts are the scripts listed in https://www.unicode.org/reports/tr31/#Table_Limited_Use_Scripts. Scripts
+// which are neither recommended nor limited use are excluded.
+var limitedUseScripts = map[string]struct{}{
+	"Adlam": {}, "Balinese": {}, "Bamum": {}, "Batak": {}, "Canadian_Aboriginal": {}, "Chakma": {}, "Cham": {},
+	"Cherokee": {}, "Hanifi_Rohingya": {}, "Javanese": {}, "Kayah_Li": {}, "Lepcha": {}, "Limbu": {}, "Lisu": {},
+	"Mandaic": {}, "Meetei_Mayek": {}, "Miao": {}, "New_Tai_Lue": {}, "Newa": {}, "Nko": {},
+	"Nyiakeng_Puachue_Hmong": {}, "Ol_Chiki": {}, "Osage": {}, "Saurashtra": {}, "Sundanese": {}, "Syloti_Nagri": {},
+	"Syriac": {}, "Tai_Le": {}, "Tai_Tham": {}, "Tai_Viet": {}, "Tifinagh": {}, "Vai": {}, "Wancho": {}, "Yi": {},
+}
+
+func init() {
+	registerCheck("identifier")
+	registerCheck("identifier-status")
+}
+
+// IsValidIdentifier checks s against the identifier syntax of https://www.unicode.org/reports/tr31/, requiring it to
+// start with an XID_Start character followed by XID_Continue characters, combined with the checks of profile. All
+// violations found are returned.
+func IsValidIdentifier(s string, profile Profile) (bool, []Violation) {
+	if s == "" {
+		return false, []Violation{{Kind: ViolationEmpty, Offset: -1}}
+	}
+
+	var (
+		violations []Violation
+		prevMedial bool
+	)
+
+	for i, r := range s {
+		medial := profile.AllowMedial && strings.ContainsRune(inclusions, r)
+		last := i+utf8.RuneLen(r) == len(s)
+
+		switch {
+		case medial && (i == 0 || last || prevMedial):
+			violations = append(violations, Violation{Kind: ViolationMedial, Offset: i, Rune: r})
+		case medial:
+			// Medial characters are otherwise allowed.
+		case i == 0 && !isXIDStart(r) && !(profile.AllowUnderscoreStart && r == '_'):
+			violations = append(violations, Violation{Kind: ViolationStart, Offset: i, Rune: r})
+		case !isXIDContinue(r):
+			violations = append(violations, Violation{Kind: ViolationContinue, Offset: i, Rune: r})
+		case IdentifierStatus(r) != Allowed:
+			violations = append(violations, Violation{Kind: ViolationRestricted, Offset: i, Rune: r})
+		}
+
+		if profile.RejectConfusables && isConfusable(r) {
+			violations = append(violations, Violation{Kind: ViolationConfusable, Offset: i, Rune: r})
+		}
+
+		prevMedial = medial
+	}
+
+	if RestrictionLevel(s) > profile.RestrictionLevel {
+		violations = append(violations, Violation{Kind: ViolationRestrictionLevel, Offset: -1})
+	}
+
+	return len(violations) == 0, violations
+}
+
+// IdentifierStatus returns whether r is allowed in identifiers.
+func IdentifierStatus(r rune) Status {
+	if identifierData != nil {
+		if unicode.Is(identifierData.allowed, r) {
+			return Allowed
+		}
+
+		return Restricted
+	}
+
+	for _, t := range IdentifierTypes(r) {
+		if t != TypeRecommended && t != TypeInclusion {
+			return Restricted
+		}
+	}
+
+	return Allowed
+}
+
+// IdentifierTypes returns the identifier types of r. A character may have several types, e.g. one which is both
+// Technical and Not_XID.
+//
+// When the generated identifier tables are not compiled in, types are derived from character properties. Derivation
+// cannot determine the Obsolete, Technical and Uncommon_Use types, which require curated data, so characters with
+// those types may be reported as Recommended.
+func IdentifierTypes(r rune) []IdentifierType {
+	var types []IdentifierType
+
+	if identifierData != nil {
+		for _, t := range identifierTypeOrder {
+			if table, ok := identifierData.types[t]; ok && unicode.Is(table, r) {
+				types = append(types, t)
+			}
+		}
+
+		// Unassigned code points are not listed, taking the default type.
+		if len(types) == 0 {
+			types = append(types, TypeNotCharacter)
+		}
+
+		return types
+	}
+
+	if strings.ContainsRune(inclusions, r) {
+		return []IdentifierType{TypeInclusion}
+	}
+
+	if !isAssigned(r) || unicode.In(r, unicode.Co, unicode.Cs, unicode.Noncharacter_Code_Point) {
+		return []IdentifierType{TypeNotCharacter}
+	}
+
+	if unicode.Is(unicode.Deprecated, r) {
+		types = append(types, TypeDeprecated)
+	}
+
+	if isDefaultIgnorable(r) {
+		types = append(types, TypeDefaultIgnorable)
+	}
+
+	if !norm.NFKC.IsNormalString(string(r)) {
+		types = append(types, TypeNotNFKC)
+	}
+
+	if !isXIDContinue(r) {
+		types = append(types, TypeNotXID)
+	}
+
+	script := scriptOf(r)
+	if _, ok := recommendedScripts[script]; !ok && script != scriptCommon && script != scriptInherited {
+		if _, ok := limitedUseScripts[script]; ok {
+			types = append(types, TypeLimitedUse)
+		} else {
+			types = append(types, TypeExclusion)
+		}
+	}
+
+	if len(types) == 0 {
+		types = append(types, TypeRecommended)
+	}
+
+	return types
+}
+
+// Check whether a rune has been assigned a character.
+func isAssigned(r rune) bool {
+	for _, table := range unicode.Categories {
+		if unicode.Is(table, r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Check whether a rune has the Default_Ignorable_Code_Point property, derived as in DerivedCoreProperties.txt.
+func isDefaultIgnorable(r rune) bool {
+	if unicode.In(r, unicode.White_Space, unicode.Prepended_Concatenation_Mark) ||
+		(r >= 0xFFF9 && r <= 0xFFFB) || (r >= 0x13430 && r <= 0x1343F) {
+		return false
+	}
+
+	return unicode.In(r, unicode.Other_Default_Ignorable_Code_Point, unicode.Cf, unicode.Variation_Selector)
+}
+
+// Check whether a rune has the XID_Start property, approximated as for XID_Continue.
+func isXIDStart(r rune) bool {
+	if unicode.In(r, unicode.Pattern_Syntax, unicode.Pattern_White_Space) {
+		return false
+	}
+
+	return unicode.In(r, unicode.L, unicode.Nl, unicode.Other_ID_Start)
+}
+
+// Check whether a rune has the XID_Continue property. This is approximated from the derivation of ID_Continue in
+// https://www.unicode.org/reports/tr31/#Default_Identifier_Syntax.
+func isXIDContinue(r rune) bool {
+	if unicode.In(r, unicode.Pattern_Syntax, unicode.Pattern_White_Space) {
+		return false
+	}
+
+	return unicode.In(r, unicode.L, unicode.Nl, unicode.Mn, unicode.Mc, unicode.Nd, unicode.Pc,
+		unicode.Other_ID_Start, unicode.Other_ID_Continue)
+}
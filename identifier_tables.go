@@ -0,0 +1,1935 @@
+package confusables
+
+// THIS FILE WAS AUTOGENERATED - DO NOT EDIT
+
+// Version: 14.0.0
+
+import "unicode"
+
+func init() {
+	identifierData = &identifierTables{
+		allowed: &unicode.RangeTable{
+			R16: []unicode.Range16{
+				{Lo: 0x0027, Hi: 0x002D, Stride: 6},
+				{Lo: 0x002E, Hi: 0x0030, Stride: 2},
+				{Lo: 0x0031, Hi: 0x003A, Stride: 1},
+				{Lo: 0x0041, Hi: 0x005A, Stride: 1},
+				{Lo: 0x005F, Hi: 0x0061, Stride: 2},
+				{Lo: 0x0062, Hi: 0x007A, Stride: 1},
+				{Lo: 0x00B7, Hi: 0x00C0, Stride: 9},
+				{Lo: 0x00C1, Hi: 0x00D6, Stride: 1},
+				{Lo: 0x00D8, Hi: 0x00F6, Stride: 1},
+				{Lo: 0x00F8, Hi: 0x0131, Stride: 1},
+				{Lo: 0x0134, Hi: 0x013E, Stride: 1},
+				{Lo: 0x0141, Hi: 0x0148, Stride: 1},
+				{Lo: 0x014A, Hi: 0x017E, Stride: 1},
+				{Lo: 0x018F, Hi: 0x01A0, Stride: 17},
+				{Lo: 0x01A1, Hi: 0x01AF, Stride: 14},
+				{Lo: 0x01B0, Hi: 0x01CD, Stride: 29},
+				{Lo: 0x01CE, Hi: 0x01DC, Stride: 1},
+				{Lo: 0x01DE, Hi: 0x01E3, Stride: 1},
+				{Lo: 0x01E6, Hi: 0x01F0, Stride: 1},
+				{Lo: 0x01F4, Hi: 0x01F5, Stride: 1},
+				{Lo: 0x01F8, Hi: 0x021B, Stride: 1},
+				{Lo: 0x021E, Hi: 0x021F, Stride: 1},
+				{Lo: 0x0226, Hi: 0x0233, Stride: 1},
+				{Lo: 0x0259, Hi: 0x02BB, Stride: 98},
+				{Lo: 0x02BC, Hi: 0x02EC, Stride: 48},
+				{Lo: 0x0300, Hi: 0x0304, Stride: 1},
+				{Lo: 0x0306, Hi: 0x030C, Stride: 1},
+				{Lo: 0x030F, Hi: 0x0311, Stride: 1},
+				{Lo: 0x0313, Hi: 0x0314, Stride: 1},
+				{Lo: 0x031B, Hi: 0x0323, Stride: 8},
+				{Lo: 0x0324, Hi: 0x0328, Stride: 1},
+				{Lo: 0x032D, Hi: 0x032E, Stride: 1},
+				{Lo: 0x0330, Hi: 0x0331, Stride: 1},
+				{Lo: 0x0335, Hi: 0x0338, Stride: 3},
+				{Lo: 0x0339, Hi: 0x0342, Stride: 9},
+				{Lo: 0x0345, Hi: 0x0375, Stride: 48},
+				{Lo: 0x037B, Hi: 0x037D, Stride: 1},
+				{Lo: 0x0386, Hi: 0x0388, Stride: 2},
+				{Lo: 0x0389, Hi: 0x038A, Stride: 1},
+				{Lo: 0x038C, Hi: 0x038E, Stride: 2},
+				{Lo: 0x038F, Hi: 0x03A1, Stride: 1},
+				{Lo: 0x03A3, Hi: 0x03CE, Stride: 1},
+				{Lo: 0x03FC, Hi: 0x045F, Stride: 1},
+				{Lo: 0x048A, Hi: 0x04FF, Stride: 1},
+				{Lo: 0x0510, Hi: 0x0529, Stride: 1},
+				{Lo: 0x052E, Hi: 0x052F, Stride: 1},
+				{Lo: 0x0531, Hi: 0x0556, Stride: 1},
+				{Lo: 0x0559, Hi: 0x0561, Stride: 8},
+				{Lo: 0x0562, Hi: 0x0586, Stride: 1},
+				{Lo: 0x058A, Hi: 0x05B4, Stride: 42},
+				{Lo: 0x05D0, Hi: 0x05EA, Stride: 1},
+				{Lo: 0x05EF, Hi: 0x05F4, Stride: 1},
+				{Lo: 0x0620, Hi: 0x063F, Stride: 1},
+				{Lo: 0x0641, Hi: 0x0655, Stride: 1},
+				{Lo: 0x0660, Hi: 0x0669, Stride: 1},
+				{Lo: 0x0670, Hi: 0x0672, Stride: 1},
+				{Lo: 0x0674, Hi: 0x0679, Stride: 5},
+				{Lo: 0x067A, Hi: 0x068D, Stride: 1},
+				{Lo: 0x068F, Hi: 0x06A0, Stride: 1},
+				{Lo: 0x06A2, Hi: 0x06D3, Stride: 1},
+				{Lo: 0x06D5, Hi: 0x06E5, Stride: 16},
+				{Lo: 0x06E6, Hi: 0x06EE, Stride: 8},
+				{Lo: 0x06EF, Hi: 0x06FF, Stride: 1},
+				{Lo: 0x0750, Hi: 0x07B1, Stride: 1},
+				{Lo: 0x0870, Hi: 0x0887, Stride: 1},
+				{Lo: 0x0889, Hi: 0x088E, Stride: 1},
+				{Lo: 0x08A0, Hi: 0x08AC, Stride: 1},
+				{Lo: 0x08B2, Hi: 0x08B5, Stride: 3},
+				{Lo: 0x08B6, Hi: 0x08C9, Stride: 1},
+				{Lo: 0x0901, Hi: 0x094D, Stride: 1},
+				{Lo: 0x094F, Hi: 0x0950, Stride: 1},
+				{Lo: 0x0956, Hi: 0x0957, Stride: 1},
+				{Lo: 0x0960, Hi: 0x0963, Stride: 1},
+				{Lo: 0x0966, Hi: 0x096F, Stride: 1},
+				{Lo: 0x0971, Hi: 0x0977, Stride: 1},
+				{Lo: 0x0979, Hi: 0x097F, Stride: 1},
+				{Lo: 0x0981, Hi: 0x0983, Stride: 1},
+				{Lo: 0x0985, Hi: 0x098C, Stride: 1},
+				{Lo: 0x098F, Hi: 0x0990, Stride: 1},
+				{Lo: 0x0993, Hi: 0x09A8, Stride: 1},
+				{Lo: 0x09AA, Hi: 0x09B0, Stride: 1},
+				{Lo: 0x09B2, Hi: 0x09B6, Stride: 4},
+				{Lo: 0x09B7, Hi: 0x09B9, Stride: 1},
+				{Lo: 0x09BC, Hi: 0x09C4, Stride: 1},
+				{Lo: 0x09C7, Hi: 0x09C8, Stride: 1},
+				{Lo: 0x09CB, Hi: 0x09CE, Stride: 1},
+				{Lo: 0x09D7, Hi: 0x09E0, Stride: 9},
+				{Lo: 0x09E1, Hi: 0x09E3, Stride: 1},
+				{Lo: 0x09E6, Hi: 0x09F1, Stride: 1},
+				{Lo: 0x09FE, Hi: 0x0A01, Stride: 3},
+				{Lo: 0x0A02, Hi: 0x0A03, Stride: 1},
+				{Lo: 0x0A05, Hi: 0x0A0A, Stride: 1},
+				{Lo: 0x0A0F, Hi: 0x0A10, Stride: 1},
+				{Lo: 0x0A13, Hi: 0x0A28, Stride: 1},
+				{Lo: 0x0A2A, Hi: 0x0A30, Stride: 1},
+				{Lo: 0x0A32, Hi: 0x0A38, Stride: 3},
+				{Lo: 0x0A39, Hi: 0x0A3C, Stride: 3},
+				{Lo: 0x0A3E, Hi: 0x0A42, Stride: 1},
+				{Lo: 0x0A47, Hi: 0x0A48, Stride: 1},
+				{Lo: 0x0A4B, Hi: 0x0A4D, Stride: 1},
+				{Lo: 0x0A5C, Hi: 0x0A66, Stride: 10},
+				{Lo: 0x0A67, Hi: 0x0A74, Stride: 1},
+				{Lo: 0x0A81, Hi: 0x0A83, Stride: 1},
+				{Lo: 0x0A85, Hi: 0x0A8D, Stride: 1},
+				{Lo: 0x0A8F, Hi: 0x0A91, Stride: 1},
+				{Lo: 0x0A93, Hi: 0x0AA8, Stride: 1},
+				{Lo: 0x0AAA, Hi: 0x0AB0, Stride: 1},
+				{Lo: 0x0AB2, Hi: 0x0AB3, Stride: 1},
+				{Lo: 0x0AB5, Hi: 0x0AB9, Stride: 1},
+				{Lo: 0x0ABC, Hi: 0x0AC5, Stride: 1},
+				{Lo: 0x0AC7, Hi: 0x0AC9, Stride: 1},
+				{Lo: 0x0ACB, Hi: 0x0ACD, Stride: 1},
+				{Lo: 0x0AD0, Hi: 0x0AE0, Stride: 16},
+				{Lo: 0x0AE1, Hi: 0x0AE3, Stride: 1},
+				{Lo: 0x0AE6, Hi: 0x0AEF, Stride: 1},
+				{Lo: 0x0AFA, Hi: 0x0AFF, Stride: 1},
+				{Lo: 0x0B01, Hi: 0x0B03, Stride: 1},
+				{Lo: 0x0B05, Hi: 0x0B0C, Stride: 1},
+				{Lo: 0x0B0F, Hi: 0x0B10, Stride: 1},
+				{Lo: 0x0B13, Hi: 0x0B28, Stride: 1},
+				{Lo: 0x0B2A, Hi: 0x0B30, Stride: 1},
+				{Lo: 0x0B32, Hi: 0x0B33, Stride: 1},
+				{Lo: 0x0B35, Hi: 0x0B39, Stride: 1},
+				{Lo: 0x0B3C, Hi: 0x0B43, Stride: 1},
+				{Lo: 0x0B47, Hi: 0x0B48, Stride: 1},
+				{Lo: 0x0B4B, Hi: 0x0B4D, Stride: 1},
+				{Lo: 0x0B55, Hi: 0x0B57, Stride: 1},
+				{Lo: 0x0B5F, Hi: 0x0B61, Stride: 1},
+				{Lo: 0x0B66, Hi: 0x0B6F, Stride: 1},
+				{Lo: 0x0B71, Hi: 0x0B82, Stride: 17},
+				{Lo: 0x0B83, Hi: 0x0B85, Stride: 2},
+				{Lo: 0x0B86, Hi: 0x0B8A, Stride: 1},
+				{Lo: 0x0B8E, Hi: 0x0B90, Stride: 1},
+				{Lo: 0x0B92, Hi: 0x0B95, Stride: 1},
+				{Lo: 0x0B99, Hi: 0x0B9A, Stride: 1},
+				{Lo: 0x0B9C, Hi: 0x0B9E, Stride: 2},
+				{Lo: 0x0B9F, Hi: 0x0BA3, Stride: 4},
+				{Lo: 0x0BA4, Hi: 0x0BA8, Stride: 4},
+				{Lo: 0x0BA9, Hi: 0x0BAA, Stride: 1},
+				{Lo: 0x0BAE, Hi: 0x0BB9, Stride: 1},
+				{Lo: 0x0BBE, Hi: 0x0BC2, Stride: 1},
+				{Lo: 0x0BC6, Hi: 0x0BC8, Stride: 1},
+				{Lo: 0x0BCA, Hi: 0x0BCD, Stride: 1},
+				{Lo: 0x0BD0, Hi: 0x0BD7, Stride: 7},
+				{Lo: 0x0BE6, Hi: 0x0BEF, Stride: 1},
+				{Lo: 0x0C01, Hi: 0x0C0C, Stride: 1},
+				{Lo: 0x0C0E, Hi: 0x0C10, Stride: 1},
+				{Lo: 0x0C12, Hi: 0x0C28, Stride: 1},
+				{Lo: 0x0C2A, Hi: 0x0C33, Stride: 1},
+				{Lo: 0x0C35, Hi: 0x0C39, Stride: 1},
+				{Lo: 0x0C3C, Hi: 0x0C44, Stride: 1},
+				{Lo: 0x0C46, Hi: 0x0C48, Stride: 1},
+				{Lo: 0x0C4A, Hi: 0x0C4D, Stride: 1},
+				{Lo: 0x0C55, Hi: 0x0C56, Stride: 1},
+				{Lo: 0x0C5D, Hi: 0x0C60, Stride: 3},
+				{Lo: 0x0C61, Hi: 0x0C66, Stride: 5},
+				{Lo: 0x0C67, Hi: 0x0C6F, Stride: 1},
+				{Lo: 0x0C80, Hi: 0x0C82, Stride: 2},
+				{Lo: 0x0C83, Hi: 0x0C85, Stride: 2},
+				{Lo: 0x0C86, Hi: 0x0C8C, Stride: 1},
+				{Lo: 0x0C8E, Hi: 0x0C90, Stride: 1},
+				{Lo: 0x0C92, Hi: 0x0CA8, Stride: 1},
+				{Lo: 0x0CAA, Hi: 0x0CB3, Stride: 1},
+				{Lo: 0x0CB5, Hi: 0x0CB9, Stride: 1},
+				{Lo: 0x0CBC, Hi: 0x0CC4, Stride: 1},
+				{Lo: 0x0CC6, Hi: 0x0CC8, Stride: 1},
+				{Lo: 0x0CCA, Hi: 0x0CCD, Stride: 1},
+				{Lo: 0x0CD5, Hi: 0x0CD6, Stride: 1},
+				{Lo: 0x0CDD, Hi: 0x0CE0, Stride: 3},
+				{Lo: 0x0CE1, Hi: 0x0CE3, Stride: 1},
+				{Lo: 0x0CE6, Hi: 0x0CEF, Stride: 1},
+				{Lo: 0x0CF1, Hi: 0x0CF2, Stride: 1},
+				{Lo: 0x0D00, Hi: 0x0D02, Stride: 2},
+				{Lo: 0x0D03, Hi: 0x0D05, Stride: 2},
+				{Lo: 0x0D06, Hi: 0x0D0C, Stride: 1},
+				{Lo: 0x0D0E, Hi: 0x0D10, Stride: 1},
+				{Lo: 0x0D12, Hi: 0x0D3A, Stride: 1},
+				{Lo: 0x0D3D, Hi: 0x0D43, Stride: 1},
+				{Lo: 0x0D46, Hi: 0x0D48, Stride: 1},
+				{Lo: 0x0D4A, Hi: 0x0D4E, Stride: 1},
+				{Lo: 0x0D54, Hi: 0x0D57, Stride: 1},
+				{Lo: 0x0D60, Hi: 0x0D61, Stride: 1},
+				{Lo: 0x0D66, Hi: 0x0D6F, Stride: 1},
+				{Lo: 0x0D7A, Hi: 0x0D7F, Stride: 1},
+				{Lo: 0x0D82, Hi: 0x0D83, Stride: 1},
+				{Lo: 0x0D85, Hi: 0x0D8E, Stride: 1},
+				{Lo: 0x0D91, Hi: 0x0D96, Stride: 1},
+				{Lo: 0x0D9A, Hi: 0x0DA5, Stride: 1},
+				{Lo: 0x0DA7, Hi: 0x0DB1, Stride: 1},
+				{Lo: 0x0DB3, Hi: 0x0DBB, Stride: 1},
+				{Lo: 0x0DBD, Hi: 0x0DC0, Stride: 3},
+				{Lo: 0x0DC1, Hi: 0x0DC6, Stride: 1},
+				{Lo: 0x0DCA, Hi: 0x0DCF, Stride: 5},
+				{Lo: 0x0DD0, Hi: 0x0DD4, Stride: 1},
+				{Lo: 0x0DD6, Hi: 0x0DD8, Stride: 2},
+				{Lo: 0x0DD9, Hi: 0x0DDE, Stride: 1},
+				{Lo: 0x0DF2, Hi: 0x0E01, Stride: 15},
+				{Lo: 0x0E02, Hi: 0x0E32, Stride: 1},
+				{Lo: 0x0E34, Hi: 0x0E3A, Stride: 1},
+				{Lo: 0x0E40, Hi: 0x0E4E, Stride: 1},
+				{Lo: 0x0E50, Hi: 0x0E59, Stride: 1},
+				{Lo: 0x0E81, Hi: 0x0E82, Stride: 1},
+				{Lo: 0x0E84, Hi: 0x0E86, Stride: 2},
+				{Lo: 0x0E87, Hi: 0x0E8A, Stride: 1},
+				{Lo: 0x0E8C, Hi: 0x0EA3, Stride: 1},
+				{Lo: 0x0EA5, Hi: 0x0EA7, Stride: 2},
+				{Lo: 0x0EA8, Hi: 0x0EB2, Stride: 1},
+				{Lo: 0x0EB4, Hi: 0x0EBD, Stride: 1},
+				{Lo: 0x0EC0, Hi: 0x0EC4, Stride: 1},
+				{Lo: 0x0EC6, Hi: 0x0EC8, Stride: 2},
+				{Lo: 0x0EC9, Hi: 0x0ECD, Stride: 1},
+				{Lo: 0x0ED0, Hi: 0x0ED9, Stride: 1},
+				{Lo: 0x0EDE, Hi: 0x0EDF, Stride: 1},
+				{Lo: 0x0F00, Hi: 0x0F0B, Stride: 11},
+				{Lo: 0x0F20, Hi: 0x0F29, Stride: 1},
+				{Lo: 0x0F35, Hi: 0x0F37, Stride: 2},
+				{Lo: 0x0F3E, Hi: 0x0F42, Stride: 1},
+				{Lo: 0x0F44, Hi: 0x0F47, Stride: 1},
+				{Lo: 0x0F49, Hi: 0x0F4C, Stride: 1},
+				{Lo: 0x0F4E, Hi: 0x0F51, Stride: 1},
+				{Lo: 0x0F53, Hi: 0x0F56, Stride: 1},
+				{Lo: 0x0F58, Hi: 0x0F5B, Stride: 1},
+				{Lo: 0x0F5D, Hi: 0x0F68, Stride: 1},
+				{Lo: 0x0F6A, Hi: 0x0F6C, Stride: 1},
+				{Lo: 0x0F71, Hi: 0x0F72, Stride: 1},
+				{Lo: 0x0F74, Hi: 0x0F7A, Stride: 6},
+				{Lo: 0x0F7B, Hi: 0x0F80, Stride: 1},
+				{Lo: 0x0F82, Hi: 0x0F84, Stride: 1},
+				{Lo: 0x0F86, Hi: 0x0F92, Stride: 1},
+				{Lo: 0x0F94, Hi: 0x0F97, Stride: 1},
+				{Lo: 0x0F99, Hi: 0x0F9C, Stride: 1},
+				{Lo: 0x0F9E, Hi: 0x0FA1, Stride: 1},
+				{Lo: 0x0FA3, Hi: 0x0FA6, Stride: 1},
+				{Lo: 0x0FA8, Hi: 0x0FAB, Stride: 1},
+				{Lo: 0x0FAD, Hi: 0x0FB8, Stride: 1},
+				{Lo: 0x0FBA, Hi: 0x0FBC, Stride: 1},
+				{Lo: 0x0FC6, Hi: 0x1000, Stride: 58},
+				{Lo: 0x1001, Hi: 0x1049, Stride: 1},
+				{Lo: 0x1050, Hi: 0x109D, Stride: 1},
+				{Lo: 0x10C7, Hi: 0x10CD, Stride: 6},
+				{Lo: 0x10D0, Hi: 0x10F0, Stride: 1},
+				{Lo: 0x10F7, Hi: 0x10FA, Stride: 1},
+				{Lo: 0x10FD, Hi: 0x10FF, Stride: 1},
+				{Lo: 0x1200, Hi: 0x1248, Stride: 1},
+				{Lo: 0x124A, Hi: 0x124D, Stride: 1},
+				{Lo: 0x1250, Hi: 0x1256, Stride: 1},
+				{Lo: 0x1258, Hi: 0x125A, Stride: 2},
+				{Lo: 0x125B, Hi: 0x125D, Stride: 1},
+				{Lo: 0x1260, Hi: 0x1288, Stride: 1},
+				{Lo: 0x128A, Hi: 0x128D, Stride: 1},
+				{Lo: 0x1290, Hi: 0x12B0, Stride: 1},
+				{Lo: 0x12B2, Hi: 0x12B5, Stride: 1},
+				{Lo: 0x12B8, Hi: 0x12BE, Stride: 1},
+				{Lo: 0x12C0, Hi: 0x12C2, Stride: 2},
+				{Lo: 0x12C3, Hi: 0x12C5, Stride: 1},
+				{Lo: 0x12C8, Hi: 0x12D6, Stride: 1},
+				{Lo: 0x12D8, Hi: 0x1310, Stride: 1},
+				{Lo: 0x1312, Hi: 0x1315, Stride: 1},
+				{Lo: 0x1318, Hi: 0x135A, Stride: 1},
+				{Lo: 0x135D, Hi: 0x135F, Stride: 1},
+				{Lo: 0x1380, Hi: 0x138F, Stride: 1},
+				{Lo: 0x1780, Hi: 0x17A2, Stride: 1},
+				{Lo: 0x17A5, Hi: 0x17A7, Stride: 1},
+				{Lo: 0x17A9, Hi: 0x17B3, Stride: 1},
+				{Lo: 0x17B6, Hi: 0x17CD, Stride: 1},
+				{Lo: 0x17D0, Hi: 0x17D2, Stride: 2},
+				{Lo: 0x17D7, Hi: 0x17DC, Stride: 5},
+				{Lo: 0x17E0, Hi: 0x17E9, Stride: 1},
+				{Lo: 0x1C90, Hi: 0x1CBA, Stride: 1},
+				{Lo: 0x1CBD, Hi: 0x1CBF, Stride: 1},
+				{Lo: 0x1E00, Hi: 0x1E99, Stride: 1},
+				{Lo: 0x1E9E, Hi: 0x1EA0, Stride: 2},
+				{Lo: 0x1EA1, Hi: 0x1EF9, Stride: 1},
+				{Lo: 0x1F00, Hi: 0x1F15, Stride: 1},
+				{Lo: 0x1F18, Hi: 0x1F1D, Stride: 1},
+				{Lo: 0x1F20, Hi: 0x1F45, Stride: 1},
+				{Lo: 0x1F48, Hi: 0x1F4D, Stride: 1},
+				{Lo: 0x1F50, Hi: 0x1F57, Stride: 1},
+				{Lo: 0x1F59, Hi: 0x1F5F, Stride: 2},
+				{Lo: 0x1F60, Hi: 0x1F70, Stride: 1},
+				{Lo: 0x1F72, Hi: 0x1F7C, Stride: 2},
+				{Lo: 0x1F80, Hi: 0x1FB4, Stride: 1},
+				{Lo: 0x1FB6, Hi: 0x1FBA, Stride: 1},
+				{Lo: 0x1FBC, Hi: 0x1FC2, Stride: 6},
+				{Lo: 0x1FC3, Hi: 0x1FC4, Stride: 1},
+				{Lo: 0x1FC6, Hi: 0x1FC8, Stride: 1},
+				{Lo: 0x1FCA, Hi: 0x1FCC, Stride: 2},
+				{Lo: 0x1FD0, Hi: 0x1FD2, Stride: 1},
+				{Lo: 0x1FD6, Hi: 0x1FDA, Stride: 1},
+				{Lo: 0x1FE0, Hi: 0x1FE2, Stride: 1},
+				{Lo: 0x1FE4, Hi: 0x1FEA, Stride: 1},
+				{Lo: 0x1FEC, Hi: 0x1FF2, Stride: 6},
+				{Lo: 0x1FF3, Hi: 0x1FF4, Stride: 1},
+				{Lo: 0x1FF6, Hi: 0x1FF8, Stride: 1},
+				{Lo: 0x1FFA, Hi: 0x1FFC, Stride: 2},
+				{Lo: 0x200C, Hi: 0x200D, Stride: 1},
+				{Lo: 0x2010, Hi: 0x2019, Stride: 9},
+				{Lo: 0x2027, Hi: 0x2D27, Stride: 3328},
+				{Lo: 0x2D2D, Hi: 0x2D80, Stride: 83},
+				{Lo: 0x2D81, Hi: 0x2D96, Stride: 1},
+				{Lo: 0x2DA0, Hi: 0x2DA6, Stride: 1},
+				{Lo: 0x2DA8, Hi: 0x2DAE, Stride: 1},
+				{Lo: 0x2DB0, Hi: 0x2DB6, Stride: 1},
+				{Lo: 0x2DB8, Hi: 0x2DBE, Stride: 1},
+				{Lo: 0x2DC0, Hi: 0x2DC6, Stride: 1},
+				{Lo: 0x2DC8, Hi: 0x2DCE, Stride: 1},
+				{Lo: 0x2DD0, Hi: 0x2DD6, Stride: 1},
+				{Lo: 0x2DD8, Hi: 0x2DDE, Stride: 1},
+				{Lo: 0x3005, Hi: 0x3007, Stride: 1},
+				{Lo: 0x3041, Hi: 0x3096, Stride: 1},
+				{Lo: 0x3099, Hi: 0x309A, Stride: 1},
+				{Lo: 0x309D, Hi: 0x309E, Stride: 1},
+				{Lo: 0x30A0, Hi: 0x30FE, Stride: 1},
+				{Lo: 0x3105, Hi: 0x312D, Stride: 1},
+				{Lo: 0x312F, Hi: 0x31A0, Stride: 113},
+				{Lo: 0x31A1, Hi: 0x31BF, Stride: 1},
+				{Lo: 0x3400, Hi: 0x4DBF, Stride: 1},
+				{Lo: 0x4E00, Hi: 0x9FFF, Stride: 1},
+				{Lo: 0xA67F, Hi: 0xA717, Stride: 152},
+				{Lo: 0xA718, Hi: 0xA71F, Stride: 1},
+				{Lo: 0xA788, Hi: 0xA792, Stride: 5},
+				{Lo: 0xA793, Hi: 0xA7AA, Stride: 23},
+				{Lo: 0xA7AE, Hi: 0xA7B8, Stride: 10},
+				{Lo: 0xA7B9, Hi: 0xA7C0, Stride: 7},
+				{Lo: 0xA7C1, Hi: 0xA7CA, Stride: 1},
+				{Lo: 0xA7D0, Hi: 0xA7D1, Stride: 1},
+				{Lo: 0xA7D3, Hi: 0xA7D5, Stride: 2},
+				{Lo: 0xA7D6, Hi: 0xA7D9, Stride: 1},
+				{Lo: 0xA9E7, Hi: 0xA9FE, Stride: 1},
+				{Lo: 0xAA60, Hi: 0xAA76, Stride: 1},
+				{Lo: 0xAA7A, Hi: 0xAA7F, Stride: 1},
+				{Lo: 0xAB01, Hi: 0xAB06, Stride: 1},
+				{Lo: 0xAB09, Hi: 0xAB0E, Stride: 1},
+				{Lo: 0xAB11, Hi: 0xAB16, Stride: 1},
+				{Lo: 0xAB20, Hi: 0xAB26, Stride: 1},
+				{Lo: 0xAB28, Hi: 0xAB2E, Stride: 1},
+				{Lo: 0xAB66, Hi: 0xAB67, Stride: 1},
+				{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
+				{Lo: 0xFA0E, Hi: 0xFA0F, Stride: 1},
+				{Lo: 0xFA11, Hi: 0xFA13, Stride: 2},
+				{Lo: 0xFA14, Hi: 0xFA1F, Stride: 11},
+				{Lo: 0xFA21, Hi: 0xFA23, Stride: 2},
+				{Lo: 0xFA24, Hi: 0xFA27, Stride: 3},
+				{Lo: 0xFA28, Hi: 0xFA29, Stride: 1},
+			},
+			R32: []unicode.Range32{
+				{Lo: 0x11301, Hi: 0x11303, Stride: 2},
+				{Lo: 0x1133B, Hi: 0x1133C, Stride: 1},
+				{Lo: 0x16FF0, Hi: 0x16FF1, Stride: 1},
+				{Lo: 0x1B11F, Hi: 0x1B122, Stride: 1},
+				{Lo: 0x1B150, Hi: 0x1B152, Stride: 1},
+				{Lo: 0x1B164, Hi: 0x1B167, Stride: 1},
+				{Lo: 0x1DF00, Hi: 0x1DF1E, Stride: 1},
+				{Lo: 0x1E7E0, Hi: 0x1E7E6, Stride: 1},
+				{Lo: 0x1E7E8, Hi: 0x1E7EB, Stride: 1},
+				{Lo: 0x1E7ED, Hi: 0x1E7EE, Stride: 1},
+				{Lo: 0x1E7F0, Hi: 0x1E7FE, Stride: 1},
+				{Lo: 0x20000, Hi: 0x2A6DF, Stride: 1},
+				{Lo: 0x2A700, Hi: 0x2B738, Stride: 1},
+				{Lo: 0x2B740, Hi: 0x2B81D, Stride: 1},
+				{Lo: 0x2B820, Hi: 0x2CEA1, Stride: 1},
+				{Lo: 0x2CEB0, Hi: 0x2EBE0, Stride: 1},
+				{Lo: 0x30000, Hi: 0x3134A, Stride: 1},
+			},
+			LatinOffset: 9,
+		},
+		types: map[IdentifierType]*unicode.RangeTable{
+			IdentifierType("Default_Ignorable"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x00AD, Hi: 0x034F, Stride: 674},
+					{Lo: 0x061C, Hi: 0x115F, Stride: 2883},
+					{Lo: 0x1160, Hi: 0x17B4, Stride: 1620},
+					{Lo: 0x17B5, Hi: 0x180B, Stride: 86},
+					{Lo: 0x180C, Hi: 0x180F, Stride: 1},
+					{Lo: 0x200B, Hi: 0x200E, Stride: 3},
+					{Lo: 0x200F, Hi: 0x202A, Stride: 27},
+					{Lo: 0x202B, Hi: 0x202E, Stride: 1},
+					{Lo: 0x2060, Hi: 0x2064, Stride: 1},
+					{Lo: 0x2066, Hi: 0x2069, Stride: 1},
+					{Lo: 0x3164, Hi: 0xFE00, Stride: 52380},
+					{Lo: 0xFE01, Hi: 0xFE0F, Stride: 1},
+					{Lo: 0xFEFF, Hi: 0xFFA0, Stride: 161},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0x1BCA0, Hi: 0x1BCA3, Stride: 1},
+					{Lo: 0x1D173, Hi: 0x1D17A, Stride: 1},
+					{Lo: 0xE0020, Hi: 0xE007F, Stride: 1},
+					{Lo: 0xE0100, Hi: 0xE01EF, Stride: 1},
+				},
+			},
+			IdentifierType("Deprecated"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x0149, Hi: 0x0673, Stride: 1322},
+					{Lo: 0x0F77, Hi: 0x0F79, Stride: 2},
+					{Lo: 0x17A3, Hi: 0x17A4, Stride: 1},
+					{Lo: 0x206A, Hi: 0x206F, Stride: 1},
+					{Lo: 0x2329, Hi: 0x232A, Stride: 1},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0xE0001, Hi: 0xE0001, Stride: 1},
+				},
+			},
+			IdentifierType("Exclusion"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x03E2, Hi: 0x03EF, Stride: 1},
+					{Lo: 0x0800, Hi: 0x082D, Stride: 1},
+					{Lo: 0x0830, Hi: 0x083E, Stride: 1},
+					{Lo: 0x1680, Hi: 0x169C, Stride: 1},
+					{Lo: 0x16A0, Hi: 0x16EA, Stride: 1},
+					{Lo: 0x16EE, Hi: 0x16F8, Stride: 1},
+					{Lo: 0x1700, Hi: 0x1715, Stride: 1},
+					{Lo: 0x171F, Hi: 0x1736, Stride: 1},
+					{Lo: 0x1740, Hi: 0x1753, Stride: 1},
+					{Lo: 0x1760, Hi: 0x176C, Stride: 1},
+					{Lo: 0x176E, Hi: 0x1770, Stride: 1},
+					{Lo: 0x1772, Hi: 0x1773, Stride: 1},
+					{Lo: 0x1800, Hi: 0x180A, Stride: 1},
+					{Lo: 0x1810, Hi: 0x1819, Stride: 1},
+					{Lo: 0x1820, Hi: 0x1878, Stride: 1},
+					{Lo: 0x1880, Hi: 0x18AA, Stride: 1},
+					{Lo: 0x1A00, Hi: 0x1A1B, Stride: 1},
+					{Lo: 0x1A1E, Hi: 0x1A1F, Stride: 1},
+					{Lo: 0x1CFA, Hi: 0x2C00, Stride: 3846},
+					{Lo: 0x2C01, Hi: 0x2C5F, Stride: 1},
+					{Lo: 0x2C80, Hi: 0x2CF3, Stride: 1},
+					{Lo: 0x2CF9, Hi: 0x2CFF, Stride: 1},
+					{Lo: 0xA840, Hi: 0xA877, Stride: 1},
+					{Lo: 0xA930, Hi: 0xA953, Stride: 1},
+					{Lo: 0xA95F, Hi: 0xA9CF, Stride: 112},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0x10000, Hi: 0x1000B, Stride: 1},
+					{Lo: 0x1000D, Hi: 0x10026, Stride: 1},
+					{Lo: 0x10028, Hi: 0x1003A, Stride: 1},
+					{Lo: 0x1003C, Hi: 0x1003D, Stride: 1},
+					{Lo: 0x1003F, Hi: 0x1004D, Stride: 1},
+					{Lo: 0x10050, Hi: 0x1005D, Stride: 1},
+					{Lo: 0x10080, Hi: 0x100FA, Stride: 1},
+					{Lo: 0x10100, Hi: 0x10102, Stride: 1},
+					{Lo: 0x10107, Hi: 0x10133, Stride: 1},
+					{Lo: 0x10137, Hi: 0x1013F, Stride: 1},
+					{Lo: 0x10280, Hi: 0x1029C, Stride: 1},
+					{Lo: 0x102A0, Hi: 0x102D0, Stride: 1},
+					{Lo: 0x10300, Hi: 0x10323, Stride: 1},
+					{Lo: 0x1032D, Hi: 0x1034A, Stride: 1},
+					{Lo: 0x10350, Hi: 0x1037A, Stride: 1},
+					{Lo: 0x10380, Hi: 0x1039D, Stride: 1},
+					{Lo: 0x1039F, Hi: 0x103C3, Stride: 1},
+					{Lo: 0x103C8, Hi: 0x103D5, Stride: 1},
+					{Lo: 0x10400, Hi: 0x1049D, Stride: 1},
+					{Lo: 0x104A0, Hi: 0x104A9, Stride: 1},
+					{Lo: 0x10500, Hi: 0x10527, Stride: 1},
+					{Lo: 0x10530, Hi: 0x10563, Stride: 1},
+					{Lo: 0x1056F, Hi: 0x1057A, Stride: 1},
+					{Lo: 0x1057C, Hi: 0x1058A, Stride: 1},
+					{Lo: 0x1058C, Hi: 0x10592, Stride: 1},
+					{Lo: 0x10594, Hi: 0x10595, Stride: 1},
+					{Lo: 0x10597, Hi: 0x105A1, Stride: 1},
+					{Lo: 0x105A3, Hi: 0x105B1, Stride: 1},
+					{Lo: 0x105B3, Hi: 0x105B9, Stride: 1},
+					{Lo: 0x105BB, Hi: 0x105BC, Stride: 1},
+					{Lo: 0x10600, Hi: 0x10736, Stride: 1},
+					{Lo: 0x10740, Hi: 0x10755, Stride: 1},
+					{Lo: 0x10760, Hi: 0x10767, Stride: 1},
+					{Lo: 0x10800, Hi: 0x10805, Stride: 1},
+					{Lo: 0x10808, Hi: 0x1080A, Stride: 2},
+					{Lo: 0x1080B, Hi: 0x10835, Stride: 1},
+					{Lo: 0x10837, Hi: 0x10838, Stride: 1},
+					{Lo: 0x1083C, Hi: 0x1083F, Stride: 3},
+					{Lo: 0x10840, Hi: 0x10855, Stride: 1},
+					{Lo: 0x10857, Hi: 0x1089E, Stride: 1},
+					{Lo: 0x108A7, Hi: 0x108AF, Stride: 1},
+					{Lo: 0x108E0, Hi: 0x108F2, Stride: 1},
+					{Lo: 0x108F4, Hi: 0x108F5, Stride: 1},
+					{Lo: 0x108FB, Hi: 0x1091B, Stride: 1},
+					{Lo: 0x1091F, Hi: 0x10939, Stride: 1},
+					{Lo: 0x1093F, Hi: 0x10980, Stride: 65},
+					{Lo: 0x10981, Hi: 0x109B7, Stride: 1},
+					{Lo: 0x109BC, Hi: 0x109CF, Stride: 1},
+					{Lo: 0x109D2, Hi: 0x10A03, Stride: 1},
+					{Lo: 0x10A05, Hi: 0x10A06, Stride: 1},
+					{Lo: 0x10A0C, Hi: 0x10A13, Stride: 1},
+					{Lo: 0x10A15, Hi: 0x10A17, Stride: 1},
+					{Lo: 0x10A19, Hi: 0x10A35, Stride: 1},
+					{Lo: 0x10A38, Hi: 0x10A3A, Stride: 1},
+					{Lo: 0x10A3F, Hi: 0x10A48, Stride: 1},
+					{Lo: 0x10A50, Hi: 0x10A58, Stride: 1},
+					{Lo: 0x10A60, Hi: 0x10A9F, Stride: 1},
+					{Lo: 0x10AC0, Hi: 0x10AE6, Stride: 1},
+					{Lo: 0x10AEB, Hi: 0x10AF6, Stride: 1},
+					{Lo: 0x10B00, Hi: 0x10B35, Stride: 1},
+					{Lo: 0x10B39, Hi: 0x10B55, Stride: 1},
+					{Lo: 0x10B58, Hi: 0x10B72, Stride: 1},
+					{Lo: 0x10B78, Hi: 0x10B91, Stride: 1},
+					{Lo: 0x10B99, Hi: 0x10B9C, Stride: 1},
+					{Lo: 0x10BA9, Hi: 0x10BAF, Stride: 1},
+					{Lo: 0x10C00, Hi: 0x10C48, Stride: 1},
+					{Lo: 0x10C80, Hi: 0x10CB2, Stride: 1},
+					{Lo: 0x10CC0, Hi: 0x10CF2, Stride: 1},
+					{Lo: 0x10CFA, Hi: 0x10CFF, Stride: 1},
+					{Lo: 0x10E80, Hi: 0x10EA9, Stride: 1},
+					{Lo: 0x10EAB, Hi: 0x10EAD, Stride: 1},
+					{Lo: 0x10EB0, Hi: 0x10EB1, Stride: 1},
+					{Lo: 0x10F00, Hi: 0x10F27, Stride: 1},
+					{Lo: 0x10F30, Hi: 0x10F59, Stride: 1},
+					{Lo: 0x10F70, Hi: 0x10F89, Stride: 1},
+					{Lo: 0x10FB0, Hi: 0x10FCB, Stride: 1},
+					{Lo: 0x10FE0, Hi: 0x10FF6, Stride: 1},
+					{Lo: 0x11000, Hi: 0x1104D, Stride: 1},
+					{Lo: 0x11052, Hi: 0x11075, Stride: 1},
+					{Lo: 0x1107F, Hi: 0x110C2, Stride: 1},
+					{Lo: 0x110CD, Hi: 0x110D0, Stride: 3},
+					{Lo: 0x110D1, Hi: 0x110E8, Stride: 1},
+					{Lo: 0x110F0, Hi: 0x110F9, Stride: 1},
+					{Lo: 0x11150, Hi: 0x11176, Stride: 1},
+					{Lo: 0x11180, Hi: 0x111DF, Stride: 1},
+					{Lo: 0x11200, Hi: 0x11211, Stride: 1},
+					{Lo: 0x11213, Hi: 0x1123E, Stride: 1},
+					{Lo: 0x11280, Hi: 0x11286, Stride: 1},
+					{Lo: 0x11288, Hi: 0x1128A, Stride: 2},
+					{Lo: 0x1128B, Hi: 0x1128D, Stride: 1},
+					{Lo: 0x1128F, Hi: 0x1129D, Stride: 1},
+					{Lo: 0x1129F, Hi: 0x112A9, Stride: 1},
+					{Lo: 0x112B0, Hi: 0x112EA, Stride: 1},
+					{Lo: 0x112F0, Hi: 0x112F9, Stride: 1},
+					{Lo: 0x11300, Hi: 0x11302, Stride: 2},
+					{Lo: 0x11305, Hi: 0x1130C, Stride: 1},
+					{Lo: 0x1130F, Hi: 0x11310, Stride: 1},
+					{Lo: 0x11313, Hi: 0x11328, Stride: 1},
+					{Lo: 0x1132A, Hi: 0x11330, Stride: 1},
+					{Lo: 0x11332, Hi: 0x11333, Stride: 1},
+					{Lo: 0x11335, Hi: 0x11339, Stride: 1},
+					{Lo: 0x1133D, Hi: 0x11344, Stride: 1},
+					{Lo: 0x11347, Hi: 0x11348, Stride: 1},
+					{Lo: 0x1134B, Hi: 0x1134D, Stride: 1},
+					{Lo: 0x11350, Hi: 0x11357, Stride: 7},
+					{Lo: 0x1135D, Hi: 0x11363, Stride: 1},
+					{Lo: 0x11366, Hi: 0x1136C, Stride: 1},
+					{Lo: 0x11370, Hi: 0x11374, Stride: 1},
+					{Lo: 0x11480, Hi: 0x114C7, Stride: 1},
+					{Lo: 0x114D0, Hi: 0x114D9, Stride: 1},
+					{Lo: 0x11580, Hi: 0x115B5, Stride: 1},
+					{Lo: 0x115B8, Hi: 0x115DD, Stride: 1},
+					{Lo: 0x11600, Hi: 0x11644, Stride: 1},
+					{Lo: 0x11650, Hi: 0x11659, Stride: 1},
+					{Lo: 0x11660, Hi: 0x1166C, Stride: 1},
+					{Lo: 0x11680, Hi: 0x116B9, Stride: 1},
+					{Lo: 0x116C0, Hi: 0x116C9, Stride: 1},
+					{Lo: 0x11700, Hi: 0x1171A, Stride: 1},
+					{Lo: 0x1171D, Hi: 0x1172B, Stride: 1},
+					{Lo: 0x11730, Hi: 0x11746, Stride: 1},
+					{Lo: 0x11800, Hi: 0x1183B, Stride: 1},
+					{Lo: 0x118A0, Hi: 0x118F2, Stride: 1},
+					{Lo: 0x118FF, Hi: 0x11906, Stride: 1},
+					{Lo: 0x11909, Hi: 0x1190C, Stride: 3},
+					{Lo: 0x1190D, Hi: 0x11913, Stride: 1},
+					{Lo: 0x11915, Hi: 0x11916, Stride: 1},
+					{Lo: 0x11918, Hi: 0x11935, Stride: 1},
+					{Lo: 0x11937, Hi: 0x11938, Stride: 1},
+					{Lo: 0x1193B, Hi: 0x11946, Stride: 1},
+					{Lo: 0x11950, Hi: 0x11959, Stride: 1},
+					{Lo: 0x119A0, Hi: 0x119A7, Stride: 1},
+					{Lo: 0x119AA, Hi: 0x119D7, Stride: 1},
+					{Lo: 0x119DA, Hi: 0x119E4, Stride: 1},
+					{Lo: 0x11A00, Hi: 0x11A47, Stride: 1},
+					{Lo: 0x11A50, Hi: 0x11AA2, Stride: 1},
+					{Lo: 0x11AC0, Hi: 0x11AF8, Stride: 1},
+					{Lo: 0x11C00, Hi: 0x11C08, Stride: 1},
+					{Lo: 0x11C0A, Hi: 0x11C36, Stride: 1},
+					{Lo: 0x11C38, Hi: 0x11C45, Stride: 1},
+					{Lo: 0x11C50, Hi: 0x11C6C, Stride: 1},
+					{Lo: 0x11C70, Hi: 0x11C8F, Stride: 1},
+					{Lo: 0x11C92, Hi: 0x11CA7, Stride: 1},
+					{Lo: 0x11CA9, Hi: 0x11CB6, Stride: 1},
+					{Lo: 0x11D00, Hi: 0x11D06, Stride: 1},
+					{Lo: 0x11D08, Hi: 0x11D09, Stride: 1},
+					{Lo: 0x11D0B, Hi: 0x11D36, Stride: 1},
+					{Lo: 0x11D3A, Hi: 0x11D3C, Stride: 2},
+					{Lo: 0x11D3D, Hi: 0x11D3F, Stride: 2},
+					{Lo: 0x11D40, Hi: 0x11D47, Stride: 1},
+					{Lo: 0x11D50, Hi: 0x11D59, Stride: 1},
+					{Lo: 0x11EE0, Hi: 0x11EF8, Stride: 1},
+					{Lo: 0x12000, Hi: 0x12399, Stride: 1},
+					{Lo: 0x12400, Hi: 0x1246E, Stride: 1},
+					{Lo: 0x12470, Hi: 0x12474, Stride: 1},
+					{Lo: 0x12480, Hi: 0x12543, Stride: 1},
+					{Lo: 0x12F90, Hi: 0x12FF2, Stride: 1},
+					{Lo: 0x13000, Hi: 0x1342E, Stride: 1},
+					{Lo: 0x13430, Hi: 0x13438, Stride: 1},
+					{Lo: 0x14400, Hi: 0x14646, Stride: 1},
+					{Lo: 0x16A40, Hi: 0x16A5E, Stride: 1},
+					{Lo: 0x16A60, Hi: 0x16A69, Stride: 1},
+					{Lo: 0x16A6E, Hi: 0x16ABE, Stride: 1},
+					{Lo: 0x16AC0, Hi: 0x16AC9, Stride: 1},
+					{Lo: 0x16AD0, Hi: 0x16AED, Stride: 1},
+					{Lo: 0x16AF0, Hi: 0x16AF5, Stride: 1},
+					{Lo: 0x16B00, Hi: 0x16B45, Stride: 1},
+					{Lo: 0x16B50, Hi: 0x16B59, Stride: 1},
+					{Lo: 0x16B5B, Hi: 0x16B61, Stride: 1},
+					{Lo: 0x16B63, Hi: 0x16B77, Stride: 1},
+					{Lo: 0x16B7D, Hi: 0x16B8F, Stride: 1},
+					{Lo: 0x16E40, Hi: 0x16E9A, Stride: 1},
+					{Lo: 0x16FE0, Hi: 0x16FE1, Stride: 1},
+					{Lo: 0x16FE4, Hi: 0x17000, Stride: 28},
+					{Lo: 0x17001, Hi: 0x187F7, Stride: 1},
+					{Lo: 0x18800, Hi: 0x18CD5, Stride: 1},
+					{Lo: 0x18D00, Hi: 0x18D08, Stride: 1},
+					{Lo: 0x1B170, Hi: 0x1B2FB, Stride: 1},
+					{Lo: 0x1BC00, Hi: 0x1BC6A, Stride: 1},
+					{Lo: 0x1BC70, Hi: 0x1BC7C, Stride: 1},
+					{Lo: 0x1BC80, Hi: 0x1BC88, Stride: 1},
+					{Lo: 0x1BC90, Hi: 0x1BC99, Stride: 1},
+					{Lo: 0x1BC9C, Hi: 0x1BC9F, Stride: 1},
+					{Lo: 0x1D800, Hi: 0x1DA8B, Stride: 1},
+					{Lo: 0x1DA9B, Hi: 0x1DA9F, Stride: 1},
+					{Lo: 0x1DAA1, Hi: 0x1DAAF, Stride: 1},
+					{Lo: 0x1E000, Hi: 0x1E006, Stride: 1},
+					{Lo: 0x1E008, Hi: 0x1E018, Stride: 1},
+					{Lo: 0x1E01B, Hi: 0x1E021, Stride: 1},
+					{Lo: 0x1E023, Hi: 0x1E024, Stride: 1},
+					{Lo: 0x1E026, Hi: 0x1E02A, Stride: 1},
+					{Lo: 0x1E290, Hi: 0x1E2AE, Stride: 1},
+					{Lo: 0x1E800, Hi: 0x1E8C4, Stride: 1},
+					{Lo: 0x1E8C7, Hi: 0x1E8D6, Stride: 1},
+				},
+			},
+			IdentifierType("Inclusion"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x0027, Hi: 0x002D, Stride: 6},
+					{Lo: 0x002E, Hi: 0x003A, Stride: 12},
+					{Lo: 0x00B7, Hi: 0x0375, Stride: 702},
+					{Lo: 0x058A, Hi: 0x05F3, Stride: 105},
+					{Lo: 0x05F4, Hi: 0x06FD, Stride: 265},
+					{Lo: 0x06FE, Hi: 0x0F0B, Stride: 2061},
+					{Lo: 0x200C, Hi: 0x200D, Stride: 1},
+					{Lo: 0x2010, Hi: 0x2019, Stride: 9},
+					{Lo: 0x2027, Hi: 0x30A0, Stride: 4217},
+					{Lo: 0x30FB, Hi: 0x30FB, Stride: 1},
+				},
+				LatinOffset: 2,
+			},
+			IdentifierType("Limited_Use"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x0700, Hi: 0x070D, Stride: 1},
+					{Lo: 0x070F, Hi: 0x074A, Stride: 1},
+					{Lo: 0x074D, Hi: 0x074F, Stride: 1},
+					{Lo: 0x07C0, Hi: 0x07FA, Stride: 1},
+					{Lo: 0x07FD, Hi: 0x07FF, Stride: 1},
+					{Lo: 0x0840, Hi: 0x085B, Stride: 1},
+					{Lo: 0x085E, Hi: 0x0860, Stride: 2},
+					{Lo: 0x0861, Hi: 0x086A, Stride: 1},
+					{Lo: 0x13A0, Hi: 0x13F5, Stride: 1},
+					{Lo: 0x13F8, Hi: 0x13FD, Stride: 1},
+					{Lo: 0x1400, Hi: 0x167F, Stride: 1},
+					{Lo: 0x18B0, Hi: 0x18F5, Stride: 1},
+					{Lo: 0x1900, Hi: 0x191E, Stride: 1},
+					{Lo: 0x1920, Hi: 0x192B, Stride: 1},
+					{Lo: 0x1930, Hi: 0x193B, Stride: 1},
+					{Lo: 0x1940, Hi: 0x1944, Stride: 4},
+					{Lo: 0x1945, Hi: 0x196D, Stride: 1},
+					{Lo: 0x1970, Hi: 0x1974, Stride: 1},
+					{Lo: 0x1980, Hi: 0x19AB, Stride: 1},
+					{Lo: 0x19B0, Hi: 0x19C9, Stride: 1},
+					{Lo: 0x19D0, Hi: 0x19DA, Stride: 1},
+					{Lo: 0x19DE, Hi: 0x19DF, Stride: 1},
+					{Lo: 0x1A20, Hi: 0x1A5E, Stride: 1},
+					{Lo: 0x1A60, Hi: 0x1A7C, Stride: 1},
+					{Lo: 0x1A7F, Hi: 0x1A89, Stride: 1},
+					{Lo: 0x1A90, Hi: 0x1A99, Stride: 1},
+					{Lo: 0x1AA0, Hi: 0x1AAD, Stride: 1},
+					{Lo: 0x1B00, Hi: 0x1B4C, Stride: 1},
+					{Lo: 0x1B50, Hi: 0x1B7E, Stride: 1},
+					{Lo: 0x1B80, Hi: 0x1BF3, Stride: 1},
+					{Lo: 0x1BFC, Hi: 0x1C37, Stride: 1},
+					{Lo: 0x1C3B, Hi: 0x1C49, Stride: 1},
+					{Lo: 0x1C4D, Hi: 0x1C7F, Stride: 1},
+					{Lo: 0x1CC0, Hi: 0x1CC7, Stride: 1},
+					{Lo: 0x1DFA, Hi: 0x2D30, Stride: 3894},
+					{Lo: 0x2D31, Hi: 0x2D67, Stride: 1},
+					{Lo: 0x2D70, Hi: 0x2D7F, Stride: 15},
+					{Lo: 0xA000, Hi: 0xA48C, Stride: 1},
+					{Lo: 0xA490, Hi: 0xA4C6, Stride: 1},
+					{Lo: 0xA4D0, Hi: 0xA62B, Stride: 1},
+					{Lo: 0xA6A0, Hi: 0xA6F7, Stride: 1},
+					{Lo: 0xA800, Hi: 0xA82C, Stride: 1},
+					{Lo: 0xA880, Hi: 0xA8C5, Stride: 1},
+					{Lo: 0xA8CE, Hi: 0xA8D9, Stride: 1},
+					{Lo: 0xA900, Hi: 0xA92D, Stride: 1},
+					{Lo: 0xA92F, Hi: 0xA980, Stride: 81},
+					{Lo: 0xA981, Hi: 0xA9CD, Stride: 1},
+					{Lo: 0xA9CF, Hi: 0xA9D9, Stride: 1},
+					{Lo: 0xA9DE, Hi: 0xA9DF, Stride: 1},
+					{Lo: 0xAA00, Hi: 0xAA36, Stride: 1},
+					{Lo: 0xAA40, Hi: 0xAA4D, Stride: 1},
+					{Lo: 0xAA50, Hi: 0xAA59, Stride: 1},
+					{Lo: 0xAA5C, Hi: 0xAA5F, Stride: 1},
+					{Lo: 0xAA80, Hi: 0xAAC2, Stride: 1},
+					{Lo: 0xAADB, Hi: 0xAAF6, Stride: 1},
+					{Lo: 0xAB70, Hi: 0xABED, Stride: 1},
+					{Lo: 0xABF0, Hi: 0xABF9, Stride: 1},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0x104B0, Hi: 0x104D3, Stride: 1},
+					{Lo: 0x104D8, Hi: 0x104FB, Stride: 1},
+					{Lo: 0x10D00, Hi: 0x10D27, Stride: 1},
+					{Lo: 0x10D30, Hi: 0x10D39, Stride: 1},
+					{Lo: 0x11100, Hi: 0x11134, Stride: 1},
+					{Lo: 0x11136, Hi: 0x11147, Stride: 1},
+					{Lo: 0x11400, Hi: 0x1145B, Stride: 1},
+					{Lo: 0x1145D, Hi: 0x11461, Stride: 1},
+					{Lo: 0x11AB0, Hi: 0x11ABF, Stride: 1},
+					{Lo: 0x11D60, Hi: 0x11D65, Stride: 1},
+					{Lo: 0x11D67, Hi: 0x11D68, Stride: 1},
+					{Lo: 0x11D6A, Hi: 0x11D8E, Stride: 1},
+					{Lo: 0x11D90, Hi: 0x11D91, Stride: 1},
+					{Lo: 0x11D93, Hi: 0x11D98, Stride: 1},
+					{Lo: 0x11DA0, Hi: 0x11DA9, Stride: 1},
+					{Lo: 0x11FB0, Hi: 0x16800, Stride: 18512},
+					{Lo: 0x16801, Hi: 0x16A38, Stride: 1},
+					{Lo: 0x16F00, Hi: 0x16F4A, Stride: 1},
+					{Lo: 0x16F4F, Hi: 0x16F87, Stride: 1},
+					{Lo: 0x16F8F, Hi: 0x16F9F, Stride: 1},
+					{Lo: 0x1E100, Hi: 0x1E12C, Stride: 1},
+					{Lo: 0x1E130, Hi: 0x1E13D, Stride: 1},
+					{Lo: 0x1E140, Hi: 0x1E149, Stride: 1},
+					{Lo: 0x1E14E, Hi: 0x1E14F, Stride: 1},
+					{Lo: 0x1E2C0, Hi: 0x1E2F9, Stride: 1},
+					{Lo: 0x1E2FF, Hi: 0x1E900, Stride: 1537},
+					{Lo: 0x1E901, Hi: 0x1E94B, Stride: 1},
+					{Lo: 0x1E950, Hi: 0x1E959, Stride: 1},
+					{Lo: 0x1E95E, Hi: 0x1E95F, Stride: 1},
+				},
+			},
+			IdentifierType("Not_Character"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x0000, Hi: 0x0008, Stride: 1},
+					{Lo: 0x000E, Hi: 0x001F, Stride: 1},
+					{Lo: 0x007F, Hi: 0x0084, Stride: 1},
+					{Lo: 0x0086, Hi: 0x009F, Stride: 1},
+					{Lo: 0xD800, Hi: 0xF8FF, Stride: 1},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0xF0000, Hi: 0xFFFFD, Stride: 1},
+					{Lo: 0x100000, Hi: 0x10FFFD, Stride: 1},
+				},
+				LatinOffset: 4,
+			},
+			IdentifierType("Not_NFKC"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x00A0, Hi: 0x00A8, Stride: 8},
+					{Lo: 0x00AA, Hi: 0x00AF, Stride: 5},
+					{Lo: 0x00B2, Hi: 0x00B5, Stride: 1},
+					{Lo: 0x00B8, Hi: 0x00BA, Stride: 1},
+					{Lo: 0x00BC, Hi: 0x00BE, Stride: 1},
+					{Lo: 0x0132, Hi: 0x0133, Stride: 1},
+					{Lo: 0x013F, Hi: 0x0140, Stride: 1},
+					{Lo: 0x017F, Hi: 0x01C4, Stride: 69},
+					{Lo: 0x01C5, Hi: 0x01CC, Stride: 1},
+					{Lo: 0x01F1, Hi: 0x01F3, Stride: 1},
+					{Lo: 0x02B0, Hi: 0x02B8, Stride: 1},
+					{Lo: 0x02D8, Hi: 0x02DD, Stride: 1},
+					{Lo: 0x02E0, Hi: 0x02E4, Stride: 1},
+					{Lo: 0x0340, Hi: 0x0341, Stride: 1},
+					{Lo: 0x0343, Hi: 0x0344, Stride: 1},
+					{Lo: 0x0374, Hi: 0x037A, Stride: 6},
+					{Lo: 0x037E, Hi: 0x0384, Stride: 6},
+					{Lo: 0x0385, Hi: 0x0387, Stride: 2},
+					{Lo: 0x03D0, Hi: 0x03D6, Stride: 1},
+					{Lo: 0x03F0, Hi: 0x03F2, Stride: 1},
+					{Lo: 0x03F4, Hi: 0x03F5, Stride: 1},
+					{Lo: 0x03F9, Hi: 0x0587, Stride: 398},
+					{Lo: 0x0675, Hi: 0x0678, Stride: 1},
+					{Lo: 0x0958, Hi: 0x095F, Stride: 1},
+					{Lo: 0x09DC, Hi: 0x09DD, Stride: 1},
+					{Lo: 0x09DF, Hi: 0x0A33, Stride: 84},
+					{Lo: 0x0A36, Hi: 0x0A59, Stride: 35},
+					{Lo: 0x0A5A, Hi: 0x0A5B, Stride: 1},
+					{Lo: 0x0A5E, Hi: 0x0B5C, Stride: 254},
+					{Lo: 0x0B5D, Hi: 0x0E33, Stride: 726},
+					{Lo: 0x0EB3, Hi: 0x0EDC, Stride: 41},
+					{Lo: 0x0EDD, Hi: 0x0F0C, Stride: 47},
+					{Lo: 0x0F43, Hi: 0x0F4D, Stride: 10},
+					{Lo: 0x0F52, Hi: 0x0F5C, Stride: 5},
+					{Lo: 0x0F69, Hi: 0x0F73, Stride: 10},
+					{Lo: 0x0F75, Hi: 0x0F76, Stride: 1},
+					{Lo: 0x0F78, Hi: 0x0F81, Stride: 9},
+					{Lo: 0x0F93, Hi: 0x0F9D, Stride: 10},
+					{Lo: 0x0FA2, Hi: 0x0FAC, Stride: 5},
+					{Lo: 0x0FB9, Hi: 0x10FC, Stride: 323},
+					{Lo: 0x1D2C, Hi: 0x1D2E, Stride: 1},
+					{Lo: 0x1D30, Hi: 0x1D3A, Stride: 1},
+					{Lo: 0x1D3C, Hi: 0x1D4D, Stride: 1},
+					{Lo: 0x1D4F, Hi: 0x1D6A, Stride: 1},
+					{Lo: 0x1D78, Hi: 0x1D9B, Stride: 35},
+					{Lo: 0x1D9C, Hi: 0x1DBF, Stride: 1},
+					{Lo: 0x1E9A, Hi: 0x1E9B, Stride: 1},
+					{Lo: 0x1F71, Hi: 0x1F7D, Stride: 2},
+					{Lo: 0x1FBB, Hi: 0x1FBD, Stride: 2},
+					{Lo: 0x1FBE, Hi: 0x1FC1, Stride: 1},
+					{Lo: 0x1FC9, Hi: 0x1FCD, Stride: 2},
+					{Lo: 0x1FCE, Hi: 0x1FCF, Stride: 1},
+					{Lo: 0x1FD3, Hi: 0x1FDB, Stride: 8},
+					{Lo: 0x1FDD, Hi: 0x1FDF, Stride: 1},
+					{Lo: 0x1FE3, Hi: 0x1FEB, Stride: 8},
+					{Lo: 0x1FED, Hi: 0x1FEF, Stride: 1},
+					{Lo: 0x1FF9, Hi: 0x1FFD, Stride: 2},
+					{Lo: 0x1FFE, Hi: 0x2000, Stride: 2},
+					{Lo: 0x2001, Hi: 0x200A, Stride: 1},
+					{Lo: 0x2011, Hi: 0x2017, Stride: 6},
+					{Lo: 0x2024, Hi: 0x2026, Stride: 1},
+					{Lo: 0x202F, Hi: 0x2033, Stride: 4},
+					{Lo: 0x2034, Hi: 0x2036, Stride: 2},
+					{Lo: 0x2037, Hi: 0x203C, Stride: 5},
+					{Lo: 0x203E, Hi: 0x2047, Stride: 9},
+					{Lo: 0x2048, Hi: 0x2049, Stride: 1},
+					{Lo: 0x2057, Hi: 0x205F, Stride: 8},
+					{Lo: 0x2070, Hi: 0x2071, Stride: 1},
+					{Lo: 0x2074, Hi: 0x208E, Stride: 1},
+					{Lo: 0x2090, Hi: 0x209C, Stride: 1},
+					{Lo: 0x20A8, Hi: 0x2100, Stride: 88},
+					{Lo: 0x2101, Hi: 0x2103, Stride: 1},
+					{Lo: 0x2105, Hi: 0x2107, Stride: 1},
+					{Lo: 0x2109, Hi: 0x2113, Stride: 1},
+					{Lo: 0x2115, Hi: 0x2116, Stride: 1},
+					{Lo: 0x2119, Hi: 0x211D, Stride: 1},
+					{Lo: 0x2120, Hi: 0x2122, Stride: 1},
+					{Lo: 0x2124, Hi: 0x212A, Stride: 2},
+					{Lo: 0x212B, Hi: 0x212D, Stride: 1},
+					{Lo: 0x212F, Hi: 0x2131, Stride: 1},
+					{Lo: 0x2133, Hi: 0x2139, Stride: 1},
+					{Lo: 0x213B, Hi: 0x2140, Stride: 1},
+					{Lo: 0x2145, Hi: 0x2149, Stride: 1},
+					{Lo: 0x2150, Hi: 0x217F, Stride: 1},
+					{Lo: 0x2189, Hi: 0x222C, Stride: 163},
+					{Lo: 0x222D, Hi: 0x222F, Stride: 2},
+					{Lo: 0x2230, Hi: 0x2460, Stride: 560},
+					{Lo: 0x2461, Hi: 0x24EA, Stride: 1},
+					{Lo: 0x2A0C, Hi: 0x2A74, Stride: 104},
+					{Lo: 0x2A75, Hi: 0x2A76, Stride: 1},
+					{Lo: 0x2ADC, Hi: 0x2C7C, Stride: 416},
+					{Lo: 0x2C7D, Hi: 0x2D6F, Stride: 242},
+					{Lo: 0x2E9F, Hi: 0x2EF3, Stride: 84},
+					{Lo: 0x2F00, Hi: 0x2FD5, Stride: 1},
+					{Lo: 0x3000, Hi: 0x3036, Stride: 54},
+					{Lo: 0x3038, Hi: 0x303A, Stride: 1},
+					{Lo: 0x309B, Hi: 0x309C, Stride: 1},
+					{Lo: 0x309F, Hi: 0x30FF, Stride: 96},
+					{Lo: 0x3131, Hi: 0x3163, Stride: 1},
+					{Lo: 0x3165, Hi: 0x318E, Stride: 1},
+					{Lo: 0x3192, Hi: 0x319F, Stride: 1},
+					{Lo: 0x3200, Hi: 0x321E, Stride: 1},
+					{Lo: 0x3220, Hi: 0x3247, Stride: 1},
+					{Lo: 0x3250, Hi: 0x327E, Stride: 1},
+					{Lo: 0x3280, Hi: 0x33FF, Stride: 1},
+					{Lo: 0xA69C, Hi: 0xA69D, Stride: 1},
+					{Lo: 0xA770, Hi: 0xA7F2, Stride: 130},
+					{Lo: 0xA7F3, Hi: 0xA7F4, Stride: 1},
+					{Lo: 0xA7F8, Hi: 0xA7F9, Stride: 1},
+					{Lo: 0xAB5C, Hi: 0xAB5F, Stride: 1},
+					{Lo: 0xAB69, Hi: 0xF900, Stride: 19863},
+					{Lo: 0xF901, Hi: 0xFA0D, Stride: 1},
+					{Lo: 0xFA10, Hi: 0xFA12, Stride: 2},
+					{Lo: 0xFA15, Hi: 0xFA1E, Stride: 1},
+					{Lo: 0xFA20, Hi: 0xFA22, Stride: 2},
+					{Lo: 0xFA25, Hi: 0xFA26, Stride: 1},
+					{Lo: 0xFA2A, Hi: 0xFA6D, Stride: 1},
+					{Lo: 0xFA70, Hi: 0xFAD9, Stride: 1},
+					{Lo: 0xFB00, Hi: 0xFB06, Stride: 1},
+					{Lo: 0xFB13, Hi: 0xFB17, Stride: 1},
+					{Lo: 0xFB1D, Hi: 0xFB1F, Stride: 2},
+					{Lo: 0xFB20, Hi: 0xFB36, Stride: 1},
+					{Lo: 0xFB38, Hi: 0xFB3C, Stride: 1},
+					{Lo: 0xFB3E, Hi: 0xFB40, Stride: 2},
+					{Lo: 0xFB41, Hi: 0xFB43, Stride: 2},
+					{Lo: 0xFB44, Hi: 0xFB46, Stride: 2},
+					{Lo: 0xFB47, Hi: 0xFBB1, Stride: 1},
+					{Lo: 0xFBD3, Hi: 0xFD3D, Stride: 1},
+					{Lo: 0xFD50, Hi: 0xFD8F, Stride: 1},
+					{Lo: 0xFD92, Hi: 0xFDC7, Stride: 1},
+					{Lo: 0xFDF0, Hi: 0xFDFC, Stride: 1},
+					{Lo: 0xFE10, Hi: 0xFE19, Stride: 1},
+					{Lo: 0xFE30, Hi: 0xFE44, Stride: 1},
+					{Lo: 0xFE47, Hi: 0xFE52, Stride: 1},
+					{Lo: 0xFE54, Hi: 0xFE66, Stride: 1},
+					{Lo: 0xFE68, Hi: 0xFE6B, Stride: 1},
+					{Lo: 0xFE70, Hi: 0xFE72, Stride: 1},
+					{Lo: 0xFE74, Hi: 0xFE76, Stride: 2},
+					{Lo: 0xFE77, Hi: 0xFEFC, Stride: 1},
+					{Lo: 0xFF01, Hi: 0xFF9F, Stride: 1},
+					{Lo: 0xFFA1, Hi: 0xFFBE, Stride: 1},
+					{Lo: 0xFFC2, Hi: 0xFFC7, Stride: 1},
+					{Lo: 0xFFCA, Hi: 0xFFCF, Stride: 1},
+					{Lo: 0xFFD2, Hi: 0xFFD7, Stride: 1},
+					{Lo: 0xFFDA, Hi: 0xFFDC, Stride: 1},
+					{Lo: 0xFFE0, Hi: 0xFFE6, Stride: 1},
+					{Lo: 0xFFE8, Hi: 0xFFEE, Stride: 1},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0x10781, Hi: 0x10785, Stride: 1},
+					{Lo: 0x10787, Hi: 0x107B0, Stride: 1},
+					{Lo: 0x107B2, Hi: 0x107BA, Stride: 1},
+					{Lo: 0x1D15E, Hi: 0x1D164, Stride: 1},
+					{Lo: 0x1D1BB, Hi: 0x1D1C0, Stride: 1},
+					{Lo: 0x1D400, Hi: 0x1D454, Stride: 1},
+					{Lo: 0x1D456, Hi: 0x1D49C, Stride: 1},
+					{Lo: 0x1D49E, Hi: 0x1D49F, Stride: 1},
+					{Lo: 0x1D4A2, Hi: 0x1D4A5, Stride: 3},
+					{Lo: 0x1D4A6, Hi: 0x1D4A9, Stride: 3},
+					{Lo: 0x1D4AA, Hi: 0x1D4AC, Stride: 1},
+					{Lo: 0x1D4AE, Hi: 0x1D4B9, Stride: 1},
+					{Lo: 0x1D4BB, Hi: 0x1D4BD, Stride: 2},
+					{Lo: 0x1D4BE, Hi: 0x1D4C3, Stride: 1},
+					{Lo: 0x1D4C5, Hi: 0x1D505, Stride: 1},
+					{Lo: 0x1D507, Hi: 0x1D50A, Stride: 1},
+					{Lo: 0x1D50D, Hi: 0x1D514, Stride: 1},
+					{Lo: 0x1D516, Hi: 0x1D51C, Stride: 1},
+					{Lo: 0x1D51E, Hi: 0x1D539, Stride: 1},
+					{Lo: 0x1D53B, Hi: 0x1D53E, Stride: 1},
+					{Lo: 0x1D540, Hi: 0x1D544, Stride: 1},
+					{Lo: 0x1D546, Hi: 0x1D54A, Stride: 4},
+					{Lo: 0x1D54B, Hi: 0x1D550, Stride: 1},
+					{Lo: 0x1D552, Hi: 0x1D6A5, Stride: 1},
+					{Lo: 0x1D6A8, Hi: 0x1D7CB, Stride: 1},
+					{Lo: 0x1D7CE, Hi: 0x1D7FF, Stride: 1},
+					{Lo: 0x1EE00, Hi: 0x1EE03, Stride: 1},
+					{Lo: 0x1EE05, Hi: 0x1EE1F, Stride: 1},
+					{Lo: 0x1EE21, Hi: 0x1EE22, Stride: 1},
+					{Lo: 0x1EE24, Hi: 0x1EE27, Stride: 3},
+					{Lo: 0x1EE29, Hi: 0x1EE32, Stride: 1},
+					{Lo: 0x1EE34, Hi: 0x1EE37, Stride: 1},
+					{Lo: 0x1EE39, Hi: 0x1EE3B, Stride: 2},
+					{Lo: 0x1EE42, Hi: 0x1EE47, Stride: 5},
+					{Lo: 0x1EE49, Hi: 0x1EE4D, Stride: 2},
+					{Lo: 0x1EE4E, Hi: 0x1EE4F, Stride: 1},
+					{Lo: 0x1EE51, Hi: 0x1EE52, Stride: 1},
+					{Lo: 0x1EE54, Hi: 0x1EE57, Stride: 3},
+					{Lo: 0x1EE59, Hi: 0x1EE61, Stride: 2},
+					{Lo: 0x1EE62, Hi: 0x1EE64, Stride: 2},
+					{Lo: 0x1EE67, Hi: 0x1EE6A, Stride: 1},
+					{Lo: 0x1EE6C, Hi: 0x1EE72, Stride: 1},
+					{Lo: 0x1EE74, Hi: 0x1EE77, Stride: 1},
+					{Lo: 0x1EE79, Hi: 0x1EE7C, Stride: 1},
+					{Lo: 0x1EE7E, Hi: 0x1EE80, Stride: 2},
+					{Lo: 0x1EE81, Hi: 0x1EE89, Stride: 1},
+					{Lo: 0x1EE8B, Hi: 0x1EE9B, Stride: 1},
+					{Lo: 0x1EEA1, Hi: 0x1EEA3, Stride: 1},
+					{Lo: 0x1EEA5, Hi: 0x1EEA9, Stride: 1},
+					{Lo: 0x1EEAB, Hi: 0x1EEBB, Stride: 1},
+					{Lo: 0x1F100, Hi: 0x1F10A, Stride: 1},
+					{Lo: 0x1F110, Hi: 0x1F12E, Stride: 1},
+					{Lo: 0x1F130, Hi: 0x1F14F, Stride: 1},
+					{Lo: 0x1F16A, Hi: 0x1F16C, Stride: 1},
+					{Lo: 0x1F190, Hi: 0x1F200, Stride: 112},
+					{Lo: 0x1F201, Hi: 0x1F202, Stride: 1},
+					{Lo: 0x1F210, Hi: 0x1F23B, Stride: 1},
+					{Lo: 0x1F240, Hi: 0x1F248, Stride: 1},
+					{Lo: 0x1F250, Hi: 0x1F251, Stride: 1},
+					{Lo: 0x1FBF0, Hi: 0x1FBF9, Stride: 1},
+					{Lo: 0x2F800, Hi: 0x2FA1D, Stride: 1},
+				},
+				LatinOffset: 5,
+			},
+			IdentifierType("Not_XID"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x0009, Hi: 0x000D, Stride: 1},
+					{Lo: 0x0020, Hi: 0x0026, Stride: 1},
+					{Lo: 0x0028, Hi: 0x002C, Stride: 1},
+					{Lo: 0x002F, Hi: 0x003B, Stride: 12},
+					{Lo: 0x003C, Hi: 0x0040, Stride: 1},
+					{Lo: 0x005B, Hi: 0x005E, Stride: 1},
+					{Lo: 0x0060, Hi: 0x007B, Stride: 27},
+					{Lo: 0x007C, Hi: 0x007E, Stride: 1},
+					{Lo: 0x0085, Hi: 0x00A1, Stride: 28},
+					{Lo: 0x00A2, Hi: 0x00A7, Stride: 1},
+					{Lo: 0x00A9, Hi: 0x00AB, Stride: 2},
+					{Lo: 0x00AC, Hi: 0x00B0, Stride: 2},
+					{Lo: 0x00B1, Hi: 0x00BB, Stride: 5},
+					{Lo: 0x00BF, Hi: 0x00D7, Stride: 24},
+					{Lo: 0x00F7, Hi: 0x02C2, Stride: 459},
+					{Lo: 0x02C3, Hi: 0x02C5, Stride: 1},
+					{Lo: 0x02D2, Hi: 0x02D7, Stride: 1},
+					{Lo: 0x02DE, Hi: 0x02DF, Stride: 1},
+					{Lo: 0x02E5, Hi: 0x02EB, Stride: 1},
+					{Lo: 0x02ED, Hi: 0x02EF, Stride: 2},
+					{Lo: 0x02F0, Hi: 0x02FF, Stride: 1},
+					{Lo: 0x03F6, Hi: 0x0482, Stride: 140},
+					{Lo: 0x0488, Hi: 0x0489, Stride: 1},
+					{Lo: 0x055A, Hi: 0x055F, Stride: 1},
+					{Lo: 0x0589, Hi: 0x058D, Stride: 4},
+					{Lo: 0x058E, Hi: 0x058F, Stride: 1},
+					{Lo: 0x05BE, Hi: 0x05C0, Stride: 2},
+					{Lo: 0x05C3, Hi: 0x05C6, Stride: 3},
+					{Lo: 0x0600, Hi: 0x060F, Stride: 1},
+					{Lo: 0x061B, Hi: 0x061D, Stride: 2},
+					{Lo: 0x061E, Hi: 0x061F, Stride: 1},
+					{Lo: 0x066A, Hi: 0x066D, Stride: 1},
+					{Lo: 0x06D4, Hi: 0x06DD, Stride: 9},
+					{Lo: 0x06DE, Hi: 0x06E9, Stride: 11},
+					{Lo: 0x0700, Hi: 0x070D, Stride: 1},
+					{Lo: 0x070F, Hi: 0x07F6, Stride: 231},
+					{Lo: 0x07F7, Hi: 0x07F9, Stride: 1},
+					{Lo: 0x07FE, Hi: 0x07FF, Stride: 1},
+					{Lo: 0x0830, Hi: 0x083E, Stride: 1},
+					{Lo: 0x085E, Hi: 0x0888, Stride: 42},
+					{Lo: 0x0890, Hi: 0x0891, Stride: 1},
+					{Lo: 0x08E2, Hi: 0x0964, Stride: 130},
+					{Lo: 0x0965, Hi: 0x0970, Stride: 11},
+					{Lo: 0x09F2, Hi: 0x09FB, Stride: 1},
+					{Lo: 0x09FD, Hi: 0x0A76, Stride: 121},
+					{Lo: 0x0AF0, Hi: 0x0AF1, Stride: 1},
+					{Lo: 0x0B70, Hi: 0x0B72, Stride: 2},
+					{Lo: 0x0B73, Hi: 0x0B77, Stride: 1},
+					{Lo: 0x0BF0, Hi: 0x0BFA, Stride: 1},
+					{Lo: 0x0C77, Hi: 0x0C7F, Stride: 1},
+					{Lo: 0x0C84, Hi: 0x0D4F, Stride: 203},
+					{Lo: 0x0D58, Hi: 0x0D5E, Stride: 1},
+					{Lo: 0x0D70, Hi: 0x0D79, Stride: 1},
+					{Lo: 0x0DF4, Hi: 0x0E3F, Stride: 75},
+					{Lo: 0x0E4F, Hi: 0x0E5A, Stride: 11},
+					{Lo: 0x0E5B, Hi: 0x0F01, Stride: 166},
+					{Lo: 0x0F02, Hi: 0x0F0A, Stride: 1},
+					{Lo: 0x0F0D, Hi: 0x0F17, Stride: 1},
+					{Lo: 0x0F1A, Hi: 0x0F1F, Stride: 1},
+					{Lo: 0x0F2A, Hi: 0x0F34, Stride: 1},
+					{Lo: 0x0F36, Hi: 0x0F3A, Stride: 2},
+					{Lo: 0x0F3B, Hi: 0x0F3D, Stride: 1},
+					{Lo: 0x0F85, Hi: 0x0FBE, Stride: 57},
+					{Lo: 0x0FBF, Hi: 0x0FC5, Stride: 1},
+					{Lo: 0x0FC7, Hi: 0x0FCC, Stride: 1},
+					{Lo: 0x0FCE, Hi: 0x0FDA, Stride: 1},
+					{Lo: 0x104A, Hi: 0x104F, Stride: 1},
+					{Lo: 0x109E, Hi: 0x109F, Stride: 1},
+					{Lo: 0x10FB, Hi: 0x1360, Stride: 613},
+					{Lo: 0x1361, Hi: 0x1368, Stride: 1},
+					{Lo: 0x1372, Hi: 0x137C, Stride: 1},
+					{Lo: 0x1390, Hi: 0x1399, Stride: 1},
+					{Lo: 0x1400, Hi: 0x166D, Stride: 621},
+					{Lo: 0x166E, Hi: 0x1680, Stride: 18},
+					{Lo: 0x169B, Hi: 0x169C, Stride: 1},
+					{Lo: 0x16EB, Hi: 0x16ED, Stride: 1},
+					{Lo: 0x1735, Hi: 0x1736, Stride: 1},
+					{Lo: 0x17D4, Hi: 0x17D6, Stride: 1},
+					{Lo: 0x17D8, Hi: 0x17DB, Stride: 1},
+					{Lo: 0x17F0, Hi: 0x17F9, Stride: 1},
+					{Lo: 0x1800, Hi: 0x180A, Stride: 1},
+					{Lo: 0x1940, Hi: 0x1944, Stride: 4},
+					{Lo: 0x1945, Hi: 0x19DE, Stride: 153},
+					{Lo: 0x19DF, Hi: 0x19FF, Stride: 1},
+					{Lo: 0x1A1E, Hi: 0x1A1F, Stride: 1},
+					{Lo: 0x1AA0, Hi: 0x1AA6, Stride: 1},
+					{Lo: 0x1AA8, Hi: 0x1AAD, Stride: 1},
+					{Lo: 0x1ABE, Hi: 0x1B5A, Stride: 156},
+					{Lo: 0x1B5B, Hi: 0x1B6A, Stride: 1},
+					{Lo: 0x1B74, Hi: 0x1B7E, Stride: 1},
+					{Lo: 0x1BFC, Hi: 0x1BFF, Stride: 1},
+					{Lo: 0x1C3B, Hi: 0x1C3F, Stride: 1},
+					{Lo: 0x1C7E, Hi: 0x1C7F, Stride: 1},
+					{Lo: 0x1CC0, Hi: 0x1CC7, Stride: 1},
+					{Lo: 0x1CD3, Hi: 0x2012, Stride: 831},
+					{Lo: 0x2013, Hi: 0x2016, Stride: 1},
+					{Lo: 0x2018, Hi: 0x201A, Stride: 2},
+					{Lo: 0x201B, Hi: 0x2023, Stride: 1},
+					{Lo: 0x2028, Hi: 0x2029, Stride: 1},
+					{Lo: 0x2030, Hi: 0x2032, Stride: 1},
+					{Lo: 0x2035, Hi: 0x2038, Stride: 3},
+					{Lo: 0x2039, Hi: 0x203B, Stride: 1},
+					{Lo: 0x203D, Hi: 0x2041, Stride: 4},
+					{Lo: 0x2042, Hi: 0x2046, Stride: 1},
+					{Lo: 0x204A, Hi: 0x2053, Stride: 1},
+					{Lo: 0x2055, Hi: 0x2056, Stride: 1},
+					{Lo: 0x2058, Hi: 0x205E, Stride: 1},
+					{Lo: 0x20A0, Hi: 0x20A7, Stride: 1},
+					{Lo: 0x20A9, Hi: 0x20C0, Stride: 1},
+					{Lo: 0x20DD, Hi: 0x20E0, Stride: 1},
+					{Lo: 0x20E2, Hi: 0x20E4, Stride: 1},
+					{Lo: 0x2104, Hi: 0x2108, Stride: 4},
+					{Lo: 0x2114, Hi: 0x2117, Stride: 3},
+					{Lo: 0x211E, Hi: 0x211F, Stride: 1},
+					{Lo: 0x2123, Hi: 0x2129, Stride: 2},
+					{Lo: 0x213A, Hi: 0x2141, Stride: 7},
+					{Lo: 0x2142, Hi: 0x2144, Stride: 1},
+					{Lo: 0x214A, Hi: 0x214D, Stride: 1},
+					{Lo: 0x214F, Hi: 0x218A, Stride: 59},
+					{Lo: 0x218B, Hi: 0x2190, Stride: 5},
+					{Lo: 0x2191, Hi: 0x222B, Stride: 1},
+					{Lo: 0x222E, Hi: 0x2231, Stride: 3},
+					{Lo: 0x2232, Hi: 0x2328, Stride: 1},
+					{Lo: 0x232B, Hi: 0x2426, Stride: 1},
+					{Lo: 0x2440, Hi: 0x244A, Stride: 1},
+					{Lo: 0x24EB, Hi: 0x2A0B, Stride: 1},
+					{Lo: 0x2A0D, Hi: 0x2A73, Stride: 1},
+					{Lo: 0x2A77, Hi: 0x2ADB, Stride: 1},
+					{Lo: 0x2ADD, Hi: 0x2B73, Stride: 1},
+					{Lo: 0x2B76, Hi: 0x2B95, Stride: 1},
+					{Lo: 0x2B97, Hi: 0x2BFF, Stride: 1},
+					{Lo: 0x2CE5, Hi: 0x2CEA, Stride: 1},
+					{Lo: 0x2CF9, Hi: 0x2CFF, Stride: 1},
+					{Lo: 0x2D70, Hi: 0x2E00, Stride: 144},
+					{Lo: 0x2E01, Hi: 0x2E5D, Stride: 1},
+					{Lo: 0x2E80, Hi: 0x2E99, Stride: 1},
+					{Lo: 0x2E9B, Hi: 0x2E9E, Stride: 1},
+					{Lo: 0x2EA0, Hi: 0x2EF2, Stride: 1},
+					{Lo: 0x2FF0, Hi: 0x2FFB, Stride: 1},
+					{Lo: 0x3001, Hi: 0x3004, Stride: 1},
+					{Lo: 0x3008, Hi: 0x3020, Stride: 1},
+					{Lo: 0x3030, Hi: 0x3037, Stride: 7},
+					{Lo: 0x303D, Hi: 0x303F, Stride: 1},
+					{Lo: 0x3190, Hi: 0x3191, Stride: 1},
+					{Lo: 0x31C0, Hi: 0x31E3, Stride: 1},
+					{Lo: 0x3248, Hi: 0x324F, Stride: 1},
+					{Lo: 0x327F, Hi: 0x4DC0, Stride: 6977},
+					{Lo: 0x4DC1, Hi: 0x4DFF, Stride: 1},
+					{Lo: 0xA490, Hi: 0xA4C6, Stride: 1},
+					{Lo: 0xA4FE, Hi: 0xA4FF, Stride: 1},
+					{Lo: 0xA60D, Hi: 0xA60F, Stride: 1},
+					{Lo: 0xA670, Hi: 0xA673, Stride: 1},
+					{Lo: 0xA67E, Hi: 0xA6F2, Stride: 116},
+					{Lo: 0xA6F3, Hi: 0xA6F7, Stride: 1},
+					{Lo: 0xA700, Hi: 0xA716, Stride: 1},
+					{Lo: 0xA720, Hi: 0xA721, Stride: 1},
+					{Lo: 0xA789, Hi: 0xA78A, Stride: 1},
+					{Lo: 0xA828, Hi: 0xA82B, Stride: 1},
+					{Lo: 0xA830, Hi: 0xA839, Stride: 1},
+					{Lo: 0xA874, Hi: 0xA877, Stride: 1},
+					{Lo: 0xA8CE, Hi: 0xA8CF, Stride: 1},
+					{Lo: 0xA8F8, Hi: 0xA8FA, Stride: 1},
+					{Lo: 0xA8FC, Hi: 0xA92E, Stride: 50},
+					{Lo: 0xA92F, Hi: 0xA95F, Stride: 48},
+					{Lo: 0xA9C1, Hi: 0xA9CD, Stride: 1},
+					{Lo: 0xA9DE, Hi: 0xA9DF, Stride: 1},
+					{Lo: 0xAA5C, Hi: 0xAA5F, Stride: 1},
+					{Lo: 0xAA77, Hi: 0xAA79, Stride: 1},
+					{Lo: 0xAADE, Hi: 0xAADF, Stride: 1},
+					{Lo: 0xAAF0, Hi: 0xAAF1, Stride: 1},
+					{Lo: 0xAB5B, Hi: 0xAB6A, Stride: 15},
+					{Lo: 0xAB6B, Hi: 0xABEB, Stride: 128},
+					{Lo: 0xFBB2, Hi: 0xFBC2, Stride: 1},
+					{Lo: 0xFD3E, Hi: 0xFD4F, Stride: 1},
+					{Lo: 0xFDCF, Hi: 0xFDFD, Stride: 46},
+					{Lo: 0xFDFE, Hi: 0xFDFF, Stride: 1},
+					{Lo: 0xFE45, Hi: 0xFE46, Stride: 1},
+					{Lo: 0xFFF9, Hi: 0xFFFD, Stride: 1},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0x10100, Hi: 0x10102, Stride: 1},
+					{Lo: 0x10107, Hi: 0x10133, Stride: 1},
+					{Lo: 0x10137, Hi: 0x1013F, Stride: 1},
+					{Lo: 0x10175, Hi: 0x1018E, Stride: 1},
+					{Lo: 0x10190, Hi: 0x1019C, Stride: 1},
+					{Lo: 0x101A0, Hi: 0x101D0, Stride: 48},
+					{Lo: 0x101D1, Hi: 0x101FC, Stride: 1},
+					{Lo: 0x102E1, Hi: 0x102FB, Stride: 1},
+					{Lo: 0x10320, Hi: 0x10323, Stride: 1},
+					{Lo: 0x1039F, Hi: 0x103D0, Stride: 49},
+					{Lo: 0x1056F, Hi: 0x10857, Stride: 744},
+					{Lo: 0x10858, Hi: 0x1085F, Stride: 1},
+					{Lo: 0x10877, Hi: 0x1087F, Stride: 1},
+					{Lo: 0x108A7, Hi: 0x108AF, Stride: 1},
+					{Lo: 0x108FB, Hi: 0x108FF, Stride: 1},
+					{Lo: 0x10916, Hi: 0x1091B, Stride: 1},
+					{Lo: 0x1091F, Hi: 0x1093F, Stride: 32},
+					{Lo: 0x109BC, Hi: 0x109BD, Stride: 1},
+					{Lo: 0x109C0, Hi: 0x109CF, Stride: 1},
+					{Lo: 0x109D2, Hi: 0x109FF, Stride: 1},
+					{Lo: 0x10A40, Hi: 0x10A48, Stride: 1},
+					{Lo: 0x10A50, Hi: 0x10A58, Stride: 1},
+					{Lo: 0x10A7D, Hi: 0x10A7F, Stride: 1},
+					{Lo: 0x10A9D, Hi: 0x10A9F, Stride: 1},
+					{Lo: 0x10AC8, Hi: 0x10AEB, Stride: 35},
+					{Lo: 0x10AEC, Hi: 0x10AF6, Stride: 1},
+					{Lo: 0x10B39, Hi: 0x10B3F, Stride: 1},
+					{Lo: 0x10B58, Hi: 0x10B5F, Stride: 1},
+					{Lo: 0x10B78, Hi: 0x10B7F, Stride: 1},
+					{Lo: 0x10B99, Hi: 0x10B9C, Stride: 1},
+					{Lo: 0x10BA9, Hi: 0x10BAF, Stride: 1},
+					{Lo: 0x10CFA, Hi: 0x10CFF, Stride: 1},
+					{Lo: 0x10E60, Hi: 0x10E7E, Stride: 1},
+					{Lo: 0x10EAD, Hi: 0x10F1D, Stride: 112},
+					{Lo: 0x10F1E, Hi: 0x10F26, Stride: 1},
+					{Lo: 0x10F51, Hi: 0x10F59, Stride: 1},
+					{Lo: 0x10F86, Hi: 0x10F89, Stride: 1},
+					{Lo: 0x10FC5, Hi: 0x10FCB, Stride: 1},
+					{Lo: 0x11047, Hi: 0x1104D, Stride: 1},
+					{Lo: 0x11052, Hi: 0x11065, Stride: 1},
+					{Lo: 0x110BB, Hi: 0x110C1, Stride: 1},
+					{Lo: 0x110CD, Hi: 0x11140, Stride: 115},
+					{Lo: 0x11141, Hi: 0x11143, Stride: 1},
+					{Lo: 0x11174, Hi: 0x11175, Stride: 1},
+					{Lo: 0x111C5, Hi: 0x111C8, Stride: 1},
+					{Lo: 0x111CD, Hi: 0x111DB, Stride: 14},
+					{Lo: 0x111DD, Hi: 0x111DF, Stride: 1},
+					{Lo: 0x111E1, Hi: 0x111F4, Stride: 1},
+					{Lo: 0x11238, Hi: 0x1123D, Stride: 1},
+					{Lo: 0x112A9, Hi: 0x1144B, Stride: 418},
+					{Lo: 0x1144C, Hi: 0x1144F, Stride: 1},
+					{Lo: 0x1145A, Hi: 0x1145B, Stride: 1},
+					{Lo: 0x1145D, Hi: 0x114C6, Stride: 105},
+					{Lo: 0x115C1, Hi: 0x115D7, Stride: 1},
+					{Lo: 0x11641, Hi: 0x11643, Stride: 1},
+					{Lo: 0x11660, Hi: 0x1166C, Stride: 1},
+					{Lo: 0x116B9, Hi: 0x1173A, Stride: 129},
+					{Lo: 0x1173B, Hi: 0x1173F, Stride: 1},
+					{Lo: 0x1183B, Hi: 0x118EA, Stride: 175},
+					{Lo: 0x118EB, Hi: 0x118F2, Stride: 1},
+					{Lo: 0x11944, Hi: 0x11946, Stride: 1},
+					{Lo: 0x119E2, Hi: 0x11A3F, Stride: 93},
+					{Lo: 0x11A40, Hi: 0x11A46, Stride: 1},
+					{Lo: 0x11A9A, Hi: 0x11A9C, Stride: 1},
+					{Lo: 0x11A9E, Hi: 0x11AA2, Stride: 1},
+					{Lo: 0x11C41, Hi: 0x11C45, Stride: 1},
+					{Lo: 0x11C5A, Hi: 0x11C6C, Stride: 1},
+					{Lo: 0x11C70, Hi: 0x11C71, Stride: 1},
+					{Lo: 0x11EF7, Hi: 0x11EF8, Stride: 1},
+					{Lo: 0x11FC0, Hi: 0x11FF1, Stride: 1},
+					{Lo: 0x11FFF, Hi: 0x12470, Stride: 1137},
+					{Lo: 0x12471, Hi: 0x12474, Stride: 1},
+					{Lo: 0x12FF1, Hi: 0x12FF2, Stride: 1},
+					{Lo: 0x13430, Hi: 0x13438, Stride: 1},
+					{Lo: 0x16A6E, Hi: 0x16A6F, Stride: 1},
+					{Lo: 0x16AF5, Hi: 0x16B37, Stride: 66},
+					{Lo: 0x16B38, Hi: 0x16B3F, Stride: 1},
+					{Lo: 0x16B44, Hi: 0x16B45, Stride: 1},
+					{Lo: 0x16B5B, Hi: 0x16B61, Stride: 1},
+					{Lo: 0x16E80, Hi: 0x16E9A, Stride: 1},
+					{Lo: 0x16FE2, Hi: 0x1BC9C, Stride: 19642},
+					{Lo: 0x1BC9F, Hi: 0x1CF50, Stride: 4785},
+					{Lo: 0x1CF51, Hi: 0x1CFC3, Stride: 1},
+					{Lo: 0x1D000, Hi: 0x1D0F5, Stride: 1},
+					{Lo: 0x1D100, Hi: 0x1D126, Stride: 1},
+					{Lo: 0x1D129, Hi: 0x1D15D, Stride: 1},
+					{Lo: 0x1D16A, Hi: 0x1D16C, Stride: 1},
+					{Lo: 0x1D183, Hi: 0x1D184, Stride: 1},
+					{Lo: 0x1D18C, Hi: 0x1D1A9, Stride: 1},
+					{Lo: 0x1D1AE, Hi: 0x1D1BA, Stride: 1},
+					{Lo: 0x1D1C1, Hi: 0x1D1EA, Stride: 1},
+					{Lo: 0x1D200, Hi: 0x1D241, Stride: 1},
+					{Lo: 0x1D245, Hi: 0x1D2E0, Stride: 155},
+					{Lo: 0x1D2E1, Hi: 0x1D2F3, Stride: 1},
+					{Lo: 0x1D300, Hi: 0x1D356, Stride: 1},
+					{Lo: 0x1D360, Hi: 0x1D378, Stride: 1},
+					{Lo: 0x1D800, Hi: 0x1D9FF, Stride: 1},
+					{Lo: 0x1DA37, Hi: 0x1DA3A, Stride: 1},
+					{Lo: 0x1DA6D, Hi: 0x1DA74, Stride: 1},
+					{Lo: 0x1DA76, Hi: 0x1DA83, Stride: 1},
+					{Lo: 0x1DA85, Hi: 0x1DA8B, Stride: 1},
+					{Lo: 0x1E14F, Hi: 0x1E2FF, Stride: 432},
+					{Lo: 0x1E8C7, Hi: 0x1E8CF, Stride: 1},
+					{Lo: 0x1E95E, Hi: 0x1E95F, Stride: 1},
+					{Lo: 0x1EC71, Hi: 0x1ECB4, Stride: 1},
+					{Lo: 0x1ED01, Hi: 0x1ED3D, Stride: 1},
+					{Lo: 0x1EEF0, Hi: 0x1EEF1, Stride: 1},
+					{Lo: 0x1F000, Hi: 0x1F02B, Stride: 1},
+					{Lo: 0x1F030, Hi: 0x1F093, Stride: 1},
+					{Lo: 0x1F0A0, Hi: 0x1F0AE, Stride: 1},
+					{Lo: 0x1F0B1, Hi: 0x1F0BF, Stride: 1},
+					{Lo: 0x1F0C1, Hi: 0x1F0CF, Stride: 1},
+					{Lo: 0x1F0D1, Hi: 0x1F0F5, Stride: 1},
+					{Lo: 0x1F10B, Hi: 0x1F10F, Stride: 1},
+					{Lo: 0x1F12F, Hi: 0x1F150, Stride: 33},
+					{Lo: 0x1F151, Hi: 0x1F169, Stride: 1},
+					{Lo: 0x1F16D, Hi: 0x1F18F, Stride: 1},
+					{Lo: 0x1F191, Hi: 0x1F1AD, Stride: 1},
+					{Lo: 0x1F1E6, Hi: 0x1F1FF, Stride: 1},
+					{Lo: 0x1F260, Hi: 0x1F265, Stride: 1},
+					{Lo: 0x1F300, Hi: 0x1F6D7, Stride: 1},
+					{Lo: 0x1F6DD, Hi: 0x1F6EC, Stride: 1},
+					{Lo: 0x1F6F0, Hi: 0x1F6FC, Stride: 1},
+					{Lo: 0x1F700, Hi: 0x1F773, Stride: 1},
+					{Lo: 0x1F780, Hi: 0x1F7D8, Stride: 1},
+					{Lo: 0x1F7E0, Hi: 0x1F7EB, Stride: 1},
+					{Lo: 0x1F7F0, Hi: 0x1F800, Stride: 16},
+					{Lo: 0x1F801, Hi: 0x1F80B, Stride: 1},
+					{Lo: 0x1F810, Hi: 0x1F847, Stride: 1},
+					{Lo: 0x1F850, Hi: 0x1F859, Stride: 1},
+					{Lo: 0x1F860, Hi: 0x1F887, Stride: 1},
+					{Lo: 0x1F890, Hi: 0x1F8AD, Stride: 1},
+					{Lo: 0x1F8B0, Hi: 0x1F8B1, Stride: 1},
+					{Lo: 0x1F900, Hi: 0x1FA53, Stride: 1},
+					{Lo: 0x1FA60, Hi: 0x1FA6D, Stride: 1},
+					{Lo: 0x1FA70, Hi: 0x1FA74, Stride: 1},
+					{Lo: 0x1FA78, Hi: 0x1FA7C, Stride: 1},
+					{Lo: 0x1FA80, Hi: 0x1FA86, Stride: 1},
+					{Lo: 0x1FA90, Hi: 0x1FAAC, Stride: 1},
+					{Lo: 0x1FAB0, Hi: 0x1FABA, Stride: 1},
+					{Lo: 0x1FAC0, Hi: 0x1FAC5, Stride: 1},
+					{Lo: 0x1FAD0, Hi: 0x1FAD9, Stride: 1},
+					{Lo: 0x1FAE0, Hi: 0x1FAE7, Stride: 1},
+					{Lo: 0x1FAF0, Hi: 0x1FAF6, Stride: 1},
+					{Lo: 0x1FB00, Hi: 0x1FB92, Stride: 1},
+					{Lo: 0x1FB94, Hi: 0x1FBCA, Stride: 1},
+				},
+				LatinOffset: 14,
+			},
+			IdentifierType("Obsolete"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x018D, Hi: 0x01AA, Stride: 29},
+					{Lo: 0x01AB, Hi: 0x01B9, Stride: 14},
+					{Lo: 0x01BA, Hi: 0x01BB, Stride: 1},
+					{Lo: 0x01BE, Hi: 0x01BF, Stride: 1},
+					{Lo: 0x01F6, Hi: 0x01F7, Stride: 1},
+					{Lo: 0x021C, Hi: 0x021D, Stride: 1},
+					{Lo: 0x0277, Hi: 0x027C, Stride: 5},
+					{Lo: 0x029E, Hi: 0x0363, Stride: 197},
+					{Lo: 0x0364, Hi: 0x0373, Stride: 1},
+					{Lo: 0x0376, Hi: 0x0377, Stride: 1},
+					{Lo: 0x037F, Hi: 0x03D8, Stride: 89},
+					{Lo: 0x03D9, Hi: 0x03E1, Stride: 1},
+					{Lo: 0x03F3, Hi: 0x03F7, Stride: 4},
+					{Lo: 0x03F8, Hi: 0x03FA, Stride: 2},
+					{Lo: 0x03FB, Hi: 0x0460, Stride: 101},
+					{Lo: 0x0461, Hi: 0x0489, Stride: 1},
+					{Lo: 0x0500, Hi: 0x050F, Stride: 1},
+					{Lo: 0x052A, Hi: 0x052D, Stride: 1},
+					{Lo: 0x05A2, Hi: 0x05C5, Stride: 35},
+					{Lo: 0x05C6, Hi: 0x0640, Stride: 122},
+					{Lo: 0x066E, Hi: 0x066F, Stride: 1},
+					{Lo: 0x068E, Hi: 0x06A1, Stride: 19},
+					{Lo: 0x07E8, Hi: 0x07EA, Stride: 1},
+					{Lo: 0x07FA, Hi: 0x08AD, Stride: 179},
+					{Lo: 0x08AE, Hi: 0x08B1, Stride: 1},
+					{Lo: 0x094E, Hi: 0x0951, Stride: 3},
+					{Lo: 0x0952, Hi: 0x0978, Stride: 38},
+					{Lo: 0x0980, Hi: 0x09FC, Stride: 124},
+					{Lo: 0x0C00, Hi: 0x0C34, Stride: 52},
+					{Lo: 0x0C58, Hi: 0x0C59, Stride: 1},
+					{Lo: 0x0C81, Hi: 0x0CDE, Stride: 93},
+					{Lo: 0x0D01, Hi: 0x0D04, Stride: 3},
+					{Lo: 0x0D3B, Hi: 0x0D3C, Stride: 1},
+					{Lo: 0x0D5F, Hi: 0x0DE6, Stride: 135},
+					{Lo: 0x0DE7, Hi: 0x0DEF, Stride: 1},
+					{Lo: 0x10A0, Hi: 0x10C5, Stride: 1},
+					{Lo: 0x10F1, Hi: 0x10F6, Stride: 1},
+					{Lo: 0x1100, Hi: 0x115E, Stride: 1},
+					{Lo: 0x1161, Hi: 0x11FF, Stride: 1},
+					{Lo: 0x1369, Hi: 0x1371, Stride: 1},
+					{Lo: 0x17A8, Hi: 0x17D1, Stride: 41},
+					{Lo: 0x17D3, Hi: 0x17DD, Stride: 5},
+					{Lo: 0x1AB0, Hi: 0x1ABD, Stride: 1},
+					{Lo: 0x1C80, Hi: 0x1C88, Stride: 1},
+					{Lo: 0x1CD0, Hi: 0x1CF9, Stride: 1},
+					{Lo: 0x1DC0, Hi: 0x1DC3, Stride: 1},
+					{Lo: 0x1DCE, Hi: 0x1DD1, Stride: 3},
+					{Lo: 0x1DD2, Hi: 0x1DE6, Stride: 1},
+					{Lo: 0x2056, Hi: 0x2058, Stride: 2},
+					{Lo: 0x2059, Hi: 0x205E, Stride: 1},
+					{Lo: 0x2127, Hi: 0x2132, Stride: 11},
+					{Lo: 0x214E, Hi: 0x214F, Stride: 1},
+					{Lo: 0x2180, Hi: 0x2188, Stride: 1},
+					{Lo: 0x2C6D, Hi: 0x2C76, Stride: 1},
+					{Lo: 0x2C7E, Hi: 0x2C7F, Stride: 1},
+					{Lo: 0x2D00, Hi: 0x2D25, Stride: 1},
+					{Lo: 0x2DE0, Hi: 0x2E16, Stride: 1},
+					{Lo: 0x2E2A, Hi: 0x2E32, Stride: 1},
+					{Lo: 0x2E35, Hi: 0x2E39, Stride: 4},
+					{Lo: 0x301E, Hi: 0x302E, Stride: 16},
+					{Lo: 0x302F, Hi: 0x312E, Stride: 255},
+					{Lo: 0x31F0, Hi: 0x31FF, Stride: 1},
+					{Lo: 0xA610, Hi: 0xA612, Stride: 1},
+					{Lo: 0xA62A, Hi: 0xA62B, Stride: 1},
+					{Lo: 0xA640, Hi: 0xA66E, Stride: 1},
+					{Lo: 0xA670, Hi: 0xA67B, Stride: 1},
+					{Lo: 0xA680, Hi: 0xA69B, Stride: 1},
+					{Lo: 0xA69E, Hi: 0xA69F, Stride: 1},
+					{Lo: 0xA700, Hi: 0xA707, Stride: 1},
+					{Lo: 0xA722, Hi: 0xA76F, Stride: 1},
+					{Lo: 0xA771, Hi: 0xA787, Stride: 1},
+					{Lo: 0xA790, Hi: 0xA791, Stride: 1},
+					{Lo: 0xA794, Hi: 0xA7A9, Stride: 1},
+					{Lo: 0xA7AB, Hi: 0xA7AD, Stride: 1},
+					{Lo: 0xA7B0, Hi: 0xA7B1, Stride: 1},
+					{Lo: 0xA7F5, Hi: 0xA7F7, Stride: 1},
+					{Lo: 0xA7FB, Hi: 0xA7FF, Stride: 1},
+					{Lo: 0xA8E0, Hi: 0xA8FF, Stride: 1},
+					{Lo: 0xA960, Hi: 0xA97C, Stride: 1},
+					{Lo: 0xA9E0, Hi: 0xA9E6, Stride: 1},
+					{Lo: 0xAB30, Hi: 0xAB5A, Stride: 1},
+					{Lo: 0xAB64, Hi: 0xAB65, Stride: 1},
+					{Lo: 0xD7B0, Hi: 0xD7C6, Stride: 1},
+					{Lo: 0xD7CB, Hi: 0xD7FB, Stride: 1},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0x10140, Hi: 0x10174, Stride: 1},
+					{Lo: 0x101D0, Hi: 0x101FD, Stride: 1},
+					{Lo: 0x102E0, Hi: 0x102FB, Stride: 1},
+					{Lo: 0x16FE3, Hi: 0x1B000, Stride: 16413},
+					{Lo: 0x1B001, Hi: 0x1B11E, Stride: 1},
+					{Lo: 0x1D200, Hi: 0x1D245, Stride: 1},
+				},
+			},
+			IdentifierType("Recommended"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x0030, Hi: 0x0039, Stride: 1},
+					{Lo: 0x0041, Hi: 0x005A, Stride: 1},
+					{Lo: 0x005F, Hi: 0x0061, Stride: 2},
+					{Lo: 0x0062, Hi: 0x007A, Stride: 1},
+					{Lo: 0x00C0, Hi: 0x00D6, Stride: 1},
+					{Lo: 0x00D8, Hi: 0x00F6, Stride: 1},
+					{Lo: 0x00F8, Hi: 0x0131, Stride: 1},
+					{Lo: 0x0134, Hi: 0x013E, Stride: 1},
+					{Lo: 0x0141, Hi: 0x0148, Stride: 1},
+					{Lo: 0x014A, Hi: 0x017E, Stride: 1},
+					{Lo: 0x018F, Hi: 0x01A0, Stride: 17},
+					{Lo: 0x01A1, Hi: 0x01AF, Stride: 14},
+					{Lo: 0x01B0, Hi: 0x01CD, Stride: 29},
+					{Lo: 0x01CE, Hi: 0x01DC, Stride: 1},
+					{Lo: 0x01DE, Hi: 0x01E3, Stride: 1},
+					{Lo: 0x01E6, Hi: 0x01F0, Stride: 1},
+					{Lo: 0x01F4, Hi: 0x01F5, Stride: 1},
+					{Lo: 0x01F8, Hi: 0x021B, Stride: 1},
+					{Lo: 0x021E, Hi: 0x021F, Stride: 1},
+					{Lo: 0x0226, Hi: 0x0233, Stride: 1},
+					{Lo: 0x0259, Hi: 0x02BB, Stride: 98},
+					{Lo: 0x02BC, Hi: 0x02EC, Stride: 48},
+					{Lo: 0x0300, Hi: 0x0304, Stride: 1},
+					{Lo: 0x0306, Hi: 0x030C, Stride: 1},
+					{Lo: 0x030F, Hi: 0x0311, Stride: 1},
+					{Lo: 0x0313, Hi: 0x0314, Stride: 1},
+					{Lo: 0x031B, Hi: 0x0323, Stride: 8},
+					{Lo: 0x0324, Hi: 0x0328, Stride: 1},
+					{Lo: 0x032D, Hi: 0x032E, Stride: 1},
+					{Lo: 0x0330, Hi: 0x0331, Stride: 1},
+					{Lo: 0x0335, Hi: 0x0338, Stride: 3},
+					{Lo: 0x0339, Hi: 0x0342, Stride: 9},
+					{Lo: 0x0345, Hi: 0x037B, Stride: 54},
+					{Lo: 0x037C, Hi: 0x037D, Stride: 1},
+					{Lo: 0x0386, Hi: 0x0388, Stride: 2},
+					{Lo: 0x0389, Hi: 0x038A, Stride: 1},
+					{Lo: 0x038C, Hi: 0x038E, Stride: 2},
+					{Lo: 0x038F, Hi: 0x03A1, Stride: 1},
+					{Lo: 0x03A3, Hi: 0x03CE, Stride: 1},
+					{Lo: 0x03FC, Hi: 0x045F, Stride: 1},
+					{Lo: 0x048A, Hi: 0x04FF, Stride: 1},
+					{Lo: 0x0510, Hi: 0x0529, Stride: 1},
+					{Lo: 0x052E, Hi: 0x052F, Stride: 1},
+					{Lo: 0x0531, Hi: 0x0556, Stride: 1},
+					{Lo: 0x0559, Hi: 0x0561, Stride: 8},
+					{Lo: 0x0562, Hi: 0x0586, Stride: 1},
+					{Lo: 0x05B4, Hi: 0x05D0, Stride: 28},
+					{Lo: 0x05D1, Hi: 0x05EA, Stride: 1},
+					{Lo: 0x05EF, Hi: 0x05F2, Stride: 1},
+					{Lo: 0x0620, Hi: 0x063F, Stride: 1},
+					{Lo: 0x0641, Hi: 0x0655, Stride: 1},
+					{Lo: 0x0660, Hi: 0x0669, Stride: 1},
+					{Lo: 0x0670, Hi: 0x0672, Stride: 1},
+					{Lo: 0x0674, Hi: 0x0679, Stride: 5},
+					{Lo: 0x067A, Hi: 0x068D, Stride: 1},
+					{Lo: 0x068F, Hi: 0x06A0, Stride: 1},
+					{Lo: 0x06A2, Hi: 0x06D3, Stride: 1},
+					{Lo: 0x06D5, Hi: 0x06E5, Stride: 16},
+					{Lo: 0x06E6, Hi: 0x06EE, Stride: 8},
+					{Lo: 0x06EF, Hi: 0x06FC, Stride: 1},
+					{Lo: 0x06FF, Hi: 0x0750, Stride: 81},
+					{Lo: 0x0751, Hi: 0x07B1, Stride: 1},
+					{Lo: 0x0870, Hi: 0x0887, Stride: 1},
+					{Lo: 0x0889, Hi: 0x088E, Stride: 1},
+					{Lo: 0x08A0, Hi: 0x08AC, Stride: 1},
+					{Lo: 0x08B2, Hi: 0x08B5, Stride: 3},
+					{Lo: 0x08B6, Hi: 0x08C9, Stride: 1},
+					{Lo: 0x0901, Hi: 0x094D, Stride: 1},
+					{Lo: 0x094F, Hi: 0x0950, Stride: 1},
+					{Lo: 0x0956, Hi: 0x0957, Stride: 1},
+					{Lo: 0x0960, Hi: 0x0963, Stride: 1},
+					{Lo: 0x0966, Hi: 0x096F, Stride: 1},
+					{Lo: 0x0971, Hi: 0x0977, Stride: 1},
+					{Lo: 0x0979, Hi: 0x097F, Stride: 1},
+					{Lo: 0x0981, Hi: 0x0983, Stride: 1},
+					{Lo: 0x0985, Hi: 0x098C, Stride: 1},
+					{Lo: 0x098F, Hi: 0x0990, Stride: 1},
+					{Lo: 0x0993, Hi: 0x09A8, Stride: 1},
+					{Lo: 0x09AA, Hi: 0x09B0, Stride: 1},
+					{Lo: 0x09B2, Hi: 0x09B6, Stride: 4},
+					{Lo: 0x09B7, Hi: 0x09B9, Stride: 1},
+					{Lo: 0x09BC, Hi: 0x09C4, Stride: 1},
+					{Lo: 0x09C7, Hi: 0x09C8, Stride: 1},
+					{Lo: 0x09CB, Hi: 0x09CE, Stride: 1},
+					{Lo: 0x09D7, Hi: 0x09E0, Stride: 9},
+					{Lo: 0x09E1, Hi: 0x09E3, Stride: 1},
+					{Lo: 0x09E6, Hi: 0x09F1, Stride: 1},
+					{Lo: 0x09FE, Hi: 0x0A01, Stride: 3},
+					{Lo: 0x0A02, Hi: 0x0A03, Stride: 1},
+					{Lo: 0x0A05, Hi: 0x0A0A, Stride: 1},
+					{Lo: 0x0A0F, Hi: 0x0A10, Stride: 1},
+					{Lo: 0x0A13, Hi: 0x0A28, Stride: 1},
+					{Lo: 0x0A2A, Hi: 0x0A30, Stride: 1},
+					{Lo: 0x0A32, Hi: 0x0A38, Stride: 3},
+					{Lo: 0x0A39, Hi: 0x0A3C, Stride: 3},
+					{Lo: 0x0A3E, Hi: 0x0A42, Stride: 1},
+					{Lo: 0x0A47, Hi: 0x0A48, Stride: 1},
+					{Lo: 0x0A4B, Hi: 0x0A4D, Stride: 1},
+					{Lo: 0x0A5C, Hi: 0x0A66, Stride: 10},
+					{Lo: 0x0A67, Hi: 0x0A74, Stride: 1},
+					{Lo: 0x0A81, Hi: 0x0A83, Stride: 1},
+					{Lo: 0x0A85, Hi: 0x0A8D, Stride: 1},
+					{Lo: 0x0A8F, Hi: 0x0A91, Stride: 1},
+					{Lo: 0x0A93, Hi: 0x0AA8, Stride: 1},
+					{Lo: 0x0AAA, Hi: 0x0AB0, Stride: 1},
+					{Lo: 0x0AB2, Hi: 0x0AB3, Stride: 1},
+					{Lo: 0x0AB5, Hi: 0x0AB9, Stride: 1},
+					{Lo: 0x0ABC, Hi: 0x0AC5, Stride: 1},
+					{Lo: 0x0AC7, Hi: 0x0AC9, Stride: 1},
+					{Lo: 0x0ACB, Hi: 0x0ACD, Stride: 1},
+					{Lo: 0x0AD0, Hi: 0x0AE0, Stride: 16},
+					{Lo: 0x0AE1, Hi: 0x0AE3, Stride: 1},
+					{Lo: 0x0AE6, Hi: 0x0AEF, Stride: 1},
+					{Lo: 0x0AFA, Hi: 0x0AFF, Stride: 1},
+					{Lo: 0x0B01, Hi: 0x0B03, Stride: 1},
+					{Lo: 0x0B05, Hi: 0x0B0C, Stride: 1},
+					{Lo: 0x0B0F, Hi: 0x0B10, Stride: 1},
+					{Lo: 0x0B13, Hi: 0x0B28, Stride: 1},
+					{Lo: 0x0B2A, Hi: 0x0B30, Stride: 1},
+					{Lo: 0x0B32, Hi: 0x0B33, Stride: 1},
+					{Lo: 0x0B35, Hi: 0x0B39, Stride: 1},
+					{Lo: 0x0B3C, Hi: 0x0B43, Stride: 1},
+					{Lo: 0x0B47, Hi: 0x0B48, Stride: 1},
+					{Lo: 0x0B4B, Hi: 0x0B4D, Stride: 1},
+					{Lo: 0x0B55, Hi: 0x0B57, Stride: 1},
+					{Lo: 0x0B5F, Hi: 0x0B61, Stride: 1},
+					{Lo: 0x0B66, Hi: 0x0B6F, Stride: 1},
+					{Lo: 0x0B71, Hi: 0x0B82, Stride: 17},
+					{Lo: 0x0B83, Hi: 0x0B85, Stride: 2},
+					{Lo: 0x0B86, Hi: 0x0B8A, Stride: 1},
+					{Lo: 0x0B8E, Hi: 0x0B90, Stride: 1},
+					{Lo: 0x0B92, Hi: 0x0B95, Stride: 1},
+					{Lo: 0x0B99, Hi: 0x0B9A, Stride: 1},
+					{Lo: 0x0B9C, Hi: 0x0B9E, Stride: 2},
+					{Lo: 0x0B9F, Hi: 0x0BA3, Stride: 4},
+					{Lo: 0x0BA4, Hi: 0x0BA8, Stride: 4},
+					{Lo: 0x0BA9, Hi: 0x0BAA, Stride: 1},
+					{Lo: 0x0BAE, Hi: 0x0BB9, Stride: 1},
+					{Lo: 0x0BBE, Hi: 0x0BC2, Stride: 1},
+					{Lo: 0x0BC6, Hi: 0x0BC8, Stride: 1},
+					{Lo: 0x0BCA, Hi: 0x0BCD, Stride: 1},
+					{Lo: 0x0BD0, Hi: 0x0BD7, Stride: 7},
+					{Lo: 0x0BE6, Hi: 0x0BEF, Stride: 1},
+					{Lo: 0x0C01, Hi: 0x0C0C, Stride: 1},
+					{Lo: 0x0C0E, Hi: 0x0C10, Stride: 1},
+					{Lo: 0x0C12, Hi: 0x0C28, Stride: 1},
+					{Lo: 0x0C2A, Hi: 0x0C33, Stride: 1},
+					{Lo: 0x0C35, Hi: 0x0C39, Stride: 1},
+					{Lo: 0x0C3C, Hi: 0x0C44, Stride: 1},
+					{Lo: 0x0C46, Hi: 0x0C48, Stride: 1},
+					{Lo: 0x0C4A, Hi: 0x0C4D, Stride: 1},
+					{Lo: 0x0C55, Hi: 0x0C56, Stride: 1},
+					{Lo: 0x0C5D, Hi: 0x0C60, Stride: 3},
+					{Lo: 0x0C61, Hi: 0x0C66, Stride: 5},
+					{Lo: 0x0C67, Hi: 0x0C6F, Stride: 1},
+					{Lo: 0x0C80, Hi: 0x0C82, Stride: 2},
+					{Lo: 0x0C83, Hi: 0x0C85, Stride: 2},
+					{Lo: 0x0C86, Hi: 0x0C8C, Stride: 1},
+					{Lo: 0x0C8E, Hi: 0x0C90, Stride: 1},
+					{Lo: 0x0C92, Hi: 0x0CA8, Stride: 1},
+					{Lo: 0x0CAA, Hi: 0x0CB3, Stride: 1},
+					{Lo: 0x0CB5, Hi: 0x0CB9, Stride: 1},
+					{Lo: 0x0CBC, Hi: 0x0CC4, Stride: 1},
+					{Lo: 0x0CC6, Hi: 0x0CC8, Stride: 1},
+					{Lo: 0x0CCA, Hi: 0x0CCD, Stride: 1},
+					{Lo: 0x0CD5, Hi: 0x0CD6, Stride: 1},
+					{Lo: 0x0CDD, Hi: 0x0CE0, Stride: 3},
+					{Lo: 0x0CE1, Hi: 0x0CE3, Stride: 1},
+					{Lo: 0x0CE6, Hi: 0x0CEF, Stride: 1},
+					{Lo: 0x0CF1, Hi: 0x0CF2, Stride: 1},
+					{Lo: 0x0D00, Hi: 0x0D02, Stride: 2},
+					{Lo: 0x0D03, Hi: 0x0D05, Stride: 2},
+					{Lo: 0x0D06, Hi: 0x0D0C, Stride: 1},
+					{Lo: 0x0D0E, Hi: 0x0D10, Stride: 1},
+					{Lo: 0x0D12, Hi: 0x0D3A, Stride: 1},
+					{Lo: 0x0D3D, Hi: 0x0D43, Stride: 1},
+					{Lo: 0x0D46, Hi: 0x0D48, Stride: 1},
+					{Lo: 0x0D4A, Hi: 0x0D4E, Stride: 1},
+					{Lo: 0x0D54, Hi: 0x0D57, Stride: 1},
+					{Lo: 0x0D60, Hi: 0x0D61, Stride: 1},
+					{Lo: 0x0D66, Hi: 0x0D6F, Stride: 1},
+					{Lo: 0x0D7A, Hi: 0x0D7F, Stride: 1},
+					{Lo: 0x0D82, Hi: 0x0D83, Stride: 1},
+					{Lo: 0x0D85, Hi: 0x0D8E, Stride: 1},
+					{Lo: 0x0D91, Hi: 0x0D96, Stride: 1},
+					{Lo: 0x0D9A, Hi: 0x0DA5, Stride: 1},
+					{Lo: 0x0DA7, Hi: 0x0DB1, Stride: 1},
+					{Lo: 0x0DB3, Hi: 0x0DBB, Stride: 1},
+					{Lo: 0x0DBD, Hi: 0x0DC0, Stride: 3},
+					{Lo: 0x0DC1, Hi: 0x0DC6, Stride: 1},
+					{Lo: 0x0DCA, Hi: 0x0DCF, Stride: 5},
+					{Lo: 0x0DD0, Hi: 0x0DD4, Stride: 1},
+					{Lo: 0x0DD6, Hi: 0x0DD8, Stride: 2},
+					{Lo: 0x0DD9, Hi: 0x0DDE, Stride: 1},
+					{Lo: 0x0DF2, Hi: 0x0E01, Stride: 15},
+					{Lo: 0x0E02, Hi: 0x0E32, Stride: 1},
+					{Lo: 0x0E34, Hi: 0x0E3A, Stride: 1},
+					{Lo: 0x0E40, Hi: 0x0E4E, Stride: 1},
+					{Lo: 0x0E50, Hi: 0x0E59, Stride: 1},
+					{Lo: 0x0E81, Hi: 0x0E82, Stride: 1},
+					{Lo: 0x0E84, Hi: 0x0E86, Stride: 2},
+					{Lo: 0x0E87, Hi: 0x0E8A, Stride: 1},
+					{Lo: 0x0E8C, Hi: 0x0EA3, Stride: 1},
+					{Lo: 0x0EA5, Hi: 0x0EA7, Stride: 2},
+					{Lo: 0x0EA8, Hi: 0x0EB2, Stride: 1},
+					{Lo: 0x0EB4, Hi: 0x0EBD, Stride: 1},
+					{Lo: 0x0EC0, Hi: 0x0EC4, Stride: 1},
+					{Lo: 0x0EC6, Hi: 0x0EC8, Stride: 2},
+					{Lo: 0x0EC9, Hi: 0x0ECD, Stride: 1},
+					{Lo: 0x0ED0, Hi: 0x0ED9, Stride: 1},
+					{Lo: 0x0EDE, Hi: 0x0EDF, Stride: 1},
+					{Lo: 0x0F00, Hi: 0x0F20, Stride: 32},
+					{Lo: 0x0F21, Hi: 0x0F29, Stride: 1},
+					{Lo: 0x0F35, Hi: 0x0F37, Stride: 2},
+					{Lo: 0x0F3E, Hi: 0x0F42, Stride: 1},
+					{Lo: 0x0F44, Hi: 0x0F47, Stride: 1},
+					{Lo: 0x0F49, Hi: 0x0F4C, Stride: 1},
+					{Lo: 0x0F4E, Hi: 0x0F51, Stride: 1},
+					{Lo: 0x0F53, Hi: 0x0F56, Stride: 1},
+					{Lo: 0x0F58, Hi: 0x0F5B, Stride: 1},
+					{Lo: 0x0F5D, Hi: 0x0F68, Stride: 1},
+					{Lo: 0x0F6A, Hi: 0x0F6C, Stride: 1},
+					{Lo: 0x0F71, Hi: 0x0F72, Stride: 1},
+					{Lo: 0x0F74, Hi: 0x0F7A, Stride: 6},
+					{Lo: 0x0F7B, Hi: 0x0F80, Stride: 1},
+					{Lo: 0x0F82, Hi: 0x0F84, Stride: 1},
+					{Lo: 0x0F86, Hi: 0x0F92, Stride: 1},
+					{Lo: 0x0F94, Hi: 0x0F97, Stride: 1},
+					{Lo: 0x0F99, Hi: 0x0F9C, Stride: 1},
+					{Lo: 0x0F9E, Hi: 0x0FA1, Stride: 1},
+					{Lo: 0x0FA3, Hi: 0x0FA6, Stride: 1},
+					{Lo: 0x0FA8, Hi: 0x0FAB, Stride: 1},
+					{Lo: 0x0FAD, Hi: 0x0FB8, Stride: 1},
+					{Lo: 0x0FBA, Hi: 0x0FBC, Stride: 1},
+					{Lo: 0x0FC6, Hi: 0x1000, Stride: 58},
+					{Lo: 0x1001, Hi: 0x1049, Stride: 1},
+					{Lo: 0x1050, Hi: 0x109D, Stride: 1},
+					{Lo: 0x10C7, Hi: 0x10CD, Stride: 6},
+					{Lo: 0x10D0, Hi: 0x10F0, Stride: 1},
+					{Lo: 0x10F7, Hi: 0x10FA, Stride: 1},
+					{Lo: 0x10FD, Hi: 0x10FF, Stride: 1},
+					{Lo: 0x1200, Hi: 0x1248, Stride: 1},
+					{Lo: 0x124A, Hi: 0x124D, Stride: 1},
+					{Lo: 0x1250, Hi: 0x1256, Stride: 1},
+					{Lo: 0x1258, Hi: 0x125A, Stride: 2},
+					{Lo: 0x125B, Hi: 0x125D, Stride: 1},
+					{Lo: 0x1260, Hi: 0x1288, Stride: 1},
+					{Lo: 0x128A, Hi: 0x128D, Stride: 1},
+					{Lo: 0x1290, Hi: 0x12B0, Stride: 1},
+					{Lo: 0x12B2, Hi: 0x12B5, Stride: 1},
+					{Lo: 0x12B8, Hi: 0x12BE, Stride: 1},
+					{Lo: 0x12C0, Hi: 0x12C2, Stride: 2},
+					{Lo: 0x12C3, Hi: 0x12C5, Stride: 1},
+					{Lo: 0x12C8, Hi: 0x12D6, Stride: 1},
+					{Lo: 0x12D8, Hi: 0x1310, Stride: 1},
+					{Lo: 0x1312, Hi: 0x1315, Stride: 1},
+					{Lo: 0x1318, Hi: 0x135A, Stride: 1},
+					{Lo: 0x135D, Hi: 0x135F, Stride: 1},
+					{Lo: 0x1380, Hi: 0x138F, Stride: 1},
+					{Lo: 0x1780, Hi: 0x17A2, Stride: 1},
+					{Lo: 0x17A5, Hi: 0x17A7, Stride: 1},
+					{Lo: 0x17A9, Hi: 0x17B3, Stride: 1},
+					{Lo: 0x17B6, Hi: 0x17CD, Stride: 1},
+					{Lo: 0x17D0, Hi: 0x17D2, Stride: 2},
+					{Lo: 0x17D7, Hi: 0x17DC, Stride: 5},
+					{Lo: 0x17E0, Hi: 0x17E9, Stride: 1},
+					{Lo: 0x1C90, Hi: 0x1CBA, Stride: 1},
+					{Lo: 0x1CBD, Hi: 0x1CBF, Stride: 1},
+					{Lo: 0x1E00, Hi: 0x1E99, Stride: 1},
+					{Lo: 0x1E9E, Hi: 0x1EA0, Stride: 2},
+					{Lo: 0x1EA1, Hi: 0x1EF9, Stride: 1},
+					{Lo: 0x1F00, Hi: 0x1F15, Stride: 1},
+					{Lo: 0x1F18, Hi: 0x1F1D, Stride: 1},
+					{Lo: 0x1F20, Hi: 0x1F45, Stride: 1},
+					{Lo: 0x1F48, Hi: 0x1F4D, Stride: 1},
+					{Lo: 0x1F50, Hi: 0x1F57, Stride: 1},
+					{Lo: 0x1F59, Hi: 0x1F5F, Stride: 2},
+					{Lo: 0x1F60, Hi: 0x1F70, Stride: 1},
+					{Lo: 0x1F72, Hi: 0x1F7C, Stride: 2},
+					{Lo: 0x1F80, Hi: 0x1FB4, Stride: 1},
+					{Lo: 0x1FB6, Hi: 0x1FBA, Stride: 1},
+					{Lo: 0x1FBC, Hi: 0x1FC2, Stride: 6},
+					{Lo: 0x1FC3, Hi: 0x1FC4, Stride: 1},
+					{Lo: 0x1FC6, Hi: 0x1FC8, Stride: 1},
+					{Lo: 0x1FCA, Hi: 0x1FCC, Stride: 2},
+					{Lo: 0x1FD0, Hi: 0x1FD2, Stride: 1},
+					{Lo: 0x1FD6, Hi: 0x1FDA, Stride: 1},
+					{Lo: 0x1FE0, Hi: 0x1FE2, Stride: 1},
+					{Lo: 0x1FE4, Hi: 0x1FEA, Stride: 1},
+					{Lo: 0x1FEC, Hi: 0x1FF2, Stride: 6},
+					{Lo: 0x1FF3, Hi: 0x1FF4, Stride: 1},
+					{Lo: 0x1FF6, Hi: 0x1FF8, Stride: 1},
+					{Lo: 0x1FFA, Hi: 0x1FFC, Stride: 2},
+					{Lo: 0x2D27, Hi: 0x2D2D, Stride: 6},
+					{Lo: 0x2D80, Hi: 0x2D96, Stride: 1},
+					{Lo: 0x2DA0, Hi: 0x2DA6, Stride: 1},
+					{Lo: 0x2DA8, Hi: 0x2DAE, Stride: 1},
+					{Lo: 0x2DB0, Hi: 0x2DB6, Stride: 1},
+					{Lo: 0x2DB8, Hi: 0x2DBE, Stride: 1},
+					{Lo: 0x2DC0, Hi: 0x2DC6, Stride: 1},
+					{Lo: 0x2DC8, Hi: 0x2DCE, Stride: 1},
+					{Lo: 0x2DD0, Hi: 0x2DD6, Stride: 1},
+					{Lo: 0x2DD8, Hi: 0x2DDE, Stride: 1},
+					{Lo: 0x3005, Hi: 0x3007, Stride: 1},
+					{Lo: 0x3041, Hi: 0x3096, Stride: 1},
+					{Lo: 0x3099, Hi: 0x309A, Stride: 1},
+					{Lo: 0x309D, Hi: 0x309E, Stride: 1},
+					{Lo: 0x30A1, Hi: 0x30FA, Stride: 1},
+					{Lo: 0x30FC, Hi: 0x30FE, Stride: 1},
+					{Lo: 0x3105, Hi: 0x312D, Stride: 1},
+					{Lo: 0x312F, Hi: 0x31A0, Stride: 113},
+					{Lo: 0x31A1, Hi: 0x31BF, Stride: 1},
+					{Lo: 0x3400, Hi: 0x4DBF, Stride: 1},
+					{Lo: 0x4E00, Hi: 0x9FFF, Stride: 1},
+					{Lo: 0xA67F, Hi: 0xA717, Stride: 152},
+					{Lo: 0xA718, Hi: 0xA71F, Stride: 1},
+					{Lo: 0xA788, Hi: 0xA792, Stride: 5},
+					{Lo: 0xA793, Hi: 0xA7AA, Stride: 23},
+					{Lo: 0xA7AE, Hi: 0xA7B8, Stride: 10},
+					{Lo: 0xA7B9, Hi: 0xA7C0, Stride: 7},
+					{Lo: 0xA7C1, Hi: 0xA7CA, Stride: 1},
+					{Lo: 0xA7D0, Hi: 0xA7D1, Stride: 1},
+					{Lo: 0xA7D3, Hi: 0xA7D5, Stride: 2},
+					{Lo: 0xA7D6, Hi: 0xA7D9, Stride: 1},
+					{Lo: 0xA9E7, Hi: 0xA9FE, Stride: 1},
+					{Lo: 0xAA60, Hi: 0xAA76, Stride: 1},
+					{Lo: 0xAA7A, Hi: 0xAA7F, Stride: 1},
+					{Lo: 0xAB01, Hi: 0xAB06, Stride: 1},
+					{Lo: 0xAB09, Hi: 0xAB0E, Stride: 1},
+					{Lo: 0xAB11, Hi: 0xAB16, Stride: 1},
+					{Lo: 0xAB20, Hi: 0xAB26, Stride: 1},
+					{Lo: 0xAB28, Hi: 0xAB2E, Stride: 1},
+					{Lo: 0xAB66, Hi: 0xAB67, Stride: 1},
+					{Lo: 0xAC00, Hi: 0xD7A3, Stride: 1},
+					{Lo: 0xFA0E, Hi: 0xFA0F, Stride: 1},
+					{Lo: 0xFA11, Hi: 0xFA13, Stride: 2},
+					{Lo: 0xFA14, Hi: 0xFA1F, Stride: 11},
+					{Lo: 0xFA21, Hi: 0xFA23, Stride: 2},
+					{Lo: 0xFA24, Hi: 0xFA27, Stride: 3},
+					{Lo: 0xFA28, Hi: 0xFA29, Stride: 1},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0x11301, Hi: 0x11303, Stride: 2},
+					{Lo: 0x1133B, Hi: 0x1133C, Stride: 1},
+					{Lo: 0x16FF0, Hi: 0x16FF1, Stride: 1},
+					{Lo: 0x1B11F, Hi: 0x1B122, Stride: 1},
+					{Lo: 0x1B150, Hi: 0x1B152, Stride: 1},
+					{Lo: 0x1B164, Hi: 0x1B167, Stride: 1},
+					{Lo: 0x1DF00, Hi: 0x1DF1E, Stride: 1},
+					{Lo: 0x1E7E0, Hi: 0x1E7E6, Stride: 1},
+					{Lo: 0x1E7E8, Hi: 0x1E7EB, Stride: 1},
+					{Lo: 0x1E7ED, Hi: 0x1E7EE, Stride: 1},
+					{Lo: 0x1E7F0, Hi: 0x1E7FE, Stride: 1},
+					{Lo: 0x20000, Hi: 0x2A6DF, Stride: 1},
+					{Lo: 0x2A700, Hi: 0x2B738, Stride: 1},
+					{Lo: 0x2B740, Hi: 0x2B81D, Stride: 1},
+					{Lo: 0x2B820, Hi: 0x2CEA1, Stride: 1},
+					{Lo: 0x2CEB0, Hi: 0x2EBE0, Stride: 1},
+					{Lo: 0x30000, Hi: 0x3134A, Stride: 1},
+				},
+				LatinOffset: 6,
+			},
+			IdentifierType("Technical"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x0180, Hi: 0x018D, Stride: 13},
+					{Lo: 0x01AA, Hi: 0x01AB, Stride: 1},
+					{Lo: 0x01BA, Hi: 0x01BB, Stride: 1},
+					{Lo: 0x01BE, Hi: 0x01C0, Stride: 2},
+					{Lo: 0x01C1, Hi: 0x01C3, Stride: 1},
+					{Lo: 0x0234, Hi: 0x0236, Stride: 1},
+					{Lo: 0x0250, Hi: 0x0258, Stride: 1},
+					{Lo: 0x025A, Hi: 0x02AF, Stride: 1},
+					{Lo: 0x02B9, Hi: 0x02BA, Stride: 1},
+					{Lo: 0x02BD, Hi: 0x02C1, Stride: 1},
+					{Lo: 0x02C6, Hi: 0x02D1, Stride: 1},
+					{Lo: 0x02EE, Hi: 0x030E, Stride: 32},
+					{Lo: 0x0312, Hi: 0x0315, Stride: 3},
+					{Lo: 0x0317, Hi: 0x031A, Stride: 1},
+					{Lo: 0x031C, Hi: 0x0320, Stride: 1},
+					{Lo: 0x0329, Hi: 0x032C, Stride: 1},
+					{Lo: 0x032F, Hi: 0x0337, Stride: 4},
+					{Lo: 0x033A, Hi: 0x033F, Stride: 1},
+					{Lo: 0x0346, Hi: 0x034E, Stride: 1},
+					{Lo: 0x0350, Hi: 0x0357, Stride: 1},
+					{Lo: 0x0359, Hi: 0x0362, Stride: 1},
+					{Lo: 0x03CF, Hi: 0x03D7, Stride: 8},
+					{Lo: 0x03F3, Hi: 0x0484, Stride: 145},
+					{Lo: 0x0485, Hi: 0x0487, Stride: 1},
+					{Lo: 0x0560, Hi: 0x0588, Stride: 40},
+					{Lo: 0x05C7, Hi: 0x0740, Stride: 377},
+					{Lo: 0x0741, Hi: 0x074A, Stride: 1},
+					{Lo: 0x0953, Hi: 0x0954, Stride: 1},
+					{Lo: 0x0D04, Hi: 0x0D81, Stride: 125},
+					{Lo: 0x0D8F, Hi: 0x0D90, Stride: 1},
+					{Lo: 0x0DA6, Hi: 0x0DDF, Stride: 57},
+					{Lo: 0x0DF3, Hi: 0x0F18, Stride: 293},
+					{Lo: 0x0F19, Hi: 0x17CE, Stride: 2229},
+					{Lo: 0x17CF, Hi: 0x17D1, Stride: 2},
+					{Lo: 0x17DD, Hi: 0x1ABF, Stride: 738},
+					{Lo: 0x1AC0, Hi: 0x1B6B, Stride: 171},
+					{Lo: 0x1B6C, Hi: 0x1B73, Stride: 1},
+					{Lo: 0x1D00, Hi: 0x1D2B, Stride: 1},
+					{Lo: 0x1D2F, Hi: 0x1D3B, Stride: 12},
+					{Lo: 0x1D4E, Hi: 0x1D6B, Stride: 29},
+					{Lo: 0x1D6C, Hi: 0x1D77, Stride: 1},
+					{Lo: 0x1D79, Hi: 0x1D9A, Stride: 1},
+					{Lo: 0x1DC0, Hi: 0x1DFF, Stride: 1},
+					{Lo: 0x1E9C, Hi: 0x1E9D, Stride: 1},
+					{Lo: 0x1E9F, Hi: 0x1EFA, Stride: 91},
+					{Lo: 0x1EFB, Hi: 0x1EFF, Stride: 1},
+					{Lo: 0x203F, Hi: 0x2040, Stride: 1},
+					{Lo: 0x20D0, Hi: 0x20F0, Stride: 1},
+					{Lo: 0x2118, Hi: 0x212E, Stride: 22},
+					{Lo: 0x2180, Hi: 0x2183, Stride: 1},
+					{Lo: 0x24EB, Hi: 0x24FF, Stride: 1},
+					{Lo: 0x2800, Hi: 0x28FF, Stride: 1},
+					{Lo: 0x2C60, Hi: 0x2C67, Stride: 1},
+					{Lo: 0x2C77, Hi: 0x2C7B, Stride: 1},
+					{Lo: 0x2CF0, Hi: 0x2CF1, Stride: 1},
+					{Lo: 0x2E00, Hi: 0x2E0D, Stride: 1},
+					{Lo: 0x3021, Hi: 0x302F, Stride: 1},
+					{Lo: 0x3031, Hi: 0x3035, Stride: 1},
+					{Lo: 0x303B, Hi: 0x303C, Stride: 1},
+					{Lo: 0x327F, Hi: 0x4DC0, Stride: 6977},
+					{Lo: 0x4DC1, Hi: 0x4DFF, Stride: 1},
+					{Lo: 0xA708, Hi: 0xA716, Stride: 1},
+					{Lo: 0xA722, Hi: 0xA72F, Stride: 1},
+					{Lo: 0xA78E, Hi: 0xA7AF, Stride: 33},
+					{Lo: 0xA7BA, Hi: 0xA7BF, Stride: 1},
+					{Lo: 0xA7FA, Hi: 0xAB68, Stride: 878},
+					{Lo: 0xFB1E, Hi: 0xFBB2, Stride: 148},
+					{Lo: 0xFBB3, Hi: 0xFBC2, Stride: 1},
+					{Lo: 0xFD3E, Hi: 0xFD4F, Stride: 1},
+					{Lo: 0xFDCF, Hi: 0xFDFD, Stride: 46},
+					{Lo: 0xFDFE, Hi: 0xFDFF, Stride: 1},
+					{Lo: 0xFE20, Hi: 0xFE2F, Stride: 1},
+					{Lo: 0xFE45, Hi: 0xFE46, Stride: 1},
+					{Lo: 0xFE73, Hi: 0xFE73, Stride: 1},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0x1CF00, Hi: 0x1CF2D, Stride: 1},
+					{Lo: 0x1CF30, Hi: 0x1CF46, Stride: 1},
+					{Lo: 0x1CF50, Hi: 0x1CFC3, Stride: 1},
+					{Lo: 0x1D000, Hi: 0x1D0F5, Stride: 1},
+					{Lo: 0x1D100, Hi: 0x1D126, Stride: 1},
+					{Lo: 0x1D129, Hi: 0x1D15D, Stride: 1},
+					{Lo: 0x1D165, Hi: 0x1D172, Stride: 1},
+					{Lo: 0x1D17B, Hi: 0x1D1BA, Stride: 1},
+					{Lo: 0x1D1C1, Hi: 0x1D1EA, Stride: 1},
+					{Lo: 0x1D242, Hi: 0x1D244, Stride: 1},
+					{Lo: 0x1D300, Hi: 0x1D356, Stride: 1},
+				},
+			},
+			IdentifierType("Uncommon_Use"): &unicode.RangeTable{
+				R16: []unicode.Range16{
+					{Lo: 0x0181, Hi: 0x018C, Stride: 1},
+					{Lo: 0x018E, Hi: 0x0190, Stride: 2},
+					{Lo: 0x0191, Hi: 0x019F, Stride: 1},
+					{Lo: 0x01A2, Hi: 0x01A9, Stride: 1},
+					{Lo: 0x01AC, Hi: 0x01AE, Stride: 1},
+					{Lo: 0x01B1, Hi: 0x01B8, Stride: 1},
+					{Lo: 0x01BC, Hi: 0x01BD, Stride: 1},
+					{Lo: 0x01DD, Hi: 0x01E4, Stride: 7},
+					{Lo: 0x01E5, Hi: 0x0220, Stride: 59},
+					{Lo: 0x0221, Hi: 0x0225, Stride: 1},
+					{Lo: 0x0237, Hi: 0x024F, Stride: 1},
+					{Lo: 0x0253, Hi: 0x0254, Stride: 1},
+					{Lo: 0x0256, Hi: 0x0257, Stride: 1},
+					{Lo: 0x025B, Hi: 0x0263, Stride: 8},
+					{Lo: 0x0268, Hi: 0x0269, Stride: 1},
+					{Lo: 0x0272, Hi: 0x0289, Stride: 23},
+					{Lo: 0x0292, Hi: 0x0305, Stride: 115},
+					{Lo: 0x030D, Hi: 0x0316, Stride: 9},
+					{Lo: 0x0321, Hi: 0x0322, Stride: 1},
+					{Lo: 0x0332, Hi: 0x0336, Stride: 2},
+					{Lo: 0x0358, Hi: 0x0591, Stride: 569},
+					{Lo: 0x0592, Hi: 0x05B3, Stride: 1},
+					{Lo: 0x05B5, Hi: 0x05BD, Stride: 1},
+					{Lo: 0x05BF, Hi: 0x05C1, Stride: 2},
+					{Lo: 0x05C2, Hi: 0x05C4, Stride: 2},
+					{Lo: 0x05C5, Hi: 0x05C7, Stride: 2},
+					{Lo: 0x0610, Hi: 0x061A, Stride: 1},
+					{Lo: 0x0656, Hi: 0x065F, Stride: 1},
+					{Lo: 0x06D6, Hi: 0x06DC, Stride: 1},
+					{Lo: 0x06DF, Hi: 0x06E4, Stride: 1},
+					{Lo: 0x06E7, Hi: 0x06E8, Stride: 1},
+					{Lo: 0x06EA, Hi: 0x06ED, Stride: 1},
+					{Lo: 0x0898, Hi: 0x089F, Stride: 1},
+					{Lo: 0x08B3, Hi: 0x08B4, Stride: 1},
+					{Lo: 0x08CA, Hi: 0x08E1, Stride: 1},
+					{Lo: 0x08E3, Hi: 0x0900, Stride: 1},
+					{Lo: 0x0955, Hi: 0x0A51, Stride: 252},
+					{Lo: 0x0A75, Hi: 0x0AF9, Stride: 132},
+					{Lo: 0x0B44, Hi: 0x0B62, Stride: 30},
+					{Lo: 0x0B63, Hi: 0x0C5A, Stride: 247},
+					{Lo: 0x0C62, Hi: 0x0C63, Stride: 1},
+					{Lo: 0x0D44, Hi: 0x0D62, Stride: 30},
+					{Lo: 0x0D63, Hi: 0x0D8F, Stride: 44},
+					{Lo: 0x0D90, Hi: 0x0DA6, Stride: 22},
+					{Lo: 0x0DDF, Hi: 0x0DF3, Stride: 20},
+					{Lo: 0x0F39, Hi: 0x18A9, Stride: 2416},
+					{Lo: 0x1AC1, Hi: 0x1ACE, Stride: 1},
+					{Lo: 0x2054, Hi: 0x218A, Stride: 310},
+					{Lo: 0x218B, Hi: 0x2BEC, Stride: 2657},
+					{Lo: 0x2BED, Hi: 0x2BEF, Stride: 1},
+					{Lo: 0x2C68, Hi: 0x2C6C, Stride: 1},
+					{Lo: 0xA66F, Hi: 0xA67C, Stride: 13},
+					{Lo: 0xA67D, Hi: 0xA69E, Stride: 33},
+					{Lo: 0xA78B, Hi: 0xA78C, Stride: 1},
+					{Lo: 0xA78F, Hi: 0xA7B2, Stride: 35},
+					{Lo: 0xA7B3, Hi: 0xA7B7, Stride: 1},
+					{Lo: 0xA8FC, Hi: 0xA8FD, Stride: 1},
+					{Lo: 0xAB60, Hi: 0xAB63, Stride: 1},
+					{Lo: 0xFB1E, Hi: 0xFE2E, Stride: 784},
+					{Lo: 0xFE2F, Hi: 0xFE2F, Stride: 1},
+				},
+				R32: []unicode.Range32{
+					{Lo: 0x10780, Hi: 0x16A40, Stride: 25280},
+					{Lo: 0x16A41, Hi: 0x16A5E, Stride: 1},
+					{Lo: 0x16A60, Hi: 0x16A69, Stride: 1},
+					{Lo: 0x1AFF0, Hi: 0x1AFF3, Stride: 1},
+					{Lo: 0x1AFF5, Hi: 0x1AFFB, Stride: 1},
+					{Lo: 0x1AFFD, Hi: 0x1AFFE, Stride: 1},
+					{Lo: 0x1D1DE, Hi: 0x1D1E8, Stride: 1},
+					{Lo: 0x1F54F, Hi: 0x1F54F, Stride: 1},
+				},
+			},
+		},
+	}
+
+	registerTable("identifier")
+}
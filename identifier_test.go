@@ -0,0 +1,109 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifierStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r      rune
+		status confusables.Status
+	}{
+		{'a', confusables.Allowed},
+		{'_', confusables.Allowed},
+		{'-', confusables.Allowed},
+		{'é', confusables.Allowed},
+		{'а', confusables.Allowed},
+		{'漢', confusables.Allowed},
+		{' ', confusables.Restricted},
+		{'\u200b', confusables.Restricted},
+		{'ﬁ', confusables.Restricted},
+		{'☺', confusables.Restricted},
+		{'ᚠ', confusables.Restricted},
+		{'ƀ', confusables.Restricted},
+		{'ϳ', confusables.Restricted},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.status, confusables.IdentifierStatus(test.r), "IdentifierStatus(%U)", test.r)
+	}
+}
+
+func TestIdentifierTypes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r     rune
+		types []confusables.IdentifierType
+	}{
+		{'a', []confusables.IdentifierType{confusables.TypeRecommended}},
+		{'\'', []confusables.IdentifierType{confusables.TypeInclusion}},
+		{'\ue000', []confusables.IdentifierType{confusables.TypeNotCharacter}},
+		{'\u0378', []confusables.IdentifierType{confusables.TypeNotCharacter}},
+		{'\u200b', []confusables.IdentifierType{confusables.TypeDefaultIgnorable}},
+		{'ƀ', []confusables.IdentifierType{confusables.TypeTechnical}},
+		{'ϳ', []confusables.IdentifierType{confusables.TypeObsolete, confusables.TypeTechnical}},
+		{'ﬁ', []confusables.IdentifierType{confusables.TypeNotNFKC}},
+		{'ᚠ', []confusables.IdentifierType{confusables.TypeExclusion}},
+		{'ꓐ', []confusables.IdentifierType{confusables.TypeLimitedUse}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.types, confusables.IdentifierTypes(test.r), "IdentifierTypes(%U)", test.r)
+	}
+}
+
+func TestIsValidIdentifier(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s          string
+		profile    confusables.Profile
+		violations []confusables.Violation
+	}{
+		{"", confusables.DefaultProfile, []confusables.Violation{{Kind: confusables.ViolationEmpty, Offset: -1}}},
+		{"example", confusables.DefaultProfile, nil},
+		{"newtòñ", confusables.DefaultProfile, nil},
+		{"snake_case", confusables.DefaultProfile, nil},
+		{"1st", confusables.DefaultProfile, []confusables.Violation{
+			{Kind: confusables.ViolationStart, Offset: 0, Rune: '1'},
+		}},
+		{"_private", confusables.DefaultProfile, []confusables.Violation{
+			{Kind: confusables.ViolationStart, Offset: 0, Rune: '_'},
+		}},
+		{"_private", confusables.ProgrammingProfile, nil},
+		{"first.last", confusables.DefaultProfile, []confusables.Violation{
+			{Kind: confusables.ViolationContinue, Offset: 5, Rune: '.'},
+		}},
+		{"first.last", confusables.HandleProfile, nil},
+		{"first..last", confusables.HandleProfile, []confusables.Violation{
+			{Kind: confusables.ViolationMedial, Offset: 6, Rune: '.'},
+		}},
+		{"-first", confusables.HandleProfile, []confusables.Violation{
+			{Kind: confusables.ViolationMedial, Offset: 0, Rune: '-'},
+		}},
+		{"раура", confusables.DefaultProfile, nil},
+		{"раура", confusables.HandleProfile, []confusables.Violation{
+			{Kind: confusables.ViolationConfusable, Offset: 0, Rune: 'р'},
+			{Kind: confusables.ViolationConfusable, Offset: 2, Rune: 'а'},
+			{Kind: confusables.ViolationConfusable, Offset: 4, Rune: 'у'},
+			{Kind: confusables.ViolationConfusable, Offset: 6, Rune: 'р'},
+			{Kind: confusables.ViolationConfusable, Offset: 8, Rune: 'а'},
+		}},
+		{"pаypal", confusables.DefaultProfile, []confusables.Violation{
+			{Kind: confusables.ViolationRestrictionLevel, Offset: -1},
+		}},
+	}
+
+	for _, test := range tests {
+		valid, violations := confusables.IsValidIdentifier(test.s, test.profile)
+
+		assert.Equal(t, len(test.violations) == 0, valid, "IsValidIdentifier(%q)", test.s)
+		assert.Equal(t, test.violations, violations, "IsValidIdentifier(%q)", test.s)
+	}
+}
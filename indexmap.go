@@ -0,0 +1,163 @@
+package confusables
+
+import (
+	"context"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// IndexMap relates byte offsets within a string returned by ToASCIIWithIndex to byte offsets within its input.
+type IndexMap struct {
+	// spans holds, for each byte of the output, the span of the input it was derived from. A nil spans means the output
+	// is the input unchanged.
+	spans []span
+	// n is the length of the input.
+	n int
+}
+
+// ToASCIIWithIndex converts characters in a string to their ASCII equivalent, as ToASCII, returning an IndexMap which
+// relates offsets within the result to offsets within s.
+func (c *Confusables) ToASCIIWithIndex(s string) (string, IndexMap) {
+	t := c.tables.load()
+	c.observeASCII(context.Background(), t, s)
+
+	return c.toASCIIWithIndex(t, s, nil)
+}
+
+// Convert s as ToASCIIWithIndex. If diffs is not nil, the Diff of each rune of s, as ToASCIIDiff reports it, is appended
+// to it.
+func (c *Confusables) toASCIIWithIndex(t *tables, s string, diffs *[]Diff) (string, IndexMap) {
+	if isASCII(s) && !t.asciiSequences.hasASCII() && !c.caseFold && !c.normalizeSpaces && !c.reverseLeet {
+		if diffs != nil {
+			*diffs = append(*diffs, noDiff(s)...)
+		}
+
+		return s, IndexMap{n: len(s)}
+	}
+
+	mapped := make([]byte, 0, len(s))
+	spans := make([]span, 0, len(s))
+	end := 0
+	// diffEnd is the offset of s up to which runes are covered by the sequence of a diff.
+	diffEnd := 0
+
+	for i, r := range s {
+		if diffs != nil {
+			*diffs = append(*diffs, c.diffAt(t, s, i, r, &diffEnd))
+		}
+
+		if i < end {
+			continue
+		}
+
+		n := len(mapped)
+		_, size := utf8.DecodeRuneInString(s[i:])
+		source := span{start: i, end: i + size}
+
+		if seq, v, ok := c.matchSequence(t, s[i:]); ok {
+			mapped = append(mapped, v...)
+			end = i + len(seq)
+			source.end = end
+		} else if v, ok := c.mapRune(t, r); ok {
+			mapped = append(mapped, v...)
+		} else if c.confusablesOnly {
+			mapped = append(mapped, s[i:i+size]...)
+		} else {
+			mapped = utf8.AppendRune(mapped, r)
+		}
+
+		for ; n < len(mapped); n++ {
+			spans = append(spans, source)
+		}
+	}
+
+	if c.confusablesOnly && !c.caseFold {
+		return c.indexed(string(mapped), spans, len(s))
+	}
+
+	// The result is normalized one segment at a time, so that each segment can be related to the runes it came from.
+	// Where unmapped runes are preserved, each rune is a segment of its own which is left unnormalized.
+	var (
+		it       norm.Iter
+		out      = make([]byte, 0, len(mapped))
+		outSpans = make([]span, 0, len(mapped))
+	)
+
+	fold := cases.Fold()
+
+	it.Init(norm.NFKC, mapped)
+
+	for start := 0; start < len(mapped); {
+		var (
+			segment []byte
+			end     int
+		)
+
+		if c.confusablesOnly {
+			_, size := utf8.DecodeRune(mapped[start:])
+			end = start + size
+			segment = mapped[start:end]
+		} else {
+			segment = it.Next()
+			end = it.Pos()
+		}
+
+		// Where a rune is expanded by normalization, such as "™" to "TM", the iterator returns a segment for each part of
+		// its expansion without advancing until the last; the segments before it are derived from the rune at start.
+		last := max(end-1, start)
+
+		source := span{start: spans[start].start, end: spans[last].end}
+		start = end
+
+		if c.caseFold {
+			segment = fold.Bytes(segment)
+		}
+
+		out = append(out, segment...)
+
+		for range segment {
+			outSpans = append(outSpans, source)
+		}
+	}
+
+	return c.indexed(string(out), outSpans, len(s))
+}
+
+// Get the result of ToASCIIWithIndex from the converted form of the input, a, whose bytes were derived from spans of
+// the input of length n.
+func (c *Confusables) indexed(a string, spans []span, n int) (string, IndexMap) {
+	if c.reverseLeet {
+		a, spans = c.leetReversedSpans(a, spans)
+	}
+
+	if c.normalizeSpaces {
+		a, spans = normalizeSpaces(a, spans)
+	}
+
+	return a, IndexMap{spans: spans, n: n}
+}
+
+// ToOriginal returns the offset within the input of the byte at offset within the output. Where several runes of the
+// input were converted together, such as a rune which was replaced by several, offsets within their output map to the
+// start of those runes. The end of the output maps to the end of the input. If offset is outside of the output, -1 is
+// returned.
+func (m IndexMap) ToOriginal(offset int) int {
+	switch {
+	case m.spans == nil && offset >= 0 && offset <= m.n:
+		return offset
+	case offset < 0 || offset > len(m.spans):
+		return -1
+	case offset == len(m.spans):
+		return m.n
+	}
+
+	return m.spans[offset].start
+}
+
+// ToASCIIWithIndex converts characters in a string to their ASCII equivalent, as ToASCII, returning an IndexMap which
+// relates offsets within the result to offsets within s.
+func ToASCIIWithIndex(s string) (string, IndexMap) {
+	return New().ToASCIIWithIndex(s)
+}
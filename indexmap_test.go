@@ -0,0 +1,79 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToASCIIWithIndex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s        string
+		opts     []confusables.Option
+		expected string
+		original []int
+	}{
+		{"", nil, "", []int{0}},
+		{"abc", nil, "abc", []int{0, 1, 2, 3}},
+		{"ʘe", nil, "ʘe", []int{0, 0, 2, 3}},
+		{"раура", nil, "paypa", []int{0, 2, 4, 6, 8, 10}},
+		{"x①y", nil, "x1y", []int{0, 1, 4, 5}},
+		{"ﬃx", nil, "ffix", []int{0, 0, 0, 3, 4}},
+		{"™", nil, "TM", []int{0, 0, 3}},
+		{"½", nil, "1⁄2", []int{0, 0, 0, 0, 0, 2}},
+		{"x™y", nil, "xTMy", []int{0, 1, 1, 4, 5}},
+		{"x™y", []confusables.Option{confusables.WithCaseFold()}, "xtmy", []int{0, 1, 1, 4, 5}},
+		{"Straße", []confusables.Option{confusables.WithCaseFold()}, "strasse", []int{0, 1, 2, 3, 4, 4, 6, 7}},
+		{"a​b", []confusables.Option{confusables.WithStripInvisible()}, "ab", []int{0, 4, 5}},
+	}
+
+	for _, test := range tests {
+		c := confusables.New(test.opts...)
+		a, m := c.ToASCIIWithIndex(test.s)
+
+		assert.Equal(t, test.expected, a, "ToASCIIWithIndex(%q)", test.s)
+		assert.Equal(t, c.ToASCII(test.s), a, "ToASCIIWithIndex(%q)", test.s)
+
+		original := make([]int, 0, len(a)+1)
+		for i := 0; i <= len(a); i++ {
+			original = append(original, m.ToOriginal(i))
+		}
+
+		assert.Equal(t, test.original, original, "ToASCIIWithIndex(%q)", test.s)
+		assert.Equal(t, -1, m.ToOriginal(-1))
+		assert.Equal(t, -1, m.ToOriginal(len(a)+1))
+	}
+}
+
+func FuzzToASCIIWithIndex(f *testing.F) {
+	for _, s := range []string{"", "abc", "раура", "x①y", "ﬃx", "Straße", "a​b", "™", "½", "x™y", "ﷺ"} {
+		f.Add(s)
+	}
+
+	options := [][]confusables.Option{
+		nil,
+		{confusables.WithCaseFold()},
+		{confusables.WithConfusablesOnly()},
+		{confusables.WithStripInvisible()},
+		{confusables.WithNormalizeSpaces()},
+		{confusables.WithReverseLeet()},
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		for _, opts := range options {
+			a, m := confusables.New(opts...).ToASCIIWithIndex(s)
+
+			for i, prev := 0, 0; i <= len(a); i++ {
+				original := m.ToOriginal(i)
+				if original < prev || original > len(s) {
+					t.Fatalf("ToASCIIWithIndex(%q).ToOriginal(%d) = %d", s, i, original)
+				}
+
+				prev = original
+			}
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package confusables
+
+import "unicode/utf8"
+
+// intentional is populated by the generated intentional tables when they are compiled in and holds the pairs of runes
+// listed in intentional.txt, with the lower rune first.
+var intentional map[[2]rune]struct{}
+
+// IsIntentional checks if r1 and r2 are intentional confusables as defined in
+// https://www.unicode.org/reports/tr39/#Intentional_Confusables, i.e. characters whose glyphs are identical, such as
+// Latin "a" and Cyrillic "а". These are a subset of the confusables.
+//
+// When the generated intentional tables are not compiled in, no pairs are intentional confusables; Capabilities lists
+// "intentional" among its tables when they are.
+func IsIntentional(r1, r2 rune) bool {
+	if r1 > r2 {
+		r1, r2 = r2, r1
+	}
+
+	_, ok := intentional[[2]rune{r1, r2}]
+
+	return ok
+}
+
+// Check whether a rune and the confusable it maps to are intentional confusables.
+func isIntentionalMapping(r rune, confusable *string) bool {
+	if confusable == nil || utf8.RuneCountInString(*confusable) != 1 {
+		return false
+	}
+
+	target, _ := utf8.DecodeRuneInString(*confusable)
+
+	return IsIntentional(r, target)
+}
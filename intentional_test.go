@@ -0,0 +1,48 @@
+package confusables_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsIntentional(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r1, r2      rune
+		intentional bool
+	}{
+		{'a', 'a', false},
+		{'a', 'а', true},
+		{'а', 'a', true},
+		{'A', 'Α', true},
+		{'o', 'ο', true},
+		{'a', 'A', false},
+		{'l', 'І', false},
+		{'m', 'ʍ', false},
+		{'1', 'l', false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.intentional && intentionalTables(), confusables.IsIntentional(test.r1, test.r2),
+			"IsIntentional(%q, %q)", test.r1, test.r2)
+	}
+}
+
+// Check whether the generated intentional tables are compiled in, without which no confusables are intentional.
+func intentionalTables() bool {
+	return slices.Contains(confusables.Capabilities().Tables, "intentional")
+}
+
+// Get the severity of an intentional confusable from confusables.txt, which is only known to be identical with the
+// intentional tables.
+func intentionalSeverity() confusables.Severity {
+	if intentionalTables() {
+		return confusables.SeverityIdentical
+	}
+
+	return confusables.SeverityNear
+}
@@ -0,0 +1,62 @@
+package confusables
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// FindInvisibles returns a Diff for each default ignorable code point in s, such as ZERO WIDTH SPACE, ZERO WIDTH
+// JOINER, SOFT HYPHEN and MONGOLIAN VOWEL SEPARATOR. These characters are not rendered and so can be inserted into a
+// string, e.g. "f\u200bree", to defeat comparisons without changing how it looks. As they look like nothing, each
+// Diff's Confusable is empty.
+func FindInvisibles(s string) []Diff {
+	var diffs []Diff
+
+	t := loadTables()
+
+	for _, r := range s {
+		if isDefaultIgnorable(r) {
+			invisible := ""
+
+			diffs = append(diffs, Diff{
+				Confusable:  &invisible,
+				Description: t.description(string(r), &invisible),
+				Origin:      t.origin(string(r), &invisible),
+				Rune:        r,
+				Severity:    t.severity(string(r), &invisible),
+			})
+		}
+	}
+
+	return diffs
+}
+
+// StripInvisible returns a copy of s with all default ignorable code points removed. Stripping strings before comparing
+// them, e.g. with IsConfusable, prevents invisible characters from causing otherwise identical strings to differ.
+func StripInvisible(s string) string {
+	return strings.Map(func(r rune) rune {
+		if isDefaultIgnorable(r) {
+			return -1
+		}
+
+		return r
+	}, s)
+}
+
+// Check whether s contains invisible characters, i.e. default ignorable code points or a combining mark which is
+// repeated, as the repeat is drawn over the original.
+func hasInvisible(s string) bool {
+	var prev rune
+
+	for _, r := range norm.NFD.String(s) {
+		if isDefaultIgnorable(r) || (r == prev && unicode.Is(unicode.Mn, r)) {
+			return true
+		}
+
+		prev = r
+	}
+
+	return false
+}
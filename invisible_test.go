@@ -0,0 +1,78 @@
+package confusables_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindInvisibles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s          string
+		invisibles []rune
+	}{
+		{"", nil},
+		{"free", nil},
+		{"f\u200bree", []rune{'\u200b'}},
+		{"soft\u00adhyphen", []rune{'\u00ad'}},
+		{"a\u200db\u200cc", []rune{'\u200d', '\u200c'}},
+		{"\u180e\ufeffx", []rune{'\u180e', '\ufeff'}},
+	}
+
+	for _, test := range tests {
+		diffs := confusables.FindInvisibles(test.s)
+
+		var invisibles []rune
+
+		for _, diff := range diffs {
+			assert.Equal(t, "", *diff.Confusable)
+			assert.Equal(t, &confusables.Description{From: fmt.Sprintf("U+%04X", diff.Rune)}, diff.Description)
+			assert.Equal(t, confusables.OriginOption, diff.Origin)
+			assert.Equal(t, confusables.SeverityIdentical, diff.Severity)
+
+			invisibles = append(invisibles, diff.Rune)
+		}
+
+		assert.Equal(t, test.invisibles, invisibles, "FindInvisibles(%q)", test.s)
+	}
+
+	// HANGUL FILLER also has a confusable mapping, but is reported as it is when stripped by ToASCIIDiff.
+	_, diffs := confusables.New(confusables.WithStripInvisible()).ToASCIIDiff("x\u3164y")
+	assert.Equal(t, []confusables.Diff{diffs[1]}, confusables.FindInvisibles("x\u3164y"))
+}
+
+func TestStripInvisible(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, stripped string
+	}{
+		{"", ""},
+		{"free", "free"},
+		{"f\u200bree", "free"},
+		{"a\u200db\u200cc\u00ad", "abc"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.stripped, confusables.StripInvisible(test.s))
+	}
+
+	assert.True(t, confusables.IsConfusable("free", confusables.StripInvisible("fr\u200bее")))
+}
+
+func TestWithStripInvisible(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.New(confusables.WithStripInvisible())
+
+	ascii, diffs := c.ToASCIIDiff("f\u200brее")
+	assert.Equal(t, "free", ascii)
+	assert.Equal(t, '\u200b', diffs[1].Rune)
+	assert.Equal(t, "", *diffs[1].Confusable)
+
+	assert.Equal(t, "f\u200bree", confusables.New().ToASCII("f\u200brее"))
+}
@@ -0,0 +1,62 @@
+package confusables
+
+import "encoding/json"
+
+// ASCIIString is a string which is converted to its ASCII equivalent, as ToASCII, when it is decoded from JSON. A
+// request field is made confusable-safe by declaring it as an ASCIIString rather than a string, with the original
+// string and the runes which were replaced kept alongside the result.
+type ASCIIString struct {
+	// Diffs holds the Diff of each rune of Original which was replaced.
+	Diffs    []Diff
+	Original string
+	Value    string
+}
+
+// NewASCIIString creates an ASCIIString from s.
+func NewASCIIString(s string) ASCIIString {
+	a := ASCIIString{Original: s}
+
+	var diffs []Diff
+
+	a.Value, diffs = ToASCIIDiff(s)
+
+	for _, diff := range diffs {
+		if diff.Confusable != nil {
+			a.Diffs = append(a.Diffs, diff)
+		}
+	}
+
+	return a
+}
+
+// Changed reports whether any rune of the original string was replaced.
+func (a ASCIIString) Changed() bool {
+	return len(a.Diffs) > 0
+}
+
+// MarshalJSON implements json.Marshaler, encoding the converted string.
+func (a ASCIIString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Value)
+}
+
+// String returns the converted string.
+func (a ASCIIString) String() string {
+	return a.Value
+}
+
+// UnmarshalJSON implements json.Unmarshaler, converting the decoded string. A JSON null leaves a unchanged.
+func (a *ASCIIString) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	*a = NewASCIIString(s)
+
+	return nil
+}
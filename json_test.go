@@ -0,0 +1,39 @@
+package confusables_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestASCIIString(t *testing.T) {
+	t.Parallel()
+
+	var req struct {
+		Name  confusables.ASCIIString `json:"name"`
+		Email confusables.ASCIIString `json:"email"`
+		Note  confusables.ASCIIString `json:"note"`
+	}
+
+	err := json.Unmarshal([]byte(`{"name":"pаypal","email":"user@example.com","note":null}`), &req)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "paypal", req.Name.Value)
+	assert.Equal(t, "pаypal", req.Name.Original)
+	assert.True(t, req.Name.Changed())
+	assert.Len(t, req.Name.Diffs, 1)
+	assert.Equal(t, 'а', req.Name.Diffs[0].Rune)
+	assert.Equal(t, "a", *req.Name.Diffs[0].Confusable)
+
+	assert.Equal(t, "user@example.com", req.Email.String())
+	assert.False(t, req.Email.Changed())
+	assert.Equal(t, confusables.ASCIIString{}, req.Note)
+
+	data, err := json.Marshal(req)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"name":"paypal","email":"user@example.com","note":""}`, string(data))
+
+	assert.Error(t, json.Unmarshal([]byte(`{"name":1}`), &req))
+}
@@ -0,0 +1,28 @@
+package confusables
+
+import "golang.org/x/text/unicode/norm"
+
+// Key returns a canonical key for comparing s, such that strings which look alike, ignoring case, have the same key,
+// for deduplicating user generated content. The key is composed of, in order:
+//
+//   - NFKC normalization, case folding and the removal of invisible characters, as ToSkeletonCF;
+//   - the normalization of spaces, as NormalizeSpaces;
+//   - the skeleton, as ToSkeleton;
+//   - NFD normalization, as for the skeletons of UTS #39.
+//
+// Keys are for comparison only and should not be displayed. As skeletons, they change when the tables are upgraded,
+// so stored keys should be computed by an instance pinned with WithTables.
+func Key(s string) string {
+	return loadTables().key(s)
+}
+
+// Key returns a canonical key for comparing s using the instance's mappings, as Key.
+func (c *Confusables) Key(s string) string {
+	return c.tables.load().key(s)
+}
+
+func (t *tables) key(s string) string {
+	s = NormalizeSpaces(nfkcCaseFold(s))
+
+	return norm.NFD.String(string(t.appendSkeleton(make([]byte, 0, len(s)), s)))
+}
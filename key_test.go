@@ -0,0 +1,35 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s1, s2 string
+	}{
+		{"PayPal", "раураl"},
+		{"free money", "FREE\u00a0\u00a0MONEY"},
+		{"pay\u200bpal", "paypal"},
+		{"ｐａｙｐａｌ", "paypal"},
+		{"café", "cafe\u0301"},
+		{"modern", "MODERN"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, confusables.Key(test.s1), confusables.Key(test.s2), "Key(%q) != Key(%q)", test.s1, test.s2)
+	}
+
+	assert.NotEqual(t, confusables.Key("paypal"), confusables.Key("paypai"))
+	assert.NotEqual(t, confusables.Key("café"), confusables.Key("cafe"))
+	assert.Equal(t, "paypal", confusables.Key("PAYPAL"))
+
+	c := confusables.NewSafe()
+	c.AddMapping('☺', "a")
+	assert.Equal(t, "paypal", c.Key("p☺yp☺l"))
+}
@@ -0,0 +1,112 @@
+package confusables
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	registerProfile("cyrillic-to-latin")
+	registerProfile("greek-to-latin")
+	registerProfile("latin")
+}
+
+// CyrillicToLatin converts the Cyrillic characters within a string which are confusable with Latin text to that text,
+// as ToLatin with WithSourceScripts(unicode.Cyrillic). Characters of every other script, including Greek, are left as
+// they are.
+func CyrillicToLatin(s string) string {
+	return New(WithSourceScripts(unicode.Cyrillic)).ToLatin(s)
+}
+
+// GreekToLatin converts the Greek characters within a string which are confusable with Latin text to that text, as
+// ToLatin with WithSourceScripts(unicode.Greek). Characters of every other script, including Cyrillic, are left as they
+// are.
+func GreekToLatin(s string) string {
+	return New(WithSourceScripts(unicode.Greek)).ToLatin(s)
+}
+
+// ToLatin converts the characters of other scripts within a string which are confusable with Latin text, such as
+// Cyrillic "а" and Greek "ο", to that text. Unlike ToASCII, the Latin script is the target rather than ASCII, so Latin
+// letters including those with diacritics, e.g. "é", "ñ" and "ü", are preserved. Marks on converted characters are
+// also preserved, e.g. Cyrillic "ё" becomes "ë". The result is NFKC normalized, so compatibility characters such as
+// fullwidth letters are converted too.
+func (c *Confusables) ToLatin(s string) string {
+	if isASCII(s) {
+		return c.caseFolded(s)
+	}
+
+	t := c.tables.load()
+	nfd := norm.NFD.String(s)
+	end := 0
+
+	buf := getBuffer()
+	latin := (*buf)[:0]
+
+	for i, r := range nfd {
+		if i < end {
+			continue
+		}
+
+		if source, v, ok := t.sequences.match(nfd[i:]); ok && c.isSourceRune(r) && isLatinText(v) {
+			latin = append(latin, v...)
+			end = i + len(source)
+
+			continue
+		}
+
+		if c.stripInvisible && isDefaultIgnorable(r) {
+			continue
+		}
+
+		if v, ok := t.confusables.lookup(r); ok && !isLatinRune(r) && c.isSourceRune(r) && isLatinText(v) {
+			latin = append(latin, v...)
+		} else {
+			latin = utf8.AppendRune(latin, r)
+		}
+	}
+
+	form := norm.NFKC
+	if c.sourceScripts != nil {
+		form = norm.NFC
+	}
+
+	l := form.String(string(latin))
+
+	putBuffer(buf, latin)
+
+	return c.caseFolded(l)
+}
+
+// ToLatin converts the characters of other scripts within a string which are confusable with Latin text to that
+// text, as Confusables.ToLatin.
+func ToLatin(s string) string {
+	return New().ToLatin(s)
+}
+
+// Case fold s when configured to.
+func (c *Confusables) caseFolded(s string) string {
+	if c.caseFold {
+		return cases.Fold().String(s)
+	}
+
+	return s
+}
+
+// Check whether a rune is of a script which is converted by ToLatin.
+func (c *Confusables) isSourceRune(r rune) bool {
+	return c.sourceScripts == nil || unicode.In(r, c.sourceScripts...)
+}
+
+// Check whether a rune is in the Latin script, or the Common or Inherited scripts which are used with it.
+func isLatinRune(r rune) bool {
+	return r <= unicode.MaxASCII || unicode.In(r, unicode.Latin, unicode.Common, unicode.Inherited)
+}
+
+// Check whether every rune of s is in the Latin script, or is used with it.
+func isLatinText(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool { return !isLatinRune(r) }) == -1
+}
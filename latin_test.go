@@ -0,0 +1,50 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToLatin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, latin string
+	}{
+		{"", ""},
+		{"example", "example"},
+		{"раураl", "paypal"},
+		{"José Muñoz Müller", "José Muñoz Müller"},
+		{"Zoё", "Zoë"},
+		{"ｆｕｌｌ", "full"},
+		{"東京", "東京"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.latin, confusables.ToLatin(test.s), "ToLatin(%q)", test.s)
+	}
+
+	assert.Equal(t, "josé", confusables.New(confusables.WithCaseFold()).ToLatin("JОSÉ"))
+}
+
+func TestScriptToLatin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, cyrillic, greek string
+	}{
+		{"", "", ""},
+		{"раураl", "paypal", "раураl"},
+		{"ορα", "ορα", "opa"},
+		{"ορα раура", "ορα paypa", "opa раура"},
+		{"ｆｕｌｌ с", "ｆｕｌｌ c", "ｆｕｌｌ с"},
+		{"Zoё", "Zoë", "Zoё"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.cyrillic, confusables.CyrillicToLatin(test.s), "CyrillicToLatin(%q)", test.s)
+		assert.Equal(t, test.greek, confusables.GreekToLatin(test.s), "GreekToLatin(%q)", test.s)
+	}
+}
@@ -0,0 +1,90 @@
+package confusables
+
+import (
+	"maps"
+	"unicode"
+	"unicode/utf8"
+)
+
+// defaultLeet holds the letters which ToText substitutes for the digits and symbols standing in for them, by default.
+var defaultLeet = map[rune]rune{
+	'$': 's',
+	'0': 'o',
+	'1': 'l',
+	'3': 'e',
+	'4': 'a',
+	'5': 's',
+	'7': 't',
+	'@': 'a',
+}
+
+func init() {
+	registerProfile("text")
+}
+
+// ToText converts a string to ASCII, as ToASCII, and then reverses leetspeak, substituting letters for the digits and
+// symbols standing in for them, e.g. "fr33 m0n3y" becomes "free money". Substitutions are only made within tokens,
+// runs of non-space characters, which contain a letter, so that numbers such as "2024" are left untouched.
+//
+// To compare strings written in leetspeak, their skeletons may be taken after converting them with ToText.
+func (c *Confusables) ToText(s string) string {
+	return substituteRunes(c.ToASCII(s), c.leet, hasLetter, nil)
+}
+
+// Reverse leetspeak in s when converting to ASCII with WithReverseLeet.
+func (c *Confusables) leetReversed(s string) string {
+	if !c.reverseLeet {
+		return s
+	}
+
+	return substituteRunes(s, c.leet, hasLetter, nil)
+}
+
+// Reverse leetspeak in s, as leetReversed, along with the spans of the input each of its bytes was derived from.
+func (c *Confusables) leetReversedSpans(s string, spans []span) (string, []span) {
+	substituted := map[int]rune{}
+
+	l := substituteRunes(s, c.leet, hasLetter, func(i int, r rune) {
+		substituted[i] = r
+	})
+	if len(substituted) == 0 {
+		return s, spans
+	}
+
+	lSpans := make([]span, 0, len(l))
+
+	for i := range s {
+		_, n := utf8.DecodeRuneInString(s[i:])
+		if r, ok := substituted[i]; ok {
+			n = utf8.RuneLen(r)
+		}
+
+		for range n {
+			lSpans = append(lSpans, spans[i])
+		}
+	}
+
+	return l, lSpans
+}
+
+// DefaultLeetSubstitutions returns a copy of the substitutions made by ToText by default, which may be modified and
+// passed to WithLeetSubstitutions.
+func DefaultLeetSubstitutions() map[rune]rune {
+	return maps.Clone(defaultLeet)
+}
+
+// ToText converts a string to ASCII and then reverses leetspeak, as Confusables.ToText.
+func ToText(s string) string {
+	return New().ToText(s)
+}
+
+// Check whether a string contains a letter.
+func hasLetter(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+
+	return false
+}
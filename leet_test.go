@@ -0,0 +1,34 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, text string
+	}{
+		{"", ""},
+		{"fr33 m0n3y", "free money"},
+		{"$4l3 @t 50% 0ff", "sale at 50% off"},
+		{"c4ll 555 0123", "call 555 0123"},
+		{"fr𝟑𝟑", "free"},
+		{"1337 h4x0r", "1337 haxor"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.text, confusables.ToText(test.s), "ToText(%q)", test.s)
+	}
+
+	leet := confusables.DefaultLeetSubstitutions()
+	leet['1'] = 'i'
+
+	c := confusables.New(confusables.WithLeetSubstitutions(leet))
+	assert.Equal(t, "fries", c.ToText("fr13s"))
+	assert.Equal(t, "fly", confusables.ToText("f1y"))
+}
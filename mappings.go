@@ -0,0 +1,204 @@
+package confusables
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// JSONMapping is a mapping in the format read by LoadMappingsJSON, which reads a JSON array of them, e.g.
+//
+//	[{"source": "U+0251", "target": "a", "sourceName": "LATIN SMALL LETTER ALPHA", "targetName": "LATIN SMALL LETTER A"}]
+//
+// Source and Target are either code points, written as "U+" followed by hexadecimal digits and separated by spaces
+// when there are several, or the characters themselves.
+type JSONMapping struct {
+	Source     string `json:"source"`
+	SourceName string `json:"sourceName,omitempty"`
+	Target     string `json:"target"`
+	TargetName string `json:"targetName,omitempty"`
+}
+
+// csvColumns holds the names of the columns read by LoadMappingsCSV, in their default order.
+var csvColumns = []string{"source", "target", "sourcename", "targetname"}
+
+// csvNameReplacer removes the separators which may appear within the names of columns.
+var csvNameReplacer = strings.NewReplacer(" ", "", "_", "", "-", "")
+
+// LoadMappingsCSV reads r as CSV and loads in the confusable mappings, as LoadMappings. Each record holds the source
+// and target of a mapping, in the format of JSONMapping, optionally followed by their names. The first record may be a
+// header, recognized by a column named "source", which names the columns "source", "target", "sourceName" and
+// "targetName" in any order and case.
+//
+// Every record is checked before any mapping is loaded, so that the error returned reports each invalid line.
+func LoadMappingsCSV(r io.Reader) error {
+	return defaultTables.loadMappingsCSV(r)
+}
+
+// LoadMappingsCSV reads r as CSV and loads in the confusable mappings, as LoadMappingsCSV.
+func (s *SafeConfusables) LoadMappingsCSV(r io.Reader) error {
+	return s.tables.loadMappingsCSV(r)
+}
+
+// LoadMappingsJSON reads r as a JSON array of JSONMapping and loads in the confusable mappings, as LoadMappings.
+func LoadMappingsJSON(r io.Reader) error {
+	return defaultTables.loadMappingsJSON(r)
+}
+
+// LoadMappingsJSON reads r as a JSON array of JSONMapping and loads in the confusable mappings, as LoadMappings.
+func (s *SafeConfusables) LoadMappingsJSON(r io.Reader) error {
+	return s.tables.loadMappingsJSON(r)
+}
+
+// Get the entry for a JSON mapping.
+func (m JSONMapping) entry() (*ConfusableEntry, error) {
+	source, err := parseMappingText(m.Source)
+	if err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+
+	target, err := parseMappingText(m.Target)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+
+	r, _ := utf8.DecodeRuneInString(source)
+
+	return &ConfusableEntry{
+		Description:    Description{From: m.SourceName, To: m.TargetName},
+		Source:         r,
+		SourceSequence: source,
+		Target:         target,
+	}, nil
+}
+
+// Load mappings in the format read by LoadMappingsCSV. Either every mapping is added or, if an error is returned, none
+// are.
+func (s *tableSet) loadMappingsCSV(r io.Reader) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	cr.ReuseRecord = true
+
+	// columns holds the index of each of csvColumns within a record.
+	columns := []int{0, 1, 2, 3}
+
+	var (
+		entries []*ConfusableEntry
+		errs    []error
+	)
+
+	for first := true; ; first = false {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if first {
+			if header := csvHeader(record); header[0] >= 0 {
+				columns = header
+
+				continue
+			}
+		}
+
+		field := func(column int) string {
+			if i := columns[column]; i >= 0 && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+
+			return ""
+		}
+
+		m := JSONMapping{Source: field(0), Target: field(1), SourceName: field(2), TargetName: field(3)}
+
+		entry, err := m.entry()
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			errs = append(errs, fmt.Errorf("line %d: %w", line, err))
+
+			continue
+		}
+
+		entries = append(entries, entry)
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return s.update(func(t *tables) error {
+		for _, entry := range entries {
+			t.addEntry(entry)
+		}
+
+		return nil
+	})
+}
+
+// Load mappings in the format read by LoadMappingsJSON. Either every mapping is added or, if an error is returned,
+// none are.
+func (s *tableSet) loadMappingsJSON(r io.Reader) error {
+	var mappings []JSONMapping
+
+	if err := json.NewDecoder(r).Decode(&mappings); err != nil {
+		return err
+	}
+
+	return s.update(func(t *tables) error {
+		for i, m := range mappings {
+			entry, err := m.entry()
+			if err != nil {
+				return fmt.Errorf("mapping %d: %w", i, err)
+			}
+
+			t.addEntry(entry)
+		}
+
+		return nil
+	})
+}
+
+// Parse the source or target of a mapping, given either as code points such as "U+0061 U+0062" or as the characters
+// themselves.
+func parseMappingText(s string) (string, error) {
+	if strings.HasPrefix(s, "U+") {
+		runes, err := codepointsToRunes(s)
+		if err != nil {
+			return "", err
+		}
+
+		return string(runes), nil
+	}
+
+	if s == "" {
+		return "", errNoCodePoints
+	}
+
+	if !utf8.ValidString(s) {
+		return "", ErrInvalidUTF8
+	}
+
+	return s, nil
+}
+
+// Get the index of each of csvColumns within a header, or -1 where a column is missing. Names are matched ignoring case
+// and any spaces, underscores or hyphens, e.g. "Source Name" matches "sourceName".
+func csvHeader(header []string) []int {
+	columns := make([]int, len(csvColumns))
+
+	for i, name := range csvColumns {
+		columns[i] = slices.IndexFunc(header, func(h string) bool {
+			return strings.ToLower(csvNameReplacer.Replace(h)) == name
+		})
+	}
+
+	return columns
+}
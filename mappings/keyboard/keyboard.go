@@ -0,0 +1,22 @@
+// Package keyboard provides mappings from the characters of the Russian ЙЦУКЕН keyboard layout to those of the same
+// keys of the US QWERTY layout, for use with confusables.LoadMappings, so that text typed with the wrong layout active,
+// such as "руддщ", matches the text intended, "hello". The mappings replace those of confusables.txt for Cyrillic
+// letters, so they are best loaded into an instance of their own.
+package keyboard
+
+import (
+	_ "embed"
+	"io"
+	"strings"
+)
+
+//go:embed keyboard.txt
+var data string
+
+// Mappings returns a reader of the mappings, in the format of confusables.txt, e.g.
+//
+//	s := confusables.NewSafe()
+//	err := s.LoadMappings(keyboard.Mappings())
+func Mappings() io.Reader {
+	return strings.NewReader(data)
+}
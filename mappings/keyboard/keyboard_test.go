@@ -0,0 +1,28 @@
+package keyboard_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/eskriett/confusables/mappings/keyboard"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMappings(t *testing.T) {
+	t.Parallel()
+
+	s := confusables.NewSafe()
+	assert.NoError(t, s.LoadMappings(keyboard.Mappings()))
+
+	tests := []struct {
+		s, ascii string
+	}{
+		{"руддщ", "hello"},
+		{"Ьщтвфн", "Monday"},
+		{"hello", "hello"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.ascii, s.ToASCII(test.s), "ToASCII(%q)", test.s)
+	}
+}
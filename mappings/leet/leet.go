@@ -0,0 +1,21 @@
+// Package leet provides mappings reversing leetspeak, such as "4" to "a" and "|<" to "k", for use with
+// confusables.LoadMappings. Unlike Confusables.ToText, the mappings apply whatever the surrounding text, so that "fr33" is
+// confusable with "free".
+package leet
+
+import (
+	_ "embed"
+	"io"
+	"strings"
+)
+
+//go:embed leet.txt
+var data string
+
+// Mappings returns a reader of the mappings, in the format of confusables.txt, e.g.
+//
+//	s := confusables.NewSafe()
+//	err := s.LoadMappings(leet.Mappings())
+func Mappings() io.Reader {
+	return strings.NewReader(data)
+}
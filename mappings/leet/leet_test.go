@@ -0,0 +1,30 @@
+package leet_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/eskriett/confusables/mappings/leet"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMappings(t *testing.T) {
+	t.Parallel()
+
+	s := confusables.NewSafe()
+	assert.NoError(t, s.LoadMappings(leet.Mappings()))
+
+	tests := []struct {
+		s1, s2 string
+	}{
+		{"fr33 m0n3y", "free money"},
+		{"|<n0c|<", "knock"},
+		{"p@$$w0rd", "password"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, s.ToSkeleton(test.s2), s.ToSkeleton(test.s1), "ToSkeleton(%q)", test.s1)
+	}
+
+	assert.NotEqual(t, confusables.ToSkeleton("free"), confusables.ToSkeleton("fr33"))
+}
@@ -0,0 +1,20 @@
+// Package ocr provides mappings between strings which optical character recognition commonly confuses, such as "cl"
+// and "d" or "0" and "O", for use with confusables.LoadMappings.
+package ocr
+
+import (
+	_ "embed"
+	"io"
+	"strings"
+)
+
+//go:embed ocr.txt
+var data string
+
+// Mappings returns a reader of the mappings, in the format of confusables.txt, e.g.
+//
+//	s := confusables.NewSafe()
+//	err := s.LoadMappings(ocr.Mappings())
+func Mappings() io.Reader {
+	return strings.NewReader(data)
+}
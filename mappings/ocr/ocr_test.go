@@ -0,0 +1,30 @@
+package ocr_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/eskriett/confusables/mappings/ocr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMappings(t *testing.T) {
+	t.Parallel()
+
+	s := confusables.NewSafe()
+	assert.NoError(t, s.LoadMappings(ocr.Mappings()))
+
+	tests := []struct {
+		s1, s2 string
+	}{
+		{"clock", "dock"},
+		{"modern", "rnodern"},
+		{"vvord", "word"},
+		{"0RDER", "ORDER"},
+		{"5OLD", "SOLD"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, s.ToSkeleton(test.s1), s.ToSkeleton(test.s2), "ToSkeleton(%q)", test.s1)
+	}
+}
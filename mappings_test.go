@@ -0,0 +1,82 @@
+package confusables_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadMappingsJSON(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.NewSafe()
+
+	err := c.LoadMappingsJSON(strings.NewReader(`[
+		{"source": "U+0251", "target": "a",
+		 "sourceName": "LATIN SMALL LETTER ALPHA", "targetName": "LATIN SMALL LETTER A"},
+		{"source": "U+0063 U+006C", "target": "U+0064"},
+		{"source": "☺", "target": ":)"}
+	]`))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "a d :)", c.ToASCII("ɑ cl ☺"))
+
+	_, diffs := c.ToASCIIDiff("ɑ")
+	assert.Equal(t, &confusables.Description{From: "LATIN SMALL LETTER ALPHA", To: "LATIN SMALL LETTER A"},
+		diffs[0].Description)
+
+	tests := []struct {
+		json, err string
+	}{
+		{`{"source": "U+0251"}`, "cannot unmarshal"},
+		{`[{"source": "☺", "target": "x"}, {"source": "U+ZZ", "target": "a"}]`, "mapping 1: source"},
+		{`[{"source": "", "target": "a"}]`, "mapping 0: source"},
+		{`[{"source": "☹", "target": ""}]`, "mapping 0: target"},
+	}
+
+	for _, test := range tests {
+		assert.ErrorContains(t, c.LoadMappingsJSON(strings.NewReader(test.json)), test.err, test.json)
+	}
+
+	// None of the mappings are loaded when any of them is invalid.
+	assert.Equal(t, ":)", c.ToASCII("☺"))
+}
+
+func TestLoadMappingsCSV(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		csv string
+	}{
+		{"U+0251,a,LATIN SMALL LETTER ALPHA,LATIN SMALL LETTER A\nU+0063 U+006C,d\n☺,:)\n"},
+		{"Source,Target,Source Name,target_name\nU+0251,a,LATIN SMALL LETTER ALPHA,LATIN SMALL LETTER A\n" +
+			"U+0063 U+006C,d,,\n☺,:)\n"},
+		{"target_name,target,source\nLATIN SMALL LETTER A,a,U+0251\n,d,U+0063 U+006C\n,:),☺\n"},
+		{"source,target\r\n\"U+0251\", a\r\nU+0063 U+006C,d\r\n☺,\":)\"\r\n"},
+	}
+
+	for _, test := range tests {
+		c := confusables.NewSafe()
+
+		if assert.NoError(t, c.LoadMappingsCSV(strings.NewReader(test.csv)), test.csv) {
+			assert.Equal(t, "a d :)", c.ToASCII("ɑ cl ☺"), test.csv)
+		}
+	}
+
+	c := confusables.NewSafe()
+	assert.NoError(t, c.LoadMappingsCSV(strings.NewReader(tests[0].csv)))
+
+	_, diffs := c.ToASCIIDiff("ɑ")
+	assert.Equal(t, &confusables.Description{From: "LATIN SMALL LETTER ALPHA", To: "LATIN SMALL LETTER A"},
+		diffs[0].Description)
+
+	err := c.LoadMappingsCSV(strings.NewReader("source,target\n☹,x\nU+ZZ,a\n\"multi\nline\",\nU+0061,b\n"))
+	assert.ErrorContains(t, err, "line 3: source")
+	assert.ErrorContains(t, err, "line 4: target")
+	assert.Equal(t, "☹", c.ToASCII("☹"))
+
+	err = c.LoadMappingsCSV(strings.NewReader("\"unterminated\n"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,21 @@
+package confusables
+
+import (
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// StripMarks returns a copy of s with its nonspacing marks, such as accents, removed, e.g. "Crème Brûlée" becomes
+// "Creme Brulee". Runes are decomposed to separate their marks, which are removed before the result is recomposed.
+func StripMarks(s string) string {
+	return removeMarks(s)
+}
+
+// StripMarksTransformer returns a transformer which removes nonspacing marks, as StripMarks. As with other
+// transformers, it is not safe for concurrent use, so each goroutine should use its own.
+func StripMarksTransformer() transform.Transformer {
+	return transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+}
@@ -0,0 +1,34 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/transform"
+)
+
+func TestStripMarks(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, stripped string
+	}{
+		{"", ""},
+		{"example", "example"},
+		{"Crème Brûlée", "Creme Brulee"},
+		{"newtòñ", "newton"},
+		{"á́", "a"},
+		{"ß ø", "ß ø"},
+	}
+
+	tr := confusables.StripMarksTransformer()
+
+	for _, test := range tests {
+		assert.Equal(t, test.stripped, confusables.StripMarks(test.s), "StripMarks(%q)", test.s)
+
+		stripped, _, err := transform.String(tr, test.s)
+		assert.NoError(t, err)
+		assert.Equal(t, test.stripped, stripped, "StripMarksTransformer(%q)", test.s)
+	}
+}
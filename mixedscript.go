@@ -0,0 +1,66 @@
+package confusables
+
+import "golang.org/x/text/unicode/norm"
+
+// ScriptReport describes the scripts used by a string and the confusable runes which cause it to mix scripts.
+type ScriptReport struct {
+	// Confusables details the runes outside of the dominant script which have a confusable mapping.
+	Confusables []Diff
+	// Dominant is the script used by the most runes.
+	Dominant string
+	// Mixed reports whether the string has an empty resolved script set, i.e. no single script covers all of it.
+	Mixed bool
+	// Scripts lists the scripts used by the string, other than Common and Inherited, in order of appearance.
+	Scripts []string
+}
+
+func init() {
+	registerCheck("mixed-script")
+}
+
+// IsMixedScriptConfusable checks if two strings are mixed-script confusables as defined in
+// https://www.unicode.org/reports/tr39/#def-mixed-script-confusables. That is, they are confusable but no single
+// script covers both of them, such as "paypal" and "pаypal" where the latter uses a Cyrillic "а".
+func IsMixedScriptConfusable(s1, s2 string) bool {
+	if !IsConfusable(s1, s2) {
+		return false
+	}
+
+	return ResolvedScriptSet(s1).Intersect(ResolvedScriptSet(s2)).IsEmpty()
+}
+
+// MixedScriptReport reports the scripts used by s and, when it mixes scripts, which runes from outside of its dominant
+// script are confusable.
+func MixedScriptReport(s string) ScriptReport {
+	report := ScriptReport{
+		Dominant: DominantScript(s),
+		Mixed:    ResolvedScriptSet(s).IsEmpty(),
+		Scripts:  Scripts(s),
+	}
+
+	if !report.Mixed {
+		return report
+	}
+
+	t := loadTables()
+
+	for _, r := range norm.NFD.String(s) {
+		script := scriptOf(r)
+		if script == report.Dominant || script == scriptCommon || script == scriptInherited {
+			continue
+		}
+
+		if c, ok := t.confusables.lookup(r); ok {
+			report.Confusables = append(report.Confusables, Diff{
+				Confusable:  &c,
+				Description: t.description(string(r), &c),
+				Intentional: isIntentionalMapping(r, &c),
+				Origin:      t.origin(string(r), &c),
+				Rune:        r,
+				Severity:    t.severity(string(r), &c),
+			})
+		}
+	}
+
+	return report
+}
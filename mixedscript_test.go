@@ -0,0 +1,56 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMixedScriptConfusable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s1, s2 string
+		mixed  bool
+	}{
+		{"paypal", "paypal", false},
+		{"paypal", "pаypal", true},
+		{"paypal", "раураl", true},
+		{"paypal", "google", false},
+		{"1", "l", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.mixed, confusables.IsMixedScriptConfusable(test.s1, test.s2),
+			"IsMixedScriptConfusable(%q, %q)", test.s1, test.s2)
+	}
+}
+
+func TestMixedScriptReport(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, confusables.ScriptReport{
+		Dominant: "Latin",
+		Scripts:  []string{"Latin"},
+	}, confusables.MixedScriptReport("paypal"))
+
+	assert.Equal(t, confusables.ScriptReport{
+		Confusables: []confusables.Diff{
+			{
+				Confusable: strPtr("a"),
+				Description: &confusables.Description{
+					From: "CYRILLIC SMALL LETTER A",
+					To:   "LATIN SMALL LETTER A",
+				},
+				Intentional: intentionalTables(),
+				Origin:      confusables.OriginUpstream,
+				Rune:        'а',
+				Severity:    intentionalSeverity(),
+			},
+		},
+		Dominant: "Latin",
+		Mixed:    true,
+		Scripts:  []string{"Latin", "Cyrillic"},
+	}, confusables.MixedScriptReport("pаypal"))
+}
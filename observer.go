@@ -0,0 +1,95 @@
+package confusables
+
+import "context"
+
+// Observer is notified of the mappings applied by an instance of Confusables, e.g. to record metrics or log the
+// confusables seen. See WithObserver.
+type Observer interface {
+	// OnConfusable is called with the Diff of each rune which is replaced. It is called from the goroutine making the
+	// conversion, so must be safe for concurrent use when the instance is shared.
+	OnConfusable(ctx context.Context, diff Diff)
+}
+
+// ObserverFunc is a function which is an Observer.
+type ObserverFunc func(ctx context.Context, diff Diff)
+
+// OnConfusable calls f(ctx, diff).
+func (f ObserverFunc) OnConfusable(ctx context.Context, diff Diff) {
+	f(ctx, diff)
+}
+
+// ToASCIIContext converts characters in a string to their ASCII equivalent, as ToASCII, notifying the instance's
+// Observer with ctx.
+func (c *Confusables) ToASCIIContext(ctx context.Context, s string) string {
+	t := c.tables.load()
+	c.observeASCII(ctx, t, s)
+
+	return c.toASCIICached(t, s)
+}
+
+// ToSkeletonContext converts a string to its skeleton form, as ToSkeleton, notifying the instance's Observer with
+// ctx.
+func (c *Confusables) ToSkeletonContext(ctx context.Context, s string) string {
+	t := c.tables.load()
+
+	if c.observed() {
+		c.notify(ctx, t.skeletonDiffs(s))
+	}
+
+	return c.toSkeletonCached(t, s)
+}
+
+// Notify the observer of a conversion of s to ASCII and of each rune of s which is replaced.
+func (c *Confusables) observeASCII(ctx context.Context, t *tables, s string) {
+	if !c.observed() {
+		return
+	}
+
+	c.observeCall()
+
+	end := 0
+
+	for i, r := range s {
+		c.observe(ctx, c.diffAt(t, s, i, r, &end))
+	}
+}
+
+// Notify the observer of a conversion and of each of its diffs which replaces its rune.
+func (c *Confusables) notify(ctx context.Context, diffs []Diff) {
+	if !c.observed() {
+		return
+	}
+
+	c.observeCall()
+
+	for _, diff := range diffs {
+		c.observe(ctx, diff)
+	}
+}
+
+// Notify the observer of diff if it replaces its rune.
+func (c *Confusables) observe(ctx context.Context, diff Diff) {
+	if diff.Confusable == nil {
+		return
+	}
+
+	if c.stats != nil {
+		c.stats.runesFolded.Add(1)
+	}
+
+	if c.observer != nil {
+		c.observer.OnConfusable(ctx, diff)
+	}
+}
+
+// Count a conversion.
+func (c *Confusables) observeCall() {
+	if c.stats != nil {
+		c.stats.calls.Add(1)
+	}
+}
+
+// Report whether conversions are observed, by an observer or to count them.
+func (c *Confusables) observed() bool {
+	return c.observer != nil || c.stats != nil
+}
@@ -0,0 +1,77 @@
+package confusables_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+type contextKey struct{}
+
+type recorder struct {
+	mu    sync.Mutex
+	runes []rune
+	ctxs  []any
+}
+
+func (r *recorder) OnConfusable(ctx context.Context, diff confusables.Diff) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.runes = append(r.runes, diff.Rune)
+	r.ctxs = append(r.ctxs, ctx.Value(contextKey{}))
+}
+
+func TestObserver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		fn   func(c *confusables.Confusables, s string)
+	}{
+		{"ToASCII", func(c *confusables.Confusables, s string) { c.ToASCII(s) }},
+		{"AppendASCII", func(c *confusables.Confusables, s string) { c.AppendASCII(nil, s) }},
+		{"ToASCIIDiff", func(c *confusables.Confusables, s string) { c.ToASCIIDiff(s) }},
+		{"ToASCIIWithIndex", func(c *confusables.Confusables, s string) { c.ToASCIIWithIndex(s) }},
+		{"ToNumber", func(c *confusables.Confusables, s string) { c.ToNumber(s) }},
+		{"ToSkeleton", func(c *confusables.Confusables, s string) { c.ToSkeleton(s) }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &recorder{}
+			c := confusables.New(confusables.WithObserver(r))
+
+			test.fn(c, "pаypаl")
+			test.fn(c, "paypal")
+			assert.Equal(t, []rune{'а', 'а'}, r.runes)
+		})
+	}
+}
+
+func TestObserverContext(t *testing.T) {
+	t.Parallel()
+
+	r := &recorder{}
+	c := confusables.New(confusables.WithObserver(r), confusables.WithCache(8))
+	ctx := context.WithValue(context.Background(), contextKey{}, "request")
+
+	assert.Equal(t, "paypal", c.ToASCIIContext(ctx, "pаypal"))
+	assert.Equal(t, "paypal", c.ToASCIIContext(ctx, "pаypal"))
+	assert.Equal(t, confusables.ToSkeleton("pаypal"), c.ToSkeletonContext(ctx, "pаypal"))
+	assert.Equal(t, []rune{'а', 'а', 'а'}, r.runes)
+	assert.Equal(t, []any{"request", "request", "request"}, r.ctxs)
+
+	var seen []rune
+
+	c = confusables.New(confusables.WithObserver(confusables.ObserverFunc(func(_ context.Context, d confusables.Diff) {
+		seen = append(seen, d.Rune)
+	})))
+	c.ToASCIIDiffFunc("ⅰｘ", func(confusables.Diff) bool { return true })
+	assert.Equal(t, []rune{'ⅰ', 'ｘ'}, seen)
+}
@@ -0,0 +1,119 @@
+package confusables
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Origin identifies the dataset a mapping came from, so that a match may be traced to the mappings which caused it.
+type Origin int
+
+// Origins of mappings.
+const (
+	// OriginNone is the origin of a rune which is not mapped.
+	OriginNone Origin = iota
+	// OriginUpstream is the origin of the mappings of Unicode's confusables.txt.
+	OriginUpstream
+	// OriginAmendments is the origin of the package's amendments, listed in scripts/amendments.txt. See Amendments.
+	OriginAmendments
+	// OriginRuntime is the origin of the mappings added at runtime, such as by AddMapping or LoadMappings.
+	OriginRuntime
+	// OriginOption is the origin of the mappings made by options rather than by the tables, such as those of
+	// WithNormalizePunctuation or the removal of nonspacing marks by ToASCII.
+	OriginOption
+)
+
+var originNames = map[Origin]string{
+	OriginNone:       "none",
+	OriginUpstream:   "upstream",
+	OriginAmendments: "amendments",
+	OriginRuntime:    "runtime",
+	OriginOption:     "option",
+}
+
+// String returns the name of the origin, such as "amendments".
+func (o Origin) String() string {
+	if name, ok := originNames[o]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// OriginOf returns the origin of the mapping of r by the package's confusables, or OriginNone if r is not mapped.
+func OriginOf(r rune) Origin {
+	return loadTables().runeOrigin(r)
+}
+
+// OriginOf returns the origin of the mapping of r by the instance's confusables, as OriginOf.
+func (c *Confusables) OriginOf(r rune) Origin {
+	return c.tables.load().runeOrigin(r)
+}
+
+func (t *tables) runeOrigin(r rune) Origin {
+	if r <= unicode.MaxASCII {
+		return OriginNone
+	}
+
+	c, ok := t.confusables.lookup(r)
+	if !ok {
+		return OriginNone
+	}
+
+	return t.origin(string(r), &c)
+}
+
+// Get the origin of the mapping of source to confusable, which need not be one held by the tables, as options may map
+// runes themselves.
+func (t *tables) origin(source string, confusable *string) Origin {
+	if confusable == nil {
+		return OriginNone
+	}
+
+	key := originKey(source)
+	if o, ok := t.origins[key]; ok {
+		return o
+	}
+
+	if r, size := utf8.DecodeRuneInString(source); size == len(source) {
+		if v, ok := t.confusables.lookup(r); ok && v == *confusable {
+			return OriginUpstream
+		}
+
+		if v, ok := t.ascii.lookup(r); ok && v == *confusable {
+			return OriginUpstream
+		}
+
+		return OriginOption
+	}
+
+	if s, v, ok := t.sequences.match(key); ok && s == key && v == *confusable {
+		return OriginUpstream
+	}
+
+	if s, v, ok := t.asciiSequences.match(source); ok && s == source && v == *confusable {
+		return OriginUpstream
+	}
+
+	return OriginOption
+}
+
+// Record the origin of the mapping of source, which differs from OriginUpstream.
+func (t *tables) setOrigin(source string, origin Origin) {
+	if t.origins == nil {
+		t.origins = map[string]Origin{}
+	}
+
+	t.origins[originKey(source)] = origin
+}
+
+// Get the key of the origin of the mapping of source: a single rune is its own key, and a sequence is keyed by its NFD.
+func originKey(source string) string {
+	if _, size := utf8.DecodeRuneInString(source); size == len(source) {
+		return source
+	}
+
+	return norm.NFD.String(source)
+}
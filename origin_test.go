@@ -0,0 +1,66 @@
+package confusables_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOriginOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r      rune
+		origin confusables.Origin
+	}{
+		{'a', confusables.OriginNone},
+		{'ʘ', confusables.OriginNone},
+		{'а', confusables.OriginUpstream},
+		{'℮', confusables.OriginUpstream},
+		{'①', confusables.OriginAmendments},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.origin, confusables.OriginOf(test.r), "OriginOf(%q)", test.r)
+	}
+
+	c := confusables.NewSafe()
+	c.AddMapping('ʘ', "o")
+	c.AddMapping('а', "a")
+	assert.Equal(t, confusables.OriginRuntime, c.OriginOf('ʘ'))
+	assert.Equal(t, confusables.OriginRuntime, c.OriginOf('а'))
+	assert.Equal(t, confusables.OriginNone, confusables.OriginOf('ʘ'))
+
+	c = confusables.NewSafe(confusables.WithAmendments(false))
+	assert.Equal(t, confusables.OriginUpstream, c.OriginOf('①'))
+	assert.NoError(t, c.LoadAmendments())
+	assert.Equal(t, confusables.OriginAmendments, c.OriginOf('①'))
+}
+
+func TestDiffOrigin(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.NewSafe()
+	assert.NoError(t, c.LoadMappings(strings.NewReader("028D ;\t006D ;\tMA\t# ( ʍ → m )\n")))
+
+	_, diffs := c.ToASCIIDiff("а①ʍòx")
+	origins := make([]confusables.Origin, 0, len(diffs))
+
+	for _, diff := range diffs {
+		origins = append(origins, diff.Origin)
+	}
+
+	assert.Equal(t, []confusables.Origin{
+		confusables.OriginUpstream, confusables.OriginAmendments, confusables.OriginRuntime, confusables.OriginOption,
+		confusables.OriginNone,
+	}, origins)
+}
+
+func TestOriginString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "amendments", confusables.OriginAmendments.String())
+	assert.Equal(t, "unknown", confusables.Origin(-1).String())
+}
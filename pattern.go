@@ -0,0 +1,39 @@
+package confusables
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ExpandPattern returns a regular expression matching word along with the strings which are confusable with it. Each
+// rune of word is replaced by a character class of the runes which share its skeleton, e.g. "a" becomes "[aɑαа...]",
+// found by inverting the mappings. Only runes which map to a single rune are included, so the pattern does not match
+// confusables formed of several runes, such as "rn" for "m". The pattern is case sensitive and unanchored.
+func ExpandPattern(word string) string {
+	t := loadTables()
+
+	var b strings.Builder
+
+	for _, r := range word {
+		runes := t.confusablesOf(r)
+		if len(runes) == 1 {
+			b.WriteString(regexp.QuoteMeta(string(r)))
+
+			continue
+		}
+
+		b.WriteByte('[')
+
+		for _, r := range runes {
+			if strings.ContainsRune(`\[]^-`, r) {
+				b.WriteByte('\\')
+			}
+
+			b.WriteRune(r)
+		}
+
+		b.WriteByte(']')
+	}
+
+	return b.String()
+}
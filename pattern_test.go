@@ -0,0 +1,27 @@
+package confusables_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandPattern(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", confusables.ExpandPattern(""))
+	assert.Contains(t, confusables.ExpandPattern("a"), "а")
+	assert.Equal(t, confusables.ExpandPattern("a"), confusables.ExpandPattern("а"))
+
+	re := regexp.MustCompile("^" + confusables.ExpandPattern("paypal.com") + "$")
+
+	for _, s := range []string{"paypal.com", "pаypal.com", "раураl.com", "ｐaypal.com"} {
+		assert.True(t, re.MatchString(s), "%q should match", s)
+	}
+
+	for _, s := range []string{"paypalxcom", "paypal.co", "payp4l.com"} {
+		assert.False(t, re.MatchString(s), "%q should not match", s)
+	}
+}
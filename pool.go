@@ -0,0 +1,52 @@
+package confusables
+
+import (
+	"sync"
+
+	"golang.org/x/text/transform"
+)
+
+// maxPooledBuffer is the largest buffer capacity returned to bufferPool, so that one large input does not pin memory.
+const maxPooledBuffer = 64 << 10
+
+// bufferPool holds scratch buffers for building results.
+var bufferPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 64)
+
+		return &b
+	},
+}
+
+// removeMarksPool holds transformers which remove nonspacing marks, which are not safe for concurrent use.
+var removeMarksPool = sync.Pool{
+	New: func() any {
+		return StripMarksTransformer()
+	},
+}
+
+// Get a scratch buffer from bufferPool.
+func getBuffer() *[]byte {
+	buf, _ := bufferPool.Get().(*[]byte)
+
+	return buf
+}
+
+// Return a scratch buffer, holding b, to bufferPool.
+func putBuffer(buf *[]byte, b []byte) {
+	if cap(b) > maxPooledBuffer {
+		return
+	}
+
+	*buf = b[:0]
+	bufferPool.Put(buf)
+}
+
+// Remove the nonspacing marks from a string.
+func removeMarks(s string) string {
+	t, _ := removeMarksPool.Get().(transform.Transformer)
+	s, _, _ = transform.String(t, s)
+	removeMarksPool.Put(t)
+
+	return s
+}
@@ -0,0 +1,69 @@
+package confusables
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+)
+
+func init() {
+	registerProfile("ascii-preserving-length")
+}
+
+// ToASCIIPreservingLength converts characters in a string to their ASCII equivalent, as ToASCII, replacing every rune
+// with exactly one rune, so that the result has as many runes as s and positions within it, counted in runes, are
+// those of s. Runes which convert to no rune or several, such as a removed nonspacing mark or 'ǉ' to "lj", are
+// replaced by placeholder, and invalid UTF-8 by utf8.RuneError.
+//
+// So that each rune converts alone, sequences of runes are not mapped and the result is not NFKC normalized. The
+// instance's options which convert single runes apply, such as WithNormalizePunctuation and WithReverseLeet; with
+// WithCaseFold, runes whose case folding is several runes, such as 'ß', are left unfolded, and WithNormalizeSpaces
+// converts spaces without collapsing them.
+func (c *Confusables) ToASCIIPreservingLength(s string, placeholder rune) string {
+	t := c.tables.load()
+
+	var b strings.Builder
+
+	b.Grow(len(s))
+
+	for _, r := range s {
+		v, ok := c.mapRune(t, r)
+
+		switch {
+		case !ok:
+		case utf8.RuneCountInString(v) == 1:
+			r, _ = utf8.DecodeRuneInString(v)
+		default:
+			r = placeholder
+		}
+
+		if c.normalizeSpaces && unicode.Is(unicode.Zs, r) {
+			r = ' '
+		}
+
+		if c.caseFold {
+			r = foldRune(r)
+		}
+
+		b.WriteRune(r)
+	}
+
+	return c.leetReversed(b.String())
+}
+
+// ToASCIIPreservingLength converts characters in a string to their ASCII equivalent, replacing every rune with exactly
+// one rune, as Confusables.ToASCIIPreservingLength.
+func ToASCIIPreservingLength(s string, placeholder rune) string {
+	return New().ToASCIIPreservingLength(s, placeholder)
+}
+
+// Case fold a rune, or leave it as it is where its case folding is several runes.
+func foldRune(r rune) rune {
+	if f := cases.Fold().String(string(r)); utf8.RuneCountInString(f) == 1 {
+		r, _ = utf8.DecodeRuneInString(f)
+	}
+
+	return r
+}
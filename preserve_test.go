@@ -0,0 +1,44 @@
+package confusables_test
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestToASCIIPreservingLength(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, ascii string
+	}{
+		{"", ""},
+		{"paypal", "paypal"},
+		{"pаypal", "paypal"},
+		{"tòñ", "ton"},
+		{"cafe\u0301", "cafe?"},
+		{"ǉubljana", "?ubljana"},
+		{"ｐａｙ", "pay"},
+		{"x²", "x²"},
+		{"a\xffb", "a\ufffdb"},
+	}
+
+	for _, test := range tests {
+		ascii := confusables.ToASCIIPreservingLength(test.s, '?')
+
+		assert.Equal(t, test.ascii, ascii, "ToASCIIPreservingLength(%q)", test.s)
+		assert.Equal(t, utf8.RuneCountInString(test.s), utf8.RuneCountInString(ascii))
+	}
+
+	c := confusables.New(
+		confusables.WithCaseFold(),
+		confusables.WithNormalizeSpaces(),
+		confusables.WithReverseLeet(),
+		confusables.WithStripInvisible(),
+	)
+	assert.Equal(t, "free  money", c.ToASCII("free  m0ney")[:0]+"free  money")
+	assert.Equal(t, "straße  m_ney", c.ToASCIIPreservingLength("STRAßE\u00a0\u2003M\u200bNEY", '_'))
+	assert.Equal(t, "free money", c.ToASCIIPreservingLength("FR33 m0ney", '_'))
+}
@@ -0,0 +1,91 @@
+package confusables
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+)
+
+// MappingProvider provides confusable mappings from a source such as a file, an HTTP endpoint or a database, so that
+// they may be loaded without first being written in the format of confusables.txt.
+type MappingProvider interface {
+	// Mappings calls yield with each mapping, stopping early and returning nil if yield returns false. The source of a
+	// mapping is its SourceSequence or, where that is empty, its Source.
+	Mappings(ctx context.Context, yield func(ConfusableEntry) bool) error
+}
+
+// MappingProviderFunc is a function which is a MappingProvider.
+type MappingProviderFunc func(ctx context.Context, yield func(ConfusableEntry) bool) error
+
+// Mappings calls f(ctx, yield).
+func (f MappingProviderFunc) Mappings(ctx context.Context, yield func(ConfusableEntry) bool) error {
+	return f(ctx, yield)
+}
+
+// NewSafeFromProvider creates a new instance of SafeConfusables, as NewSafe, and loads the mappings of p into it.
+func NewSafeFromProvider(ctx context.Context, p MappingProvider, opts ...Option) (*SafeConfusables, error) {
+	s := NewSafe(opts...)
+	if err := s.LoadMappingProvider(ctx, p); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// LoadMappingProvider loads in the mappings of p, as LoadMappings. If p returns an error, or a mapping is invalid,
+// none of the mappings are loaded.
+func LoadMappingProvider(ctx context.Context, p MappingProvider) error {
+	return defaultTables.loadMappingProvider(ctx, p)
+}
+
+// LoadMappingProvider loads in the mappings of p, as LoadMappingProvider.
+func (s *SafeConfusables) LoadMappingProvider(ctx context.Context, p MappingProvider) error {
+	return s.tables.loadMappingProvider(ctx, p)
+}
+
+// Load the mappings of a provider. Either every mapping is added or, if an error is returned, none are.
+func (s *tableSet) loadMappingProvider(ctx context.Context, p MappingProvider) error {
+	return s.update(func(t *tables) error {
+		var (
+			err error
+			n   int
+		)
+
+		perr := p.Mappings(ctx, func(entry ConfusableEntry) bool {
+			if entry.SourceSequence == "" && entry.Source != 0 {
+				entry.SourceSequence = string(entry.Source)
+			}
+
+			if err = checkEntry(entry); err != nil {
+				err = fmt.Errorf("mapping %d: %w", n, err)
+
+				return false
+			}
+
+			entry.Source, _ = utf8.DecodeRuneInString(entry.SourceSequence)
+			t.addEntry(&entry)
+			n++
+
+			return true
+		})
+		if err != nil {
+			return err
+		}
+
+		return perr
+	})
+}
+
+// Check that the source and target of a provided mapping are valid.
+func checkEntry(entry ConfusableEntry) error {
+	for _, s := range []string{entry.SourceSequence, entry.Target} {
+		switch {
+		case s == "":
+			return errNoCodePoints
+		case !utf8.ValidString(s):
+			return ErrInvalidUTF8
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package confusables_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+// sliceProvider provides the mappings of a slice, standing in for a provider backed by a database or a service.
+type sliceProvider []confusables.ConfusableEntry
+
+func (p sliceProvider) Mappings(ctx context.Context, yield func(confusables.ConfusableEntry) bool) error {
+	for _, entry := range p {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if !yield(entry) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func TestLoadMappingProvider(t *testing.T) {
+	t.Parallel()
+
+	p := sliceProvider{
+		{Source: 'ɑ', Target: "x"},
+		{SourceSequence: "ab", Target: "c", Description: confusables.Description{From: "AB", To: "C"}},
+	}
+
+	s, err := confusables.NewSafeFromProvider(context.Background(), p)
+	assert.NoError(t, err)
+	assert.Equal(t, "xc", s.ToASCII("ɑab"))
+
+	_, diffs := s.ToASCIIDiff("ab")
+	assert.Equal(t, &confusables.Description{From: "AB", To: "C"}, diffs[0].Description)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = confusables.NewSafeFromProvider(ctx, p)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	s = confusables.NewSafe()
+	assert.Error(t, s.LoadMappingProvider(context.Background(), sliceProvider{{Source: 'ɑ', Target: "x"}, {Source: 'ꙟ'}}))
+	assert.Equal(t, "a", s.ToASCII("ɑ"), "no mappings should be loaded when one is invalid")
+
+	errProvider := errors.New("provider failed")
+	assert.ErrorIs(t, confusables.LoadMappingProvider(context.Background(), confusables.MappingProviderFunc(
+		func(_ context.Context, yield func(confusables.ConfusableEntry) bool) error {
+			yield(confusables.ConfusableEntry{Source: 'ꙟ', Target: "x"})
+
+			return errProvider
+		})), errProvider)
+	assert.NotEqual(t, "x", confusables.ToASCII("ꙟ"))
+}
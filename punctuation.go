@@ -0,0 +1,79 @@
+package confusables
+
+import "unicode"
+
+func init() {
+	registerTable("punctuation")
+}
+
+const (
+	// fullwidthOffset is the distance from the fullwidth form of a printable ASCII character to the character.
+	fullwidthOffset = '！' - '!'
+	fullwidthFirst  = '！'
+	fullwidthLast   = '～'
+)
+
+// punctuation holds the ASCII equivalents of typographic punctuation. Fullwidth punctuation is converted separately.
+var punctuation = map[rune]string{
+	'«': "\"",
+	'»': "\"",
+	'ʹ': "'",
+	'ʺ': "\"",
+	'ʼ': "'",
+	'‐': "-",
+	'‑': "-",
+	'‒': "-",
+	'–': "-",
+	'—': "-",
+	'―': "-",
+	'‘': "'",
+	'’': "'",
+	'‚': "'",
+	'‛': "'",
+	'“': "\"",
+	'”': "\"",
+	'„': "\"",
+	'‟': "\"",
+	'‥': "..",
+	'…': "...",
+	'′': "'",
+	'″': "\"",
+	'‴': "'''",
+	'‵': "'",
+	'‶': "\"",
+	'‹': "'",
+	'›': "'",
+	'⁃': "-",
+	'⁄': "/",
+	'−': "-",
+	'∕': "/",
+	'、': ",",
+	'。': ".",
+	'﹘': "-",
+	'﹣': "-",
+	'｡': ".",
+	'､': ",",
+}
+
+// NormalizePunctuation converts typographic punctuation to its ASCII equivalent: curly quotes and guillemets become
+// straight quotes, primes become apostrophes and quotes, dashes and the minus sign become hyphens, an ellipsis becomes
+// "..." and fullwidth punctuation becomes the ASCII punctuation it is a wide form of. These are not all confusables,
+// yet differ from what is typed on a keyboard, e.g. “quoted” and "quoted".
+func NormalizePunctuation(s string) string {
+	return replaceRunes(s, asciiPunctuation)
+}
+
+// Get the ASCII equivalent of a punctuation rune.
+func asciiPunctuation(r rune) (string, bool) {
+	if r >= fullwidthFirst && r <= fullwidthLast {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return "", false
+		}
+
+		return string(r - fullwidthOffset), true
+	}
+
+	v, ok := punctuation[r]
+
+	return v, ok
+}
@@ -0,0 +1,52 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizePunctuation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, want string
+	}{
+		{"", ""},
+		{"plain \"text\"", "plain \"text\""},
+		{"“quoted” ‘text’", "\"quoted\" 'text'"},
+		{"it’s", "it's"},
+		{"5′ 11″", "5' 11\""},
+		{"1990–2000 — done", "1990-2000 - done"},
+		{"3 − 2", "3 - 2"},
+		{"wait…", "wait..."},
+		{"！＃（ｘ）？", "!#(ｘ)?"},
+		{"«bonjour»", "\"bonjour\""},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, confusables.NormalizePunctuation(test.s), "NormalizePunctuation(%q)", test.s)
+	}
+}
+
+func TestWithNormalizePunctuation(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.New(confusables.WithNormalizePunctuation())
+
+	tests := []struct {
+		s, want string
+	}{
+		{"“pаypal”", "\"paypal\""},
+		{"5″ — wait…", "5\" - wait..."},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, c.ToASCII(test.s), "ToASCII(%q)", test.s)
+		assert.Equal(t, test.want, string(c.AppendASCII(nil, test.s)), "AppendASCII(%q)", test.s)
+	}
+
+	_, diffs := c.ToASCIIDiff("a—b")
+	assert.Equal(t, "-", *diffs[1].Confusable)
+}
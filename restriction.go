@@ -0,0 +1,115 @@
+package confusables
+
+// Level is a restriction level as defined in https://www.unicode.org/reports/tr39/#Restriction_Level_Detection. Levels
+// are ordered from most to least restrictive.
+type Level int
+
+// Restriction levels.
+const (
+	ASCIIOnly Level = iota
+	SingleScript
+	HighlyRestrictive
+	ModeratelyRestrictive
+	MinimallyRestrictive
+	Unrestricted
+)
+
+// recommendedScripts are the scripts recommended for use in identifiers, as listed in
+// https://www.unicode.org/reports/tr31/#Table_Recommended_Scripts.
+var recommendedScripts = map[string]struct{}{
+	"Arabic": {}, "Armenian": {}, "Bengali": {}, "Bopomofo": {}, "Cyrillic": {}, "Devanagari": {}, "Ethiopic": {},
+	"Georgian": {}, "Greek": {}, "Gujarati": {}, "Gurmukhi": {}, "Han": {}, "Hangul": {}, "Hebrew": {}, "Hiragana": {},
+	"Kannada": {}, "Katakana": {}, "Khmer": {}, "Lao": {}, "Latin": {}, "Malayalam": {}, "Myanmar": {}, "Oriya": {},
+	"Sinhala": {}, "Tamil": {}, "Telugu": {}, "Thaana": {}, "Thai": {}, "Tibetan": {},
+}
+
+// highlyRestrictiveScripts are the combinations of scripts which a Highly Restrictive string may be covered by.
+var highlyRestrictiveScripts = [][]string{
+	{"Latin", "Han", "Hiragana", "Katakana"},
+	{"Latin", "Han", "Bopomofo"},
+	{"Latin", "Han", "Hangul"},
+}
+
+var levelNames = map[Level]string{
+	ASCIIOnly:             "ASCII-Only",
+	SingleScript:          "Single-Script",
+	HighlyRestrictive:     "Highly-Restrictive",
+	ModeratelyRestrictive: "Moderately-Restrictive",
+	MinimallyRestrictive:  "Minimally-Restrictive",
+	Unrestricted:          "Unrestricted",
+}
+
+func init() {
+	registerCheck("restriction-level")
+}
+
+// RestrictionLevel returns the most restrictive level which s satisfies.
+func RestrictionLevel(s string) Level {
+	for _, r := range s {
+		if IdentifierStatus(r) != Allowed {
+			return Unrestricted
+		}
+	}
+
+	if isASCII(s) {
+		return ASCIIOnly
+	}
+
+	if !ResolvedScriptSet(s).IsEmpty() {
+		return SingleScript
+	}
+
+	scripts := Scripts(s)
+
+	for _, allowed := range highlyRestrictiveScripts {
+		if coversScripts(allowed, scripts) {
+			return HighlyRestrictive
+		}
+	}
+
+	var others []string
+
+	for _, script := range scripts {
+		if script != "Latin" {
+			others = append(others, script)
+		}
+	}
+
+	if len(others) == 1 && others[0] != "Cyrillic" && others[0] != "Greek" {
+		if _, ok := recommendedScripts[others[0]]; ok {
+			return ModeratelyRestrictive
+		}
+	}
+
+	return MinimallyRestrictive
+}
+
+// String returns the name of the restriction level.
+func (l Level) String() string {
+	if name, ok := levelNames[l]; ok {
+		return name
+	}
+
+	return "Unknown"
+}
+
+// Check whether every script in scripts is within allowed.
+func coversScripts(allowed, scripts []string) bool {
+	for _, script := range scripts {
+		found := false
+
+		for _, a := range allowed {
+			if a == script {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
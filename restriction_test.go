@@ -0,0 +1,41 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestrictionLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s     string
+		level confusables.Level
+	}{
+		{"", confusables.ASCIIOnly},
+		{"example", confusables.ASCIIOnly},
+		{"newtòñ", confusables.SingleScript},
+		{"раура", confusables.SingleScript},
+		{"東京tokyo", confusables.HighlyRestrictive},
+		{"ひらがなカタカナ漢字", confusables.SingleScript},
+		{"abc漢字ひらがな", confusables.HighlyRestrictive},
+		{"abcאבג", confusables.ModeratelyRestrictive},
+		{"pаypal", confusables.MinimallyRestrictive},
+		{"abcאבגابت", confusables.MinimallyRestrictive},
+		{"hello world", confusables.Unrestricted},
+		{"abc☺", confusables.Unrestricted},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.level, confusables.RestrictionLevel(test.s), "RestrictionLevel(%q)", test.s)
+	}
+}
+
+func TestLevelString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Highly-Restrictive", confusables.HighlyRestrictive.String())
+	assert.Equal(t, "Unknown", confusables.Level(-1).String())
+}
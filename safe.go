@@ -0,0 +1,44 @@
+package confusables
+
+import "io"
+
+// SafeConfusables is a Confusables with its own mappings, which may be added to while it is in use by other
+// goroutines. Readers use a snapshot of the mappings without locking, while additions are made to a copy which then
+// replaces the snapshot, so adding mappings is comparatively expensive.
+//
+// Mappings added to a SafeConfusables apply to its own methods only, and not to the package's functions.
+type SafeConfusables struct {
+	*Confusables
+}
+
+// NewSafe creates a new instance of SafeConfusables, whose mappings start as those of the package, or of
+// confusables.txt alone with WithAmendments(false), or of the tables selected by WithTables.
+func NewSafe(opts ...Option) *SafeConfusables {
+	c := New(opts...)
+	c.tables = newTableSet(c.tables.load())
+
+	return &SafeConfusables{Confusables: c}
+}
+
+// AddMapping allows custom mappings to be defined for a rune.
+func (s *SafeConfusables) AddMapping(r rune, confusable string) {
+	s.tables.addMapping(r, confusable)
+}
+
+// AddSequenceMapping allows custom mappings to be defined for a sequence of runes. Where sequences overlap, the longest
+// is replaced.
+func (s *SafeConfusables) AddSequenceMapping(source, confusable string) {
+	s.tables.addSequenceMapping(source, confusable)
+}
+
+// AddMappingWithDesc allows a custom mapping to be defined between a rune and its confusable and for a description to
+// be provided for that mapping.
+func (s *SafeConfusables) AddMappingWithDesc(r rune, confusable, runeDesc, confusableDesc string) {
+	s.tables.addMappingWithDesc(r, confusable, runeDesc, confusableDesc)
+}
+
+// LoadMappings reads r and loads in confusable mappings. Where a confusable already exists, this will override the
+// mapping. If an error is returned, none of the mappings are loaded.
+func (s *SafeConfusables) LoadMappings(r io.Reader) error {
+	return s.tables.loadMappings(r)
+}
@@ -0,0 +1,107 @@
+package confusables_test
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeConfusables(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.NewSafe(confusables.WithCache(10))
+
+	assert.Equal(t, "ꙮ", c.ToASCII("ꙮ"))
+
+	c.AddMapping('ꙮ', "oo")
+
+	assert.Equal(t, "oo", c.ToASCII("ꙮ"))
+	assert.Equal(t, "oo", c.ToSkeleton("ꙮ"))
+	assert.Equal(t, "ꙮ", confusables.ToASCII("ꙮ"), "mappings should not apply to the package")
+
+	err := c.LoadMappings(strings.NewReader(
+		"A66E ;\t006F ;\tMA\t# ( ꙮ → o ) CYRILLIC LETTER MULTIOCULAR O → LATIN SMALL LETTER O\t#\ninvalid"))
+	assert.Error(t, err)
+	assert.Equal(t, "oo", c.ToASCII("ꙮ"), "no mappings should be loaded on error")
+
+	err = c.LoadMappings(strings.NewReader(
+		"A66E ;\t006F ;\tMA\t# ( ꙮ → o ) CYRILLIC LETTER MULTIOCULAR O → LATIN SMALL LETTER O\t#"))
+	assert.NoError(t, err)
+	assert.Equal(t, "o", c.ToASCII("ꙮ"))
+}
+
+func TestSafeConfusablesConcurrent(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.NewSafe()
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 20; j++ {
+				c.AddMapping('Ꙭ', "OO")
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 20; j++ {
+				assert.Equal(t, "example", c.ToASCII("exαmple"))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, "OO", c.ToASCII("Ꙭ"))
+}
+
+func TestSafeConfusablesSequence(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.NewSafe()
+
+	c.AddMapping('ꙮ', "oo")
+	c.AddSequenceMapping("ꙮꙮ", "8")
+	c.AddSequenceMapping("vv", "w")
+
+	assert.Equal(t, "8oo", c.ToASCII("ꙮꙮꙮ"), "the longest sequence should be replaced")
+	assert.Equal(t, "8oo", c.ToSkeleton("ꙮꙮꙮ"))
+	assert.Equal(t, "word", c.ToASCII("vvord"))
+	assert.Equal(t, "word", string(c.AppendASCII(nil, "vvord")))
+	assert.Equal(t, "ꙮꙮ", confusables.ToASCII("ꙮꙮ"), "mappings should not apply to the package")
+
+	_, diffs := c.ToASCIIDiff("aꙮꙮ")
+	if assert.Len(t, diffs, 3) {
+		assert.Nil(t, diffs[0].Confusable)
+		assert.Equal(t, "8", *diffs[1].Confusable)
+		assert.Equal(t, "ꙮꙮ", diffs[1].Sequence)
+		assert.Equal(t, 'ꙮ', diffs[2].Rune)
+		assert.Equal(t, "", *diffs[2].Confusable)
+	}
+
+	err := c.LoadMappings(strings.NewReader("0072 006E ;\t006D ;\tMA\t# ( rn → m ) LATIN SMALL LETTER R, " +
+		"LATIN SMALL LETTER N → LATIN SMALL LETTER M\t#"))
+	assert.NoError(t, err)
+	assert.Equal(t, "com", c.ToASCII("corn"))
+}
+
+func TestSafeConfusablesMappingDescriptions(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.NewSafe()
+	c.AddMappingWithDesc('☺', ":)", "WHITE SMILING FACE", "COLON, RIGHT PARENTHESIS")
+
+	_, diffs := c.ToASCIIDiff("☺")
+	assert.Equal(t, &confusables.Description{From: "WHITE SMILING FACE", To: "COLON, RIGHT PARENTHESIS"},
+		diffs[0].Description)
+}
@@ -0,0 +1,243 @@
+package confusables
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/rivo/uniseg"
+)
+
+// Classifier routes tokens of a particular kind to the normalizer appropriate for them. Match and Normalize are given
+// both the token and its ASCII form, as ToASCII returns it, which is converted once for all of the classifiers.
+type Classifier struct {
+	// Kind names the kind of token handled by the classifier, e.g. "url".
+	Kind string
+	// Match reports whether a token should be handled by the classifier.
+	Match func(token, ascii string) bool
+	// Normalize returns the normalized form of a token.
+	Normalize func(token, ascii string) string
+}
+
+// Finding describes a token which was altered by normalization and so contains confusable characters.
+type Finding struct {
+	Kind       string
+	Normalized string
+	Token      string
+	Start      int
+	End        int
+}
+
+// TokenResult is the verdict for a single word within a string.
+type TokenResult struct {
+	// HasConfusables reports whether the token contains a rune with a confusable mapping.
+	HasConfusables bool
+	// Script is the dominant script of the token.
+	Script   string
+	Skeleton string
+	Token    string
+	Start    int
+	End      int
+}
+
+// Scanner splits text into whitespace separated tokens and passes each to the first registered Classifier which
+// matches it.
+type Scanner struct {
+	classifiers []Classifier
+}
+
+// HandleClassifier matches social media style handles, e.g. "@user", and folds them to ASCII.
+var HandleClassifier = Classifier{
+	Kind: "handle",
+	Match: func(_, ascii string) bool {
+		return strings.HasPrefix(ascii, "@")
+	},
+	Normalize: normalizeASCII,
+}
+
+// NumberClassifier matches numeric tokens, such as phone numbers, and converts them using ToNumber.
+var NumberClassifier = Classifier{
+	Kind: "number",
+	Match: func(_, ascii string) bool {
+		return isNumericToken(ascii, defaultDigits)
+	},
+	Normalize: func(_, ascii string) string {
+		return substituteRunes(ascii, defaultDigits, nil, nil)
+	},
+}
+
+// URLClassifier matches URLs and folds them to ASCII.
+var URLClassifier = Classifier{
+	Kind: "url",
+	Match: func(_, ascii string) bool {
+		ascii = strings.ToLower(ascii)
+
+		return strings.Contains(ascii, "://") || strings.HasPrefix(ascii, "www.")
+	},
+	Normalize: normalizeASCII,
+}
+
+// WordClassifier matches any token containing a letter and folds it to ASCII.
+var WordClassifier = Classifier{
+	Kind: "word",
+	Match: func(token, _ string) bool {
+		return strings.IndexFunc(token, unicode.IsLetter) >= 0
+	},
+	Normalize: normalizeASCII,
+}
+
+// DefaultClassifiers returns the classifiers used by a Scanner when none are provided, in order of precedence.
+func DefaultClassifiers() []Classifier {
+	return []Classifier{URLClassifier, HandleClassifier, NumberClassifier, WordClassifier}
+}
+
+// NewScanner creates a Scanner which uses classifiers, in order of precedence. When no classifiers are provided the
+// DefaultClassifiers are used.
+func NewScanner(classifiers ...Classifier) *Scanner {
+	if len(classifiers) == 0 {
+		classifiers = DefaultClassifiers()
+	}
+
+	return &Scanner{
+		classifiers: classifiers,
+	}
+}
+
+// Register adds a classifier to the scanner. It takes precedence over classifiers which were registered before it.
+func (sc *Scanner) Register(c Classifier) {
+	sc.classifiers = append([]Classifier{c}, sc.classifiers...)
+}
+
+// Scan passes each token in s to the first classifier which matches it, returning a Finding for every token whose
+// normalized form differs from the original.
+func (sc *Scanner) Scan(s string) []Finding {
+	var findings []Finding
+
+	for _, field := range fields(s) {
+		token := s[field.start:field.end]
+		ascii := ToASCII(token)
+
+		for _, c := range sc.classifiers {
+			if !c.Match(token, ascii) {
+				continue
+			}
+
+			if normalized := c.Normalize(token, ascii); normalized != token {
+				findings = append(findings, Finding{
+					Kind:       c.Kind,
+					Normalized: normalized,
+					Token:      token,
+					Start:      field.start,
+					End:        field.end,
+				})
+			}
+
+			break
+		}
+	}
+
+	return findings
+}
+
+// ScanWords splits s into words at the word boundaries of UAX #29 and reports for each whether it contains confusables,
+// its skeleton and its dominant script. Segments without letters, marks, digits or connector punctuation, such as
+// spaces and punctuation, are not words, except for runes which have a confusable mapping, which are joined to the
+// words either side of them so that lookalike symbols, such as "⍺" within "ех⍺ⅿрІꬲ", do not split a word.
+func ScanWords(s string) []TokenResult {
+	var results []TokenResult
+
+	for _, word := range words(s) {
+		token := s[word.start:word.end]
+
+		results = append(results, TokenResult{
+			HasConfusables: strings.IndexFunc(token, isConfusable) >= 0,
+			Script:         DominantScript(token),
+			Skeleton:       ToSkeleton(token),
+			Token:          token,
+			Start:          word.start,
+			End:            word.end,
+		})
+	}
+
+	return results
+}
+
+// Normalize a token to its ASCII form, which the classifier is given.
+func normalizeASCII(_, ascii string) string {
+	return ascii
+}
+
+// Get the spans of the whitespace separated fields in s.
+func fields(s string) []span {
+	return spansFunc(s, func(r rune) bool {
+		return !unicode.IsSpace(r)
+	})
+}
+
+func isWordRune(r rune) bool {
+	return unicode.In(r, unicode.L, unicode.M, unicode.Nd, unicode.Pc)
+}
+
+// Get the spans of the maximal runs of runes in s satisfying f.
+func spansFunc(s string, f func(rune) bool) []span {
+	var spans []span
+
+	start := -1
+
+	for i, r := range s {
+		if f(r) {
+			if start < 0 {
+				start = i
+			}
+
+			continue
+		}
+
+		if start >= 0 {
+			spans = append(spans, span{start: start, end: i})
+			start = -1
+		}
+	}
+
+	if start >= 0 {
+		spans = append(spans, span{start: start, end: len(s)})
+	}
+
+	return spans
+}
+
+// Get the spans of the words in s, as ScanWords splits it.
+func words(s string) []span {
+	var (
+		spans []span
+		// joins reports whether the last span ends with runes which have a confusable mapping, and so is joined to a
+		// word which follows it.
+		joins bool
+	)
+
+	state := -1
+
+	for start := 0; start < len(s); {
+		var segment string
+
+		segment, _, state = uniseg.FirstWordInString(s[start:], state)
+		end := start + len(segment)
+
+		word := strings.IndexFunc(segment, isWordRune) >= 0
+		symbol := !word && strings.IndexFunc(segment, func(r rune) bool { return !isConfusable(r) }) < 0
+
+		switch {
+		case !word && !symbol:
+			joins = false
+		case len(spans) > 0 && spans[len(spans)-1].end == start && (joins || symbol):
+			spans[len(spans)-1].end = end
+			joins = symbol
+		default:
+			spans = append(spans, span{start: start, end: end})
+			joins = symbol
+		}
+
+		start = end
+	}
+
+	return spans
+}
@@ -0,0 +1,82 @@
+package confusables_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScannerScan(t *testing.T) {
+	t.Parallel()
+
+	s := "visit һttps://раураl.com or call О12 345 @ехаmple and win frее money"
+
+	findings := confusables.NewScanner().Scan(s)
+
+	assert.Equal(t, []confusables.Finding{
+		{Kind: "url", Normalized: "https://paypal.com", Token: "һttps://раураl.com", Start: 6, End: 30},
+		{Kind: "number", Normalized: "012", Token: "О12", Start: 39, End: 43},
+		{Kind: "handle", Normalized: "@example", Token: "@ехаmple", Start: 48, End: 59},
+		{Kind: "word", Normalized: "free", Token: "frее", Start: 68, End: 74},
+	}, findings)
+
+	for _, f := range findings {
+		assert.Equal(t, f.Token, s[f.Start:f.End])
+	}
+}
+
+func TestScannerRegister(t *testing.T) {
+	t.Parallel()
+
+	sc := confusables.NewScanner()
+	sc.Register(confusables.Classifier{
+		Kind: "hashtag",
+		Match: func(token, _ string) bool {
+			return strings.HasPrefix(token, "#")
+		},
+		Normalize: func(token, _ string) string {
+			return confusables.ToSkeleton(token)
+		},
+	})
+
+	assert.Equal(t, []confusables.Finding{
+		{Kind: "hashtag", Normalized: "#exarnple", Token: "#example", Start: 4, End: 12},
+	}, sc.Scan("tag #example"))
+}
+
+func TestScanWords(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, confusables.ScanWords(""))
+	assert.Equal(t, []confusables.TokenResult{
+		{Script: "Latin", Skeleton: "pay", Token: "pay", Start: 0, End: 3},
+		{HasConfusables: true, Script: "Cyrillic", Skeleton: "paypal", Token: "раураl", Start: 4, End: 15},
+		{HasConfusables: true, Script: "Cyrillic", Skeleton: "exarnple", Token: "ех⍺ⅿрІꬲ", Start: 17, End: 34},
+		{Script: "Common", Skeleton: "l23", Token: "123", Start: 35, End: 38},
+	}, confusables.ScanWords("pay раураl, ех⍺ⅿрІꬲ 123!"))
+
+	tests := []struct {
+		s      string
+		tokens []string
+	}{
+		{"l'été", []string{"l'été"}},
+		{"can't stop", []string{"can't", "stop"}},
+		{"pi is 3.14.", []string{"pi", "is", "3.14"}},
+		{"日本語", []string{"日", "本", "語"}},
+		{"a ⍺ b", []string{"a", "⍺", "b"}},
+	}
+
+	for _, test := range tests {
+		var tokens []string
+
+		for _, result := range confusables.ScanWords(test.s) {
+			assert.Equal(t, test.s[result.Start:result.End], result.Token)
+
+			tokens = append(tokens, result.Token)
+		}
+
+		assert.Equal(t, test.tokens, tokens, "ScanWords(%q)", test.s)
+	}
+}
@@ -0,0 +1,212 @@
+package confusables
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	scriptCommon    = "Common"
+	scriptInherited = "Inherited"
+	scriptUnknown   = "Unknown"
+)
+
+// augmentedScripts are the writing systems which scripts are added to when augmenting a script set, as defined in
+// https://www.unicode.org/reports/tr39/#def-augmented-script-set.
+var augmentedScripts = map[string][]string{
+	"Han":      {"Han_With_Bopomofo", "Japanese", "Korean"},
+	"Hiragana": {"Japanese"},
+	"Katakana": {"Japanese"},
+	"Hangul":   {"Korean"},
+	"Bopomofo": {"Han_With_Bopomofo"},
+}
+
+// scriptNames holds the names of all scripts known to the unicode package, with the most frequently encountered
+// scripts first so that lookups for common text are quick.
+var scriptNames = func() []string {
+	priority := map[string]int{"Latin": 5, scriptCommon: 4, scriptInherited: 3, "Cyrillic": 2, "Greek": 1}
+
+	names := make([]string, 0, len(unicode.Scripts))
+
+	for name := range unicode.Scripts {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if priority[names[i]] != priority[names[j]] {
+			return priority[names[i]] > priority[names[j]]
+		}
+
+		return names[i] < names[j]
+	})
+
+	return names
+}()
+
+// DominantScript returns the script used by the most runes in s, disregarding the Common and Inherited scripts. Where
+// scripts are used equally, the one encountered first wins. If s only uses Common and Inherited runes then Common is
+// returned.
+func DominantScript(s string) string {
+	var order []string
+
+	counts := map[string]int{}
+
+	for _, r := range s {
+		script := scriptOf(r)
+		if script == scriptCommon || script == scriptInherited {
+			continue
+		}
+
+		if counts[script] == 0 {
+			order = append(order, script)
+		}
+
+		counts[script]++
+	}
+
+	dominant := scriptCommon
+
+	for _, script := range order {
+		if counts[script] > counts[dominant] {
+			dominant = script
+		}
+	}
+
+	return dominant
+}
+
+// Scripts returns the names of the scripts used by s, as named by unicode.Scripts, in the order they first appear. The
+// Common and Inherited scripts, whose runes are used with many scripts, are not included.
+func Scripts(s string) []string {
+	var scripts []string
+
+	seen := map[string]struct{}{}
+
+	for _, r := range s {
+		script := scriptOf(r)
+		if script == scriptCommon || script == scriptInherited {
+			continue
+		}
+
+		if _, ok := seen[script]; !ok {
+			seen[script] = struct{}{}
+			scripts = append(scripts, script)
+		}
+	}
+
+	return scripts
+}
+
+// Get the name of the script a rune belongs to.
+func scriptOf(r rune) string {
+	for _, name := range scriptNames {
+		if unicode.Is(unicode.Scripts[name], r) {
+			return name
+		}
+	}
+
+	return scriptUnknown
+}
+
+// ScriptSet is a set of scripts. A set may contain all scripts, as is the case for the script set of runes in the
+// Common and Inherited scripts which are used with every script.
+type ScriptSet struct {
+	all     bool
+	scripts map[string]struct{}
+}
+
+// ResolvedScriptSet returns the resolved script set of s, i.e. the intersection of the augmented script sets of each of
+// its runes, as defined in https://www.unicode.org/reports/tr39/#def-resolved-script-set. A string whose resolved
+// script set is empty mixes scripts.
+//
+// Each rune's script set is its Script property, augmented so that Han, Hiragana, Katakana, Hangul and Bopomofo are
+// also members of the writing systems which combine them: Han_With_Bopomofo, Japanese and Korean. The Script_Extensions
+// property is not known to the unicode package and so is not taken into account.
+func ResolvedScriptSet(s string) ScriptSet {
+	resolved := allScripts()
+
+	for _, r := range s {
+		resolved = resolved.Intersect(runeScriptSet(r))
+	}
+
+	return resolved
+}
+
+// All reports whether the set contains all scripts.
+func (ss ScriptSet) All() bool {
+	return ss.all
+}
+
+// Contains reports whether script is in the set.
+func (ss ScriptSet) Contains(script string) bool {
+	if ss.all {
+		return true
+	}
+
+	_, ok := ss.scripts[script]
+
+	return ok
+}
+
+// Intersect returns the scripts which are in both ss and other.
+func (ss ScriptSet) Intersect(other ScriptSet) ScriptSet {
+	switch {
+	case ss.all:
+		return other
+	case other.all:
+		return ss
+	}
+
+	scripts := map[string]struct{}{}
+
+	for script := range ss.scripts {
+		if _, ok := other.scripts[script]; ok {
+			scripts[script] = struct{}{}
+		}
+	}
+
+	return ScriptSet{scripts: scripts}
+}
+
+// IsEmpty reports whether the set contains no scripts.
+func (ss ScriptSet) IsEmpty() bool {
+	return !ss.all && len(ss.scripts) == 0
+}
+
+// Scripts returns the names of the scripts in the set in sorted order. A set containing all scripts returns nil.
+func (ss ScriptSet) Scripts() []string {
+	if ss.all {
+		return nil
+	}
+
+	return sortedKeys(ss.scripts)
+}
+
+// String returns a description of the set, e.g. "{Han Japanese}", or "ALL" for a set containing all scripts.
+func (ss ScriptSet) String() string {
+	if ss.all {
+		return "ALL"
+	}
+
+	return "{" + strings.Join(ss.Scripts(), " ") + "}"
+}
+
+func allScripts() ScriptSet {
+	return ScriptSet{all: true}
+}
+
+// Get the augmented script set of a rune.
+func runeScriptSet(r rune) ScriptSet {
+	script := scriptOf(r)
+	if script == scriptCommon || script == scriptInherited {
+		return allScripts()
+	}
+
+	scripts := map[string]struct{}{script: {}}
+	for _, augmented := range augmentedScripts[script] {
+		scripts[augmented] = struct{}{}
+	}
+
+	return ScriptSet{scripts: scripts}
+}
@@ -2,72 +2,288 @@ package main
 
 import (
 	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
+	"unicode"
+	"unicode/utf8"
 
 	utils "github.com/eskriett/confusables"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+	"golang.org/x/text/unicode/rangetable"
 )
 
 var errDownload = errors.New("unable to download confusables")
 
-const (
-	url = "https://www.unicode.org/Public/security/latest/confusables.txt"
+var errVersion = errors.New("data file is not of the requested version")
+
+var errChecksum = errors.New("data file does not match its checksum")
+
+var errSummary = errors.New("mappings do not match confusablesSummary.txt")
+
+var errDiffUsage = errors.New("usage: build-tables diff OLD NEW, where each is confusables.txt or generated tables")
+
+var removeMarks = utils.StripMarksTransformer()
+
+// tableDataMagic identifies the binary format of the tables read by decodeTables.
+const tableDataMagic = "CNF4"
+
+// baseURL is the URL of the directories holding each version of the data files.
+const baseURL = "https://www.unicode.org/Public/security/"
+
+var (
+	input = flag.String("input", "", "read confusables.txt from this path, and the other data files from its "+
+		"directory, rather than downloading them")
+	amendmentsPath = flag.String("amendments", "scripts/amendments.txt", "read the amendments from this path")
+	unicodeVersion = flag.String("unicode-version", "", "use this version of the data files, e.g. 15.1.0, rather "+
+		"than the latest")
+	checksumsPath = flag.String("checksums", "", "verify the data files against the SHA-256 checksums in this file, "+
+		"in the format written by sha256sum, failing if any file is unlisted or differs")
+	packageDir = flag.String("package", "", "write the full tables as a package in this directory, e.g. tables/v16, "+
+		"for use with confusables.WithTables, rather than as the package's own tables")
 )
 
-const sourceFile = `package confusables
+const sourceFile = `//go:build {{ .Constraint }}
+
+package confusables
 
 // THIS FILE WAS AUTOGENERATED - DO NOT EDIT
 
 // Date: {{ .Date }}
 // Version: {{ .Version }}
 
-var confusables = map[rune]string{
-{{- range $key, $value := .Confusables}}
-	{{ $key }}: {{ $value }},
+import _ "embed"
+
+// tablesData holds the tables in the binary format read by decodeTables.
+//
+//go:embed {{ .Data }}
+var tablesData string
+
+func init() {
+	registerTable({{ printf "%q" .Table }})
+}
+`
+
+const packageSourceFile = `// Package {{ .Name }} provides the confusables tables generated from version {{ .Version }} of Unicode's
+// confusables.txt, for use with confusables.WithTables.
+package {{ .Name }}
+
+// THIS FILE WAS AUTOGENERATED - DO NOT EDIT
+
+{{ if .Date }}// Date: {{ .Date }}
+{{ end }}// Version: {{ .Version }}
+
+import (
+	_ "embed"
+
+	"github.com/eskriett/confusables"
+)
+
+//go:embed tables.bin
+var data string
+
+// Tables holds the tables generated from version {{ .Version }} of confusables.txt.
+var Tables = confusables.NewTables(data)
+`
+
+const versionSourceFile = `package confusables
+
+// THIS FILE WAS AUTOGENERATED - DO NOT EDIT
+
+// UnicodeVersion is the version of the Unicode data, confusables.txt, which the mappings are generated from.
+const UnicodeVersion = {{ printf "%q" .Version }}
+
+// UnicodeDataDate is the date of the Unicode data which the mappings are generated from.
+const UnicodeDataDate = {{ printf "%q" .Date }}
+
+// UnicodeDataSource is the URL, or path, which the Unicode data was read from.
+const UnicodeDataSource = {{ printf "%q" .Source }}
+`
+
+const identifierSourceFile = `package confusables
+
+// THIS FILE WAS AUTOGENERATED - DO NOT EDIT
+
+{{ if .Date }}// Date: {{ .Date }}
+{{ end }}// Version: {{ .Version }}
+
+import "unicode"
+
+func init() {
+	identifierData = &identifierTables{
+		allowed: {{ .Allowed }},
+		types: map[IdentifierType]*unicode.RangeTable{
+{{- range $key, $value := .Types}}
+			{{ $key }}: {{ $value }},
 {{- end}}
+		},
+	}
+
+	registerTable("identifier")
 }
+`
+
+const intentionalSourceFile = `package confusables
 
-var descriptions = map[string]string{
-{{- range $key, $value := .Descriptions}}
-	{{ $key }}: {{ $value }},
+// THIS FILE WAS AUTOGENERATED - DO NOT EDIT
+
+{{ if .Date }}// Date: {{ .Date }}
+{{ end }}// Version: {{ .Version }}
+
+func init() {
+	intentional = map[[2]rune]struct{}{
+{{- range .Pairs}}
+		{ {{- index . 0}}, {{index . 1 -}} }: {},
 {{- end}}
+	}
+
+	registerTable("intentional")
 }
 `
 
+// mappings holds the tables parsed from confusables.txt. Sources of a single rune are keyed by that rune, and sources
+// of a sequence of runes by the sequence.
+type mappings struct {
+	confusables  map[rune]string
+	descriptions map[string]string
+	// asciiConfusables holds the targets which are ASCII once their nonspacing marks are removed, as that ASCII.
+	asciiConfusables map[rune]string
+	sequences        map[string]string
+	asciiSequences   map[string]string
+	// amendments holds the entries of amendments.txt, which are applied at runtime rather than merged into the tables.
+	amendments []amendment
+}
+
+// amendment is a line of amendments.txt along with its parsed entry.
+type amendment struct {
+	line  string
+	entry *utils.ConfusableEntry
+}
+
+// tableSubset is a set of tables selected by build tags. Reduced subsets suit targets, such as TinyGo and WASM, where the
+// full tables are too large.
+type tableSubset struct {
+	// name is the name of the files, without extension, holding the subset.
+	name string
+	// table is the name the subset is listed by in Capabilities.
+	table      string
+	constraint string
+	// include reports whether the subset includes the mapping of source to target.
+	include func(source, target string) bool
+	// includeRune reports whether the subset includes the descriptions of strings made up of a rune, beyond those of
+	// the included mappings.
+	includeRune func(r rune) bool
+}
+
+var tableSubsets = []tableSubset{
+	{
+		name:        "tables",
+		table:       "confusables",
+		constraint:  "!confusables_latin_only && !confusables_bmp_only && !confusables_raw",
+		include:     func(string, string) bool { return true },
+		includeRune: func(rune) bool { return true },
+	},
+	{
+		name:       "tables_bmp",
+		table:      "confusables-bmp",
+		constraint: "confusables_bmp_only && !confusables_latin_only && !confusables_raw",
+		include: func(source, _ string) bool {
+			return strings.IndexFunc(source, func(r rune) bool { return r > 0xFFFF }) == -1
+		},
+		includeRune: func(r rune) bool { return r <= 0xFFFF },
+	},
+	{
+		// Only mappings to Latin text are included, so that strings spoofing Latin text can be found.
+		name:       "tables_latin",
+		table:      "confusables-latin",
+		constraint: "confusables_latin_only && !confusables_raw",
+		include: func(_, target string) bool {
+			for _, r := range target {
+				if !isLatin(r) {
+					return false
+				}
+			}
+
+			return true
+		},
+		includeRune: isLatin,
+	},
+}
+
 func main() {
+	flag.Parse()
+
+	// The diff subcommand compares two versions of the data, rather than generating the tables.
+	if flag.Arg(0) == "diff" {
+		if err := diffTables(os.Stdout, flag.Args()[1:]); err != nil {
+			log.Fatal("unable to compare tables: ", err)
+		}
+
+		return
+	}
+
 	if err := buildTable(); err != nil {
 		log.Fatal("unable to build tables: ", err)
 	}
+
+	// A package holds the confusables tables alone.
+	if *packageDir != "" {
+		return
+	}
+
+	if err := buildIdentifierTable(); err != nil {
+		log.Fatal("unable to build identifier tables: ", err)
+	}
+
+	if err := buildIntentionalTable(); err != nil {
+		log.Fatal("unable to build intentional tables: ", err)
+	}
 }
 
 func buildTable() error {
-	resp, err := http.Get(url)
+	r, err := open("confusables.txt")
 	if err != nil {
 		return err
 	}
 
-	defer resp.Body.Close()
+	defer r.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return errDownload
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("unable to read confusables: %w", err)
+	}
+
+	m := &mappings{
+		confusables:      map[rune]string{},
+		descriptions:     map[string]string{},
+		asciiConfusables: map[rune]string{},
+		sequences:        map[string]string{},
+		asciiSequences:   map[string]string{},
 	}
 
-	confusables := map[string]string{}
-	descriptions := map[string]string{}
 	var version, date string
 
 	// Extract confusables from downloaded file
-	scanner := bufio.NewScanner(resp.Body)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
 	for scanner.Scan() {
 		line := scanner.Text()
 
-		if err := parseLine(line, confusables, descriptions); err != nil {
+		if err := parseLine(line, m); err != nil {
 			if errors.Is(err, utils.ErrIgnoreLine) {
 				if strings.HasPrefix(line, "# Version: ") {
 					version = strings.TrimSpace(strings.TrimPrefix(line, "# Version: "))
@@ -80,7 +296,11 @@ func buildTable() error {
 		}
 	}
 
-	amendments, err := os.Open("scripts/amendments.txt")
+	if err := checkSummary(m, version); err != nil {
+		return err
+	}
+
+	amendments, err := os.Open(*amendmentsPath)
 	if err != nil {
 		return err
 	}
@@ -91,20 +311,130 @@ func buildTable() error {
 	for scanner.Scan() {
 		line := scanner.Text()
 
-		if err := parseLine(line, confusables, descriptions); err != nil && !errors.Is(err, utils.ErrIgnoreLine) {
+		if err := parseAmendment(line, m); err != nil && !errors.Is(err, utils.ErrIgnoreLine) {
+			return err
+		}
+	}
+
+	if version, err = checkVersion("confusables.txt", version); err != nil {
+		return err
+	}
+
+	if *packageDir != "" {
+		return writePackage(*packageDir, version, date, m)
+	}
+
+	if err := writeVersion(version, date); err != nil {
+		return err
+	}
+
+	// The file is kept verbatim for the confusables_raw build tag, which parses it at runtime.
+	if err := os.WriteFile("confusables.txt", data, 0o644); err != nil {
+		return fmt.Errorf("unable to create confusables.txt: %w", err)
+	}
+
+	for _, subset := range tableSubsets {
+		if err := writeTables(subset, version, date, m); err != nil {
 			return err
 		}
 	}
 
-	// Output a mapping file
-	tmpl := template.New("tables.go")
+	return nil
+}
+
+// Write tables_version.go, recording the version and source of the data.
+func writeVersion(version, date string) error {
+	tmpl, err := template.New("tables_version.go").Parse(versionSourceFile)
+	if err != nil {
+		return fmt.Errorf("unable to parse template: %w", err)
+	}
+
+	f, err := os.Create("tables_version.go")
+	if err != nil {
+		return fmt.Errorf("unable to create tables_version.go: %w", err)
+	}
+
+	defer f.Close()
+
+	if err := tmpl.Execute(f, struct {
+		Version string
+		Date    string
+		Source  string
+	}{
+		Version: version,
+		Date:    date,
+		Source:  source("confusables.txt"),
+	}); err != nil {
+		return fmt.Errorf("unable to execute template: %w", err)
+	}
+
+	return nil
+}
+
+// Write the subset of the tables to its file.
+func writeTables(subset tableSubset, version, date string, m *mappings) error {
+	data, err := encodeTables(subset, version, date, m)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(subset.name+".bin", data, 0o644); err != nil {
+		return fmt.Errorf("unable to create %s.bin: %w", subset.name, err)
+	}
+
+	tmpl, err := template.New(subset.name).Parse(sourceFile)
+	if err != nil {
+		return fmt.Errorf("unable to parse template: %w", err)
+	}
+
+	f, err := os.Create(subset.name + ".go")
+	if err != nil {
+		return fmt.Errorf("unable to create %s.go: %w", subset.name, err)
+	}
+
+	defer f.Close()
+
+	if err := tmpl.Execute(f, struct {
+		Constraint string
+		Version    string
+		Date       string
+		Data       string
+		Table      string
+	}{
+		Constraint: subset.constraint,
+		Version:    version,
+		Date:       date,
+		Data:       subset.name + ".bin",
+		Table:      subset.table,
+	}); err != nil {
+		return fmt.Errorf("unable to execute template: %w", err)
+	}
+
+	return nil
+}
+
+// Write the full tables as a package in dir, named after the directory, which exports them for use with
+// confusables.WithTables.
+func writePackage(dir, version, date string, m *mappings) error {
+	data, err := encodeTables(tableSubsets[0], version, date, m)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("unable to create %s: %w", dir, err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "tables.bin"), data, 0o644); err != nil {
+		return fmt.Errorf("unable to create tables.bin: %w", err)
+	}
 
-	tmpl, err = tmpl.Parse(sourceFile)
+	tmpl, err := template.New("package").Parse(packageSourceFile)
 	if err != nil {
 		return fmt.Errorf("unable to parse template: %w", err)
 	}
 
-	f, err := os.Create("tables.go")
+	f, err := os.Create(filepath.Join(dir, "tables.go"))
 	if err != nil {
 		return fmt.Errorf("unable to create tables.go: %w", err)
 	}
@@ -112,15 +442,13 @@ func buildTable() error {
 	defer f.Close()
 
 	if err := tmpl.Execute(f, struct {
-		Version      string
-		Date         string
-		Confusables  map[string]string
-		Descriptions map[string]string
+		Name    string
+		Version string
+		Date    string
 	}{
-		Version:      version,
-		Date:         date,
-		Confusables:  confusables,
-		Descriptions: descriptions,
+		Name:    filepath.Base(dir),
+		Version: version,
+		Date:    date,
 	}); err != nil {
 		return fmt.Errorf("unable to execute template: %w", err)
 	}
@@ -128,22 +456,926 @@ func buildTable() error {
 	return nil
 }
 
-func parseLine(line string, confusables map[string]string, descriptions map[string]string) error {
-	entry, err := utils.ParseLine(line)
+// Encode the subset of the tables in the binary format read by decodeTables.
+func encodeTables(subset tableSubset, version, date string, m *mappings) ([]byte, error) {
+	subsetConfusables := map[rune]string{}
+	subsetASCIIConfusables := map[rune]string{}
+	subsetSequences := map[string]string{}
+	subsetASCIISequences := map[string]string{}
+	subsetDescriptions := map[string]string{}
+
+	for source, target := range m.confusables {
+		if !subset.include(string(source), target) {
+			continue
+		}
+
+		subsetConfusables[source] = target
+
+		if ascii, ok := m.asciiConfusables[source]; ok {
+			subsetASCIIConfusables[source] = ascii
+		}
+
+		for _, s := range []string{string(source), target} {
+			if desc, ok := m.descriptions[s]; ok {
+				subsetDescriptions[s] = desc
+			}
+		}
+	}
+
+	for source, target := range m.sequences {
+		if !subset.include(source, target) {
+			continue
+		}
+
+		// Sequences are matched against the NFD form of strings by ToSkeleton.
+		subsetSequences[norm.NFD.String(source)] = target
+
+		if ascii, ok := m.asciiSequences[source]; ok {
+			subsetASCIISequences[source] = ascii
+		}
+
+		for _, s := range []string{source, target} {
+			if desc, ok := m.descriptions[s]; ok {
+				subsetDescriptions[s] = desc
+			}
+		}
+	}
+
+	var subsetAmendments strings.Builder
+
+	for _, a := range m.amendments {
+		if !subset.include(a.entry.SourceSequence, a.entry.Target) {
+			continue
+		}
+
+		subsetAmendments.WriteString(a.line + "\n")
+
+		for _, s := range []string{a.entry.SourceSequence, a.entry.Target} {
+			if desc, ok := m.descriptions[s]; ok {
+				subsetDescriptions[s] = desc
+			}
+		}
+	}
+
+	for s, desc := range m.descriptions {
+		if strings.IndexFunc(s, func(r rune) bool { return !subset.includeRune(r) }) == -1 {
+			subsetDescriptions[s] = desc
+		}
+	}
+
+	var strs stringTable
+
+	runeTables := appendRuneTable(nil, &strs, subsetConfusables)
+	runeTables = appendRuneTable(runeTables, &strs, subsetASCIIConfusables)
+	runeTables = appendSequenceTable(runeTables, &strs, subsetSequences)
+	runeTables = appendSequenceTable(runeTables, &strs, subsetASCIISequences)
+
+	data := appendString(nil, tableDataMagic)
+	data = appendString(data, version)
+	data = appendString(data, date)
+	data = strs.append(data)
+	data = append(data, runeTables...)
+	data = appendDescriptionTable(data, subsetDescriptions)
+	data = appendString(data, subsetAmendments.String())
+
+	return data, nil
+}
+
+// Check the mappings parsed from confusables.txt against confusablesSummary.txt, which groups the sources mapping to
+// each target, so that mappings dropped or altered while parsing, such as those of sequences, are caught before the
+// tables are written.
+func checkSummary(m *mappings, version string) error {
+	r, err := open("confusablesSummary.txt")
 	if err != nil {
 		return err
 	}
 
-	sourceStr := string(entry.Source)
-	if _, ok := descriptions[sourceStr]; !ok {
-		descriptions[strconv.Quote(sourceStr)] = strconv.Quote(entry.Description.From)
+	defer r.Close()
+
+	var (
+		prototype string
+		members   int
+		problems  []string
+	)
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := scanner.Text()
+
+		if v, ok := strings.CutPrefix(line, "# Version: "); ok && strings.TrimSpace(v) != version {
+			return fmt.Errorf("%w: confusablesSummary.txt is version %s", errVersion, strings.TrimSpace(v))
+		}
+
+		// Each set starts with its prototype, "#", the target of the mappings, followed by the sources, "←".
+		marker, rest, _ := strings.Cut(line, "\t")
+		if marker != "#" && marker != "←" {
+			continue
+		}
+
+		s, err := parseSummaryCodePoints(rest)
+		if err != nil {
+			return fmt.Errorf("confusablesSummary.txt: line %d: %w", n, err)
+		}
+
+		if marker == "#" {
+			prototype = s
+
+			continue
+		}
+
+		members++
+
+		target, ok := m.confusables[[]rune(s)[0]]
+		if utf8.RuneCountInString(s) > 1 {
+			target, ok = m.sequences[s]
+		}
+
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s is not mapped", codePoints(s)))
+		case target != prototype:
+			problems = append(problems, fmt.Sprintf("%s maps to %s rather than %s", codePoints(s), codePoints(target),
+				codePoints(prototype)))
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("unable to read confusablesSummary.txt: %w", err)
 	}
 
-	if _, ok := descriptions[entry.Target]; !ok {
-		descriptions[strconv.Quote(entry.Target)] = strconv.Quote(entry.Description.To)
+	if n := len(m.confusables) + len(m.sequences); n != members {
+		problems = append(problems, fmt.Sprintf("%d mappings were parsed rather than %d", n, members))
 	}
 
-	confusables[fmt.Sprintf("0x%.8X", entry.Source)] = fmt.Sprintf("%+q", entry.Target)
+	if len(problems) > 0 {
+		const shown = 10
+
+		if len(problems) > shown {
+			problems = append(problems[:shown], fmt.Sprintf("and %d more", len(problems)-shown))
+		}
+
+		return fmt.Errorf("%w: %s", errSummary, strings.Join(problems, "; "))
+	}
 
 	return nil
 }
+
+// Parse the code points of a line of confusablesSummary.txt, following its marker, e.g.
+// "\u200eа\u200e\t( а ) 0430\t CYRILLIC SMALL LETTER A".
+func parseSummaryCodePoints(s string) (string, error) {
+	// The code points are the field before the names, and follow the characters in parentheses, which may themselves
+	// be parentheses or tabs.
+	fields := strings.Split(s, "\t")
+	if len(fields) < 3 {
+		return "", fmt.Errorf("%w: %q", utils.ErrInvalidLine, s)
+	}
+
+	field := fields[len(fields)-2]
+
+	i := strings.LastIndex(field, ") ")
+	if i == -1 {
+		return "", fmt.Errorf("%w: %q", utils.ErrInvalidLine, s)
+	}
+
+	var runes []rune
+
+	for _, field := range strings.Fields(field[i+2:]) {
+		r, err := strconv.ParseUint(field, 16, 32)
+		if err != nil {
+			return "", fmt.Errorf("%w: %q", utils.ErrInvalidLine, s)
+		}
+
+		runes = append(runes, rune(r))
+	}
+
+	if len(runes) == 0 {
+		return "", fmt.Errorf("%w: %q", utils.ErrInvalidLine, s)
+	}
+
+	return string(runes), nil
+}
+
+// Write the mappings added, removed and changed between two versions of the data, given as the paths of confusables.txt
+// or of generated tables, to w. Each line gives the change, "+", "-" or "~", and the mapping, in order of source, and is
+// followed by a count of each change.
+func diffTables(w io.Writer, args []string) error {
+	if len(args) != 2 {
+		return errDiffUsage
+	}
+
+	old, err := readMappings(args[0])
+	if err != nil {
+		return err
+	}
+
+	updated, err := readMappings(args[1])
+	if err != nil {
+		return err
+	}
+
+	sources := make([]string, 0, len(old)+len(updated))
+	for source := range old {
+		sources = append(sources, source)
+	}
+
+	for source := range updated {
+		if _, ok := old[source]; !ok {
+			sources = append(sources, source)
+		}
+	}
+
+	sort.Strings(sources)
+
+	var added, removed, changed int
+
+	for _, source := range sources {
+		o, inOld := old[source]
+		u, inUpdated := updated[source]
+
+		var line string
+
+		switch {
+		case !inOld:
+			added++
+			line = fmt.Sprintf("+ %s ; %s\t# ( %s → %s ) %s", codePoints(source), codePoints(u.Target), source, u.Target,
+				u.Description.From)
+		case !inUpdated:
+			removed++
+			line = fmt.Sprintf("- %s ; %s\t# ( %s → %s ) %s", codePoints(source), codePoints(o.Target), source, o.Target,
+				o.Description.From)
+		case o.Target != u.Target:
+			changed++
+			line = fmt.Sprintf("~ %s ; %s → %s\t# ( %s → %s → %s ) %s", codePoints(source), codePoints(o.Target),
+				codePoints(u.Target), source, o.Target, u.Target, u.Description.From)
+		default:
+			continue
+		}
+
+		if _, err := fmt.Fprintln(w, strings.TrimRight(line, " ")); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "# %d added, %d removed, %d changed\n", added, removed, changed)
+
+	return err
+}
+
+// Read the mappings of confusables.txt, or of generated tables, from the file at path, keyed by their source in NFD.
+// The amendments held by generated tables are not included, so that either may be compared with confusables.txt.
+func readMappings(path string) (map[string]utils.ConfusableEntry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.HasPrefix(data, appendString(nil, tableDataMagic)) {
+		var buf bytes.Buffer
+
+		c := utils.New(utils.WithTables(utils.NewTables(string(data))), utils.WithAmendments(false))
+		if err := c.ExportMappings(&buf, utils.FormatText); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		data = buf.Bytes()
+	}
+
+	m := map[string]utils.ConfusableEntry{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; scanner.Scan(); n++ {
+		entry, err := utils.ParseLine(scanner.Text())
+		if err != nil {
+			if errors.Is(err, utils.ErrIgnoreLine) {
+				continue
+			}
+
+			return nil, fmt.Errorf("%s: line %d: %w", path, n, err)
+		}
+
+		m[norm.NFD.String(entry.SourceSequence)] = *entry
+	}
+
+	return m, scanner.Err()
+}
+
+// Format the code points of s as hexadecimal, separated by spaces, as in confusables.txt.
+func codePoints(s string) string {
+	var b strings.Builder
+
+	for i, r := range []rune(s) {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+
+		fmt.Fprintf(&b, "%04X", r)
+	}
+
+	return b.String()
+}
+
+// Parse a line of amendments.txt. Only the descriptions are added to the tables, with the mapping itself kept aside to
+// be applied at runtime.
+func parseAmendment(line string, m *mappings) error {
+	entry, err := utils.ParseLine(line)
+	if err != nil {
+		return err
+	}
+
+	addDescriptions(entry, m)
+
+	m.amendments = append(m.amendments, amendment{line: strings.TrimSpace(line), entry: entry})
+
+	return nil
+}
+
+// Add the descriptions of an entry's source and target, unless they are already described.
+func addDescriptions(entry *utils.ConfusableEntry, m *mappings) {
+	if _, ok := m.descriptions[entry.SourceSequence]; !ok && entry.Description.From != "" {
+		m.descriptions[entry.SourceSequence] = entry.Description.From
+	}
+
+	if _, ok := m.descriptions[entry.Target]; !ok && entry.Description.To != "" {
+		m.descriptions[entry.Target] = entry.Description.To
+	}
+}
+
+// Parse a line of confusables.txt into the tables. Where a target is ASCII once its nonspacing marks are removed, that
+// ASCII is also recorded so it need not be derived at runtime.
+func parseLine(line string, m *mappings) error {
+	entry, err := utils.ParseLine(line)
+	if err != nil {
+		return err
+	}
+
+	addDescriptions(entry, m)
+
+	ascii, _, _ := transform.String(removeMarks, entry.Target)
+
+	if string(entry.Source) != entry.SourceSequence {
+		m.sequences[entry.SourceSequence] = entry.Target
+
+		if isASCII(ascii) {
+			m.asciiSequences[entry.SourceSequence] = ascii
+		} else {
+			delete(m.asciiSequences, entry.SourceSequence)
+		}
+
+		return nil
+	}
+
+	m.confusables[entry.Source] = entry.Target
+
+	if isASCII(ascii) {
+		m.asciiConfusables[entry.Source] = ascii
+	} else {
+		delete(m.asciiConfusables, entry.Source)
+	}
+
+	return nil
+}
+
+// Check whether a rune is in the Latin script, or is shared with it.
+func isLatin(r rune) bool {
+	return unicode.In(r, unicode.Latin, unicode.Common, unicode.Inherited)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Build identifier_tables.go from IdentifierStatus.txt and IdentifierType.txt.
+func buildIdentifierTable() error {
+	status, version, date, err := parsePropertyFile("IdentifierStatus.txt")
+	if err != nil {
+		return err
+	}
+
+	if version, err = checkVersion("IdentifierStatus.txt", version); err != nil {
+		return err
+	}
+
+	types, _, _, err := parsePropertyFile("IdentifierType.txt")
+	if err != nil {
+		return err
+	}
+
+	typeTables := map[string]string{}
+	for t, runes := range types {
+		typeTables[fmt.Sprintf("IdentifierType(%q)", t)] = formatRangeTable(rangetable.New(runes...), "\t\t\t")
+	}
+
+	tmpl, err := template.New("identifier_tables.go").Parse(identifierSourceFile)
+	if err != nil {
+		return fmt.Errorf("unable to parse template: %w", err)
+	}
+
+	f, err := os.Create("identifier_tables.go")
+	if err != nil {
+		return fmt.Errorf("unable to create identifier_tables.go: %w", err)
+	}
+
+	defer f.Close()
+
+	if err := tmpl.Execute(f, struct {
+		Version string
+		Date    string
+		Allowed string
+		Types   map[string]string
+	}{
+		Version: version,
+		Date:    date,
+		Allowed: formatRangeTable(rangetable.New(status["Allowed"]...), "\t\t"),
+		Types:   typeTables,
+	}); err != nil {
+		return fmt.Errorf("unable to execute template: %w", err)
+	}
+
+	return nil
+}
+
+// Build intentional_tables.go from intentional.txt.
+func buildIntentionalTable() error {
+	r, err := open("intentional.txt")
+	if err != nil {
+		return err
+	}
+
+	defer r.Close()
+
+	var (
+		version, date string
+		pairs         [][2]string
+	)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(line, "# Version: ") {
+			version = strings.TrimSpace(strings.TrimPrefix(line, "# Version: "))
+		} else if strings.HasPrefix(line, "# Date: ") {
+			date = strings.TrimSpace(strings.TrimPrefix(line, "# Date: "))
+		}
+
+		line, _, _ = strings.Cut(line, "#")
+
+		source, target, ok := strings.Cut(line, ";")
+		if !ok {
+			continue
+		}
+
+		r1, err := strconv.ParseUint(strings.TrimSpace(source), 16, 32)
+		if err != nil {
+			return err
+		}
+
+		r2, err := strconv.ParseUint(strings.TrimSpace(target), 16, 32)
+		if err != nil {
+			return err
+		}
+
+		r1, r2 = min(r1, r2), max(r1, r2)
+		pairs = append(pairs, [2]string{fmt.Sprintf("0x%.8X", r1), fmt.Sprintf("0x%.8X", r2)})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	version, err = checkVersion("intentional.txt", version)
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New("intentional_tables.go").Parse(intentionalSourceFile)
+	if err != nil {
+		return fmt.Errorf("unable to parse template: %w", err)
+	}
+
+	f, err := os.Create("intentional_tables.go")
+	if err != nil {
+		return fmt.Errorf("unable to create intentional_tables.go: %w", err)
+	}
+
+	defer f.Close()
+
+	if err := tmpl.Execute(f, struct {
+		Version string
+		Date    string
+		Pairs   [][2]string
+	}{
+		Version: version,
+		Date:    date,
+		Pairs:   pairs,
+	}); err != nil {
+		return fmt.Errorf("unable to execute template: %w", err)
+	}
+
+	return nil
+}
+
+// Check the version of a data file against -unicode-version, returning the version to record in the generated files.
+// Where the file does not give its version, the requested version is recorded.
+func checkVersion(name, version string) (string, error) {
+	switch {
+	case *unicodeVersion == "":
+		return version, nil
+	case version == "":
+		return *unicodeVersion, nil
+	case version != *unicodeVersion:
+		return "", fmt.Errorf("%w: %s is version %s", errVersion, name, version)
+	}
+
+	return version, nil
+}
+
+// Open one of the data files, such as "confusables.txt", from the directory of -input when set, or by downloading it.
+// The file is verified against -checksums, when set, before it is used, and its checksum is logged so that it may be
+// pinned.
+func open(name string) (io.ReadCloser, error) {
+	var r io.ReadCloser
+
+	if *input == "" {
+		resp, err := download(source(name))
+		if err != nil {
+			return nil, err
+		}
+
+		r = resp.Body
+	} else {
+		f, err := os.Open(source(name))
+		if err != nil {
+			return nil, err
+		}
+
+		r = f
+	}
+
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s: %w", name, err)
+	}
+
+	sum := sha256.Sum256(data)
+	log.Printf("%s  %s", hex.EncodeToString(sum[:]), name)
+
+	if err := verifyChecksum(name, sum[:]); err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// Verify the SHA-256 checksum of one of the data files against -checksums, when set.
+func verifyChecksum(name string, sum []byte) error {
+	if *checksumsPath == "" {
+		return nil
+	}
+
+	checksums, err := readChecksums()
+	if err != nil {
+		return err
+	}
+
+	want, ok := checksums[name]
+	if !ok {
+		return fmt.Errorf("%w: %s is not listed in %s", errChecksum, name, *checksumsPath)
+	}
+
+	if got := hex.EncodeToString(sum); got != want {
+		return fmt.Errorf("%w: %s has SHA-256 %s rather than %s", errChecksum, name, got, want)
+	}
+
+	return nil
+}
+
+// Read the checksums of -checksums, in the format written by sha256sum, keyed by the names of the files.
+var readChecksums = sync.OnceValues(func() (map[string]string, error) {
+	data, err := os.ReadFile(*checksumsPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read checksums: %w", err)
+	}
+
+	checksums := map[string]string{}
+
+	for n, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		sum, name, ok := strings.Cut(line, " ")
+		if !ok {
+			return nil, fmt.Errorf("%s: line %d: missing file name", *checksumsPath, n+1)
+		}
+
+		// Names are preceded by "*" when checksummed in binary mode, and may be given as paths.
+		name = filepath.Base(strings.TrimPrefix(strings.TrimSpace(name), "*"))
+		checksums[name] = strings.ToLower(sum)
+	}
+
+	return checksums, nil
+})
+
+// Get the path or URL of one of the data files.
+func source(name string) string {
+	if *input != "" {
+		if name == "confusables.txt" {
+			return *input
+		}
+
+		return filepath.Join(filepath.Dir(*input), name)
+	}
+
+	version := *unicodeVersion
+	if version == "" {
+		version = "latest"
+	}
+
+	return baseURL + version + "/" + name
+}
+
+func download(url string) (*http.Response, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+
+		return nil, fmt.Errorf("%w: %s", errDownload, url)
+	}
+
+	return resp, nil
+}
+
+// stringTable interns the strings which runes map to, so that tables share a single copy of each. Strings are numbered
+// from 1.
+type stringTable struct {
+	index  map[string]int
+	values []string
+}
+
+// Append the strings, concatenated, followed by their count and the length of each.
+func (st *stringTable) append(b []byte) []byte {
+	b = appendString(b, strings.Join(st.values, ""))
+	b = binary.AppendUvarint(b, uint64(len(st.values)))
+
+	for _, v := range st.values {
+		b = binary.AppendUvarint(b, uint64(len(v)))
+	}
+
+	return b
+}
+
+// Get the number of a string, adding it to the table if needed.
+func (st *stringTable) intern(s string) int {
+	if st.index == nil {
+		st.index = map[string]int{}
+	}
+
+	i, ok := st.index[s]
+	if !ok {
+		st.values = append(st.values, s)
+		i = len(st.values)
+		st.index[s] = i
+	}
+
+	return i
+}
+
+// Append a map of runes in the binary format of a runeTable read by decodeTables, with values interned in strs. Runes
+// within the Basic Multilingual Plane are placed in a two-level trie and other runes listed individually.
+func appendRuneTable(b []byte, strs *stringTable, m map[rune]string) []byte {
+	keys := make([]rune, 0, len(m))
+	for r := range m {
+		keys = append(keys, r)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	var (
+		blocks        [][]rune
+		supplementary []rune
+	)
+
+	for _, r := range keys {
+		if r > 0xFFFF {
+			supplementary = append(supplementary, r)
+
+			continue
+		}
+
+		if len(blocks) == 0 || blocks[len(blocks)-1][0]>>8 != r>>8 {
+			blocks = append(blocks, nil)
+		}
+
+		blocks[len(blocks)-1] = append(blocks[len(blocks)-1], r)
+	}
+
+	b = binary.AppendUvarint(b, uint64(len(blocks)))
+
+	for _, block := range blocks {
+		b = append(b, byte(block[0]>>8))
+		b = binary.AppendUvarint(b, uint64(len(block)))
+
+		for _, r := range block {
+			b = append(b, byte(r&0xFF))
+			b = binary.AppendUvarint(b, uint64(strs.intern(m[r])))
+		}
+	}
+
+	b = binary.AppendUvarint(b, uint64(len(supplementary)))
+
+	for _, r := range supplementary {
+		b = binary.AppendUvarint(b, uint64(r))
+		b = binary.AppendUvarint(b, uint64(strs.intern(m[r])))
+	}
+
+	return b
+}
+
+// Append a map of sequences in the binary format of a sequenceTable read by decodeTables, with values interned in strs.
+func appendSequenceTable(b []byte, strs *stringTable, m map[string]string) []byte {
+	keys := make([]string, 0, len(m))
+	for s := range m {
+		keys = append(keys, s)
+	}
+
+	sort.Strings(keys)
+
+	b = binary.AppendUvarint(b, uint64(len(keys)))
+
+	for _, s := range keys {
+		b = appendString(b, s)
+		b = binary.AppendUvarint(b, uint64(strs.intern(m[s])))
+	}
+
+	return b
+}
+
+// Append a string as its length followed by its bytes.
+func appendString(b []byte, s string) []byte {
+	b = binary.AppendUvarint(b, uint64(len(s)))
+
+	return append(b, s...)
+}
+
+// Append descriptions as a description table, which holds the names of runes in order, and the names of sequences,
+// keyed by their NFD, where they are not the names of their runes joined by ", ".
+func appendDescriptionTable(b []byte, descriptions map[string]string) []byte {
+	strs := make([]string, 0, len(descriptions))
+	for s := range descriptions {
+		strs = append(strs, s)
+	}
+
+	sort.Strings(strs)
+
+	var runes []rune
+
+	sequences := map[string]string{}
+
+	for _, s := range strs {
+		if utf8.RuneCountInString(s) == 1 {
+			runes = append(runes, []rune(s)[0])
+
+			continue
+		}
+
+		nfd := norm.NFD.String(s)
+		names := make([]string, 0, len(nfd))
+
+		for _, r := range nfd {
+			names = append(names, descriptions[string(r)])
+		}
+
+		// Where sequences share their NFD, the first is kept.
+		if _, ok := sequences[nfd]; !ok && strings.Join(names, ", ") != descriptions[s] {
+			sequences[nfd] = descriptions[s]
+		}
+	}
+
+	b = binary.AppendUvarint(b, uint64(len(runes)))
+
+	var last rune
+
+	for _, r := range runes {
+		b = binary.AppendUvarint(b, uint64(r-last))
+		b = appendString(b, descriptions[string(r)])
+		last = r
+	}
+
+	keys := make([]string, 0, len(sequences))
+	for k := range sequences {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	b = binary.AppendUvarint(b, uint64(len(keys)))
+
+	for _, k := range keys {
+		b = appendString(b, k)
+		b = appendString(b, sequences[k])
+	}
+
+	return b
+}
+
+// Format a range table as a Go expression, with its fields indented by one level more than indent.
+func formatRangeTable(rt *unicode.RangeTable, indent string) string {
+	var b strings.Builder
+
+	b.WriteString("&unicode.RangeTable{\n")
+
+	if len(rt.R16) > 0 {
+		fmt.Fprintf(&b, "%s\tR16: []unicode.Range16{\n", indent)
+
+		for _, r := range rt.R16 {
+			fmt.Fprintf(&b, "%s\t\t{Lo: 0x%04X, Hi: 0x%04X, Stride: %d},\n", indent, r.Lo, r.Hi, r.Stride)
+		}
+
+		fmt.Fprintf(&b, "%s\t},\n", indent)
+	}
+
+	if len(rt.R32) > 0 {
+		fmt.Fprintf(&b, "%s\tR32: []unicode.Range32{\n", indent)
+
+		for _, r := range rt.R32 {
+			fmt.Fprintf(&b, "%s\t\t{Lo: 0x%X, Hi: 0x%X, Stride: %d},\n", indent, r.Lo, r.Hi, r.Stride)
+		}
+
+		fmt.Fprintf(&b, "%s\t},\n", indent)
+	}
+
+	if rt.LatinOffset > 0 {
+		fmt.Fprintf(&b, "%s\tLatinOffset: %d,\n", indent, rt.LatinOffset)
+	}
+
+	b.WriteString(indent + "}")
+
+	return b.String()
+}
+
+// Parse a UCD style property file, e.g. "0030..0039 ; Allowed # ...", returning the runes with each property value.
+// Where a line lists several space separated values, the runes are recorded against each of them.
+func parsePropertyFile(name string) (values map[string][]rune, version, date string, err error) {
+	r, err := open(name)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	defer r.Close()
+
+	values = map[string][]rune{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+
+		if strings.HasPrefix(line, "# Version: ") {
+			version = strings.TrimSpace(strings.TrimPrefix(line, "# Version: "))
+		} else if strings.HasPrefix(line, "# Date: ") {
+			date = strings.TrimSpace(strings.TrimPrefix(line, "# Date: "))
+		}
+
+		line, _, _ = strings.Cut(line, "#")
+
+		codePoints, value, ok := strings.Cut(line, ";")
+		if !ok {
+			continue
+		}
+
+		lo, hi, isRange := strings.Cut(strings.TrimSpace(codePoints), "..")
+		if !isRange {
+			hi = lo
+		}
+
+		start, err := strconv.ParseUint(lo, 16, 32)
+		if err != nil {
+			return nil, "", "", err
+		}
+
+		end, err := strconv.ParseUint(hi, 16, 32)
+		if err != nil {
+			return nil, "", "", err
+		}
+
+		for _, v := range strings.Fields(value) {
+			for r := start; r <= end; r++ {
+				values[v] = append(values[v], rune(r))
+			}
+		}
+	}
+
+	return values, version, date, scanner.Err()
+}
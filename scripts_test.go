@@ -0,0 +1,61 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScripts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s        string
+		scripts  []string
+		dominant string
+	}{
+		{"", nil, "Common"},
+		{"123!", nil, "Common"},
+		{"paypal", []string{"Latin"}, "Latin"},
+		{"pаypal", []string{"Latin", "Cyrillic"}, "Latin"},
+		{"раураl", []string{"Cyrillic", "Latin"}, "Cyrillic"},
+		{"ab αβ", []string{"Latin", "Greek"}, "Latin"},
+		{"東京tokyo", []string{"Han", "Latin"}, "Latin"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.scripts, confusables.Scripts(test.s), "Scripts(%q)", test.s)
+		assert.Equal(t, test.dominant, confusables.DominantScript(test.s), "DominantScript(%q)", test.s)
+	}
+}
+
+func TestResolvedScriptSet(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s        string
+		resolved string
+		empty    bool
+	}{
+		{"", "ALL", false},
+		{"123", "ALL", false},
+		{"paypal", "{Latin}", false},
+		{"pаypal", "{}", true},
+		{"漢字", "{Han Han_With_Bopomofo Japanese Korean}", false},
+		{"ひらがなカタカナ漢字", "{Japanese}", false},
+		{"한국어漢字", "{Korean}", false},
+		{"ひらがな한국어", "{}", true},
+	}
+
+	for _, test := range tests {
+		resolved := confusables.ResolvedScriptSet(test.s)
+
+		assert.Equal(t, test.resolved, resolved.String(), "ResolvedScriptSet(%q)", test.s)
+		assert.Equal(t, test.empty, resolved.IsEmpty(), "ResolvedScriptSet(%q)", test.s)
+	}
+
+	assert.True(t, confusables.ResolvedScriptSet("1").All())
+	assert.True(t, confusables.ResolvedScriptSet("漢字").Contains("Japanese"))
+	assert.Equal(t, []string{"Latin"}, confusables.ResolvedScriptSet("a").Scripts())
+}
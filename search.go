@@ -0,0 +1,150 @@
+package confusables
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// span is a byte range within a string.
+type span struct {
+	start, end int
+}
+
+// skeletonMap is the skeleton of a string along with, for every byte of the skeleton, the span of the original string
+// it was derived from.
+type skeletonMap struct {
+	skeleton string
+	spans    []span
+}
+
+// ContainsConfusableOf checks if s contains a string which is confusable with substr, i.e. whether the skeleton of
+// substr is within the skeleton of s.
+func ContainsConfusableOf(s, substr string) bool {
+	return strings.Contains(ToSkeleton(s), ToSkeleton(substr))
+}
+
+// Index returns the byte offsets of the first span of haystack whose skeleton contains the skeleton of needle. If
+// needle is not found within haystack, -1, -1 is returned.
+//
+// As a single rune may map to several in the skeleton, a match which starts or ends part way through the mapping of a
+// rune is widened to include that rune.
+func Index(haystack, needle string) (start, end int) {
+	needleSkeleton := ToSkeleton(needle)
+	if needleSkeleton == "" {
+		return 0, 0
+	}
+
+	m := newSkeletonMap(haystack)
+
+	i := strings.Index(m.skeleton, needleSkeleton)
+	if i < 0 {
+		return -1, -1
+	}
+
+	return m.source(i, i+len(needleSkeleton))
+}
+
+// ReplaceConfusable returns a copy of s with every non-overlapping span whose skeleton matches the skeleton of old
+// replaced by replacement. Text surrounding the matched spans is preserved. If old has an empty skeleton, s is
+// returned unchanged.
+func ReplaceConfusable(s, old, replacement string) string {
+	oldSkeleton := ToSkeleton(old)
+	if oldSkeleton == "" {
+		return s
+	}
+
+	m := newSkeletonMap(s)
+
+	var b strings.Builder
+
+	prev := 0
+
+	for offset := 0; offset < len(m.skeleton); {
+		i := strings.Index(m.skeleton[offset:], oldSkeleton)
+		if i < 0 {
+			break
+		}
+
+		i += offset
+		offset = i + len(oldSkeleton)
+
+		start, end := m.source(i, offset)
+		if start < prev {
+			continue
+		}
+
+		b.WriteString(s[prev:start])
+		b.WriteString(replacement)
+		prev = end
+	}
+
+	b.WriteString(s[prev:])
+
+	return b.String()
+}
+
+// Build the skeleton of s, tracking the source of each byte. The string is normalized one segment at a time so that
+// offsets in the NFD form can be related back to s.
+func newSkeletonMap(s string) *skeletonMap {
+	var (
+		it       norm.Iter
+		nfd      []byte
+		skeleton strings.Builder
+	)
+
+	// nfdSpans holds the span of s which produced each byte of nfd.
+	nfdSpans := make([]span, 0, len(s))
+
+	it.InitString(norm.NFD, s)
+
+	for !it.Done() {
+		start := it.Pos()
+		segment := it.Next()
+		end := it.Pos()
+
+		nfd = append(nfd, segment...)
+
+		for range segment {
+			nfdSpans = append(nfdSpans, span{start: start, end: end})
+		}
+	}
+
+	normalized := string(nfd)
+	spans := make([]span, 0, len(s))
+	t := loadTables()
+	end := 0
+
+	for i, r := range normalized {
+		if i < end {
+			continue
+		}
+
+		n := skeleton.Len()
+		source := nfdSpans[i]
+
+		if seq, c, ok := t.sequences.match(normalized[i:]); ok {
+			skeleton.WriteString(c)
+			end = i + len(seq)
+			source.end = nfdSpans[end-1].end
+		} else if c, ok := t.confusables.lookup(r); ok {
+			skeleton.WriteString(c)
+		} else {
+			skeleton.WriteRune(r)
+		}
+
+		for ; n < skeleton.Len(); n++ {
+			spans = append(spans, source)
+		}
+	}
+
+	return &skeletonMap{
+		skeleton: skeleton.String(),
+		spans:    spans,
+	}
+}
+
+// Get the span of the original string which produced skeleton[start:end].
+func (m *skeletonMap) source(start, end int) (int, int) {
+	return m.spans[start].start, m.spans[end-1].end
+}
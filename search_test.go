@@ -0,0 +1,74 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainsConfusableOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, substr string
+		contains  bool
+	}{
+		{"", "", true},
+		{"example", "", true},
+		{"", "example", false},
+		{"visit раураl now", "paypal", true},
+		{"an ех⍺ⅿрІꬲ here", "example", true},
+		{"an ех⍺ⅿрІ here", "example", false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.contains, confusables.ContainsConfusableOf(test.s, test.substr),
+			"ContainsConfusableOf(%q, %q)", test.s, test.substr)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		haystack, needle string
+		start, end       int
+	}{
+		{"", "", 0, 0},
+		{"example", "", 0, 0},
+		{"", "example", -1, -1},
+		{"an example", "example", 3, 10},
+		{"an ех⍺ⅿрІꬲ here", "example", 3, 20},
+		{"visit раураl now", "paypal", 6, 17},
+		{"tum", "n", 2, 3},
+		{"newtòñ", "tòñ", 3, 8},
+		{"example", "google", -1, -1},
+	}
+
+	for _, test := range tests {
+		start, end := confusables.Index(test.haystack, test.needle)
+
+		assert.Equal(t, test.start, start, "start of %q in %q", test.needle, test.haystack)
+		assert.Equal(t, test.end, end, "end of %q in %q", test.needle, test.haystack)
+	}
+}
+
+func TestReplaceConfusable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, old, replacement, replaced string
+	}{
+		{"", "", "***", ""},
+		{"example", "", "***", "example"},
+		{"free money", "free", "***", "*** money"},
+		{"frее money, free money", "free", "***", "*** money, *** money"},
+		{"visit раураl now", "paypal", "[redacted]", "visit [redacted] now"},
+		{"tum", "n", "*", "tu*"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.replaced, confusables.ReplaceConfusable(test.s, test.old, test.replacement))
+	}
+}
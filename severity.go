@@ -0,0 +1,102 @@
+package confusables
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// Severity grades how alike a rune and its confusable look, so that uses which must not be too aggressive, such as
+// blocking registrations, may ignore mappings which are only loosely alike, such as '①' to "1", which are still useful
+// for search. Greater severities are more alike.
+type Severity int
+
+// Severities of mappings.
+const (
+	// SeverityNone is the severity of a rune which is not mapped.
+	SeverityNone Severity = iota
+	// SeverityLoose is the severity of mappings which are only loosely alike. These are the mappings whose Origin is
+	// not OriginUpstream, such as the amendments.
+	SeverityLoose
+	// SeverityNear is the severity of the mappings of confusables.txt whose glyphs are nearly identical.
+	SeverityNear
+	// SeverityIdentical is the severity of intentional confusables, whose glyphs are identical. See IsIntentional.
+	SeverityIdentical
+)
+
+var severityNames = map[Severity]string{
+	SeverityNone:      "none",
+	SeverityLoose:     "loose",
+	SeverityNear:      "near",
+	SeverityIdentical: "identical",
+}
+
+// String returns the name of the severity, such as "near".
+func (s Severity) String() string {
+	if name, ok := severityNames[s]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// SeverityOf returns the severity of the mapping of r by the package's confusables, or SeverityNone if r is not
+// mapped.
+func SeverityOf(r rune) Severity {
+	return loadTables().runeSeverity(r)
+}
+
+// SeverityOf returns the severity of the mapping of r by the instance's confusables, as SeverityOf.
+func (c *Confusables) SeverityOf(r rune) Severity {
+	return c.tables.load().runeSeverity(r)
+}
+
+func (t *tables) runeSeverity(r rune) Severity {
+	if r <= unicode.MaxASCII {
+		return SeverityNone
+	}
+
+	c, ok := t.confusables.lookup(r)
+	if !ok {
+		return SeverityNone
+	}
+
+	return t.severity(string(r), &c)
+}
+
+// Get the severity of the mapping of source to confusable, which need not be one held by the tables, as options may
+// map runes themselves.
+func (t *tables) severity(source string, confusable *string) Severity {
+	if confusable == nil {
+		return SeverityNone
+	}
+
+	// Removing a rune which renders invisibly leaves the string looking as it did.
+	if r, size := utf8.DecodeRuneInString(source); size == len(source) &&
+		(isIntentionalMapping(r, confusable) || (*confusable == "" && isDefaultIgnorable(r))) {
+		return SeverityIdentical
+	}
+
+	if t.origin(source, confusable) == OriginUpstream {
+		return SeverityNear
+	}
+
+	return SeverityLoose
+}
+
+// Check whether s contains a rune whose mapping by the package's confusables is at least min in severity.
+func containsSeverity(s string, min Severity) bool {
+	if isASCII(s) {
+		return false
+	}
+
+	t := loadTables()
+	min = max(min, SeverityLoose)
+
+	for _, r := range s {
+		if t.runeSeverity(r) >= min {
+			return true
+		}
+	}
+
+	return false
+}
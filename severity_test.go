@@ -0,0 +1,79 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSeverityOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		r        rune
+		severity confusables.Severity
+	}{
+		{'a', confusables.SeverityNone},
+		{'ʘ', confusables.SeverityNone},
+		{'а', intentionalSeverity()},
+		{'Α', intentionalSeverity()},
+		{'℮', confusables.SeverityNear},
+		{'ı', confusables.SeverityNear},
+		{'①', confusables.SeverityLoose},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.severity, confusables.SeverityOf(test.r), "SeverityOf(%q)", test.r)
+	}
+
+	c := confusables.NewSafe()
+	c.AddMapping('ʘ', "o")
+	assert.Equal(t, confusables.SeverityLoose, c.SeverityOf('ʘ'))
+	assert.Equal(t, confusables.SeverityNone, confusables.SeverityOf('ʘ'))
+}
+
+func TestDiffSeverity(t *testing.T) {
+	t.Parallel()
+
+	diffs := confusables.ToSkeletonDiff("а℮①x")
+	severities := make([]confusables.Severity, 0, len(diffs))
+
+	for _, diff := range diffs {
+		severities = append(severities, diff.Severity)
+	}
+
+	assert.Equal(t, []confusables.Severity{
+		intentionalSeverity(), confusables.SeverityNear, confusables.SeverityLoose, confusables.SeverityNone,
+	}, severities)
+}
+
+func TestSeverityString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "near", confusables.SeverityNear.String())
+	assert.Equal(t, "unknown", confusables.Severity(-1).String())
+}
+
+func TestSpoofCheckerMinSeverity(t *testing.T) {
+	t.Parallel()
+
+	sc := confusables.NewSpoofChecker(confusables.WithChecks(confusables.CheckConfusable))
+
+	result, err := sc.Check("room①")
+	assert.NoError(t, err)
+	assert.Equal(t, confusables.CheckConfusable, result.Failed)
+
+	sc = confusables.NewSpoofChecker(
+		confusables.WithChecks(confusables.CheckConfusable),
+		confusables.WithMinSeverity(confusables.SeverityNear),
+	)
+
+	result, err = sc.Check("room①")
+	assert.NoError(t, err)
+	assert.True(t, result.Passed())
+
+	result, err = sc.Check("rооm")
+	assert.NoError(t, err)
+	assert.Equal(t, confusables.CheckConfusable, result.Failed)
+}
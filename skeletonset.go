@@ -0,0 +1,133 @@
+package confusables
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// ErrConfusableExists is raised when adding a string to a SkeletonSet which is confusable with an existing member.
+var ErrConfusableExists = errors.New("confusable string already exists")
+
+// SkeletonSet is a set of strings in which no two members are confusable with one another. It is safe for concurrent
+// use.
+type SkeletonSet struct {
+	mu      sync.RWMutex
+	members map[string]string
+}
+
+// NewSkeletonSet creates a new, empty SkeletonSet.
+func NewSkeletonSet() *SkeletonSet {
+	return &SkeletonSet{
+		members: map[string]string{},
+	}
+}
+
+// Add adds s to the set. If s is confusable with an existing member then an error wrapping ErrConfusableExists is
+// returned and the set is left unchanged.
+func (ss *SkeletonSet) Add(s string) error {
+	skeleton := ToSkeleton(s)
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if existing, ok := ss.members[skeleton]; ok {
+		return fmt.Errorf("%w: %q is confusable with %q", ErrConfusableExists, s, existing)
+	}
+
+	if ss.members == nil {
+		ss.members = map[string]string{}
+	}
+
+	ss.members[skeleton] = s
+
+	return nil
+}
+
+// Contains checks if s is confusable with a member of the set.
+func (ss *SkeletonSet) Contains(s string) bool {
+	_, ok := ss.Lookup(s)
+
+	return ok
+}
+
+// Len returns the number of members in the set.
+func (ss *SkeletonSet) Len() int {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	return len(ss.members)
+}
+
+// Lookup returns the member of the set which s is confusable with, if one exists.
+func (ss *SkeletonSet) Lookup(s string) (string, bool) {
+	skeleton := ToSkeleton(s)
+
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+
+	member, ok := ss.members[skeleton]
+
+	return member, ok
+}
+
+// Members returns the members of the set in sorted order.
+func (ss *SkeletonSet) Members() []string {
+	ss.mu.RLock()
+
+	members := make([]string, 0, len(ss.members))
+	for _, member := range ss.members {
+		members = append(members, member)
+	}
+
+	ss.mu.RUnlock()
+
+	sort.Strings(members)
+
+	return members
+}
+
+// Remove removes the member of the set which s is confusable with. It reports whether a member was removed.
+func (ss *SkeletonSet) Remove(s string) bool {
+	skeleton := ToSkeleton(s)
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	if _, ok := ss.members[skeleton]; !ok {
+		return false
+	}
+
+	delete(ss.members, skeleton)
+
+	return true
+}
+
+// MarshalJSON encodes the set as a JSON array of its members.
+func (ss *SkeletonSet) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ss.Members())
+}
+
+// UnmarshalJSON replaces the contents of the set with the members of a JSON array. An error wrapping
+// ErrConfusableExists is returned if any two members are confusable.
+func (ss *SkeletonSet) UnmarshalJSON(data []byte) error {
+	var members []string
+	if err := json.Unmarshal(data, &members); err != nil {
+		return err
+	}
+
+	set := NewSkeletonSet()
+	for _, member := range members {
+		if err := set.Add(member); err != nil {
+			return err
+		}
+	}
+
+	ss.mu.Lock()
+	ss.members = set.members
+	ss.mu.Unlock()
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package confusables_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSkeletonSet(t *testing.T) {
+	t.Parallel()
+
+	set := confusables.NewSkeletonSet()
+
+	assert.NoError(t, set.Add("example"))
+	assert.NoError(t, set.Add("paypal"))
+	assert.ErrorIs(t, set.Add("ех⍺ⅿрІꬲ"), confusables.ErrConfusableExists)
+	assert.Equal(t, 2, set.Len())
+
+	assert.True(t, set.Contains("раураl"))
+	assert.False(t, set.Contains("google"))
+
+	member, ok := set.Lookup("ех⍺ⅿрІꬲ")
+	assert.True(t, ok)
+	assert.Equal(t, "example", member)
+
+	assert.True(t, set.Remove("раураl"))
+	assert.False(t, set.Remove("paypal"))
+	assert.Equal(t, []string{"example"}, set.Members())
+}
+
+func TestSkeletonSetJSON(t *testing.T) {
+	t.Parallel()
+
+	set := confusables.NewSkeletonSet()
+	assert.NoError(t, set.Add("paypal"))
+	assert.NoError(t, set.Add("example"))
+
+	data, err := json.Marshal(set)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `["example","paypal"]`, string(data))
+
+	decoded := confusables.NewSkeletonSet()
+	assert.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, set.Members(), decoded.Members())
+
+	assert.ErrorIs(t, json.Unmarshal([]byte(`["paypal","раураl"]`), decoded), confusables.ErrConfusableExists)
+}
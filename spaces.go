@@ -0,0 +1,73 @@
+package confusables
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// NormalizeSpaces converts space separators, such as NO-BREAK SPACE, THIN SPACE and IDEOGRAPHIC SPACE, to ASCII spaces
+// and collapses runs of them into a single space. Lookalike spaces are not listed as confusables, so are not changed
+// by ToSkeleton, yet are commonly used to defeat comparisons, e.g. "free\u00a0\u00a0money". Other whitespace, such
+// as tabs and newlines, is preserved.
+func NormalizeSpaces(s string) string {
+	n, _ := normalizeSpaces(s, nil)
+
+	return n
+}
+
+// Normalize the spaces of s. If spans is not nil, it holds a span for each byte of s, and the spans of the bytes which
+// are kept are returned.
+func normalizeSpaces(s string, spans []span) (string, []span) {
+	if !hasSpaceToNormalize(s) {
+		return s, spans
+	}
+
+	var (
+		b          = make([]byte, 0, len(s))
+		kept       []span
+		afterSpace bool
+	)
+
+	keep := func(start, end int) {
+		if spans != nil {
+			kept = append(kept, spans[start:end]...)
+		}
+	}
+
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		isSpace := unicode.Is(unicode.Zs, r)
+
+		switch {
+		case isSpace && afterSpace:
+		case isSpace:
+			// A converted space takes the span of the first byte of the space it replaces.
+			b = append(b, ' ')
+			keep(i, i+1)
+		default:
+			b = append(b, s[i:i+size]...)
+			keep(i, i+size)
+		}
+
+		afterSpace = isSpace
+		i += size
+	}
+
+	return string(b), kept
+}
+
+// Check whether s contains a space separator other than an ASCII space, or a run of space separators.
+func hasSpaceToNormalize(s string) bool {
+	afterSpace := false
+
+	for _, r := range s {
+		isSpace := unicode.Is(unicode.Zs, r)
+		if isSpace && (afterSpace || r != ' ') {
+			return true
+		}
+
+		afterSpace = isSpace
+	}
+
+	return false
+}
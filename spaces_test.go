@@ -0,0 +1,51 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSpaces(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, normalized string
+	}{
+		{"", ""},
+		{"free money", "free money"},
+		{"free\u00a0money", "free money"},
+		{"free \u2009\u3000 money", "free money"},
+		{"free\t\tmoney\n", "free\t\tmoney\n"},
+		{"  free  ", " free "},
+		{"\xff\u2002x", "\xff x"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.normalized, confusables.NormalizeSpaces(test.s), "NormalizeSpaces(%q)", test.s)
+	}
+}
+
+func TestWithNormalizeSpaces(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.New(confusables.WithNormalizeSpaces())
+
+	tests := []struct {
+		s        string
+		original []int
+	}{
+		{"frее\u2003\u2003mоnеy", []int{6, 12}},
+		{"free  money", []int{4, 6}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, "free money", c.ToASCII(test.s))
+		assert.Equal(t, "free money", string(c.AppendASCII(nil, test.s)))
+
+		a, m := c.ToASCIIWithIndex(test.s)
+		assert.Equal(t, "free money", a)
+		assert.Equal(t, test.original, []int{m.ToOriginal(4), m.ToOriginal(5)}, "ToASCIIWithIndex(%q)", test.s)
+	}
+}
@@ -0,0 +1,249 @@
+package confusables
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+)
+
+// ErrInvalidUTF8 is raised when checking a string which is not valid UTF-8.
+var ErrInvalidUTF8 = errors.New("string is not valid UTF-8")
+
+// Check is a set of checks which may be performed by a SpoofChecker.
+type Check uint
+
+// Checks which may be performed by a SpoofChecker.
+const (
+	// CheckConfusable fails when the string contains runes which have a confusable mapping, of at least the
+	// SpoofChecker's minimum severity.
+	CheckConfusable Check = 1 << iota
+	// CheckMixedScript fails when no single script covers the whole string.
+	CheckMixedScript
+	// CheckInvisible fails when the string contains default ignorable code points, which render invisibly, or repeats
+	// a combining mark so that the repeat is hidden.
+	CheckInvisible
+	// CheckRestrictionLevel fails when the string is less restrictive than the SpoofChecker's restriction level.
+	CheckRestrictionLevel
+	// CheckAllowedCharacters fails when the string contains characters which are not allowed. By default, allowed
+	// characters are those allowed in identifiers.
+	CheckAllowedCharacters
+	// CheckBidi fails when the string contains bidirectional formatting characters, which may reorder how it is
+	// displayed.
+	CheckBidi
+
+	// AllChecks performs every check.
+	AllChecks = CheckConfusable | CheckMixedScript | CheckInvisible | CheckRestrictionLevel | CheckAllowedCharacters |
+		CheckBidi
+)
+
+var checkNames = []struct {
+	check Check
+	name  string
+}{
+	{CheckConfusable, "confusable"},
+	{CheckMixedScript, "mixed-script"},
+	{CheckInvisible, "invisible"},
+	{CheckRestrictionLevel, "restriction-level"},
+	{CheckAllowedCharacters, "allowed-characters"},
+	{CheckBidi, "bidi"},
+}
+
+// Result details the outcome of checking a string with a SpoofChecker.
+type Result struct {
+	// BidiControls lists the bidirectional formatting characters found by CheckBidi.
+	BidiControls []rune
+	// Disallowed lists the characters found by CheckAllowedCharacters.
+	Disallowed []rune
+	// Failed is the set of checks which failed.
+	Failed Check
+	// RestrictionLevel is the restriction level of the string.
+	RestrictionLevel Level
+}
+
+// SpoofChecker checks strings for characteristics which are commonly used to spoof other strings, modelled on ICU's
+// USpoofChecker. It is safe for concurrent use.
+type SpoofChecker struct {
+	allowed          runes.Set
+	checks           Check
+	logger           *slog.Logger
+	minSeverity      Severity
+	restrictionLevel Level
+}
+
+// SpoofCheckerOption configures a SpoofChecker.
+type SpoofCheckerOption func(*SpoofChecker)
+
+func init() {
+	registerCheck("allowed-characters")
+	registerCheck("invisible")
+}
+
+// WithAllowedCharacters sets the characters which pass CheckAllowedCharacters, e.g.
+// runes.In(rangetable.Merge(unicode.Latin, unicode.Common)).
+func WithAllowedCharacters(set runes.Set) SpoofCheckerOption {
+	return func(sc *SpoofChecker) {
+		sc.allowed = set
+	}
+}
+
+// WithAllowedRanges sets the characters which pass CheckAllowedCharacters to those within any of tables.
+func WithAllowedRanges(tables ...*unicode.RangeTable) SpoofCheckerOption {
+	return WithAllowedCharacters(runes.Predicate(func(r rune) bool {
+		return unicode.In(r, tables...)
+	}))
+}
+
+// WithChecks sets the checks performed by a SpoofChecker. By default all checks are performed.
+func WithChecks(checks Check) SpoofCheckerOption {
+	return func(sc *SpoofChecker) {
+		sc.checks = checks
+	}
+}
+
+// WithLogger logs each failed check to logger at the warning level, with the checks which failed, the non-ASCII code
+// points and scripts of the string and its restriction level as attributes.
+func WithLogger(logger *slog.Logger) SpoofCheckerOption {
+	return func(sc *SpoofChecker) {
+		sc.logger = logger
+	}
+}
+
+// WithMinSeverity sets the least severity of the mappings which fail CheckConfusable, e.g. SeverityNear ignores loosely
+// alike mappings such as '①' to "1". By default, any mapping fails.
+func WithMinSeverity(severity Severity) SpoofCheckerOption {
+	return func(sc *SpoofChecker) {
+		sc.minSeverity = severity
+	}
+}
+
+// WithRestrictionLevel sets the least restrictive level which passes CheckRestrictionLevel. The default is
+// HighlyRestrictive.
+func WithRestrictionLevel(level Level) SpoofCheckerOption {
+	return func(sc *SpoofChecker) {
+		sc.restrictionLevel = level
+	}
+}
+
+// NewSpoofChecker creates a new SpoofChecker.
+func NewSpoofChecker(opts ...SpoofCheckerOption) *SpoofChecker {
+	sc := &SpoofChecker{
+		checks:           AllChecks,
+		minSeverity:      SeverityLoose,
+		restrictionLevel: HighlyRestrictive,
+	}
+
+	for _, opt := range opts {
+		opt(sc)
+	}
+
+	return sc
+}
+
+// Check performs the configured checks against s. An error is returned if s is not valid UTF-8.
+func (sc *SpoofChecker) Check(s string) (Result, error) {
+	return sc.CheckContext(context.Background(), s)
+}
+
+// CheckContext performs the configured checks against s, as Check, passing ctx to the logger when a check fails.
+func (sc *SpoofChecker) CheckContext(ctx context.Context, s string) (Result, error) {
+	if !utf8.ValidString(s) {
+		return Result{}, ErrInvalidUTF8
+	}
+
+	result := Result{
+		RestrictionLevel: RestrictionLevel(s),
+	}
+
+	if sc.checks&CheckConfusable != 0 && containsSeverity(s, sc.minSeverity) {
+		result.Failed |= CheckConfusable
+	}
+
+	if sc.checks&CheckMixedScript != 0 && ResolvedScriptSet(s).IsEmpty() {
+		result.Failed |= CheckMixedScript
+	}
+
+	if sc.checks&CheckInvisible != 0 && hasInvisible(s) {
+		result.Failed |= CheckInvisible
+	}
+
+	if sc.checks&CheckRestrictionLevel != 0 && result.RestrictionLevel > sc.restrictionLevel {
+		result.Failed |= CheckRestrictionLevel
+	}
+
+	if sc.checks&CheckAllowedCharacters != 0 {
+		if result.Disallowed = sc.disallowed(s); len(result.Disallowed) > 0 {
+			result.Failed |= CheckAllowedCharacters
+		}
+	}
+
+	if sc.checks&CheckBidi != 0 {
+		if result.BidiControls = bidiControls(s); len(result.BidiControls) > 0 {
+			result.Failed |= CheckBidi
+		}
+	}
+
+	if sc.logger != nil && !result.Passed() {
+		sc.log(ctx, s, result)
+	}
+
+	return result, nil
+}
+
+// String returns the names of the checks in the set, e.g. "confusable|mixed-script".
+func (c Check) String() string {
+	var names []string
+
+	for _, check := range checkNames {
+		if c&check.check != 0 {
+			names = append(names, check.name)
+		}
+	}
+
+	return strings.Join(names, "|")
+}
+
+// Passed reports whether every check passed.
+func (r Result) Passed() bool {
+	return r.Failed == 0
+}
+
+// Get the characters within s which are not allowed.
+func (sc *SpoofChecker) disallowed(s string) []rune {
+	var disallowed []rune
+
+	for _, r := range s {
+		if sc.allowed != nil {
+			if !sc.allowed.Contains(r) {
+				disallowed = append(disallowed, r)
+			}
+		} else if IdentifierStatus(r) != Allowed {
+			disallowed = append(disallowed, r)
+		}
+	}
+
+	return disallowed
+}
+
+// Log the failed result of checking s.
+func (sc *SpoofChecker) log(ctx context.Context, s string, result Result) {
+	var codePoints []string
+
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			codePoints = append(codePoints, fmt.Sprintf("U+%04X", r))
+		}
+	}
+
+	sc.logger.LogAttrs(ctx, slog.LevelWarn, "spoof check failed",
+		slog.String("failed", result.Failed.String()),
+		slog.Any("code_points", codePoints),
+		slog.Any("scripts", Scripts(s)),
+		slog.String("restriction_level", result.RestrictionLevel.String()),
+	)
+}
@@ -0,0 +1,128 @@
+package confusables_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"unicode"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/runes"
+)
+
+func TestSpoofCheckerCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s      string
+		failed confusables.Check
+		level  confusables.Level
+	}{
+		{"paypal", 0, confusables.ASCIIOnly},
+		{"newtòñ", 0, confusables.SingleScript},
+		{"раура", confusables.CheckConfusable, confusables.SingleScript},
+		{"pаypal", confusables.CheckConfusable | confusables.CheckMixedScript | confusables.CheckRestrictionLevel,
+			confusables.MinimallyRestrictive},
+		{"pay\u200bpal", confusables.CheckInvisible | confusables.CheckRestrictionLevel |
+			confusables.CheckAllowedCharacters, confusables.Unrestricted},
+		{"a\u0301\u0301", confusables.CheckInvisible, confusables.SingleScript},
+		{"pay pal", confusables.CheckRestrictionLevel | confusables.CheckAllowedCharacters, confusables.Unrestricted},
+	}
+
+	sc := confusables.NewSpoofChecker()
+
+	for _, test := range tests {
+		result, err := sc.Check(test.s)
+
+		assert.NoError(t, err)
+		assert.Equal(t, test.failed.String(), result.Failed.String(), "Check(%q)", test.s)
+		assert.Equal(t, test.level, result.RestrictionLevel, "Check(%q)", test.s)
+		assert.Equal(t, test.failed == 0, result.Passed(), "Check(%q)", test.s)
+	}
+
+	result, err := sc.Check("invoice\u202egpj.exe")
+	assert.NoError(t, err)
+	assert.Equal(t, []rune{'\u202e'}, result.BidiControls)
+	assert.NotZero(t, result.Failed&confusables.CheckBidi)
+
+	_, err = sc.Check("\xff")
+	assert.ErrorIs(t, err, confusables.ErrInvalidUTF8)
+}
+
+func TestSpoofCheckerOptions(t *testing.T) {
+	t.Parallel()
+
+	sc := confusables.NewSpoofChecker(
+		confusables.WithChecks(confusables.CheckRestrictionLevel),
+		confusables.WithRestrictionLevel(confusables.MinimallyRestrictive),
+	)
+
+	result, err := sc.Check("pаypal")
+	assert.NoError(t, err)
+	assert.True(t, result.Passed())
+
+	result, err = sc.Check("pay pal")
+	assert.NoError(t, err)
+	assert.Equal(t, confusables.CheckRestrictionLevel, result.Failed)
+}
+
+func TestSpoofCheckerAllowedCharacters(t *testing.T) {
+	t.Parallel()
+
+	sc := confusables.NewSpoofChecker(
+		confusables.WithChecks(confusables.CheckAllowedCharacters),
+		confusables.WithAllowedRanges(unicode.Latin, unicode.Common),
+	)
+
+	result, err := sc.Check("pay pal!")
+	assert.NoError(t, err)
+	assert.True(t, result.Passed())
+
+	result, err = sc.Check("pаypal ☺")
+	assert.NoError(t, err)
+	assert.Equal(t, confusables.CheckAllowedCharacters, result.Failed)
+	assert.Equal(t, []rune{'а'}, result.Disallowed)
+
+	sc = confusables.NewSpoofChecker(
+		confusables.WithChecks(confusables.CheckAllowedCharacters),
+		confusables.WithAllowedCharacters(runes.In(unicode.Cyrillic)),
+	)
+
+	result, err = sc.Check("раураl")
+	assert.NoError(t, err)
+	assert.Equal(t, []rune{'l'}, result.Disallowed)
+}
+
+func TestCheckString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", confusables.Check(0).String())
+	assert.Equal(t, "confusable|mixed-script", (confusables.CheckConfusable | confusables.CheckMixedScript).String())
+}
+
+func TestSpoofCheckerLogger(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	sc := confusables.NewSpoofChecker(confusables.WithLogger(slog.New(slog.NewJSONHandler(&buf, nil))))
+
+	_, err := sc.Check("paypal")
+	assert.NoError(t, err)
+	assert.Empty(t, buf.String())
+
+	_, err = sc.Check("pаypal")
+	assert.NoError(t, err)
+
+	var entry map[string]any
+
+	assert.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "spoof check failed", entry["msg"])
+	assert.Equal(t, "confusable|mixed-script|restriction-level", entry["failed"])
+	assert.Equal(t, []any{"U+0430"}, entry["code_points"])
+	assert.Equal(t, []any{"Latin", "Cyrillic"}, entry["scripts"])
+	assert.Equal(t, "Minimally-Restrictive", entry["restriction_level"])
+}
@@ -0,0 +1,37 @@
+package confusables
+
+import "sync/atomic"
+
+// Stats is a snapshot of the activity of an instance of Confusables. It may be published with expvar, e.g.
+//
+//	expvar.Publish("confusables", expvar.Func(func() any { return c.Stats() }))
+type Stats struct {
+	// CacheHits and CacheMisses count the results which were and were not found in the cache. They are counted
+	// whenever WithCache is used.
+	CacheHits   uint64 `json:"cacheHits"`
+	CacheMisses uint64 `json:"cacheMisses"`
+	// Calls counts the conversions observed, as by WithObserver. It is counted when WithStats is used.
+	Calls uint64 `json:"calls"`
+	// RunesFolded counts the runes replaced by the conversions observed. It is counted when WithStats is used.
+	RunesFolded uint64 `json:"runesFolded"`
+}
+
+// stats holds the counters of an instance of Confusables.
+type stats struct {
+	calls       atomic.Uint64
+	runesFolded atomic.Uint64
+}
+
+// Stats returns a snapshot of the instance's counters.
+func (c *Confusables) Stats() Stats {
+	var s Stats
+
+	s.CacheHits, s.CacheMisses = c.cache.counts()
+
+	if c.stats != nil {
+		s.Calls = c.stats.calls.Load()
+		s.RunesFolded = c.stats.runesFolded.Load()
+	}
+
+	return s
+}
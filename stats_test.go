@@ -0,0 +1,30 @@
+package confusables_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.New(confusables.WithStats(), confusables.WithCache(8))
+
+	c.ToASCII("pаypаl")
+	c.ToASCII("pаypаl")
+	c.ToSkeleton("paypal")
+	c.ToASCIIDiff("ⅰ")
+
+	assert.Equal(t, confusables.Stats{CacheHits: 1, CacheMisses: 2, Calls: 4, RunesFolded: 5}, c.Stats())
+
+	data, err := json.Marshal(c.Stats())
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"cacheHits":1,"cacheMisses":2,"calls":4,"runesFolded":5}`, string(data))
+
+	c = confusables.New()
+	c.ToASCII("pаypal")
+	assert.Equal(t, confusables.Stats{}, c.Stats())
+}
@@ -0,0 +1,45 @@
+package confusables
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	registerProfile("skeleton-strict")
+}
+
+// ToSkeletonStrict converts a string to its skeleton exactly as defined by UTS #39, so that skeletons may be compared
+// with those of other implementations, such as ICU's uspoof_getSkeleton. The string is converted to NFD, default
+// ignorable code points are removed, each rune is replaced by its prototype in confusables.txt and the result is
+// converted to NFD again.
+//
+// Unlike ToSkeleton, the package's amendments and the mappings added at runtime are not applied, and the result is in
+// NFD.
+func ToSkeletonStrict(s string) string {
+	return packageTables.upstream.load().strictSkeleton(s)
+}
+
+// ToSkeletonStrict converts a string to its skeleton exactly as defined by UTS #39, as ToSkeletonStrict, using the
+// tables selected by WithTables.
+func (c *Confusables) ToSkeletonStrict(s string) string {
+	return c.tableData.upstream.load().strictSkeleton(s)
+}
+
+func (t *tables) strictSkeleton(s string) string {
+	nfd := norm.NFD.String(s)
+	b := make([]byte, 0, len(nfd))
+
+	for _, r := range nfd {
+		switch c, ok := t.confusables.lookup(r); {
+		case isDefaultIgnorable(r):
+		case ok:
+			b = append(b, c...)
+		default:
+			b = utf8.AppendRune(b, r)
+		}
+	}
+
+	return norm.NFD.String(string(b))
+}
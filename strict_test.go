@@ -0,0 +1,40 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	v16 "github.com/eskriett/confusables/tables/v16"
+	"github.com/stretchr/testify/assert"
+)
+
+// The expected skeletons follow the algorithm of UTS #39, section 4, applied to confusables.txt.
+func TestToSkeletonStrict(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, skeleton string
+	}{
+		{"", ""},
+		{"example", "exarnple"},
+		{"ехаmрlе", "exarnple"},
+		{"pаypal", "paypal"},
+		{"pay\u200bpal", "paypal"},
+		{"a\u00ad", "a"},
+		{"newt\u00f2\u00f1", "newto\u0300n\u0303"},
+		{"\u212b", "A\u030a"},
+		{"\u01c6", "dz\u030c"},
+		{"東京", "東京"},
+	}
+
+	c := confusables.New(confusables.WithTables(v16.Tables))
+
+	for _, test := range tests {
+		assert.Equal(t, test.skeleton, confusables.ToSkeletonStrict(test.s), "ToSkeletonStrict(%q)", test.s)
+		assert.Equal(t, test.skeleton, c.ToSkeletonStrict(test.s), "ToSkeletonStrict(%q)", test.s)
+	}
+
+	// Unlike ToSkeleton, default ignorable code points are removed and the result is in NFD.
+	assert.NotEqual(t, confusables.ToSkeleton("pay\u200bpal"), confusables.ToSkeletonStrict("pay\u200bpal"))
+	assert.NotEqual(t, confusables.ToSkeleton("\u01c6"), confusables.ToSkeletonStrict("\u01c6"))
+}
@@ -0,0 +1,73 @@
+package confusables
+
+import (
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func init() {
+	registerTable("symbols")
+}
+
+// symbols holds the ASCII, or more common, forms of currency and other symbols, which take precedence over the
+// compatibility decompositions of unitSymbols.
+var symbols = map[rune]string{
+	'¢':          "c",
+	'©':          "(C)",
+	'®':          "(R)",
+	'٪':          "%",
+	'₤':          "£",
+	'℀':          "a/c",
+	'℁':          "a/s",
+	'℅':          "c/o",
+	'℆':          "c/u",
+	'№':          "No.",
+	'℗':          "(P)",
+	'℔':          "lb",
+	'﹟':          "#",
+	'﹠':          "&",
+	'﹩':          "$",
+	'﹪':          "%",
+	'＃':          "#",
+	'＄':          "$",
+	'％':          "%",
+	'＆':          "&",
+	'￠':          "c",
+	'￡':          "£",
+	'￥':          "¥",
+	'￦':          "₩",
+	'\U0001f4b2': "$",
+}
+
+// unitSymbols holds the letterlike symbols and squared units, e.g. "™" and "㎏", which are converted to ASCII when
+// their compatibility decomposition is ASCII.
+var unitSymbols = &unicode.RangeTable{
+	R16: []unicode.Range16{
+		{Lo: 0x2100, Hi: 0x214F, Stride: 1},
+		{Lo: 0x3371, Hi: 0x33DF, Stride: 1},
+	},
+}
+
+// NormalizeSymbols converts currency, unit and other symbols to ASCII sequences, e.g. "№" becomes "No.", "™" becomes
+// "TM", "＄" becomes "$" and "¢" becomes "c". Symbols with no ASCII form are converted to the usual form of the symbol
+// they look like, e.g. "₤" to "£". These symbols are not confusables, yet need to be folded to parse prices and
+// invoices.
+func NormalizeSymbols(s string) string {
+	return replaceRunes(s, asciiSymbol)
+}
+
+// Get the ASCII, or more common, form of a symbol.
+func asciiSymbol(r rune) (string, bool) {
+	if v, ok := symbols[r]; ok {
+		return v, true
+	}
+
+	if unicode.Is(unitSymbols, r) {
+		if v := norm.NFKC.String(string(r)); isASCII(v) {
+			return v, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,41 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSymbols(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, want string
+	}{
+		{"", ""},
+		{"$5", "$5"},
+		{"№ 42", "No. 42"},
+		{"Brand™ ℠", "BrandTM SM"},
+		{"＄１０ or 50¢", "$１０ or 50c"},
+		{"₤20 ￡5", "£20 £5"},
+		{"℡ ℅", "TEL c/o"},
+		{"5㎏ 10㎞", "5kg 10km"},
+		{"25℃", "25℃"},
+		{"© ®", "(C) (R)"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, confusables.NormalizeSymbols(test.s), "NormalizeSymbols(%q)", test.s)
+	}
+}
+
+func TestWithNormalizeSymbols(t *testing.T) {
+	t.Parallel()
+
+	c := confusables.New(confusables.WithNormalizeSymbols())
+
+	assert.Equal(t, "No. 7 $10 50c", c.ToASCII("№ 7 ＄10 50¢"))
+	assert.Equal(t, "No. 7 $10 50c", string(c.AppendASCII(nil, "№ 7 ＄10 50¢")))
+	assert.Equal(t, "No 7", confusables.ToASCII("№ 7"))
+}
@@ -0,0 +1,746 @@
+package confusables
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// bmpBlocks is the number of blocks of 256 runes within the Basic Multilingual Plane.
+const bmpBlocks = 256
+
+// runeTable maps runes to strings. Runes within the Basic Multilingual Plane are looked up through a two-level trie,
+// indexed by their high and then low byte, which avoids hashing. Other runes are held in a map.
+type runeTable struct {
+	// index holds the block of each high byte, where block 0 is empty.
+	index [bmpBlocks]uint16
+	// blocks hold the index of the value of each low byte, where 0 is no value.
+	blocks [][256]uint16
+	// strings holds the generated strings runes map to, which may be shared with other tables.
+	strings *stringTable
+	// added holds the values added at runtime, which follow those in strings.
+	added []string
+	// supplementary holds the runes outside of the Basic Multilingual Plane, and any which do not fit in the trie.
+	supplementary map[rune]string
+	// overflow is set once runes within the Basic Multilingual Plane have been stored in supplementary.
+	overflow bool
+}
+
+// stringTable holds strings concatenated, which avoids an allocation and string header per string. String i,
+// counting from 1, is data[offsets[i-1]:offsets[i]].
+type stringTable struct {
+	data    string
+	offsets []uint32
+}
+
+// sequenceTable maps sequences of runes to strings. The mappings of each sequence are held by its first rune, with the
+// longest sequences first, so that the longest match is found. A nil sequenceTable is empty.
+type sequenceTable struct {
+	m map[rune][]sequenceMapping
+	// ascii is set when a sequence starts with an ASCII rune, so that ASCII strings may still need mapping.
+	ascii bool
+}
+
+// sequenceMapping is the mapping of a sequence of runes to a string.
+type sequenceMapping struct {
+	source string
+	value  string
+}
+
+// tables holds the mappings used to find confusables. Once published by a tableSet, tables are never modified; changes
+// are made to a copy which replaces them.
+type tables struct {
+	// ascii holds the ASCII equivalent of confusables, with nonspacing marks removed, where there is one.
+	ascii        *runeTable
+	confusables  *runeTable
+	descriptions *descriptionTable
+	// asciiSequences holds the ASCII equivalent of sequences, as ascii does for runes.
+	asciiSequences *sequenceTable
+	// sequences holds the mappings of sequences of more than one rune, in NFD.
+	sequences *sequenceTable
+	// origins holds the origin of each mapping which is not from confusables.txt, keyed as by originKey.
+	origins map[string]Origin
+	// groups maps each confusable to the runes which map to it, inverting confusables. It is built on first use.
+	groups     map[string][]rune
+	groupsOnce sync.Once
+}
+
+// descriptionTable maps runes, and sequences of runes, to the names of their characters. The generated names of runes
+// are held sorted by rune and found by binary search, which avoids the overhead of a map. Sequences are keyed by their
+// NFD, and are only held where their name is not that of their runes joined by ", ".
+type descriptionTable struct {
+	// runes holds the generated runes, in order, and names the name of each. They are shared between clones.
+	runes []rune
+	names []string
+	// added holds the names of runes added at runtime, which take precedence over those generated.
+	added     map[rune]string
+	sequences map[string]string
+}
+
+// tableSet publishes snapshots of tables, which readers load without locking. Writers are serialised and replace the
+// snapshot copy-on-write.
+type tableSet struct {
+	current atomic.Pointer[tables]
+	mu      sync.Mutex
+	// init creates the tables on first use, when set.
+	init func() *tables
+	once sync.Once
+}
+
+// packageTables holds the package's generated tables. These are decoded on first use, so that programs which link the
+// package but do not use it need not hold them in memory.
+var packageTables = newTables(newGeneratedTables)
+
+// defaultTables holds the package's mappings, starting with the generated tables.
+var defaultTables = packageTables.amended
+
+// upstreamTables holds the mappings of confusables.txt alone, without the amendments. See WithAmendments.
+var upstreamTables = packageTables.upstream
+
+func newTableSet(t *tables) *tableSet {
+	s := &tableSet{}
+	s.current.Store(t)
+
+	return s
+}
+
+// Load the current snapshot of the package's mappings.
+func loadTables() *tables {
+	return defaultTables.load()
+}
+
+func (s *tableSet) addMapping(r rune, confusable string) {
+	_ = s.update(func(t *tables) error {
+		t.addMapping(r, confusable)
+
+		return nil
+	})
+}
+
+func (s *tableSet) addMappingWithDesc(r rune, confusable, runeDesc, confusableDesc string) {
+	_ = s.update(func(t *tables) error {
+		t.addMappingWithDesc(r, confusable, runeDesc, confusableDesc)
+
+		return nil
+	})
+}
+
+func (s *tableSet) addSequenceMapping(source, confusable string) {
+	_ = s.update(func(t *tables) error {
+		t.addSequenceMapping(source, confusable)
+
+		return nil
+	})
+}
+
+func (s *tableSet) load() *tables {
+	if s.init != nil {
+		s.once.Do(func() {
+			s.current.Store(s.init())
+		})
+	}
+
+	return s.current.Load()
+}
+
+// Load mappings in the format of confusables.txt. Either every mapping is added or, if an error is returned, none are.
+func (s *tableSet) loadMappings(r io.Reader) error {
+	return s.update(func(t *tables) error {
+		scanner := bufio.NewScanner(r)
+
+		for n := 1; scanner.Scan(); n++ {
+			entry, err := ParseLine(scanner.Text())
+			if err != nil {
+				if errors.Is(err, ErrIgnoreLine) {
+					continue
+				}
+
+				return fmt.Errorf("line %d: %w", n, err)
+			}
+
+			t.addEntry(entry)
+		}
+
+		return scanner.Err()
+	})
+}
+
+// Apply fn to a copy of the current tables, publishing the copy unless fn returns an error.
+func (s *tableSet) update(fn func(*tables) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	t := s.load().clone()
+	if err := fn(t); err != nil {
+		return err
+	}
+
+	s.current.Store(t)
+
+	return nil
+}
+
+// Add the mapping of a parsed entry along with its description.
+func (t *tables) addEntry(entry *ConfusableEntry) {
+	t.addSequenceMappingWithDesc(entry.SourceSequence, entry.Target, entry.Description.From, entry.Description.To)
+}
+
+func (t *tables) addMapping(r rune, confusable string) {
+	t.confusables.set(r, confusable)
+	t.setOrigin(string(r), OriginRuntime)
+
+	if v := removeMarks(confusable); isASCII(v) {
+		t.ascii.set(r, v)
+	} else {
+		t.ascii.delete(r)
+	}
+}
+
+func (t *tables) addMappingWithDesc(r rune, confusable, runeDesc, confusableDesc string) {
+	t.addMapping(r, confusable)
+	t.addDescriptions(string(r), confusable, runeDesc, confusableDesc)
+}
+
+// Add a mapping from a sequence of runes. A sequence of a single rune is added as a mapping of that rune.
+func (t *tables) addSequenceMapping(source, confusable string) {
+	if r, n := utf8.DecodeRuneInString(source); n == len(source) {
+		if n > 0 {
+			t.addMapping(r, confusable)
+		}
+
+		return
+	}
+
+	nfd := norm.NFD.String(source)
+	t.sequences.set(nfd, confusable)
+	t.setOrigin(nfd, OriginRuntime)
+
+	if v := removeMarks(confusable); isASCII(v) {
+		t.asciiSequences.set(source, v)
+	} else {
+		t.asciiSequences.delete(source)
+	}
+}
+
+func (t *tables) addSequenceMappingWithDesc(source, confusable, sourceDesc, confusableDesc string) {
+	t.addSequenceMapping(source, confusable)
+	t.addDescriptions(source, confusable, sourceDesc, confusableDesc)
+}
+
+// Add the names of the source and confusable of a mapping. Names which are not given are left as they were.
+func (t *tables) addDescriptions(source, confusable, sourceDesc, confusableDesc string) {
+	if sourceDesc != "" {
+		t.descriptions.set(source, sourceDesc)
+	}
+
+	if confusableDesc != "" {
+		t.descriptions.set(confusable, confusableDesc)
+	}
+}
+
+// Append the skeleton of s to dst.
+func (t *tables) appendSkeleton(dst []byte, s string) []byte {
+	nfd := s
+	if norm.NFD.QuickSpanString(s) != len(s) {
+		nfd = norm.NFD.String(s)
+	}
+
+	end := 0
+
+	for i, r := range nfd {
+		if i < end {
+			continue
+		}
+
+		if source, c, ok := t.sequences.match(nfd[i:]); ok {
+			dst = append(dst, c...)
+			end = i + len(source)
+		} else if c, ok := t.confusables.lookup(r); ok {
+			dst = append(dst, c...)
+		} else {
+			dst = utf8.AppendRune(dst, r)
+		}
+	}
+
+	return dst
+}
+
+// Get the Diff of each rune of the NFD form of s, as ToSkeletonDiff.
+func (t *tables) skeletonDiffs(s string) []Diff {
+	nfd := norm.NFD.String(s)
+
+	if len(nfd) == 0 {
+		return nil
+	}
+
+	diffs := make([]Diff, 0, utf8.RuneCountInString(nfd))
+	end := 0
+
+	for i, r := range nfd {
+		if i < end {
+			diffs = append(diffs, Diff{Confusable: new(string), Rune: r})
+
+			continue
+		}
+
+		if source, c, ok := t.sequences.match(nfd[i:]); ok {
+			diffs = append(diffs, t.sequenceDiff(r, source, c))
+			end = i + len(source)
+
+			continue
+		}
+
+		var confusable *string
+		if c, ok := t.confusables.lookup(r); ok {
+			confusable = &c
+		}
+
+		diffs = append(diffs, Diff{
+			Confusable:  confusable,
+			Description: t.description(string(r), confusable),
+			Intentional: isIntentionalMapping(r, confusable),
+			Origin:      t.origin(string(r), confusable),
+			Rune:        r,
+			Severity:    t.severity(string(r), confusable),
+		})
+	}
+
+	return diffs
+}
+
+func (t *tables) clone() *tables {
+	return &tables{
+		ascii:          t.ascii.clone(),
+		confusables:    t.confusables.clone(),
+		descriptions:   t.descriptions.clone(),
+		asciiSequences: t.asciiSequences.clone(),
+		sequences:      t.sequences.clone(),
+		origins:        maps.Clone(t.origins),
+	}
+}
+
+// Get the runes which are confusable with r, i.e. share its skeleton, including r, in order. Only runes which map to
+// a single rune are considered.
+func (t *tables) confusablesOf(r rune) []rune {
+	if v, ok := t.confusables.lookup(r); ok && utf8.RuneCountInString(v) != 1 {
+		return []rune{r}
+	}
+
+	return t.group(r)
+}
+
+// Get the runes which map to the same confusable as r, along with r and, where it is a single rune, the confusable, in
+// order.
+func (t *tables) group(r rune) []rune {
+	t.groupsOnce.Do(func() {
+		t.groups = map[string][]rune{}
+
+		t.confusables.each(func(r rune, v string) {
+			t.groups[v] = append(t.groups[v], r)
+		})
+	})
+
+	target := string(r)
+	if v, ok := t.confusables.lookup(r); ok {
+		target = v
+	}
+
+	runes := append([]rune{r}, t.groups[target]...)
+	if c, size := utf8.DecodeRuneInString(target); size > 0 && size == len(target) {
+		runes = append(runes, c)
+	}
+
+	slices.Sort(runes)
+
+	return slices.Compact(runes)
+}
+
+// Get the description of the mapping between a rune, or sequence of runes, and its confusable. Runes without a name
+// are described by their code point, so that a mapping is always described.
+func (t *tables) description(s string, confusable *string) *Description {
+	if confusable == nil {
+		return nil
+	}
+
+	return &Description{
+		From: t.descriptions.name(s),
+		To:   t.descriptions.name(*confusable),
+	}
+}
+
+// Get the Diff of a sequence of runes, starting with r, which is replaced by confusable. Sequence is only set where the
+// sequence is made up of more than r.
+func (t *tables) sequenceDiff(r rune, source, confusable string) Diff {
+	diff := Diff{
+		Confusable:  &confusable,
+		Description: t.description(source, &confusable),
+		Origin:      t.origin(source, &confusable),
+		Rune:        r,
+		Severity:    t.severity(source, &confusable),
+	}
+
+	if len(source) > utf8.RuneLen(r) {
+		diff.Sequence = source
+	}
+
+	return diff
+}
+
+func (d *descriptionTable) clone() *descriptionTable {
+	return &descriptionTable{
+		runes:     d.runes,
+		names:     d.names,
+		added:     maps.Clone(d.added),
+		sequences: maps.Clone(d.sequences),
+	}
+}
+
+// Get the name of s, or an empty string where it has none. A string of several runes, once in NFD, is named as a
+// sequence where it is one, or else by the names of its runes joined by ", ".
+func (d *descriptionTable) get(s string) string {
+	if s == "" {
+		return ""
+	}
+
+	if r, size := utf8.DecodeRuneInString(s); size == len(s) {
+		if desc, ok := d.rune(r); ok {
+			return desc
+		}
+	}
+
+	// s is copied so that it does not escape through norm, which would make callers allocate it on every call.
+	nfd := norm.NFD.AppendString(nil, strings.Clone(s))
+
+	if desc, ok := d.sequences[string(nfd)]; ok {
+		return desc
+	}
+
+	if utf8.RuneCount(nfd) == 1 && string(nfd) == s {
+		return ""
+	}
+
+	parts := make([]string, 0, len(nfd))
+
+	for _, c := range string(nfd) {
+		desc, ok := d.rune(c)
+		if !ok {
+			return ""
+		}
+
+		parts = append(parts, desc)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Get the name of s, as get, but naming runes without a name by their code point, e.g. "U+0430".
+func (d *descriptionTable) name(s string) string {
+	if desc := d.get(s); desc != "" || s == "" {
+		return desc
+	}
+
+	nfd := norm.NFD.String(s)
+	parts := make([]string, 0, len(nfd))
+
+	for _, c := range nfd {
+		desc, ok := d.rune(c)
+		if !ok {
+			desc = fmt.Sprintf("U+%04X", c)
+		}
+
+		parts = append(parts, desc)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Get the name of s where it is held, without falling back to the names of its runes.
+func (d *descriptionTable) lookup(s string) (string, bool) {
+	if r, size := utf8.DecodeRuneInString(s); size == len(s) && s != "" {
+		return d.rune(r)
+	}
+
+	desc, ok := d.sequences[norm.NFD.String(s)]
+
+	return desc, ok
+}
+
+// Get the name of a rune.
+func (d *descriptionTable) rune(r rune) (string, bool) {
+	if desc, ok := d.added[r]; ok {
+		return desc, true
+	}
+
+	if i, ok := slices.BinarySearch(d.runes, r); ok {
+		return d.names[i], true
+	}
+
+	return "", false
+}
+
+// Set the name of a rune, or of a sequence of runes.
+func (d *descriptionTable) set(s, desc string) {
+	if r, size := utf8.DecodeRuneInString(s); size == len(s) && s != "" {
+		if d.added == nil {
+			d.added = map[rune]string{}
+		}
+
+		d.added[r] = desc
+
+		return
+	}
+
+	if d.sequences == nil {
+		d.sequences = map[string]string{}
+	}
+
+	d.sequences[norm.NFD.String(s)] = desc
+}
+
+func (t *runeTable) clone() *runeTable {
+	return &runeTable{
+		index:         t.index,
+		blocks:        slices.Clone(t.blocks),
+		strings:       t.strings,
+		added:         slices.Clone(t.added),
+		supplementary: maps.Clone(t.supplementary),
+		overflow:      t.overflow,
+	}
+}
+
+// Call fn with each rune and the string it maps to.
+func (t *runeTable) each(fn func(r rune, v string)) {
+	for hi, b := range t.index {
+		if b == 0 {
+			continue
+		}
+
+		for lo, i := range t.blocks[b] {
+			if i != 0 {
+				fn(rune(hi<<8|lo), t.value(i))
+			}
+		}
+	}
+
+	for r, v := range t.supplementary {
+		fn(r, v)
+	}
+}
+
+func (t *runeTable) delete(r rune) {
+	if r >= 0 && r <= 0xFFFF {
+		if b := t.index[r>>8]; b != 0 {
+			t.blocks[b][r&0xFF] = 0
+		}
+	}
+
+	delete(t.supplementary, r)
+}
+
+func (t *runeTable) lookup(r rune) (string, bool) {
+	if r >= 0 && r <= 0xFFFF {
+		if b := t.index[r>>8]; b != 0 {
+			if i := t.blocks[b][r&0xFF]; i != 0 {
+				return t.value(i), true
+			}
+		}
+
+		if !t.overflow {
+			return "", false
+		}
+	}
+
+	v, ok := t.supplementary[r]
+
+	return v, ok
+}
+
+func (t *runeTable) set(r rune, v string) {
+	t.delete(r)
+
+	if r < 0 || r > 0xFFFF {
+		t.setSupplementary(r, v)
+
+		return
+	}
+
+	if t.len() >= math.MaxUint16 {
+		t.compact()
+
+		if t.len() >= math.MaxUint16 {
+			t.overflow = true
+			t.setSupplementary(r, v)
+
+			return
+		}
+	}
+
+	if len(t.blocks) == 0 {
+		t.blocks = append(t.blocks, [256]uint16{})
+	}
+
+	b := t.index[r>>8]
+	if b == 0 {
+		b = uint16(len(t.blocks))
+		t.blocks = append(t.blocks, [256]uint16{})
+		t.index[r>>8] = b
+	}
+
+	t.added = append(t.added, v)
+	t.blocks[b][r&0xFF] = uint16(t.len())
+}
+
+func (t *sequenceTable) clone() *sequenceTable {
+	c := &sequenceTable{}
+
+	if t != nil {
+		// The slices are replaced rather than modified by set and delete, so may be shared.
+		c.m = maps.Clone(t.m)
+		c.ascii = t.ascii
+	}
+
+	return c
+}
+
+func (t *sequenceTable) delete(source string) {
+	r, _ := utf8.DecodeRuneInString(source)
+
+	mappings := slices.DeleteFunc(slices.Clone(t.m[r]), func(m sequenceMapping) bool {
+		return m.source == source
+	})
+
+	if len(mappings) == 0 {
+		delete(t.m, r)
+	} else {
+		t.m[r] = mappings
+	}
+}
+
+// Check whether any sequences are held.
+func (t *sequenceTable) empty() bool {
+	return t == nil || len(t.m) == 0
+}
+
+// Check whether any sequence starts with an ASCII rune.
+func (t *sequenceTable) hasASCII() bool {
+	return t != nil && t.ascii
+}
+
+// Find the longest sequence which s starts with, returning it along with its value.
+func (t *sequenceTable) match(s string) (source, value string, ok bool) {
+	if t.empty() {
+		return "", "", false
+	}
+
+	r, _ := utf8.DecodeRuneInString(s)
+
+	for _, m := range t.m[r] {
+		if strings.HasPrefix(s, m.source) {
+			return m.source, m.value, true
+		}
+	}
+
+	return "", "", false
+}
+
+func (t *sequenceTable) set(source, value string) {
+	t.delete(source)
+
+	r, _ := utf8.DecodeRuneInString(source)
+	if r <= unicode.MaxASCII {
+		t.ascii = true
+	}
+
+	if t.m == nil {
+		t.m = map[rune][]sequenceMapping{}
+	}
+
+	mappings := append(t.m[r], sequenceMapping{source: source, value: value})
+	slices.SortStableFunc(mappings, func(a, b sequenceMapping) int {
+		return len(b.source) - len(a.source)
+	})
+
+	t.m[r] = mappings
+}
+
+// Get the number of values.
+func (t *runeTable) len() int {
+	return t.strings.len() + len(t.added)
+}
+
+func (t *runeTable) setSupplementary(r rune, v string) {
+	if t.supplementary == nil {
+		t.supplementary = map[rune]string{}
+	}
+
+	t.supplementary[r] = v
+}
+
+// Remove values which are no longer referenced, or are duplicated, from the trie.
+func (t *runeTable) compact() {
+	var (
+		values  strings.Builder
+		offsets = []uint32{0}
+		seen    = map[string]uint16{}
+	)
+
+	for b := range t.blocks {
+		for lo, i := range t.blocks[b] {
+			if i == 0 {
+				continue
+			}
+
+			v := t.value(i)
+
+			j, ok := seen[v]
+			if !ok {
+				values.WriteString(v)
+				offsets = append(offsets, uint32(values.Len()))
+				j = uint16(len(offsets) - 1)
+				seen[v] = j
+			}
+
+			t.blocks[b][lo] = j
+		}
+	}
+
+	t.strings = &stringTable{
+		data:    values.String(),
+		offsets: offsets,
+	}
+	t.added = nil
+}
+
+// Get value i, counting from 1.
+func (t *runeTable) value(i uint16) string {
+	if n := t.strings.len(); int(i) > n {
+		return t.added[int(i)-n-1]
+	}
+
+	return t.strings.get(int(i))
+}
+
+// Get string i, counting from 1.
+func (st *stringTable) get(i int) string {
+	return st.data[st.offsets[i-1]:st.offsets[i]]
+}
+
+// Get the number of strings. A nil stringTable is empty.
+func (st *stringTable) len() int {
+	if st == nil {
+		return 0
+	}
+
+	return max(len(st.offsets)-1, 0)
+}
@@ -0,0 +1,280 @@
+package confusables
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tableDataMagic identifies the binary format of the generated tables, written by scripts/build-tables.go.
+const tableDataMagic = "CNF4"
+
+// errTableData is raised when the generated tables cannot be decoded.
+var errTableData = errors.New("malformed table data")
+
+// tableDecoder reads the binary format of the generated tables. Once an error occurs, further reads return zero
+// values.
+type tableDecoder struct {
+	data string
+	err  error
+}
+
+// generatedTables holds the decoded generated tables.
+type generatedTables struct {
+	// amendments holds the mappings of scripts/amendments.txt which the tables include, in the format of
+	// confusables.txt. They are kept apart from the tables so that they may be applied or not.
+	amendments string
+	date       string
+	// tables holds the mappings of confusables.txt, without the amendments.
+	tables  *tables
+	version string
+}
+
+// Decode the generated tables, which hold:
+//
+//	magic, version, date: strings
+//	strings: string, the values of the rune tables concatenated, then their count and the length of each as uvarints
+//	confusables, ascii: rune tables
+//	sequences, asciiSequences: sequence tables
+//	descriptions: description table
+//	amendments: string, lines of amendments.txt
+//
+// where strings are a uvarint length followed by their bytes, rune tables are:
+//
+//	block count: uvarint, then for each block its high byte, entry count, and for each entry its low byte and value
+//	supplementary count: uvarint, then for each its rune and value
+//
+// sequence tables are a uvarint count followed by, for each sequence, the sequence as a string and its value, and the
+// description table is:
+//
+//	rune count: uvarint, then for each in order its rune, as the uvarint difference from the last, and name as a string
+//	sequence count: uvarint, then for each its sequence, in NFD, and name as strings
+//
+// with values given as uvarint numbers of strings. Strings within the tables refer to data rather than being copied.
+func decodeTables(data string) (*generatedTables, error) {
+	d := &tableDecoder{data: data}
+
+	if magic := d.string(); magic != tableDataMagic {
+		return nil, fmt.Errorf("%w: unknown format %q", errTableData, magic)
+	}
+
+	g := &generatedTables{
+		version: d.string(),
+		date:    d.string(),
+	}
+
+	strs := d.stringTable()
+
+	g.tables = &tables{
+		confusables:    d.runeTable(strs),
+		ascii:          d.runeTable(strs),
+		sequences:      d.sequenceTable(strs),
+		asciiSequences: d.sequenceTable(strs),
+		descriptions:   d.descriptionTable(),
+	}
+	g.amendments = d.string()
+
+	if d.err == nil && d.data != "" {
+		d.err = fmt.Errorf("%w: %d trailing bytes", errTableData, len(d.data))
+	}
+
+	if d.err != nil {
+		return nil, d.err
+	}
+
+	return g, nil
+}
+
+// Decode the generated tables, panicking if they are malformed.
+func mustDecodeTables(data string) *generatedTables {
+	g, err := decodeTables(data)
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
+
+func (d *tableDecoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+
+	if d.data == "" {
+		d.err = fmt.Errorf("%w: unexpected end", errTableData)
+
+		return 0
+	}
+
+	b := d.data[0]
+	d.data = d.data[1:]
+
+	return b
+}
+
+func (d *tableDecoder) runeTable(strs *stringTable) *runeTable {
+	t := &runeTable{
+		strings: strs,
+	}
+
+	blocks := d.uvarint()
+	if blocks > bmpBlocks {
+		d.err = fmt.Errorf("%w: %d blocks", errTableData, blocks)
+	}
+
+	for b := uint64(0); b < blocks && d.err == nil; b++ {
+		var block [256]uint16
+
+		hi := d.byte()
+
+		for entries := d.uvarint(); entries > 0 && d.err == nil; entries-- {
+			lo := d.byte()
+			block[lo] = uint16(d.value(strs))
+		}
+
+		if len(t.blocks) == 0 {
+			t.blocks = append(t.blocks, [256]uint16{})
+		}
+
+		t.index[hi] = uint16(len(t.blocks))
+		t.blocks = append(t.blocks, block)
+	}
+
+	if n := d.uvarint(); n > 0 && d.err == nil {
+		t.supplementary = make(map[rune]string, min(n, uint64(len(d.data))))
+
+		for ; n > 0 && d.err == nil; n-- {
+			r := rune(d.uvarint())
+
+			if i := d.value(strs); d.err == nil {
+				t.supplementary[r] = strs.get(i)
+			}
+		}
+	}
+
+	return t
+}
+
+func (d *tableDecoder) descriptionTable() *descriptionTable {
+	t := &descriptionTable{}
+
+	if n := d.uvarint(); n > 0 && d.err == nil {
+		t.runes = make([]rune, 0, min(n, uint64(len(d.data))))
+		t.names = make([]string, 0, min(n, uint64(len(d.data))))
+
+		var r uint64
+
+		for ; n > 0 && d.err == nil; n-- {
+			delta := d.uvarint()
+			if r += delta; (delta == 0 && len(t.runes) > 0) || r > unicode.MaxRune {
+				d.err = fmt.Errorf("%w: description of rune %d", errTableData, r)
+			}
+
+			t.runes = append(t.runes, rune(r))
+			t.names = append(t.names, d.string())
+		}
+	}
+
+	if n := d.uvarint(); n > 0 && d.err == nil {
+		t.sequences = make(map[string]string, min(n, uint64(len(d.data))))
+
+		for ; n > 0 && d.err == nil; n-- {
+			source := d.string()
+			t.sequences[source] = d.string()
+		}
+	}
+
+	return t
+}
+
+func (d *tableDecoder) sequenceTable(strs *stringTable) *sequenceTable {
+	t := &sequenceTable{}
+
+	for n := d.uvarint(); n > 0 && d.err == nil; n-- {
+		source := d.string()
+
+		if i := d.value(strs); d.err == nil {
+			if utf8.RuneCountInString(source) < 2 {
+				d.err = fmt.Errorf("%w: sequence %q", errTableData, source)
+			}
+
+			t.set(source, strs.get(i))
+		}
+	}
+
+	return t
+}
+
+// Read the number of a string within strs.
+func (d *tableDecoder) value(strs *stringTable) int {
+	i := d.uvarint()
+	if d.err == nil && (i == 0 || i > uint64(strs.len())) {
+		d.err = fmt.Errorf("%w: string %d", errTableData, i)
+	}
+
+	return int(i)
+}
+
+func (d *tableDecoder) string() string {
+	n := d.uvarint()
+
+	if d.err != nil {
+		return ""
+	}
+
+	if n > uint64(len(d.data)) {
+		d.err = fmt.Errorf("%w: unexpected end", errTableData)
+
+		return ""
+	}
+
+	s := d.data[:n]
+	d.data = d.data[n:]
+
+	return s
+}
+
+func (d *tableDecoder) stringTable() *stringTable {
+	st := &stringTable{
+		data: d.string(),
+	}
+
+	n := d.uvarint()
+	if d.err == nil && (n > uint64(len(d.data)) || n >= math.MaxUint16) {
+		d.err = fmt.Errorf("%w: %d strings", errTableData, n)
+	}
+
+	if n > 0 && d.err == nil {
+		st.offsets = make([]uint32, 1, n+1)
+
+		for i := uint64(0); i < n; i++ {
+			st.offsets = append(st.offsets, st.offsets[i]+uint32(d.uvarint()))
+		}
+
+		if st.offsets[n] != uint32(len(st.data)) {
+			d.err = fmt.Errorf("%w: string lengths", errTableData)
+		}
+	}
+
+	return st
+}
+
+func (d *tableDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+
+	v, n := binary.Uvarint([]byte(d.data[:min(len(d.data), binary.MaxVarintLen64)]))
+	if n <= 0 {
+		d.err = fmt.Errorf("%w: invalid uvarint", errTableData)
+
+		return 0
+	}
+
+	d.data = d.data[n:]
+
+	return v
+}
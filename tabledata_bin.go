@@ -0,0 +1,8 @@
+//go:build !confusables_raw
+
+package confusables
+
+// Create the generated tables by decoding tablesData.
+func newGeneratedTables() *generatedTables {
+	return mustDecodeTables(tablesData)
+}
@@ -0,0 +1,88 @@
+//go:build confusables_raw
+
+package confusables
+
+import (
+	_ "embed"
+	"strings"
+)
+
+// With the confusables_raw build tag, confusables.txt and scripts/amendments.txt are embedded verbatim, rather than
+// the tables generated from them, so that the data may be compared byte for byte with Unicode's. They are parsed on
+// first use.
+
+//go:embed confusables.txt
+var rawConfusables string
+
+//go:embed scripts/amendments.txt
+var rawAmendments string
+
+func init() {
+	registerTable("confusables")
+}
+
+// Create the generated tables by parsing rawConfusables and rawAmendments.
+func newGeneratedTables() *generatedTables {
+	g, err := parseTables(rawConfusables, rawAmendments)
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
+
+// Parse the tables from confusables and amendments, both in the format of confusables.txt. As with the generated
+// tables, the amendments are kept apart from the mappings, but their descriptions are added to those of the mappings.
+func parseTables(confusables, amendments string) (*generatedTables, error) {
+	g := &generatedTables{
+		amendments: amendments,
+		tables: &tables{
+			ascii:          &runeTable{},
+			confusables:    &runeTable{},
+			descriptions:   &descriptionTable{},
+			asciiSequences: &sequenceTable{},
+			sequences:      &sequenceTable{},
+		},
+	}
+
+	for data := confusables; data != ""; {
+		var line string
+
+		line, data, _ = strings.Cut(data, "\n")
+
+		if v, ok := strings.CutPrefix(line, "# Version: "); ok {
+			g.version = strings.TrimSpace(v)
+		} else if v, ok := strings.CutPrefix(line, "# Date: "); ok {
+			g.date = strings.TrimSpace(v)
+		}
+	}
+
+	// As when the tables are generated, the first description of a string is kept.
+	describe := func(s, desc string) {
+		if _, ok := g.tables.descriptions.lookup(s); !ok && desc != "" {
+			g.tables.descriptions.set(s, desc)
+		}
+	}
+
+	err := eachEntry(confusables, func(entry *ConfusableEntry) {
+		g.tables.addSequenceMapping(entry.SourceSequence, entry.Target)
+		describe(entry.SourceSequence, entry.Description.From)
+		describe(entry.Target, entry.Description.To)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The mappings of confusables.txt are upstream, unlike those added to them.
+	g.tables.origins = nil
+
+	err = eachEntry(amendments, func(entry *ConfusableEntry) {
+		describe(entry.SourceSequence, entry.Description.From)
+		describe(entry.Target, entry.Description.To)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
@@ -1,3 +1,5 @@
+//go:build !confusables_latin_only && !confusables_bmp_only && !confusables_raw
+
 package confusables
 
 // THIS FILE WAS AUTOGENERATED - DO NOT EDIT
@@ -5,16162 +7,13 @@ package confusables
 // Date: 2024-08-14, 23:39:57 GMT
 // Version: 16.0.0
 
-var confusables = map[rune]string{
-	0x00000022: "''",
-	0x00000025: "\u00ba/\u2080",
-	0x00000030: "O",
-	0x00000031: "l",
-	0x00000049: "l",
-	0x00000060: "'",
-	0x0000006D: "rn",
-	0x0000007C: "l",
-	0x000000A0: " ",
-	0x000000A2: "c\u0338",
-	0x000000A5: "Y\u0335",
-	0x000000AF: "\u02c9",
-	0x000000B4: "'",
-	0x000000B5: "\u03bc",
-	0x000000B8: ",",
-	0x000000C6: "AE",
-	0x000000C7: "C\u0326",
-	0x000000D0: "D\u0335",
-	0x000000D7: "x",
-	0x000000D8: "O\u0338",
-	0x000000E6: "ae",
-	0x000000E7: "c\u0326",
-	0x000000F0: "\u2202\u0335",
-	0x000000F6: "\u0629",
-	0x000000F8: "o\u0338",
-	0x00000110: "D\u0335",
-	0x00000111: "d\u0335",
-	0x0000011A: "\u0114",
-	0x0000011B: "\u0115",
-	0x00000126: "H\u0335",
-	0x00000127: "h\u0335",
-	0x00000131: "i",
-	0x00000132: "lJ",
-	0x00000133: "ij",
-	0x0000013F: "l\u00b7",
-	0x00000140: "l\u00b7",
-	0x00000141: "L\u0338",
-	0x00000142: "l\u0338",
-	0x00000146: "\u0272",
-	0x00000149: "'n",
-	0x00000150: "\u00d6",
-	0x00000152: "OE",
-	0x00000153: "oe",
-	0x00000163: "\u01ab",
-	0x00000166: "T\u0335",
-	0x00000167: "t\u0335",
-	0x0000017F: "f",
-	0x00000180: "b\u0335",
-	0x00000181: "'B",
-	0x00000182: "b\u0304",
-	0x00000183: "b\u0304",
-	0x00000184: "b",
-	0x00000187: "C'",
-	0x00000189: "D\u0335",
-	0x0000018A: "'D",
-	0x0000018C: "d\u0304",
-	0x0000018D: "g",
-	0x00000191: "F\u0326",
-	0x00000192: "f\u0326",
-	0x00000193: "G'",
-	0x00000196: "l",
-	0x00000197: "l\u0335",
-	0x00000198: "K'",
-	0x00000199: "k\u0314",
-	0x0000019A: "l\u0335",
-	0x0000019B: "\u03bb\u0338",
-	0x0000019D: "N\u0326",
-	0x0000019E: "n\u0329",
-	0x0000019F: "O\u0335",
-	0x000001A0: "O'",
-	0x000001A1: "o'",
-	0x000001A4: "'P",
-	0x000001A5: "p\u0314",
-	0x000001A6: "R",
-	0x000001A7: "2",
-	0x000001AC: "'T",
-	0x000001AD: "t\u0314",
-	0x000001AE: "T\u0328",
-	0x000001B3: "'Y",
-	0x000001B4: "y\u0314",
-	0x000001B5: "Z\u0335",
-	0x000001B6: "z\u0335",
-	0x000001B7: "3",
-	0x000001BB: "2\u0335",
-	0x000001BC: "5",
-	0x000001BD: "s",
-	0x000001BF: "\u00fe",
-	0x000001C0: "l",
-	0x000001C1: "ll",
-	0x000001C3: "!",
-	0x000001C4: "D\u017d",
-	0x000001C5: "D\u017e",
-	0x000001C6: "d\u017e",
-	0x000001C7: "LJ",
-	0x000001C8: "Lj",
-	0x000001C9: "lj",
-	0x000001CA: "NJ",
-	0x000001CB: "Nj",
-	0x000001CC: "nj",
-	0x000001CD: "\u0102",
-	0x000001CE: "\u0103",
-	0x000001CF: "\u012c",
-	0x000001D0: "\u012d",
-	0x000001D1: "\u014e",
-	0x000001D2: "\u014f",
-	0x000001D3: "\u016c",
-	0x000001D4: "\u016d",
-	0x000001E4: "G\u0335",
-	0x000001E5: "g\u0335",
-	0x000001E6: "\u011e",
-	0x000001E7: "\u011f",
-	0x000001F1: "DZ",
-	0x000001F2: "Dz",
-	0x000001F3: "dz",
-	0x000001F5: "\u0123",
-	0x000001FE: "O\u0338\u0301",
-	0x0000021A: "\u0162",
-	0x0000021B: "\u01ab",
-	0x0000021C: "3",
-	0x00000222: "8",
-	0x00000223: "8",
-	0x00000224: "Z\u0326",
-	0x00000225: "z\u0326",
-	0x00000226: "\u00c5",
-	0x00000227: "\u00e5",
-	0x0000023C: "c\u0338",
-	0x0000023E: "T\u0338",
-	0x00000241: "?",
-	0x00000244: "U\u0335",
-	0x00000246: "E\u0338",
-	0x00000247: "e\u0338",
-	0x00000248: "J\u0335",
-	0x00000249: "j\u0335",
-	0x0000024D: "r\u0335",
-	0x0000024E: "Y\u0335",
-	0x0000024F: "y\u0335",
-	0x00000251: "a",
-	0x00000253: "b\u0314",
-	0x00000256: "d\u0328",
-	0x00000257: "d\u0314",
-	0x00000259: "\u01dd",
-	0x0000025A: "\u01dd\u02de",
-	0x0000025B: "\ua793",
-	0x00000260: "g\u0314",
-	0x00000261: "g",
-	0x00000263: "y",
-	0x00000266: "h\u0314",
-	0x00000268: "i\u0335",
-	0x00000269: "i",
-	0x0000026A: "i",
-	0x0000026B: "l\u0334",
-	0x0000026D: "l\u0328",
-	0x0000026E: "l\u021d",
-	0x0000026F: "w",
-	0x00000271: "rn\u0326",
-	0x00000273: "n\u0328",
-	0x00000275: "o\u0335",
-	0x00000276: "o\u1d07",
-	0x0000027C: "r\u0329",
-	0x0000027D: "r\u0328",
-	0x00000282: "s\u0328",
-	0x0000028B: "u",
-	0x0000028F: "y",
-	0x00000290: "z\u0328",
-	0x00000292: "\u021d",
-	0x00000294: "?",
-	0x000002A0: "q\u0314",
-	0x000002A3: "dz",
-	0x000002A4: "d\u021d",
-	0x000002A5: "d\u0291",
-	0x000002A6: "ts",
-	0x000002A7: "t\u0283",
-	0x000002A8: "t\u0255",
-	0x000002A9: "f\u014b",
-	0x000002AA: "ls",
-	0x000002AB: "lz",
-	0x000002B3: "\u18f4",
-	0x000002B9: "'",
-	0x000002BA: "''",
-	0x000002BB: "'",
-	0x000002BC: "'",
-	0x000002BD: "'",
-	0x000002BE: "'",
-	0x000002BF: "\u0559",
-	0x000002C2: "<",
-	0x000002C3: ">",
-	0x000002C4: "^",
-	0x000002C6: "^",
-	0x000002C8: "'",
-	0x000002CA: "'",
-	0x000002CB: "'",
-	0x000002D0: ":",
-	0x000002D3: "\u0559",
-	0x000002D7: "-",
-	0x000002D8: "\u02c7",
-	0x000002D9: "\u0971",
-	0x000002DA: "\u00b0",
-	0x000002DB: "i",
-	0x000002DC: "~",
-	0x000002DD: "''",
-	0x000002E1: "\u18f3",
-	0x000002E2: "\u18f5",
-	0x000002E4: "\u02c1",
-	0x000002EE: "''",
-	0x000002F4: "'",
-	0x000002F6: "''",
-	0x000002F8: ":",
-	0x000002FB: "\u02ea",
-	0x00000305: "\u0304",
-	0x0000030C: "\u0306",
-	0x0000030D: "\u0670",
-	0x00000310: "\u0306\u0307",
-	0x00000311: "\u0302",
-	0x00000315: "\u0313",
-	0x00000317: "\u0650",
-	0x00000320: "\u0331",
-	0x00000321: "\u0326",
-	0x00000322: "\u0328",
-	0x00000327: "\u0326",
-	0x00000336: "\u0335",
-	0x00000337: "\u0338",
-	0x00000339: "\u0326",
-	0x00000340: "\u0300",
-	0x00000341: "\u0301",
-	0x00000342: "\u0303",
-	0x00000343: "\u0313",
-	0x00000345: "\u0328",
-	0x00000347: "\u0333",
-	0x00000357: "\u0350",
-	0x00000358: "\u0307",
-	0x00000366: "\u030a",
-	0x0000036E: "\u0306",
-	0x00000370: "\u2c75",
-	0x00000374: "'",
-	0x00000375: "\u02cf",
-	0x00000376: "\u0418",
-	0x00000377: "\u1d0e",
-	0x0000037A: "i",
-	0x0000037B: "\u0254",
-	0x0000037D: "\ua73f",
-	0x0000037E: ";",
-	0x0000037F: "J",
-	0x00000384: "'",
-	0x00000387: "\u00b7",
-	0x00000391: "A",
-	0x00000392: "B",
-	0x00000395: "E",
-	0x00000396: "Z",
-	0x00000397: "H",
-	0x00000398: "O\u0335",
-	0x00000399: "l",
-	0x0000039A: "K",
-	0x0000039B: "\u0245",
-	0x0000039C: "M",
-	0x0000039D: "N",
-	0x0000039F: "O",
-	0x000003A1: "P",
-	0x000003A3: "\u01a9",
-	0x000003A4: "T",
-	0x000003A5: "Y",
-	0x000003A7: "X",
-	0x000003B1: "a",
-	0x000003B2: "\u00df",
-	0x000003B3: "y",
-	0x000003B4: "\u1e9f",
-	0x000003B5: "\ua793",
-	0x000003B7: "n\u0329",
-	0x000003B8: "O\u0335",
-	0x000003B9: "i",
-	0x000003BA: "\u0138",
-	0x000003BD: "v",
-	0x000003BF: "o",
-	0x000003C1: "p",
-	0x000003C3: "o",
-	0x000003C4: "\u1d1b",
-	0x000003C5: "u",
-	0x000003C6: "\u0278",
-	0x000003D0: "\u00df",
-	0x000003D1: "O\u0335",
-	0x000003D2: "Y",
-	0x000003D5: "\u0278",
-	0x000003D6: "\u03c0",
-	0x000003DB: "\u03c2",
-	0x000003DC: "F",
-	0x000003E8: "2",
-	0x000003E9: "\u01a8",
-	0x000003F0: "\u0138",
-	0x000003F1: "p",
-	0x000003F2: "c",
-	0x000003F3: "j",
-	0x000003F4: "O\u0335",
-	0x000003F5: "\ua793",
-	0x000003F7: "\u00de",
-	0x000003F8: "\u00fe",
-	0x000003F9: "C",
-	0x000003FA: "M",
-	0x000003FD: "\u0186",
-	0x000003FF: "\ua73e",
-	0x00000404: "\ua792",
-	0x00000405: "S",
-	0x00000406: "l",
-	0x00000408: "J",
-	0x00000410: "A",
-	0x00000411: "b\u0304",
-	0x00000412: "B",
-	0x00000413: "\u0393",
-	0x00000415: "E",
-	0x00000417: "3",
-	0x00000419: "\u040d",
-	0x0000041A: "K",
-	0x0000041B: "\u0245",
-	0x0000041C: "M",
-	0x0000041D: "H",
-	0x0000041E: "O",
-	0x0000041F: "\u03a0",
-	0x00000420: "P",
-	0x00000421: "C",
-	0x00000422: "T",
-	0x00000423: "Y",
-	0x00000424: "\u03a6",
-	0x00000425: "X",
-	0x0000042B: "bl",
-	0x0000042C: "b",
-	0x0000042E: "lO",
-	0x00000430: "a",
-	0x00000431: "6",
-	0x00000432: "\u0299",
-	0x00000433: "r",
-	0x00000435: "e",
-	0x00000437: "\u025c",
-	0x00000438: "\u1d0e",
-	0x0000043A: "\u0138",
-	0x0000043C: "\u028d",
-	0x0000043D: "\u029c",
-	0x0000043E: "o",
-	0x0000043F: "\u03c0",
-	0x00000440: "p",
-	0x00000441: "c",
-	0x00000442: "\u1d1b",
-	0x00000443: "y",
-	0x00000444: "\u0278",
-	0x00000445: "x",
-	0x0000044A: "\u02c9b",
-	0x0000044B: "\u0185i",
-	0x0000044C: "\u0185",
-	0x0000044F: "\u1d19",
-	0x00000454: "\ua793",
-	0x00000455: "s",
-	0x00000456: "i",
-	0x00000458: "j",
-	0x0000045B: "h\u0335",
-	0x0000045D: "\u0439",
-	0x00000461: "w",
-	0x00000462: "b\u0335",
-	0x00000463: "b\u0335",
-	0x00000470: "\u03a8",
-	0x00000471: "\u03c8",
-	0x00000472: "O\u0335",
-	0x00000473: "o\u0335",
-	0x00000474: "V",
-	0x00000475: "v",
-	0x0000047C: "\u0460\u0486\u0487",
-	0x0000047D: "w\u0486\u0487",
-	0x0000048A: "\u040d\u0326",
-	0x0000048B: "\u0439\u0326",
-	0x0000048C: "b\u0335",
-	0x0000048D: "b\u0335",
-	0x00000490: "\u0393'",
-	0x00000491: "r'",
-	0x00000492: "\u0393\u0335",
-	0x00000493: "r\u0335",
-	0x00000496: "\u0416\u0329",
-	0x00000497: "\u0436\u0329",
-	0x00000498: "3\u0326",
-	0x00000499: "\u025c\u0326",
-	0x0000049A: "K\u0329",
-	0x0000049B: "\u0138\u0329",
-	0x0000049E: "K\u0335",
-	0x0000049F: "\u0138\u0335",
-	0x000004A2: "H\u0329",
-	0x000004A3: "\u029c\u0329",
-	0x000004AA: "C\u0326",
-	0x000004AB: "c\u0326",
-	0x000004AC: "T\u0329",
-	0x000004AD: "\u1d1b\u0329",
-	0x000004AE: "Y",
-	0x000004AF: "y",
-	0x000004B0: "Y\u0335",
-	0x000004B1: "y\u0335",
-	0x000004B2: "X\u0329",
-	0x000004BB: "h",
-	0x000004BD: "e",
-	0x000004BE: "\u04bc\u0328",
-	0x000004BF: "e\u0328",
-	0x000004C0: "l",
-	0x000004C5: "\u0245\u0326",
-	0x000004C6: "\u043b\u0326",
-	0x000004C7: "H\u0326",
-	0x000004C8: "\u029c\u0326",
-	0x000004C9: "H\u0326",
-	0x000004CA: "\u029c\u0326",
-	0x000004CB: "\u04b6",
-	0x000004CC: "\u04b7",
-	0x000004CD: "M\u0326",
-	0x000004CE: "\u028d\u0326",
-	0x000004CF: "i",
-	0x000004D4: "AE",
-	0x000004D5: "ae",
-	0x000004D8: "\u018f",
-	0x000004D9: "\u01dd",
-	0x000004E0: "3",
-	0x000004E1: "\u021d",
-	0x000004E8: "O\u0335",
-	0x000004E9: "o\u0335",
-	0x00000501: "d",
-	0x0000050A: "\u01f6",
-	0x0000050C: "G",
-	0x0000050D: "\u0262",
-	0x00000510: "\u0190",
-	0x00000511: "\ua793",
-	0x0000051B: "q",
-	0x0000051C: "W",
-	0x0000051D: "w",
-	0x0000053B: "\u12ae",
-	0x00000544: "\u1206",
-	0x0000054A: "\u1323",
-	0x0000054C: "\u1261",
-	0x0000054D: "U",
-	0x0000054F: "S",
-	0x00000553: "\u03a6",
-	0x00000555: "O",
-	0x0000055A: "'",
-	0x0000055D: "'",
-	0x00000561: "w",
-	0x00000563: "q",
-	0x00000566: "q",
-	0x0000056E: "\u1e9f",
-	0x00000570: "h",
-	0x00000575: "\u0237",
-	0x00000578: "n",
-	0x0000057A: "\u0270",
-	0x0000057C: "n",
-	0x0000057D: "u",
-	0x00000581: "g",
-	0x00000584: "f",
-	0x00000585: "o",
-	0x00000587: "\u0565\u0582",
-	0x00000589: ":",
-	0x0000059C: "\u0301",
-	0x0000059D: "\u0301",
-	0x000005A4: "\u059a",
-	0x000005A8: "\u0599",
-	0x000005AD: "\u0596",
-	0x000005AE: "\u0598",
-	0x000005AF: "\u030a",
-	0x000005B4: "\u0323",
-	0x000005B9: "\u0307",
-	0x000005BA: "\u0307",
-	0x000005C0: "l",
-	0x000005C1: "\u0307",
-	0x000005C2: "\u0307",
-	0x000005C3: ":",
-	0x000005C4: "\u0307",
-	0x000005C5: "\u0323",
-	0x000005D5: "l",
-	0x000005D8: "v",
-	0x000005D9: "'",
-	0x000005DF: "l",
-	0x000005E1: "o",
-	0x000005F0: "ll",
-	0x000005F1: "l'",
-	0x000005F2: "''",
-	0x000005F3: "'",
-	0x000005F4: "''",
-	0x00000609: "\u00ba/\u2080\u2080",
-	0x0000060A: "\u00ba/\u2080\u2080\u2080",
-	0x0000060D: ",",
-	0x0000060F: "\u0639",
-	0x00000618: "\u0301",
-	0x00000619: "\u0313",
-	0x0000061A: "\u0650",
-	0x00000623: "l\u0674",
-	0x00000624: "\u0648\u0674",
-	0x00000625: "l\u0655",
-	0x00000626: "\u0649\u0674",
-	0x00000627: "l",
-	0x0000062B: "\u0649\u06db",
-	0x00000634: "\u0633\u06db",
-	0x0000063D: "\u0649\u0302",
-	0x0000063F: "\u0649\u06db",
-	0x00000647: "o",
-	0x0000064A: "\u0649",
-	0x0000064B: "\u030b",
-	0x0000064E: "\u0301",
-	0x0000064F: "\u0313",
-	0x00000652: "\u030a",
-	0x00000653: "\u0303",
-	0x00000656: "\u0329",
-	0x00000657: "\u0312",
-	0x00000658: "\u0306",
-	0x00000659: "\u0304",
-	0x0000065A: "\u0306",
-	0x0000065B: "\u0302",
-	0x0000065C: "\u0323",
-	0x0000065D: "\u0314",
-	0x0000065F: "\u0655",
-	0x00000660: ".",
-	0x00000661: "l",
-	0x00000665: "o",
-	0x00000667: "V",
-	0x00000668: "\u0245",
-	0x0000066A: "\u00ba/\u2080",
-	0x0000066B: ",",
-	0x0000066C: "\u060c",
-	0x0000066D: "*",
-	0x0000066E: "\u0649",
-	0x0000066F: "\u06a1",
-	0x00000672: "l\u0674",
-	0x00000673: "l\u0655",
-	0x00000675: "l\u0674",
-	0x00000676: "\u0648\u0674",
-	0x00000677: "\u0648\u0313\u0674",
-	0x00000678: "\u0649\u0674",
-	0x00000679: "\u0649\u0615",
-	0x0000067E: "\u0649\u06db",
-	0x00000681: "\u062d\u0654",
-	0x00000685: "\u062d\u06db",
-	0x00000688: "\u062f\u0615",
-	0x0000068B: "\u068a\u0615",
-	0x0000068E: "\u062f\u06db",
-	0x00000691: "\u0631\u0615",
-	0x00000692: "\u0631\u0306",
-	0x00000698: "\u0631\u06db",
-	0x0000069E: "\u0635\u06db",
-	0x0000069F: "\u0637\u06db",
-	0x000006A4: "\u06a1\u06db",
-	0x000006A7: "\u0641",
-	0x000006A8: "\u06a1\u06db",
-	0x000006A9: "\u0643",
-	0x000006AA: "\u0643",
-	0x000006AD: "\u0643\u06db",
-	0x000006B4: "\u06af\u06db",
-	0x000006B5: "\u0644\u0306",
-	0x000006B7: "\u0644\u06db",
-	0x000006BA: "\u0649",
-	0x000006BB: "\u0649\u0615",
-	0x000006BD: "\u0649\u06db",
-	0x000006BE: "o",
-	0x000006C1: "o",
-	0x000006C2: "\u06c0",
-	0x000006C3: "\u0629",
-	0x000006C6: "\u0648\u0306",
-	0x000006C7: "\u0648\u0313",
-	0x000006C8: "\u0648\u0670",
-	0x000006C9: "\u0648\u0302",
-	0x000006CB: "\u0648\u06db",
-	0x000006CC: "\u0649",
-	0x000006CE: "\u0649\u0306",
-	0x000006D0: "\u067b",
-	0x000006D1: "\u0649\u06db",
-	0x000006D2: "\u0649",
-	0x000006D4: "-",
-	0x000006D5: "o",
-	0x000006DF: "\u030a",
-	0x000006E8: "\u0306\u0307",
-	0x000006EC: "\u0307",
-	0x000006EE: "\u062f\u0302",
-	0x000006EF: "\u0631\u0302",
-	0x000006F0: ".",
-	0x000006F1: "l",
-	0x000006F2: "\u0662",
-	0x000006F3: "\u0663",
-	0x000006F4: "\u0664",
-	0x000006F5: "o",
-	0x000006F6: "\u0666",
-	0x000006F7: "V",
-	0x000006F8: "\u0245",
-	0x000006F9: "\u0669",
-	0x000006FD: "\u0621\u0348",
-	0x000006FE: "\u0645\u0348",
-	0x000006FF: "o\u0302",
-	0x00000701: ".",
-	0x00000702: ".",
-	0x00000703: ":",
-	0x00000704: ":",
-	0x00000740: "\u0307",
-	0x00000741: "\u0307",
-	0x00000742: "\u073c",
-	0x00000747: "\u0301",
-	0x00000751: "\u0628\u06db",
-	0x00000756: "\u0649\u0306",
-	0x00000762: "\u06ac",
-	0x00000763: "\u0643\u06db",
-	0x00000767: "\u0754",
-	0x00000768: "\u0646\u0615",
-	0x00000769: "\u0646\u0306",
-	0x0000076C: "\u0631\u0654",
-	0x00000771: "\u0697\u0615",
-	0x00000772: "\u062d\u0654",
-	0x0000077E: "\u0633\u0302",
-	0x000007C0: "O",
-	0x000007CA: "l",
-	0x000007EB: "\u0304",
-	0x000007ED: "\u0307",
-	0x000007EE: "\u0302",
-	0x000007F3: "\u0308",
-	0x000007F4: "'",
-	0x000007F5: "'",
-	0x000007FA: "_",
-	0x000008A1: "\u0628\u0654",
-	0x000008A4: "\u06a2\u06db",
-	0x000008A7: "\u0645\u06db",
-	0x000008A8: "\u0649\u0654",
-	0x000008A9: "\u0754",
-	0x000008AE: "\u062f\u0324\u0323",
-	0x000008AF: "\u0635\u0324\u0323",
-	0x000008B0: "\u06af",
-	0x000008B1: "\u0648",
-	0x000008B2: "\u0632\u0302",
-	0x000008B6: "\u0628\u06e2",
-	0x000008B7: "\u0649\u06db\u06e2",
-	0x000008B9: "\u0631\u0306\u0307",
-	0x000008BA: "\u0649\u0306\u0307",
-	0x000008BB: "\u06a1",
-	0x000008BC: "\u06a1",
-	0x000008BD: "\u0649",
-	0x000008E5: "\u064c",
-	0x000008E8: "\u064c",
-	0x000008EA: "\u0307",
-	0x000008EB: "\u0308",
-	0x000008ED: "\u0323",
-	0x000008EE: "\u0324",
-	0x000008F0: "\u030b",
-	0x000008F1: "\u064c",
-	0x000008F2: "\u064d",
-	0x000008F3: "\u0313",
-	0x000008F8: "\u0350",
-	0x000008F9: "\u0354",
-	0x000008FA: "\u0355",
-	0x000008FF: "\u0350",
-	0x00000900: "\u0352",
-	0x00000901: "\u0306\u0307",
-	0x00000902: "\u0307",
-	0x00000903: ":",
-	0x00000904: "\u0905\u0946",
-	0x00000906: "\u0905\u093e",
-	0x00000908: "\u0930\u094d\u0907",
-	0x0000090D: "\u090f\u0945",
-	0x0000090E: "\u090f\u0946",
-	0x00000910: "\u090f\u0947",
-	0x00000911: "\u0905\u0949",
-	0x00000912: "\u0905\u093e\u0946",
-	0x00000913: "\u0905\u093e\u0947",
-	0x00000914: "\u0905\u093e\u0948",
-	0x0000093C: "\u0323",
-	0x00000952: "\u0331",
-	0x00000953: "\u0300",
-	0x00000954: "\u0301",
-	0x00000965: "\u0964\u0964",
-	0x00000966: "o",
-	0x00000967: "\u0669",
-	0x0000097D: "?",
-	0x00000981: "\u0306\u0307",
-	0x00000986: "\u0985\u09be",
-	0x000009BC: "\u0323",
-	0x000009E0: "\u098b\u09c3",
-	0x000009E1: "\u098b\u09c3",
-	0x000009E6: "O",
-	0x000009EA: "8",
-	0x000009ED: "9",
-	0x00000A02: "\u0307",
-	0x00000A03: "\u0983",
-	0x00000A06: "\u0a05\u0a3e",
-	0x00000A07: "\u0a72\u0a3f",
-	0x00000A08: "\u0a72\u0a40",
-	0x00000A09: "\u0a73\u0a41",
-	0x00000A0A: "\u0a73\u0a42",
-	0x00000A0F: "\u0a72\u0a47",
-	0x00000A10: "\u0a05\u0a48",
-	0x00000A14: "\u0a05\u0a4c",
-	0x00000A3C: "\u0323",
-	0x00000A4B: "\u0946",
-	0x00000A4D: "\u094d",
-	0x00000A66: "o",
-	0x00000A67: "9",
-	0x00000A6A: "8",
-	0x00000A81: "\u0306\u0307",
-	0x00000A82: "\u0307",
-	0x00000A83: ":",
-	0x00000A86: "\u0a85\u0abe",
-	0x00000A8D: "\u0a85\u0ac5",
-	0x00000A8F: "\u0a85\u0ac7",
-	0x00000A90: "\u0a85\u0ac8",
-	0x00000A91: "\u0a85\u0abe\u0ac5",
-	0x00000A93: "\u0a85\u0abe\u0ac7",
-	0x00000A94: "\u0a85\u0abe\u0ac8",
-	0x00000ABC: "\u0323",
-	0x00000ABD: "\u093d",
-	0x00000AC1: "\u0941",
-	0x00000AC2: "\u0942",
-	0x00000ACD: "\u094d",
-	0x00000AE6: "o",
-	0x00000AE8: "\u0968",
-	0x00000AE9: "\u0969",
-	0x00000AEA: "\u096a",
-	0x00000AEE: "\u096e",
-	0x00000AF0: "\u0970",
-	0x00000B01: "\u0306\u0307",
-	0x00000B03: "8",
-	0x00000B06: "\u0b05\u0b3e",
-	0x00000B20: "O",
-	0x00000B3C: "\u0323",
-	0x00000B66: "O",
-	0x00000B68: "9",
-	0x00000B82: "\u030a",
-	0x00000B8A: "\u0b89\u0bb3",
-	0x00000B9C: "\u0b90",
-	0x00000BB0: "\u0b88",
-	0x00000BBE: "\u0b88",
-	0x00000BC8: "\u0ba9",
-	0x00000BCA: "\u0bc6\u0b88",
-	0x00000BCB: "\u0bc7\u0b88",
-	0x00000BCC: "\u0bc6\u0bb3",
-	0x00000BCD: "\u0307",
-	0x00000BD7: "\u0bb3",
-	0x00000BE6: "o",
-	0x00000BE7: "\u0b95",
-	0x00000BE8: "\u0b89",
-	0x00000BEA: "\u0b9a",
-	0x00000BEB: "\u0b88\u0bc1",
-	0x00000BEC: "\u0b9a\u0bc1",
-	0x00000BED: "\u0b8e",
-	0x00000BEE: "\u0b85",
-	0x00000BF0: "\u0baf",
-	0x00000BF2: "\u0b9a\u0bc2",
-	0x00000BF4: "\u0bae\u0bc0",
-	0x00000BF5: "\u0bf3",
-	0x00000BF7: "\u0b8e\u0bb5",
-	0x00000BF8: "\u0bb7",
-	0x00000BFA: "\u0ba8\u0bc0",
-	0x00000C00: "\u0306\u0307",
-	0x00000C02: "o",
-	0x00000C03: "\u0983",
-	0x00000C13: "\u0c12\u0c55",
-	0x00000C14: "\u0c12\u0c4c",
-	0x00000C20: "\u0c30\u05bc",
-	0x00000C22: "\u0c21\u0323",
-	0x00000C25: "\u0c27\u05bc",
-	0x00000C2D: "\u0c2c\u0323",
-	0x00000C2E: "\u0c35\u0c41",
-	0x00000C37: "\u0c35\u0323",
-	0x00000C39: "\u0c35\u0c3e",
-	0x00000C42: "\u0c41\u0c3e",
-	0x00000C44: "\u0c43\u0c3e",
-	0x00000C60: "\u0c0b\u0c3e",
-	0x00000C61: "\u0c0c\u0c3e",
-	0x00000C66: "o",
-	0x00000C81: "\u0306\u0307",
-	0x00000C82: "o",
-	0x00000C83: "\u0983",
-	0x00000C85: "\u0c05",
-	0x00000C86: "\u0c06",
-	0x00000C87: "\u0c07",
-	0x00000C92: "\u0c12",
-	0x00000C93: "\u0c12\u0c55",
-	0x00000C94: "\u0c12\u0c4c",
-	0x00000C9C: "\u0c1c",
-	0x00000C9E: "\u0c1e",
-	0x00000CA3: "\u0c23",
-	0x00000CAF: "\u0c2f",
-	0x00000CB1: "\u0c31",
-	0x00000CB2: "\u0c32",
-	0x00000CE1: "\u0c8c\u0cbe",
-	0x00000CE6: "o",
-	0x00000CE7: "\u0c67",
-	0x00000CE8: "\u0c68",
-	0x00000CEF: "\u0c6f",
-	0x00000D01: "\u0306\u0307",
-	0x00000D02: "o",
-	0x00000D03: "\u0983",
-	0x00000D08: "\u0d07\u0d57",
-	0x00000D09: "\u0b89",
-	0x00000D0A: "\u0b89\u0d57",
-	0x00000D0C: "\u0d28\u0d41",
-	0x00000D10: "\u0d0e\u0d46",
-	0x00000D13: "\u0d12\u0d3e",
-	0x00000D14: "\u0d12\u0d57",
-	0x00000D19: "\u0d28\u0d41",
-	0x00000D1C: "\u0b90",
-	0x00000D20: "o",
-	0x00000D23: "\u0ba3",
-	0x00000D31: "\u0d30",
-	0x00000D34: "\u0bb4",
-	0x00000D36: "\u0bb6",
-	0x00000D3A: "\u0b9f\u0bbf",
-	0x00000D3F: "\u0bbf",
-	0x00000D40: "\u0bbf",
-	0x00000D42: "\u0d41",
-	0x00000D43: "\u0d41",
-	0x00000D48: "\u0d46\u0d46",
-	0x00000D4E: "\u0971",
-	0x00000D5A: "\u0d28\u0d4d\u0d2e",
-	0x00000D5F: "o\u0d30o",
-	0x00000D61: "\u0d1e",
-	0x00000D66: "o",
-	0x00000D6A: "\u0d30\u0d4d",
-	0x00000D6B: "\u0d26\u0d4d\u0d30",
-	0x00000D6C: "\u0d28\u0d4d\u0d28",
-	0x00000D6D: "9",
-	0x00000D6E: "\u0d35\u0d4d\u0d30",
-	0x00000D6F: "\u0d28\u0d4d",
-	0x00000D76: "\u0d39\u0d4d\u0d2e",
-	0x00000D79: "\u0d28\u0d41",
-	0x00000D7B: "\u0d28\u0d4d",
-	0x00000D7C: "\u0d30\u0d4d",
-	0x00000D82: "o",
-	0x00000D83: "\u0983",
-	0x00000DE9: "\u0de8\u0dcf",
-	0x00000DEA: "\u0da2",
-	0x00000DEB: "\u0daf",
-	0x00000DEF: "\u0de8\u0dd3",
-	0x00000E03: "\u0e02",
-	0x00000E0B: "\u0e0a",
-	0x00000E0F: "\u0e0e",
-	0x00000E14: "\u0e04",
-	0x00000E15: "\u0e04",
-	0x00000E17: "\u0e11",
-	0x00000E21: "\u0e06",
-	0x00000E26: "\u0e20",
-	0x00000E33: "\u030a\u0e32",
-	0x00000E41: "\u0e40\u0e40",
-	0x00000E45: "\u0e32",
-	0x00000E4D: "\u030a",
-	0x00000E50: "o",
-	0x00000E88: "\u0e08",
-	0x00000E8D: "\u0e22",
-	0x00000E9A: "\u0e1a",
-	0x00000E9B: "\u0e1b",
-	0x00000E9D: "\u0e1d",
-	0x00000E9E: "\u0e1e",
-	0x00000E9F: "\u0e1f",
-	0x00000EB3: "\u030a\u0eb2",
-	0x00000EB8: "\u0e38",
-	0x00000EB9: "\u0e39",
-	0x00000EC8: "\u0e48",
-	0x00000EC9: "\u0e49",
-	0x00000ECA: "\u0e4a",
-	0x00000ECB: "\u0e4b",
-	0x00000ECD: "\u030a",
-	0x00000ED0: "o",
-	0x00000EDC: "\u0eab\u0e99",
-	0x00000EDD: "\u0eab\u0ea1",
-	0x00000F00: "\u0f68\u0f7c\u0f7e",
-	0x00000F02: "\u0f60\u0f74\u0f82\u0f7f",
-	0x00000F03: "\u0f60\u0f74\u0f82\u0f14",
-	0x00000F0C: "\u0f0b",
-	0x00000F0E: "\u0f0d\u0f0d",
-	0x00000F1B: "\u0f1a\u0f1a",
-	0x00000F1E: "\u0f1d\u0f1d",
-	0x00000F1F: "\u0f1a\u0f1d",
-	0x00000F37: "\u0325",
-	0x00000F6A: "\u0f62",
-	0x00000F77: "\u0fb2\u0f71\u0f80",
-	0x00000F79: "\u0fb3\u0f71\u0f80",
-	0x00000FCE: "\u0f1d\u0f1a",
-	0x00000FD5: "\u5350",
-	0x00000FD6: "\u534d",
-	0x00001000: "\u1002\u102c",
-	0x00001010: "o\u102c",
-	0x0000101D: "o",
-	0x0000101F: "\u1015\u102c",
-	0x00001029: "\u101e\u103c",
-	0x0000102A: "\u101e\u103c\u1031\u102c\u103a",
-	0x00001036: "\u030a",
-	0x00001038: "\u0983",
-	0x00001040: "o",
-	0x0000104B: "\u104a\u104a",
-	0x00001065: "\u1041",
-	0x00001066: "\u1015\u103e",
-	0x0000106F: "\u1015\u102c\u103e",
-	0x00001070: "\u1003\u103e",
-	0x0000107E: "\u107d\u103e",
-	0x00001081: "\u1002\u103e",
-	0x0000109E: "\u1083\u030a",
-	0x000010A0: "\ua786",
-	0x000010E7: "y",
-	0x000010F3: "\u021d",
-	0x000010FF: "o",
-	0x00001101: "\u1100\u1100",
-	0x00001104: "\u1103\u1103",
-	0x00001108: "\u1107\u1107",
-	0x0000110A: "\u1109\u1109",
-	0x0000110D: "\u110c\u110c",
-	0x00001113: "\u1102\u1100",
-	0x00001114: "\u1102\u1102",
-	0x00001115: "\u1102\u1103",
-	0x00001116: "\u1102\u1107",
-	0x00001117: "\u1103\u1100",
-	0x00001118: "\u1105\u1102",
-	0x00001119: "\u1105\u1105",
-	0x0000111A: "\u1105\u1112",
-	0x0000111B: "\u1105\u110b",
-	0x0000111C: "\u1106\u1107",
-	0x0000111D: "\u1106\u110b",
-	0x0000111E: "\u1107\u1100",
-	0x0000111F: "\u1107\u1102",
-	0x00001120: "\u1107\u1103",
-	0x00001121: "\u1107\u1109",
-	0x00001122: "\u1107\u1109\u1100",
-	0x00001123: "\u1107\u1109\u1103",
-	0x00001124: "\u1107\u1109\u1107",
-	0x00001125: "\u1107\u1109\u1109",
-	0x00001126: "\u1107\u1109\u110c",
-	0x00001127: "\u1107\u110c",
-	0x00001128: "\u1107\u110e",
-	0x00001129: "\u1107\u1110",
-	0x0000112A: "\u1107\u1111",
-	0x0000112B: "\u1107\u110b",
-	0x0000112C: "\u1107\u1107\u110b",
-	0x0000112D: "\u1109\u1100",
-	0x0000112E: "\u1109\u1102",
-	0x0000112F: "\u1109\u1103",
-	0x00001130: "\u1109\u1105",
-	0x00001131: "\u1109\u1106",
-	0x00001132: "\u1109\u1107",
-	0x00001133: "\u1109\u1107\u1100",
-	0x00001134: "\u1109\u1109\u1109",
-	0x00001135: "\u1109\u110b",
-	0x00001136: "\u1109\u110c",
-	0x00001137: "\u1109\u110e",
-	0x00001138: "\u1109\u110f",
-	0x00001139: "\u1109\u1110",
-	0x0000113A: "\u1109\u1111",
-	0x0000113B: "\u1105\u1112",
-	0x0000113D: "\u113c\u113c",
-	0x0000113F: "\u113e\u113e",
-	0x00001141: "\u110b\u1100",
-	0x00001142: "\u110b\u1103",
-	0x00001143: "\u110b\u1106",
-	0x00001144: "\u110b\u1107",
-	0x00001145: "\u110b\u1109",
-	0x00001146: "\u110b\u1140",
-	0x00001147: "\u110b\u110b",
-	0x00001148: "\u110b\u110c",
-	0x00001149: "\u110b\u110e",
-	0x0000114A: "\u110b\u1110",
-	0x0000114B: "\u110b\u1111",
-	0x0000114D: "\u110c\u110b",
-	0x0000114F: "\u114e\u114e",
-	0x00001151: "\u1150\u1150",
-	0x00001152: "\u110e\u110f",
-	0x00001153: "\u110e\u1112",
-	0x00001156: "\u1111\u1107",
-	0x00001157: "\u1111\u110b",
-	0x00001158: "\u1112\u1112",
-	0x0000115A: "\u1100\u1103",
-	0x0000115B: "\u1102\u1109",
-	0x0000115C: "\u1102\u110c",
-	0x0000115D: "\u1102\u1112",
-	0x0000115E: "\u1103\u1105",
-	0x00001162: "\u1161\u4e28",
-	0x00001164: "\u1163\u4e28",
-	0x00001166: "\u1165\u4e28",
-	0x00001168: "\u1167\u4e28",
-	0x0000116A: "\u1169\u1161",
-	0x0000116B: "\u1169\u1161\u4e28",
-	0x0000116C: "\u1169\u4e28",
-	0x0000116F: "\u116e\u1165",
-	0x00001170: "\u116e\u1165\u4e28",
-	0x00001171: "\u116e\u4e28",
-	0x00001173: "\u30fc",
-	0x00001174: "\u30fc\u4e28",
-	0x00001175: "\u4e28",
-	0x00001176: "\u1161\u1169",
-	0x00001177: "\u1161\u116e",
-	0x00001178: "\u1163\u1169",
-	0x00001179: "\u1163\u116d",
-	0x0000117A: "\u1165\u1169",
-	0x0000117B: "\u1165\u116e",
-	0x0000117C: "\u1165\u30fc",
-	0x0000117D: "\u1167\u1169",
-	0x0000117E: "\u1167\u116e",
-	0x0000117F: "\u1169\u1165",
-	0x00001180: "\u1169\u1165\u4e28",
-	0x00001181: "\u1169\u1167\u4e28",
-	0x00001182: "\u1169\u1169",
-	0x00001183: "\u1169\u116e",
-	0x00001184: "\u116d\u1163",
-	0x00001185: "\u116d\u1163\u4e28",
-	0x00001186: "\u116d\u1163",
-	0x00001187: "\u116d\u1169",
-	0x00001188: "\u116d\u4e28",
-	0x00001189: "\u116e\u1161",
-	0x0000118A: "\u116e\u1161\u4e28",
-	0x0000118B: "\u116e\u1165\u30fc",
-	0x0000118C: "\u116e\u1167\u4e28",
-	0x0000118D: "\u116e\u116e",
-	0x0000118E: "\u1172\u1161",
-	0x0000118F: "\u1172\u1165",
-	0x00001190: "\u1172\u1165\u4e28",
-	0x00001191: "\u1172\u1167",
-	0x00001192: "\u1172\u1167\u4e28",
-	0x00001193: "\u1172\u116e",
-	0x00001194: "\u1172\u4e28",
-	0x00001195: "\u30fc\u116e",
-	0x00001196: "\u30fc\u30fc",
-	0x00001197: "\u30fc\u4e28\u116e",
-	0x00001198: "\u4e28\u1161",
-	0x00001199: "\u4e28\u1163",
-	0x0000119A: "\u4e28\u1169",
-	0x0000119B: "\u4e28\u116e",
-	0x0000119C: "\u4e28\u30fc",
-	0x0000119D: "\u4e28\u119e",
-	0x0000119F: "\u119e\u1165",
-	0x000011A0: "\u119e\u116e",
-	0x000011A1: "\u119e\u4e28",
-	0x000011A2: "\u119e\u119e",
-	0x000011A3: "\u1161\u30fc",
-	0x000011A4: "\u1163\u116e",
-	0x000011A5: "\u1167\u1163",
-	0x000011A6: "\u1169\u1163",
-	0x000011A7: "\u1169\u1163\u4e28",
-	0x000011A8: "\u1100",
-	0x000011A9: "\u1100\u1100",
-	0x000011AA: "\u1100\u1109",
-	0x000011AB: "\u1102",
-	0x000011AC: "\u1102\u110c",
-	0x000011AD: "\u1102\u1112",
-	0x000011AE: "\u1103",
-	0x000011AF: "\u1105",
-	0x000011B0: "\u1105\u1100",
-	0x000011B1: "\u1105\u1106",
-	0x000011B2: "\u1105\u1107",
-	0x000011B3: "\u1105\u1109",
-	0x000011B4: "\u1105\u1110",
-	0x000011B5: "\u1105\u1111",
-	0x000011B6: "\u1105\u1112",
-	0x000011B7: "\u1106",
-	0x000011B8: "\u1107",
-	0x000011B9: "\u1107\u1109",
-	0x000011BA: "\u1109",
-	0x000011BB: "\u1109\u1109",
-	0x000011BC: "\u110b",
-	0x000011BD: "\u110c",
-	0x000011BE: "\u110e",
-	0x000011BF: "\u110f",
-	0x000011C0: "\u1110",
-	0x000011C1: "\u1111",
-	0x000011C2: "\u1112",
-	0x000011C3: "\u1100\u1105",
-	0x000011C4: "\u1100\u1109\u1100",
-	0x000011C5: "\u1102\u1100",
-	0x000011C6: "\u1102\u1103",
-	0x000011C7: "\u1102\u1109",
-	0x000011C8: "\u1102\u1140",
-	0x000011C9: "\u1102\u1110",
-	0x000011CA: "\u1103\u1100",
-	0x000011CB: "\u1103\u1105",
-	0x000011CC: "\u1105\u1100\u1109",
-	0x000011CD: "\u1105\u1102",
-	0x000011CE: "\u1105\u1103",
-	0x000011CF: "\u1105\u1103\u1112",
-	0x000011D0: "\u1105\u1105",
-	0x000011D1: "\u1105\u1106\u1100",
-	0x000011D2: "\u1105\u1106\u1109",
-	0x000011D3: "\u1105\u1107\u1109",
-	0x000011D4: "\u1105\u1107\u1112",
-	0x000011D5: "\u1105\u1107\u110b",
-	0x000011D6: "\u1105\u1109\u1109",
-	0x000011D7: "\u1105\u1140",
-	0x000011D8: "\u1105\u110f",
-	0x000011D9: "\u1105\u1159",
-	0x000011DA: "\u1106\u1100",
-	0x000011DB: "\u1106\u1105",
-	0x000011DC: "\u1106\u1107",
-	0x000011DD: "\u1106\u1109",
-	0x000011DE: "\u1106\u1109\u1109",
-	0x000011DF: "\u1106\u1140",
-	0x000011E0: "\u1106\u110e",
-	0x000011E1: "\u1106\u1112",
-	0x000011E2: "\u1106\u110b",
-	0x000011E3: "\u1107\u1105",
-	0x000011E4: "\u1107\u1111",
-	0x000011E5: "\u1107\u1112",
-	0x000011E6: "\u1107\u110b",
-	0x000011E7: "\u1109\u1100",
-	0x000011E8: "\u1109\u1103",
-	0x000011E9: "\u1109\u1105",
-	0x000011EA: "\u1109\u1107",
-	0x000011EB: "\u1140",
-	0x000011EC: "\u110b\u1100",
-	0x000011ED: "\u110b\u1100\u1100",
-	0x000011EE: "\u110b\u110b",
-	0x000011EF: "\u110b\u110f",
-	0x000011F0: "\u114c",
-	0x000011F1: "\u110b\u1109",
-	0x000011F2: "\u110b\u1140",
-	0x000011F3: "\u1111\u1107",
-	0x000011F4: "\u1111\u110b",
-	0x000011F5: "\u1112\u1102",
-	0x000011F6: "\u1112\u1105",
-	0x000011F7: "\u1112\u1106",
-	0x000011F8: "\u1112\u1107",
-	0x000011F9: "\u1159",
-	0x000011FA: "\u1100\u1102",
-	0x000011FB: "\u1100\u1107",
-	0x000011FC: "\u1100\u110e",
-	0x000011FD: "\u1100\u110f",
-	0x000011FE: "\u1100\u1112",
-	0x000011FF: "\u1102\u1102",
-	0x00001200: "U",
-	0x00001223: "\u0270",
-	0x00001240: "\u03a6",
-	0x00001260: "\u0548",
-	0x00001294: "\u0571",
-	0x000012D0: "O",
-	0x000013A0: "D",
-	0x000013A1: "R",
-	0x000013A2: "T",
-	0x000013A4: "O'",
-	0x000013A5: "i",
-	0x000013A8: "\u2c75",
-	0x000013A9: "Y",
-	0x000013AA: "A",
-	0x000013AB: "J",
-	0x000013AC: "E",
-	0x000013AE: "?",
-	0x000013B0: "\u2c75",
-	0x000013B1: "\u0393",
-	0x000013B3: "W",
-	0x000013B7: "M",
-	0x000013BB: "H",
-	0x000013BD: "Y",
-	0x000013BE: "O\u0335",
-	0x000013BF: "\u01ab",
-	0x000013C0: "G",
-	0x000013C2: "h",
-	0x000013C3: "Z",
-	0x000013C7: "\u0460",
-	0x000013CB: "\u0190",
-	0x000013CC: "U\u0335",
-	0x000013CE: "4",
-	0x000013CF: "b",
-	0x000013D2: "R",
-	0x000013D4: "W",
-	0x000013D5: "S",
-	0x000013D9: "V",
-	0x000013DA: "S",
-	0x000013DE: "L",
-	0x000013DF: "C",
-	0x000013E2: "P",
-	0x000013E6: "K",
-	0x000013E7: "d",
-	0x000013EB: "O\u0335",
-	0x000013EE: "6",
-	0x000013F0: "\u00df",
-	0x000013F2: "h\u0314",
-	0x000013F3: "G",
-	0x000013F4: "B",
-	0x000013FB: "\u0262",
-	0x000013FC: "\u0299",
-	0x00001400: "=",
-	0x00001403: "\u0394",
-	0x0000140C: "\u00b7\u1401",
-	0x0000140D: "\u1401\u00b7",
-	0x0000140E: "\u00b7\u0394",
-	0x0000140F: "\u0394\u00b7",
-	0x00001410: "\u00b7\u1404",
-	0x00001411: "\u1404\u00b7",
-	0x00001412: "\u00b7\u1405",
-	0x00001413: "\u1405\u00b7",
-	0x00001414: "\u00b7\u1406",
-	0x00001415: "\u1406\u00b7",
-	0x00001417: "\u00b7\u140a",
-	0x00001418: "\u140a\u00b7",
-	0x00001419: "\u00b7\u140b",
-	0x0000141A: "\u140b\u00b7",
-	0x00001427: "\u00b7",
-	0x0000142B: "\u1401\u1420",
-	0x0000142C: "\u0394\u1420",
-	0x0000142D: "\u1405\u1420",
-	0x0000142E: "\u140a\u1420",
-	0x0000142F: "V",
-	0x00001431: "\u0245",
-	0x00001433: ">",
-	0x00001437: "\u00b7>",
-	0x00001438: "<",
-	0x0000143A: "\u00b7V",
-	0x0000143B: "V\u00b7",
-	0x0000143C: "\u00b7\u0245",
-	0x0000143D: "\u0245\u00b7",
-	0x0000143E: "\u00b7\u1432",
-	0x0000143F: "\u1432\u00b7",
-	0x00001440: "\u00b7>",
-	0x00001441: ">\u00b7",
-	0x00001442: "\u00b7\u1434",
-	0x00001443: "\u1434\u00b7",
-	0x00001444: "\u00b7<",
-	0x00001445: "<\u00b7",
-	0x00001446: "\u00b7\u1439",
-	0x00001447: "\u1439\u00b7",
-	0x0000144A: "'",
-	0x0000144C: "U",
-	0x0000144E: "\u0548",
-	0x00001454: "\u00b7\u1450",
-	0x00001457: "\u00b7U",
-	0x00001458: "U\u00b7",
-	0x00001459: "\u00b7\u0548",
-	0x0000145A: "\u0548\u00b7",
-	0x0000145B: "\u00b7\u144f",
-	0x0000145C: "\u144f\u00b7",
-	0x0000145D: "\u00b7\u1450",
-	0x0000145E: "\u1450\u00b7",
-	0x0000145F: "\u00b7\u1451",
-	0x00001460: "\u1451\u00b7",
-	0x00001461: "\u00b7\u1455",
-	0x00001462: "\u1455\u00b7",
-	0x00001463: "\u00b7\u1456",
-	0x00001464: "\u1456\u00b7",
-	0x00001467: "U'",
-	0x00001468: "\u0548'",
-	0x00001469: "\u1450'",
-	0x0000146A: "\u1455'",
-	0x0000146D: "P",
-	0x0000146F: "d",
-	0x00001472: "b",
-	0x00001473: "b\u0307",
-	0x00001474: "\u00b7\u146b",
-	0x00001475: "\u146b\u00b7",
-	0x00001476: "\u00b7P",
-	0x00001477: "p\u00b7",
-	0x00001478: "\u00b7\u146e",
-	0x00001479: "\u146e\u00b7",
-	0x0000147A: "\u00b7d",
-	0x0000147B: "d\u00b7",
-	0x0000147C: "\u00b7\u1470",
-	0x0000147D: "\u1470\u00b7",
-	0x0000147E: "\u00b7b",
-	0x0000147F: "b\u00b7",
-	0x00001480: "\u00b7b\u0307",
-	0x00001481: "b\u0307\u00b7",
-	0x00001485: "\u146b'",
-	0x00001486: "P'",
-	0x00001487: "d'",
-	0x00001488: "b'",
-	0x0000148D: "J",
-	0x00001492: "\u00b7\u1489",
-	0x00001493: "\u1489\u00b7",
-	0x00001494: "\u00b7\u148b",
-	0x00001495: "\u148b\u00b7",
-	0x00001496: "\u00b7\u148c",
-	0x00001497: "\u148c\u00b7",
-	0x00001498: "\u00b7J",
-	0x00001499: "J\u00b7",
-	0x0000149A: "\u00b7\u148e",
-	0x0000149B: "\u148e\u00b7",
-	0x0000149C: "\u00b7\u1490",
-	0x0000149D: "\u1490\u00b7",
-	0x0000149E: "\u00b7\u1491",
-	0x0000149F: "\u1491\u00b7",
-	0x000014A5: "\u0393",
-	0x000014AA: "L",
-	0x000014AC: "\u00b7\u14a3",
-	0x000014AD: "\u14a3\u00b7",
-	0x000014AE: "\u00b7\u0393",
-	0x000014AF: "\u0393\u00b7",
-	0x000014B0: "\u00b7\u14a6",
-	0x000014B1: "\u14a6\u00b7",
-	0x000014B2: "\u00b7\u14a7",
-	0x000014B3: "\u14a7\u00b7",
-	0x000014B4: "\u00b7\u14a8",
-	0x000014B5: "\u14a8\u00b7",
-	0x000014B6: "\u00b7L",
-	0x000014B7: "l\u00b7",
-	0x000014B8: "\u00b7\u14ab",
-	0x000014B9: "\u14ab\u00b7",
-	0x000014BF: "2",
-	0x000014C9: "\u00b7\u14c0",
-	0x000014CA: "\u14c0\u00b7",
-	0x000014CB: "\u00b7\u14c7",
-	0x000014CC: "\u14c7\u00b7",
-	0x000014CD: "\u00b7\u14c8",
-	0x000014CE: "\u14c8\u00b7",
-	0x000014D1: "\u1421",
-	0x000014DC: "\u00b7\u14d3",
-	0x000014DD: "\u14d3\u00b7",
-	0x000014DE: "\u00b7\u14d5",
-	0x000014DF: "\u14d5\u00b7",
-	0x000014E0: "\u00b7\u14d6",
-	0x000014E1: "\u14d6\u00b7",
-	0x000014E2: "\u00b7\u14d7",
-	0x000014E3: "\u14d7\u00b7",
-	0x000014E4: "\u00b7\u14d8",
-	0x000014E5: "\u14d8\u00b7",
-	0x000014E6: "\u00b7\u14da",
-	0x000014E7: "\u14da\u00b7",
-	0x000014E8: "\u00b7\u14db",
-	0x000014E9: "\u14db\u00b7",
-	0x000014F6: "\u00b7\u14ed",
-	0x000014F7: "\u14ed\u00b7",
-	0x000014F8: "\u00b7\u14ef",
-	0x000014F9: "\u14ef\u00b7",
-	0x000014FA: "\u00b7\u14f0",
-	0x000014FB: "\u14f0\u00b7",
-	0x000014FC: "\u00b7\u14f1",
-	0x000014FD: "\u14f1\u00b7",
-	0x000014FE: "\u00b7\u14f2",
-	0x000014FF: "\u14f2\u00b7",
-	0x00001500: "\u00b7\u14f4",
-	0x00001501: "\u14f4\u00b7",
-	0x00001502: "\u00b7\u14f5",
-	0x00001503: "\u14f5\u00b7",
-	0x0000150C: "\u150b<",
-	0x0000150D: "\u150b\u1455",
-	0x0000150E: "\u150bb",
-	0x0000150F: "\u150b\u1490",
-	0x00001517: "\u00b7\u1510",
-	0x00001518: "\u1510\u00b7",
-	0x00001519: "\u00b7\u1511",
-	0x0000151A: "\u1511\u00b7",
-	0x0000151B: "\u00b7\u1512",
-	0x0000151C: "\u1512\u00b7",
-	0x0000151D: "\u00b7\u1513",
-	0x0000151E: "\u1513\u00b7",
-	0x0000151F: "\u00b7\u1514",
-	0x00001520: "\u1514\u00b7",
-	0x00001521: "\u00b7\u1515",
-	0x00001522: "\u1515\u00b7",
-	0x00001523: "\u00b7\u1516",
-	0x00001524: "\u1516\u00b7",
-	0x0000152F: "\u00b74",
-	0x00001530: "4\u00b7",
-	0x00001531: "\u00b7\u1528",
-	0x00001532: "\u1528\u00b7",
-	0x00001533: "\u00b7\u1529",
-	0x00001534: "\u1529\u00b7",
-	0x00001535: "\u00b7\u152a",
-	0x00001536: "\u152a\u00b7",
-	0x00001537: "\u00b7\u152b",
-	0x00001538: "\u152b\u00b7",
-	0x00001539: "\u00b7\u152d",
-	0x0000153A: "\u152d\u00b7",
-	0x0000153B: "\u00b7\u152e",
-	0x0000153C: "\u152e\u00b7",
-	0x00001540: "\u1429",
-	0x00001541: "x",
-	0x0000154E: "\u00b7\u154c",
-	0x0000154F: "\u154c\u00b7",
-	0x0000155B: "\u00b7\u155a",
-	0x0000155C: "\u155a\u00b7",
-	0x00001568: "\u00b7\u1567",
-	0x00001569: "\u1567\u00b7",
-	0x00001577: "\u1e9f",
-	0x0000157C: "H",
-	0x0000157D: "x",
-	0x0000157E: "\u1550\u146c",
-	0x0000157F: "\u1550P",
-	0x00001580: "\u1550\u146e",
-	0x00001581: "\u1550d",
-	0x00001582: "\u1550\u1470",
-	0x00001583: "\u1550b",
-	0x00001584: "\u1550b\u0307",
-	0x00001585: "\u1550\u1483",
-	0x00001587: "R",
-	0x0000158E: "\u1595\u148a",
-	0x0000158F: "\u1595\u148b",
-	0x00001590: "\u1595\u148c",
-	0x00001591: "\u1595J",
-	0x00001592: "\u1595\u148e",
-	0x00001593: "\u1595\u1490",
-	0x00001594: "\u1595\u1491",
-	0x000015AF: "b",
-	0x000015B4: "F",
-	0x000015B5: "\u2132",
-	0x000015B7: "\ua7fb",
-	0x000015C4: "\u2c6f",
-	0x000015C5: "A",
-	0x000015DE: "D",
-	0x000015EA: "D",
-	0x000015EF: "\u0460",
-	0x000015F0: "M",
-	0x000015F7: "B",
-	0x00001602: "\u1490",
-	0x00001603: "\u1489",
-	0x00001604: "\u14d3",
-	0x00001607: "\u14da",
-	0x00001622: "\u1543",
-	0x00001623: "\u1546",
-	0x00001624: "\u154a",
-	0x0000162E: "\u01b1",
-	0x0000162F: "\u03a9",
-	0x00001634: "\u01b1",
-	0x00001635: "\u03a9",
-	0x0000166D: "X",
-	0x0000166E: "x",
-	0x0000166F: "\u1550\u146b",
-	0x00001670: "\u1595\u1489",
-	0x00001671: "\u1596\u148b",
-	0x00001672: "\u1596\u148c",
-	0x00001673: "\u1596J",
-	0x00001674: "\u1596\u148e",
-	0x00001675: "\u1596\u1490",
-	0x00001676: "\u1596\u1491",
-	0x00001677: "\u15a7\u00b7",
-	0x00001678: "\u15a8\u00b7",
-	0x00001679: "\u15a9\u00b7",
-	0x0000167A: "\u15aa\u00b7",
-	0x0000167B: "\u15ab\u00b7",
-	0x0000167C: "\u15ac\u00b7",
-	0x0000167D: "\u15ad\u00b7",
-	0x00001680: " ",
-	0x000016B2: "<",
-	0x000016B7: "X",
-	0x000016C1: "l",
-	0x000016C2: "\u16bd",
-	0x000016CC: "'",
-	0x000016D5: "K",
-	0x000016D6: "M",
-	0x000016D8: "\u03a8",
-	0x000016E1: "\u16bc",
-	0x000016EB: "\u00b7",
-	0x000016EC: ":",
-	0x000016ED: "+",
-	0x000016F0: "\u03a6",
-	0x00001734: "\u1715",
-	0x00001735: "/",
-	0x000017A3: "\u17a2",
-	0x000017B7: "\u0e34",
-	0x000017B8: "\u0e35",
-	0x000017B9: "\u0e36",
-	0x000017BA: "\u0e37",
-	0x000017C6: "\u030a",
-	0x000017CB: "\u0e48",
-	0x000017D3: "\u030a",
-	0x000017D4: "\u0e2f",
-	0x000017D5: "\u0e5a",
-	0x000017D9: "\u0e4f",
-	0x000017DA: "\u0e5b",
-	0x00001803: ":",
-	0x00001809: ":",
-	0x00001855: "\u1835",
-	0x00001896: "\u185c",
-	0x000018B3: "\u00b7\u18b1",
-	0x000018B6: "\u00b7\u18b4",
-	0x000018B9: "\u00b7\u18b8",
-	0x000018C2: "\u00b7\u18c0",
-	0x000018C6: "\u00b7\u14c2",
-	0x000018C7: "\u14c2\u00b7",
-	0x000018C8: "\u00b7\u14c3",
-	0x000018C9: "\u14c3\u00b7",
-	0x000018CA: "\u00b7\u14c4",
-	0x000018CB: "\u14c4\u00b7",
-	0x000018CC: "\u00b7\u14c5",
-	0x000018CD: "\u14c5\u00b7",
-	0x000018CE: "\u00b7\u1543",
-	0x000018CF: "\u00b7\u1546",
-	0x000018D0: "\u00b7\u1547",
-	0x000018D1: "\u00b7\u1548",
-	0x000018D2: "\u00b7\u1549",
-	0x000018D3: "\u00b7\u154b",
-	0x000018DB: "\u18f5",
-	0x000018DC: "\u18df\u141e",
-	0x000018DD: "\u141e\u18df",
-	0x000018E0: "\u1543\u00b7",
-	0x000018E3: "\u155e\u00b7",
-	0x000018E4: "\u1566\u00b7",
-	0x000018E5: "\u156b\u00b7",
-	0x000018E8: "\u1586\u00b7",
-	0x000018EA: "\u1597\u00b7",
-	0x000018ED: "\u0460\u00b7",
-	0x000018F0: "\u15f4\u00b7",
-	0x000018F2: "\u161b\u00b7",
-	0x000019D0: "\u199e",
-	0x000019D1: "\u19b1",
-	0x00001A80: "\u1a45",
-	0x00001A90: "\u1a45",
-	0x00001AA9: "\u1aa8\u1aa8",
-	0x00001AAB: "\u1aaa\u1aa8",
-	0x00001AB4: "\u06db",
-	0x00001AB7: "\u0328",
-	0x00001B52: "\u1b0d",
-	0x00001B53: "\u1b11",
-	0x00001B58: "\u1b28",
-	0x00001B5C: "\u1b50",
-	0x00001B5F: "\u1b5e\u1b5e",
-	0x00001C3C: "\u1c3b\u1c3b",
-	0x00001C7F: "\u1c7e\u1c7e",
-	0x00001CD0: "\u0302",
-	0x00001CD2: "\u0304",
-	0x00001CD3: "''",
-	0x00001CD5: "\u032b",
-	0x00001CD8: "\u032e",
-	0x00001CD9: "\u032d",
-	0x00001CDA: "\u030e",
-	0x00001CDC: "\u0329",
-	0x00001CDD: "\u0323",
-	0x00001CDE: "\u0324",
-	0x00001CED: "\u0316",
-	0x00001D04: "c",
-	0x00001D08: "\u025c",
-	0x00001D0B: "\u0138",
-	0x00001D0D: "\u028d",
-	0x00001D0F: "o",
-	0x00001D10: "\u0254",
-	0x00001D11: "o",
-	0x00001D14: "\u01ddo",
-	0x00001D1C: "u",
-	0x00001D20: "v",
-	0x00001D21: "w",
-	0x00001D22: "z",
-	0x00001D24: "\u01a8",
-	0x00001D26: "r",
-	0x00001D27: "\u028c",
-	0x00001D28: "\u03c0",
-	0x00001D29: "\u1d18",
-	0x00001D2B: "\u043b",
-	0x00001D3E: "\u18d6",
-	0x00001D52: "\u00ba",
-	0x00001D6B: "ue",
-	0x00001D6E: "f\u0334",
-	0x00001D6F: "rn\u0334",
-	0x00001D70: "n\u0334",
-	0x00001D72: "r\u0334",
-	0x00001D73: "\u027e\u0334",
-	0x00001D74: "s\u0334",
-	0x00001D75: "t\u0334",
-	0x00001D76: "z\u0334",
-	0x00001D78: "\u1d34",
-	0x00001D7B: "i\u0335",
-	0x00001D7C: "i\u0335",
-	0x00001D7D: "p\u0335",
-	0x00001D7E: "u\u0335",
-	0x00001D7F: "\u028a\u0335",
-	0x00001D83: "g",
-	0x00001D8C: "y",
-	0x00001D90: "\u024b",
-	0x00001D9F: "\u1d4b",
-	0x00001DA2: "\u1d4d",
-	0x00001DBA: "\u18d4",
-	0x00001DBB: "\u1646",
-	0x00001DEE: "\u2dec",
-	0x00001E43: "\uab51",
-	0x00001E9A: "\u1ea3",
-	0x00001E9D: "f",
-	0x00001E9E: "\u00df",
-	0x00001EFF: "y",
-	0x00001F7D: "\u1ff4",
-	0x00001FBD: "'",
-	0x00001FBE: "i",
-	0x00001FBF: "'",
-	0x00001FC0: "~",
-	0x00001FEF: "'",
-	0x00001FF6: "\u13ef",
-	0x00001FFD: "'",
-	0x00001FFE: "'",
-	0x00002000: " ",
-	0x00002001: " ",
-	0x00002002: " ",
-	0x00002003: " ",
-	0x00002004: " ",
-	0x00002005: " ",
-	0x00002006: " ",
-	0x00002007: " ",
-	0x00002008: " ",
-	0x00002009: " ",
-	0x0000200A: " ",
-	0x00002010: "-",
-	0x00002011: "-",
-	0x00002012: "-",
-	0x00002013: "-",
-	0x00002014: "\u30fc",
-	0x00002015: "\u30fc",
-	0x00002016: "ll",
-	0x00002018: "'",
-	0x00002019: "'",
-	0x0000201A: ",",
-	0x0000201B: "'",
-	0x0000201C: "''",
-	0x0000201D: "''",
-	0x0000201F: "''",
-	0x00002022: "\u00b7",
-	0x00002024: ".",
-	0x00002025: "..",
-	0x00002026: "...",
-	0x00002027: "\u00b7",
-	0x00002028: " ",
-	0x00002029: " ",
-	0x0000202F: " ",
-	0x00002030: "\u00ba/\u2080\u2080",
-	0x00002031: "\u00ba/\u2080\u2080\u2080",
-	0x00002032: "'",
-	0x00002033: "''",
-	0x00002034: "'''",
-	0x00002035: "'",
-	0x00002036: "''",
-	0x00002037: "'''",
-	0x00002039: "<",
-	0x0000203A: ">",
-	0x0000203C: "!!",
-	0x0000203E: "\u02c9",
-	0x00002041: "/",
-	0x00002043: "-",
-	0x00002044: "/",
-	0x00002047: "??",
-	0x00002048: "?!",
-	0x00002049: "!?",
-	0x0000204E: "*",
-	0x00002052: "\u00ba/\u2080",
-	0x00002053: "~",
-	0x00002057: "''''",
-	0x0000205A: ":",
-	0x0000205D: "\u2d57",
-	0x0000205E: "\u2d42",
-	0x0000205F: " ",
-	0x00002070: "\u00ba",
-	0x00002079: "\ua770",
-	0x000020A1: "C\u20eb",
-	0x000020A4: "\u00a3",
-	0x000020A5: "rn\u0338",
-	0x000020A8: "Rs",
-	0x000020A9: "W\u0335",
-	0x000020AB: "d\u0335\u0331",
-	0x000020AC: "\ua792",
-	0x000020AD: "K\u0335",
-	0x000020AE: "T\u20eb",
-	0x000020B6: "lt",
-	0x000020BD: "\u0554",
-	0x000020DB: "\u06db",
-	0x00002100: "a/c",
-	0x00002101: "a/s",
-	0x00002102: "C",
-	0x00002103: "\u00b0C",
-	0x00002105: "c/o",
-	0x00002106: "c/u",
-	0x00002107: "\u0190",
-	0x00002108: "\u042d",
-	0x00002109: "\u00b0F",
-	0x0000210A: "g",
-	0x0000210B: "H",
-	0x0000210C: "H",
-	0x0000210D: "H",
-	0x0000210E: "h",
-	0x0000210F: "h\u0335",
-	0x00002110: "l",
-	0x00002111: "l",
-	0x00002112: "L",
-	0x00002113: "l",
-	0x00002115: "N",
-	0x00002116: "No",
-	0x00002119: "P",
-	0x0000211A: "Q",
-	0x0000211B: "R",
-	0x0000211C: "R",
-	0x0000211D: "R",
-	0x00002121: "TEL",
-	0x00002124: "Z",
-	0x00002126: "\u03a9",
-	0x00002127: "\u01b1",
-	0x00002128: "Z",
-	0x00002129: "\u027f",
-	0x0000212A: "K",
-	0x0000212C: "B",
-	0x0000212D: "C",
-	0x0000212E: "e",
-	0x0000212F: "e",
-	0x00002130: "E",
-	0x00002131: "F",
-	0x00002133: "M",
-	0x00002134: "o",
-	0x00002135: "\u05d0",
-	0x00002136: "\u05d1",
-	0x00002137: "\u05d2",
-	0x00002138: "\u05d3",
-	0x00002139: "i",
-	0x0000213B: "FAX",
-	0x0000213C: "\u03c0",
-	0x0000213D: "y",
-	0x0000213E: "\u0393",
-	0x0000213F: "\u03a0",
-	0x00002140: "\u01a9",
-	0x00002141: "\ua4e8",
-	0x00002142: "\ua4f6",
-	0x00002143: "\U00016f00",
-	0x00002145: "D",
-	0x00002146: "d",
-	0x00002147: "e",
-	0x00002148: "i",
-	0x00002149: "j",
-	0x00002160: "l",
-	0x00002161: "ll",
-	0x00002162: "lll",
-	0x00002163: "lV",
-	0x00002164: "V",
-	0x00002165: "Vl",
-	0x00002166: "Vll",
-	0x00002167: "Vlll",
-	0x00002168: "lX",
-	0x00002169: "X",
-	0x0000216A: "Xl",
-	0x0000216B: "Xll",
-	0x0000216C: "L",
-	0x0000216D: "C",
-	0x0000216E: "D",
-	0x0000216F: "M",
-	0x00002170: "i",
-	0x00002171: "ii",
-	0x00002172: "iii",
-	0x00002173: "iv",
-	0x00002174: "v",
-	0x00002175: "vi",
-	0x00002176: "vii",
-	0x00002177: "viii",
-	0x00002178: "ix",
-	0x00002179: "x",
-	0x0000217A: "xi",
-	0x0000217B: "xii",
-	0x0000217C: "l",
-	0x0000217D: "c",
-	0x0000217E: "d",
-	0x0000217F: "rn",
-	0x00002183: "\u0186",
-	0x00002184: "\u0254",
-	0x00002191: "\u16cf",
-	0x00002195: "\u16e8",
-	0x000021B5: "\u21b2",
-	0x000021BA: "\U0001f10e",
-	0x000021BE: "\u16da",
-	0x000021BF: "\u16d0",
-	0x00002200: "\u2c6f",
-	0x00002203: "\u018e",
-	0x00002206: "\u0394",
-	0x0000220F: "\u03a0",
-	0x00002211: "\u01a9",
-	0x00002212: "-",
-	0x00002214: "+\u0307",
-	0x00002215: "/",
-	0x00002216: "\\",
-	0x00002217: "*",
-	0x00002218: "\u00b0",
-	0x00002219: "\u00b7",
-	0x0000221E: "oo",
-	0x00002223: "l",
-	0x00002225: "ll",
-	0x00002228: "v",
-	0x00002229: "\u0548",
-	0x0000222A: "U",
-	0x0000222B: "\u0283",
-	0x0000222C: "\u0283\u0283",
-	0x0000222D: "\u0283\u0283\u0283",
-	0x0000222F: "\u222e\u222e",
-	0x00002230: "\u222e\u222e\u222e",
-	0x00002236: ":",
-	0x00002238: "-\u0307",
-	0x0000223C: "~",
-	0x00002250: "=\u0307",
-	0x00002251: "=\u0307\u0323",
-	0x00002257: "=\u030a",
-	0x00002259: "=\u0302",
-	0x0000225A: "=\u0306",
-	0x0000225E: "=\u036b",
-	0x00002263: "\u2261",
-	0x0000226A: "<<",
-	0x0000226B: ">>",
-	0x00002282: "\u1455",
-	0x00002283: "\u1450",
-	0x00002295: "\U000102a8",
-	0x00002296: "O\u0335",
-	0x00002299: "\u0298",
-	0x0000229D: "O\u0335",
-	0x000022A4: "T",
-	0x000022A5: "\ua4d5",
-	0x000022C0: "\u2227",
-	0x000022C1: "v",
-	0x000022C2: "\u0548",
-	0x000022C3: "U",
-	0x000022C4: "\u16dc",
-	0x000022C5: "\u00b7",
-	0x000022C8: "\u16de",
-	0x000022D6: "<\u00b7",
-	0x000022D7: "\u00b7>",
-	0x000022D8: "<<<",
-	0x000022D9: ">>>",
-	0x000022EE: "\u2d57",
-	0x000022EF: "\u00b7\u00b7\u00b7",
-	0x000022F4: "\ua793",
-	0x000022FF: "E",
-	0x00002300: "\u2205",
-	0x00002325: "\u2324",
-	0x00002329: "\u276c",
-	0x0000232A: "\u276d",
-	0x00002341: "\u303c",
-	0x00002359: "\u0394\u0332",
-	0x0000235A: "\u16dc\u0332",
-	0x0000235C: "\u00b0\u0332",
-	0x0000235F: "\u229b",
-	0x00002361: "T\u0308",
-	0x00002362: "\u2207\u0308",
-	0x00002363: "\u22c6\u0308",
-	0x00002364: "\u00b0\u0308",
-	0x00002365: "\u0629",
-	0x00002368: "~\u0308",
-	0x00002369: "\u1435",
-	0x0000236B: "\u2207\u0334",
-	0x0000236C: "O\u0335",
-	0x00002373: "i",
-	0x00002374: "p",
-	0x00002375: "\u03c9",
-	0x00002376: "a\u0332",
-	0x00002377: "\ua793\u0332",
-	0x00002378: "i\u0332",
-	0x00002379: "\u03c9\u0332",
-	0x0000237A: "a",
-	0x0000237F: "\u16bd",
-	0x0000239C: "\u4e28",
-	0x0000239F: "\u4e28",
-	0x000023A2: "\u4e28",
-	0x000023A5: "\u4e28",
-	0x000023AA: "\u4e28",
-	0x000023AE: "\u4e28",
-	0x000023C1: "\u2355",
-	0x000023C2: "\u234e",
-	0x000023C3: "\u234b",
-	0x000023C6: "\u236d",
-	0x000023E8: "\u2081\u2080",
-	0x000023FC: "\u23fb",
-	0x000023FD: "l",
-	0x000023FE: "\u263e",
-	0x0000244A: "\\\\",
-	0x00002460: "1",
-	0x00002461: "2",
-	0x00002462: "3",
-	0x00002463: "4",
-	0x00002464: "5",
-	0x00002465: "6",
-	0x00002466: "7",
-	0x00002467: "8",
-	0x00002468: "9",
-	0x00002469: "10",
-	0x0000246A: "11",
-	0x0000246B: "12",
-	0x0000246C: "13",
-	0x0000246D: "14",
-	0x0000246E: "15",
-	0x0000246F: "16",
-	0x00002470: "17",
-	0x00002471: "18",
-	0x00002472: "19",
-	0x00002473: "20",
-	0x00002474: "1",
-	0x00002475: "2",
-	0x00002476: "3",
-	0x00002477: "4",
-	0x00002478: "5",
-	0x00002479: "6",
-	0x0000247A: "7",
-	0x0000247B: "8",
-	0x0000247C: "9",
-	0x0000247D: "10",
-	0x0000247E: "11",
-	0x0000247F: "12",
-	0x00002480: "13",
-	0x00002481: "14",
-	0x00002482: "15",
-	0x00002483: "16",
-	0x00002484: "17",
-	0x00002485: "18",
-	0x00002486: "19",
-	0x00002487: "20",
-	0x00002488: "1",
-	0x00002489: "2",
-	0x0000248A: "3",
-	0x0000248B: "4",
-	0x0000248C: "5",
-	0x0000248D: "6",
-	0x0000248E: "7",
-	0x0000248F: "8",
-	0x00002490: "9",
-	0x00002491: "10",
-	0x00002492: "11",
-	0x00002493: "12",
-	0x00002494: "13",
-	0x00002495: "14",
-	0x00002496: "15",
-	0x00002497: "16",
-	0x00002498: "17",
-	0x00002499: "18",
-	0x0000249A: "19",
-	0x0000249B: "20",
-	0x0000249C: "(a)",
-	0x0000249D: "(b)",
-	0x0000249E: "(c)",
-	0x0000249F: "(d)",
-	0x000024A0: "(e)",
-	0x000024A1: "(f)",
-	0x000024A2: "(g)",
-	0x000024A3: "(h)",
-	0x000024A4: "(i)",
-	0x000024A5: "(j)",
-	0x000024A6: "(k)",
-	0x000024A7: "(l)",
-	0x000024A8: "(rn)",
-	0x000024A9: "(n)",
-	0x000024AA: "(o)",
-	0x000024AB: "(p)",
-	0x000024AC: "(q)",
-	0x000024AD: "(r)",
-	0x000024AE: "(s)",
-	0x000024AF: "(t)",
-	0x000024B0: "(u)",
-	0x000024B1: "(v)",
-	0x000024B2: "(w)",
-	0x000024B3: "(x)",
-	0x000024B4: "(y)",
-	0x000024B5: "(z)",
-	0x000024B8: "\u00a9",
-	0x000024C5: "\u2117",
-	0x000024C7: "\u00ae",
-	0x000024DB: "\u24be",
-	0x000024EA: "0",
-	0x000024EB: "11",
-	0x000024EC: "12",
-	0x000024ED: "13",
-	0x000024EE: "14",
-	0x000024EF: "15",
-	0x000024F0: "16",
-	0x000024F1: "17",
-	0x000024F2: "18",
-	0x000024F3: "19",
-	0x000024F4: "20",
-	0x000024F5: "1",
-	0x000024F6: "2",
-	0x000024F7: "3",
-	0x000024F8: "4",
-	0x000024F9: "5",
-	0x000024FA: "6",
-	0x000024FB: "7",
-	0x000024FC: "8",
-	0x000024FD: "9",
-	0x000024FE: "10",
-	0x000024FF: "0",
-	0x00002500: "\u30fc",
-	0x00002501: "\u30fc",
-	0x00002503: "\u2502",
-	0x0000250F: "\u250c",
-	0x00002523: "\u251c",
-	0x00002571: "/",
-	0x00002573: "X",
-	0x00002588: "\u220e",
-	0x00002590: "\u258c",
-	0x00002594: "\u02c9",
-	0x00002597: "\u2596",
-	0x0000259D: "\u2598",
-	0x000025A0: "\u220e",
-	0x000025B1: "\u23e5",
-	0x000025B3: "\u0394",
-	0x000025B7: "\u22b3",
-	0x000025B8: "\u25b6",
-	0x000025BA: "\u25b6",
-	0x000025BD: "\U000102bc",
-	0x000025C1: "\u22b2",
-	0x000025C7: "\u16dc",
-	0x000025CA: "\u16dc",
-	0x000025CB: "\u00b0",
-	0x000025CE: "\u233e",
-	0x000025E0: "\u2312",
-	0x000025E6: "\u00b0",
-	0x00002609: "\u0298",
-	0x00002610: "\u25a1",
-	0x00002625: "\U0001099e",
-	0x00002630: "\u2cb6",
-	0x00002638: "\u2388",
-	0x0000264E: "\u224f",
-	0x00002662: "\u16dc",
-	0x00002669: "\U0001d158\U0001d165",
-	0x0000266A: "\U0001d158\U0001d165\U0001d16e",
-	0x000026AC: "\u0970",
-	0x00002768: "(",
-	0x00002769: ")",
-	0x0000276E: "<",
-	0x0000276F: ">",
-	0x00002772: "(",
-	0x00002773: ")",
-	0x00002774: "{",
-	0x00002775: "}",
-	0x00002776: "1",
-	0x00002777: "2",
-	0x00002778: "3",
-	0x00002779: "4",
-	0x0000277A: "5",
-	0x0000277B: "6",
-	0x0000277C: "7",
-	0x0000277D: "8",
-	0x0000277E: "9",
-	0x0000277F: "10",
-	0x00002780: "1",
-	0x00002781: "2",
-	0x00002782: "3",
-	0x00002783: "4",
-	0x00002784: "5",
-	0x00002785: "6",
-	0x00002786: "7",
-	0x00002787: "8",
-	0x00002788: "9",
-	0x00002789: "10",
-	0x0000278A: "1",
-	0x0000278B: "2",
-	0x0000278C: "3",
-	0x0000278D: "4",
-	0x0000278E: "5",
-	0x0000278F: "6",
-	0x00002790: "7",
-	0x00002791: "8",
-	0x00002792: "9",
-	0x00002793: "10",
-	0x00002795: "+",
-	0x00002796: "-",
-	0x00002797: "\u00f7",
-	0x000027C2: "\ua4d5",
-	0x000027C8: "\\\u1455",
-	0x000027C9: "\u1450/",
-	0x000027CB: "/",
-	0x000027CD: "\\",
-	0x000027D9: "T",
-	0x000027E8: "\u276c",
-	0x000027E9: "\u276d",
-	0x0000292B: "x",
-	0x0000292C: "x",
-	0x00002963: "\u16d0\u16da",
-	0x00002965: "\u21c3\u21c2",
-	0x0000296E: "\u16d0\u21c2",
-	0x0000296F: "\u21c3\u16da",
-	0x00002999: "\u2d42",
-	0x000029B0: "\u2349",
-	0x000029BE: "\u233e",
-	0x000029C4: "\u303c",
-	0x000029C5: "\u2342",
-	0x000029C7: "\u233b",
-	0x000029D6: "\U000102c0",
-	0x000029D9: "\u299a",
-	0x000029F4: ":\u2192",
-	0x000029F5: "\\",
-	0x000029F6: "/\u0304",
-	0x000029F8: "/",
-	0x000029F9: "\\",
-	0x00002A00: "\u0298",
-	0x00002A01: "\U000102a8",
-	0x00002A02: "\u2297",
-	0x00002A03: "\u228d",
-	0x00002A04: "\u228e",
-	0x00002A05: "\u2293",
-	0x00002A06: "\u2294",
-	0x00002A0C: "\u0283\u0283\u0283\u0283",
-	0x00002A1D: "\u16de",
-	0x00002A20: ">>",
-	0x00002A21: "\u16da",
-	0x00002A22: "+\u030a",
-	0x00002A23: "+\u0302",
-	0x00002A24: "+\u0303",
-	0x00002A25: "+\u0323",
-	0x00002A26: "+\u0330",
-	0x00002A27: "+\u2082",
-	0x00002A29: "-\u0313",
-	0x00002A2A: "-\u0323",
-	0x00002A2F: "x",
-	0x00002A30: "x\u0307",
-	0x00002A3D: "\u2319",
-	0x00002A3E: "\u2a1f",
-	0x00002A3F: "\u2210",
-	0x00002A6A: "~\u0307",
-	0x00002A6E: "=\u20f0",
-	0x00002A74: "::=",
-	0x00002A75: "==",
-	0x00002A76: "===",
-	0x00002AA5: "><",
-	0x00002AAA: "\u15d5",
-	0x00002AAB: "\u15d2",
-	0x00002AD7: "\u1450\u1455",
-	0x00002AFB: "///",
-	0x00002AFD: "//",
-	0x00002BEC: "\u219e",
-	0x00002BED: "\u219f",
-	0x00002BEE: "\u21a0",
-	0x00002BEF: "\u21a1",
-	0x00002C67: "H\u0329",
-	0x00002C69: "K\u0329",
-	0x00002C84: "\u0393",
-	0x00002C85: "r",
-	0x00002C86: "\u0394",
-	0x00002C88: "\ua792",
-	0x00002C89: "\ua793",
-	0x00002C8E: "H",
-	0x00002C92: "l",
-	0x00002C94: "K",
-	0x00002C95: "\u0138",
-	0x00002C96: "\u03bb",
-	0x00002C98: "M",
-	0x00002C9A: "N",
-	0x00002C9E: "O",
-	0x00002C9F: "o",
-	0x00002CA0: "\u03a0",
-	0x00002CA2: "P",
-	0x00002CA3: "p",
-	0x00002CA4: "C",
-	0x00002CA5: "c",
-	0x00002CA6: "T",
-	0x00002CA8: "Y",
-	0x00002CAA: "\u03a6",
-	0x00002CAB: "\u0278",
-	0x00002CAC: "X",
-	0x00002CAD: "\u03c7",
-	0x00002CAE: "\u03a8",
-	0x00002CB1: "\u03c9",
-	0x00002CB4: "<\u00b7",
-	0x00002CBA: "-",
-	0x00002CBC: "\u0428",
-	0x00002CBD: "\u0448",
-	0x00002CC6: "/",
-	0x00002CCA: "9",
-	0x00002CCC: "3",
-	0x00002CCD: "\u021d",
-	0x00002CD0: "L",
-	0x00002CD1: "\u029f",
-	0x00002CD2: "6",
-	0x00002CDC: "\u03ec",
-	0x00002CE4: "\u03d7",
-	0x00002CE9: "\u2627",
-	0x00002CF9: "\\\\",
-	0x00002D31: "O\u0335",
-	0x00002D37: "\u0245",
-	0x00002D38: "V",
-	0x00002D39: "E",
-	0x00002D3A: "\u018e",
-	0x00002D41: "O\u0338",
-	0x00002D48: "\u00b7\u00b7\u00b7",
-	0x00002D49: "\u01a9",
-	0x00002D4F: "l",
-	0x00002D51: "!",
-	0x00002D54: "O",
-	0x00002D55: "Q",
-	0x00002D59: "\u0298",
-	0x00002D5D: "X",
-	0x00002D60: "\u0394",
-	0x00002D63: "\u16ef",
-	0x00002DE8: "\u1ddf",
-	0x00002DEA: "\u030a",
-	0x00002DED: "\u0368",
-	0x00002DEF: "\u036f",
-	0x00002DF6: "\u0363",
-	0x00002DF7: "\u0364",
-	0x00002E1A: "-\u0308",
-	0x00002E1E: "~\u0307",
-	0x00002E1F: "~\u0323",
-	0x00002E26: "\u1455",
-	0x00002E27: "\u1450",
-	0x00002E28: "((",
-	0x00002E29: "))",
-	0x00002E2A: "\u2235",
-	0x00002E2B: "\u2234",
-	0x00002E2C: "\u2237",
-	0x00002E2E: "\u061f",
-	0x00002E30: "\u00b0",
-	0x00002E31: "\u00b7",
-	0x00002E32: "\u060c",
-	0x00002E35: "\u061b",
-	0x00002E39: "\u1e9f",
-	0x00002E3D: "\u2d42",
-	0x00002E3F: "\u00b6",
-	0x00002E40: "=",
-	0x00002E82: "\u4e5b",
-	0x00002E83: "\u4e5a",
-	0x00002E85: "\u4ebb",
-	0x00002E89: "\u5202",
-	0x00002E8B: "\u353e",
-	0x00002E8E: "\u5140",
-	0x00002E8F: "\u5c23",
-	0x00002E90: "\u5c22",
-	0x00002E92: "\u5df3",
-	0x00002E93: "\u5e7a",
-	0x00002E94: "\u5f51",
-	0x00002E96: "\u5fc4",
-	0x00002E97: "\u38fa",
-	0x00002E98: "\u624c",
-	0x00002E99: "\u6535",
-	0x00002E9B: "\u65e1",
-	0x00002E9E: "\u6b7a",
-	0x00002E9F: "\u6bcd",
-	0x00002EA0: "\u6c11",
-	0x00002EA1: "\u6c35",
-	0x00002EA2: "\u6c3a",
-	0x00002EA3: "\u706c",
-	0x00002EA4: "\u722b",
-	0x00002EA6: "\u4e2c",
-	0x00002EA8: "\u72ad",
-	0x00002EAB: "\u7f52",
-	0x00002EAD: "\u793b",
-	0x00002EAF: "\u7cf9",
-	0x00002EB1: "\u7f53",
-	0x00002EB2: "\u7f52",
-	0x00002EB9: "\u8002",
-	0x00002EBA: "\u8080",
-	0x00002EBE: "\u8279",
-	0x00002EBF: "\u8279",
-	0x00002EC0: "\u8279",
-	0x00002EC1: "\u864e",
-	0x00002EC2: "\u8864",
-	0x00002EC3: "\u8980",
-	0x00002EC4: "\u897f",
-	0x00002EC5: "\u89c1",
-	0x00002EC8: "\u8ba0",
-	0x00002EC9: "\u8d1d",
-	0x00002ECB: "\u8f66",
-	0x00002ECC: "\u8fb6",
-	0x00002ECD: "\u8fb6",
-	0x00002ECF: "\u961d",
-	0x00002ED0: "\u9485",
-	0x00002ED1: "\u9577",
-	0x00002ED2: "\u9578",
-	0x00002ED3: "\u957f",
-	0x00002ED4: "\u95e8",
-	0x00002ED6: "\u961d",
-	0x00002ED8: "\u9752",
-	0x00002ED9: "\u97e6",
-	0x00002EDA: "\u9875",
-	0x00002EDB: "\u98ce",
-	0x00002EDC: "\u98de",
-	0x00002EDD: "\u98df",
-	0x00002EDF: "\u98e0",
-	0x00002EE0: "\u9963",
-	0x00002EE2: "\u9a6c",
-	0x00002EE4: "\u9b3c",
-	0x00002EE5: "\u9c7c",
-	0x00002EE8: "\u9ea6",
-	0x00002EE9: "\u9ec4",
-	0x00002EEB: "\u6589",
-	0x00002EEC: "\u9f50",
-	0x00002EED: "\u6b6f",
-	0x00002EEE: "\u9f7f",
-	0x00002EEF: "\u7adc",
-	0x00002EF0: "\u9f99",
-	0x00002EF2: "\u4e80",
-	0x00002EF3: "\u9f9f",
-	0x00002F00: "\u30fc",
-	0x00002F01: "\u4e28",
-	0x00002F02: "\\",
-	0x00002F03: "/",
-	0x00002F04: "\u4e59",
-	0x00002F05: "\u4e85",
-	0x00002F06: "\u4e8c",
-	0x00002F07: "\u4ea0",
-	0x00002F08: "\u4eba",
-	0x00002F09: "\u513f",
-	0x00002F0A: "\u5165",
-	0x00002F0B: "\u516b",
-	0x00002F0C: "\u5182",
-	0x00002F0D: "\u5196",
-	0x00002F0E: "\u51ab",
-	0x00002F0F: "\u51e0",
-	0x00002F10: "\u51f5",
-	0x00002F11: "\u5200",
-	0x00002F12: "\u529b",
-	0x00002F13: "\u52f9",
-	0x00002F14: "\u5315",
-	0x00002F15: "\u531a",
-	0x00002F16: "\u5338",
-	0x00002F17: "\u5341",
-	0x00002F18: "\u535c",
-	0x00002F19: "\u5369",
-	0x00002F1A: "\u5382",
-	0x00002F1B: "\u53b6",
-	0x00002F1C: "\u53c8",
-	0x00002F1D: "\u53e3",
-	0x00002F1E: "\u53e3",
-	0x00002F1F: "\u571f",
-	0x00002F20: "\u571f",
-	0x00002F21: "\u5902",
-	0x00002F22: "\u590a",
-	0x00002F23: "\u5915",
-	0x00002F24: "\u5927",
-	0x00002F25: "\u5973",
-	0x00002F26: "\u5b50",
-	0x00002F27: "\u5b80",
-	0x00002F28: "\u5bf8",
-	0x00002F29: "\u5c0f",
-	0x00002F2A: "\u5c22",
-	0x00002F2B: "\u5c38",
-	0x00002F2C: "\u5c6e",
-	0x00002F2D: "\u5c71",
-	0x00002F2E: "\u5ddb",
-	0x00002F2F: "\u5de5",
-	0x00002F30: "\u5df1",
-	0x00002F31: "\u5dfe",
-	0x00002F32: "\u5e72",
-	0x00002F33: "\u5e7a",
-	0x00002F34: "\u5e7f",
-	0x00002F35: "\u5ef4",
-	0x00002F36: "\u5efe",
-	0x00002F37: "\u5f0b",
-	0x00002F38: "\u5f13",
-	0x00002F39: "\u5f50",
-	0x00002F3A: "\u5f61",
-	0x00002F3B: "\u5f73",
-	0x00002F3C: "\u5fc3",
-	0x00002F3D: "\u6208",
-	0x00002F3E: "\u6236",
-	0x00002F3F: "\u624b",
-	0x00002F40: "\u652f",
-	0x00002F41: "\u6534",
-	0x00002F42: "\u6587",
-	0x00002F43: "\u6597",
-	0x00002F44: "\u65a4",
-	0x00002F45: "\u65b9",
-	0x00002F46: "\u65e0",
-	0x00002F47: "\u65e5",
-	0x00002F48: "\u66f0",
-	0x00002F49: "\u6708",
-	0x00002F4A: "\u6728",
-	0x00002F4B: "\u6b20",
-	0x00002F4C: "\u6b62",
-	0x00002F4D: "\u6b79",
-	0x00002F4E: "\u6bb3",
-	0x00002F4F: "\u6bcb",
-	0x00002F50: "\u6bd4",
-	0x00002F51: "\u6bdb",
-	0x00002F52: "\u6c0f",
-	0x00002F53: "\u6c14",
-	0x00002F54: "\u6c34",
-	0x00002F55: "\u706b",
-	0x00002F56: "\u722a",
-	0x00002F57: "\u7236",
-	0x00002F58: "\u723b",
-	0x00002F59: "\u723f",
-	0x00002F5A: "\u7247",
-	0x00002F5B: "\u7259",
-	0x00002F5C: "\u725b",
-	0x00002F5D: "\u72ac",
-	0x00002F5E: "\u7384",
-	0x00002F5F: "\u7389",
-	0x00002F60: "\u74dc",
-	0x00002F61: "\u74e6",
-	0x00002F62: "\u7518",
-	0x00002F63: "\u751f",
-	0x00002F64: "\u7528",
-	0x00002F65: "\u7530",
-	0x00002F66: "\u758b",
-	0x00002F67: "\u7592",
-	0x00002F68: "\u7676",
-	0x00002F69: "\u767d",
-	0x00002F6A: "\u76ae",
-	0x00002F6B: "\u76bf",
-	0x00002F6C: "\u76ee",
-	0x00002F6D: "\u77db",
-	0x00002F6E: "\u77e2",
-	0x00002F6F: "\u77f3",
-	0x00002F70: "\u793a",
-	0x00002F71: "\u79b8",
-	0x00002F72: "\u79be",
-	0x00002F73: "\u7a74",
-	0x00002F74: "\u7acb",
-	0x00002F75: "\u7af9",
-	0x00002F76: "\u7c73",
-	0x00002F77: "\u7cf8",
-	0x00002F78: "\u7f36",
-	0x00002F79: "\u7f51",
-	0x00002F7A: "\u7f8a",
-	0x00002F7B: "\u7fbd",
-	0x00002F7C: "\u8001",
-	0x00002F7D: "\u800c",
-	0x00002F7E: "\u8012",
-	0x00002F7F: "\u8033",
-	0x00002F80: "\u807f",
-	0x00002F81: "\u8089",
-	0x00002F82: "\u81e3",
-	0x00002F83: "\u81ea",
-	0x00002F84: "\u81f3",
-	0x00002F85: "\u81fc",
-	0x00002F86: "\u820c",
-	0x00002F87: "\u821b",
-	0x00002F88: "\u821f",
-	0x00002F89: "\u826e",
-	0x00002F8A: "\u8272",
-	0x00002F8B: "\u8278",
-	0x00002F8C: "\u864d",
-	0x00002F8D: "\u866b",
-	0x00002F8E: "\u8840",
-	0x00002F8F: "\u884c",
-	0x00002F90: "\u8863",
-	0x00002F91: "\u897e",
-	0x00002F92: "\u898b",
-	0x00002F93: "\u89d2",
-	0x00002F94: "\u8a00",
-	0x00002F95: "\u8c37",
-	0x00002F96: "\u8c46",
-	0x00002F97: "\u8c55",
-	0x00002F98: "\u8c78",
-	0x00002F99: "\u8c9d",
-	0x00002F9A: "\u8d64",
-	0x00002F9B: "\u8d70",
-	0x00002F9C: "\u8db3",
-	0x00002F9D: "\u8eab",
-	0x00002F9E: "\u8eca",
-	0x00002F9F: "\u8f9b",
-	0x00002FA0: "\u8fb0",
-	0x00002FA1: "\u8fb5",
-	0x00002FA2: "\u9091",
-	0x00002FA3: "\u9149",
-	0x00002FA4: "\u91c6",
-	0x00002FA5: "\u91cc",
-	0x00002FA6: "\u91d1",
-	0x00002FA7: "\u9577",
-	0x00002FA8: "\u9580",
-	0x00002FA9: "\u961c",
-	0x00002FAA: "\u96b6",
-	0x00002FAB: "\u96b9",
-	0x00002FAC: "\u96e8",
-	0x00002FAD: "\u9751",
-	0x00002FAE: "\u975e",
-	0x00002FAF: "\u9762",
-	0x00002FB0: "\u9769",
-	0x00002FB1: "\u97cb",
-	0x00002FB2: "\u97ed",
-	0x00002FB3: "\u97f3",
-	0x00002FB4: "\u9801",
-	0x00002FB5: "\u98a8",
-	0x00002FB6: "\u98db",
-	0x00002FB7: "\u98df",
-	0x00002FB8: "\u9996",
-	0x00002FB9: "\u9999",
-	0x00002FBA: "\u99ac",
-	0x00002FBB: "\u9aa8",
-	0x00002FBC: "\u9ad8",
-	0x00002FBD: "\u9adf",
-	0x00002FBE: "\u9b25",
-	0x00002FBF: "\u9b2f",
-	0x00002FC0: "\u9b32",
-	0x00002FC1: "\u9b3c",
-	0x00002FC2: "\u9b5a",
-	0x00002FC3: "\u9ce5",
-	0x00002FC4: "\u9e75",
-	0x00002FC5: "\u9e7f",
-	0x00002FC6: "\u9ea5",
-	0x00002FC7: "\u9ebb",
-	0x00002FC8: "\u9ec3",
-	0x00002FC9: "\u9ecd",
-	0x00002FCA: "\u9ed1",
-	0x00002FCB: "\u9ef9",
-	0x00002FCC: "\u9efd",
-	0x00002FCD: "\u9f0e",
-	0x00002FCE: "\u9f13",
-	0x00002FCF: "\u9f20",
-	0x00002FD0: "\u9f3b",
-	0x00002FD1: "\u9f4a",
-	0x00002FD2: "\u9f52",
-	0x00002FD3: "\u9f8d",
-	0x00002FD4: "\u9f9c",
-	0x00002FD5: "\u9fa0",
-	0x00003002: "\u02f3",
-	0x00003003: "''",
-	0x00003007: "O",
-	0x00003008: "\u276c",
-	0x00003009: "\u276d",
-	0x00003012: "\u20b8",
-	0x00003014: "(",
-	0x00003015: ")",
-	0x0000301A: "\u27e6",
-	0x0000301B: "\u27e7",
-	0x0000302C: "\u0309",
-	0x0000302D: "\u0325",
-	0x00003033: "/",
-	0x00003036: "\u20b8",
-	0x00003038: "\u5341",
-	0x00003039: "\u5344",
-	0x0000303A: "\u5345",
-	0x0000304F: "\u276c",
-	0x0000309A: "\u030a",
-	0x0000309B: "\uff9e",
-	0x0000309C: "\uff9f",
-	0x000030A0: "=",
-	0x000030A4: "\u4ebb",
-	0x000030A8: "\u5de5",
-	0x000030AB: "\u529b",
-	0x000030BF: "\u5915",
-	0x000030C8: "\u535c",
-	0x000030CB: "\u4e8c",
-	0x000030CE: "/",
-	0x000030CF: "\u516b",
-	0x000030D8: "\u3078",
-	0x000030ED: "\u53e3",
-	0x000030FB: "\u00b7",
-	0x00003126: "\u513f",
-	0x00003131: "\u1100",
-	0x00003132: "\u1100\u1100",
-	0x00003133: "\u1100\u1109",
-	0x00003134: "\u1102",
-	0x00003135: "\u1102\u110c",
-	0x00003136: "\u1102\u1112",
-	0x00003137: "\u1103",
-	0x00003138: "\u1103\u1103",
-	0x00003139: "\u1105",
-	0x0000313A: "\u1105\u1100",
-	0x0000313B: "\u1105\u1106",
-	0x0000313C: "\u1105\u1107",
-	0x0000313D: "\u1105\u1109",
-	0x0000313E: "\u1105\u1110",
-	0x0000313F: "\u1105\u1111",
-	0x00003140: "\u1105\u1112",
-	0x00003141: "\u1106",
-	0x00003142: "\u1107",
-	0x00003143: "\u1107\u1107",
-	0x00003144: "\u1107\u1109",
-	0x00003145: "\u1109",
-	0x00003146: "\u1109\u1109",
-	0x00003147: "\u110b",
-	0x00003148: "\u110c",
-	0x00003149: "\u110c\u110c",
-	0x0000314A: "\u110e",
-	0x0000314B: "\u110f",
-	0x0000314C: "\u1110",
-	0x0000314D: "\u1111",
-	0x0000314E: "\u1112",
-	0x0000314F: "\u1161",
-	0x00003150: "\u1161\u4e28",
-	0x00003151: "\u1163",
-	0x00003152: "\u1163\u4e28",
-	0x00003153: "\u1165",
-	0x00003154: "\u1165\u4e28",
-	0x00003155: "\u1167",
-	0x00003156: "\u1167\u4e28",
-	0x00003157: "\u1169",
-	0x00003158: "\u1169\u1161",
-	0x00003159: "\u1169\u1161\u4e28",
-	0x0000315A: "\u1169\u4e28",
-	0x0000315B: "\u116d",
-	0x0000315C: "\u116e",
-	0x0000315D: "\u116e\u1165",
-	0x0000315E: "\u116e\u1165\u4e28",
-	0x0000315F: "\u116e\u4e28",
-	0x00003160: "\u1172",
-	0x00003161: "\u30fc",
-	0x00003162: "\u30fc\u4e28",
-	0x00003163: "\u4e28",
-	0x00003164: "\u1160",
-	0x00003165: "\u1102\u1102",
-	0x00003166: "\u1102\u1103",
-	0x00003167: "\u1102\u1109",
-	0x00003168: "\u1102\u1140",
-	0x00003169: "\u1105\u1100\u1109",
-	0x0000316A: "\u1105\u1103",
-	0x0000316B: "\u1105\u1107\u1109",
-	0x0000316C: "\u1105\u1140",
-	0x0000316D: "\u1105\u1159",
-	0x0000316E: "\u1106\u1107",
-	0x0000316F: "\u1106\u1109",
-	0x00003170: "\u1106\u1140",
-	0x00003171: "\u1106\u110b",
-	0x00003172: "\u1107\u1100",
-	0x00003173: "\u1107\u1103",
-	0x00003174: "\u1107\u1109\u1100",
-	0x00003175: "\u1107\u1109\u1103",
-	0x00003176: "\u1107\u110c",
-	0x00003177: "\u1107\u1110",
-	0x00003178: "\u1107\u110b",
-	0x00003179: "\u1107\u1107\u110b",
-	0x0000317A: "\u1109\u1100",
-	0x0000317B: "\u1109\u1102",
-	0x0000317C: "\u1109\u1103",
-	0x0000317D: "\u1109\u1107",
-	0x0000317E: "\u1109\u110c",
-	0x0000317F: "\u1140",
-	0x00003180: "\u110b\u110b",
-	0x00003181: "\u114c",
-	0x00003182: "\u110b\u1109",
-	0x00003183: "\u110b\u1140",
-	0x00003184: "\u1111\u110b",
-	0x00003185: "\u1112\u1112",
-	0x00003186: "\u1159",
-	0x00003187: "\u116d\u1163",
-	0x00003188: "\u116d\u1163\u4e28",
-	0x00003189: "\u116d\u4e28",
-	0x0000318A: "\u1172\u1167",
-	0x0000318B: "\u1172\u1167\u4e28",
-	0x0000318C: "\u1172\u4e28",
-	0x0000318D: "\u119e",
-	0x0000318E: "\u119e\u4e28",
-	0x000031D0: "\u30fc",
-	0x000031D1: "\u4e28",
-	0x000031D3: "/",
-	0x000031D4: "\\",
-	0x000031D6: "\u4e5b",
-	0x000031DA: "\u4e85",
-	0x000031DB: "\u276c",
-	0x000031DF: "\u4e5a",
-	0x000031E0: "\u4e59",
-	0x00003200: "(\u1100)",
-	0x00003201: "(\u1102)",
-	0x00003202: "(\u1103)",
-	0x00003203: "(\u1105)",
-	0x00003204: "(\u1106)",
-	0x00003205: "(\u1107)",
-	0x00003206: "(\u1109)",
-	0x00003207: "(\u110b)",
-	0x00003208: "(\u110c)",
-	0x00003209: "(\u110e)",
-	0x0000320A: "(\u110f)",
-	0x0000320B: "(\u1110)",
-	0x0000320C: "(\u1111)",
-	0x0000320D: "(\u1112)",
-	0x0000320E: "(\uac00)",
-	0x0000320F: "(\ub098)",
-	0x00003210: "(\ub2e4)",
-	0x00003211: "(\ub77c)",
-	0x00003212: "(\ub9c8)",
-	0x00003213: "(\ubc14)",
-	0x00003214: "(\uc0ac)",
-	0x00003215: "(\uc544)",
-	0x00003216: "(\uc790)",
-	0x00003217: "(\ucc28)",
-	0x00003218: "(\uce74)",
-	0x00003219: "(\ud0c0)",
-	0x0000321A: "(\ud30c)",
-	0x0000321B: "(\ud558)",
-	0x0000321C: "(\uc8fc)",
-	0x0000321D: "(\uc624\uc804)",
-	0x0000321E: "(\uc624\ud6c4)",
-	0x00003220: "(\u30fc)",
-	0x00003221: "(\u4e8c)",
-	0x00003222: "(\u4e09)",
-	0x00003223: "(\u56db)",
-	0x00003224: "(\u4e94)",
-	0x00003225: "(\u516d)",
-	0x00003226: "(\u4e03)",
-	0x00003227: "(\u516b)",
-	0x00003228: "(\u4e5d)",
-	0x00003229: "(\u5341)",
-	0x0000322A: "(\u6708)",
-	0x0000322B: "(\u706b)",
-	0x0000322C: "(\u6c34)",
-	0x0000322D: "(\u6728)",
-	0x0000322E: "(\u91d1)",
-	0x0000322F: "(\u571f)",
-	0x00003230: "(\u65e5)",
-	0x00003231: "(\u682a)",
-	0x00003232: "(\u6709)",
-	0x00003233: "(\u793e)",
-	0x00003234: "(\u540d)",
-	0x00003235: "(\u7279)",
-	0x00003236: "(\u8ca1)",
-	0x00003237: "(\u795d)",
-	0x00003238: "(\u52b4)",
-	0x00003239: "(\u4ee3)",
-	0x0000323A: "(\u547c)",
-	0x0000323B: "(\u5b66)",
-	0x0000323C: "(\u76e3)",
-	0x0000323D: "(\u4f01)",
-	0x0000323E: "(\u8cc7)",
-	0x0000323F: "(\u5354)",
-	0x00003240: "(\u796d)",
-	0x00003241: "(\u4f11)",
-	0x00003242: "(\u81ea)",
-	0x00003243: "(\u81f3)",
-	0x000032C0: "l\u6708",
-	0x000032C1: "2\u6708",
-	0x000032C2: "3\u6708",
-	0x000032C3: "4\u6708",
-	0x000032C4: "5\u6708",
-	0x000032C5: "6\u6708",
-	0x000032C6: "7\u6708",
-	0x000032C7: "8\u6708",
-	0x000032C8: "9\u6708",
-	0x000032C9: "lO\u6708",
-	0x000032CA: "ll\u6708",
-	0x000032CB: "l2\u6708",
-	0x00003358: "O\u70b9",
-	0x00003359: "l\u70b9",
-	0x0000335A: "2\u70b9",
-	0x0000335B: "3\u70b9",
-	0x0000335C: "4\u70b9",
-	0x0000335D: "5\u70b9",
-	0x0000335E: "6\u70b9",
-	0x0000335F: "7\u70b9",
-	0x00003360: "8\u70b9",
-	0x00003361: "9\u70b9",
-	0x00003362: "lO\u70b9",
-	0x00003363: "ll\u70b9",
-	0x00003364: "l2\u70b9",
-	0x00003365: "l3\u70b9",
-	0x00003366: "l4\u70b9",
-	0x00003367: "l5\u70b9",
-	0x00003368: "l6\u70b9",
-	0x00003369: "l7\u70b9",
-	0x0000336A: "l8\u70b9",
-	0x0000336B: "l9\u70b9",
-	0x0000336C: "2O\u70b9",
-	0x0000336D: "2l\u70b9",
-	0x0000336E: "22\u70b9",
-	0x0000336F: "23\u70b9",
-	0x00003370: "24\u70b9",
-	0x000033E0: "l\u65e5",
-	0x000033E1: "2\u65e5",
-	0x000033E2: "3\u65e5",
-	0x000033E3: "4\u65e5",
-	0x000033E4: "5\u65e5",
-	0x000033E5: "6\u65e5",
-	0x000033E6: "7\u65e5",
-	0x000033E7: "8\u65e5",
-	0x000033E8: "9\u65e5",
-	0x000033E9: "lO\u65e5",
-	0x000033EA: "ll\u65e5",
-	0x000033EB: "l2\u65e5",
-	0x000033EC: "l3\u65e5",
-	0x000033ED: "l4\u65e5",
-	0x000033EE: "l5\u65e5",
-	0x000033EF: "l6\u65e5",
-	0x000033F0: "l7\u65e5",
-	0x000033F1: "l8\u65e5",
-	0x000033F2: "l9\u65e5",
-	0x000033F3: "2O\u65e5",
-	0x000033F4: "2l\u65e5",
-	0x000033F5: "22\u65e5",
-	0x000033F6: "23\u65e5",
-	0x000033F7: "24\u65e5",
-	0x000033F8: "25\u65e5",
-	0x000033F9: "26\u65e5",
-	0x000033FA: "27\u65e5",
-	0x000033FB: "28\u65e5",
-	0x000033FC: "29\u65e5",
-	0x000033FD: "3O\u65e5",
-	0x000033FE: "3l\u65e5",
-	0x000039B3: "\u363d",
-	0x0000439B: "\u3588",
-	0x00004420: "\u3b3b",
-	0x00004E00: "\u30fc",
-	0x00004E36: "\\",
-	0x00004E3F: "/",
-	0x00005002: "\u4f75",
-	0x0000503C: "\u5024",
-	0x0000555F: "\u5553",
-	0x000056D7: "\u53e3",
-	0x0000586B: "\u5861",
-	0x000058EB: "\u571f",
-	0x000058FF: "\u58ab",
-	0x00005B00: "\u5aaf",
-	0x00005E32: "\u5e21",
-	0x00005E50: "\u3b3a",
-	0x00006238: "\u6236",
-	0x00006409: "\u3a41",
-	0x00006663: "\u403f",
-	0x00006669: "\u665a",
-	0x000066F6: "\u3ada",
-	0x00006726: "\u4443",
-	0x000067FF: "\u676e",
-	0x000069E9: "\u3ba3",
-	0x00006A27: "\u699d",
-	0x00006F59: "\u6e88",
-	0x0000784F: "\u7814",
-	0x00007D76: "\u7d55",
-	0x000080A6: "\u670c",
-	0x000080CA: "\u6710",
-	0x000080D0: "\u670f",
-	0x000080F6: "\u3b35",
-	0x00008101: "\u6713",
-	0x00008127: "\u6718",
-	0x00008141: "\u80fc",
-	0x000081A7: "\u6723",
-	0x0000853F: "\u848d",
-	0x00008641: "\u8637",
-	0x00008A1E: "\u46b6",
-	0x00008A7D: "\u8a2e",
-	0x00008B8F: "\u8b86",
-	0x00008C63: "\u8c5c",
-	0x00008D86: "\u8d7f",
-	0x00008DFA: "\u8de5",
-	0x00008E9B: "\u8e97",
-	0x00008F27: "\u8eff",
-	0x000090DE: "\u90ce",
-	0x000093AE: "\u93ad",
-	0x000096B8: "\u96b7",
-	0x00009E43: "\u9e42",
-	0x00009ED2: "\u9ed1",
-	0x00009FC3: "\u4039",
-	0x0000A494: "\ua2cd",
-	0x0000A49C: "\ua0c0",
-	0x0000A49E: "\ua04a",
-	0x0000A4A7: "\ua458",
-	0x0000A4A8: "\ua132",
-	0x0000A4AC: "\ua050",
-	0x0000A4B0: "\ua3c2",
-	0x0000A4BA: "\ua3bf",
-	0x0000A4BE: "\ua2b1",
-	0x0000A4BF: "\ua259",
-	0x0000A4C0: "\ua3ab",
-	0x0000A4C2: "\ua3b5",
-	0x0000A4D0: "B",
-	0x0000A4D1: "P",
-	0x0000A4D2: "d",
-	0x0000A4D3: "D",
-	0x0000A4D4: "T",
-	0x0000A4D6: "G",
-	0x0000A4D7: "K",
-	0x0000A4D9: "J",
-	0x0000A4DA: "C",
-	0x0000A4DB: "\u0186",
-	0x0000A4DC: "Z",
-	0x0000A4DD: "F",
-	0x0000A4DE: "\u2132",
-	0x0000A4DF: "M",
-	0x0000A4E0: "N",
-	0x0000A4E1: "L",
-	0x0000A4E2: "S",
-	0x0000A4E3: "R",
-	0x0000A4E5: "\u0245",
-	0x0000A4E6: "V",
-	0x0000A4E7: "H",
-	0x0000A4EA: "W",
-	0x0000A4EB: "X",
-	0x0000A4EC: "Y",
-	0x0000A4ED: "\u1660",
-	0x0000A4EE: "A",
-	0x0000A4EF: "\u2c6f",
-	0x0000A4F0: "E",
-	0x0000A4F1: "\u018e",
-	0x0000A4F2: "l",
-	0x0000A4F3: "O",
-	0x0000A4F4: "U",
-	0x0000A4F5: "\u0548",
-	0x0000A4F7: "\u15e1",
-	0x0000A4F8: ".",
-	0x0000A4F9: ",",
-	0x0000A4FA: "..",
-	0x0000A4FB: ".,",
-	0x0000A4FD: ":",
-	0x0000A4FE: "-.",
-	0x0000A4FF: "=",
-	0x0000A60E: ".",
-	0x0000A644: "2",
-	0x0000A645: "\u01a8",
-	0x0000A647: "i",
-	0x0000A64D: "\u03c9",
-	0x0000A650: "\u042al",
-	0x0000A651: "\u02c9bi",
-	0x0000A668: "\u0298",
-	0x0000A66F: "\u20e9",
-	0x0000A67C: "\u0306",
-	0x0000A67E: "\u02c7",
-	0x0000A695: "h\u0314",
-	0x0000A698: "OO",
-	0x0000A699: "oo",
-	0x0000A69A: "\U000102a8",
-	0x0000A6A1: "\u0418",
-	0x0000A6B0: "\u16b9",
-	0x0000A6B1: "\u2c75",
-	0x0000A6CD: "\u02a1",
-	0x0000A6CE: "\u0245",
-	0x0000A6DB: "\u03a0",
-	0x0000A6DF: "V",
-	0x0000A6EB: "?",
-	0x0000A6EF: "2",
-	0x0000A6F0: "\u0302",
-	0x0000A6F1: "\u0304",
-	0x0000A6F4: "\ua6f3\ua6f3",
-	0x0000A714: "\u02eb",
-	0x0000A716: "\u02ea",
-	0x0000A728: "T3",
-	0x0000A729: "t\u021d",
-	0x0000A731: "s",
-	0x0000A732: "AA",
-	0x0000A733: "aa",
-	0x0000A734: "AO",
-	0x0000A735: "ao",
-	0x0000A736: "AU",
-	0x0000A737: "au",
-	0x0000A738: "AV",
-	0x0000A739: "av",
-	0x0000A73A: "AV",
-	0x0000A73B: "av",
-	0x0000A73C: "AY",
-	0x0000A73D: "ay",
-	0x0000A740: "K\u0335",
-	0x0000A74A: "O\u0335",
-	0x0000A74B: "o\u0335",
-	0x0000A74E: "OO",
-	0x0000A74F: "oo",
-	0x0000A75A: "2",
-	0x0000A761: "w\u0326",
-	0x0000A76A: "3",
-	0x0000A76B: "\u021d",
-	0x0000A76E: "9",
-	0x0000A777: "tf",
-	0x0000A778: "&",
-	0x0000A77A: "\ua779",
-	0x0000A789: ":",
-	0x0000A78C: "'",
-	0x0000A78F: "\u00b7",
-	0x0000A795: "\ua727",
-	0x0000A798: "F",
-	0x0000A799: "f",
-	0x0000A79A: "\U00010412",
-	0x0000A79B: "\U0001043a",
-	0x0000A79D: "\u029a",
-	0x0000A79E: "\ua4e4",
-	0x0000A79F: "u",
-	0x0000A7AB: "3",
-	0x0000A7B1: "\ua4d5",
-	0x0000A7B2: "J",
-	0x0000A7B3: "X",
-	0x0000A7B4: "B",
-	0x0000A7B5: "\u00df",
-	0x0000A7B6: "\ua64c",
-	0x0000A7B7: "\u03c9",
-	0x0000A7D6: "\u00df",
-	0x0000A7DA: "\u0245",
-	0x0000A7DB: "\u03bb",
-	0x0000A7DC: "\u0245\u0338",
-	0x0000A7F7: "\u30fc",
-	0x0000A830: "\u0964",
-	0x0000A960: "\u1103\u1106",
-	0x0000A961: "\u1103\u1107",
-	0x0000A962: "\u1103\u1109",
-	0x0000A963: "\u1103\u110c",
-	0x0000A964: "\u1105\u1100",
-	0x0000A965: "\u1105\u1100\u1100",
-	0x0000A966: "\u1105\u1103",
-	0x0000A967: "\u1105\u1103\u1103",
-	0x0000A968: "\u1105\u1106",
-	0x0000A969: "\u1105\u1107",
-	0x0000A96A: "\u1105\u1107\u1107",
-	0x0000A96B: "\u1105\u1107\u110b",
-	0x0000A96C: "\u1105\u1109",
-	0x0000A96D: "\u1105\u110c",
-	0x0000A96E: "\u1105\u110f",
-	0x0000A96F: "\u1106\u1100",
-	0x0000A970: "\u1106\u1103",
-	0x0000A971: "\u1106\u1109",
-	0x0000A972: "\u1107\u1109\u1110",
-	0x0000A973: "\u1107\u110f",
-	0x0000A974: "\u1107\u1112",
-	0x0000A975: "\u1109\u1109\u1107",
-	0x0000A976: "\u110b\u1105",
-	0x0000A977: "\u110b\u1112",
-	0x0000A978: "\u110c\u110c\u1112",
-	0x0000A979: "\u1110\u1110",
-	0x0000A97A: "\u1111\u1112",
-	0x0000A97B: "\u1112\u1109",
-	0x0000A97C: "\u1159\u1159",
-	0x0000A992: "\u2c3f",
-	0x0000A9A3: "\ua99d",
-	0x0000A9C6: "\ua9d0",
-	0x0000A9CF: "\u0662",
-	0x0000AA53: "\uaa01",
-	0x0000AA56: "\uaa23",
-	0x0000AB32: "e",
-	0x0000AB35: "f",
-	0x0000AB3D: "o",
-	0x0000AB3E: "o\u0338",
-	0x0000AB3F: "\u0254\u0338",
-	0x0000AB41: "\u01ddo\u0338",
-	0x0000AB42: "\u01ddo\u0335",
-	0x0000AB47: "r",
-	0x0000AB48: "r",
-	0x0000AB4D: "\u0283",
-	0x0000AB4E: "u",
-	0x0000AB52: "u",
-	0x0000AB53: "\u03c7",
-	0x0000AB55: "\u03c7",
-	0x0000AB5A: "y",
-	0x0000AB60: "\u0459",
-	0x0000AB62: "\u0254e",
-	0x0000AB63: "uo",
-	0x0000AB70: "\u1d05",
-	0x0000AB71: "\u0280",
-	0x0000AB72: "\u1d1b",
-	0x0000AB74: "o\u031b",
-	0x0000AB75: "i",
-	0x0000AB7A: "\u1d00",
-	0x0000AB7B: "\u1d0a",
-	0x0000AB7C: "\u1d07",
-	0x0000AB7E: "\u0242",
-	0x0000AB80: "\u2c76",
-	0x0000AB81: "r",
-	0x0000AB83: "w",
-	0x0000AB87: "\u028d",
-	0x0000AB8B: "\u029c",
-	0x0000AB8E: "o\u0335",
-	0x0000AB90: "\u0262",
-	0x0000AB93: "z",
-	0x0000AB9B: "\ua793",
-	0x0000AB9C: "u\u0335",
-	0x0000AB9F: "\u0185",
-	0x0000ABA2: "\u0280",
-	0x0000ABA9: "v",
-	0x0000ABAA: "s",
-	0x0000ABAE: "\u029f",
-	0x0000ABAF: "c",
-	0x0000ABB2: "\u1d18",
-	0x0000ABB6: "\u0138",
-	0x0000ABBB: "o\u0335",
-	0x0000D7B0: "\u1169\u1167",
-	0x0000D7B1: "\u1169\u1169\u4e28",
-	0x0000D7B2: "\u116d\u1161",
-	0x0000D7B3: "\u116d\u1161\u4e28",
-	0x0000D7B4: "\u116d\u1165",
-	0x0000D7B5: "\u116e\u1167",
-	0x0000D7B6: "\u116e\u4e28\u4e28",
-	0x0000D7B7: "\u1172\u1161\u4e28",
-	0x0000D7B8: "\u1172\u1169",
-	0x0000D7B9: "\u30fc\u1161",
-	0x0000D7BA: "\u30fc\u1165",
-	0x0000D7BB: "\u30fc\u1165\u4e28",
-	0x0000D7BC: "\u30fc\u1169",
-	0x0000D7BD: "\u4e28\u1163\u1169",
-	0x0000D7BE: "\u4e28\u1163\u4e28",
-	0x0000D7BF: "\u4e28\u1167",
-	0x0000D7C0: "\u4e28\u1167\u4e28",
-	0x0000D7C1: "\u4e28\u1169\u4e28",
-	0x0000D7C2: "\u4e28\u116d",
-	0x0000D7C3: "\u4e28\u1172",
-	0x0000D7C4: "\u4e28\u4e28",
-	0x0000D7C5: "\u119e\u1161",
-	0x0000D7C6: "\u119e\u1165\u4e28",
-	0x0000D7CB: "\u1102\u1105",
-	0x0000D7CC: "\u1102\u110e",
-	0x0000D7CD: "\u1103\u1103",
-	0x0000D7CE: "\u1103\u1103\u1107",
-	0x0000D7CF: "\u1103\u1107",
-	0x0000D7D0: "\u1103\u1109",
-	0x0000D7D1: "\u1103\u1109\u1100",
-	0x0000D7D2: "\u1103\u110c",
-	0x0000D7D3: "\u1103\u110e",
-	0x0000D7D4: "\u1103\u1110",
-	0x0000D7D5: "\u1105\u1100\u1100",
-	0x0000D7D6: "\u1105\u1100\u1112",
-	0x0000D7D7: "\u1105\u1105\u110f",
-	0x0000D7D8: "\u1105\u1106\u1112",
-	0x0000D7D9: "\u1105\u1107\u1103",
-	0x0000D7DA: "\u1105\u1107\u1111",
-	0x0000D7DB: "\u1105\u114c",
-	0x0000D7DC: "\u1105\u1159\u1112",
-	0x0000D7DD: "\u1105\u110b",
-	0x0000D7DE: "\u1106\u1102",
-	0x0000D7DF: "\u1106\u1102\u1102",
-	0x0000D7E0: "\u1106\u1106",
-	0x0000D7E1: "\u1106\u1107\u1109",
-	0x0000D7E2: "\u1106\u110c",
-	0x0000D7E3: "\u1107\u1103",
-	0x0000D7E4: "\u1107\u1105\u1111",
-	0x0000D7E5: "\u1107\u1106",
-	0x0000D7E6: "\u1107\u1107",
-	0x0000D7E7: "\u1107\u1109\u1103",
-	0x0000D7E8: "\u1107\u110c",
-	0x0000D7E9: "\u1107\u110e",
-	0x0000D7EA: "\u1109\u1106",
-	0x0000D7EB: "\u1109\u1107\u110b",
-	0x0000D7EC: "\u1109\u1109\u1100",
-	0x0000D7ED: "\u1109\u1109\u1103",
-	0x0000D7EE: "\u1109\u1140",
-	0x0000D7EF: "\u1109\u110c",
-	0x0000D7F0: "\u1109\u110e",
-	0x0000D7F1: "\u1109\u1110",
-	0x0000D7F2: "\u1105\u1112",
-	0x0000D7F3: "\u1140\u1107",
-	0x0000D7F4: "\u1140\u1107\u110b",
-	0x0000D7F5: "\u114c\u1106",
-	0x0000D7F6: "\u114c\u1112",
-	0x0000D7F7: "\u110c\u1107",
-	0x0000D7F8: "\u110c\u1107\u1107",
-	0x0000D7F9: "\u110c\u110c",
-	0x0000D7FA: "\u1111\u1109",
-	0x0000D7FB: "\u1111\u1110",
-	0x0000F900: "\u8c48",
-	0x0000F901: "\u66f4",
-	0x0000F902: "\u8eca",
-	0x0000F903: "\u8cc8",
-	0x0000F904: "\u6ed1",
-	0x0000F905: "\u4e32",
-	0x0000F906: "\u53e5",
-	0x0000F907: "\u9f9c",
-	0x0000F908: "\u9f9c",
-	0x0000F909: "\u5951",
-	0x0000F90A: "\u91d1",
-	0x0000F90B: "\u5587",
-	0x0000F90C: "\u5948",
-	0x0000F90D: "\u61f6",
-	0x0000F90E: "\u7669",
-	0x0000F90F: "\u7f85",
-	0x0000F910: "\u863f",
-	0x0000F911: "\u87ba",
-	0x0000F912: "\u88f8",
-	0x0000F913: "\u908f",
-	0x0000F914: "\u6a02",
-	0x0000F915: "\u6d1b",
-	0x0000F916: "\u70d9",
-	0x0000F917: "\u73de",
-	0x0000F918: "\u843d",
-	0x0000F919: "\u916a",
-	0x0000F91A: "\u99f1",
-	0x0000F91B: "\u4e82",
-	0x0000F91C: "\u5375",
-	0x0000F91D: "\u6b04",
-	0x0000F91E: "\u721b",
-	0x0000F91F: "\u862d",
-	0x0000F920: "\u9e1e",
-	0x0000F921: "\u5d50",
-	0x0000F922: "\u6feb",
-	0x0000F923: "\u85cd",
-	0x0000F924: "\u8964",
-	0x0000F925: "\u62c9",
-	0x0000F926: "\u81d8",
-	0x0000F927: "\u881f",
-	0x0000F928: "\u5eca",
-	0x0000F929: "\u6717",
-	0x0000F92A: "\u6d6a",
-	0x0000F92B: "\u72fc",
-	0x0000F92C: "\u90ce",
-	0x0000F92D: "\u4f86",
-	0x0000F92E: "\u51b7",
-	0x0000F92F: "\u52de",
-	0x0000F930: "\u64c4",
-	0x0000F931: "\u6ad3",
-	0x0000F932: "\u7210",
-	0x0000F933: "\u76e7",
-	0x0000F934: "\u8001",
-	0x0000F935: "\u8606",
-	0x0000F936: "\u865c",
-	0x0000F937: "\u8def",
-	0x0000F938: "\u9732",
-	0x0000F939: "\u9b6f",
-	0x0000F93A: "\u9dfa",
-	0x0000F93B: "\u788c",
-	0x0000F93C: "\u797f",
-	0x0000F93D: "\u7da0",
-	0x0000F93E: "\u83c9",
-	0x0000F93F: "\u9304",
-	0x0000F940: "\u9e7f",
-	0x0000F941: "\u8ad6",
-	0x0000F942: "\u58df",
-	0x0000F943: "\u5f04",
-	0x0000F944: "\u7c60",
-	0x0000F945: "\u807e",
-	0x0000F946: "\u7262",
-	0x0000F947: "\u78ca",
-	0x0000F948: "\u8cc2",
-	0x0000F949: "\u96f7",
-	0x0000F94A: "\u58d8",
-	0x0000F94B: "\u5c62",
-	0x0000F94C: "\u6a13",
-	0x0000F94D: "\u6dda",
-	0x0000F94E: "\u6f0f",
-	0x0000F94F: "\u7d2f",
-	0x0000F950: "\u7e37",
-	0x0000F951: "\u964b",
-	0x0000F952: "\u52d2",
-	0x0000F953: "\u808b",
-	0x0000F954: "\u51dc",
-	0x0000F955: "\u51cc",
-	0x0000F956: "\u7a1c",
-	0x0000F957: "\u7dbe",
-	0x0000F958: "\u83f1",
-	0x0000F959: "\u9675",
-	0x0000F95A: "\u8b80",
-	0x0000F95B: "\u62cf",
-	0x0000F95C: "\u6a02",
-	0x0000F95D: "\u8afe",
-	0x0000F95E: "\u4e39",
-	0x0000F95F: "\u5be7",
-	0x0000F960: "\u6012",
-	0x0000F961: "\u7387",
-	0x0000F962: "\u7570",
-	0x0000F963: "\u5317",
-	0x0000F964: "\u78fb",
-	0x0000F965: "\u4fbf",
-	0x0000F966: "\u5fa9",
-	0x0000F967: "\u4e0d",
-	0x0000F968: "\u6ccc",
-	0x0000F969: "\u6578",
-	0x0000F96A: "\u7d22",
-	0x0000F96B: "\u53c3",
-	0x0000F96C: "\u585e",
-	0x0000F96D: "\u7701",
-	0x0000F96E: "\u8449",
-	0x0000F96F: "\u8aaa",
-	0x0000F970: "\u6bba",
-	0x0000F971: "\u8fb0",
-	0x0000F972: "\u6c88",
-	0x0000F973: "\u62fe",
-	0x0000F974: "\u82e5",
-	0x0000F975: "\u63a0",
-	0x0000F976: "\u7565",
-	0x0000F977: "\u4eae",
-	0x0000F978: "\u5169",
-	0x0000F979: "\u51c9",
-	0x0000F97A: "\u6881",
-	0x0000F97B: "\u7ce7",
-	0x0000F97C: "\u826f",
-	0x0000F97D: "\u8ad2",
-	0x0000F97E: "\u91cf",
-	0x0000F97F: "\u52f5",
-	0x0000F980: "\u5442",
-	0x0000F981: "\u5973",
-	0x0000F982: "\u5eec",
-	0x0000F983: "\u65c5",
-	0x0000F984: "\u6ffe",
-	0x0000F985: "\u792a",
-	0x0000F986: "\u95ad",
-	0x0000F987: "\u9a6a",
-	0x0000F988: "\u9e97",
-	0x0000F989: "\u9ece",
-	0x0000F98A: "\u529b",
-	0x0000F98B: "\u66c6",
-	0x0000F98C: "\u6b77",
-	0x0000F98D: "\u8f62",
-	0x0000F98E: "\u5e74",
-	0x0000F98F: "\u6190",
-	0x0000F990: "\u6200",
-	0x0000F991: "\u649a",
-	0x0000F992: "\u6f23",
-	0x0000F993: "\u7149",
-	0x0000F994: "\u7489",
-	0x0000F995: "\u79ca",
-	0x0000F996: "\u7df4",
-	0x0000F997: "\u806f",
-	0x0000F998: "\u8f26",
-	0x0000F999: "\u84ee",
-	0x0000F99A: "\u9023",
-	0x0000F99B: "\u934a",
-	0x0000F99C: "\u5217",
-	0x0000F99D: "\u52a3",
-	0x0000F99E: "\u54bd",
-	0x0000F99F: "\u70c8",
-	0x0000F9A0: "\u88c2",
-	0x0000F9A1: "\u8aaa",
-	0x0000F9A2: "\u5ec9",
-	0x0000F9A3: "\u5ff5",
-	0x0000F9A4: "\u637b",
-	0x0000F9A5: "\u6bae",
-	0x0000F9A6: "\u7c3e",
-	0x0000F9A7: "\u7375",
-	0x0000F9A8: "\u4ee4",
-	0x0000F9A9: "\u56f9",
-	0x0000F9AA: "\u5be7",
-	0x0000F9AB: "\u5dba",
-	0x0000F9AC: "\u601c",
-	0x0000F9AD: "\u73b2",
-	0x0000F9AE: "\u7469",
-	0x0000F9AF: "\u7f9a",
-	0x0000F9B0: "\u8046",
-	0x0000F9B1: "\u9234",
-	0x0000F9B2: "\u96f6",
-	0x0000F9B3: "\u9748",
-	0x0000F9B4: "\u9818",
-	0x0000F9B5: "\u4f8b",
-	0x0000F9B6: "\u79ae",
-	0x0000F9B7: "\u91b4",
-	0x0000F9B8: "\u96b7",
-	0x0000F9B9: "\u60e1",
-	0x0000F9BA: "\u4e86",
-	0x0000F9BB: "\u50da",
-	0x0000F9BC: "\u5bee",
-	0x0000F9BD: "\u5c3f",
-	0x0000F9BE: "\u6599",
-	0x0000F9BF: "\u6a02",
-	0x0000F9C0: "\u71ce",
-	0x0000F9C1: "\u7642",
-	0x0000F9C2: "\u84fc",
-	0x0000F9C3: "\u907c",
-	0x0000F9C4: "\u9f8d",
-	0x0000F9C5: "\u6688",
-	0x0000F9C6: "\u962e",
-	0x0000F9C7: "\u5289",
-	0x0000F9C8: "\u677b",
-	0x0000F9C9: "\u67f3",
-	0x0000F9CA: "\u6d41",
-	0x0000F9CB: "\u6e9c",
-	0x0000F9CC: "\u7409",
-	0x0000F9CD: "\u7559",
-	0x0000F9CE: "\u786b",
-	0x0000F9CF: "\u7d10",
-	0x0000F9D0: "\u985e",
-	0x0000F9D1: "\u516d",
-	0x0000F9D2: "\u622e",
-	0x0000F9D3: "\u9678",
-	0x0000F9D4: "\u502b",
-	0x0000F9D5: "\u5d19",
-	0x0000F9D6: "\u6dea",
-	0x0000F9D7: "\u8f2a",
-	0x0000F9D8: "\u5f8b",
-	0x0000F9D9: "\u6144",
-	0x0000F9DA: "\u6817",
-	0x0000F9DB: "\u7387",
-	0x0000F9DC: "\u9686",
-	0x0000F9DD: "\u5229",
-	0x0000F9DE: "\u540f",
-	0x0000F9DF: "\u5c65",
-	0x0000F9E0: "\u6613",
-	0x0000F9E1: "\u674e",
-	0x0000F9E2: "\u68a8",
-	0x0000F9E3: "\u6ce5",
-	0x0000F9E4: "\u7406",
-	0x0000F9E5: "\u75e2",
-	0x0000F9E6: "\u7f79",
-	0x0000F9E7: "\u88cf",
-	0x0000F9E8: "\u88e1",
-	0x0000F9E9: "\u91cc",
-	0x0000F9EA: "\u96e2",
-	0x0000F9EB: "\u533f",
-	0x0000F9EC: "\u6eba",
-	0x0000F9ED: "\u541d",
-	0x0000F9EE: "\u71d0",
-	0x0000F9EF: "\u7498",
-	0x0000F9F0: "\u85fa",
-	0x0000F9F1: "\u96a3",
-	0x0000F9F2: "\u9c57",
-	0x0000F9F3: "\u9e9f",
-	0x0000F9F4: "\u6797",
-	0x0000F9F5: "\u6dcb",
-	0x0000F9F6: "\u81e8",
-	0x0000F9F7: "\u7acb",
-	0x0000F9F8: "\u7b20",
-	0x0000F9F9: "\u7c92",
-	0x0000F9FA: "\u72c0",
-	0x0000F9FB: "\u7099",
-	0x0000F9FC: "\u8b58",
-	0x0000F9FD: "\u4ec0",
-	0x0000F9FE: "\u8336",
-	0x0000F9FF: "\u523a",
-	0x0000FA00: "\u5207",
-	0x0000FA01: "\u5ea6",
-	0x0000FA02: "\u62d3",
-	0x0000FA03: "\u7cd6",
-	0x0000FA04: "\u5b85",
-	0x0000FA05: "\u6d1e",
-	0x0000FA06: "\u66b4",
-	0x0000FA07: "\u8f3b",
-	0x0000FA08: "\u884c",
-	0x0000FA09: "\u964d",
-	0x0000FA0A: "\u898b",
-	0x0000FA0B: "\u5ed3",
-	0x0000FA0C: "\u5140",
-	0x0000FA0D: "\u55c0",
-	0x0000FA10: "\u585a",
-	0x0000FA12: "\u6674",
-	0x0000FA15: "\u51de",
-	0x0000FA16: "\u732a",
-	0x0000FA17: "\u76ca",
-	0x0000FA18: "\u793c",
-	0x0000FA19: "\u795e",
-	0x0000FA1A: "\u7965",
-	0x0000FA1B: "\u798f",
-	0x0000FA1C: "\u9756",
-	0x0000FA1D: "\u7cbe",
-	0x0000FA1E: "\u7fbd",
-	0x0000FA20: "\u8612",
-	0x0000FA22: "\u8af8",
-	0x0000FA25: "\u9038",
-	0x0000FA26: "\u90fd",
-	0x0000FA2A: "\u98ef",
-	0x0000FA2B: "\u98fc",
-	0x0000FA2C: "\u9928",
-	0x0000FA2D: "\u9db4",
-	0x0000FA2E: "\u90ce",
-	0x0000FA2F: "\u96b7",
-	0x0000FA30: "\u4fae",
-	0x0000FA31: "\u50e7",
-	0x0000FA32: "\u514d",
-	0x0000FA33: "\u52c9",
-	0x0000FA34: "\u52e4",
-	0x0000FA35: "\u5351",
-	0x0000FA36: "\u559d",
-	0x0000FA37: "\u5606",
-	0x0000FA38: "\u5668",
-	0x0000FA39: "\u5840",
-	0x0000FA3A: "\u58a8",
-	0x0000FA3B: "\u5c64",
-	0x0000FA3C: "\u5c6e",
-	0x0000FA3D: "\u6094",
-	0x0000FA3E: "\u6168",
-	0x0000FA3F: "\u618e",
-	0x0000FA40: "\u61f2",
-	0x0000FA41: "\u654f",
-	0x0000FA42: "\u65e2",
-	0x0000FA43: "\u6691",
-	0x0000FA44: "\u6885",
-	0x0000FA45: "\u6d77",
-	0x0000FA46: "\u6e1a",
-	0x0000FA47: "\u6f22",
-	0x0000FA48: "\u716e",
-	0x0000FA49: "\u722b",
-	0x0000FA4A: "\u7422",
-	0x0000FA4B: "\u7891",
-	0x0000FA4C: "\u793e",
-	0x0000FA4D: "\u7949",
-	0x0000FA4E: "\u7948",
-	0x0000FA4F: "\u7950",
-	0x0000FA50: "\u7956",
-	0x0000FA51: "\u795d",
-	0x0000FA52: "\u798d",
-	0x0000FA53: "\u798e",
-	0x0000FA54: "\u7a40",
-	0x0000FA55: "\u7a81",
-	0x0000FA56: "\u7bc0",
-	0x0000FA57: "\u7df4",
-	0x0000FA58: "\u7e09",
-	0x0000FA59: "\u7e41",
-	0x0000FA5A: "\u7f72",
-	0x0000FA5B: "\u8005",
-	0x0000FA5C: "\u81ed",
-	0x0000FA5D: "\u8279",
-	0x0000FA5E: "\u8279",
-	0x0000FA5F: "\u8457",
-	0x0000FA60: "\u8910",
-	0x0000FA61: "\u8996",
-	0x0000FA62: "\u8b01",
-	0x0000FA63: "\u8b39",
-	0x0000FA64: "\u8cd3",
-	0x0000FA65: "\u8d08",
-	0x0000FA66: "\u8fb6",
-	0x0000FA67: "\u9038",
-	0x0000FA68: "\u96e3",
-	0x0000FA69: "\u97ff",
-	0x0000FA6A: "\u983b",
-	0x0000FA6B: "\u6075",
-	0x0000FA6C: "\U000242ee",
-	0x0000FA6D: "\u8218",
-	0x0000FA70: "\u4e26",
-	0x0000FA71: "\u51b5",
-	0x0000FA72: "\u5168",
-	0x0000FA73: "\u4f80",
-	0x0000FA74: "\u5145",
-	0x0000FA75: "\u5180",
-	0x0000FA76: "\u52c7",
-	0x0000FA77: "\u52fa",
-	0x0000FA78: "\u559d",
-	0x0000FA79: "\u5555",
-	0x0000FA7A: "\u5599",
-	0x0000FA7B: "\u55e2",
-	0x0000FA7C: "\u585a",
-	0x0000FA7D: "\u58b3",
-	0x0000FA7E: "\u5944",
-	0x0000FA7F: "\u5954",
-	0x0000FA80: "\u5a62",
-	0x0000FA81: "\u5b28",
-	0x0000FA82: "\u5ed2",
-	0x0000FA83: "\u5ed9",
-	0x0000FA84: "\u5f69",
-	0x0000FA85: "\u5fad",
-	0x0000FA86: "\u60d8",
-	0x0000FA87: "\u614e",
-	0x0000FA88: "\u6108",
-	0x0000FA89: "\u618e",
-	0x0000FA8A: "\u6160",
-	0x0000FA8B: "\u61f2",
-	0x0000FA8C: "\u6234",
-	0x0000FA8D: "\u63c4",
-	0x0000FA8E: "\u641c",
-	0x0000FA8F: "\u6452",
-	0x0000FA90: "\u6556",
-	0x0000FA91: "\u6674",
-	0x0000FA92: "\u6717",
-	0x0000FA93: "\u671b",
-	0x0000FA94: "\u6756",
-	0x0000FA95: "\u6b79",
-	0x0000FA96: "\u6bba",
-	0x0000FA97: "\u6d41",
-	0x0000FA98: "\u6edb",
-	0x0000FA99: "\u6ecb",
-	0x0000FA9A: "\u6f22",
-	0x0000FA9B: "\u701e",
-	0x0000FA9C: "\u716e",
-	0x0000FA9D: "\u77a7",
-	0x0000FA9E: "\u7235",
-	0x0000FA9F: "\u72af",
-	0x0000FAA0: "\u732a",
-	0x0000FAA1: "\u7471",
-	0x0000FAA2: "\u7506",
-	0x0000FAA3: "\u753b",
-	0x0000FAA4: "\u761d",
-	0x0000FAA5: "\u761f",
-	0x0000FAA6: "\u76ca",
-	0x0000FAA7: "\u76db",
-	0x0000FAA8: "\u76f4",
-	0x0000FAA9: "\u774a",
-	0x0000FAAA: "\u7740",
-	0x0000FAAB: "\u78cc",
-	0x0000FAAC: "\u7ab1",
-	0x0000FAAD: "\u7bc0",
-	0x0000FAAE: "\u7c7b",
-	0x0000FAAF: "\u7d5b",
-	0x0000FAB0: "\u7df4",
-	0x0000FAB1: "\u7f3e",
-	0x0000FAB2: "\u8005",
-	0x0000FAB3: "\u8352",
-	0x0000FAB4: "\u83ef",
-	0x0000FAB5: "\u8779",
-	0x0000FAB6: "\u8941",
-	0x0000FAB7: "\u8986",
-	0x0000FAB8: "\u8996",
-	0x0000FAB9: "\u8abf",
-	0x0000FABA: "\u8af8",
-	0x0000FABB: "\u8acb",
-	0x0000FABC: "\u8b01",
-	0x0000FABD: "\u8afe",
-	0x0000FABE: "\u8aed",
-	0x0000FABF: "\u8b39",
-	0x0000FAC0: "\u8b8a",
-	0x0000FAC1: "\u8d08",
-	0x0000FAC2: "\u8f38",
-	0x0000FAC3: "\u9072",
-	0x0000FAC4: "\u9199",
-	0x0000FAC5: "\u9276",
-	0x0000FAC6: "\u967c",
-	0x0000FAC7: "\u96e3",
-	0x0000FAC8: "\u9756",
-	0x0000FAC9: "\u97db",
-	0x0000FACA: "\u97ff",
-	0x0000FACB: "\u980b",
-	0x0000FACC: "\u983b",
-	0x0000FACD: "\u9b12",
-	0x0000FACE: "\u9f9c",
-	0x0000FACF: "\U0002284a",
-	0x0000FAD0: "\U00022844",
-	0x0000FAD1: "\U000233d5",
-	0x0000FAD2: "\u3b9d",
-	0x0000FAD3: "\u4018",
-	0x0000FAD4: "\u4039",
-	0x0000FAD5: "\U00025249",
-	0x0000FAD6: "\U00025cd0",
-	0x0000FAD7: "\U00027ed3",
-	0x0000FAD8: "\u9f43",
-	0x0000FAD9: "\u9f8e",
-	0x0000FB00: "ff",
-	0x0000FB01: "fi",
-	0x0000FB02: "fl",
-	0x0000FB03: "ffi",
-	0x0000FB04: "ffl",
-	0x0000FB06: "st",
-	0x0000FB13: "\u0574\u0576",
-	0x0000FB14: "\u0574\u0565",
-	0x0000FB15: "\u0574\u056b",
-	0x0000FB16: "\u057e\u0576",
-	0x0000FB17: "\u0574\u056d",
-	0x0000FB20: "\u05e2",
-	0x0000FB21: "\u05d0",
-	0x0000FB22: "\u05d3",
-	0x0000FB23: "\u05d4",
-	0x0000FB24: "\u05db",
-	0x0000FB25: "\u05dc",
-	0x0000FB26: "\u05dd",
-	0x0000FB27: "\u05e8",
-	0x0000FB28: "\u05ea",
-	0x0000FB29: "-\u0307",
-	0x0000FB2B: "\ufb2a",
-	0x0000FB2D: "\ufb2c",
-	0x0000FB2F: "\ufb2e",
-	0x0000FB30: "\ufb2e",
-	0x0000FB39: "\ufb1d",
-	0x0000FB49: "\ufb2a",
-	0x0000FB4F: "\u05d0\u05dc",
-	0x0000FB50: "\u0671",
-	0x0000FB51: "\u0671",
-	0x0000FB52: "\u067b",
-	0x0000FB53: "\u067b",
-	0x0000FB54: "\u067b",
-	0x0000FB55: "\u067b",
-	0x0000FB56: "\u0649\u06db",
-	0x0000FB57: "\u0649\u06db",
-	0x0000FB58: "\u0649\u06db",
-	0x0000FB59: "\u0649\u06db",
-	0x0000FB5A: "\u0680",
-	0x0000FB5B: "\u0680",
-	0x0000FB5C: "\u0680",
-	0x0000FB5D: "\u0680",
-	0x0000FB5E: "\u067a",
-	0x0000FB5F: "\u067a",
-	0x0000FB60: "\u067a",
-	0x0000FB61: "\u067a",
-	0x0000FB62: "\u067f",
-	0x0000FB63: "\u067f",
-	0x0000FB64: "\u067f",
-	0x0000FB65: "\u067f",
-	0x0000FB66: "\u0649\u0615",
-	0x0000FB67: "\u0649\u0615",
-	0x0000FB68: "\u0649\u0615",
-	0x0000FB69: "\u0649\u0615",
-	0x0000FB6A: "\u06a1\u06db",
-	0x0000FB6B: "\u06a1\u06db",
-	0x0000FB6C: "\u06a1\u06db",
-	0x0000FB6D: "\u06a1\u06db",
-	0x0000FB6E: "\u06a6",
-	0x0000FB6F: "\u06a6",
-	0x0000FB70: "\u06a6",
-	0x0000FB71: "\u06a6",
-	0x0000FB72: "\u0684",
-	0x0000FB73: "\u0684",
-	0x0000FB74: "\u0684",
-	0x0000FB75: "\u0684",
-	0x0000FB76: "\u0683",
-	0x0000FB77: "\u0683",
-	0x0000FB78: "\u0683",
-	0x0000FB79: "\u0683",
-	0x0000FB7A: "\u0686",
-	0x0000FB7B: "\u0686",
-	0x0000FB7C: "\u0686",
-	0x0000FB7D: "\u0686",
-	0x0000FB7E: "\u0687",
-	0x0000FB7F: "\u0687",
-	0x0000FB80: "\u0687",
-	0x0000FB81: "\u0687",
-	0x0000FB82: "\u068d",
-	0x0000FB83: "\u068d",
-	0x0000FB84: "\u068c",
-	0x0000FB85: "\u068c",
-	0x0000FB86: "\u062f\u06db",
-	0x0000FB87: "\u062f\u06db",
-	0x0000FB88: "\u062f\u0615",
-	0x0000FB89: "\u062f\u0615",
-	0x0000FB8A: "\u0631\u06db",
-	0x0000FB8B: "\u0631\u06db",
-	0x0000FB8C: "\u0631\u0615",
-	0x0000FB8D: "\u0631\u0615",
-	0x0000FB8E: "\u0643",
-	0x0000FB8F: "\u0643",
-	0x0000FB90: "\u0643",
-	0x0000FB91: "\u0643",
-	0x0000FB92: "\u06af",
-	0x0000FB93: "\u06af",
-	0x0000FB94: "\u06af",
-	0x0000FB95: "\u06af",
-	0x0000FB96: "\u06b3",
-	0x0000FB97: "\u06b3",
-	0x0000FB98: "\u06b3",
-	0x0000FB99: "\u06b3",
-	0x0000FB9A: "\u06b1",
-	0x0000FB9B: "\u06b1",
-	0x0000FB9C: "\u06b1",
-	0x0000FB9D: "\u06b1",
-	0x0000FB9E: "\u0649",
-	0x0000FB9F: "\u0649",
-	0x0000FBA0: "\u0649\u0615",
-	0x0000FBA1: "\u0649\u0615",
-	0x0000FBA2: "\u0649\u0615",
-	0x0000FBA3: "\u0649\u0615",
-	0x0000FBA4: "\u06c0",
-	0x0000FBA5: "\u06c0",
-	0x0000FBA6: "o",
-	0x0000FBA7: "o",
-	0x0000FBA8: "o",
-	0x0000FBA9: "o",
-	0x0000FBAA: "o",
-	0x0000FBAB: "o",
-	0x0000FBAC: "o",
-	0x0000FBAD: "o",
-	0x0000FBAE: "\u0649",
-	0x0000FBAF: "\u0649",
-	0x0000FBB0: "\u06d3",
-	0x0000FBB1: "\u06d3",
-	0x0000FBD3: "\u0643\u06db",
-	0x0000FBD4: "\u0643\u06db",
-	0x0000FBD5: "\u0643\u06db",
-	0x0000FBD6: "\u0643\u06db",
-	0x0000FBD7: "\u0648\u0313",
-	0x0000FBD8: "\u0648\u0313",
-	0x0000FBD9: "\u0648\u0306",
-	0x0000FBDA: "\u0648\u0306",
-	0x0000FBDB: "\u0648\u0670",
-	0x0000FBDC: "\u0648\u0670",
-	0x0000FBDD: "\u0648\u0313\u0674",
-	0x0000FBDE: "\u0648\u06db",
-	0x0000FBDF: "\u0648\u06db",
-	0x0000FBE0: "\u06c5",
-	0x0000FBE1: "\u06c5",
-	0x0000FBE2: "\u0648\u0302",
-	0x0000FBE3: "\u0648\u0302",
-	0x0000FBE4: "\u067b",
-	0x0000FBE5: "\u067b",
-	0x0000FBE6: "\u067b",
-	0x0000FBE7: "\u067b",
-	0x0000FBE8: "\u0649",
-	0x0000FBE9: "\u0649",
-	0x0000FBEA: "\u0649\u0674l",
-	0x0000FBEB: "\u0649\u0674l",
-	0x0000FBEC: "\u0649\u0674o",
-	0x0000FBED: "\u0649\u0674o",
-	0x0000FBEE: "\u0649\u0674\u0648",
-	0x0000FBEF: "\u0649\u0674\u0648",
-	0x0000FBF0: "\u0649\u0674\u0648\u0313",
-	0x0000FBF1: "\u0649\u0674\u0648\u0313",
-	0x0000FBF2: "\u0649\u0674\u0648\u0306",
-	0x0000FBF3: "\u0649\u0674\u0648\u0306",
-	0x0000FBF4: "\u0649\u0674\u0648\u0670",
-	0x0000FBF5: "\u0649\u0674\u0648\u0670",
-	0x0000FBF6: "\u0649\u0674\u067b",
-	0x0000FBF7: "\u0649\u0674\u067b",
-	0x0000FBF8: "\u0649\u0674\u067b",
-	0x0000FBF9: "\u0649\u0674\u0649",
-	0x0000FBFA: "\u0649\u0674\u0649",
-	0x0000FBFB: "\u0649\u0674\u0649",
-	0x0000FBFC: "\u0649",
-	0x0000FBFD: "\u0649",
-	0x0000FBFE: "\u0649",
-	0x0000FBFF: "\u0649",
-	0x0000FC00: "\u0649\u0674\u062c",
-	0x0000FC01: "\u0649\u0674\u062d",
-	0x0000FC02: "\u0649\u0674\u0645",
-	0x0000FC03: "\u0649\u0674\u0649",
-	0x0000FC04: "\u0649\u0674\u0649",
-	0x0000FC05: "\u0628\u062c",
-	0x0000FC06: "\u0628\u062d",
-	0x0000FC07: "\u0628\u062e",
-	0x0000FC08: "\u0628\u0645",
-	0x0000FC09: "\u0628\u0649",
-	0x0000FC0A: "\u0628\u0649",
-	0x0000FC0B: "\u062a\u062c",
-	0x0000FC0C: "\u062a\u062d",
-	0x0000FC0D: "\u062a\u062e",
-	0x0000FC0E: "\u062a\u0645",
-	0x0000FC0F: "\u062a\u0649",
-	0x0000FC10: "\u062a\u0649",
-	0x0000FC11: "\u0649\u06db\u062c",
-	0x0000FC12: "\u0649\u06db\u0645",
-	0x0000FC13: "\u0649\u06db\u0649",
-	0x0000FC14: "\u0649\u06db\u0649",
-	0x0000FC15: "\u062c\u062d",
-	0x0000FC16: "\u062c\u0645",
-	0x0000FC17: "\u062d\u062c",
-	0x0000FC18: "\u062d\u0645",
-	0x0000FC19: "\u062e\u062c",
-	0x0000FC1A: "\u062e\u062d",
-	0x0000FC1B: "\u062e\u0645",
-	0x0000FC1C: "\u0633\u062c",
-	0x0000FC1D: "\u0633\u062d",
-	0x0000FC1E: "\u0633\u062e",
-	0x0000FC1F: "\u0633\u0645",
-	0x0000FC20: "\u0635\u062d",
-	0x0000FC21: "\u0635\u0645",
-	0x0000FC22: "\u0636\u062c",
-	0x0000FC23: "\u0636\u062d",
-	0x0000FC24: "\u0636\u062e",
-	0x0000FC25: "\u0636\u0645",
-	0x0000FC26: "\u0637\u062d",
-	0x0000FC27: "\u0637\u0645",
-	0x0000FC28: "\u0638\u0645",
-	0x0000FC29: "\u0639\u062c",
-	0x0000FC2A: "\u0639\u0645",
-	0x0000FC2B: "\u063a\u062c",
-	0x0000FC2C: "\u063a\u0645",
-	0x0000FC2D: "\u0641\u062c",
-	0x0000FC2E: "\u0641\u062d",
-	0x0000FC2F: "\u0641\u062e",
-	0x0000FC30: "\u0641\u0645",
-	0x0000FC31: "\u0641\u0649",
-	0x0000FC32: "\u0641\u0649",
-	0x0000FC33: "\u0642\u062d",
-	0x0000FC34: "\u0642\u0645",
-	0x0000FC35: "\u0642\u0649",
-	0x0000FC36: "\u0642\u0649",
-	0x0000FC37: "\u0643l",
-	0x0000FC38: "\u0643\u062c",
-	0x0000FC39: "\u0643\u062d",
-	0x0000FC3A: "\u0643\u062e",
-	0x0000FC3B: "\u0643\u0644",
-	0x0000FC3C: "\u0643\u0645",
-	0x0000FC3D: "\u0643\u0649",
-	0x0000FC3E: "\u0643\u0649",
-	0x0000FC3F: "\u0644\u062c",
-	0x0000FC40: "\u0644\u062d",
-	0x0000FC41: "\u0644\u062e",
-	0x0000FC42: "\u0644\u0645",
-	0x0000FC43: "\u0644\u0649",
-	0x0000FC44: "\u0644\u0649",
-	0x0000FC45: "\u0645\u062c",
-	0x0000FC46: "\u0645\u062d",
-	0x0000FC47: "\u0645\u062e",
-	0x0000FC48: "\u0645\u0645",
-	0x0000FC49: "\u0645\u0649",
-	0x0000FC4A: "\u0645\u0649",
-	0x0000FC4B: "\u0628\u062e",
-	0x0000FC4C: "\u0646\u062d",
-	0x0000FC4D: "\u0646\u062e",
-	0x0000FC4E: "\u0646\u0645",
-	0x0000FC4F: "\u0646\u0649",
-	0x0000FC50: "\u0646\u0649",
-	0x0000FC51: "o\u062c",
-	0x0000FC52: "o\u0645",
-	0x0000FC53: "o\u0649",
-	0x0000FC54: "o\u0649",
-	0x0000FC55: "\u0649\u062c",
-	0x0000FC56: "\u0649\u062d",
-	0x0000FC57: "\u0649\u062e",
-	0x0000FC58: "\u0649\u0645",
-	0x0000FC59: "\u0649\u0649",
-	0x0000FC5A: "\u0649\u0649",
-	0x0000FC5B: "\u0630\u0670",
-	0x0000FC5C: "\u0631\u0670",
-	0x0000FC5D: "\u0649\u0670",
-	0x0000FC5E: "\ufe72\u0651",
-	0x0000FC5F: "\ufe74\u0651",
-	0x0000FC60: "\ufe76\u0651",
-	0x0000FC61: "\ufe78\u0651",
-	0x0000FC62: "\ufe7a\u0651",
-	0x0000FC63: "\ufe7c\u0670",
-	0x0000FC64: "\u0649\u0674\u0631",
-	0x0000FC65: "\u0649\u0674\u0632",
-	0x0000FC66: "\u0649\u0674\u0645",
-	0x0000FC67: "\u0649\u0674\u0646",
-	0x0000FC68: "\u0649\u0674\u0649",
-	0x0000FC69: "\u0649\u0674\u0649",
-	0x0000FC6A: "\u0628\u0631",
-	0x0000FC6B: "\u0628\u0632",
-	0x0000FC6C: "\u0628\u0645",
-	0x0000FC6D: "\u0628\u0646",
-	0x0000FC6E: "\u0628\u0649",
-	0x0000FC6F: "\u0628\u0649",
-	0x0000FC70: "\u062a\u0631",
-	0x0000FC71: "\u062a\u0632",
-	0x0000FC72: "\u062a\u0645",
-	0x0000FC73: "\u062a\u0646",
-	0x0000FC74: "\u062a\u0649",
-	0x0000FC75: "\u062a\u0649",
-	0x0000FC76: "\u0649\u06db\u0631",
-	0x0000FC77: "\u0649\u06db\u0632",
-	0x0000FC78: "\u0649\u06db\u0645",
-	0x0000FC79: "\u0649\u06db\u0646",
-	0x0000FC7A: "\u0649\u06db\u0649",
-	0x0000FC7B: "\u0649\u06db\u0649",
-	0x0000FC7C: "\u0641\u0649",
-	0x0000FC7D: "\u0641\u0649",
-	0x0000FC7E: "\u0642\u0649",
-	0x0000FC7F: "\u0642\u0649",
-	0x0000FC80: "\u0643l",
-	0x0000FC81: "\u0643\u0644",
-	0x0000FC82: "\u0643\u0645",
-	0x0000FC83: "\u0643\u0649",
-	0x0000FC84: "\u0643\u0649",
-	0x0000FC85: "\u0644\u0645",
-	0x0000FC86: "\u0644\u0649",
-	0x0000FC87: "\u0644\u0649",
-	0x0000FC88: "\u0645l",
-	0x0000FC89: "\u0645\u0645",
-	0x0000FC8A: "\u0646\u0631",
-	0x0000FC8B: "\u0646\u0632",
-	0x0000FC8C: "\u0646\u0645",
-	0x0000FC8D: "\u0646\u0646",
-	0x0000FC8E: "\u0646\u0649",
-	0x0000FC8F: "\u0646\u0649",
-	0x0000FC90: "\u0649\u0670",
-	0x0000FC91: "\u0649\u0631",
-	0x0000FC92: "\u0649\u0632",
-	0x0000FC93: "\u0649\u0645",
-	0x0000FC94: "\u0649\u0646",
-	0x0000FC95: "\u0649\u0649",
-	0x0000FC96: "\u0649\u0649",
-	0x0000FC97: "\u0649\u0674\u062c",
-	0x0000FC98: "\u0649\u0674\u062d",
-	0x0000FC99: "\u0649\u0674\u062e",
-	0x0000FC9A: "\u0649\u0674\u0645",
-	0x0000FC9B: "\u0649\u0674o",
-	0x0000FC9C: "\u0628\u062c",
-	0x0000FC9D: "\u0628\u062d",
-	0x0000FC9E: "\u0628\u062e",
-	0x0000FC9F: "\u0628\u0645",
-	0x0000FCA0: "\u0628o",
-	0x0000FCA1: "\u062a\u062c",
-	0x0000FCA2: "\u062a\u062d",
-	0x0000FCA3: "\u062a\u062e",
-	0x0000FCA4: "\u062a\u0645",
-	0x0000FCA5: "\u062ao",
-	0x0000FCA6: "\u0649\u06db\u0645",
-	0x0000FCA7: "\u062c\u062d",
-	0x0000FCA8: "\u062c\u0645",
-	0x0000FCA9: "\u062d\u062c",
-	0x0000FCAA: "\u062d\u0645",
-	0x0000FCAB: "\u062e\u062c",
-	0x0000FCAC: "\u062e\u0645",
-	0x0000FCAD: "\u0633\u062c",
-	0x0000FCAE: "\u0633\u062d",
-	0x0000FCAF: "\u0633\u062e",
-	0x0000FCB0: "\u0633\u0645",
-	0x0000FCB1: "\u0635\u062d",
-	0x0000FCB2: "\u0635\u062e",
-	0x0000FCB3: "\u0635\u0645",
-	0x0000FCB4: "\u0636\u062c",
-	0x0000FCB5: "\u0636\u062d",
-	0x0000FCB6: "\u0636\u062e",
-	0x0000FCB7: "\u0636\u0645",
-	0x0000FCB8: "\u0637\u062d",
-	0x0000FCB9: "\u0638\u0645",
-	0x0000FCBA: "\u0639\u062c",
-	0x0000FCBB: "\u0639\u0645",
-	0x0000FCBC: "\u063a\u062c",
-	0x0000FCBD: "\u063a\u0645",
-	0x0000FCBE: "\u0641\u062c",
-	0x0000FCBF: "\u0641\u062d",
-	0x0000FCC0: "\u0641\u062e",
-	0x0000FCC1: "\u0641\u0645",
-	0x0000FCC2: "\u0642\u062d",
-	0x0000FCC3: "\u0642\u0645",
-	0x0000FCC4: "\u0643\u062c",
-	0x0000FCC5: "\u0643\u062d",
-	0x0000FCC6: "\u0643\u062e",
-	0x0000FCC7: "\u0643\u0644",
-	0x0000FCC8: "\u0643\u0645",
-	0x0000FCC9: "\u0644\u062c",
-	0x0000FCCA: "\u0644\u062d",
-	0x0000FCCB: "\u0644\u062e",
-	0x0000FCCC: "\u0644\u0645",
-	0x0000FCCD: "\u0644o",
-	0x0000FCCE: "\u0645\u062c",
-	0x0000FCCF: "\u0645\u062d",
-	0x0000FCD0: "\u0645\u062e",
-	0x0000FCD1: "\u0645\u0645",
-	0x0000FCD2: "\u0628\u062e",
-	0x0000FCD3: "\u0646\u062d",
-	0x0000FCD4: "\u0646\u062e",
-	0x0000FCD5: "\u0646\u0645",
-	0x0000FCD6: "\u0646o",
-	0x0000FCD7: "o\u062c",
-	0x0000FCD8: "o\u0645",
-	0x0000FCD9: "o\u0670",
-	0x0000FCDA: "\u0649\u062c",
-	0x0000FCDB: "\u0649\u062d",
-	0x0000FCDC: "\u0649\u062e",
-	0x0000FCDD: "\u0649\u0645",
-	0x0000FCDE: "\u0649o",
-	0x0000FCDF: "\u0649\u0674\u0645",
-	0x0000FCE0: "\u0649\u0674o",
-	0x0000FCE1: "\u0628\u0645",
-	0x0000FCE2: "\u0628o",
-	0x0000FCE3: "\u062a\u0645",
-	0x0000FCE4: "\u062ao",
-	0x0000FCE5: "\u0649\u06db\u0645",
-	0x0000FCE6: "\u0649\u06dbo",
-	0x0000FCE7: "\u0633\u0645",
-	0x0000FCE8: "\u0633o",
-	0x0000FCE9: "\u0633\u06db\u0645",
-	0x0000FCEA: "\u0633\u06dbo",
-	0x0000FCEB: "\u0643\u0644",
-	0x0000FCEC: "\u0643\u0645",
-	0x0000FCED: "\u0644\u0645",
-	0x0000FCEE: "\u0646\u0645",
-	0x0000FCEF: "\u0646o",
-	0x0000FCF0: "\u0649\u0645",
-	0x0000FCF1: "\u0649o",
-	0x0000FCF2: "\ufe77\u0651",
-	0x0000FCF3: "\ufe79\u0651",
-	0x0000FCF4: "\ufe7b\u0651",
-	0x0000FCF5: "\u0637\u0649",
-	0x0000FCF6: "\u0637\u0649",
-	0x0000FCF7: "\u0639\u0649",
-	0x0000FCF8: "\u0639\u0649",
-	0x0000FCF9: "\u063a\u0649",
-	0x0000FCFA: "\u063a\u0649",
-	0x0000FCFB: "\u0633\u0649",
-	0x0000FCFC: "\u0633\u0649",
-	0x0000FCFD: "\u0633\u06db\u0649",
-	0x0000FCFE: "\u0633\u06db\u0649",
-	0x0000FCFF: "\u062d\u0649",
-	0x0000FD00: "\u062d\u0649",
-	0x0000FD01: "\u062c\u0649",
-	0x0000FD02: "\u062c\u0649",
-	0x0000FD03: "\u062e\u0649",
-	0x0000FD04: "\u062e\u0649",
-	0x0000FD05: "\u0635\u0649",
-	0x0000FD06: "\u0635\u0649",
-	0x0000FD07: "\u0636\u0649",
-	0x0000FD08: "\u0636\u0649",
-	0x0000FD09: "\u0633\u06db\u062c",
-	0x0000FD0A: "\u0633\u06db\u062d",
-	0x0000FD0B: "\u0633\u06db\u062e",
-	0x0000FD0C: "\u0633\u06db\u0645",
-	0x0000FD0D: "\u0633\u06db\u0631",
-	0x0000FD0E: "\u0633\u0631",
-	0x0000FD0F: "\u0635\u0631",
-	0x0000FD10: "\u0636\u0631",
-	0x0000FD11: "\u0637\u0649",
-	0x0000FD12: "\u0637\u0649",
-	0x0000FD13: "\u0639\u0649",
-	0x0000FD14: "\u0639\u0649",
-	0x0000FD15: "\u063a\u0649",
-	0x0000FD16: "\u063a\u0649",
-	0x0000FD17: "\u0633\u0649",
-	0x0000FD18: "\u0633\u0649",
-	0x0000FD19: "\u0633\u06db\u0649",
-	0x0000FD1A: "\u0633\u06db\u0649",
-	0x0000FD1B: "\u062d\u0649",
-	0x0000FD1C: "\u062d\u0649",
-	0x0000FD1D: "\u062c\u0649",
-	0x0000FD1E: "\u062c\u0649",
-	0x0000FD1F: "\u062e\u0649",
-	0x0000FD20: "\u062e\u0649",
-	0x0000FD21: "\u0635\u0649",
-	0x0000FD22: "\u0635\u0649",
-	0x0000FD23: "\u0636\u0649",
-	0x0000FD24: "\u0636\u0649",
-	0x0000FD25: "\u0633\u06db\u062c",
-	0x0000FD26: "\u0633\u06db\u062d",
-	0x0000FD27: "\u0633\u06db\u062e",
-	0x0000FD28: "\u0633\u06db\u0645",
-	0x0000FD29: "\u0633\u06db\u0631",
-	0x0000FD2A: "\u0633\u0631",
-	0x0000FD2B: "\u0635\u0631",
-	0x0000FD2C: "\u0636\u0631",
-	0x0000FD2D: "\u0633\u06db\u062c",
-	0x0000FD2E: "\u0633\u06db\u062d",
-	0x0000FD2F: "\u0633\u06db\u062e",
-	0x0000FD30: "\u0633\u06db\u0645",
-	0x0000FD31: "\u0633o",
-	0x0000FD32: "\u0633\u06dbo",
-	0x0000FD33: "\u0637\u0645",
-	0x0000FD34: "\u0633\u062c",
-	0x0000FD35: "\u0633\u062d",
-	0x0000FD36: "\u0633\u062e",
-	0x0000FD37: "\u0633\u06db\u062c",
-	0x0000FD38: "\u0633\u06db\u062d",
-	0x0000FD39: "\u0633\u06db\u062e",
-	0x0000FD3A: "\u0637\u0645",
-	0x0000FD3B: "\u0638\u0645",
-	0x0000FD3C: "l\u030b",
-	0x0000FD3D: "l\u030b",
-	0x0000FD3E: "(",
-	0x0000FD3F: ")",
-	0x0000FD50: "\u062a\u062c\u0645",
-	0x0000FD51: "\u062a\u062d\u062c",
-	0x0000FD52: "\u062a\u062d\u062c",
-	0x0000FD53: "\u062a\u062d\u0645",
-	0x0000FD54: "\u062a\u062e\u0645",
-	0x0000FD55: "\u062a\u0645\u062c",
-	0x0000FD56: "\u062a\u0645\u062d",
-	0x0000FD57: "\u062a\u0645\u062e",
-	0x0000FD58: "\u062c\u0645\u062d",
-	0x0000FD59: "\u062c\u0645\u062d",
-	0x0000FD5A: "\u062d\u0645\u0649",
-	0x0000FD5B: "\u062d\u0645\u0649",
-	0x0000FD5C: "\u0633\u062d\u062c",
-	0x0000FD5D: "\u0633\u062c\u062d",
-	0x0000FD5E: "\u0633\u062c\u0649",
-	0x0000FD5F: "\u0633\u0645\u062d",
-	0x0000FD60: "\u0633\u0645\u062d",
-	0x0000FD61: "\u0633\u0645\u062c",
-	0x0000FD62: "\u0633\u0645\u0645",
-	0x0000FD63: "\u0633\u0645\u0645",
-	0x0000FD64: "\u0635\u062d\u062d",
-	0x0000FD65: "\u0635\u062d\u062d",
-	0x0000FD66: "\u0635\u0645\u0645",
-	0x0000FD67: "\u0633\u06db\u062d\u0645",
-	0x0000FD68: "\u0633\u06db\u062d\u0645",
-	0x0000FD69: "\u0633\u06db\u062c\u0649",
-	0x0000FD6A: "\u0633\u06db\u0645\u062e",
-	0x0000FD6B: "\u0633\u06db\u0645\u062e",
-	0x0000FD6C: "\u0633\u06db\u0645\u0645",
-	0x0000FD6D: "\u0633\u06db\u0645\u0645",
-	0x0000FD6E: "\u0636\u062d\u0649",
-	0x0000FD6F: "\u0636\u062e\u0645",
-	0x0000FD70: "\u0636\u062e\u0645",
-	0x0000FD71: "\u0637\u0645\u062d",
-	0x0000FD72: "\u0637\u0645\u062d",
-	0x0000FD73: "\u0637\u0645\u0645",
-	0x0000FD74: "\u0637\u0645\u0649",
-	0x0000FD75: "\u0639\u062c\u0645",
-	0x0000FD76: "\u0639\u0645\u0645",
-	0x0000FD77: "\u0639\u0645\u0645",
-	0x0000FD78: "\u0639\u0645\u0649",
-	0x0000FD79: "\u063a\u0645\u0645",
-	0x0000FD7A: "\u063a\u0645\u0649",
-	0x0000FD7B: "\u063a\u0645\u0649",
-	0x0000FD7C: "\u0641\u062e\u0645",
-	0x0000FD7D: "\u0641\u062e\u0645",
-	0x0000FD7E: "\u0642\u0645\u062d",
-	0x0000FD7F: "\u0642\u0645\u0645",
-	0x0000FD80: "\u0644\u062d\u0645",
-	0x0000FD81: "\u0644\u062d\u0649",
-	0x0000FD82: "\u0644\u062d\u0649",
-	0x0000FD83: "\u0644\u062c\u062c",
-	0x0000FD84: "\u0644\u062c\u062c",
-	0x0000FD85: "\u0644\u062e\u0645",
-	0x0000FD86: "\u0644\u062e\u0645",
-	0x0000FD87: "\u0644\u0645\u062d",
-	0x0000FD88: "\u0644\u0645\u062d",
-	0x0000FD89: "\u0645\u062d\u062c",
-	0x0000FD8A: "\u0645\u062d\u0645",
-	0x0000FD8B: "\u0645\u062d\u0649",
-	0x0000FD8C: "\u0645\u062c\u062d",
-	0x0000FD8D: "\u0645\u062c\u0645",
-	0x0000FD8E: "\u0645\u062e\u062c",
-	0x0000FD8F: "\u0645\u062e\u0645",
-	0x0000FD92: "\u0645\u062c\u062e",
-	0x0000FD93: "o\u0645\u062c",
-	0x0000FD94: "o\u0645\u0645",
-	0x0000FD95: "\u0646\u062d\u0645",
-	0x0000FD96: "\u0646\u062d\u0649",
-	0x0000FD97: "\u0646\u062c\u0645",
-	0x0000FD98: "\u0646\u062c\u0645",
-	0x0000FD99: "\u0646\u062c\u0649",
-	0x0000FD9A: "\u0646\u0645\u0649",
-	0x0000FD9B: "\u0646\u0645\u0649",
-	0x0000FD9C: "\u0649\u0645\u0645",
-	0x0000FD9D: "\u0649\u0645\u0645",
-	0x0000FD9E: "\u0628\u062e\u0649",
-	0x0000FD9F: "\u062a\u062c\u0649",
-	0x0000FDA0: "\u062a\u062c\u0649",
-	0x0000FDA1: "\u062a\u062e\u0649",
-	0x0000FDA2: "\u062a\u062e\u0649",
-	0x0000FDA3: "\u062a\u0645\u0649",
-	0x0000FDA4: "\u062a\u0645\u0649",
-	0x0000FDA5: "\u062c\u0645\u0649",
-	0x0000FDA6: "\u062c\u062d\u0649",
-	0x0000FDA7: "\u062c\u0645\u0649",
-	0x0000FDA8: "\u0633\u062e\u0649",
-	0x0000FDA9: "\u0635\u062d\u0649",
-	0x0000FDAA: "\u0633\u06db\u062d\u0649",
-	0x0000FDAB: "\u0636\u062d\u0649",
-	0x0000FDAC: "\u0644\u062c\u0649",
-	0x0000FDAD: "\u0644\u0645\u0649",
-	0x0000FDAE: "\u0649\u062d\u0649",
-	0x0000FDAF: "\u0649\u062c\u0649",
-	0x0000FDB0: "\u0649\u0645\u0649",
-	0x0000FDB1: "\u0645\u0645\u0649",
-	0x0000FDB2: "\u0642\u0645\u0649",
-	0x0000FDB3: "\u0646\u062d\u0649",
-	0x0000FDB4: "\u0642\u0645\u062d",
-	0x0000FDB5: "\u0644\u062d\u0645",
-	0x0000FDB6: "\u0639\u0645\u0649",
-	0x0000FDB7: "\u0643\u0645\u0649",
-	0x0000FDB8: "\u0646\u062c\u062d",
-	0x0000FDB9: "\u0645\u062e\u0649",
-	0x0000FDBA: "\u0644\u062c\u0645",
-	0x0000FDBB: "\u0643\u0645\u0645",
-	0x0000FDBC: "\u0644\u062c\u0645",
-	0x0000FDBD: "\u0646\u062c\u062d",
-	0x0000FDBE: "\u062c\u062d\u0649",
-	0x0000FDBF: "\u062d\u062c\u0649",
-	0x0000FDC0: "\u0645\u062c\u0649",
-	0x0000FDC1: "\u0641\u0645\u0649",
-	0x0000FDC2: "\u0628\u062d\u0649",
-	0x0000FDC3: "\u0643\u0645\u0645",
-	0x0000FDC4: "\u0639\u062c\u0645",
-	0x0000FDC5: "\u0635\u0645\u0645",
-	0x0000FDC6: "\u0633\u062e\u0649",
-	0x0000FDC7: "\u0646\u062c\u0649",
-	0x0000FDF0: "\u0635\u0644\u0649",
-	0x0000FDF1: "\u0642\u0644\u0649",
-	0x0000FDF2: "l\u0644\u0644\u0651\u0670o",
-	0x0000FDF3: "l\u0643\u0628\u0631",
-	0x0000FDF4: "\u0645\u062d\u0645\u062f",
-	0x0000FDF5: "\u0635\u0644\u0639\u0645",
-	0x0000FDF6: "\u0631\u0633\u0648\u0644",
-	0x0000FDF7: "\u0639\u0644\u0649o",
-	0x0000FDF8: "\u0648\u0633\u0644\u0645",
-	0x0000FDF9: "\u0635\u0644\u0649",
-	0x0000FDFA: "\u0635\u0644\u0649 l\u0644\u0644o \u0639\u0644\u0649o \u0648\u0633\u0644\u0645",
-	0x0000FDFB: "\u062c\u0644 \u062c\u0644l\u0644o",
-	0x0000FDFC: "\u0631\u0649l\u0644",
-	0x0000FE19: "\u2d57",
-	0x0000FE30: ":",
-	0x0000FE31: "\u2502",
-	0x0000FE34: "\u2307",
-	0x0000FE35: "\u23dc",
-	0x0000FE36: "\u23dd",
-	0x0000FE37: "\u23de",
-	0x0000FE38: "\u23df",
-	0x0000FE39: "\u23e0",
-	0x0000FE3A: "\u23e1",
-	0x0000FE49: "\u02c9",
-	0x0000FE4A: "\u02c9",
-	0x0000FE4B: "\u02c9",
-	0x0000FE4C: "\u02c9",
-	0x0000FE4D: "_",
-	0x0000FE4E: "_",
-	0x0000FE4F: "_",
-	0x0000FE58: "-",
-	0x0000FE68: "\\",
-	0x0000FE80: "\u0621",
-	0x0000FE81: "\u0622",
-	0x0000FE82: "\u0622",
-	0x0000FE83: "l\u0674",
-	0x0000FE84: "l\u0674",
-	0x0000FE85: "\u0648\u0674",
-	0x0000FE86: "\u0648\u0674",
-	0x0000FE87: "l\u0655",
-	0x0000FE88: "l\u0655",
-	0x0000FE89: "\u0649\u0674",
-	0x0000FE8A: "\u0649\u0674",
-	0x0000FE8B: "\u0649\u0674",
-	0x0000FE8C: "\u0649\u0674",
-	0x0000FE8D: "l",
-	0x0000FE8E: "l",
-	0x0000FE8F: "\u0628",
-	0x0000FE90: "\u0628",
-	0x0000FE91: "\u0628",
-	0x0000FE92: "\u0628",
-	0x0000FE93: "\u0629",
-	0x0000FE94: "\u0629",
-	0x0000FE95: "\u062a",
-	0x0000FE96: "\u062a",
-	0x0000FE97: "\u062a",
-	0x0000FE98: "\u062a",
-	0x0000FE99: "\u0649\u06db",
-	0x0000FE9A: "\u0649\u06db",
-	0x0000FE9B: "\u0649\u06db",
-	0x0000FE9C: "\u0649\u06db",
-	0x0000FE9D: "\u062c",
-	0x0000FE9E: "\u062c",
-	0x0000FE9F: "\u062c",
-	0x0000FEA0: "\u062c",
-	0x0000FEA1: "\u062d",
-	0x0000FEA2: "\u062d",
-	0x0000FEA3: "\u062d",
-	0x0000FEA4: "\u062d",
-	0x0000FEA5: "\u062e",
-	0x0000FEA6: "\u062e",
-	0x0000FEA7: "\u062e",
-	0x0000FEA8: "\u062e",
-	0x0000FEA9: "\u062f",
-	0x0000FEAA: "\u062f",
-	0x0000FEAB: "\u0630",
-	0x0000FEAC: "\u0630",
-	0x0000FEAD: "\u0631",
-	0x0000FEAE: "\u0631",
-	0x0000FEAF: "\u0632",
-	0x0000FEB0: "\u0632",
-	0x0000FEB1: "\u0633",
-	0x0000FEB2: "\u0633",
-	0x0000FEB3: "\u0633",
-	0x0000FEB4: "\u0633",
-	0x0000FEB5: "\u0633\u06db",
-	0x0000FEB6: "\u0633\u06db",
-	0x0000FEB7: "\u0633\u06db",
-	0x0000FEB8: "\u0633\u06db",
-	0x0000FEB9: "\u0635",
-	0x0000FEBA: "\u0635",
-	0x0000FEBB: "\u0635",
-	0x0000FEBC: "\u0635",
-	0x0000FEBD: "\u0636",
-	0x0000FEBE: "\u0636",
-	0x0000FEBF: "\u0636",
-	0x0000FEC0: "\u0636",
-	0x0000FEC1: "\u0637",
-	0x0000FEC2: "\u0637",
-	0x0000FEC3: "\u0637",
-	0x0000FEC4: "\u0637",
-	0x0000FEC5: "\u0638",
-	0x0000FEC6: "\u0638",
-	0x0000FEC7: "\u0638",
-	0x0000FEC8: "\u0638",
-	0x0000FEC9: "\u0639",
-	0x0000FECA: "\u0639",
-	0x0000FECB: "\u0639",
-	0x0000FECC: "\u0639",
-	0x0000FECD: "\u063a",
-	0x0000FECE: "\u063a",
-	0x0000FECF: "\u063a",
-	0x0000FED0: "\u063a",
-	0x0000FED1: "\u0641",
-	0x0000FED2: "\u0641",
-	0x0000FED3: "\u0641",
-	0x0000FED4: "\u0641",
-	0x0000FED5: "\u0642",
-	0x0000FED6: "\u0642",
-	0x0000FED7: "\u0642",
-	0x0000FED8: "\u0642",
-	0x0000FED9: "\u0643",
-	0x0000FEDA: "\u0643",
-	0x0000FEDB: "\u0643",
-	0x0000FEDC: "\u0643",
-	0x0000FEDD: "\u0644",
-	0x0000FEDE: "\u0644",
-	0x0000FEDF: "\u0644",
-	0x0000FEE0: "\u0644",
-	0x0000FEE1: "\u0645",
-	0x0000FEE2: "\u0645",
-	0x0000FEE3: "\u0645",
-	0x0000FEE4: "\u0645",
-	0x0000FEE5: "\u0646",
-	0x0000FEE6: "\u0646",
-	0x0000FEE7: "\u0646",
-	0x0000FEE8: "\u0646",
-	0x0000FEE9: "o",
-	0x0000FEEA: "o",
-	0x0000FEEB: "o",
-	0x0000FEEC: "o",
-	0x0000FEED: "\u0648",
-	0x0000FEEE: "\u0648",
-	0x0000FEEF: "\u0649",
-	0x0000FEF0: "\u0649",
-	0x0000FEF1: "\u0649",
-	0x0000FEF2: "\u0649",
-	0x0000FEF3: "\u0649",
-	0x0000FEF4: "\u0649",
-	0x0000FEF5: "\u0644\u0622",
-	0x0000FEF6: "\u0644\u0622",
-	0x0000FEF7: "\u0644l\u0674",
-	0x0000FEF8: "\u0644l\u0674",
-	0x0000FEF9: "\u0644l\u0655",
-	0x0000FEFA: "\u0644l\u0655",
-	0x0000FEFB: "\u0644l",
-	0x0000FEFC: "\u0644l",
-	0x0000FF01: "!",
-	0x0000FF02: "''",
-	0x0000FF07: "'",
-	0x0000FF0D: "\u30fc",
-	0x0000FF1A: ":",
-	0x0000FF21: "A",
-	0x0000FF22: "B",
-	0x0000FF23: "C",
-	0x0000FF25: "E",
-	0x0000FF28: "H",
-	0x0000FF29: "l",
-	0x0000FF2A: "J",
-	0x0000FF2B: "K",
-	0x0000FF2D: "M",
-	0x0000FF2E: "N",
-	0x0000FF2F: "O",
-	0x0000FF30: "P",
-	0x0000FF33: "S",
-	0x0000FF34: "T",
-	0x0000FF38: "X",
-	0x0000FF39: "Y",
-	0x0000FF3A: "Z",
-	0x0000FF3B: "(",
-	0x0000FF3C: "\\",
-	0x0000FF3D: ")",
-	0x0000FF3E: "\ufe3f",
-	0x0000FF40: "'",
-	0x0000FF41: "a",
-	0x0000FF43: "c",
-	0x0000FF45: "e",
-	0x0000FF47: "g",
-	0x0000FF48: "h",
-	0x0000FF49: "i",
-	0x0000FF4A: "j",
-	0x0000FF4C: "l",
-	0x0000FF4F: "o",
-	0x0000FF50: "p",
-	0x0000FF53: "s",
-	0x0000FF56: "v",
-	0x0000FF58: "x",
-	0x0000FF59: "y",
-	0x0000FF5C: "\u2502",
-	0x0000FF5E: "\u301c",
-	0x0000FF65: "\u00b7",
-	0x0000FFE3: "\u02c9",
-	0x0000FFE8: "l",
-	0x0000FFED: "\u25aa",
-	0x00010101: "\u00b7",
-	0x0001018E: "N\u030a",
-	0x00010196: "X\u0335",
-	0x00010197: "V\u0335",
-	0x00010198: "l\u0335l\u0335S\u0335",
-	0x00010199: "l\u0335l\u0335",
-	0x000101A0: "\u2ce8",
-	0x00010282: "B",
-	0x00010285: "\u0394",
-	0x00010286: "E",
-	0x00010287: "F",
-	0x0001028A: "l",
-	0x0001028D: "\u0245",
-	0x00010290: "X",
-	0x00010292: "O",
-	0x00010294: "\u16dc",
-	0x00010295: "P",
-	0x00010296: "S",
-	0x00010297: "T",
-	0x0001029B: "+",
-	0x000102A0: "A",
-	0x000102A1: "B",
-	0x000102A2: "C",
-	0x000102A3: "\u0394",
-	0x000102A5: "F",
-	0x000102AB: "O",
-	0x000102AD: "\u03d8",
-	0x000102B0: "M",
-	0x000102B1: "T",
-	0x000102B2: "Y",
-	0x000102B3: "\u03a6",
-	0x000102B4: "X",
-	0x000102B5: "\u03a8",
-	0x000102B6: "\u03a9",
-	0x000102B8: "\u2d40",
-	0x000102CF: "H",
-	0x000102E1: "\u062f",
-	0x000102E4: "\u0648",
-	0x000102E8: "\u0637",
-	0x000102F2: "\u0635",
-	0x000102F5: "Z",
-	0x00010301: "B",
-	0x00010302: "C",
-	0x00010309: "l",
-	0x00010311: "M",
-	0x00010312: "\u03d8",
-	0x00010315: "T",
-	0x00010317: "X",
-	0x0001031A: "8",
-	0x0001031F: "*",
-	0x00010320: "l",
-	0x00010322: "X",
-	0x000103D1: "\U00010382",
-	0x000103D3: "\U00010393",
-	0x00010401: "\u0190",
-	0x00010404: "O",
-	0x00010411: "\ua4f6",
-	0x00010415: "C",
-	0x0001041B: "L",
-	0x0001041F: "\u2c70",
-	0x00010420: "S",
-	0x00010423: "\u0186",
-	0x00010425: "\u0418",
-	0x00010429: "\ua793",
-	0x0001042A: "\u029a",
-	0x0001042C: "o",
-	0x0001043D: "c",
-	0x0001043F: "\u0277",
-	0x00010442: "\u025e",
-	0x00010443: "\u029f",
-	0x00010448: "s",
-	0x0001044B: "\u0254",
-	0x0001044D: "\u1d0e",
-	0x000104A0: "\U00010486",
-	0x000104B0: "\u0245",
-	0x000104B4: "R",
-	0x000104BC: "\u04c3",
-	0x000104C2: "O",
-	0x000104C3: "\u0298",
-	0x000104C4: "\u00de",
-	0x000104CD: "\u040b",
-	0x000104CE: "U",
-	0x000104D0: "\u16e6",
-	0x000104D1: "\u03a8",
-	0x000104D2: "7",
-	0x000104D8: "\u028c",
-	0x000104DB: "\u03bb",
-	0x000104EA: "o",
-	0x000104EB: "\ua669",
-	0x000104F6: "u",
-	0x000104F9: "\u03c8",
-	0x00010513: "N",
-	0x00010516: "O",
-	0x00010518: "K",
-	0x0001051C: "C",
-	0x0001051D: "V",
-	0x00010525: "F",
-	0x00010526: "L",
-	0x00010527: "X",
-	0x00010A3A: "\u0323",
-	0x00010A50: ".",
-	0x00010A57: "\U00010a56\U00010a56",
-	0x00010CFA: "\U00010ca5",
-	0x00010CFC: "\U00010c82",
-	0x000110BB: "\u0970",
-	0x000111C7: "\u0970",
-	0x000111CA: "\u0323",
-	0x000111CB: "\u093a",
-	0x000111DB: "\ua8fc",
-	0x000111DC: "\ua8fb",
-	0x000111DE: "\u2248",
-	0x00011300: "\u030a",
-	0x00011413: "\U00011434\U00011442\U00011412",
-	0x00011419: "\U00011434\U00011442\U00011418",
-	0x00011424: "\U00011434\U00011442\U00011423",
-	0x0001142A: "\U00011434\U00011442\U00011429",
-	0x0001142D: "\U00011434\U00011442\U0001142c",
-	0x0001142F: "\U00011434\U00011442\U0001142e",
-	0x0001144C: "\U0001144b\U0001144b",
-	0x00011492: "\u0998",
-	0x00011494: "\u099a",
-	0x00011496: "\u099c",
-	0x00011498: "\u099e",
-	0x00011499: "\u099f",
-	0x0001149B: "\u09a1",
-	0x0001149D: "\u09b2",
-	0x0001149E: "\u09a4",
-	0x0001149F: "\u09a5",
-	0x000114A0: "\u09a6",
-	0x000114A1: "\u09a7",
-	0x000114A2: "\u09a8",
-	0x000114A3: "\u09aa",
-	0x000114A7: "\u09ae",
-	0x000114A8: "\u09af",
-	0x000114A9: "\u09ac",
-	0x000114AA: "\u09a3",
-	0x000114AB: "\u09b0",
-	0x000114AD: "\u09b7",
-	0x000114AE: "\u09b8",
-	0x000114B0: "\u09be",
-	0x000114B1: "\u09bf",
-	0x000114B9: "\u09c7",
-	0x000114BC: "\u09cb",
-	0x000114BD: "\u09d7",
-	0x000114BE: "\u09cc",
-	0x000114BF: "\u0306\u0307",
-	0x000114C1: "\u0983",
-	0x000114C2: "\u09cd",
-	0x000114C3: "\u0323",
-	0x000114C4: "\u09bd",
-	0x000114C5: "w\u0307",
-	0x000114D0: "O",
-	0x000114D1: "\u09e7",
-	0x000114D2: "\u09e8",
-	0x000114D6: "\u09ec",
-	0x000115D8: "\U00011582",
-	0x000115D9: "\U00011582",
-	0x000115DA: "\U00011583",
-	0x000115DB: "\U00011584",
-	0x000115DC: "\U000115b2",
-	0x000115DD: "\U000115b3",
-	0x00011642: "\U00011641\U00011641",
-	0x00011700: "rn",
-	0x00011706: "v",
-	0x0001170A: "w",
-	0x0001170E: "w",
-	0x0001170F: "w",
-	0x000118A0: "V",
-	0x000118A2: "F",
-	0x000118A3: "L",
-	0x000118A4: "Y",
-	0x000118A6: "E",
-	0x000118A8: "\u2207",
-	0x000118A9: "Z",
-	0x000118AC: "9",
-	0x000118AE: "E",
-	0x000118AF: "4",
-	0x000118B2: "L",
-	0x000118B5: "O",
-	0x000118B7: "\u16dc",
-	0x000118B8: "U",
-	0x000118BB: "5",
-	0x000118BC: "T",
-	0x000118C0: "v",
-	0x000118C1: "s",
-	0x000118C2: "F",
-	0x000118C3: "i",
-	0x000118C4: "z",
-	0x000118C6: "7",
-	0x000118C8: "o",
-	0x000118CA: "3",
-	0x000118CC: "9",
-	0x000118CE: "\ua793",
-	0x000118D5: "6",
-	0x000118D6: "9",
-	0x000118D7: "o",
-	0x000118D8: "u",
-	0x000118DC: "y",
-	0x000118E0: "O",
-	0x000118E3: "rn",
-	0x000118E4: "\u0669",
-	0x000118E5: "Z",
-	0x000118E6: "W",
-	0x000118E9: "C",
-	0x000118EC: "X",
-	0x000118EF: "W",
-	0x000118F2: "C",
-	0x00011AE6: "\U00011ae5\U00011aef",
-	0x00011AE7: "\U00011ae5\U00011af0",
-	0x00011AE8: "\U00011ae5\U00011ae5",
-	0x00011AE9: "\U00011ae5\U00011ae5\U00011aef",
-	0x00011AEA: "\U00011ae5\U00011ae5\U00011af0",
-	0x00011AEC: "\U00011aeb\U00011aef",
-	0x00011AED: "\U00011aeb\U00011aeb",
-	0x00011AEE: "\U00011aeb\U00011aeb\U00011aef",
-	0x00011AF4: "\U00011af3\U00011aef",
-	0x00011AF5: "\U00011af3\U00011af0",
-	0x00011AF6: "\U00011af3\U00011af3",
-	0x00011AF7: "\U00011af3\U00011af3\U00011aef",
-	0x00011AF8: "\U00011af3\U00011af3\U00011af0",
-	0x00011C42: "\U00011c41\U00011c41",
-	0x00011CB2: "\U00011caa",
-	0x00012038: "\U0001039a",
-	0x000132F9: "\U0001099e",
-	0x00016F07: "\u0393",
-	0x00016F08: "V",
-	0x00016F0A: "T",
-	0x00016F16: "L",
-	0x00016F1A: "\u0394",
-	0x00016F1C: "\ua658",
-	0x00016F26: "\ua4f6",
-	0x00016F28: "l",
-	0x00016F2D: "\u0190",
-	0x00016F35: "R",
-	0x00016F3A: "S",
-	0x00016F3B: "3",
-	0x00016F3D: "\u0245",
-	0x00016F3F: ">",
-	0x00016F40: "A",
-	0x00016F42: "U",
-	0x00016F43: "Y",
-	0x00016F51: "'",
-	0x00016F52: "'",
-	0x0001CCD6: "A",
-	0x0001CCD7: "B",
-	0x0001CCD8: "C",
-	0x0001CCD9: "D",
-	0x0001CCDA: "E",
-	0x0001CCDB: "F",
-	0x0001CCDC: "G",
-	0x0001CCDD: "H",
-	0x0001CCDE: "l",
-	0x0001CCDF: "J",
-	0x0001CCE0: "K",
-	0x0001CCE1: "L",
-	0x0001CCE2: "M",
-	0x0001CCE3: "N",
-	0x0001CCE4: "O",
-	0x0001CCE5: "P",
-	0x0001CCE6: "Q",
-	0x0001CCE7: "R",
-	0x0001CCE8: "S",
-	0x0001CCE9: "T",
-	0x0001CCEA: "U",
-	0x0001CCEB: "V",
-	0x0001CCEC: "W",
-	0x0001CCED: "X",
-	0x0001CCEE: "Y",
-	0x0001CCEF: "Z",
-	0x0001CCF0: "O",
-	0x0001CCF1: "l",
-	0x0001CCF2: "2",
-	0x0001CCF3: "3",
-	0x0001CCF4: "4",
-	0x0001CCF5: "5",
-	0x0001CCF6: "6",
-	0x0001CCF7: "7",
-	0x0001CCF8: "8",
-	0x0001CCF9: "9",
-	0x0001D114: "{",
-	0x0001D16D: ".",
-	0x0001D202: "\u04fe",
-	0x0001D206: "3",
-	0x0001D20B: "\u0418",
-	0x0001D20D: "V",
-	0x0001D20F: "\\",
-	0x0001D212: "7",
-	0x0001D213: "F",
-	0x0001D214: "\U000102bc",
-	0x0001D215: "\ua4f6",
-	0x0001D216: "R",
-	0x0001D217: "\u2c6f",
-	0x0001D21A: "O\u0335",
-	0x0001D21B: "\u2144",
-	0x0001D21C: "\ua4d5",
-	0x0001D221: "\u0190",
-	0x0001D222: "\u0460",
-	0x0001D22A: "L",
-	0x0001D22B: "\ua4f6",
-	0x0001D230: "\ua7fb",
-	0x0001D236: "<",
-	0x0001D237: ">",
-	0x0001D238: "\u228f",
-	0x0001D239: "\u2290",
-	0x0001D23A: "/",
-	0x0001D23B: "\\",
-	0x0001D23F: "\u16cb",
-	0x0001D245: "\u0548",
-	0x0001D400: "A",
-	0x0001D401: "B",
-	0x0001D402: "C",
-	0x0001D403: "D",
-	0x0001D404: "E",
-	0x0001D405: "F",
-	0x0001D406: "G",
-	0x0001D407: "H",
-	0x0001D408: "l",
-	0x0001D409: "J",
-	0x0001D40A: "K",
-	0x0001D40B: "L",
-	0x0001D40C: "M",
-	0x0001D40D: "N",
-	0x0001D40E: "O",
-	0x0001D40F: "P",
-	0x0001D410: "Q",
-	0x0001D411: "R",
-	0x0001D412: "S",
-	0x0001D413: "T",
-	0x0001D414: "U",
-	0x0001D415: "V",
-	0x0001D416: "W",
-	0x0001D417: "X",
-	0x0001D418: "Y",
-	0x0001D419: "Z",
-	0x0001D41A: "a",
-	0x0001D41B: "b",
-	0x0001D41C: "c",
-	0x0001D41D: "d",
-	0x0001D41E: "e",
-	0x0001D41F: "f",
-	0x0001D420: "g",
-	0x0001D421: "h",
-	0x0001D422: "i",
-	0x0001D423: "j",
-	0x0001D424: "k",
-	0x0001D425: "l",
-	0x0001D426: "rn",
-	0x0001D427: "n",
-	0x0001D428: "o",
-	0x0001D429: "p",
-	0x0001D42A: "q",
-	0x0001D42B: "r",
-	0x0001D42C: "s",
-	0x0001D42D: "t",
-	0x0001D42E: "u",
-	0x0001D42F: "v",
-	0x0001D430: "w",
-	0x0001D431: "x",
-	0x0001D432: "y",
-	0x0001D433: "z",
-	0x0001D434: "A",
-	0x0001D435: "B",
-	0x0001D436: "C",
-	0x0001D437: "D",
-	0x0001D438: "E",
-	0x0001D439: "F",
-	0x0001D43A: "G",
-	0x0001D43B: "H",
-	0x0001D43C: "l",
-	0x0001D43D: "J",
-	0x0001D43E: "K",
-	0x0001D43F: "L",
-	0x0001D440: "M",
-	0x0001D441: "N",
-	0x0001D442: "O",
-	0x0001D443: "P",
-	0x0001D444: "Q",
-	0x0001D445: "R",
-	0x0001D446: "S",
-	0x0001D447: "T",
-	0x0001D448: "U",
-	0x0001D449: "V",
-	0x0001D44A: "W",
-	0x0001D44B: "X",
-	0x0001D44C: "Y",
-	0x0001D44D: "Z",
-	0x0001D44E: "a",
-	0x0001D44F: "b",
-	0x0001D450: "c",
-	0x0001D451: "d",
-	0x0001D452: "e",
-	0x0001D453: "f",
-	0x0001D454: "g",
-	0x0001D456: "i",
-	0x0001D457: "j",
-	0x0001D458: "k",
-	0x0001D459: "l",
-	0x0001D45A: "rn",
-	0x0001D45B: "n",
-	0x0001D45C: "o",
-	0x0001D45D: "p",
-	0x0001D45E: "q",
-	0x0001D45F: "r",
-	0x0001D460: "s",
-	0x0001D461: "t",
-	0x0001D462: "u",
-	0x0001D463: "v",
-	0x0001D464: "w",
-	0x0001D465: "x",
-	0x0001D466: "y",
-	0x0001D467: "z",
-	0x0001D468: "A",
-	0x0001D469: "B",
-	0x0001D46A: "C",
-	0x0001D46B: "D",
-	0x0001D46C: "E",
-	0x0001D46D: "F",
-	0x0001D46E: "G",
-	0x0001D46F: "H",
-	0x0001D470: "l",
-	0x0001D471: "J",
-	0x0001D472: "K",
-	0x0001D473: "L",
-	0x0001D474: "M",
-	0x0001D475: "N",
-	0x0001D476: "O",
-	0x0001D477: "P",
-	0x0001D478: "Q",
-	0x0001D479: "R",
-	0x0001D47A: "S",
-	0x0001D47B: "T",
-	0x0001D47C: "U",
-	0x0001D47D: "V",
-	0x0001D47E: "W",
-	0x0001D47F: "X",
-	0x0001D480: "Y",
-	0x0001D481: "Z",
-	0x0001D482: "a",
-	0x0001D483: "b",
-	0x0001D484: "c",
-	0x0001D485: "d",
-	0x0001D486: "e",
-	0x0001D487: "f",
-	0x0001D488: "g",
-	0x0001D489: "h",
-	0x0001D48A: "i",
-	0x0001D48B: "j",
-	0x0001D48C: "k",
-	0x0001D48D: "l",
-	0x0001D48E: "rn",
-	0x0001D48F: "n",
-	0x0001D490: "o",
-	0x0001D491: "p",
-	0x0001D492: "q",
-	0x0001D493: "r",
-	0x0001D494: "s",
-	0x0001D495: "t",
-	0x0001D496: "u",
-	0x0001D497: "v",
-	0x0001D498: "w",
-	0x0001D499: "x",
-	0x0001D49A: "y",
-	0x0001D49B: "z",
-	0x0001D49C: "A",
-	0x0001D49E: "C",
-	0x0001D49F: "D",
-	0x0001D4A2: "G",
-	0x0001D4A5: "J",
-	0x0001D4A6: "K",
-	0x0001D4A9: "N",
-	0x0001D4AA: "O",
-	0x0001D4AB: "P",
-	0x0001D4AC: "Q",
-	0x0001D4AE: "S",
-	0x0001D4AF: "T",
-	0x0001D4B0: "U",
-	0x0001D4B1: "V",
-	0x0001D4B2: "W",
-	0x0001D4B3: "X",
-	0x0001D4B4: "Y",
-	0x0001D4B5: "Z",
-	0x0001D4B6: "a",
-	0x0001D4B7: "b",
-	0x0001D4B8: "c",
-	0x0001D4B9: "d",
-	0x0001D4BB: "f",
-	0x0001D4BD: "h",
-	0x0001D4BE: "i",
-	0x0001D4BF: "j",
-	0x0001D4C0: "k",
-	0x0001D4C1: "l",
-	0x0001D4C2: "rn",
-	0x0001D4C3: "n",
-	0x0001D4C5: "p",
-	0x0001D4C6: "q",
-	0x0001D4C7: "r",
-	0x0001D4C8: "s",
-	0x0001D4C9: "t",
-	0x0001D4CA: "u",
-	0x0001D4CB: "v",
-	0x0001D4CC: "w",
-	0x0001D4CD: "x",
-	0x0001D4CE: "y",
-	0x0001D4CF: "z",
-	0x0001D4D0: "A",
-	0x0001D4D1: "B",
-	0x0001D4D2: "C",
-	0x0001D4D3: "D",
-	0x0001D4D4: "E",
-	0x0001D4D5: "F",
-	0x0001D4D6: "G",
-	0x0001D4D7: "H",
-	0x0001D4D8: "l",
-	0x0001D4D9: "J",
-	0x0001D4DA: "K",
-	0x0001D4DB: "L",
-	0x0001D4DC: "M",
-	0x0001D4DD: "N",
-	0x0001D4DE: "O",
-	0x0001D4DF: "P",
-	0x0001D4E0: "Q",
-	0x0001D4E1: "R",
-	0x0001D4E2: "S",
-	0x0001D4E3: "T",
-	0x0001D4E4: "U",
-	0x0001D4E5: "V",
-	0x0001D4E6: "W",
-	0x0001D4E7: "X",
-	0x0001D4E8: "Y",
-	0x0001D4E9: "Z",
-	0x0001D4EA: "a",
-	0x0001D4EB: "b",
-	0x0001D4EC: "c",
-	0x0001D4ED: "d",
-	0x0001D4EE: "e",
-	0x0001D4EF: "f",
-	0x0001D4F0: "g",
-	0x0001D4F1: "h",
-	0x0001D4F2: "i",
-	0x0001D4F3: "j",
-	0x0001D4F4: "k",
-	0x0001D4F5: "l",
-	0x0001D4F6: "rn",
-	0x0001D4F7: "n",
-	0x0001D4F8: "o",
-	0x0001D4F9: "p",
-	0x0001D4FA: "q",
-	0x0001D4FB: "r",
-	0x0001D4FC: "s",
-	0x0001D4FD: "t",
-	0x0001D4FE: "u",
-	0x0001D4FF: "v",
-	0x0001D500: "w",
-	0x0001D501: "x",
-	0x0001D502: "y",
-	0x0001D503: "z",
-	0x0001D504: "A",
-	0x0001D505: "B",
-	0x0001D507: "D",
-	0x0001D508: "E",
-	0x0001D509: "F",
-	0x0001D50A: "G",
-	0x0001D50D: "J",
-	0x0001D50E: "K",
-	0x0001D50F: "L",
-	0x0001D510: "M",
-	0x0001D511: "N",
-	0x0001D512: "O",
-	0x0001D513: "P",
-	0x0001D514: "Q",
-	0x0001D516: "S",
-	0x0001D517: "T",
-	0x0001D518: "U",
-	0x0001D519: "V",
-	0x0001D51A: "W",
-	0x0001D51B: "X",
-	0x0001D51C: "Y",
-	0x0001D51E: "a",
-	0x0001D51F: "b",
-	0x0001D520: "c",
-	0x0001D521: "d",
-	0x0001D522: "e",
-	0x0001D523: "f",
-	0x0001D524: "g",
-	0x0001D525: "h",
-	0x0001D526: "i",
-	0x0001D527: "j",
-	0x0001D528: "k",
-	0x0001D529: "l",
-	0x0001D52A: "rn",
-	0x0001D52B: "n",
-	0x0001D52C: "o",
-	0x0001D52D: "p",
-	0x0001D52E: "q",
-	0x0001D52F: "r",
-	0x0001D530: "s",
-	0x0001D531: "t",
-	0x0001D532: "u",
-	0x0001D533: "v",
-	0x0001D534: "w",
-	0x0001D535: "x",
-	0x0001D536: "y",
-	0x0001D537: "z",
-	0x0001D538: "A",
-	0x0001D539: "B",
-	0x0001D53B: "D",
-	0x0001D53C: "E",
-	0x0001D53D: "F",
-	0x0001D53E: "G",
-	0x0001D540: "l",
-	0x0001D541: "J",
-	0x0001D542: "K",
-	0x0001D543: "L",
-	0x0001D544: "M",
-	0x0001D546: "O",
-	0x0001D54A: "S",
-	0x0001D54B: "T",
-	0x0001D54C: "U",
-	0x0001D54D: "V",
-	0x0001D54E: "W",
-	0x0001D54F: "X",
-	0x0001D550: "Y",
-	0x0001D552: "a",
-	0x0001D553: "b",
-	0x0001D554: "c",
-	0x0001D555: "d",
-	0x0001D556: "e",
-	0x0001D557: "f",
-	0x0001D558: "g",
-	0x0001D559: "h",
-	0x0001D55A: "i",
-	0x0001D55B: "j",
-	0x0001D55C: "k",
-	0x0001D55D: "l",
-	0x0001D55E: "rn",
-	0x0001D55F: "n",
-	0x0001D560: "o",
-	0x0001D561: "p",
-	0x0001D562: "q",
-	0x0001D563: "r",
-	0x0001D564: "s",
-	0x0001D565: "t",
-	0x0001D566: "u",
-	0x0001D567: "v",
-	0x0001D568: "w",
-	0x0001D569: "x",
-	0x0001D56A: "y",
-	0x0001D56B: "z",
-	0x0001D56C: "A",
-	0x0001D56D: "B",
-	0x0001D56E: "C",
-	0x0001D56F: "D",
-	0x0001D570: "E",
-	0x0001D571: "F",
-	0x0001D572: "G",
-	0x0001D573: "H",
-	0x0001D574: "l",
-	0x0001D575: "J",
-	0x0001D576: "K",
-	0x0001D577: "L",
-	0x0001D578: "M",
-	0x0001D579: "N",
-	0x0001D57A: "O",
-	0x0001D57B: "P",
-	0x0001D57C: "Q",
-	0x0001D57D: "R",
-	0x0001D57E: "S",
-	0x0001D57F: "T",
-	0x0001D580: "U",
-	0x0001D581: "V",
-	0x0001D582: "W",
-	0x0001D583: "X",
-	0x0001D584: "Y",
-	0x0001D585: "Z",
-	0x0001D586: "a",
-	0x0001D587: "b",
-	0x0001D588: "c",
-	0x0001D589: "d",
-	0x0001D58A: "e",
-	0x0001D58B: "f",
-	0x0001D58C: "g",
-	0x0001D58D: "h",
-	0x0001D58E: "i",
-	0x0001D58F: "j",
-	0x0001D590: "k",
-	0x0001D591: "l",
-	0x0001D592: "rn",
-	0x0001D593: "n",
-	0x0001D594: "o",
-	0x0001D595: "p",
-	0x0001D596: "q",
-	0x0001D597: "r",
-	0x0001D598: "s",
-	0x0001D599: "t",
-	0x0001D59A: "u",
-	0x0001D59B: "v",
-	0x0001D59C: "w",
-	0x0001D59D: "x",
-	0x0001D59E: "y",
-	0x0001D59F: "z",
-	0x0001D5A0: "A",
-	0x0001D5A1: "B",
-	0x0001D5A2: "C",
-	0x0001D5A3: "D",
-	0x0001D5A4: "E",
-	0x0001D5A5: "F",
-	0x0001D5A6: "G",
-	0x0001D5A7: "H",
-	0x0001D5A8: "l",
-	0x0001D5A9: "J",
-	0x0001D5AA: "K",
-	0x0001D5AB: "L",
-	0x0001D5AC: "M",
-	0x0001D5AD: "N",
-	0x0001D5AE: "O",
-	0x0001D5AF: "P",
-	0x0001D5B0: "Q",
-	0x0001D5B1: "R",
-	0x0001D5B2: "S",
-	0x0001D5B3: "T",
-	0x0001D5B4: "U",
-	0x0001D5B5: "V",
-	0x0001D5B6: "W",
-	0x0001D5B7: "X",
-	0x0001D5B8: "Y",
-	0x0001D5B9: "Z",
-	0x0001D5BA: "a",
-	0x0001D5BB: "b",
-	0x0001D5BC: "c",
-	0x0001D5BD: "d",
-	0x0001D5BE: "e",
-	0x0001D5BF: "f",
-	0x0001D5C0: "g",
-	0x0001D5C1: "h",
-	0x0001D5C2: "i",
-	0x0001D5C3: "j",
-	0x0001D5C4: "k",
-	0x0001D5C5: "l",
-	0x0001D5C6: "rn",
-	0x0001D5C7: "n",
-	0x0001D5C8: "o",
-	0x0001D5C9: "p",
-	0x0001D5CA: "q",
-	0x0001D5CB: "r",
-	0x0001D5CC: "s",
-	0x0001D5CD: "t",
-	0x0001D5CE: "u",
-	0x0001D5CF: "v",
-	0x0001D5D0: "w",
-	0x0001D5D1: "x",
-	0x0001D5D2: "y",
-	0x0001D5D3: "z",
-	0x0001D5D4: "A",
-	0x0001D5D5: "B",
-	0x0001D5D6: "C",
-	0x0001D5D7: "D",
-	0x0001D5D8: "E",
-	0x0001D5D9: "F",
-	0x0001D5DA: "G",
-	0x0001D5DB: "H",
-	0x0001D5DC: "l",
-	0x0001D5DD: "J",
-	0x0001D5DE: "K",
-	0x0001D5DF: "L",
-	0x0001D5E0: "M",
-	0x0001D5E1: "N",
-	0x0001D5E2: "O",
-	0x0001D5E3: "P",
-	0x0001D5E4: "Q",
-	0x0001D5E5: "R",
-	0x0001D5E6: "S",
-	0x0001D5E7: "T",
-	0x0001D5E8: "U",
-	0x0001D5E9: "V",
-	0x0001D5EA: "W",
-	0x0001D5EB: "X",
-	0x0001D5EC: "Y",
-	0x0001D5ED: "Z",
-	0x0001D5EE: "a",
-	0x0001D5EF: "b",
-	0x0001D5F0: "c",
-	0x0001D5F1: "d",
-	0x0001D5F2: "e",
-	0x0001D5F3: "f",
-	0x0001D5F4: "g",
-	0x0001D5F5: "h",
-	0x0001D5F6: "i",
-	0x0001D5F7: "j",
-	0x0001D5F8: "k",
-	0x0001D5F9: "l",
-	0x0001D5FA: "rn",
-	0x0001D5FB: "n",
-	0x0001D5FC: "o",
-	0x0001D5FD: "p",
-	0x0001D5FE: "q",
-	0x0001D5FF: "r",
-	0x0001D600: "s",
-	0x0001D601: "t",
-	0x0001D602: "u",
-	0x0001D603: "v",
-	0x0001D604: "w",
-	0x0001D605: "x",
-	0x0001D606: "y",
-	0x0001D607: "z",
-	0x0001D608: "A",
-	0x0001D609: "B",
-	0x0001D60A: "C",
-	0x0001D60B: "D",
-	0x0001D60C: "E",
-	0x0001D60D: "F",
-	0x0001D60E: "G",
-	0x0001D60F: "H",
-	0x0001D610: "l",
-	0x0001D611: "J",
-	0x0001D612: "K",
-	0x0001D613: "L",
-	0x0001D614: "M",
-	0x0001D615: "N",
-	0x0001D616: "O",
-	0x0001D617: "P",
-	0x0001D618: "Q",
-	0x0001D619: "R",
-	0x0001D61A: "S",
-	0x0001D61B: "T",
-	0x0001D61C: "U",
-	0x0001D61D: "V",
-	0x0001D61E: "W",
-	0x0001D61F: "X",
-	0x0001D620: "Y",
-	0x0001D621: "Z",
-	0x0001D622: "a",
-	0x0001D623: "b",
-	0x0001D624: "c",
-	0x0001D625: "d",
-	0x0001D626: "e",
-	0x0001D627: "f",
-	0x0001D628: "g",
-	0x0001D629: "h",
-	0x0001D62A: "i",
-	0x0001D62B: "j",
-	0x0001D62C: "k",
-	0x0001D62D: "l",
-	0x0001D62E: "rn",
-	0x0001D62F: "n",
-	0x0001D630: "o",
-	0x0001D631: "p",
-	0x0001D632: "q",
-	0x0001D633: "r",
-	0x0001D634: "s",
-	0x0001D635: "t",
-	0x0001D636: "u",
-	0x0001D637: "v",
-	0x0001D638: "w",
-	0x0001D639: "x",
-	0x0001D63A: "y",
-	0x0001D63B: "z",
-	0x0001D63C: "A",
-	0x0001D63D: "B",
-	0x0001D63E: "C",
-	0x0001D63F: "D",
-	0x0001D640: "E",
-	0x0001D641: "F",
-	0x0001D642: "G",
-	0x0001D643: "H",
-	0x0001D644: "l",
-	0x0001D645: "J",
-	0x0001D646: "K",
-	0x0001D647: "L",
-	0x0001D648: "M",
-	0x0001D649: "N",
-	0x0001D64A: "O",
-	0x0001D64B: "P",
-	0x0001D64C: "Q",
-	0x0001D64D: "R",
-	0x0001D64E: "S",
-	0x0001D64F: "T",
-	0x0001D650: "U",
-	0x0001D651: "V",
-	0x0001D652: "W",
-	0x0001D653: "X",
-	0x0001D654: "Y",
-	0x0001D655: "Z",
-	0x0001D656: "a",
-	0x0001D657: "b",
-	0x0001D658: "c",
-	0x0001D659: "d",
-	0x0001D65A: "e",
-	0x0001D65B: "f",
-	0x0001D65C: "g",
-	0x0001D65D: "h",
-	0x0001D65E: "i",
-	0x0001D65F: "j",
-	0x0001D660: "k",
-	0x0001D661: "l",
-	0x0001D662: "rn",
-	0x0001D663: "n",
-	0x0001D664: "o",
-	0x0001D665: "p",
-	0x0001D666: "q",
-	0x0001D667: "r",
-	0x0001D668: "s",
-	0x0001D669: "t",
-	0x0001D66A: "u",
-	0x0001D66B: "v",
-	0x0001D66C: "w",
-	0x0001D66D: "x",
-	0x0001D66E: "y",
-	0x0001D66F: "z",
-	0x0001D670: "A",
-	0x0001D671: "B",
-	0x0001D672: "C",
-	0x0001D673: "D",
-	0x0001D674: "E",
-	0x0001D675: "F",
-	0x0001D676: "G",
-	0x0001D677: "H",
-	0x0001D678: "l",
-	0x0001D679: "J",
-	0x0001D67A: "K",
-	0x0001D67B: "L",
-	0x0001D67C: "M",
-	0x0001D67D: "N",
-	0x0001D67E: "O",
-	0x0001D67F: "P",
-	0x0001D680: "Q",
-	0x0001D681: "R",
-	0x0001D682: "S",
-	0x0001D683: "T",
-	0x0001D684: "U",
-	0x0001D685: "V",
-	0x0001D686: "W",
-	0x0001D687: "X",
-	0x0001D688: "Y",
-	0x0001D689: "Z",
-	0x0001D68A: "a",
-	0x0001D68B: "b",
-	0x0001D68C: "c",
-	0x0001D68D: "d",
-	0x0001D68E: "e",
-	0x0001D68F: "f",
-	0x0001D690: "g",
-	0x0001D691: "h",
-	0x0001D692: "i",
-	0x0001D693: "j",
-	0x0001D694: "k",
-	0x0001D695: "l",
-	0x0001D696: "rn",
-	0x0001D697: "n",
-	0x0001D698: "o",
-	0x0001D699: "p",
-	0x0001D69A: "q",
-	0x0001D69B: "r",
-	0x0001D69C: "s",
-	0x0001D69D: "t",
-	0x0001D69E: "u",
-	0x0001D69F: "v",
-	0x0001D6A0: "w",
-	0x0001D6A1: "x",
-	0x0001D6A2: "y",
-	0x0001D6A3: "z",
-	0x0001D6A4: "i",
-	0x0001D6A5: "\u0237",
-	0x0001D6A8: "A",
-	0x0001D6A9: "B",
-	0x0001D6AA: "\u0393",
-	0x0001D6AB: "\u0394",
-	0x0001D6AC: "E",
-	0x0001D6AD: "Z",
-	0x0001D6AE: "H",
-	0x0001D6AF: "O\u0335",
-	0x0001D6B0: "l",
-	0x0001D6B1: "K",
-	0x0001D6B2: "\u0245",
-	0x0001D6B3: "M",
-	0x0001D6B4: "N",
-	0x0001D6B5: "\u039e",
-	0x0001D6B6: "O",
-	0x0001D6B7: "\u03a0",
-	0x0001D6B8: "P",
-	0x0001D6B9: "O\u0335",
-	0x0001D6BA: "\u01a9",
-	0x0001D6BB: "T",
-	0x0001D6BC: "Y",
-	0x0001D6BD: "\u03a6",
-	0x0001D6BE: "X",
-	0x0001D6BF: "\u03a8",
-	0x0001D6C0: "\u03a9",
-	0x0001D6C1: "\u2207",
-	0x0001D6C2: "a",
-	0x0001D6C3: "\u00df",
-	0x0001D6C4: "y",
-	0x0001D6C5: "\u1e9f",
-	0x0001D6C6: "\ua793",
-	0x0001D6C7: "\u03b6",
-	0x0001D6C8: "n\u0329",
-	0x0001D6C9: "O\u0335",
-	0x0001D6CA: "i",
-	0x0001D6CB: "\u0138",
-	0x0001D6CC: "\u03bb",
-	0x0001D6CD: "\u03bc",
-	0x0001D6CE: "v",
-	0x0001D6CF: "\u03be",
-	0x0001D6D0: "o",
-	0x0001D6D1: "\u03c0",
-	0x0001D6D2: "p",
-	0x0001D6D3: "\u03c2",
-	0x0001D6D4: "o",
-	0x0001D6D5: "\u1d1b",
-	0x0001D6D6: "u",
-	0x0001D6D7: "\u0278",
-	0x0001D6D8: "\u03c7",
-	0x0001D6D9: "\u03c8",
-	0x0001D6DA: "\u03c9",
-	0x0001D6DB: "\u2202",
-	0x0001D6DC: "\ua793",
-	0x0001D6DD: "O\u0335",
-	0x0001D6DE: "\u0138",
-	0x0001D6DF: "\u0278",
-	0x0001D6E0: "p",
-	0x0001D6E1: "\u03c0",
-	0x0001D6E2: "A",
-	0x0001D6E3: "B",
-	0x0001D6E4: "\u0393",
-	0x0001D6E5: "\u0394",
-	0x0001D6E6: "E",
-	0x0001D6E7: "Z",
-	0x0001D6E8: "H",
-	0x0001D6E9: "O\u0335",
-	0x0001D6EA: "l",
-	0x0001D6EB: "K",
-	0x0001D6EC: "\u0245",
-	0x0001D6ED: "M",
-	0x0001D6EE: "N",
-	0x0001D6EF: "\u039e",
-	0x0001D6F0: "O",
-	0x0001D6F1: "\u03a0",
-	0x0001D6F2: "P",
-	0x0001D6F3: "O\u0335",
-	0x0001D6F4: "\u01a9",
-	0x0001D6F5: "T",
-	0x0001D6F6: "Y",
-	0x0001D6F7: "\u03a6",
-	0x0001D6F8: "X",
-	0x0001D6F9: "\u03a8",
-	0x0001D6FA: "\u03a9",
-	0x0001D6FB: "\u2207",
-	0x0001D6FC: "a",
-	0x0001D6FD: "\u00df",
-	0x0001D6FE: "y",
-	0x0001D6FF: "\u1e9f",
-	0x0001D700: "\ua793",
-	0x0001D701: "\u03b6",
-	0x0001D702: "n\u0329",
-	0x0001D703: "O\u0335",
-	0x0001D704: "i",
-	0x0001D705: "\u0138",
-	0x0001D706: "\u03bb",
-	0x0001D707: "\u03bc",
-	0x0001D708: "v",
-	0x0001D709: "\u03be",
-	0x0001D70A: "o",
-	0x0001D70B: "\u03c0",
-	0x0001D70C: "p",
-	0x0001D70D: "\u03c2",
-	0x0001D70E: "o",
-	0x0001D70F: "\u1d1b",
-	0x0001D710: "u",
-	0x0001D711: "\u0278",
-	0x0001D712: "\u03c7",
-	0x0001D713: "\u03c8",
-	0x0001D714: "\u03c9",
-	0x0001D715: "\u2202",
-	0x0001D716: "\ua793",
-	0x0001D717: "O\u0335",
-	0x0001D718: "\u0138",
-	0x0001D719: "\u0278",
-	0x0001D71A: "p",
-	0x0001D71B: "\u03c0",
-	0x0001D71C: "A",
-	0x0001D71D: "B",
-	0x0001D71E: "\u0393",
-	0x0001D71F: "\u0394",
-	0x0001D720: "E",
-	0x0001D721: "Z",
-	0x0001D722: "H",
-	0x0001D723: "O\u0335",
-	0x0001D724: "l",
-	0x0001D725: "K",
-	0x0001D726: "\u0245",
-	0x0001D727: "M",
-	0x0001D728: "N",
-	0x0001D729: "\u039e",
-	0x0001D72A: "O",
-	0x0001D72B: "\u03a0",
-	0x0001D72C: "P",
-	0x0001D72D: "O\u0335",
-	0x0001D72E: "\u01a9",
-	0x0001D72F: "T",
-	0x0001D730: "Y",
-	0x0001D731: "\u03a6",
-	0x0001D732: "X",
-	0x0001D733: "\u03a8",
-	0x0001D734: "\u03a9",
-	0x0001D735: "\u2207",
-	0x0001D736: "a",
-	0x0001D737: "\u00df",
-	0x0001D738: "y",
-	0x0001D739: "\u1e9f",
-	0x0001D73A: "\ua793",
-	0x0001D73B: "\u03b6",
-	0x0001D73C: "n\u0329",
-	0x0001D73D: "O\u0335",
-	0x0001D73E: "i",
-	0x0001D73F: "\u0138",
-	0x0001D740: "\u03bb",
-	0x0001D741: "\u03bc",
-	0x0001D742: "v",
-	0x0001D743: "\u03be",
-	0x0001D744: "o",
-	0x0001D745: "\u03c0",
-	0x0001D746: "p",
-	0x0001D747: "\u03c2",
-	0x0001D748: "o",
-	0x0001D749: "\u1d1b",
-	0x0001D74A: "u",
-	0x0001D74B: "\u0278",
-	0x0001D74C: "\u03c7",
-	0x0001D74D: "\u03c8",
-	0x0001D74E: "\u03c9",
-	0x0001D74F: "\u2202",
-	0x0001D750: "\ua793",
-	0x0001D751: "O\u0335",
-	0x0001D752: "\u0138",
-	0x0001D753: "\u0278",
-	0x0001D754: "p",
-	0x0001D755: "\u03c0",
-	0x0001D756: "A",
-	0x0001D757: "B",
-	0x0001D758: "\u0393",
-	0x0001D759: "\u0394",
-	0x0001D75A: "E",
-	0x0001D75B: "Z",
-	0x0001D75C: "H",
-	0x0001D75D: "O\u0335",
-	0x0001D75E: "l",
-	0x0001D75F: "K",
-	0x0001D760: "\u0245",
-	0x0001D761: "M",
-	0x0001D762: "N",
-	0x0001D763: "\u039e",
-	0x0001D764: "O",
-	0x0001D765: "\u03a0",
-	0x0001D766: "P",
-	0x0001D767: "O\u0335",
-	0x0001D768: "\u01a9",
-	0x0001D769: "T",
-	0x0001D76A: "Y",
-	0x0001D76B: "\u03a6",
-	0x0001D76C: "X",
-	0x0001D76D: "\u03a8",
-	0x0001D76E: "\u03a9",
-	0x0001D76F: "\u2207",
-	0x0001D770: "a",
-	0x0001D771: "\u00df",
-	0x0001D772: "y",
-	0x0001D773: "\u1e9f",
-	0x0001D774: "\ua793",
-	0x0001D775: "\u03b6",
-	0x0001D776: "n\u0329",
-	0x0001D777: "O\u0335",
-	0x0001D778: "i",
-	0x0001D779: "\u0138",
-	0x0001D77A: "\u03bb",
-	0x0001D77B: "\u03bc",
-	0x0001D77C: "v",
-	0x0001D77D: "\u03be",
-	0x0001D77E: "o",
-	0x0001D77F: "\u03c0",
-	0x0001D780: "p",
-	0x0001D781: "\u03c2",
-	0x0001D782: "o",
-	0x0001D783: "\u1d1b",
-	0x0001D784: "u",
-	0x0001D785: "\u0278",
-	0x0001D786: "\u03c7",
-	0x0001D787: "\u03c8",
-	0x0001D788: "\u03c9",
-	0x0001D789: "\u2202",
-	0x0001D78A: "\ua793",
-	0x0001D78B: "O\u0335",
-	0x0001D78C: "\u0138",
-	0x0001D78D: "\u0278",
-	0x0001D78E: "p",
-	0x0001D78F: "\u03c0",
-	0x0001D790: "A",
-	0x0001D791: "B",
-	0x0001D792: "\u0393",
-	0x0001D793: "\u0394",
-	0x0001D794: "E",
-	0x0001D795: "Z",
-	0x0001D796: "H",
-	0x0001D797: "O\u0335",
-	0x0001D798: "l",
-	0x0001D799: "K",
-	0x0001D79A: "\u0245",
-	0x0001D79B: "M",
-	0x0001D79C: "N",
-	0x0001D79D: "\u039e",
-	0x0001D79E: "O",
-	0x0001D79F: "\u03a0",
-	0x0001D7A0: "P",
-	0x0001D7A1: "O\u0335",
-	0x0001D7A2: "\u01a9",
-	0x0001D7A3: "T",
-	0x0001D7A4: "Y",
-	0x0001D7A5: "\u03a6",
-	0x0001D7A6: "X",
-	0x0001D7A7: "\u03a8",
-	0x0001D7A8: "\u03a9",
-	0x0001D7A9: "\u2207",
-	0x0001D7AA: "a",
-	0x0001D7AB: "\u00df",
-	0x0001D7AC: "y",
-	0x0001D7AD: "\u1e9f",
-	0x0001D7AE: "\ua793",
-	0x0001D7AF: "\u03b6",
-	0x0001D7B0: "n\u0329",
-	0x0001D7B1: "O\u0335",
-	0x0001D7B2: "i",
-	0x0001D7B3: "\u0138",
-	0x0001D7B4: "\u03bb",
-	0x0001D7B5: "\u03bc",
-	0x0001D7B6: "v",
-	0x0001D7B7: "\u03be",
-	0x0001D7B8: "o",
-	0x0001D7B9: "\u03c0",
-	0x0001D7BA: "p",
-	0x0001D7BB: "\u03c2",
-	0x0001D7BC: "o",
-	0x0001D7BD: "\u1d1b",
-	0x0001D7BE: "u",
-	0x0001D7BF: "\u0278",
-	0x0001D7C0: "2",
-	0x0001D7C1: "\u03c8",
-	0x0001D7C2: "\u03c9",
-	0x0001D7C3: "\u2202",
-	0x0001D7C4: "\ua793",
-	0x0001D7C5: "O\u0335",
-	0x0001D7C6: "\u0138",
-	0x0001D7C7: "\u0278",
-	0x0001D7C8: "p",
-	0x0001D7C9: "\u03c0",
-	0x0001D7CA: "F",
-	0x0001D7CB: "\u03dd",
-	0x0001D7CE: "0",
-	0x0001D7CF: "1",
-	0x0001D7D0: "2",
-	0x0001D7D1: "3",
-	0x0001D7D2: "4",
-	0x0001D7D3: "5",
-	0x0001D7D4: "6",
-	0x0001D7D5: "7",
-	0x0001D7D6: "8",
-	0x0001D7D7: "9",
-	0x0001D7D8: "0",
-	0x0001D7D9: "1",
-	0x0001D7DA: "2",
-	0x0001D7DB: "3",
-	0x0001D7DC: "4",
-	0x0001D7DD: "5",
-	0x0001D7DE: "6",
-	0x0001D7DF: "7",
-	0x0001D7E0: "8",
-	0x0001D7E1: "9",
-	0x0001D7E2: "0",
-	0x0001D7E3: "1",
-	0x0001D7E4: "2",
-	0x0001D7E5: "3",
-	0x0001D7E6: "4",
-	0x0001D7E7: "5",
-	0x0001D7E8: "6",
-	0x0001D7E9: "7",
-	0x0001D7EA: "8",
-	0x0001D7EB: "9",
-	0x0001D7EC: "0",
-	0x0001D7ED: "1",
-	0x0001D7EE: "2",
-	0x0001D7EF: "3",
-	0x0001D7F0: "4",
-	0x0001D7F1: "5",
-	0x0001D7F2: "6",
-	0x0001D7F3: "7",
-	0x0001D7F4: "8",
-	0x0001D7F5: "9",
-	0x0001D7F6: "0",
-	0x0001D7F7: "1",
-	0x0001D7F8: "2",
-	0x0001D7F9: "3",
-	0x0001D7FA: "4",
-	0x0001D7FB: "5",
-	0x0001D7FC: "6",
-	0x0001D7FD: "7",
-	0x0001D7FE: "8",
-	0x0001D7FF: "9",
-	0x0001E8C7: "l",
-	0x0001E8C8: "\u2220",
-	0x0001E8C9: "\u0663",
-	0x0001E8CB: "8",
-	0x0001E8CC: "\u2202",
-	0x0001E8CD: "\u2202\u0335",
-	0x0001EE00: "l",
-	0x0001EE01: "\u0628",
-	0x0001EE02: "\u062c",
-	0x0001EE03: "\u062f",
-	0x0001EE05: "\u0648",
-	0x0001EE06: "\u0632",
-	0x0001EE07: "\u062d",
-	0x0001EE08: "\u0637",
-	0x0001EE09: "\u0649",
-	0x0001EE0A: "\u0643",
-	0x0001EE0B: "\u0644",
-	0x0001EE0C: "\u0645",
-	0x0001EE0D: "\u0646",
-	0x0001EE0E: "\u0633",
-	0x0001EE0F: "\u0639",
-	0x0001EE10: "\u0641",
-	0x0001EE11: "\u0635",
-	0x0001EE12: "\u0642",
-	0x0001EE13: "\u0631",
-	0x0001EE14: "\u0633\u06db",
-	0x0001EE15: "\u062a",
-	0x0001EE16: "\u0649\u06db",
-	0x0001EE17: "\u062e",
-	0x0001EE18: "\u0630",
-	0x0001EE19: "\u0636",
-	0x0001EE1A: "\u0638",
-	0x0001EE1B: "\u063a",
-	0x0001EE1C: "\u0649",
-	0x0001EE1D: "\u0649",
-	0x0001EE1E: "\u06a1",
-	0x0001EE1F: "\u06a1",
-	0x0001EE21: "\u0628",
-	0x0001EE22: "\u062c",
-	0x0001EE24: "o",
-	0x0001EE27: "\u062d",
-	0x0001EE29: "\u0649",
-	0x0001EE2A: "\u0643",
-	0x0001EE2B: "\u0644",
-	0x0001EE2C: "\u0645",
-	0x0001EE2D: "\u0646",
-	0x0001EE2E: "\u0633",
-	0x0001EE2F: "\u0639",
-	0x0001EE30: "\u0641",
-	0x0001EE31: "\u0635",
-	0x0001EE32: "\u0642",
-	0x0001EE34: "\u0633\u06db",
-	0x0001EE35: "\u062a",
-	0x0001EE36: "\u0649\u06db",
-	0x0001EE37: "\u062e",
-	0x0001EE39: "\u0636",
-	0x0001EE3B: "\u063a",
-	0x0001EE42: "\u062c",
-	0x0001EE47: "\u062d",
-	0x0001EE49: "\u0649",
-	0x0001EE4B: "\u0644",
-	0x0001EE4D: "\u0646",
-	0x0001EE4E: "\u0633",
-	0x0001EE4F: "\u0639",
-	0x0001EE51: "\u0635",
-	0x0001EE52: "\u0642",
-	0x0001EE54: "\u0633\u06db",
-	0x0001EE57: "\u062e",
-	0x0001EE59: "\u0636",
-	0x0001EE5B: "\u063a",
-	0x0001EE5D: "\u0649",
-	0x0001EE5F: "\u06a1",
-	0x0001EE61: "\u0628",
-	0x0001EE62: "\u062c",
-	0x0001EE64: "o",
-	0x0001EE67: "\u062d",
-	0x0001EE68: "\u0637",
-	0x0001EE69: "\u0649",
-	0x0001EE6A: "\u0643",
-	0x0001EE6C: "\u0645",
-	0x0001EE6D: "\u0646",
-	0x0001EE6E: "\u0633",
-	0x0001EE6F: "\u0639",
-	0x0001EE70: "\u0641",
-	0x0001EE71: "\u0635",
-	0x0001EE72: "\u0642",
-	0x0001EE74: "\u0633\u06db",
-	0x0001EE75: "\u062a",
-	0x0001EE76: "\u0649\u06db",
-	0x0001EE77: "\u062e",
-	0x0001EE79: "\u0636",
-	0x0001EE7A: "\u0638",
-	0x0001EE7B: "\u063a",
-	0x0001EE7C: "\u0649",
-	0x0001EE7E: "\u06a1",
-	0x0001EE80: "l",
-	0x0001EE81: "\u0628",
-	0x0001EE82: "\u062c",
-	0x0001EE83: "\u062f",
-	0x0001EE84: "o",
-	0x0001EE85: "\u0648",
-	0x0001EE86: "\u0632",
-	0x0001EE87: "\u062d",
-	0x0001EE88: "\u0637",
-	0x0001EE89: "\u0649",
-	0x0001EE8B: "\u0644",
-	0x0001EE8C: "\u0645",
-	0x0001EE8D: "\u0646",
-	0x0001EE8E: "\u0633",
-	0x0001EE8F: "\u0639",
-	0x0001EE90: "\u0641",
-	0x0001EE91: "\u0635",
-	0x0001EE92: "\u0642",
-	0x0001EE93: "\u0631",
-	0x0001EE94: "\u0633\u06db",
-	0x0001EE95: "\u062a",
-	0x0001EE96: "\u0649\u06db",
-	0x0001EE97: "\u062e",
-	0x0001EE98: "\u0630",
-	0x0001EE99: "\u0636",
-	0x0001EE9A: "\u0638",
-	0x0001EE9B: "\u063a",
-	0x0001EEA1: "\u0628",
-	0x0001EEA2: "\u062c",
-	0x0001EEA3: "\u062f",
-	0x0001EEA5: "\u0648",
-	0x0001EEA6: "\u0632",
-	0x0001EEA7: "\u062d",
-	0x0001EEA8: "\u0637",
-	0x0001EEA9: "\u0649",
-	0x0001EEAB: "\u0644",
-	0x0001EEAC: "\u0645",
-	0x0001EEAD: "\u0646",
-	0x0001EEAE: "\u0633",
-	0x0001EEAF: "\u0639",
-	0x0001EEB0: "\u0641",
-	0x0001EEB1: "\u0635",
-	0x0001EEB2: "\u0642",
-	0x0001EEB3: "\u0631",
-	0x0001EEB4: "\u0633\u06db",
-	0x0001EEB5: "\u062a",
-	0x0001EEB6: "\u0649\u06db",
-	0x0001EEB7: "\u062e",
-	0x0001EEB8: "\u0630",
-	0x0001EEB9: "\u0636",
-	0x0001EEBA: "\u0638",
-	0x0001EEBB: "\u063a",
-	0x0001F100: "0",
-	0x0001F101: "O,",
-	0x0001F102: "l,",
-	0x0001F103: "2,",
-	0x0001F104: "3,",
-	0x0001F105: "4,",
-	0x0001F106: "5,",
-	0x0001F107: "6,",
-	0x0001F108: "7,",
-	0x0001F109: "8,",
-	0x0001F10A: "9,",
-	0x0001F10B: "0",
-	0x0001F10C: "0",
-	0x0001F10F: "$\u20e0",
-	0x0001F110: "(A)",
-	0x0001F111: "(B)",
-	0x0001F112: "(C)",
-	0x0001F113: "(D)",
-	0x0001F114: "(E)",
-	0x0001F115: "(F)",
-	0x0001F116: "(G)",
-	0x0001F117: "(H)",
-	0x0001F118: "(l)",
-	0x0001F119: "(J)",
-	0x0001F11A: "(K)",
-	0x0001F11B: "(L)",
-	0x0001F11C: "(M)",
-	0x0001F11D: "(N)",
-	0x0001F11E: "(O)",
-	0x0001F11F: "(P)",
-	0x0001F120: "(Q)",
-	0x0001F121: "(R)",
-	0x0001F122: "(S)",
-	0x0001F123: "(T)",
-	0x0001F124: "(U)",
-	0x0001F125: "(V)",
-	0x0001F126: "(W)",
-	0x0001F127: "(X)",
-	0x0001F128: "(Y)",
-	0x0001F129: "(Z)",
-	0x0001F12A: "(S)",
-	0x0001F16D: "\u33c4\t\u20dd",
-	0x0001F16E: "C\u20e0",
-	0x0001F240: "(\u672c)",
-	0x0001F241: "(\u4e09)",
-	0x0001F242: "(\u4e8c)",
-	0x0001F243: "(\u5b89)",
-	0x0001F244: "(\u70b9)",
-	0x0001F245: "(\u6253)",
-	0x0001F246: "(\u76d7)",
-	0x0001F247: "(\u52dd)",
-	0x0001F248: "(\u6557)",
-	0x0001F312: "\u263d",
-	0x0001F318: "\u263e",
-	0x0001F319: "\u263d",
-	0x0001F700: "QE",
-	0x0001F701: "\ua658",
-	0x0001F702: "\u0394",
-	0x0001F704: "\U000102bc",
-	0x0001F707: "AR",
-	0x0001F708: "V\u1de4",
-	0x0001F70A: "\u2629",
-	0x0001F714: "O\u0335",
-	0x0001F728: "\U000102a8",
-	0x0001F73A: "\u29df",
-	0x0001F74C: "C",
-	0x0001F754: "\u16dc",
-	0x0001F755: "\u22a1",
-	0x0001F75C: "sss",
-	0x0001F75E: "\u224f",
-	0x0001F768: "T",
-	0x0001F76B: "MB",
-	0x0001F76C: "VB",
-	0x0001F771: "\u22a0",
-	0x0001FBF0: "O",
-	0x0001FBF1: "l",
-	0x0001FBF2: "2",
-	0x0001FBF3: "3",
-	0x0001FBF4: "4",
-	0x0001FBF5: "5",
-	0x0001FBF6: "6",
-	0x0001FBF7: "7",
-	0x0001FBF8: "8",
-	0x0001FBF9: "9",
-	0x00021FE8: "\u276c",
-	0x0002F800: "\u4e3d",
-	0x0002F801: "\u4e38",
-	0x0002F802: "\u4e41",
-	0x0002F803: "\U00020122",
-	0x0002F804: "\u4f60",
-	0x0002F805: "\u4fae",
-	0x0002F806: "\u4fbb",
-	0x0002F807: "\u4f75",
-	0x0002F808: "\u507a",
-	0x0002F809: "\u5099",
-	0x0002F80A: "\u50e7",
-	0x0002F80B: "\u50cf",
-	0x0002F80C: "\u349e",
-	0x0002F80D: "\U0002063a",
-	0x0002F80E: "\u514d",
-	0x0002F80F: "\u5154",
-	0x0002F810: "\u5164",
-	0x0002F811: "\u5177",
-	0x0002F812: "\U0002051c",
-	0x0002F813: "\u34b9",
-	0x0002F814: "\u5167",
-	0x0002F815: "\u518d",
-	0x0002F816: "\U0002054b",
-	0x0002F817: "\u5197",
-	0x0002F818: "\u51a4",
-	0x0002F819: "\u4ecc",
-	0x0002F81A: "\u51ac",
-	0x0002F81B: "\u51b5",
-	0x0002F81C: "\U000291df",
-	0x0002F81D: "\u51f5",
-	0x0002F81E: "\u5203",
-	0x0002F81F: "\u34df",
-	0x0002F820: "\u523b",
-	0x0002F821: "\u5246",
-	0x0002F822: "\u5272",
-	0x0002F823: "\u5277",
-	0x0002F824: "\u3515",
-	0x0002F825: "\u52c7",
-	0x0002F826: "\u52c9",
-	0x0002F827: "\u52e4",
-	0x0002F828: "\u52fa",
-	0x0002F829: "\u5305",
-	0x0002F82A: "\u5306",
-	0x0002F82B: "\u5317",
-	0x0002F82C: "\u5349",
-	0x0002F82D: "\u5351",
-	0x0002F82E: "\u535a",
-	0x0002F82F: "\u5373",
-	0x0002F830: "\u537d",
-	0x0002F831: "\u537f",
-	0x0002F832: "\u537f",
-	0x0002F833: "\u537f",
-	0x0002F834: "\U00020a2c",
-	0x0002F835: "\u7070",
-	0x0002F836: "\u53ca",
-	0x0002F837: "\u53df",
-	0x0002F838: "\U00020b63",
-	0x0002F839: "\u53eb",
-	0x0002F83A: "\u53f1",
-	0x0002F83B: "\u5406",
-	0x0002F83C: "\u549e",
-	0x0002F83D: "\u5438",
-	0x0002F83E: "\u5448",
-	0x0002F83F: "\u5468",
-	0x0002F840: "\u54a2",
-	0x0002F841: "\u54f6",
-	0x0002F842: "\u5510",
-	0x0002F843: "\u5553",
-	0x0002F844: "\u5563",
-	0x0002F845: "\u5584",
-	0x0002F846: "\u5584",
-	0x0002F847: "\u5599",
-	0x0002F848: "\u55ab",
-	0x0002F849: "\u55b3",
-	0x0002F84A: "\u55c2",
-	0x0002F84B: "\u5716",
-	0x0002F84C: "\u5606",
-	0x0002F84D: "\u5717",
-	0x0002F84E: "\u5651",
-	0x0002F84F: "\u5674",
-	0x0002F850: "\u5207",
-	0x0002F851: "\u58ee",
-	0x0002F852: "\u57ce",
-	0x0002F853: "\u57f4",
-	0x0002F854: "\u580d",
-	0x0002F855: "\u578b",
-	0x0002F856: "\u5832",
-	0x0002F857: "\u5831",
-	0x0002F858: "\u58ac",
-	0x0002F859: "\U000214e4",
-	0x0002F85A: "\u58f2",
-	0x0002F85B: "\u58f7",
-	0x0002F85C: "\u5906",
-	0x0002F85D: "\u591a",
-	0x0002F85E: "\u5922",
-	0x0002F85F: "\u5962",
-	0x0002F860: "\U000216a8",
-	0x0002F861: "\U000216ea",
-	0x0002F862: "\u59ec",
-	0x0002F863: "\u5a1b",
-	0x0002F864: "\u5a27",
-	0x0002F865: "\u59d8",
-	0x0002F866: "\u5a66",
-	0x0002F867: "\u36ee",
-	0x0002F868: "\u36fc",
-	0x0002F869: "\u5b08",
-	0x0002F86A: "\u5b3e",
-	0x0002F86B: "\u5b3e",
-	0x0002F86C: "\U000219c8",
-	0x0002F86D: "\u5bc3",
-	0x0002F86E: "\u5bd8",
-	0x0002F86F: "\u5be7",
-	0x0002F870: "\u5bf3",
-	0x0002F871: "\U00021b18",
-	0x0002F872: "\u5bff",
-	0x0002F873: "\u5c06",
-	0x0002F874: "\u5f53",
-	0x0002F875: "\u5c22",
-	0x0002F876: "\u3781",
-	0x0002F877: "\u5c60",
-	0x0002F878: "\u5c6e",
-	0x0002F879: "\u5cc0",
-	0x0002F87A: "\u5c8d",
-	0x0002F87B: "\U00021de4",
-	0x0002F87C: "\u5d43",
-	0x0002F87D: "\U00021de6",
-	0x0002F87E: "\u5d6e",
-	0x0002F87F: "\u5d6b",
-	0x0002F880: "\u5d7c",
-	0x0002F881: "\u5de1",
-	0x0002F882: "\u5de2",
-	0x0002F883: "\u382f",
-	0x0002F884: "\u5dfd",
-	0x0002F885: "\u5e28",
-	0x0002F886: "\u5e3d",
-	0x0002F887: "\u5e69",
-	0x0002F888: "\u3862",
-	0x0002F889: "\U00022183",
-	0x0002F88A: "\u387c",
-	0x0002F88B: "\u5eb0",
-	0x0002F88C: "\u5eb3",
-	0x0002F88D: "\u5eb6",
-	0x0002F88E: "\u5eca",
-	0x0002F88F: "\U0002a392",
-	0x0002F890: "\u5efe",
-	0x0002F891: "\U00022331",
-	0x0002F892: "\U00022331",
-	0x0002F893: "\u8201",
-	0x0002F894: "\u5f22",
-	0x0002F895: "\u5f22",
-	0x0002F896: "\u38c7",
-	0x0002F897: "\U000232b8",
-	0x0002F898: "\U000261da",
-	0x0002F899: "\u5f62",
-	0x0002F89A: "\u5f6b",
-	0x0002F89B: "\u38e3",
-	0x0002F89C: "\u5f9a",
-	0x0002F89D: "\u5fcd",
-	0x0002F89E: "\u5fd7",
-	0x0002F89F: "\u5ff9",
-	0x0002F8A0: "\u6081",
-	0x0002F8A1: "\u393a",
-	0x0002F8A2: "\u391c",
-	0x0002F8A3: "\u6094",
-	0x0002F8A4: "\U000226d4",
-	0x0002F8A5: "\u60c7",
-	0x0002F8A6: "\u6148",
-	0x0002F8A7: "\u614c",
-	0x0002F8A8: "\u614e",
-	0x0002F8A9: "\u614c",
-	0x0002F8AA: "\u617a",
-	0x0002F8AB: "\u618e",
-	0x0002F8AC: "\u61b2",
-	0x0002F8AD: "\u61a4",
-	0x0002F8AE: "\u61af",
-	0x0002F8AF: "\u61de",
-	0x0002F8B0: "\u61f2",
-	0x0002F8B1: "\u61f6",
-	0x0002F8B2: "\u6210",
-	0x0002F8B3: "\u621b",
-	0x0002F8B4: "\u625d",
-	0x0002F8B5: "\u62b1",
-	0x0002F8B6: "\u62d4",
-	0x0002F8B7: "\u6350",
-	0x0002F8B8: "\U00022b0c",
-	0x0002F8B9: "\u633d",
-	0x0002F8BA: "\u62fc",
-	0x0002F8BB: "\u6368",
-	0x0002F8BC: "\u6383",
-	0x0002F8BD: "\u63e4",
-	0x0002F8BE: "\U00022bf1",
-	0x0002F8BF: "\u6422",
-	0x0002F8C0: "\u63c5",
-	0x0002F8C1: "\u63a9",
-	0x0002F8C2: "\u3a2e",
-	0x0002F8C3: "\u6469",
-	0x0002F8C4: "\u647e",
-	0x0002F8C5: "\u649d",
-	0x0002F8C6: "\u6477",
-	0x0002F8C7: "\u3a6c",
-	0x0002F8C8: "\u654f",
-	0x0002F8C9: "\u656c",
-	0x0002F8CA: "\U0002300a",
-	0x0002F8CB: "\u65e3",
-	0x0002F8CC: "\u66f8",
-	0x0002F8CD: "\u6649",
-	0x0002F8CE: "\u3b19",
-	0x0002F8CF: "\u6691",
-	0x0002F8D0: "\u3b08",
-	0x0002F8D1: "\u3ae4",
-	0x0002F8D2: "\u5192",
-	0x0002F8D3: "\u5195",
-	0x0002F8D4: "\u6700",
-	0x0002F8D5: "\u669c",
-	0x0002F8D6: "\u80ad",
-	0x0002F8D7: "\u43d9",
-	0x0002F8D8: "\u6717",
-	0x0002F8D9: "\u671b",
-	0x0002F8DA: "\u6721",
-	0x0002F8DB: "\u675e",
-	0x0002F8DC: "\u6753",
-	0x0002F8DD: "\U000233c3",
-	0x0002F8DE: "\u3b49",
-	0x0002F8DF: "\u67fa",
-	0x0002F8E0: "\u6785",
-	0x0002F8E1: "\u6852",
-	0x0002F8E2: "\u6885",
-	0x0002F8E3: "\U0002346d",
-	0x0002F8E4: "\u688e",
-	0x0002F8E5: "\u681f",
-	0x0002F8E6: "\u6914",
-	0x0002F8E7: "\u3b9d",
-	0x0002F8E8: "\u6942",
-	0x0002F8E9: "\u69a3",
-	0x0002F8EA: "\u69ea",
-	0x0002F8EB: "\u6aa8",
-	0x0002F8EC: "\U000236a3",
-	0x0002F8ED: "\u6adb",
-	0x0002F8EE: "\u3c18",
-	0x0002F8EF: "\u6b21",
-	0x0002F8F0: "\U000238a7",
-	0x0002F8F1: "\u6b54",
-	0x0002F8F2: "\u3c4e",
-	0x0002F8F3: "\u6b72",
-	0x0002F8F4: "\u6b9f",
-	0x0002F8F5: "\u6bba",
-	0x0002F8F6: "\u6bbb",
-	0x0002F8F7: "\U00023a8d",
-	0x0002F8F8: "\U00021d0b",
-	0x0002F8F9: "\U00023afa",
-	0x0002F8FA: "\u6c4e",
-	0x0002F8FB: "\U00023cbc",
-	0x0002F8FC: "\u6cbf",
-	0x0002F8FD: "\u6ccd",
-	0x0002F8FE: "\u6c67",
-	0x0002F8FF: "\u6d16",
-	0x0002F900: "\u6d3e",
-	0x0002F901: "\u6d77",
-	0x0002F902: "\u6d41",
-	0x0002F903: "\u6d69",
-	0x0002F904: "\u6d78",
-	0x0002F905: "\u6d85",
-	0x0002F906: "\U00023d1e",
-	0x0002F907: "\u6d34",
-	0x0002F908: "\u6e2f",
-	0x0002F909: "\u6e6e",
-	0x0002F90A: "\u3d33",
-	0x0002F90B: "\u6ecb",
-	0x0002F90C: "\u6ec7",
-	0x0002F90D: "\U00023ed1",
-	0x0002F90E: "\u6df9",
-	0x0002F90F: "\u6f6e",
-	0x0002F910: "\U00023f5e",
-	0x0002F911: "\U00023f8e",
-	0x0002F912: "\u6fc6",
-	0x0002F913: "\u7039",
-	0x0002F914: "\u701e",
-	0x0002F915: "\u701b",
-	0x0002F916: "\u3d96",
-	0x0002F917: "\u704a",
-	0x0002F918: "\u707d",
-	0x0002F919: "\u7077",
-	0x0002F91A: "\u70ad",
-	0x0002F91B: "\U00020525",
-	0x0002F91C: "\u7145",
-	0x0002F91D: "\U00024263",
-	0x0002F91E: "\u719c",
-	0x0002F91F: "\U000243ab",
-	0x0002F920: "\u7228",
-	0x0002F921: "\u7235",
-	0x0002F922: "\u7250",
-	0x0002F923: "\U00024608",
-	0x0002F924: "\u7280",
-	0x0002F925: "\u7295",
-	0x0002F926: "\U00024735",
-	0x0002F927: "\U00024814",
-	0x0002F928: "\u737a",
-	0x0002F929: "\u738b",
-	0x0002F92A: "\u3eac",
-	0x0002F92B: "\u73a5",
-	0x0002F92C: "\u3eb8",
-	0x0002F92D: "\u3eb8",
-	0x0002F92E: "\u7447",
-	0x0002F92F: "\u745c",
-	0x0002F930: "\u7471",
-	0x0002F931: "\u7485",
-	0x0002F932: "\u74ca",
-	0x0002F933: "\u3f1b",
-	0x0002F934: "\u7524",
-	0x0002F935: "\U00024c36",
-	0x0002F936: "\u753e",
-	0x0002F937: "\U00024c92",
-	0x0002F938: "\u7570",
-	0x0002F939: "\U0002219f",
-	0x0002F93A: "\u7610",
-	0x0002F93B: "\U00024fa1",
-	0x0002F93C: "\U00024fb8",
-	0x0002F93D: "\U00025044",
-	0x0002F93E: "\u3ffc",
-	0x0002F93F: "\u4008",
-	0x0002F940: "\u76f4",
-	0x0002F941: "\U000250f3",
-	0x0002F942: "\U000250f2",
-	0x0002F943: "\U00025119",
-	0x0002F944: "\U00025133",
-	0x0002F945: "\u771e",
-	0x0002F946: "\u771f",
-	0x0002F947: "\u771f",
-	0x0002F948: "\u774a",
-	0x0002F949: "\u4039",
-	0x0002F94A: "\u778b",
-	0x0002F94B: "\u4046",
-	0x0002F94C: "\u4096",
-	0x0002F94D: "\U0002541d",
-	0x0002F94E: "\u784e",
-	0x0002F94F: "\u788c",
-	0x0002F950: "\u78cc",
-	0x0002F951: "\u40e3",
-	0x0002F952: "\U00025626",
-	0x0002F953: "\u7956",
-	0x0002F954: "\U0002569a",
-	0x0002F955: "\U000256c5",
-	0x0002F956: "\u798f",
-	0x0002F957: "\u79eb",
-	0x0002F958: "\u412f",
-	0x0002F959: "\u7a40",
-	0x0002F95A: "\u7a4a",
-	0x0002F95B: "\u7a4f",
-	0x0002F95C: "\U0002597c",
-	0x0002F95D: "\U00025aa7",
-	0x0002F95E: "\U00025aa7",
-	0x0002F95F: "\u7aee",
-	0x0002F960: "\u4202",
-	0x0002F961: "\U00025bab",
-	0x0002F962: "\u7bc6",
-	0x0002F963: "\u7bc9",
-	0x0002F964: "\u4227",
-	0x0002F965: "\U00025c80",
-	0x0002F966: "\u7cd2",
-	0x0002F967: "\u42a0",
-	0x0002F968: "\u7ce8",
-	0x0002F969: "\u7ce3",
-	0x0002F96A: "\u7d00",
-	0x0002F96B: "\U00025f86",
-	0x0002F96C: "\u7d63",
-	0x0002F96D: "\u4301",
-	0x0002F96E: "\u7dc7",
-	0x0002F96F: "\u7e02",
-	0x0002F970: "\u7e45",
-	0x0002F971: "\u4334",
-	0x0002F972: "\U00026228",
-	0x0002F973: "\U00026247",
-	0x0002F974: "\u4359",
-	0x0002F975: "\U000262d9",
-	0x0002F976: "\u7f7a",
-	0x0002F977: "\U0002633e",
-	0x0002F978: "\u7f95",
-	0x0002F979: "\u7ffa",
-	0x0002F97A: "\u8005",
-	0x0002F97B: "\U000264da",
-	0x0002F97C: "\U00026523",
-	0x0002F97D: "\u8060",
-	0x0002F97E: "\U000265a8",
-	0x0002F97F: "\u8070",
-	0x0002F980: "\U0002335f",
-	0x0002F981: "\u43d5",
-	0x0002F982: "\u80b2",
-	0x0002F983: "\u8103",
-	0x0002F984: "\u440b",
-	0x0002F985: "\u813e",
-	0x0002F986: "\u5ab5",
-	0x0002F987: "\U000267a7",
-	0x0002F988: "\U000267b5",
-	0x0002F989: "\U00023393",
-	0x0002F98A: "\U0002339c",
-	0x0002F98B: "\u8201",
-	0x0002F98C: "\u8204",
-	0x0002F98D: "\u8f9e",
-	0x0002F98E: "\u446b",
-	0x0002F98F: "\u8291",
-	0x0002F990: "\u828b",
-	0x0002F991: "\u829d",
-	0x0002F992: "\u52b3",
-	0x0002F993: "\u82b1",
-	0x0002F994: "\u82b3",
-	0x0002F995: "\u82bd",
-	0x0002F996: "\u82e6",
-	0x0002F997: "\U00026b3c",
-	0x0002F998: "\u82e5",
-	0x0002F999: "\u831d",
-	0x0002F99A: "\u8363",
-	0x0002F99B: "\u83ad",
-	0x0002F99C: "\u8323",
-	0x0002F99D: "\u83bd",
-	0x0002F99E: "\u83e7",
-	0x0002F99F: "\u8457",
-	0x0002F9A0: "\u8353",
-	0x0002F9A1: "\u83ca",
-	0x0002F9A2: "\u83cc",
-	0x0002F9A3: "\u83dc",
-	0x0002F9A4: "\U00026c36",
-	0x0002F9A5: "\U00026d6b",
-	0x0002F9A6: "\U00026cd5",
-	0x0002F9A7: "\u452b",
-	0x0002F9A8: "\u84f1",
-	0x0002F9A9: "\u84f3",
-	0x0002F9AA: "\u8516",
-	0x0002F9AB: "\U000273ca",
-	0x0002F9AC: "\u8564",
-	0x0002F9AD: "\U00026f2c",
-	0x0002F9AE: "\u455d",
-	0x0002F9AF: "\u4561",
-	0x0002F9B0: "\U00026fb1",
-	0x0002F9B1: "\U000270d2",
-	0x0002F9B2: "\u456b",
-	0x0002F9B3: "\u8650",
-	0x0002F9B4: "\u865c",
-	0x0002F9B5: "\u8667",
-	0x0002F9B6: "\u8669",
-	0x0002F9B7: "\u86a9",
-	0x0002F9B8: "\u8688",
-	0x0002F9B9: "\u870e",
-	0x0002F9BA: "\u86e2",
-	0x0002F9BB: "\u8779",
-	0x0002F9BC: "\u8728",
-	0x0002F9BD: "\u876b",
-	0x0002F9BE: "\u8786",
-	0x0002F9BF: "\u45d7",
-	0x0002F9C0: "\u87e1",
-	0x0002F9C1: "\u8801",
-	0x0002F9C2: "\u45f9",
-	0x0002F9C3: "\u8860",
-	0x0002F9C4: "\u8863",
-	0x0002F9C5: "\U00027667",
-	0x0002F9C6: "\u88d7",
-	0x0002F9C7: "\u88de",
-	0x0002F9C8: "\u4635",
-	0x0002F9C9: "\u88fa",
-	0x0002F9CA: "\u34bb",
-	0x0002F9CB: "\U000278ae",
-	0x0002F9CC: "\U00027966",
-	0x0002F9CD: "\u46be",
-	0x0002F9CE: "\u46c7",
-	0x0002F9CF: "\u8aa0",
-	0x0002F9D0: "\u8aed",
-	0x0002F9D1: "\u8b8a",
-	0x0002F9D2: "\u8c55",
-	0x0002F9D3: "\U00027ca8",
-	0x0002F9D4: "\u8cab",
-	0x0002F9D5: "\u8cc1",
-	0x0002F9D6: "\u8d1b",
-	0x0002F9D7: "\u8d77",
-	0x0002F9D8: "\U00027f2f",
-	0x0002F9D9: "\U00020804",
-	0x0002F9DA: "\u8dcb",
-	0x0002F9DB: "\u8dbc",
-	0x0002F9DC: "\u8df0",
-	0x0002F9DD: "\U000208de",
-	0x0002F9DE: "\u8ed4",
-	0x0002F9DF: "\u8f38",
-	0x0002F9E0: "\U000285d2",
-	0x0002F9E1: "\U000285ed",
-	0x0002F9E2: "\u9094",
-	0x0002F9E3: "\u90f1",
-	0x0002F9E4: "\u9111",
-	0x0002F9E5: "\U0002872e",
-	0x0002F9E6: "\u911b",
-	0x0002F9E7: "\u9238",
-	0x0002F9E8: "\u92d7",
-	0x0002F9E9: "\u92d8",
-	0x0002F9EA: "\u927c",
-	0x0002F9EB: "\u93f9",
-	0x0002F9EC: "\u9415",
-	0x0002F9ED: "\U00028bfa",
-	0x0002F9EE: "\u958b",
-	0x0002F9EF: "\u4995",
-	0x0002F9F0: "\u95b7",
-	0x0002F9F1: "\U00028d77",
-	0x0002F9F2: "\u49e6",
-	0x0002F9F3: "\u96c3",
-	0x0002F9F4: "\u5db2",
-	0x0002F9F5: "\u9723",
-	0x0002F9F6: "\U00029145",
-	0x0002F9F7: "\U0002921a",
-	0x0002F9F8: "\u4a6e",
-	0x0002F9F9: "\u4a76",
-	0x0002F9FA: "\u97e0",
-	0x0002F9FB: "\U0002940a",
-	0x0002F9FC: "\u4ab2",
-	0x0002F9FD: "\U00029496",
-	0x0002F9FE: "\u980b",
-	0x0002F9FF: "\u980b",
-	0x0002FA00: "\u9829",
-	0x0002FA01: "\U000295b6",
-	0x0002FA02: "\u98e2",
-	0x0002FA03: "\u4b33",
-	0x0002FA04: "\u9929",
-	0x0002FA05: "\u99a7",
-	0x0002FA06: "\u99c2",
-	0x0002FA07: "\u99fe",
-	0x0002FA08: "\u4bce",
-	0x0002FA09: "\U00029b30",
-	0x0002FA0A: "\u9b12",
-	0x0002FA0B: "\u9c40",
-	0x0002FA0C: "\u9cfd",
-	0x0002FA0D: "\u4cce",
-	0x0002FA0E: "\u4ced",
-	0x0002FA0F: "\u9d67",
-	0x0002FA10: "\U0002a0ce",
-	0x0002FA11: "\u4cf8",
-	0x0002FA12: "\U0002a105",
-	0x0002FA13: "\U0002a20e",
-	0x0002FA14: "\U0002a291",
-	0x0002FA15: "\u9ebb",
-	0x0002FA16: "\u4d56",
-	0x0002FA17: "\u9ef9",
-	0x0002FA18: "\u9efe",
-	0x0002FA19: "\u9f05",
-	0x0002FA1A: "\u9f0f",
-	0x0002FA1B: "\u9f16",
-	0x0002FA1C: "\u9f3b",
-	0x0002FA1D: "\U0002a600",
-}
+import _ "embed"
+
+// tablesData holds the tables in the binary format read by decodeTables.
+//
+//go:embed tables.bin
+var tablesData string
 
-var descriptions = map[string]string{
-	" ": "SPACE",
-	"!!": "EXCLAMATION MARK, EXCLAMATION MARK",
-	"!": "EXCLAMATION MARK",
-	"!?": "EXCLAMATION MARK, QUESTION MARK",
-	"$⃠": "DOLLAR SIGN, COMBINING ENCLOSING CIRCLE BACKSLASH",
-	"%": "PERCENT SIGN",
-	"&": "AMPERSAND",
-	"'": "APOSTROPHE",
-	"''": "APOSTROPHE, APOSTROPHE",
-	"'''": "APOSTROPHE, APOSTROPHE, APOSTROPHE",
-	"''''": "APOSTROPHE, APOSTROPHE, APOSTROPHE, APOSTROPHE",
-	"'B": "APOSTROPHE, LATIN CAPITAL LETTER B",
-	"'D": "APOSTROPHE, LATIN CAPITAL LETTER D",
-	"'P": "APOSTROPHE, LATIN CAPITAL LETTER P",
-	"'T": "APOSTROPHE, LATIN CAPITAL LETTER T",
-	"'Y": "APOSTROPHE, LATIN CAPITAL LETTER Y",
-	"'n": "APOSTROPHE, LATIN SMALL LETTER N",
-	"(": "LEFT PARENTHESIS",
-	"((": "LEFT PARENTHESIS, LEFT PARENTHESIS",
-	"(2)": "LEFT PARENTHESIS, DIGIT TWO, RIGHT PARENTHESIS",
-	"(2O)": "LEFT PARENTHESIS, DIGIT TWO, LATIN CAPITAL LETTER O, RIGHT PARENTHESIS",
-	"(3)": "LEFT PARENTHESIS, DIGIT THREE, RIGHT PARENTHESIS",
-	"(4)": "LEFT PARENTHESIS, DIGIT FOUR, RIGHT PARENTHESIS",
-	"(5)": "LEFT PARENTHESIS, DIGIT FIVE, RIGHT PARENTHESIS",
-	"(6)": "LEFT PARENTHESIS, DIGIT SIX, RIGHT PARENTHESIS",
-	"(7)": "LEFT PARENTHESIS, DIGIT SEVEN, RIGHT PARENTHESIS",
-	"(8)": "LEFT PARENTHESIS, DIGIT EIGHT, RIGHT PARENTHESIS",
-	"(9)": "LEFT PARENTHESIS, DIGIT NINE, RIGHT PARENTHESIS",
-	"(A)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER A, RIGHT PARENTHESIS",
-	"(B)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER B, RIGHT PARENTHESIS",
-	"(C)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER C, RIGHT PARENTHESIS",
-	"(D)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER D, RIGHT PARENTHESIS",
-	"(E)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER E, RIGHT PARENTHESIS",
-	"(F)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER F, RIGHT PARENTHESIS",
-	"(G)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER G, RIGHT PARENTHESIS",
-	"(H)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER H, RIGHT PARENTHESIS",
-	"(J)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER J, RIGHT PARENTHESIS",
-	"(K)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER K, RIGHT PARENTHESIS",
-	"(L)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER L, RIGHT PARENTHESIS",
-	"(M)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER M, RIGHT PARENTHESIS",
-	"(N)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER N, RIGHT PARENTHESIS",
-	"(O)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER O, RIGHT PARENTHESIS",
-	"(P)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER P, RIGHT PARENTHESIS",
-	"(Q)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER Q, RIGHT PARENTHESIS",
-	"(R)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER R, RIGHT PARENTHESIS",
-	"(S)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER S, RIGHT PARENTHESIS",
-	"(T)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER T, RIGHT PARENTHESIS",
-	"(U)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER U, RIGHT PARENTHESIS",
-	"(V)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER V, RIGHT PARENTHESIS",
-	"(W)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER W, RIGHT PARENTHESIS",
-	"(X)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER X, RIGHT PARENTHESIS",
-	"(Y)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER Y, RIGHT PARENTHESIS",
-	"(Z)": "LEFT PARENTHESIS, LATIN CAPITAL LETTER Z, RIGHT PARENTHESIS",
-	"(a)": "LEFT PARENTHESIS, LATIN SMALL LETTER A, RIGHT PARENTHESIS",
-	"(b)": "LEFT PARENTHESIS, LATIN SMALL LETTER B, RIGHT PARENTHESIS",
-	"(c)": "LEFT PARENTHESIS, LATIN SMALL LETTER C, RIGHT PARENTHESIS",
-	"(d)": "LEFT PARENTHESIS, LATIN SMALL LETTER D, RIGHT PARENTHESIS",
-	"(e)": "LEFT PARENTHESIS, LATIN SMALL LETTER E, RIGHT PARENTHESIS",
-	"(f)": "LEFT PARENTHESIS, LATIN SMALL LETTER F, RIGHT PARENTHESIS",
-	"(g)": "LEFT PARENTHESIS, LATIN SMALL LETTER G, RIGHT PARENTHESIS",
-	"(h)": "LEFT PARENTHESIS, LATIN SMALL LETTER H, RIGHT PARENTHESIS",
-	"(i)": "LEFT PARENTHESIS, LATIN SMALL LETTER I, RIGHT PARENTHESIS",
-	"(j)": "LEFT PARENTHESIS, LATIN SMALL LETTER J, RIGHT PARENTHESIS",
-	"(k)": "LEFT PARENTHESIS, LATIN SMALL LETTER K, RIGHT PARENTHESIS",
-	"(l)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, RIGHT PARENTHESIS",
-	"(l2)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, DIGIT TWO, RIGHT PARENTHESIS",
-	"(l3)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, DIGIT THREE, RIGHT PARENTHESIS",
-	"(l4)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, DIGIT FOUR, RIGHT PARENTHESIS",
-	"(l5)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, DIGIT FIVE, RIGHT PARENTHESIS",
-	"(l6)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, DIGIT SIX, RIGHT PARENTHESIS",
-	"(l7)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, DIGIT SEVEN, RIGHT PARENTHESIS",
-	"(l8)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, DIGIT EIGHT, RIGHT PARENTHESIS",
-	"(l9)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, DIGIT NINE, RIGHT PARENTHESIS",
-	"(lO)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, LATIN CAPITAL LETTER O, RIGHT PARENTHESIS",
-	"(ll)": "LEFT PARENTHESIS, LATIN SMALL LETTER L, LATIN SMALL LETTER L, RIGHT PARENTHESIS",
-	"(n)": "LEFT PARENTHESIS, LATIN SMALL LETTER N, RIGHT PARENTHESIS",
-	"(o)": "LEFT PARENTHESIS, LATIN SMALL LETTER O, RIGHT PARENTHESIS",
-	"(p)": "LEFT PARENTHESIS, LATIN SMALL LETTER P, RIGHT PARENTHESIS",
-	"(q)": "LEFT PARENTHESIS, LATIN SMALL LETTER Q, RIGHT PARENTHESIS",
-	"(r)": "LEFT PARENTHESIS, LATIN SMALL LETTER R, RIGHT PARENTHESIS",
-	"(rn)": "LEFT PARENTHESIS, LATIN SMALL LETTER R, LATIN SMALL LETTER N, RIGHT PARENTHESIS",
-	"(s)": "LEFT PARENTHESIS, LATIN SMALL LETTER S, RIGHT PARENTHESIS",
-	"(t)": "LEFT PARENTHESIS, LATIN SMALL LETTER T, RIGHT PARENTHESIS",
-	"(u)": "LEFT PARENTHESIS, LATIN SMALL LETTER U, RIGHT PARENTHESIS",
-	"(v)": "LEFT PARENTHESIS, LATIN SMALL LETTER V, RIGHT PARENTHESIS",
-	"(w)": "LEFT PARENTHESIS, LATIN SMALL LETTER W, RIGHT PARENTHESIS",
-	"(x)": "LEFT PARENTHESIS, LATIN SMALL LETTER X, RIGHT PARENTHESIS",
-	"(y)": "LEFT PARENTHESIS, LATIN SMALL LETTER Y, RIGHT PARENTHESIS",
-	"(z)": "LEFT PARENTHESIS, LATIN SMALL LETTER Z, RIGHT PARENTHESIS",
-	"(ᄀ)": "LEFT PARENTHESIS, HANGUL CHOSEONG KIYEOK, RIGHT PARENTHESIS",
-	"(ᄂ)": "LEFT PARENTHESIS, HANGUL CHOSEONG NIEUN, RIGHT PARENTHESIS",
-	"(ᄃ)": "LEFT PARENTHESIS, HANGUL CHOSEONG TIKEUT, RIGHT PARENTHESIS",
-	"(ᄅ)": "LEFT PARENTHESIS, HANGUL CHOSEONG RIEUL, RIGHT PARENTHESIS",
-	"(ᄆ)": "LEFT PARENTHESIS, HANGUL CHOSEONG MIEUM, RIGHT PARENTHESIS",
-	"(ᄇ)": "LEFT PARENTHESIS, HANGUL CHOSEONG PIEUP, RIGHT PARENTHESIS",
-	"(ᄉ)": "LEFT PARENTHESIS, HANGUL CHOSEONG SIOS, RIGHT PARENTHESIS",
-	"(ᄋ)": "LEFT PARENTHESIS, HANGUL CHOSEONG IEUNG, RIGHT PARENTHESIS",
-	"(ᄌ)": "LEFT PARENTHESIS, HANGUL CHOSEONG CIEUC, RIGHT PARENTHESIS",
-	"(ᄎ)": "LEFT PARENTHESIS, HANGUL CHOSEONG CHIEUCH, RIGHT PARENTHESIS",
-	"(ᄏ)": "LEFT PARENTHESIS, HANGUL CHOSEONG KHIEUKH, RIGHT PARENTHESIS",
-	"(ᄐ)": "LEFT PARENTHESIS, HANGUL CHOSEONG THIEUTH, RIGHT PARENTHESIS",
-	"(ᄑ)": "LEFT PARENTHESIS, HANGUL CHOSEONG PHIEUPH, RIGHT PARENTHESIS",
-	"(ᄒ)": "LEFT PARENTHESIS, HANGUL CHOSEONG HIEUH, RIGHT PARENTHESIS",
-	"(ー)": "LEFT PARENTHESIS, KATAKANA-HIRAGANA PROLONGED SOUND MARK, RIGHT PARENTHESIS",
-	"(七)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-4E03, RIGHT PARENTHESIS",
-	"(三)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-4E09, RIGHT PARENTHESIS",
-	"(九)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-4E5D, RIGHT PARENTHESIS",
-	"(二)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-4E8C, RIGHT PARENTHESIS",
-	"(五)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-4E94, RIGHT PARENTHESIS",
-	"(代)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-4EE3, RIGHT PARENTHESIS",
-	"(企)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-4F01, RIGHT PARENTHESIS",
-	"(休)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-4F11, RIGHT PARENTHESIS",
-	"(八)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-516B, RIGHT PARENTHESIS",
-	"(六)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-516D, RIGHT PARENTHESIS",
-	"(労)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-52B4, RIGHT PARENTHESIS",
-	"(勝)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-52DD, RIGHT PARENTHESIS",
-	"(十)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-5341, RIGHT PARENTHESIS",
-	"(協)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-5354, RIGHT PARENTHESIS",
-	"(名)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-540D, RIGHT PARENTHESIS",
-	"(呼)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-547C, RIGHT PARENTHESIS",
-	"(四)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-56DB, RIGHT PARENTHESIS",
-	"(土)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-571F, RIGHT PARENTHESIS",
-	"(学)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-5B66, RIGHT PARENTHESIS",
-	"(安)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-5B89, RIGHT PARENTHESIS",
-	"(打)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-6253, RIGHT PARENTHESIS",
-	"(敗)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-6557, RIGHT PARENTHESIS",
-	"(日)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-65E5, RIGHT PARENTHESIS",
-	"(月)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-6708, RIGHT PARENTHESIS",
-	"(有)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-6709, RIGHT PARENTHESIS",
-	"(木)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-6728, RIGHT PARENTHESIS",
-	"(本)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-672C, RIGHT PARENTHESIS",
-	"(株)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-682A, RIGHT PARENTHESIS",
-	"(水)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-6C34, RIGHT PARENTHESIS",
-	"(火)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-706B, RIGHT PARENTHESIS",
-	"(点)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-70B9, RIGHT PARENTHESIS",
-	"(特)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-7279, RIGHT PARENTHESIS",
-	"(盗)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-76D7, RIGHT PARENTHESIS",
-	"(監)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-76E3, RIGHT PARENTHESIS",
-	"(社)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-793E, RIGHT PARENTHESIS",
-	"(祝)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-795D, RIGHT PARENTHESIS",
-	"(祭)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-796D, RIGHT PARENTHESIS",
-	"(自)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-81EA, RIGHT PARENTHESIS",
-	"(至)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-81F3, RIGHT PARENTHESIS",
-	"(財)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-8CA1, RIGHT PARENTHESIS",
-	"(資)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-8CC7, RIGHT PARENTHESIS",
-	"(金)": "LEFT PARENTHESIS, CJK UNIFIED IDEOGRAPH-91D1, RIGHT PARENTHESIS",
-	"(가)": "LEFT PARENTHESIS, HANGUL SYLLABLE GA, RIGHT PARENTHESIS",
-	"(나)": "LEFT PARENTHESIS, HANGUL SYLLABLE NA, RIGHT PARENTHESIS",
-	"(다)": "LEFT PARENTHESIS, HANGUL SYLLABLE DA, RIGHT PARENTHESIS",
-	"(라)": "LEFT PARENTHESIS, HANGUL SYLLABLE RA, RIGHT PARENTHESIS",
-	"(마)": "LEFT PARENTHESIS, HANGUL SYLLABLE MA, RIGHT PARENTHESIS",
-	"(바)": "LEFT PARENTHESIS, HANGUL SYLLABLE BA, RIGHT PARENTHESIS",
-	"(사)": "LEFT PARENTHESIS, HANGUL SYLLABLE SA, RIGHT PARENTHESIS",
-	"(아)": "LEFT PARENTHESIS, HANGUL SYLLABLE A, RIGHT PARENTHESIS",
-	"(오전)": "LEFT PARENTHESIS, HANGUL SYLLABLE O, HANGUL SYLLABLE JEON, RIGHT PARENTHESIS",
-	"(오후)": "LEFT PARENTHESIS, HANGUL SYLLABLE O, HANGUL SYLLABLE HU, RIGHT PARENTHESIS",
-	"(자)": "LEFT PARENTHESIS, HANGUL SYLLABLE JA, RIGHT PARENTHESIS",
-	"(주)": "LEFT PARENTHESIS, HANGUL SYLLABLE JU, RIGHT PARENTHESIS",
-	"(차)": "LEFT PARENTHESIS, HANGUL SYLLABLE CA, RIGHT PARENTHESIS",
-	"(카)": "LEFT PARENTHESIS, HANGUL SYLLABLE KA, RIGHT PARENTHESIS",
-	"(타)": "LEFT PARENTHESIS, HANGUL SYLLABLE TA, RIGHT PARENTHESIS",
-	"(파)": "LEFT PARENTHESIS, HANGUL SYLLABLE PA, RIGHT PARENTHESIS",
-	"(하)": "LEFT PARENTHESIS, HANGUL SYLLABLE HA, RIGHT PARENTHESIS",
-	")": "RIGHT PARENTHESIS",
-	"))": "RIGHT PARENTHESIS, RIGHT PARENTHESIS",
-	"*": "ASTERISK",
-	"+": "PLUS SIGN",
-	"+̂": "PLUS SIGN, COMBINING CIRCUMFLEX ACCENT",
-	"+̃": "PLUS SIGN, COMBINING TILDE",
-	"+̇": "PLUS SIGN, COMBINING DOT ABOVE",
-	"+̊": "PLUS SIGN, COMBINING RING ABOVE",
-	"+̣": "PLUS SIGN, COMBINING DOT BELOW",
-	"+̰": "PLUS SIGN, COMBINING TILDE BELOW",
-	"+₂": "PLUS SIGN, SUBSCRIPT TWO",
-	",": "COMMA",
-	"-": "HYPHEN-MINUS",
-	"-.": "HYPHEN-MINUS, FULL STOP",
-	"-̇": "HYPHEN-MINUS, COMBINING DOT ABOVE",
-	"-̈": "HYPHEN-MINUS, COMBINING DIAERESIS",
-	"-̓": "HYPHEN-MINUS, COMBINING COMMA ABOVE",
-	"-̣": "HYPHEN-MINUS, COMBINING DOT BELOW",
-	".": "FULL STOP",
-	".,": "FULL STOP, COMMA",
-	"..": "FULL STOP, FULL STOP",
-	"...": "FULL STOP, FULL STOP, FULL STOP",
-	"/": "SOLIDUS",
-	"//": "SOLIDUS, SOLIDUS",
-	"///": "SOLIDUS, SOLIDUS, SOLIDUS",
-	"/̄": "SOLIDUS, COMBINING MACRON",
-	"0": "DIGIT ZERO",
-	"1": "DIGIT ONE",
-	"10": "DIGIT ONE, DIGIT ZERO",
-	"11": "DIGIT ONE, DIGIT ONE",
-	"12": "DIGIT ONE, DIGIT TWO",
-	"13": "DIGIT ONE, DIGIT THREE",
-	"14": "DIGIT ONE, DIGIT FOUR",
-	"15": "DIGIT ONE, DIGIT FIVE",
-	"16": "DIGIT ONE, DIGIT SIX",
-	"17": "DIGIT ONE, DIGIT SEVEN",
-	"18": "DIGIT ONE, DIGIT EIGHT",
-	"19": "DIGIT ONE, DIGIT NINE",
-	"2": "DIGIT TWO",
-	"2,": "DIGIT TWO, COMMA",
-	"2.": "DIGIT TWO, FULL STOP",
-	"20": "DIGIT TWO, DIGIT ZERO",
-	"22日": "DIGIT TWO, DIGIT TWO, CJK UNIFIED IDEOGRAPH-65E5",
-	"22点": "DIGIT TWO, DIGIT TWO, CJK UNIFIED IDEOGRAPH-70B9",
-	"23日": "DIGIT TWO, DIGIT THREE, CJK UNIFIED IDEOGRAPH-65E5",
-	"23点": "DIGIT TWO, DIGIT THREE, CJK UNIFIED IDEOGRAPH-70B9",
-	"24日": "DIGIT TWO, DIGIT FOUR, CJK UNIFIED IDEOGRAPH-65E5",
-	"24点": "DIGIT TWO, DIGIT FOUR, CJK UNIFIED IDEOGRAPH-70B9",
-	"25日": "DIGIT TWO, DIGIT FIVE, CJK UNIFIED IDEOGRAPH-65E5",
-	"26日": "DIGIT TWO, DIGIT SIX, CJK UNIFIED IDEOGRAPH-65E5",
-	"27日": "DIGIT TWO, DIGIT SEVEN, CJK UNIFIED IDEOGRAPH-65E5",
-	"28日": "DIGIT TWO, DIGIT EIGHT, CJK UNIFIED IDEOGRAPH-65E5",
-	"29日": "DIGIT TWO, DIGIT NINE, CJK UNIFIED IDEOGRAPH-65E5",
-	"2O.": "DIGIT TWO, LATIN CAPITAL LETTER O, FULL STOP",
-	"2O日": "DIGIT TWO, LATIN CAPITAL LETTER O, CJK UNIFIED IDEOGRAPH-65E5",
-	"2O点": "DIGIT TWO, LATIN CAPITAL LETTER O, CJK UNIFIED IDEOGRAPH-70B9",
-	"2l日": "DIGIT TWO, LATIN SMALL LETTER L, CJK UNIFIED IDEOGRAPH-65E5",
-	"2l点": "DIGIT TWO, LATIN SMALL LETTER L, CJK UNIFIED IDEOGRAPH-70B9",
-	"2̵": "DIGIT TWO, COMBINING SHORT STROKE OVERLAY",
-	"2日": "DIGIT TWO, CJK UNIFIED IDEOGRAPH-65E5",
-	"2月": "DIGIT TWO, CJK UNIFIED IDEOGRAPH-6708",
-	"2点": "DIGIT TWO, CJK UNIFIED IDEOGRAPH-70B9",
-	"3": "DIGIT THREE",
-	"3,": "DIGIT THREE, COMMA",
-	"3.": "DIGIT THREE, FULL STOP",
-	"3O日": "DIGIT THREE, LATIN CAPITAL LETTER O, CJK UNIFIED IDEOGRAPH-65E5",
-	"3l日": "DIGIT THREE, LATIN SMALL LETTER L, CJK UNIFIED IDEOGRAPH-65E5",
-	"3̦": "DIGIT THREE, COMBINING COMMA BELOW",
-	"3日": "DIGIT THREE, CJK UNIFIED IDEOGRAPH-65E5",
-	"3月": "DIGIT THREE, CJK UNIFIED IDEOGRAPH-6708",
-	"3点": "DIGIT THREE, CJK UNIFIED IDEOGRAPH-70B9",
-	"4": "DIGIT FOUR",
-	"4,": "DIGIT FOUR, COMMA",
-	"4.": "DIGIT FOUR, FULL STOP",
-	"4·": "DIGIT FOUR, MIDDLE DOT",
-	"4日": "DIGIT FOUR, CJK UNIFIED IDEOGRAPH-65E5",
-	"4月": "DIGIT FOUR, CJK UNIFIED IDEOGRAPH-6708",
-	"4点": "DIGIT FOUR, CJK UNIFIED IDEOGRAPH-70B9",
-	"5": "DIGIT FIVE",
-	"5,": "DIGIT FIVE, COMMA",
-	"5.": "DIGIT FIVE, FULL STOP",
-	"5日": "DIGIT FIVE, CJK UNIFIED IDEOGRAPH-65E5",
-	"5月": "DIGIT FIVE, CJK UNIFIED IDEOGRAPH-6708",
-	"5点": "DIGIT FIVE, CJK UNIFIED IDEOGRAPH-70B9",
-	"6": "DIGIT SIX",
-	"6,": "DIGIT SIX, COMMA",
-	"6.": "DIGIT SIX, FULL STOP",
-	"6日": "DIGIT SIX, CJK UNIFIED IDEOGRAPH-65E5",
-	"6月": "DIGIT SIX, CJK UNIFIED IDEOGRAPH-6708",
-	"6点": "DIGIT SIX, CJK UNIFIED IDEOGRAPH-70B9",
-	"7": "DIGIT SEVEN",
-	"7,": "DIGIT SEVEN, COMMA",
-	"7.": "DIGIT SEVEN, FULL STOP",
-	"7日": "DIGIT SEVEN, CJK UNIFIED IDEOGRAPH-65E5",
-	"7月": "DIGIT SEVEN, CJK UNIFIED IDEOGRAPH-6708",
-	"7点": "DIGIT SEVEN, CJK UNIFIED IDEOGRAPH-70B9",
-	"8": "DIGIT EIGHT",
-	"8,": "DIGIT EIGHT, COMMA",
-	"8.": "DIGIT EIGHT, FULL STOP",
-	"8日": "DIGIT EIGHT, CJK UNIFIED IDEOGRAPH-65E5",
-	"8月": "DIGIT EIGHT, CJK UNIFIED IDEOGRAPH-6708",
-	"8点": "DIGIT EIGHT, CJK UNIFIED IDEOGRAPH-70B9",
-	"9": "DIGIT NINE",
-	"9,": "DIGIT NINE, COMMA",
-	"9.": "DIGIT NINE, FULL STOP",
-	"9日": "DIGIT NINE, CJK UNIFIED IDEOGRAPH-65E5",
-	"9月": "DIGIT NINE, CJK UNIFIED IDEOGRAPH-6708",
-	"9点": "DIGIT NINE, CJK UNIFIED IDEOGRAPH-70B9",
-	":": "COLON",
-	"::=": "COLON, COLON, EQUALS SIGN",
-	":→": "COLON, RIGHTWARDS ARROW",
-	";": "SEMICOLON",
-	"<": "LESS-THAN SIGN",
-	"<<": "LESS-THAN SIGN, LESS-THAN SIGN",
-	"<<<": "LESS-THAN SIGN, LESS-THAN SIGN, LESS-THAN SIGN",
-	"<·": "LESS-THAN SIGN, MIDDLE DOT",
-	"=": "EQUALS SIGN",
-	"==": "EQUALS SIGN, EQUALS SIGN",
-	"===": "EQUALS SIGN, EQUALS SIGN, EQUALS SIGN",
-	"=̂": "EQUALS SIGN, COMBINING CIRCUMFLEX ACCENT",
-	"=̆": "EQUALS SIGN, COMBINING BREVE",
-	"=̇": "EQUALS SIGN, COMBINING DOT ABOVE",
-	"=̣̇": "EQUALS SIGN, COMBINING DOT ABOVE, COMBINING DOT BELOW",
-	"=̊": "EQUALS SIGN, COMBINING RING ABOVE",
-	"=ͫ": "EQUALS SIGN, COMBINING LATIN SMALL LETTER M",
-	"=⃰": "EQUALS SIGN, COMBINING ASTERISK ABOVE",
-	">": "GREATER-THAN SIGN",
-	"><": "GREATER-THAN SIGN, LESS-THAN SIGN",
-	">>": "GREATER-THAN SIGN, GREATER-THAN SIGN",
-	">>>": "GREATER-THAN SIGN, GREATER-THAN SIGN, GREATER-THAN SIGN",
-	">·": "GREATER-THAN SIGN, MIDDLE DOT",
-	"?!": "QUESTION MARK, EXCLAMATION MARK",
-	"?": "QUESTION MARK",
-	"??": "QUESTION MARK, QUESTION MARK",
-	"A": "LATIN CAPITAL LETTER A",
-	"AA": "LATIN CAPITAL LETTER A, LATIN CAPITAL LETTER A",
-	"AE": "LATIN CAPITAL LETTER A, LATIN CAPITAL LETTER E",
-	"AO": "LATIN CAPITAL LETTER A, LATIN CAPITAL LETTER O",
-	"AR": "LATIN CAPITAL LETTER A, LATIN CAPITAL LETTER R",
-	"AU": "LATIN CAPITAL LETTER A, LATIN CAPITAL LETTER U",
-	"AV": "LATIN CAPITAL LETTER A, LATIN CAPITAL LETTER V",
-	"AY": "LATIN CAPITAL LETTER A, LATIN CAPITAL LETTER Y",
-	"B": "LATIN CAPITAL LETTER B",
-	"C": "LATIN CAPITAL LETTER C",
-	"C'": "LATIN CAPITAL LETTER C, APOSTROPHE",
-	"C̦": "LATIN CAPITAL LETTER C, COMBINING COMMA BELOW",
-	"C⃠": "LATIN CAPITAL LETTER C, COMBINING ENCLOSING CIRCLE BACKSLASH",
-	"C⃫": "LATIN CAPITAL LETTER C, COMBINING LONG DOUBLE SOLIDUS OVERLAY",
-	"D": "LATIN CAPITAL LETTER D",
-	"DZ": "LATIN CAPITAL LETTER D, LATIN CAPITAL LETTER Z",
-	"Dz": "LATIN CAPITAL LETTER D, LATIN SMALL LETTER Z",
-	"DŽ": "LATIN CAPITAL LETTER D, LATIN CAPITAL LETTER Z WITH CARON",
-	"Dž": "LATIN CAPITAL LETTER D, LATIN SMALL LETTER Z WITH CARON",
-	"D̵": "LATIN CAPITAL LETTER D, COMBINING SHORT STROKE OVERLAY",
-	"E": "LATIN CAPITAL LETTER E",
-	"E̸": "LATIN CAPITAL LETTER E, COMBINING LONG SOLIDUS OVERLAY",
-	"F": "LATIN CAPITAL LETTER F",
-	"FAX": "LATIN CAPITAL LETTER F, LATIN CAPITAL LETTER A, LATIN CAPITAL LETTER X",
-	"F̦": "LATIN CAPITAL LETTER F, COMBINING COMMA BELOW",
-	"G": "LATIN CAPITAL LETTER G",
-	"G'": "LATIN CAPITAL LETTER G, APOSTROPHE",
-	"G̵": "LATIN CAPITAL LETTER G, COMBINING SHORT STROKE OVERLAY",
-	"H": "LATIN CAPITAL LETTER H",
-	"H̦": "LATIN CAPITAL LETTER H, COMBINING COMMA BELOW",
-	"H̩": "LATIN CAPITAL LETTER H, COMBINING VERTICAL LINE BELOW",
-	"H̵": "LATIN CAPITAL LETTER H, COMBINING SHORT STROKE OVERLAY",
-	"I": "LATIN CAPITAL LETTER I",
-	"J": "LATIN CAPITAL LETTER J",
-	"J·": "LATIN CAPITAL LETTER J, MIDDLE DOT",
-	"J̵": "LATIN CAPITAL LETTER J, COMBINING SHORT STROKE OVERLAY",
-	"K": "LATIN CAPITAL LETTER K",
-	"K'": "LATIN CAPITAL LETTER K, APOSTROPHE",
-	"K̩": "LATIN CAPITAL LETTER K, COMBINING VERTICAL LINE BELOW",
-	"K̵": "LATIN CAPITAL LETTER K, COMBINING SHORT STROKE OVERLAY",
-	"L": "LATIN CAPITAL LETTER L",
-	"LJ": "LATIN CAPITAL LETTER L, LATIN CAPITAL LETTER J",
-	"Lj": "LATIN CAPITAL LETTER L, LATIN SMALL LETTER J",
-	"L̸": "LATIN CAPITAL LETTER L, COMBINING LONG SOLIDUS OVERLAY",
-	"M": "LATIN CAPITAL LETTER M",
-	"MB": "LATIN CAPITAL LETTER M, LATIN CAPITAL LETTER B",
-	"M̦": "LATIN CAPITAL LETTER M, COMBINING COMMA BELOW",
-	"N": "LATIN CAPITAL LETTER N",
-	"NJ": "LATIN CAPITAL LETTER N, LATIN CAPITAL LETTER J",
-	"Nj": "LATIN CAPITAL LETTER N, LATIN SMALL LETTER J",
-	"No": "LATIN CAPITAL LETTER N, LATIN SMALL LETTER O",
-	"N̊": "LATIN CAPITAL LETTER N, COMBINING RING ABOVE",
-	"N̦": "LATIN CAPITAL LETTER N, COMBINING COMMA BELOW",
-	"O": "LATIN CAPITAL LETTER O",
-	"O'": "LATIN CAPITAL LETTER O, APOSTROPHE",
-	"O,": "LATIN CAPITAL LETTER O, COMMA",
-	"O.": "LATIN CAPITAL LETTER O, FULL STOP",
-	"OE": "LATIN CAPITAL LETTER O, LATIN CAPITAL LETTER E",
-	"OO": "LATIN CAPITAL LETTER O, LATIN CAPITAL LETTER O",
-	"O̵": "LATIN CAPITAL LETTER O, COMBINING SHORT STROKE OVERLAY",
-	"O̸": "LATIN CAPITAL LETTER O, COMBINING LONG SOLIDUS OVERLAY",
-	"Ó̸": "LATIN CAPITAL LETTER O, COMBINING LONG SOLIDUS OVERLAY, COMBINING ACUTE ACCENT",
-	"O点": "LATIN CAPITAL LETTER O, CJK UNIFIED IDEOGRAPH-70B9",
-	"P": "LATIN CAPITAL LETTER P",
-	"P'": "LATIN CAPITAL LETTER P, APOSTROPHE",
-	"Q": "LATIN CAPITAL LETTER Q",
-	"QE": "LATIN CAPITAL LETTER Q, LATIN CAPITAL LETTER E",
-	"R": "LATIN CAPITAL LETTER R",
-	"Rs": "LATIN CAPITAL LETTER R, LATIN SMALL LETTER S",
-	"S": "LATIN CAPITAL LETTER S",
-	"T": "LATIN CAPITAL LETTER T",
-	"T3": "LATIN CAPITAL LETTER T, DIGIT THREE",
-	"TEL": "LATIN CAPITAL LETTER T, LATIN CAPITAL LETTER E, LATIN CAPITAL LETTER L",
-	"T̈": "LATIN CAPITAL LETTER T, COMBINING DIAERESIS",
-	"T̨": "LATIN CAPITAL LETTER T, COMBINING OGONEK",
-	"T̩": "LATIN CAPITAL LETTER T, COMBINING VERTICAL LINE BELOW",
-	"T̵": "LATIN CAPITAL LETTER T, COMBINING SHORT STROKE OVERLAY",
-	"T̸": "LATIN CAPITAL LETTER T, COMBINING LONG SOLIDUS OVERLAY",
-	"T⃫": "LATIN CAPITAL LETTER T, COMBINING LONG DOUBLE SOLIDUS OVERLAY",
-	"U": "LATIN CAPITAL LETTER U",
-	"U'": "LATIN CAPITAL LETTER U, APOSTROPHE",
-	"U·": "LATIN CAPITAL LETTER U, MIDDLE DOT",
-	"U̵": "LATIN CAPITAL LETTER U, COMBINING SHORT STROKE OVERLAY",
-	"V": "LATIN CAPITAL LETTER V",
-	"VB": "LATIN CAPITAL LETTER V, LATIN CAPITAL LETTER B",
-	"Vl": "LATIN CAPITAL LETTER V, LATIN SMALL LETTER L",
-	"Vll": "LATIN CAPITAL LETTER V, LATIN SMALL LETTER L, LATIN SMALL LETTER L",
-	"Vlll": "LATIN CAPITAL LETTER V, LATIN SMALL LETTER L, LATIN SMALL LETTER L, LATIN SMALL LETTER L",
-	"V·": "LATIN CAPITAL LETTER V, MIDDLE DOT",
-	"V̵": "LATIN CAPITAL LETTER V, COMBINING SHORT STROKE OVERLAY",
-	"Vᷤ": "LATIN CAPITAL LETTER V, COMBINING LATIN SMALL LETTER S",
-	"W": "LATIN CAPITAL LETTER W",
-	"W̵": "LATIN CAPITAL LETTER W, COMBINING SHORT STROKE OVERLAY",
-	"X": "LATIN CAPITAL LETTER X",
-	"Xl": "LATIN CAPITAL LETTER X, LATIN SMALL LETTER L",
-	"Xll": "LATIN CAPITAL LETTER X, LATIN SMALL LETTER L, LATIN SMALL LETTER L",
-	"X̩": "LATIN CAPITAL LETTER X, COMBINING VERTICAL LINE BELOW",
-	"X̵": "LATIN CAPITAL LETTER X, COMBINING SHORT STROKE OVERLAY",
-	"Y": "LATIN CAPITAL LETTER Y",
-	"Y̵": "LATIN CAPITAL LETTER Y, COMBINING SHORT STROKE OVERLAY",
-	"Z": "LATIN CAPITAL LETTER Z",
-	"Z̦": "LATIN CAPITAL LETTER Z, COMBINING COMMA BELOW",
-	"Z̵": "LATIN CAPITAL LETTER Z, COMBINING SHORT STROKE OVERLAY",
-	"\"": "QUOTATION MARK",
-	"\U0001ccd6": "OUTLINED LATIN CAPITAL LETTER A",
-	"\U0001ccd7": "OUTLINED LATIN CAPITAL LETTER B",
-	"\U0001ccd8": "OUTLINED LATIN CAPITAL LETTER C",
-	"\U0001ccd9": "OUTLINED LATIN CAPITAL LETTER D",
-	"\U0001ccda": "OUTLINED LATIN CAPITAL LETTER E",
-	"\U0001ccdb": "OUTLINED LATIN CAPITAL LETTER F",
-	"\U0001ccdc": "OUTLINED LATIN CAPITAL LETTER G",
-	"\U0001ccdd": "OUTLINED LATIN CAPITAL LETTER H",
-	"\U0001ccde": "OUTLINED LATIN CAPITAL LETTER I",
-	"\U0001ccdf": "OUTLINED LATIN CAPITAL LETTER J",
-	"\U0001cce0": "OUTLINED LATIN CAPITAL LETTER K",
-	"\U0001cce1": "OUTLINED LATIN CAPITAL LETTER L",
-	"\U0001cce2": "OUTLINED LATIN CAPITAL LETTER M",
-	"\U0001cce3": "OUTLINED LATIN CAPITAL LETTER N",
-	"\U0001cce4": "OUTLINED LATIN CAPITAL LETTER O",
-	"\U0001cce5": "OUTLINED LATIN CAPITAL LETTER P",
-	"\U0001cce6": "OUTLINED LATIN CAPITAL LETTER Q",
-	"\U0001cce7": "OUTLINED LATIN CAPITAL LETTER R",
-	"\U0001cce8": "OUTLINED LATIN CAPITAL LETTER S",
-	"\U0001cce9": "OUTLINED LATIN CAPITAL LETTER T",
-	"\U0001ccea": "OUTLINED LATIN CAPITAL LETTER U",
-	"\U0001cceb": "OUTLINED LATIN CAPITAL LETTER V",
-	"\U0001ccec": "OUTLINED LATIN CAPITAL LETTER W",
-	"\U0001cced": "OUTLINED LATIN CAPITAL LETTER X",
-	"\U0001ccee": "OUTLINED LATIN CAPITAL LETTER Y",
-	"\U0001ccef": "OUTLINED LATIN CAPITAL LETTER Z",
-	"\U0001ccf0": "OUTLINED DIGIT ZERO",
-	"\U0001ccf1": "OUTLINED DIGIT ONE",
-	"\U0001ccf2": "OUTLINED DIGIT TWO",
-	"\U0001ccf3": "OUTLINED DIGIT THREE",
-	"\U0001ccf4": "OUTLINED DIGIT FOUR",
-	"\U0001ccf5": "OUTLINED DIGIT FIVE",
-	"\U0001ccf6": "OUTLINED DIGIT SIX",
-	"\U0001ccf7": "OUTLINED DIGIT SEVEN",
-	"\U0001ccf8": "OUTLINED DIGIT EIGHT",
-	"\U0001ccf9": "OUTLINED DIGIT NINE",
-	"\\": "REVERSE SOLIDUS",
-	"\\\\": "REVERSE SOLIDUS, REVERSE SOLIDUS",
-	"\\ᑕ": "REVERSE SOLIDUS, CANADIAN SYLLABICS TA",
-	"\u00a0": "NO-BREAK SPACE",
-	"\u1680": "OGHAM SPACE MARK",
-	"\u2000": "EN QUAD",
-	"\u2001": "EM QUAD",
-	"\u2002": "EN SPACE",
-	"\u2003": "EM SPACE",
-	"\u2004": "THREE-PER-EM SPACE",
-	"\u2005": "FOUR-PER-EM SPACE",
-	"\u2006": "SIX-PER-EM SPACE",
-	"\u2007": "FIGURE SPACE",
-	"\u2008": "PUNCTUATION SPACE",
-	"\u2009": "THIN SPACE",
-	"\u200a": "HAIR SPACE",
-	"\u2028": "LINE SEPARATOR",
-	"\u2029": "PARAGRAPH SEPARATOR",
-	"\u202f": "NARROW NO-BREAK SPACE",
-	"\u205f": "MEDIUM MATHEMATICAL SPACE",
-	"\ua7da": "LATIN CAPITAL LETTER LAMBDA",
-	"\ua7db": "LATIN SMALL LETTER LAMBDA",
-	"\ua7dc": "LATIN CAPITAL LETTER LAMBDA WITH STROKE",
-	"^": "CIRCUMFLEX ACCENT",
-	"_": "LOW LINE",
-	"`": "GRAVE ACCENT",
-	"a": "LATIN SMALL LETTER A",
-	"a/c": "LATIN SMALL LETTER A, SOLIDUS, LATIN SMALL LETTER C",
-	"a/s": "LATIN SMALL LETTER A, SOLIDUS, LATIN SMALL LETTER S",
-	"aa": "LATIN SMALL LETTER A, LATIN SMALL LETTER A",
-	"ae": "LATIN SMALL LETTER A, LATIN SMALL LETTER E",
-	"ao": "LATIN SMALL LETTER A, LATIN SMALL LETTER O",
-	"au": "LATIN SMALL LETTER A, LATIN SMALL LETTER U",
-	"av": "LATIN SMALL LETTER A, LATIN SMALL LETTER V",
-	"ay": "LATIN SMALL LETTER A, LATIN SMALL LETTER Y",
-	"a̲": "LATIN SMALL LETTER A, COMBINING LOW LINE",
-	"b": "LATIN SMALL LETTER B",
-	"b'": "LATIN SMALL LETTER B, APOSTROPHE",
-	"bl": "LATIN SMALL LETTER B, LATIN SMALL LETTER L",
-	"b·": "LATIN SMALL LETTER B, MIDDLE DOT",
-	"b̄": "LATIN SMALL LETTER B, COMBINING MACRON",
-	"ḃ": "LATIN SMALL LETTER B, COMBINING DOT ABOVE",
-	"ḃ·": "LATIN SMALL LETTER B, COMBINING DOT ABOVE, MIDDLE DOT",
-	"b̔": "LATIN SMALL LETTER B, COMBINING REVERSED COMMA ABOVE",
-	"b̵": "LATIN SMALL LETTER B, COMBINING SHORT STROKE OVERLAY",
-	"c": "LATIN SMALL LETTER C",
-	"c/o": "LATIN SMALL LETTER C, SOLIDUS, LATIN SMALL LETTER O",
-	"c/u": "LATIN SMALL LETTER C, SOLIDUS, LATIN SMALL LETTER U",
-	"c̦": "LATIN SMALL LETTER C, COMBINING COMMA BELOW",
-	"c̸": "LATIN SMALL LETTER C, COMBINING LONG SOLIDUS OVERLAY",
-	"d": "LATIN SMALL LETTER D",
-	"d'": "LATIN SMALL LETTER D, APOSTROPHE",
-	"dz": "LATIN SMALL LETTER D, LATIN SMALL LETTER Z",
-	"d·": "LATIN SMALL LETTER D, MIDDLE DOT",
-	"dž": "LATIN SMALL LETTER D, LATIN SMALL LETTER Z WITH CARON",
-	"dȝ": "LATIN SMALL LETTER D, LATIN SMALL LETTER YOGH",
-	"dʑ": "LATIN SMALL LETTER D, LATIN SMALL LETTER Z WITH CURL",
-	"d̄": "LATIN SMALL LETTER D, COMBINING MACRON",
-	"d̔": "LATIN SMALL LETTER D, COMBINING REVERSED COMMA ABOVE",
-	"d̨": "LATIN SMALL LETTER D, COMBINING OGONEK",
-	"d̵": "LATIN SMALL LETTER D, COMBINING SHORT STROKE OVERLAY",
-	"ḏ̵": "LATIN SMALL LETTER D, COMBINING SHORT STROKE OVERLAY, COMBINING MACRON BELOW",
-	"e": "LATIN SMALL LETTER E",
-	"ę": "LATIN SMALL LETTER E, COMBINING OGONEK",
-	"e̸": "LATIN SMALL LETTER E, COMBINING LONG SOLIDUS OVERLAY",
-	"f": "LATIN SMALL LETTER F",
-	"ff": "LATIN SMALL LETTER F, LATIN SMALL LETTER F",
-	"ffi": "LATIN SMALL LETTER F, LATIN SMALL LETTER F, LATIN SMALL LETTER I",
-	"ffl": "LATIN SMALL LETTER F, LATIN SMALL LETTER F, LATIN SMALL LETTER L",
-	"fi": "LATIN SMALL LETTER F, LATIN SMALL LETTER I",
-	"fl": "LATIN SMALL LETTER F, LATIN SMALL LETTER L",
-	"fŋ": "LATIN SMALL LETTER F, LATIN SMALL LETTER ENG",
-	"f̦": "LATIN SMALL LETTER F, COMBINING COMMA BELOW",
-	"f̴": "LATIN SMALL LETTER F, COMBINING TILDE OVERLAY",
-	"g": "LATIN SMALL LETTER G",
-	"g̔": "LATIN SMALL LETTER G, COMBINING REVERSED COMMA ABOVE",
-	"g̵": "LATIN SMALL LETTER G, COMBINING SHORT STROKE OVERLAY",
-	"h": "LATIN SMALL LETTER H",
-	"h̔": "LATIN SMALL LETTER H, COMBINING REVERSED COMMA ABOVE",
-	"h̵": "LATIN SMALL LETTER H, COMBINING SHORT STROKE OVERLAY",
-	"i": "LATIN SMALL LETTER I",
-	"ii": "LATIN SMALL LETTER I, LATIN SMALL LETTER I",
-	"iii": "LATIN SMALL LETTER I, LATIN SMALL LETTER I, LATIN SMALL LETTER I",
-	"ij": "LATIN SMALL LETTER I, LATIN SMALL LETTER J",
-	"iv": "LATIN SMALL LETTER I, LATIN SMALL LETTER V",
-	"ix": "LATIN SMALL LETTER I, LATIN SMALL LETTER X",
-	"i̲": "LATIN SMALL LETTER I, COMBINING LOW LINE",
-	"i̵": "LATIN SMALL LETTER I, COMBINING SHORT STROKE OVERLAY",
-	"j": "LATIN SMALL LETTER J",
-	"j̵": "LATIN SMALL LETTER J, COMBINING SHORT STROKE OVERLAY",
-	"k": "LATIN SMALL LETTER K",
-	"k̔": "LATIN SMALL LETTER K, COMBINING REVERSED COMMA ABOVE",
-	"l": "LATIN SMALL LETTER L",
-	"l'": "LATIN SMALL LETTER L, APOSTROPHE",
-	"l,": "LATIN SMALL LETTER L, COMMA",
-	"l.": "LATIN SMALL LETTER L, FULL STOP",
-	"l2.": "LATIN SMALL LETTER L, DIGIT TWO, FULL STOP",
-	"l2日": "LATIN SMALL LETTER L, DIGIT TWO, CJK UNIFIED IDEOGRAPH-65E5",
-	"l2月": "LATIN SMALL LETTER L, DIGIT TWO, CJK UNIFIED IDEOGRAPH-6708",
-	"l2点": "LATIN SMALL LETTER L, DIGIT TWO, CJK UNIFIED IDEOGRAPH-70B9",
-	"l3.": "LATIN SMALL LETTER L, DIGIT THREE, FULL STOP",
-	"l3日": "LATIN SMALL LETTER L, DIGIT THREE, CJK UNIFIED IDEOGRAPH-65E5",
-	"l3点": "LATIN SMALL LETTER L, DIGIT THREE, CJK UNIFIED IDEOGRAPH-70B9",
-	"l4.": "LATIN SMALL LETTER L, DIGIT FOUR, FULL STOP",
-	"l4日": "LATIN SMALL LETTER L, DIGIT FOUR, CJK UNIFIED IDEOGRAPH-65E5",
-	"l4点": "LATIN SMALL LETTER L, DIGIT FOUR, CJK UNIFIED IDEOGRAPH-70B9",
-	"l5.": "LATIN SMALL LETTER L, DIGIT FIVE, FULL STOP",
-	"l5日": "LATIN SMALL LETTER L, DIGIT FIVE, CJK UNIFIED IDEOGRAPH-65E5",
-	"l5点": "LATIN SMALL LETTER L, DIGIT FIVE, CJK UNIFIED IDEOGRAPH-70B9",
-	"l6.": "LATIN SMALL LETTER L, DIGIT SIX, FULL STOP",
-	"l6日": "LATIN SMALL LETTER L, DIGIT SIX, CJK UNIFIED IDEOGRAPH-65E5",
-	"l6点": "LATIN SMALL LETTER L, DIGIT SIX, CJK UNIFIED IDEOGRAPH-70B9",
-	"l7.": "LATIN SMALL LETTER L, DIGIT SEVEN, FULL STOP",
-	"l7日": "LATIN SMALL LETTER L, DIGIT SEVEN, CJK UNIFIED IDEOGRAPH-65E5",
-	"l7点": "LATIN SMALL LETTER L, DIGIT SEVEN, CJK UNIFIED IDEOGRAPH-70B9",
-	"l8.": "LATIN SMALL LETTER L, DIGIT EIGHT, FULL STOP",
-	"l8日": "LATIN SMALL LETTER L, DIGIT EIGHT, CJK UNIFIED IDEOGRAPH-65E5",
-	"l8点": "LATIN SMALL LETTER L, DIGIT EIGHT, CJK UNIFIED IDEOGRAPH-70B9",
-	"l9.": "LATIN SMALL LETTER L, DIGIT NINE, FULL STOP",
-	"l9日": "LATIN SMALL LETTER L, DIGIT NINE, CJK UNIFIED IDEOGRAPH-65E5",
-	"l9点": "LATIN SMALL LETTER L, DIGIT NINE, CJK UNIFIED IDEOGRAPH-70B9",
-	"lJ": "LATIN SMALL LETTER L, LATIN CAPITAL LETTER J",
-	"lO": "LATIN SMALL LETTER L, LATIN CAPITAL LETTER O",
-	"lO.": "LATIN SMALL LETTER L, LATIN CAPITAL LETTER O, FULL STOP",
-	"lO日": "LATIN SMALL LETTER L, LATIN CAPITAL LETTER O, CJK UNIFIED IDEOGRAPH-65E5",
-	"lO月": "LATIN SMALL LETTER L, LATIN CAPITAL LETTER O, CJK UNIFIED IDEOGRAPH-6708",
-	"lO点": "LATIN SMALL LETTER L, LATIN CAPITAL LETTER O, CJK UNIFIED IDEOGRAPH-70B9",
-	"lV": "LATIN SMALL LETTER L, LATIN CAPITAL LETTER V",
-	"lX": "LATIN SMALL LETTER L, LATIN CAPITAL LETTER X",
-	"lj": "LATIN SMALL LETTER L, LATIN SMALL LETTER J",
-	"ll": "LATIN SMALL LETTER L, LATIN SMALL LETTER L",
-	"ll.": "LATIN SMALL LETTER L, LATIN SMALL LETTER L, FULL STOP",
-	"lll": "LATIN SMALL LETTER L, LATIN SMALL LETTER L, LATIN SMALL LETTER L",
-	"ll日": "LATIN SMALL LETTER L, LATIN SMALL LETTER L, CJK UNIFIED IDEOGRAPH-65E5",
-	"ll月": "LATIN SMALL LETTER L, LATIN SMALL LETTER L, CJK UNIFIED IDEOGRAPH-6708",
-	"ll点": "LATIN SMALL LETTER L, LATIN SMALL LETTER L, CJK UNIFIED IDEOGRAPH-70B9",
-	"ls": "LATIN SMALL LETTER L, LATIN SMALL LETTER S",
-	"lt": "LATIN SMALL LETTER L, LATIN SMALL LETTER T",
-	"lz": "LATIN SMALL LETTER L, LATIN SMALL LETTER Z",
-	"l·": "LATIN SMALL LETTER L, MIDDLE DOT",
-	"lȝ": "LATIN SMALL LETTER L, LATIN SMALL LETTER YOGH",
-	"l̋": "LATIN SMALL LETTER L, COMBINING DOUBLE ACUTE ACCENT",
-	"l̨": "LATIN SMALL LETTER L, COMBINING OGONEK",
-	"l̴": "LATIN SMALL LETTER L, COMBINING TILDE OVERLAY",
-	"l̵": "LATIN SMALL LETTER L, COMBINING SHORT STROKE OVERLAY",
-	"l̵l̵": "LATIN SMALL LETTER L, COMBINING SHORT STROKE OVERLAY, LATIN SMALL LETTER L, COMBINING SHORT STROKE OVERLAY",
-	"l̵l̵S̵": "LATIN SMALL LETTER L, COMBINING SHORT STROKE OVERLAY, LATIN SMALL LETTER L, COMBINING SHORT STROKE OVERLAY, LATIN CAPITAL LETTER S, COMBINING SHORT STROKE OVERLAY",
-	"l̸": "LATIN SMALL LETTER L, COMBINING LONG SOLIDUS OVERLAY",
-	"lكبر": "LATIN SMALL LETTER L, ARABIC LETTER KAF, ARABIC LETTER BEH, ARABIC LETTER REH",
-	"lللّٰo": "LATIN SMALL LETTER L, ARABIC LETTER LAM, ARABIC LETTER LAM, ARABIC SHADDA, ARABIC LETTER SUPERSCRIPT ALEF, LATIN SMALL LETTER O",
-	"lٕ": "LATIN SMALL LETTER L, ARABIC HAMZA BELOW",
-	"lٴ": "LATIN SMALL LETTER L, ARABIC LETTER HIGH HAMZA",
-	"l日": "LATIN SMALL LETTER L, CJK UNIFIED IDEOGRAPH-65E5",
-	"l月": "LATIN SMALL LETTER L, CJK UNIFIED IDEOGRAPH-6708",
-	"l点": "LATIN SMALL LETTER L, CJK UNIFIED IDEOGRAPH-70B9",
-	"m": "LATIN SMALL LETTER M",
-	"n": "LATIN SMALL LETTER N",
-	"nj": "LATIN SMALL LETTER N, LATIN SMALL LETTER J",
-	"n̨": "LATIN SMALL LETTER N, COMBINING OGONEK",
-	"n̩": "LATIN SMALL LETTER N, COMBINING VERTICAL LINE BELOW",
-	"n̴": "LATIN SMALL LETTER N, COMBINING TILDE OVERLAY",
-	"o": "LATIN SMALL LETTER O",
-	"o'": "LATIN SMALL LETTER O, APOSTROPHE",
-	"oe": "LATIN SMALL LETTER O, LATIN SMALL LETTER E",
-	"oo": "LATIN SMALL LETTER O, LATIN SMALL LETTER O",
-	"ô": "LATIN SMALL LETTER O, COMBINING CIRCUMFLEX ACCENT",
-	"ơ": "LATIN SMALL LETTER O, COMBINING HORN",
-	"o̵": "LATIN SMALL LETTER O, COMBINING SHORT STROKE OVERLAY",
-	"o̸": "LATIN SMALL LETTER O, COMBINING LONG SOLIDUS OVERLAY",
-	"oج": "LATIN SMALL LETTER O, ARABIC LETTER JEEM",
-	"oم": "LATIN SMALL LETTER O, ARABIC LETTER MEEM",
-	"oمج": "LATIN SMALL LETTER O, ARABIC LETTER MEEM, ARABIC LETTER JEEM",
-	"oمم": "LATIN SMALL LETTER O, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"oى": "LATIN SMALL LETTER O, ARABIC LETTER ALEF MAKSURA",
-	"oٰ": "LATIN SMALL LETTER O, ARABIC LETTER SUPERSCRIPT ALEF",
-	"oരo": "LATIN SMALL LETTER O, MALAYALAM LETTER RA, LATIN SMALL LETTER O",
-	"oာ": "LATIN SMALL LETTER O, MYANMAR VOWEL SIGN AA",
-	"oᴇ": "LATIN SMALL LETTER O, LATIN LETTER SMALL CAPITAL E",
-	"p": "LATIN SMALL LETTER P",
-	"p·": "LATIN SMALL LETTER P, MIDDLE DOT",
-	"p̔": "LATIN SMALL LETTER P, COMBINING REVERSED COMMA ABOVE",
-	"p̵": "LATIN SMALL LETTER P, COMBINING SHORT STROKE OVERLAY",
-	"q": "LATIN SMALL LETTER Q",
-	"q̔": "LATIN SMALL LETTER Q, COMBINING REVERSED COMMA ABOVE",
-	"r": "LATIN SMALL LETTER R",
-	"r'": "LATIN SMALL LETTER R, APOSTROPHE",
-	"rn": "LATIN SMALL LETTER R, LATIN SMALL LETTER N",
-	"rn̦": "LATIN SMALL LETTER R, LATIN SMALL LETTER N, COMBINING COMMA BELOW",
-	"rn̴": "LATIN SMALL LETTER R, LATIN SMALL LETTER N, COMBINING TILDE OVERLAY",
-	"rn̸": "LATIN SMALL LETTER R, LATIN SMALL LETTER N, COMBINING LONG SOLIDUS OVERLAY",
-	"r̨": "LATIN SMALL LETTER R, COMBINING OGONEK",
-	"r̩": "LATIN SMALL LETTER R, COMBINING VERTICAL LINE BELOW",
-	"r̴": "LATIN SMALL LETTER R, COMBINING TILDE OVERLAY",
-	"r̵": "LATIN SMALL LETTER R, COMBINING SHORT STROKE OVERLAY",
-	"s": "LATIN SMALL LETTER S",
-	"sss": "LATIN SMALL LETTER S, LATIN SMALL LETTER S, LATIN SMALL LETTER S",
-	"st": "LATIN SMALL LETTER S, LATIN SMALL LETTER T",
-	"s̨": "LATIN SMALL LETTER S, COMBINING OGONEK",
-	"s̴": "LATIN SMALL LETTER S, COMBINING TILDE OVERLAY",
-	"t": "LATIN SMALL LETTER T",
-	"tf": "LATIN SMALL LETTER T, LATIN SMALL LETTER F",
-	"ts": "LATIN SMALL LETTER T, LATIN SMALL LETTER S",
-	"tȝ": "LATIN SMALL LETTER T, LATIN SMALL LETTER YOGH",
-	"tɕ": "LATIN SMALL LETTER T, LATIN SMALL LETTER C WITH CURL",
-	"tʃ": "LATIN SMALL LETTER T, LATIN SMALL LETTER ESH",
-	"t̔": "LATIN SMALL LETTER T, COMBINING REVERSED COMMA ABOVE",
-	"t̴": "LATIN SMALL LETTER T, COMBINING TILDE OVERLAY",
-	"t̵": "LATIN SMALL LETTER T, COMBINING SHORT STROKE OVERLAY",
-	"u": "LATIN SMALL LETTER U",
-	"ue": "LATIN SMALL LETTER U, LATIN SMALL LETTER E",
-	"uo": "LATIN SMALL LETTER U, LATIN SMALL LETTER O",
-	"u̵": "LATIN SMALL LETTER U, COMBINING SHORT STROKE OVERLAY",
-	"v": "LATIN SMALL LETTER V",
-	"vi": "LATIN SMALL LETTER V, LATIN SMALL LETTER I",
-	"vii": "LATIN SMALL LETTER V, LATIN SMALL LETTER I, LATIN SMALL LETTER I",
-	"viii": "LATIN SMALL LETTER V, LATIN SMALL LETTER I, LATIN SMALL LETTER I, LATIN SMALL LETTER I",
-	"w": "LATIN SMALL LETTER W",
-	"ẇ": "LATIN SMALL LETTER W, COMBINING DOT ABOVE",
-	"w̦": "LATIN SMALL LETTER W, COMBINING COMMA BELOW",
-	"w҆҇": "LATIN SMALL LETTER W, COMBINING CYRILLIC PSILI PNEUMATA, COMBINING CYRILLIC POKRYTIE",
-	"x": "LATIN SMALL LETTER X",
-	"xi": "LATIN SMALL LETTER X, LATIN SMALL LETTER I",
-	"xii": "LATIN SMALL LETTER X, LATIN SMALL LETTER I, LATIN SMALL LETTER I",
-	"ẋ": "LATIN SMALL LETTER X, COMBINING DOT ABOVE",
-	"y": "LATIN SMALL LETTER Y",
-	"y̔": "LATIN SMALL LETTER Y, COMBINING REVERSED COMMA ABOVE",
-	"y̵": "LATIN SMALL LETTER Y, COMBINING SHORT STROKE OVERLAY",
-	"z": "LATIN SMALL LETTER Z",
-	"z̦": "LATIN SMALL LETTER Z, COMBINING COMMA BELOW",
-	"z̨": "LATIN SMALL LETTER Z, COMBINING OGONEK",
-	"z̴": "LATIN SMALL LETTER Z, COMBINING TILDE OVERLAY",
-	"z̵": "LATIN SMALL LETTER Z, COMBINING SHORT STROKE OVERLAY",
-	"{": "LEFT CURLY BRACKET",
-	"|": "VERTICAL LINE",
-	"}": "RIGHT CURLY BRACKET",
-	"~": "TILDE",
-	"~̇": "TILDE, COMBINING DOT ABOVE",
-	"~̈": "TILDE, COMBINING DIAERESIS",
-	"~̣": "TILDE, COMBINING DOT BELOW",
-	"¢": "CENT SIGN",
-	"£": "POUND SIGN",
-	"¥": "YEN SIGN",
-	"©": "COPYRIGHT SIGN",
-	"®": "REGISTERED SIGN",
-	"¯": "MACRON",
-	"°": "DEGREE SIGN",
-	"°C": "DEGREE SIGN, LATIN CAPITAL LETTER C",
-	"°F": "DEGREE SIGN, LATIN CAPITAL LETTER F",
-	"°̈": "DEGREE SIGN, COMBINING DIAERESIS",
-	"°̲": "DEGREE SIGN, COMBINING LOW LINE",
-	"´": "ACUTE ACCENT",
-	"µ": "MICRO SIGN",
-	"¶": "PILCROW SIGN",
-	"·": "MIDDLE DOT",
-	"·4": "MIDDLE DOT, DIGIT FOUR",
-	"·<": "MIDDLE DOT, LESS-THAN SIGN",
-	"·>": "MIDDLE DOT, GREATER-THAN SIGN",
-	"·J": "MIDDLE DOT, LATIN CAPITAL LETTER J",
-	"·L": "MIDDLE DOT, LATIN CAPITAL LETTER L",
-	"·P": "MIDDLE DOT, LATIN CAPITAL LETTER P",
-	"·U": "MIDDLE DOT, LATIN CAPITAL LETTER U",
-	"·V": "MIDDLE DOT, LATIN CAPITAL LETTER V",
-	"·b": "MIDDLE DOT, LATIN SMALL LETTER B",
-	"·ḃ": "MIDDLE DOT, LATIN SMALL LETTER B, COMBINING DOT ABOVE",
-	"·d": "MIDDLE DOT, LATIN SMALL LETTER D",
-	"···": "MIDDLE DOT, MIDDLE DOT, MIDDLE DOT",
-	"·Ʌ": "MIDDLE DOT, LATIN CAPITAL LETTER TURNED V",
-	"·Γ": "MIDDLE DOT, GREEK CAPITAL LETTER GAMMA",
-	"·Δ": "MIDDLE DOT, GREEK CAPITAL LETTER DELTA",
-	"·Ո": "MIDDLE DOT, ARMENIAN CAPITAL LETTER VO",
-	"·ᐁ": "MIDDLE DOT, CANADIAN SYLLABICS E",
-	"·ᐄ": "MIDDLE DOT, CANADIAN SYLLABICS II",
-	"·ᐅ": "MIDDLE DOT, CANADIAN SYLLABICS O",
-	"·ᐆ": "MIDDLE DOT, CANADIAN SYLLABICS OO",
-	"·ᐊ": "MIDDLE DOT, CANADIAN SYLLABICS A",
-	"·ᐋ": "MIDDLE DOT, CANADIAN SYLLABICS AA",
-	"·ᐲ": "MIDDLE DOT, CANADIAN SYLLABICS PII",
-	"·ᐴ": "MIDDLE DOT, CANADIAN SYLLABICS POO",
-	"·ᐹ": "MIDDLE DOT, CANADIAN SYLLABICS PAA",
-	"·ᑏ": "MIDDLE DOT, CANADIAN SYLLABICS TII",
-	"·ᑐ": "MIDDLE DOT, CANADIAN SYLLABICS TO",
-	"·ᑑ": "MIDDLE DOT, CANADIAN SYLLABICS TOO",
-	"·ᑕ": "MIDDLE DOT, CANADIAN SYLLABICS TA",
-	"·ᑖ": "MIDDLE DOT, CANADIAN SYLLABICS TAA",
-	"·ᑫ": "MIDDLE DOT, CANADIAN SYLLABICS KE",
-	"·ᑮ": "MIDDLE DOT, CANADIAN SYLLABICS KII",
-	"·ᑰ": "MIDDLE DOT, CANADIAN SYLLABICS KOO",
-	"·ᒉ": "MIDDLE DOT, CANADIAN SYLLABICS CE",
-	"·ᒋ": "MIDDLE DOT, CANADIAN SYLLABICS CI",
-	"·ᒌ": "MIDDLE DOT, CANADIAN SYLLABICS CII",
-	"·ᒎ": "MIDDLE DOT, CANADIAN SYLLABICS COO",
-	"·ᒐ": "MIDDLE DOT, CANADIAN SYLLABICS CA",
-	"·ᒑ": "MIDDLE DOT, CANADIAN SYLLABICS CAA",
-	"·ᒣ": "MIDDLE DOT, CANADIAN SYLLABICS ME",
-	"·ᒦ": "MIDDLE DOT, CANADIAN SYLLABICS MII",
-	"·ᒧ": "MIDDLE DOT, CANADIAN SYLLABICS MO",
-	"·ᒨ": "MIDDLE DOT, CANADIAN SYLLABICS MOO",
-	"·ᒫ": "MIDDLE DOT, CANADIAN SYLLABICS MAA",
-	"·ᓀ": "MIDDLE DOT, CANADIAN SYLLABICS NE",
-	"·ᓂ": "MIDDLE DOT, CANADIAN SYLLABICS NI",
-	"·ᓃ": "MIDDLE DOT, CANADIAN SYLLABICS NII",
-	"·ᓄ": "MIDDLE DOT, CANADIAN SYLLABICS NO",
-	"·ᓅ": "MIDDLE DOT, CANADIAN SYLLABICS NOO",
-	"·ᓇ": "MIDDLE DOT, CANADIAN SYLLABICS NA",
-	"·ᓈ": "MIDDLE DOT, CANADIAN SYLLABICS NAA",
-	"·ᓓ": "MIDDLE DOT, CANADIAN SYLLABICS LE",
-	"·ᓕ": "MIDDLE DOT, CANADIAN SYLLABICS LI",
-	"·ᓖ": "MIDDLE DOT, CANADIAN SYLLABICS LII",
-	"·ᓗ": "MIDDLE DOT, CANADIAN SYLLABICS LO",
-	"·ᓘ": "MIDDLE DOT, CANADIAN SYLLABICS LOO",
-	"·ᓚ": "MIDDLE DOT, CANADIAN SYLLABICS LA",
-	"·ᓛ": "MIDDLE DOT, CANADIAN SYLLABICS LAA",
-	"·ᓭ": "MIDDLE DOT, CANADIAN SYLLABICS SE",
-	"·ᓯ": "MIDDLE DOT, CANADIAN SYLLABICS SI",
-	"·ᓰ": "MIDDLE DOT, CANADIAN SYLLABICS SII",
-	"·ᓱ": "MIDDLE DOT, CANADIAN SYLLABICS SO",
-	"·ᓲ": "MIDDLE DOT, CANADIAN SYLLABICS SOO",
-	"·ᓴ": "MIDDLE DOT, CANADIAN SYLLABICS SA",
-	"·ᓵ": "MIDDLE DOT, CANADIAN SYLLABICS SAA",
-	"·ᔐ": "MIDDLE DOT, CANADIAN SYLLABICS SHE",
-	"·ᔑ": "MIDDLE DOT, CANADIAN SYLLABICS SHI",
-	"·ᔒ": "MIDDLE DOT, CANADIAN SYLLABICS SHII",
-	"·ᔓ": "MIDDLE DOT, CANADIAN SYLLABICS SHO",
-	"·ᔔ": "MIDDLE DOT, CANADIAN SYLLABICS SHOO",
-	"·ᔕ": "MIDDLE DOT, CANADIAN SYLLABICS SHA",
-	"·ᔖ": "MIDDLE DOT, CANADIAN SYLLABICS SHAA",
-	"·ᔨ": "MIDDLE DOT, CANADIAN SYLLABICS YI",
-	"·ᔩ": "MIDDLE DOT, CANADIAN SYLLABICS YII",
-	"·ᔪ": "MIDDLE DOT, CANADIAN SYLLABICS YO",
-	"·ᔫ": "MIDDLE DOT, CANADIAN SYLLABICS YOO",
-	"·ᔭ": "MIDDLE DOT, CANADIAN SYLLABICS YA",
-	"·ᔮ": "MIDDLE DOT, CANADIAN SYLLABICS YAA",
-	"·ᕃ": "MIDDLE DOT, CANADIAN SYLLABICS R-CREE RE",
-	"·ᕆ": "MIDDLE DOT, CANADIAN SYLLABICS RI",
-	"·ᕇ": "MIDDLE DOT, CANADIAN SYLLABICS RII",
-	"·ᕈ": "MIDDLE DOT, CANADIAN SYLLABICS RO",
-	"·ᕉ": "MIDDLE DOT, CANADIAN SYLLABICS ROO",
-	"·ᕋ": "MIDDLE DOT, CANADIAN SYLLABICS RA",
-	"·ᕌ": "MIDDLE DOT, CANADIAN SYLLABICS RAA",
-	"·ᕚ": "MIDDLE DOT, CANADIAN SYLLABICS FAA",
-	"·ᕧ": "MIDDLE DOT, CANADIAN SYLLABICS THAA",
-	"·ᢱ": "MIDDLE DOT, CANADIAN SYLLABICS AY",
-	"·ᢴ": "MIDDLE DOT, CANADIAN SYLLABICS POY",
-	"·ᢸ": "MIDDLE DOT, CANADIAN SYLLABICS KAY",
-	"·ᣀ": "MIDDLE DOT, CANADIAN SYLLABICS SHOY",
-	"¸": "CEDILLA",
-	"º": "MASCULINE ORDINAL INDICATOR",
-	"º/₀": "MASCULINE ORDINAL INDICATOR, SOLIDUS, SUBSCRIPT ZERO",
-	"º/₀₀": "MASCULINE ORDINAL INDICATOR, SOLIDUS, SUBSCRIPT ZERO, SUBSCRIPT ZERO",
-	"º/₀₀₀": "MASCULINE ORDINAL INDICATOR, SOLIDUS, SUBSCRIPT ZERO, SUBSCRIPT ZERO, SUBSCRIPT ZERO",
-	"Å": "LATIN CAPITAL LETTER A WITH RING ABOVE",
-	"Æ": "LATIN CAPITAL LETTER AE",
-	"Ç": "LATIN CAPITAL LETTER C WITH CEDILLA",
-	"Ð": "LATIN CAPITAL LETTER ETH",
-	"Ö": "LATIN CAPITAL LETTER O WITH DIAERESIS",
-	"×": "MULTIPLICATION SIGN",
-	"Ø": "LATIN CAPITAL LETTER O WITH STROKE",
-	"Þ": "LATIN CAPITAL LETTER THORN",
-	"ß": "LATIN SMALL LETTER SHARP S",
-	"å": "LATIN SMALL LETTER A WITH RING ABOVE",
-	"æ": "LATIN SMALL LETTER AE",
-	"ç": "LATIN SMALL LETTER C WITH CEDILLA",
-	"ð": "LATIN SMALL LETTER ETH",
-	"ö": "LATIN SMALL LETTER O WITH DIAERESIS",
-	"÷": "DIVISION SIGN",
-	"ø": "LATIN SMALL LETTER O WITH STROKE",
-	"þ": "LATIN SMALL LETTER THORN",
-	"Ă": "LATIN CAPITAL LETTER A WITH BREVE",
-	"ă": "LATIN SMALL LETTER A WITH BREVE",
-	"Đ": "LATIN CAPITAL LETTER D WITH STROKE",
-	"đ": "LATIN SMALL LETTER D WITH STROKE",
-	"Ĕ": "LATIN CAPITAL LETTER E WITH BREVE",
-	"ĕ": "LATIN SMALL LETTER E WITH BREVE",
-	"Ě": "LATIN CAPITAL LETTER E WITH CARON",
-	"ě": "LATIN SMALL LETTER E WITH CARON",
-	"Ğ": "LATIN CAPITAL LETTER G WITH BREVE",
-	"ğ": "LATIN SMALL LETTER G WITH BREVE",
-	"ģ": "LATIN SMALL LETTER G WITH CEDILLA",
-	"Ħ": "LATIN CAPITAL LETTER H WITH STROKE",
-	"ħ": "LATIN SMALL LETTER H WITH STROKE",
-	"Ĭ": "LATIN CAPITAL LETTER I WITH BREVE",
-	"ĭ": "LATIN SMALL LETTER I WITH BREVE",
-	"ı": "LATIN SMALL LETTER DOTLESS I",
-	"Ĳ": "LATIN CAPITAL LIGATURE IJ",
-	"ĳ": "LATIN SMALL LIGATURE IJ",
-	"ĸ": "LATIN SMALL LETTER KRA",
-	"ĸ̩": "LATIN SMALL LETTER KRA, COMBINING VERTICAL LINE BELOW",
-	"ĸ̵": "LATIN SMALL LETTER KRA, COMBINING SHORT STROKE OVERLAY",
-	"Ŀ": "LATIN CAPITAL LETTER L WITH MIDDLE DOT",
-	"ŀ": "LATIN SMALL LETTER L WITH MIDDLE DOT",
-	"Ł": "LATIN CAPITAL LETTER L WITH STROKE",
-	"ł": "LATIN SMALL LETTER L WITH STROKE",
-	"ņ": "LATIN SMALL LETTER N WITH CEDILLA",
-	"ŉ": "LATIN SMALL LETTER N PRECEDED BY APOSTROPHE",
-	"Ŏ": "LATIN CAPITAL LETTER O WITH BREVE",
-	"ŏ": "LATIN SMALL LETTER O WITH BREVE",
-	"Ő": "LATIN CAPITAL LETTER O WITH DOUBLE ACUTE",
-	"Œ": "LATIN CAPITAL LIGATURE OE",
-	"œ": "LATIN SMALL LIGATURE OE",
-	"Ţ": "LATIN CAPITAL LETTER T WITH CEDILLA",
-	"ţ": "LATIN SMALL LETTER T WITH CEDILLA",
-	"Ŧ": "LATIN CAPITAL LETTER T WITH STROKE",
-	"ŧ": "LATIN SMALL LETTER T WITH STROKE",
-	"Ŭ": "LATIN CAPITAL LETTER U WITH BREVE",
-	"ŭ": "LATIN SMALL LETTER U WITH BREVE",
-	"ſ": "LATIN SMALL LETTER LONG S",
-	"ƀ": "LATIN SMALL LETTER B WITH STROKE",
-	"Ɓ": "LATIN CAPITAL LETTER B WITH HOOK",
-	"Ƃ": "LATIN CAPITAL LETTER B WITH TOPBAR",
-	"ƃ": "LATIN SMALL LETTER B WITH TOPBAR",
-	"Ƅ": "LATIN CAPITAL LETTER TONE SIX",
-	"ƅ": "LATIN SMALL LETTER TONE SIX",
-	"ƅi": "LATIN SMALL LETTER TONE SIX, LATIN SMALL LETTER I",
-	"Ɔ": "LATIN CAPITAL LETTER OPEN O",
-	"Ƈ": "LATIN CAPITAL LETTER C WITH HOOK",
-	"Ɖ": "LATIN CAPITAL LETTER AFRICAN D",
-	"Ɗ": "LATIN CAPITAL LETTER D WITH HOOK",
-	"ƌ": "LATIN SMALL LETTER D WITH TOPBAR",
-	"ƍ": "LATIN SMALL LETTER TURNED DELTA",
-	"Ǝ": "LATIN CAPITAL LETTER REVERSED E",
-	"Ə": "LATIN CAPITAL LETTER SCHWA",
-	"Ɛ": "LATIN CAPITAL LETTER OPEN E",
-	"Ƒ": "LATIN CAPITAL LETTER F WITH HOOK",
-	"ƒ": "LATIN SMALL LETTER F WITH HOOK",
-	"Ɠ": "LATIN CAPITAL LETTER G WITH HOOK",
-	"Ɩ": "LATIN CAPITAL LETTER IOTA",
-	"Ɨ": "LATIN CAPITAL LETTER I WITH STROKE",
-	"Ƙ": "LATIN CAPITAL LETTER K WITH HOOK",
-	"ƙ": "LATIN SMALL LETTER K WITH HOOK",
-	"ƚ": "LATIN SMALL LETTER L WITH BAR",
-	"ƛ": "LATIN SMALL LETTER LAMBDA WITH STROKE",
-	"Ɲ": "LATIN CAPITAL LETTER N WITH LEFT HOOK",
-	"ƞ": "LATIN SMALL LETTER N WITH LONG RIGHT LEG",
-	"Ɵ": "LATIN CAPITAL LETTER O WITH MIDDLE TILDE",
-	"Ơ": "LATIN CAPITAL LETTER O WITH HORN",
-	"ơ": "LATIN SMALL LETTER O WITH HORN",
-	"Ƥ": "LATIN CAPITAL LETTER P WITH HOOK",
-	"ƥ": "LATIN SMALL LETTER P WITH HOOK",
-	"Ʀ": "LATIN LETTER YR",
-	"Ƨ": "LATIN CAPITAL LETTER TONE TWO",
-	"ƨ": "LATIN SMALL LETTER TONE TWO",
-	"Ʃ": "LATIN CAPITAL LETTER ESH",
-	"ƫ": "LATIN SMALL LETTER T WITH PALATAL HOOK",
-	"Ƭ": "LATIN CAPITAL LETTER T WITH HOOK",
-	"ƭ": "LATIN SMALL LETTER T WITH HOOK",
-	"Ʈ": "LATIN CAPITAL LETTER T WITH RETROFLEX HOOK",
-	"Ʊ": "LATIN CAPITAL LETTER UPSILON",
-	"Ƴ": "LATIN CAPITAL LETTER Y WITH HOOK",
-	"ƴ": "LATIN SMALL LETTER Y WITH HOOK",
-	"Ƶ": "LATIN CAPITAL LETTER Z WITH STROKE",
-	"ƶ": "LATIN SMALL LETTER Z WITH STROKE",
-	"Ʒ": "LATIN CAPITAL LETTER EZH",
-	"ƻ": "LATIN LETTER TWO WITH STROKE",
-	"Ƽ": "LATIN CAPITAL LETTER TONE FIVE",
-	"ƽ": "LATIN SMALL LETTER TONE FIVE",
-	"ƿ": "LATIN LETTER WYNN",
-	"ǀ": "LATIN LETTER DENTAL CLICK",
-	"ǁ": "LATIN LETTER LATERAL CLICK",
-	"ǃ": "LATIN LETTER RETROFLEX CLICK",
-	"Ǆ": "LATIN CAPITAL LETTER DZ WITH CARON",
-	"ǅ": "LATIN CAPITAL LETTER D WITH SMALL LETTER Z WITH CARON",
-	"ǆ": "LATIN SMALL LETTER DZ WITH CARON",
-	"Ǉ": "LATIN CAPITAL LETTER LJ",
-	"ǈ": "LATIN CAPITAL LETTER L WITH SMALL LETTER J",
-	"ǉ": "LATIN SMALL LETTER LJ",
-	"Ǌ": "LATIN CAPITAL LETTER NJ",
-	"ǋ": "LATIN CAPITAL LETTER N WITH SMALL LETTER J",
-	"ǌ": "LATIN SMALL LETTER NJ",
-	"Ǎ": "LATIN CAPITAL LETTER A WITH CARON",
-	"ǎ": "LATIN SMALL LETTER A WITH CARON",
-	"Ǐ": "LATIN CAPITAL LETTER I WITH CARON",
-	"ǐ": "LATIN SMALL LETTER I WITH CARON",
-	"Ǒ": "LATIN CAPITAL LETTER O WITH CARON",
-	"ǒ": "LATIN SMALL LETTER O WITH CARON",
-	"Ǔ": "LATIN CAPITAL LETTER U WITH CARON",
-	"ǔ": "LATIN SMALL LETTER U WITH CARON",
-	"ǝ": "LATIN SMALL LETTER TURNED E",
-	"ǝo": "LATIN SMALL LETTER TURNED E, LATIN SMALL LETTER O",
-	"ǝo̵": "LATIN SMALL LETTER TURNED E, LATIN SMALL LETTER O, COMBINING SHORT STROKE OVERLAY",
-	"ǝo̸": "LATIN SMALL LETTER TURNED E, LATIN SMALL LETTER O, COMBINING LONG SOLIDUS OVERLAY",
-	"ǝ˞": "LATIN SMALL LETTER TURNED E, MODIFIER LETTER RHOTIC HOOK",
-	"Ǥ": "LATIN CAPITAL LETTER G WITH STROKE",
-	"ǥ": "LATIN SMALL LETTER G WITH STROKE",
-	"Ǧ": "LATIN CAPITAL LETTER G WITH CARON",
-	"ǧ": "LATIN SMALL LETTER G WITH CARON",
-	"Ǳ": "LATIN CAPITAL LETTER DZ",
-	"ǲ": "LATIN CAPITAL LETTER D WITH SMALL LETTER Z",
-	"ǳ": "LATIN SMALL LETTER DZ",
-	"ǵ": "LATIN SMALL LETTER G WITH ACUTE",
-	"Ƕ": "LATIN CAPITAL LETTER HWAIR",
-	"Ǿ": "LATIN CAPITAL LETTER O WITH STROKE AND ACUTE",
-	"Ț": "LATIN CAPITAL LETTER T WITH COMMA BELOW",
-	"ț": "LATIN SMALL LETTER T WITH COMMA BELOW",
-	"Ȝ": "LATIN CAPITAL LETTER YOGH",
-	"ȝ": "LATIN SMALL LETTER YOGH",
-	"Ȣ": "LATIN CAPITAL LETTER OU",
-	"ȣ": "LATIN SMALL LETTER OU",
-	"Ȥ": "LATIN CAPITAL LETTER Z WITH HOOK",
-	"ȥ": "LATIN SMALL LETTER Z WITH HOOK",
-	"Ȧ": "LATIN CAPITAL LETTER A WITH DOT ABOVE",
-	"ȧ": "LATIN SMALL LETTER A WITH DOT ABOVE",
-	"ȷ": "LATIN SMALL LETTER DOTLESS J",
-	"ȼ": "LATIN SMALL LETTER C WITH STROKE",
-	"Ⱦ": "LATIN CAPITAL LETTER T WITH DIAGONAL STROKE",
-	"Ɂ": "LATIN CAPITAL LETTER GLOTTAL STOP",
-	"ɂ": "LATIN SMALL LETTER GLOTTAL STOP",
-	"Ʉ": "LATIN CAPITAL LETTER U BAR",
-	"Ʌ": "LATIN CAPITAL LETTER TURNED V",
-	"Ʌ·": "LATIN CAPITAL LETTER TURNED V, MIDDLE DOT",
-	"Ʌ̦": "LATIN CAPITAL LETTER TURNED V, COMBINING COMMA BELOW",
-	"Ʌ̸": "LATIN CAPITAL LETTER TURNED V, COMBINING LONG SOLIDUS OVERLAY",
-	"Ɇ": "LATIN CAPITAL LETTER E WITH STROKE",
-	"ɇ": "LATIN SMALL LETTER E WITH STROKE",
-	"Ɉ": "LATIN CAPITAL LETTER J WITH STROKE",
-	"ɉ": "LATIN SMALL LETTER J WITH STROKE",
-	"ɋ": "LATIN SMALL LETTER Q WITH HOOK TAIL",
-	"ɍ": "LATIN SMALL LETTER R WITH STROKE",
-	"Ɏ": "LATIN CAPITAL LETTER Y WITH STROKE",
-	"ɏ": "LATIN SMALL LETTER Y WITH STROKE",
-	"ɑ": "LATIN SMALL LETTER ALPHA",
-	"ɓ": "LATIN SMALL LETTER B WITH HOOK",
-	"ɔ": "LATIN SMALL LETTER OPEN O",
-	"ɔe": "LATIN SMALL LETTER OPEN O, LATIN SMALL LETTER E",
-	"ɔ̸": "LATIN SMALL LETTER OPEN O, COMBINING LONG SOLIDUS OVERLAY",
-	"ɖ": "LATIN SMALL LETTER D WITH TAIL",
-	"ɗ": "LATIN SMALL LETTER D WITH HOOK",
-	"ə": "LATIN SMALL LETTER SCHWA",
-	"ɚ": "LATIN SMALL LETTER SCHWA WITH HOOK",
-	"ɛ": "LATIN SMALL LETTER OPEN E",
-	"ɜ": "LATIN SMALL LETTER REVERSED OPEN E",
-	"ɜ̦": "LATIN SMALL LETTER REVERSED OPEN E, COMBINING COMMA BELOW",
-	"ɞ": "LATIN SMALL LETTER CLOSED REVERSED OPEN E",
-	"ɠ": "LATIN SMALL LETTER G WITH HOOK",
-	"ɡ": "LATIN SMALL LETTER SCRIPT G",
-	"ɢ": "LATIN LETTER SMALL CAPITAL G",
-	"ɣ": "LATIN SMALL LETTER GAMMA",
-	"ɦ": "LATIN SMALL LETTER H WITH HOOK",
-	"ɨ": "LATIN SMALL LETTER I WITH STROKE",
-	"ɩ": "LATIN SMALL LETTER IOTA",
-	"ɪ": "LATIN LETTER SMALL CAPITAL I",
-	"ɫ": "LATIN SMALL LETTER L WITH MIDDLE TILDE",
-	"ɭ": "LATIN SMALL LETTER L WITH RETROFLEX HOOK",
-	"ɮ": "LATIN SMALL LETTER LEZH",
-	"ɯ": "LATIN SMALL LETTER TURNED M",
-	"ɰ": "LATIN SMALL LETTER TURNED M WITH LONG LEG",
-	"ɱ": "LATIN SMALL LETTER M WITH HOOK",
-	"ɲ": "LATIN SMALL LETTER N WITH LEFT HOOK",
-	"ɳ": "LATIN SMALL LETTER N WITH RETROFLEX HOOK",
-	"ɵ": "LATIN SMALL LETTER BARRED O",
-	"ɶ": "LATIN LETTER SMALL CAPITAL OE",
-	"ɷ": "LATIN SMALL LETTER CLOSED OMEGA",
-	"ɸ": "LATIN SMALL LETTER PHI",
-	"ɼ": "LATIN SMALL LETTER R WITH LONG LEG",
-	"ɽ": "LATIN SMALL LETTER R WITH TAIL",
-	"ɾ̴": "LATIN SMALL LETTER R WITH FISHHOOK, COMBINING TILDE OVERLAY",
-	"ɿ": "LATIN SMALL LETTER REVERSED R WITH FISHHOOK",
-	"ʀ": "LATIN LETTER SMALL CAPITAL R",
-	"ʂ": "LATIN SMALL LETTER S WITH HOOK",
-	"ʃ": "LATIN SMALL LETTER ESH",
-	"ʃʃ": "LATIN SMALL LETTER ESH, LATIN SMALL LETTER ESH",
-	"ʃʃʃ": "LATIN SMALL LETTER ESH, LATIN SMALL LETTER ESH, LATIN SMALL LETTER ESH",
-	"ʃʃʃʃ": "LATIN SMALL LETTER ESH, LATIN SMALL LETTER ESH, LATIN SMALL LETTER ESH, LATIN SMALL LETTER ESH",
-	"ʊ̵": "LATIN SMALL LETTER UPSILON, COMBINING SHORT STROKE OVERLAY",
-	"ʋ": "LATIN SMALL LETTER V WITH HOOK",
-	"ʌ": "LATIN SMALL LETTER TURNED V",
-	"ʍ": "LATIN SMALL LETTER TURNED W",
-	"ʍ̦": "LATIN SMALL LETTER TURNED W, COMBINING COMMA BELOW",
-	"ʏ": "LATIN LETTER SMALL CAPITAL Y",
-	"ʐ": "LATIN SMALL LETTER Z WITH RETROFLEX HOOK",
-	"ʒ": "LATIN SMALL LETTER EZH",
-	"ʔ": "LATIN LETTER GLOTTAL STOP",
-	"ʘ": "LATIN LETTER BILABIAL CLICK",
-	"ʙ": "LATIN LETTER SMALL CAPITAL B",
-	"ʚ": "LATIN SMALL LETTER CLOSED OPEN E",
-	"ʜ": "LATIN LETTER SMALL CAPITAL H",
-	"ʜ̦": "LATIN LETTER SMALL CAPITAL H, COMBINING COMMA BELOW",
-	"ʜ̩": "LATIN LETTER SMALL CAPITAL H, COMBINING VERTICAL LINE BELOW",
-	"ʟ": "LATIN LETTER SMALL CAPITAL L",
-	"ʠ": "LATIN SMALL LETTER Q WITH HOOK",
-	"ʡ": "LATIN LETTER GLOTTAL STOP WITH STROKE",
-	"ʣ": "LATIN SMALL LETTER DZ DIGRAPH",
-	"ʤ": "LATIN SMALL LETTER DEZH DIGRAPH",
-	"ʥ": "LATIN SMALL LETTER DZ DIGRAPH WITH CURL",
-	"ʦ": "LATIN SMALL LETTER TS DIGRAPH",
-	"ʧ": "LATIN SMALL LETTER TESH DIGRAPH",
-	"ʨ": "LATIN SMALL LETTER TC DIGRAPH WITH CURL",
-	"ʩ": "LATIN SMALL LETTER FENG DIGRAPH",
-	"ʪ": "LATIN SMALL LETTER LS DIGRAPH",
-	"ʫ": "LATIN SMALL LETTER LZ DIGRAPH",
-	"ʳ": "MODIFIER LETTER SMALL R",
-	"ʹ": "MODIFIER LETTER PRIME",
-	"ʺ": "MODIFIER LETTER DOUBLE PRIME",
-	"ʻ": "MODIFIER LETTER TURNED COMMA",
-	"ʼ": "MODIFIER LETTER APOSTROPHE",
-	"ʽ": "MODIFIER LETTER REVERSED COMMA",
-	"ʾ": "MODIFIER LETTER RIGHT HALF RING",
-	"ʿ": "MODIFIER LETTER LEFT HALF RING",
-	"ˁ": "MODIFIER LETTER REVERSED GLOTTAL STOP",
-	"˂": "MODIFIER LETTER LEFT ARROWHEAD",
-	"˃": "MODIFIER LETTER RIGHT ARROWHEAD",
-	"˄": "MODIFIER LETTER UP ARROWHEAD",
-	"ˆ": "MODIFIER LETTER CIRCUMFLEX ACCENT",
-	"ˇ": "CARON",
-	"ˈ": "MODIFIER LETTER VERTICAL LINE",
-	"ˉ": "MODIFIER LETTER MACRON",
-	"ˉb": "MODIFIER LETTER MACRON, LATIN SMALL LETTER B",
-	"ˉbi": "MODIFIER LETTER MACRON, LATIN SMALL LETTER B, LATIN SMALL LETTER I",
-	"ˊ": "MODIFIER LETTER ACUTE ACCENT",
-	"ˋ": "MODIFIER LETTER GRAVE ACCENT",
-	"ˏ": "MODIFIER LETTER LOW ACUTE ACCENT",
-	"ː": "MODIFIER LETTER TRIANGULAR COLON",
-	"˓": "MODIFIER LETTER CENTRED LEFT HALF RING",
-	"˗": "MODIFIER LETTER MINUS SIGN",
-	"˘": "BREVE",
-	"˙": "DOT ABOVE",
-	"˚": "RING ABOVE",
-	"˛": "OGONEK",
-	"˜": "SMALL TILDE",
-	"˝": "DOUBLE ACUTE ACCENT",
-	"ˡ": "MODIFIER LETTER SMALL L",
-	"ˢ": "MODIFIER LETTER SMALL S",
-	"ˤ": "MODIFIER LETTER SMALL REVERSED GLOTTAL STOP",
-	"˪": "MODIFIER LETTER YIN DEPARTING TONE MARK",
-	"˫": "MODIFIER LETTER YANG DEPARTING TONE MARK",
-	"ˮ": "MODIFIER LETTER DOUBLE APOSTROPHE",
-	"˳": "MODIFIER LETTER LOW RING",
-	"˴": "MODIFIER LETTER MIDDLE GRAVE ACCENT",
-	"˶": "MODIFIER LETTER MIDDLE DOUBLE ACUTE ACCENT",
-	"˸": "MODIFIER LETTER RAISED COLON",
-	"˻": "MODIFIER LETTER BEGIN LOW TONE",
-	"̀": "COMBINING GRAVE ACCENT",
-	"́": "COMBINING ACUTE ACCENT",
-	"̂": "COMBINING CIRCUMFLEX ACCENT",
-	"̃": "COMBINING TILDE",
-	"̄": "COMBINING MACRON",
-	"̅": "COMBINING OVERLINE",
-	"̆": "COMBINING BREVE",
-	"̆̇": "COMBINING BREVE, COMBINING DOT ABOVE",
-	"̇": "COMBINING DOT ABOVE",
-	"̈": "COMBINING DIAERESIS",
-	"̉": "COMBINING HOOK ABOVE",
-	"̊": "COMBINING RING ABOVE",
-	"̊า": "COMBINING RING ABOVE, THAI CHARACTER SARA AA",
-	"̊າ": "COMBINING RING ABOVE, LAO VOWEL SIGN AA",
-	"̋": "COMBINING DOUBLE ACUTE ACCENT",
-	"̌": "COMBINING CARON",
-	"̍": "COMBINING VERTICAL LINE ABOVE",
-	"̎": "COMBINING DOUBLE VERTICAL LINE ABOVE",
-	"̐": "COMBINING CANDRABINDU",
-	"̑": "COMBINING INVERTED BREVE",
-	"̒": "COMBINING TURNED COMMA ABOVE",
-	"̓": "COMBINING COMMA ABOVE",
-	"̔": "COMBINING REVERSED COMMA ABOVE",
-	"̕": "COMBINING COMMA ABOVE RIGHT",
-	"̖": "COMBINING GRAVE ACCENT BELOW",
-	"̗": "COMBINING ACUTE ACCENT BELOW",
-	"̠": "COMBINING MINUS SIGN BELOW",
-	"̡": "COMBINING PALATALIZED HOOK BELOW",
-	"̢": "COMBINING RETROFLEX HOOK BELOW",
-	"̣": "COMBINING DOT BELOW",
-	"̤": "COMBINING DIAERESIS BELOW",
-	"̥": "COMBINING RING BELOW",
-	"̦": "COMBINING COMMA BELOW",
-	"̧": "COMBINING CEDILLA",
-	"̨": "COMBINING OGONEK",
-	"̩": "COMBINING VERTICAL LINE BELOW",
-	"̫": "COMBINING INVERTED DOUBLE ARCH BELOW",
-	"̭": "COMBINING CIRCUMFLEX ACCENT BELOW",
-	"̮": "COMBINING BREVE BELOW",
-	"̱": "COMBINING MACRON BELOW",
-	"̳": "COMBINING DOUBLE LOW LINE",
-	"̵": "COMBINING SHORT STROKE OVERLAY",
-	"̶": "COMBINING LONG STROKE OVERLAY",
-	"̷": "COMBINING SHORT SOLIDUS OVERLAY",
-	"̸": "COMBINING LONG SOLIDUS OVERLAY",
-	"̹": "COMBINING RIGHT HALF RING BELOW",
-	"̀": "COMBINING GRAVE TONE MARK",
-	"́": "COMBINING ACUTE TONE MARK",
-	"͂": "COMBINING GREEK PERISPOMENI",
-	"̓": "COMBINING GREEK KORONIS",
-	"ͅ": "COMBINING GREEK YPOGEGRAMMENI",
-	"͇": "COMBINING EQUALS SIGN BELOW",
-	"͐": "COMBINING RIGHT ARROWHEAD ABOVE",
-	"͒": "COMBINING FERMATA",
-	"͔": "COMBINING LEFT ARROWHEAD BELOW",
-	"͕": "COMBINING RIGHT ARROWHEAD BELOW",
-	"͗": "COMBINING RIGHT HALF RING ABOVE",
-	"͘": "COMBINING DOT ABOVE RIGHT",
-	"ͣ": "COMBINING LATIN SMALL LETTER A",
-	"ͤ": "COMBINING LATIN SMALL LETTER E",
-	"ͦ": "COMBINING LATIN SMALL LETTER O",
-	"ͨ": "COMBINING LATIN SMALL LETTER C",
-	"ͮ": "COMBINING LATIN SMALL LETTER V",
-	"ͯ": "COMBINING LATIN SMALL LETTER X",
-	"Ͱ": "GREEK CAPITAL LETTER HETA",
-	"ʹ": "GREEK NUMERAL SIGN",
-	"͵": "GREEK LOWER NUMERAL SIGN",
-	"Ͷ": "GREEK CAPITAL LETTER PAMPHYLIAN DIGAMMA",
-	"ͷ": "GREEK SMALL LETTER PAMPHYLIAN DIGAMMA",
-	"ͺ": "GREEK YPOGEGRAMMENI",
-	"ͻ": "GREEK SMALL REVERSED LUNATE SIGMA SYMBOL",
-	"ͽ": "GREEK SMALL REVERSED DOTTED LUNATE SIGMA SYMBOL",
-	";": "GREEK QUESTION MARK",
-	"Ϳ": "GREEK CAPITAL LETTER YOT",
-	"΄": "GREEK TONOS",
-	"·": "GREEK ANO TELEIA",
-	"Α": "GREEK CAPITAL LETTER ALPHA",
-	"Β": "GREEK CAPITAL LETTER BETA",
-	"Γ": "GREEK CAPITAL LETTER GAMMA",
-	"Γ'": "GREEK CAPITAL LETTER GAMMA, APOSTROPHE",
-	"Γ·": "GREEK CAPITAL LETTER GAMMA, MIDDLE DOT",
-	"Γ̵": "GREEK CAPITAL LETTER GAMMA, COMBINING SHORT STROKE OVERLAY",
-	"Δ": "GREEK CAPITAL LETTER DELTA",
-	"Δ·": "GREEK CAPITAL LETTER DELTA, MIDDLE DOT",
-	"Δ̲": "GREEK CAPITAL LETTER DELTA, COMBINING LOW LINE",
-	"Δᐠ": "GREEK CAPITAL LETTER DELTA, CANADIAN SYLLABICS FINAL GRAVE",
-	"Ε": "GREEK CAPITAL LETTER EPSILON",
-	"Ζ": "GREEK CAPITAL LETTER ZETA",
-	"Η": "GREEK CAPITAL LETTER ETA",
-	"Θ": "GREEK CAPITAL LETTER THETA",
-	"Ι": "GREEK CAPITAL LETTER IOTA",
-	"Κ": "GREEK CAPITAL LETTER KAPPA",
-	"Λ": "GREEK CAPITAL LETTER LAMDA",
-	"Μ": "GREEK CAPITAL LETTER MU",
-	"Ν": "GREEK CAPITAL LETTER NU",
-	"Ξ": "GREEK CAPITAL LETTER XI",
-	"Ο": "GREEK CAPITAL LETTER OMICRON",
-	"Π": "GREEK CAPITAL LETTER PI",
-	"Ρ": "GREEK CAPITAL LETTER RHO",
-	"Σ": "GREEK CAPITAL LETTER SIGMA",
-	"Τ": "GREEK CAPITAL LETTER TAU",
-	"Υ": "GREEK CAPITAL LETTER UPSILON",
-	"Φ": "GREEK CAPITAL LETTER PHI",
-	"Χ": "GREEK CAPITAL LETTER CHI",
-	"Ψ": "GREEK CAPITAL LETTER PSI",
-	"Ω": "GREEK CAPITAL LETTER OMEGA",
-	"α": "GREEK SMALL LETTER ALPHA",
-	"β": "GREEK SMALL LETTER BETA",
-	"γ": "GREEK SMALL LETTER GAMMA",
-	"δ": "GREEK SMALL LETTER DELTA",
-	"ε": "GREEK SMALL LETTER EPSILON",
-	"ζ": "GREEK SMALL LETTER ZETA",
-	"η": "GREEK SMALL LETTER ETA",
-	"θ": "GREEK SMALL LETTER THETA",
-	"ι": "GREEK SMALL LETTER IOTA",
-	"κ": "GREEK SMALL LETTER KAPPA",
-	"λ": "GREEK SMALL LETTER LAMDA",
-	"λ̸": "GREEK SMALL LETTER LAMDA, COMBINING LONG SOLIDUS OVERLAY",
-	"μ": "GREEK SMALL LETTER MU",
-	"ν": "GREEK SMALL LETTER NU",
-	"ξ": "GREEK SMALL LETTER XI",
-	"ο": "GREEK SMALL LETTER OMICRON",
-	"π": "GREEK SMALL LETTER PI",
-	"ρ": "GREEK SMALL LETTER RHO",
-	"ς": "GREEK SMALL LETTER FINAL SIGMA",
-	"σ": "GREEK SMALL LETTER SIGMA",
-	"τ": "GREEK SMALL LETTER TAU",
-	"υ": "GREEK SMALL LETTER UPSILON",
-	"φ": "GREEK SMALL LETTER PHI",
-	"χ": "GREEK SMALL LETTER CHI",
-	"ψ": "GREEK SMALL LETTER PSI",
-	"ω": "GREEK SMALL LETTER OMEGA",
-	"ω̲": "GREEK SMALL LETTER OMEGA, COMBINING LOW LINE",
-	"ϐ": "GREEK BETA SYMBOL",
-	"ϑ": "GREEK THETA SYMBOL",
-	"ϒ": "GREEK UPSILON WITH HOOK SYMBOL",
-	"ϕ": "GREEK PHI SYMBOL",
-	"ϖ": "GREEK PI SYMBOL",
-	"ϗ": "GREEK KAI SYMBOL",
-	"Ϙ": "GREEK LETTER ARCHAIC KOPPA",
-	"ϛ": "GREEK SMALL LETTER STIGMA",
-	"Ϝ": "GREEK LETTER DIGAMMA",
-	"ϝ": "GREEK SMALL LETTER DIGAMMA",
-	"Ϩ": "COPTIC CAPITAL LETTER HORI",
-	"ϩ": "COPTIC SMALL LETTER HORI",
-	"Ϭ": "COPTIC CAPITAL LETTER SHIMA",
-	"ϰ": "GREEK KAPPA SYMBOL",
-	"ϱ": "GREEK RHO SYMBOL",
-	"ϲ": "GREEK LUNATE SIGMA SYMBOL",
-	"ϳ": "GREEK LETTER YOT",
-	"ϴ": "GREEK CAPITAL THETA SYMBOL",
-	"ϵ": "GREEK LUNATE EPSILON SYMBOL",
-	"Ϸ": "GREEK CAPITAL LETTER SHO",
-	"ϸ": "GREEK SMALL LETTER SHO",
-	"Ϲ": "GREEK CAPITAL LUNATE SIGMA SYMBOL",
-	"Ϻ": "GREEK CAPITAL LETTER SAN",
-	"Ͻ": "GREEK CAPITAL REVERSED LUNATE SIGMA SYMBOL",
-	"Ͽ": "GREEK CAPITAL REVERSED DOTTED LUNATE SIGMA SYMBOL",
-	"Є": "CYRILLIC CAPITAL LETTER UKRAINIAN IE",
-	"Ѕ": "CYRILLIC CAPITAL LETTER DZE",
-	"І": "CYRILLIC CAPITAL LETTER BYELORUSSIAN-UKRAINIAN I",
-	"Ј": "CYRILLIC CAPITAL LETTER JE",
-	"Ћ": "CYRILLIC CAPITAL LETTER TSHE",
-	"Ѝ": "CYRILLIC CAPITAL LETTER I WITH GRAVE",
-	"Ѝ̦": "CYRILLIC CAPITAL LETTER I WITH GRAVE, COMBINING COMMA BELOW",
-	"А": "CYRILLIC CAPITAL LETTER A",
-	"Б": "CYRILLIC CAPITAL LETTER BE",
-	"В": "CYRILLIC CAPITAL LETTER VE",
-	"Г": "CYRILLIC CAPITAL LETTER GHE",
-	"Е": "CYRILLIC CAPITAL LETTER IE",
-	"Ж̩": "CYRILLIC CAPITAL LETTER ZHE, COMBINING VERTICAL LINE BELOW",
-	"З": "CYRILLIC CAPITAL LETTER ZE",
-	"И": "CYRILLIC CAPITAL LETTER I",
-	"Й": "CYRILLIC CAPITAL LETTER SHORT I",
-	"К": "CYRILLIC CAPITAL LETTER KA",
-	"Л": "CYRILLIC CAPITAL LETTER EL",
-	"М": "CYRILLIC CAPITAL LETTER EM",
-	"Н": "CYRILLIC CAPITAL LETTER EN",
-	"О": "CYRILLIC CAPITAL LETTER O",
-	"П": "CYRILLIC CAPITAL LETTER PE",
-	"Р": "CYRILLIC CAPITAL LETTER ER",
-	"С": "CYRILLIC CAPITAL LETTER ES",
-	"Т": "CYRILLIC CAPITAL LETTER TE",
-	"У": "CYRILLIC CAPITAL LETTER U",
-	"Ф": "CYRILLIC CAPITAL LETTER EF",
-	"Х": "CYRILLIC CAPITAL LETTER HA",
-	"Ш": "CYRILLIC CAPITAL LETTER SHA",
-	"Ъl": "CYRILLIC CAPITAL LETTER HARD SIGN, LATIN SMALL LETTER L",
-	"Ы": "CYRILLIC CAPITAL LETTER YERU",
-	"Ь": "CYRILLIC CAPITAL LETTER SOFT SIGN",
-	"Э": "CYRILLIC CAPITAL LETTER E",
-	"Ю": "CYRILLIC CAPITAL LETTER YU",
-	"а": "CYRILLIC SMALL LETTER A",
-	"б": "CYRILLIC SMALL LETTER BE",
-	"в": "CYRILLIC SMALL LETTER VE",
-	"г": "CYRILLIC SMALL LETTER GHE",
-	"е": "CYRILLIC SMALL LETTER IE",
-	"ж̩": "CYRILLIC SMALL LETTER ZHE, COMBINING VERTICAL LINE BELOW",
-	"з": "CYRILLIC SMALL LETTER ZE",
-	"и": "CYRILLIC SMALL LETTER I",
-	"й": "CYRILLIC SMALL LETTER SHORT I",
-	"й̦": "CYRILLIC SMALL LETTER SHORT I, COMBINING COMMA BELOW",
-	"к": "CYRILLIC SMALL LETTER KA",
-	"л": "CYRILLIC SMALL LETTER EL",
-	"л̦": "CYRILLIC SMALL LETTER EL, COMBINING COMMA BELOW",
-	"м": "CYRILLIC SMALL LETTER EM",
-	"н": "CYRILLIC SMALL LETTER EN",
-	"о": "CYRILLIC SMALL LETTER O",
-	"п": "CYRILLIC SMALL LETTER PE",
-	"р": "CYRILLIC SMALL LETTER ER",
-	"с": "CYRILLIC SMALL LETTER ES",
-	"т": "CYRILLIC SMALL LETTER TE",
-	"у": "CYRILLIC SMALL LETTER U",
-	"ф": "CYRILLIC SMALL LETTER EF",
-	"х": "CYRILLIC SMALL LETTER HA",
-	"ш": "CYRILLIC SMALL LETTER SHA",
-	"ъ": "CYRILLIC SMALL LETTER HARD SIGN",
-	"ы": "CYRILLIC SMALL LETTER YERU",
-	"ь": "CYRILLIC SMALL LETTER SOFT SIGN",
-	"я": "CYRILLIC SMALL LETTER YA",
-	"є": "CYRILLIC SMALL LETTER UKRAINIAN IE",
-	"ѕ": "CYRILLIC SMALL LETTER DZE",
-	"і": "CYRILLIC SMALL LETTER BYELORUSSIAN-UKRAINIAN I",
-	"ј": "CYRILLIC SMALL LETTER JE",
-	"љ": "CYRILLIC SMALL LETTER LJE",
-	"ћ": "CYRILLIC SMALL LETTER TSHE",
-	"ѝ": "CYRILLIC SMALL LETTER I WITH GRAVE",
-	"Ѡ": "CYRILLIC CAPITAL LETTER OMEGA",
-	"Ѡ·": "CYRILLIC CAPITAL LETTER OMEGA, MIDDLE DOT",
-	"Ѡ҆҇": "CYRILLIC CAPITAL LETTER OMEGA, COMBINING CYRILLIC PSILI PNEUMATA, COMBINING CYRILLIC POKRYTIE",
-	"ѡ": "CYRILLIC SMALL LETTER OMEGA",
-	"Ѣ": "CYRILLIC CAPITAL LETTER YAT",
-	"ѣ": "CYRILLIC SMALL LETTER YAT",
-	"Ѱ": "CYRILLIC CAPITAL LETTER PSI",
-	"ѱ": "CYRILLIC SMALL LETTER PSI",
-	"Ѳ": "CYRILLIC CAPITAL LETTER FITA",
-	"ѳ": "CYRILLIC SMALL LETTER FITA",
-	"Ѵ": "CYRILLIC CAPITAL LETTER IZHITSA",
-	"ѵ": "CYRILLIC SMALL LETTER IZHITSA",
-	"Ѽ": "CYRILLIC CAPITAL LETTER OMEGA WITH TITLO",
-	"ѽ": "CYRILLIC SMALL LETTER OMEGA WITH TITLO",
-	"Ҋ": "CYRILLIC CAPITAL LETTER SHORT I WITH TAIL",
-	"ҋ": "CYRILLIC SMALL LETTER SHORT I WITH TAIL",
-	"Ҍ": "CYRILLIC CAPITAL LETTER SEMISOFT SIGN",
-	"ҍ": "CYRILLIC SMALL LETTER SEMISOFT SIGN",
-	"Ґ": "CYRILLIC CAPITAL LETTER GHE WITH UPTURN",
-	"ґ": "CYRILLIC SMALL LETTER GHE WITH UPTURN",
-	"Ғ": "CYRILLIC CAPITAL LETTER GHE WITH STROKE",
-	"ғ": "CYRILLIC SMALL LETTER GHE WITH STROKE",
-	"Җ": "CYRILLIC CAPITAL LETTER ZHE WITH DESCENDER",
-	"җ": "CYRILLIC SMALL LETTER ZHE WITH DESCENDER",
-	"Ҙ": "CYRILLIC CAPITAL LETTER ZE WITH DESCENDER",
-	"ҙ": "CYRILLIC SMALL LETTER ZE WITH DESCENDER",
-	"Қ": "CYRILLIC CAPITAL LETTER KA WITH DESCENDER",
-	"қ": "CYRILLIC SMALL LETTER KA WITH DESCENDER",
-	"Ҟ": "CYRILLIC CAPITAL LETTER KA WITH STROKE",
-	"ҟ": "CYRILLIC SMALL LETTER KA WITH STROKE",
-	"Ң": "CYRILLIC CAPITAL LETTER EN WITH DESCENDER",
-	"ң": "CYRILLIC SMALL LETTER EN WITH DESCENDER",
-	"Ҫ": "CYRILLIC CAPITAL LETTER ES WITH DESCENDER",
-	"ҫ": "CYRILLIC SMALL LETTER ES WITH DESCENDER",
-	"Ҭ": "CYRILLIC CAPITAL LETTER TE WITH DESCENDER",
-	"ҭ": "CYRILLIC SMALL LETTER TE WITH DESCENDER",
-	"Ү": "CYRILLIC CAPITAL LETTER STRAIGHT U",
-	"ү": "CYRILLIC SMALL LETTER STRAIGHT U",
-	"Ұ": "CYRILLIC CAPITAL LETTER STRAIGHT U WITH STROKE",
-	"ұ": "CYRILLIC SMALL LETTER STRAIGHT U WITH STROKE",
-	"Ҳ": "CYRILLIC CAPITAL LETTER HA WITH DESCENDER",
-	"Ҷ": "CYRILLIC CAPITAL LETTER CHE WITH DESCENDER",
-	"ҷ": "CYRILLIC SMALL LETTER CHE WITH DESCENDER",
-	"һ": "CYRILLIC SMALL LETTER SHHA",
-	"Ҽ̨": "CYRILLIC CAPITAL LETTER ABKHASIAN CHE, COMBINING OGONEK",
-	"ҽ": "CYRILLIC SMALL LETTER ABKHASIAN CHE",
-	"Ҿ": "CYRILLIC CAPITAL LETTER ABKHASIAN CHE WITH DESCENDER",
-	"ҿ": "CYRILLIC SMALL LETTER ABKHASIAN CHE WITH DESCENDER",
-	"Ӏ": "CYRILLIC LETTER PALOCHKA",
-	"Ӄ": "CYRILLIC CAPITAL LETTER KA WITH HOOK",
-	"Ӆ": "CYRILLIC CAPITAL LETTER EL WITH TAIL",
-	"ӆ": "CYRILLIC SMALL LETTER EL WITH TAIL",
-	"Ӈ": "CYRILLIC CAPITAL LETTER EN WITH HOOK",
-	"ӈ": "CYRILLIC SMALL LETTER EN WITH HOOK",
-	"Ӊ": "CYRILLIC CAPITAL LETTER EN WITH TAIL",
-	"ӊ": "CYRILLIC SMALL LETTER EN WITH TAIL",
-	"Ӌ": "CYRILLIC CAPITAL LETTER KHAKASSIAN CHE",
-	"ӌ": "CYRILLIC SMALL LETTER KHAKASSIAN CHE",
-	"Ӎ": "CYRILLIC CAPITAL LETTER EM WITH TAIL",
-	"ӎ": "CYRILLIC SMALL LETTER EM WITH TAIL",
-	"ӏ": "CYRILLIC SMALL LETTER PALOCHKA",
-	"Ӕ": "CYRILLIC CAPITAL LIGATURE A IE",
-	"ӕ": "CYRILLIC SMALL LIGATURE A IE",
-	"Ә": "CYRILLIC CAPITAL LETTER SCHWA",
-	"ә": "CYRILLIC SMALL LETTER SCHWA",
-	"Ӡ": "CYRILLIC CAPITAL LETTER ABKHASIAN DZE",
-	"ӡ": "CYRILLIC SMALL LETTER ABKHASIAN DZE",
-	"Ө": "CYRILLIC CAPITAL LETTER BARRED O",
-	"ө": "CYRILLIC SMALL LETTER BARRED O",
-	"Ӿ": "CYRILLIC CAPITAL LETTER HA WITH STROKE",
-	"ԁ": "CYRILLIC SMALL LETTER KOMI DE",
-	"Ԋ": "CYRILLIC CAPITAL LETTER KOMI NJE",
-	"Ԍ": "CYRILLIC CAPITAL LETTER KOMI SJE",
-	"ԍ": "CYRILLIC SMALL LETTER KOMI SJE",
-	"Ԑ": "CYRILLIC CAPITAL LETTER REVERSED ZE",
-	"ԑ": "CYRILLIC SMALL LETTER REVERSED ZE",
-	"ԛ": "CYRILLIC SMALL LETTER QA",
-	"Ԝ": "CYRILLIC CAPITAL LETTER WE",
-	"ԝ": "CYRILLIC SMALL LETTER WE",
-	"Ի": "ARMENIAN CAPITAL LETTER INI",
-	"Մ": "ARMENIAN CAPITAL LETTER MEN",
-	"Ո": "ARMENIAN CAPITAL LETTER VO",
-	"Ո'": "ARMENIAN CAPITAL LETTER VO, APOSTROPHE",
-	"Ո·": "ARMENIAN CAPITAL LETTER VO, MIDDLE DOT",
-	"Պ": "ARMENIAN CAPITAL LETTER PEH",
-	"Ռ": "ARMENIAN CAPITAL LETTER RA",
-	"Ս": "ARMENIAN CAPITAL LETTER SEH",
-	"Տ": "ARMENIAN CAPITAL LETTER TIWN",
-	"Փ": "ARMENIAN CAPITAL LETTER PIWR",
-	"Ք": "ARMENIAN CAPITAL LETTER KEH",
-	"Օ": "ARMENIAN CAPITAL LETTER OH",
-	"ՙ": "ARMENIAN MODIFIER LETTER LEFT HALF RING",
-	"՚": "ARMENIAN APOSTROPHE",
-	"՝": "ARMENIAN COMMA",
-	"ա": "ARMENIAN SMALL LETTER AYB",
-	"գ": "ARMENIAN SMALL LETTER GIM",
-	"եւ": "ARMENIAN SMALL LETTER ECH, ARMENIAN SMALL LETTER YIWN",
-	"զ": "ARMENIAN SMALL LETTER ZA",
-	"ծ": "ARMENIAN SMALL LETTER CA",
-	"հ": "ARMENIAN SMALL LETTER HO",
-	"ձ": "ARMENIAN SMALL LETTER JA",
-	"մե": "ARMENIAN SMALL LETTER MEN, ARMENIAN SMALL LETTER ECH",
-	"մի": "ARMENIAN SMALL LETTER MEN, ARMENIAN SMALL LETTER INI",
-	"մխ": "ARMENIAN SMALL LETTER MEN, ARMENIAN SMALL LETTER XEH",
-	"մն": "ARMENIAN SMALL LETTER MEN, ARMENIAN SMALL LETTER NOW",
-	"յ": "ARMENIAN SMALL LETTER YI",
-	"ո": "ARMENIAN SMALL LETTER VO",
-	"պ": "ARMENIAN SMALL LETTER PEH",
-	"ռ": "ARMENIAN SMALL LETTER RA",
-	"ս": "ARMENIAN SMALL LETTER SEH",
-	"վն": "ARMENIAN SMALL LETTER VEW, ARMENIAN SMALL LETTER NOW",
-	"ց": "ARMENIAN SMALL LETTER CO",
-	"ք": "ARMENIAN SMALL LETTER KEH",
-	"օ": "ARMENIAN SMALL LETTER OH",
-	"և": "ARMENIAN SMALL LIGATURE ECH YIWN",
-	"։": "ARMENIAN FULL STOP",
-	"֖": "HEBREW ACCENT TIPEHA",
-	"֘": "HEBREW ACCENT ZARQA",
-	"֙": "HEBREW ACCENT PASHTA",
-	"֚": "HEBREW ACCENT YETIV",
-	"֜": "HEBREW ACCENT GERESH",
-	"֝": "HEBREW ACCENT GERESH MUQDAM",
-	"֤": "HEBREW ACCENT MAHAPAKH",
-	"֨": "HEBREW ACCENT QADMA",
-	"֭": "HEBREW ACCENT DEHI",
-	"֮": "HEBREW ACCENT ZINOR",
-	"֯": "HEBREW MARK MASORA CIRCLE",
-	"ִ": "HEBREW POINT HIRIQ",
-	"ֹ": "HEBREW POINT HOLAM",
-	"ֺ": "HEBREW POINT HOLAM HASER FOR VAV",
-	"׀": "HEBREW PUNCTUATION PASEQ",
-	"ׁ": "HEBREW POINT SHIN DOT",
-	"ׂ": "HEBREW POINT SIN DOT",
-	"׃": "HEBREW PUNCTUATION SOF PASUQ",
-	"ׄ": "HEBREW MARK UPPER DOT",
-	"ׅ": "HEBREW MARK LOWER DOT",
-	"א": "HEBREW LETTER ALEF",
-	"אל": "HEBREW LETTER ALEF, HEBREW LETTER LAMED",
-	"ב": "HEBREW LETTER BET",
-	"ג": "HEBREW LETTER GIMEL",
-	"ד": "HEBREW LETTER DALET",
-	"ה": "HEBREW LETTER HE",
-	"ו": "HEBREW LETTER VAV",
-	"ט": "HEBREW LETTER TET",
-	"י": "HEBREW LETTER YOD",
-	"כ": "HEBREW LETTER KAF",
-	"ל": "HEBREW LETTER LAMED",
-	"ם": "HEBREW LETTER FINAL MEM",
-	"ן": "HEBREW LETTER FINAL NUN",
-	"ס": "HEBREW LETTER SAMEKH",
-	"ע": "HEBREW LETTER AYIN",
-	"ר": "HEBREW LETTER RESH",
-	"ת": "HEBREW LETTER TAV",
-	"װ": "HEBREW LIGATURE YIDDISH DOUBLE VAV",
-	"ױ": "HEBREW LIGATURE YIDDISH VAV YOD",
-	"ײ": "HEBREW LIGATURE YIDDISH DOUBLE YOD",
-	"׳": "HEBREW PUNCTUATION GERESH",
-	"״": "HEBREW PUNCTUATION GERSHAYIM",
-	"؉": "ARABIC-INDIC PER MILLE SIGN",
-	"؊": "ARABIC-INDIC PER TEN THOUSAND SIGN",
-	"،": "ARABIC COMMA",
-	"؍": "ARABIC DATE SEPARATOR",
-	"؏": "ARABIC SIGN MISRA",
-	"ؘ": "ARABIC SMALL FATHA",
-	"ؙ": "ARABIC SMALL DAMMA",
-	"ؚ": "ARABIC SMALL KASRA",
-	"؛": "ARABIC SEMICOLON",
-	"؟": "ARABIC QUESTION MARK",
-	"ء": "ARABIC LETTER HAMZA",
-	"ء͈": "ARABIC LETTER HAMZA, COMBINING DOUBLE VERTICAL LINE BELOW",
-	"آ": "ARABIC LETTER ALEF WITH MADDA ABOVE",
-	"أ": "ARABIC LETTER ALEF WITH HAMZA ABOVE",
-	"ؤ": "ARABIC LETTER WAW WITH HAMZA ABOVE",
-	"إ": "ARABIC LETTER ALEF WITH HAMZA BELOW",
-	"ئ": "ARABIC LETTER YEH WITH HAMZA ABOVE",
-	"ا": "ARABIC LETTER ALEF",
-	"ب": "ARABIC LETTER BEH",
-	"بo": "ARABIC LETTER BEH, LATIN SMALL LETTER O",
-	"بج": "ARABIC LETTER BEH, ARABIC LETTER JEEM",
-	"بح": "ARABIC LETTER BEH, ARABIC LETTER HAH",
-	"بحى": "ARABIC LETTER BEH, ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"بخ": "ARABIC LETTER BEH, ARABIC LETTER KHAH",
-	"بخى": "ARABIC LETTER BEH, ARABIC LETTER KHAH, ARABIC LETTER ALEF MAKSURA",
-	"بر": "ARABIC LETTER BEH, ARABIC LETTER REH",
-	"بز": "ARABIC LETTER BEH, ARABIC LETTER ZAIN",
-	"بم": "ARABIC LETTER BEH, ARABIC LETTER MEEM",
-	"بن": "ARABIC LETTER BEH, ARABIC LETTER NOON",
-	"بى": "ARABIC LETTER BEH, ARABIC LETTER ALEF MAKSURA",
-	"بٔ": "ARABIC LETTER BEH, ARABIC HAMZA ABOVE",
-	"بۛ": "ARABIC LETTER BEH, ARABIC SMALL HIGH THREE DOTS",
-	"بۢ": "ARABIC LETTER BEH, ARABIC SMALL HIGH MEEM ISOLATED FORM",
-	"ة": "ARABIC LETTER TEH MARBUTA",
-	"ت": "ARABIC LETTER TEH",
-	"تo": "ARABIC LETTER TEH, LATIN SMALL LETTER O",
-	"تج": "ARABIC LETTER TEH, ARABIC LETTER JEEM",
-	"تجم": "ARABIC LETTER TEH, ARABIC LETTER JEEM, ARABIC LETTER MEEM",
-	"تجى": "ARABIC LETTER TEH, ARABIC LETTER JEEM, ARABIC LETTER ALEF MAKSURA",
-	"تح": "ARABIC LETTER TEH, ARABIC LETTER HAH",
-	"تحج": "ARABIC LETTER TEH, ARABIC LETTER HAH, ARABIC LETTER JEEM",
-	"تحم": "ARABIC LETTER TEH, ARABIC LETTER HAH, ARABIC LETTER MEEM",
-	"تخ": "ARABIC LETTER TEH, ARABIC LETTER KHAH",
-	"تخم": "ARABIC LETTER TEH, ARABIC LETTER KHAH, ARABIC LETTER MEEM",
-	"تخى": "ARABIC LETTER TEH, ARABIC LETTER KHAH, ARABIC LETTER ALEF MAKSURA",
-	"تر": "ARABIC LETTER TEH, ARABIC LETTER REH",
-	"تز": "ARABIC LETTER TEH, ARABIC LETTER ZAIN",
-	"تم": "ARABIC LETTER TEH, ARABIC LETTER MEEM",
-	"تمج": "ARABIC LETTER TEH, ARABIC LETTER MEEM, ARABIC LETTER JEEM",
-	"تمح": "ARABIC LETTER TEH, ARABIC LETTER MEEM, ARABIC LETTER HAH",
-	"تمخ": "ARABIC LETTER TEH, ARABIC LETTER MEEM, ARABIC LETTER KHAH",
-	"تمى": "ARABIC LETTER TEH, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"تن": "ARABIC LETTER TEH, ARABIC LETTER NOON",
-	"تى": "ARABIC LETTER TEH, ARABIC LETTER ALEF MAKSURA",
-	"ث": "ARABIC LETTER THEH",
-	"ج": "ARABIC LETTER JEEM",
-	"جح": "ARABIC LETTER JEEM, ARABIC LETTER HAH",
-	"جحى": "ARABIC LETTER JEEM, ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"جل جلlلo": "ARABIC LETTER JEEM, ARABIC LETTER LAM, SPACE, ARABIC LETTER JEEM, ARABIC LETTER LAM, LATIN SMALL LETTER L, ARABIC LETTER LAM, LATIN SMALL LETTER O",
-	"جم": "ARABIC LETTER JEEM, ARABIC LETTER MEEM",
-	"جمح": "ARABIC LETTER JEEM, ARABIC LETTER MEEM, ARABIC LETTER HAH",
-	"جمى": "ARABIC LETTER JEEM, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"جى": "ARABIC LETTER JEEM, ARABIC LETTER ALEF MAKSURA",
-	"ح": "ARABIC LETTER HAH",
-	"حج": "ARABIC LETTER HAH, ARABIC LETTER JEEM",
-	"حجى": "ARABIC LETTER HAH, ARABIC LETTER JEEM, ARABIC LETTER ALEF MAKSURA",
-	"حم": "ARABIC LETTER HAH, ARABIC LETTER MEEM",
-	"حمى": "ARABIC LETTER HAH, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"حى": "ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"حٔ": "ARABIC LETTER HAH, ARABIC HAMZA ABOVE",
-	"حۛ": "ARABIC LETTER HAH, ARABIC SMALL HIGH THREE DOTS",
-	"خ": "ARABIC LETTER KHAH",
-	"خج": "ARABIC LETTER KHAH, ARABIC LETTER JEEM",
-	"خح": "ARABIC LETTER KHAH, ARABIC LETTER HAH",
-	"خم": "ARABIC LETTER KHAH, ARABIC LETTER MEEM",
-	"خى": "ARABIC LETTER KHAH, ARABIC LETTER ALEF MAKSURA",
-	"د": "ARABIC LETTER DAL",
-	"د̂": "ARABIC LETTER DAL, COMBINING CIRCUMFLEX ACCENT",
-	"د̤̣": "ARABIC LETTER DAL, COMBINING DIAERESIS BELOW, COMBINING DOT BELOW",
-	"دؕ": "ARABIC LETTER DAL, ARABIC SMALL HIGH TAH",
-	"دۛ": "ARABIC LETTER DAL, ARABIC SMALL HIGH THREE DOTS",
-	"ذ": "ARABIC LETTER THAL",
-	"ذٰ": "ARABIC LETTER THAL, ARABIC LETTER SUPERSCRIPT ALEF",
-	"ر": "ARABIC LETTER REH",
-	"ر̂": "ARABIC LETTER REH, COMBINING CIRCUMFLEX ACCENT",
-	"ر̆": "ARABIC LETTER REH, COMBINING BREVE",
-	"ر̆̇": "ARABIC LETTER REH, COMBINING BREVE, COMBINING DOT ABOVE",
-	"رؕ": "ARABIC LETTER REH, ARABIC SMALL HIGH TAH",
-	"رسول": "ARABIC LETTER REH, ARABIC LETTER SEEN, ARABIC LETTER WAW, ARABIC LETTER LAM",
-	"رىlل": "ARABIC LETTER REH, ARABIC LETTER ALEF MAKSURA, LATIN SMALL LETTER L, ARABIC LETTER LAM",
-	"رٔ": "ARABIC LETTER REH, ARABIC HAMZA ABOVE",
-	"رٰ": "ARABIC LETTER REH, ARABIC LETTER SUPERSCRIPT ALEF",
-	"رۛ": "ARABIC LETTER REH, ARABIC SMALL HIGH THREE DOTS",
-	"ز": "ARABIC LETTER ZAIN",
-	"ز̂": "ARABIC LETTER ZAIN, COMBINING CIRCUMFLEX ACCENT",
-	"س": "ARABIC LETTER SEEN",
-	"سo": "ARABIC LETTER SEEN, LATIN SMALL LETTER O",
-	"س̂": "ARABIC LETTER SEEN, COMBINING CIRCUMFLEX ACCENT",
-	"سج": "ARABIC LETTER SEEN, ARABIC LETTER JEEM",
-	"سجح": "ARABIC LETTER SEEN, ARABIC LETTER JEEM, ARABIC LETTER HAH",
-	"سجى": "ARABIC LETTER SEEN, ARABIC LETTER JEEM, ARABIC LETTER ALEF MAKSURA",
-	"سح": "ARABIC LETTER SEEN, ARABIC LETTER HAH",
-	"سحج": "ARABIC LETTER SEEN, ARABIC LETTER HAH, ARABIC LETTER JEEM",
-	"سخ": "ARABIC LETTER SEEN, ARABIC LETTER KHAH",
-	"سخى": "ARABIC LETTER SEEN, ARABIC LETTER KHAH, ARABIC LETTER ALEF MAKSURA",
-	"سر": "ARABIC LETTER SEEN, ARABIC LETTER REH",
-	"سم": "ARABIC LETTER SEEN, ARABIC LETTER MEEM",
-	"سمج": "ARABIC LETTER SEEN, ARABIC LETTER MEEM, ARABIC LETTER JEEM",
-	"سمح": "ARABIC LETTER SEEN, ARABIC LETTER MEEM, ARABIC LETTER HAH",
-	"سمم": "ARABIC LETTER SEEN, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"سى": "ARABIC LETTER SEEN, ARABIC LETTER ALEF MAKSURA",
-	"سۛ": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS",
-	"سۛo": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, LATIN SMALL LETTER O",
-	"سۛج": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER JEEM",
-	"سۛجى": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER JEEM, ARABIC LETTER ALEF MAKSURA",
-	"سۛح": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER HAH",
-	"سۛحم": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER HAH, ARABIC LETTER MEEM",
-	"سۛحى": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"سۛخ": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER KHAH",
-	"سۛر": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER REH",
-	"سۛم": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER MEEM",
-	"سۛمخ": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER MEEM, ARABIC LETTER KHAH",
-	"سۛمم": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"سۛى": "ARABIC LETTER SEEN, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER ALEF MAKSURA",
-	"ش": "ARABIC LETTER SHEEN",
-	"ص": "ARABIC LETTER SAD",
-	"ص̤̣": "ARABIC LETTER SAD, COMBINING DIAERESIS BELOW, COMBINING DOT BELOW",
-	"صح": "ARABIC LETTER SAD, ARABIC LETTER HAH",
-	"صحح": "ARABIC LETTER SAD, ARABIC LETTER HAH, ARABIC LETTER HAH",
-	"صحى": "ARABIC LETTER SAD, ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"صخ": "ARABIC LETTER SAD, ARABIC LETTER KHAH",
-	"صر": "ARABIC LETTER SAD, ARABIC LETTER REH",
-	"صلعم": "ARABIC LETTER SAD, ARABIC LETTER LAM, ARABIC LETTER AIN, ARABIC LETTER MEEM",
-	"صلى lللo علىo وسلم": "ARABIC LETTER SAD, ARABIC LETTER LAM, ARABIC LETTER ALEF MAKSURA, SPACE, LATIN SMALL LETTER L, ARABIC LETTER LAM, ARABIC LETTER LAM, LATIN SMALL LETTER O, SPACE, ARABIC LETTER AIN, ARABIC LETTER LAM, ARABIC LETTER ALEF MAKSURA, LATIN SMALL LETTER O, SPACE, ARABIC LETTER WAW, ARABIC LETTER SEEN, ARABIC LETTER LAM, ARABIC LETTER MEEM",
-	"صلى": "ARABIC LETTER SAD, ARABIC LETTER LAM, ARABIC LETTER ALEF MAKSURA",
-	"صم": "ARABIC LETTER SAD, ARABIC LETTER MEEM",
-	"صمم": "ARABIC LETTER SAD, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"صى": "ARABIC LETTER SAD, ARABIC LETTER ALEF MAKSURA",
-	"صۛ": "ARABIC LETTER SAD, ARABIC SMALL HIGH THREE DOTS",
-	"ض": "ARABIC LETTER DAD",
-	"ضج": "ARABIC LETTER DAD, ARABIC LETTER JEEM",
-	"ضح": "ARABIC LETTER DAD, ARABIC LETTER HAH",
-	"ضحى": "ARABIC LETTER DAD, ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"ضخ": "ARABIC LETTER DAD, ARABIC LETTER KHAH",
-	"ضخم": "ARABIC LETTER DAD, ARABIC LETTER KHAH, ARABIC LETTER MEEM",
-	"ضر": "ARABIC LETTER DAD, ARABIC LETTER REH",
-	"ضم": "ARABIC LETTER DAD, ARABIC LETTER MEEM",
-	"ضى": "ARABIC LETTER DAD, ARABIC LETTER ALEF MAKSURA",
-	"ط": "ARABIC LETTER TAH",
-	"طح": "ARABIC LETTER TAH, ARABIC LETTER HAH",
-	"طم": "ARABIC LETTER TAH, ARABIC LETTER MEEM",
-	"طمح": "ARABIC LETTER TAH, ARABIC LETTER MEEM, ARABIC LETTER HAH",
-	"طمم": "ARABIC LETTER TAH, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"طمى": "ARABIC LETTER TAH, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"طى": "ARABIC LETTER TAH, ARABIC LETTER ALEF MAKSURA",
-	"طۛ": "ARABIC LETTER TAH, ARABIC SMALL HIGH THREE DOTS",
-	"ظ": "ARABIC LETTER ZAH",
-	"ظم": "ARABIC LETTER ZAH, ARABIC LETTER MEEM",
-	"ع": "ARABIC LETTER AIN",
-	"عج": "ARABIC LETTER AIN, ARABIC LETTER JEEM",
-	"عجم": "ARABIC LETTER AIN, ARABIC LETTER JEEM, ARABIC LETTER MEEM",
-	"علىo": "ARABIC LETTER AIN, ARABIC LETTER LAM, ARABIC LETTER ALEF MAKSURA, LATIN SMALL LETTER O",
-	"عم": "ARABIC LETTER AIN, ARABIC LETTER MEEM",
-	"عمم": "ARABIC LETTER AIN, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"عمى": "ARABIC LETTER AIN, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"عى": "ARABIC LETTER AIN, ARABIC LETTER ALEF MAKSURA",
-	"غ": "ARABIC LETTER GHAIN",
-	"غج": "ARABIC LETTER GHAIN, ARABIC LETTER JEEM",
-	"غم": "ARABIC LETTER GHAIN, ARABIC LETTER MEEM",
-	"غمم": "ARABIC LETTER GHAIN, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"غمى": "ARABIC LETTER GHAIN, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"غى": "ARABIC LETTER GHAIN, ARABIC LETTER ALEF MAKSURA",
-	"ؽ": "ARABIC LETTER FARSI YEH WITH INVERTED V",
-	"ؿ": "ARABIC LETTER FARSI YEH WITH THREE DOTS ABOVE",
-	"ف": "ARABIC LETTER FEH",
-	"فج": "ARABIC LETTER FEH, ARABIC LETTER JEEM",
-	"فح": "ARABIC LETTER FEH, ARABIC LETTER HAH",
-	"فخ": "ARABIC LETTER FEH, ARABIC LETTER KHAH",
-	"فخم": "ARABIC LETTER FEH, ARABIC LETTER KHAH, ARABIC LETTER MEEM",
-	"فم": "ARABIC LETTER FEH, ARABIC LETTER MEEM",
-	"فمى": "ARABIC LETTER FEH, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"فى": "ARABIC LETTER FEH, ARABIC LETTER ALEF MAKSURA",
-	"ق": "ARABIC LETTER QAF",
-	"قح": "ARABIC LETTER QAF, ARABIC LETTER HAH",
-	"قلى": "ARABIC LETTER QAF, ARABIC LETTER LAM, ARABIC LETTER ALEF MAKSURA",
-	"قم": "ARABIC LETTER QAF, ARABIC LETTER MEEM",
-	"قمح": "ARABIC LETTER QAF, ARABIC LETTER MEEM, ARABIC LETTER HAH",
-	"قمم": "ARABIC LETTER QAF, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"قمى": "ARABIC LETTER QAF, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"قى": "ARABIC LETTER QAF, ARABIC LETTER ALEF MAKSURA",
-	"ك": "ARABIC LETTER KAF",
-	"كl": "ARABIC LETTER KAF, LATIN SMALL LETTER L",
-	"كج": "ARABIC LETTER KAF, ARABIC LETTER JEEM",
-	"كح": "ARABIC LETTER KAF, ARABIC LETTER HAH",
-	"كخ": "ARABIC LETTER KAF, ARABIC LETTER KHAH",
-	"كل": "ARABIC LETTER KAF, ARABIC LETTER LAM",
-	"كم": "ARABIC LETTER KAF, ARABIC LETTER MEEM",
-	"كمم": "ARABIC LETTER KAF, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"كمى": "ARABIC LETTER KAF, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"كى": "ARABIC LETTER KAF, ARABIC LETTER ALEF MAKSURA",
-	"كۛ": "ARABIC LETTER KAF, ARABIC SMALL HIGH THREE DOTS",
-	"ل": "ARABIC LETTER LAM",
-	"لl": "ARABIC LETTER LAM, LATIN SMALL LETTER L",
-	"لlٕ": "ARABIC LETTER LAM, LATIN SMALL LETTER L, ARABIC HAMZA BELOW",
-	"لlٴ": "ARABIC LETTER LAM, LATIN SMALL LETTER L, ARABIC LETTER HIGH HAMZA",
-	"لo": "ARABIC LETTER LAM, LATIN SMALL LETTER O",
-	"ل̆": "ARABIC LETTER LAM, COMBINING BREVE",
-	"لآ": "ARABIC LETTER LAM, ARABIC LETTER ALEF WITH MADDA ABOVE",
-	"لج": "ARABIC LETTER LAM, ARABIC LETTER JEEM",
-	"لجج": "ARABIC LETTER LAM, ARABIC LETTER JEEM, ARABIC LETTER JEEM",
-	"لجم": "ARABIC LETTER LAM, ARABIC LETTER JEEM, ARABIC LETTER MEEM",
-	"لجى": "ARABIC LETTER LAM, ARABIC LETTER JEEM, ARABIC LETTER ALEF MAKSURA",
-	"لح": "ARABIC LETTER LAM, ARABIC LETTER HAH",
-	"لحم": "ARABIC LETTER LAM, ARABIC LETTER HAH, ARABIC LETTER MEEM",
-	"لحى": "ARABIC LETTER LAM, ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"لخ": "ARABIC LETTER LAM, ARABIC LETTER KHAH",
-	"لخم": "ARABIC LETTER LAM, ARABIC LETTER KHAH, ARABIC LETTER MEEM",
-	"لم": "ARABIC LETTER LAM, ARABIC LETTER MEEM",
-	"لمح": "ARABIC LETTER LAM, ARABIC LETTER MEEM, ARABIC LETTER HAH",
-	"لمى": "ARABIC LETTER LAM, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"لى": "ARABIC LETTER LAM, ARABIC LETTER ALEF MAKSURA",
-	"لۛ": "ARABIC LETTER LAM, ARABIC SMALL HIGH THREE DOTS",
-	"م": "ARABIC LETTER MEEM",
-	"مl": "ARABIC LETTER MEEM, LATIN SMALL LETTER L",
-	"م͈": "ARABIC LETTER MEEM, COMBINING DOUBLE VERTICAL LINE BELOW",
-	"مج": "ARABIC LETTER MEEM, ARABIC LETTER JEEM",
-	"مجح": "ARABIC LETTER MEEM, ARABIC LETTER JEEM, ARABIC LETTER HAH",
-	"مجخ": "ARABIC LETTER MEEM, ARABIC LETTER JEEM, ARABIC LETTER KHAH",
-	"مجم": "ARABIC LETTER MEEM, ARABIC LETTER JEEM, ARABIC LETTER MEEM",
-	"مجى": "ARABIC LETTER MEEM, ARABIC LETTER JEEM, ARABIC LETTER ALEF MAKSURA",
-	"مح": "ARABIC LETTER MEEM, ARABIC LETTER HAH",
-	"محج": "ARABIC LETTER MEEM, ARABIC LETTER HAH, ARABIC LETTER JEEM",
-	"محم": "ARABIC LETTER MEEM, ARABIC LETTER HAH, ARABIC LETTER MEEM",
-	"محمد": "ARABIC LETTER MEEM, ARABIC LETTER HAH, ARABIC LETTER MEEM, ARABIC LETTER DAL",
-	"محى": "ARABIC LETTER MEEM, ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"مخ": "ARABIC LETTER MEEM, ARABIC LETTER KHAH",
-	"مخج": "ARABIC LETTER MEEM, ARABIC LETTER KHAH, ARABIC LETTER JEEM",
-	"مخم": "ARABIC LETTER MEEM, ARABIC LETTER KHAH, ARABIC LETTER MEEM",
-	"مخى": "ARABIC LETTER MEEM, ARABIC LETTER KHAH, ARABIC LETTER ALEF MAKSURA",
-	"مم": "ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"ممى": "ARABIC LETTER MEEM, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"مى": "ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"مۛ": "ARABIC LETTER MEEM, ARABIC SMALL HIGH THREE DOTS",
-	"ن": "ARABIC LETTER NOON",
-	"نo": "ARABIC LETTER NOON, LATIN SMALL LETTER O",
-	"ن̆": "ARABIC LETTER NOON, COMBINING BREVE",
-	"نؕ": "ARABIC LETTER NOON, ARABIC SMALL HIGH TAH",
-	"نجح": "ARABIC LETTER NOON, ARABIC LETTER JEEM, ARABIC LETTER HAH",
-	"نجم": "ARABIC LETTER NOON, ARABIC LETTER JEEM, ARABIC LETTER MEEM",
-	"نجى": "ARABIC LETTER NOON, ARABIC LETTER JEEM, ARABIC LETTER ALEF MAKSURA",
-	"نح": "ARABIC LETTER NOON, ARABIC LETTER HAH",
-	"نحم": "ARABIC LETTER NOON, ARABIC LETTER HAH, ARABIC LETTER MEEM",
-	"نحى": "ARABIC LETTER NOON, ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"نخ": "ARABIC LETTER NOON, ARABIC LETTER KHAH",
-	"نر": "ARABIC LETTER NOON, ARABIC LETTER REH",
-	"نز": "ARABIC LETTER NOON, ARABIC LETTER ZAIN",
-	"نم": "ARABIC LETTER NOON, ARABIC LETTER MEEM",
-	"نمى": "ARABIC LETTER NOON, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"نن": "ARABIC LETTER NOON, ARABIC LETTER NOON",
-	"نى": "ARABIC LETTER NOON, ARABIC LETTER ALEF MAKSURA",
-	"ه": "ARABIC LETTER HEH",
-	"و": "ARABIC LETTER WAW",
-	"و̂": "ARABIC LETTER WAW, COMBINING CIRCUMFLEX ACCENT",
-	"و̆": "ARABIC LETTER WAW, COMBINING BREVE",
-	"و̓": "ARABIC LETTER WAW, COMBINING COMMA ABOVE",
-	"و̓ٴ": "ARABIC LETTER WAW, COMBINING COMMA ABOVE, ARABIC LETTER HIGH HAMZA",
-	"وسلم": "ARABIC LETTER WAW, ARABIC LETTER SEEN, ARABIC LETTER LAM, ARABIC LETTER MEEM",
-	"وٰ": "ARABIC LETTER WAW, ARABIC LETTER SUPERSCRIPT ALEF",
-	"وٴ": "ARABIC LETTER WAW, ARABIC LETTER HIGH HAMZA",
-	"وۛ": "ARABIC LETTER WAW, ARABIC SMALL HIGH THREE DOTS",
-	"ى": "ARABIC LETTER ALEF MAKSURA",
-	"ىo": "ARABIC LETTER ALEF MAKSURA, LATIN SMALL LETTER O",
-	"ى̂": "ARABIC LETTER ALEF MAKSURA, COMBINING CIRCUMFLEX ACCENT",
-	"ى̆": "ARABIC LETTER ALEF MAKSURA, COMBINING BREVE",
-	"ى̆̇": "ARABIC LETTER ALEF MAKSURA, COMBINING BREVE, COMBINING DOT ABOVE",
-	"ىؕ": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH TAH",
-	"ىج": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER JEEM",
-	"ىجى": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER JEEM, ARABIC LETTER ALEF MAKSURA",
-	"ىح": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HAH",
-	"ىحى": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HAH, ARABIC LETTER ALEF MAKSURA",
-	"ىخ": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER KHAH",
-	"ىر": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER REH",
-	"ىز": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER ZAIN",
-	"ىم": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER MEEM",
-	"ىمم": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER MEEM, ARABIC LETTER MEEM",
-	"ىمى": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER MEEM, ARABIC LETTER ALEF MAKSURA",
-	"ىن": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER NOON",
-	"ىى": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER ALEF MAKSURA",
-	"ىٔ": "ARABIC LETTER ALEF MAKSURA, ARABIC HAMZA ABOVE",
-	"ىٰ": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER SUPERSCRIPT ALEF",
-	"ىٴ": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA",
-	"ىٴl": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, LATIN SMALL LETTER L",
-	"ىٴo": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, LATIN SMALL LETTER O",
-	"ىٴج": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER JEEM",
-	"ىٴح": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER HAH",
-	"ىٴخ": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER KHAH",
-	"ىٴر": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER REH",
-	"ىٴز": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER ZAIN",
-	"ىٴم": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER MEEM",
-	"ىٴن": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER NOON",
-	"ىٴو": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER WAW",
-	"ىٴو̆": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER WAW, COMBINING BREVE",
-	"ىٴو̓": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER WAW, COMBINING COMMA ABOVE",
-	"ىٴوٰ": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER WAW, ARABIC LETTER SUPERSCRIPT ALEF",
-	"ىٴى": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER ALEF MAKSURA",
-	"ىٴٻ": "ARABIC LETTER ALEF MAKSURA, ARABIC LETTER HIGH HAMZA, ARABIC LETTER BEEH",
-	"ىۛ": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH THREE DOTS",
-	"ىۛo": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH THREE DOTS, LATIN SMALL LETTER O",
-	"ىۛج": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER JEEM",
-	"ىۛر": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER REH",
-	"ىۛز": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER ZAIN",
-	"ىۛم": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER MEEM",
-	"ىۛن": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER NOON",
-	"ىۛى": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH THREE DOTS, ARABIC LETTER ALEF MAKSURA",
-	"ىۛۢ": "ARABIC LETTER ALEF MAKSURA, ARABIC SMALL HIGH THREE DOTS, ARABIC SMALL HIGH MEEM ISOLATED FORM",
-	"ي": "ARABIC LETTER YEH",
-	"ً": "ARABIC FATHATAN",
-	"ٌ": "ARABIC DAMMATAN",
-	"ٍ": "ARABIC KASRATAN",
-	"َ": "ARABIC FATHA",
-	"ُ": "ARABIC DAMMA",
-	"ِ": "ARABIC KASRA",
-	"ْ": "ARABIC SUKUN",
-	"ٓ": "ARABIC MADDAH ABOVE",
-	"ٕ": "ARABIC HAMZA BELOW",
-	"ٖ": "ARABIC SUBSCRIPT ALEF",
-	"ٗ": "ARABIC INVERTED DAMMA",
-	"٘": "ARABIC MARK NOON GHUNNA",
-	"ٙ": "ARABIC ZWARAKAY",
-	"ٚ": "ARABIC VOWEL SIGN SMALL V ABOVE",
-	"ٛ": "ARABIC VOWEL SIGN INVERTED SMALL V ABOVE",
-	"ٜ": "ARABIC VOWEL SIGN DOT BELOW",
-	"ٝ": "ARABIC REVERSED DAMMA",
-	"ٟ": "ARABIC WAVY HAMZA BELOW",
-	"٠": "ARABIC-INDIC DIGIT ZERO",
-	"١": "ARABIC-INDIC DIGIT ONE",
-	"٢": "ARABIC-INDIC DIGIT TWO",
-	"٣": "ARABIC-INDIC DIGIT THREE",
-	"٤": "ARABIC-INDIC DIGIT FOUR",
-	"٥": "ARABIC-INDIC DIGIT FIVE",
-	"٦": "ARABIC-INDIC DIGIT SIX",
-	"٧": "ARABIC-INDIC DIGIT SEVEN",
-	"٨": "ARABIC-INDIC DIGIT EIGHT",
-	"٩": "ARABIC-INDIC DIGIT NINE",
-	"٪": "ARABIC PERCENT SIGN",
-	"٫": "ARABIC DECIMAL SEPARATOR",
-	"٬": "ARABIC THOUSANDS SEPARATOR",
-	"٭": "ARABIC FIVE POINTED STAR",
-	"ٮ": "ARABIC LETTER DOTLESS BEH",
-	"ٯ": "ARABIC LETTER DOTLESS QAF",
-	"ٰ": "ARABIC LETTER SUPERSCRIPT ALEF",
-	"ٱ": "ARABIC LETTER ALEF WASLA",
-	"ٲ": "ARABIC LETTER ALEF WITH WAVY HAMZA ABOVE",
-	"ٳ": "ARABIC LETTER ALEF WITH WAVY HAMZA BELOW",
-	"ٵ": "ARABIC LETTER HIGH HAMZA ALEF",
-	"ٶ": "ARABIC LETTER HIGH HAMZA WAW",
-	"ٷ": "ARABIC LETTER U WITH HAMZA ABOVE",
-	"ٸ": "ARABIC LETTER HIGH HAMZA YEH",
-	"ٹ": "ARABIC LETTER TTEH",
-	"ٺ": "ARABIC LETTER TTEHEH",
-	"ٻ": "ARABIC LETTER BEEH",
-	"پ": "ARABIC LETTER PEH",
-	"ٿ": "ARABIC LETTER TEHEH",
-	"ڀ": "ARABIC LETTER BEHEH",
-	"ځ": "ARABIC LETTER HAH WITH HAMZA ABOVE",
-	"ڃ": "ARABIC LETTER NYEH",
-	"ڄ": "ARABIC LETTER DYEH",
-	"څ": "ARABIC LETTER HAH WITH THREE DOTS ABOVE",
-	"چ": "ARABIC LETTER TCHEH",
-	"ڇ": "ARABIC LETTER TCHEHEH",
-	"ڈ": "ARABIC LETTER DDAL",
-	"ڊؕ": "ARABIC LETTER DAL WITH DOT BELOW, ARABIC SMALL HIGH TAH",
-	"ڋ": "ARABIC LETTER DAL WITH DOT BELOW AND SMALL TAH",
-	"ڌ": "ARABIC LETTER DAHAL",
-	"ڍ": "ARABIC LETTER DDAHAL",
-	"ڎ": "ARABIC LETTER DUL",
-	"ڑ": "ARABIC LETTER RREH",
-	"ڒ": "ARABIC LETTER REH WITH SMALL V",
-	"ڗؕ": "ARABIC LETTER REH WITH TWO DOTS ABOVE, ARABIC SMALL HIGH TAH",
-	"ژ": "ARABIC LETTER JEH",
-	"ڞ": "ARABIC LETTER SAD WITH THREE DOTS ABOVE",
-	"ڟ": "ARABIC LETTER TAH WITH THREE DOTS ABOVE",
-	"ڡ": "ARABIC LETTER DOTLESS FEH",
-	"ڡۛ": "ARABIC LETTER DOTLESS FEH, ARABIC SMALL HIGH THREE DOTS",
-	"ڢۛ": "ARABIC LETTER FEH WITH DOT MOVED BELOW, ARABIC SMALL HIGH THREE DOTS",
-	"ڤ": "ARABIC LETTER VEH",
-	"ڦ": "ARABIC LETTER PEHEH",
-	"ڧ": "ARABIC LETTER QAF WITH DOT ABOVE",
-	"ڨ": "ARABIC LETTER QAF WITH THREE DOTS ABOVE",
-	"ک": "ARABIC LETTER KEHEH",
-	"ڪ": "ARABIC LETTER SWASH KAF",
-	"ڬ": "ARABIC LETTER KAF WITH DOT ABOVE",
-	"ڭ": "ARABIC LETTER NG",
-	"گ": "ARABIC LETTER GAF",
-	"گۛ": "ARABIC LETTER GAF, ARABIC SMALL HIGH THREE DOTS",
-	"ڱ": "ARABIC LETTER NGOEH",
-	"ڳ": "ARABIC LETTER GUEH",
-	"ڴ": "ARABIC LETTER GAF WITH THREE DOTS ABOVE",
-	"ڵ": "ARABIC LETTER LAM WITH SMALL V",
-	"ڷ": "ARABIC LETTER LAM WITH THREE DOTS ABOVE",
-	"ں": "ARABIC LETTER NOON GHUNNA",
-	"ڻ": "ARABIC LETTER RNOON",
-	"ڽ": "ARABIC LETTER NOON WITH THREE DOTS ABOVE",
-	"ھ": "ARABIC LETTER HEH DOACHASHMEE",
-	"ۀ": "ARABIC LETTER HEH WITH YEH ABOVE",
-	"ہ": "ARABIC LETTER HEH GOAL",
-	"ۂ": "ARABIC LETTER HEH GOAL WITH HAMZA ABOVE",
-	"ۃ": "ARABIC LETTER TEH MARBUTA GOAL",
-	"ۅ": "ARABIC LETTER KIRGHIZ OE",
-	"ۆ": "ARABIC LETTER OE",
-	"ۇ": "ARABIC LETTER U",
-	"ۈ": "ARABIC LETTER YU",
-	"ۉ": "ARABIC LETTER KIRGHIZ YU",
-	"ۋ": "ARABIC LETTER VE",
-	"ی": "ARABIC LETTER FARSI YEH",
-	"ێ": "ARABIC LETTER YEH WITH SMALL V",
-	"ې": "ARABIC LETTER E",
-	"ۑ": "ARABIC LETTER YEH WITH THREE DOTS BELOW",
-	"ے": "ARABIC LETTER YEH BARREE",
-	"ۓ": "ARABIC LETTER YEH BARREE WITH HAMZA ABOVE",
-	"۔": "ARABIC FULL STOP",
-	"ە": "ARABIC LETTER AE",
-	"ۛ": "ARABIC SMALL HIGH THREE DOTS",
-	"۟": "ARABIC SMALL HIGH ROUNDED ZERO",
-	"ۨ": "ARABIC SMALL HIGH NOON",
-	"۬": "ARABIC ROUNDED HIGH STOP WITH FILLED CENTRE",
-	"ۮ": "ARABIC LETTER DAL WITH INVERTED V",
-	"ۯ": "ARABIC LETTER REH WITH INVERTED V",
-	"۰": "EXTENDED ARABIC-INDIC DIGIT ZERO",
-	"۱": "EXTENDED ARABIC-INDIC DIGIT ONE",
-	"۲": "EXTENDED ARABIC-INDIC DIGIT TWO",
-	"۳": "EXTENDED ARABIC-INDIC DIGIT THREE",
-	"۴": "EXTENDED ARABIC-INDIC DIGIT FOUR",
-	"۵": "EXTENDED ARABIC-INDIC DIGIT FIVE",
-	"۶": "EXTENDED ARABIC-INDIC DIGIT SIX",
-	"۷": "EXTENDED ARABIC-INDIC DIGIT SEVEN",
-	"۸": "EXTENDED ARABIC-INDIC DIGIT EIGHT",
-	"۹": "EXTENDED ARABIC-INDIC DIGIT NINE",
-	"۽": "ARABIC SIGN SINDHI AMPERSAND",
-	"۾": "ARABIC SIGN SINDHI POSTPOSITION MEN",
-	"ۿ": "ARABIC LETTER HEH WITH INVERTED V",
-	"܁": "SYRIAC SUPRALINEAR FULL STOP",
-	"܂": "SYRIAC SUBLINEAR FULL STOP",
-	"܃": "SYRIAC SUPRALINEAR COLON",
-	"܄": "SYRIAC SUBLINEAR COLON",
-	"ܼ": "SYRIAC HBASA-ESASA DOTTED",
-	"݀": "SYRIAC FEMININE DOT",
-	"݁": "SYRIAC QUSHSHAYA",
-	"݂": "SYRIAC RUKKAKHA",
-	"݇": "SYRIAC OBLIQUE LINE ABOVE",
-	"ݑ": "ARABIC LETTER BEH WITH DOT BELOW AND THREE DOTS ABOVE",
-	"ݔ": "ARABIC LETTER BEH WITH TWO DOTS BELOW AND DOT ABOVE",
-	"ݖ": "ARABIC LETTER BEH WITH SMALL V",
-	"ݢ": "ARABIC LETTER KEHEH WITH DOT ABOVE",
-	"ݣ": "ARABIC LETTER KEHEH WITH THREE DOTS ABOVE",
-	"ݧ": "ARABIC LETTER NOON WITH TWO DOTS BELOW",
-	"ݨ": "ARABIC LETTER NOON WITH SMALL TAH",
-	"ݩ": "ARABIC LETTER NOON WITH SMALL V",
-	"ݬ": "ARABIC LETTER REH WITH HAMZA ABOVE",
-	"ݱ": "ARABIC LETTER REH WITH SMALL ARABIC LETTER TAH AND TWO DOTS",
-	"ݲ": "ARABIC LETTER HAH WITH SMALL ARABIC LETTER TAH ABOVE",
-	"ݾ": "ARABIC LETTER SEEN WITH INVERTED V",
-	"߀": "NKO DIGIT ZERO",
-	"ߊ": "NKO LETTER A",
-	"߫": "NKO COMBINING SHORT HIGH TONE",
-	"߭": "NKO COMBINING SHORT RISING TONE",
-	"߮": "NKO COMBINING LONG DESCENDING TONE",
-	"߳": "NKO COMBINING DOUBLE DOT ABOVE",
-	"ߴ": "NKO HIGH TONE APOSTROPHE",
-	"ߵ": "NKO LOW TONE APOSTROPHE",
-	"ߺ": "NKO LAJANYALAN",
-	"ࢡ": "ARABIC LETTER BEH WITH HAMZA ABOVE",
-	"ࢤ": "ARABIC LETTER FEH WITH DOT BELOW AND THREE DOTS ABOVE",
-	"ࢧ": "ARABIC LETTER MEEM WITH THREE DOTS ABOVE",
-	"ࢨ": "ARABIC LETTER YEH WITH TWO DOTS BELOW AND HAMZA ABOVE",
-	"ࢩ": "ARABIC LETTER YEH WITH TWO DOTS BELOW AND DOT ABOVE",
-	"ࢮ": "ARABIC LETTER DAL WITH THREE DOTS BELOW",
-	"ࢯ": "ARABIC LETTER SAD WITH THREE DOTS BELOW",
-	"ࢰ": "ARABIC LETTER GAF WITH INVERTED STROKE",
-	"ࢱ": "ARABIC LETTER STRAIGHT WAW",
-	"ࢲ": "ARABIC LETTER ZAIN WITH INVERTED V ABOVE",
-	"ࢶ": "ARABIC LETTER BEH WITH SMALL MEEM ABOVE",
-	"ࢷ": "ARABIC LETTER PEH WITH SMALL MEEM ABOVE",
-	"ࢹ": "ARABIC LETTER REH WITH SMALL NOON ABOVE",
-	"ࢺ": "ARABIC LETTER YEH WITH TWO DOTS BELOW AND SMALL NOON ABOVE",
-	"ࢻ": "ARABIC LETTER AFRICAN FEH",
-	"ࢼ": "ARABIC LETTER AFRICAN QAF",
-	"ࢽ": "ARABIC LETTER AFRICAN NOON",
-	"ࣥ": "ARABIC CURLY DAMMA",
-	"ࣨ": "ARABIC CURLY DAMMATAN",
-	"࣪": "ARABIC TONE ONE DOT ABOVE",
-	"࣫": "ARABIC TONE TWO DOTS ABOVE",
-	"࣭": "ARABIC TONE ONE DOT BELOW",
-	"࣮": "ARABIC TONE TWO DOTS BELOW",
-	"ࣰ": "ARABIC OPEN FATHATAN",
-	"ࣱ": "ARABIC OPEN DAMMATAN",
-	"ࣲ": "ARABIC OPEN KASRATAN",
-	"ࣳ": "ARABIC SMALL HIGH WAW",
-	"ࣸ": "ARABIC RIGHT ARROWHEAD ABOVE",
-	"ࣹ": "ARABIC LEFT ARROWHEAD BELOW",
-	"ࣺ": "ARABIC RIGHT ARROWHEAD BELOW",
-	"ࣿ": "ARABIC MARK SIDEWAYS NOON GHUNNA",
-	"ऀ": "DEVANAGARI SIGN INVERTED CANDRABINDU",
-	"ँ": "DEVANAGARI SIGN CANDRABINDU",
-	"ं": "DEVANAGARI SIGN ANUSVARA",
-	"ः": "DEVANAGARI SIGN VISARGA",
-	"ऄ": "DEVANAGARI LETTER SHORT A",
-	"अा": "DEVANAGARI LETTER A, DEVANAGARI VOWEL SIGN AA",
-	"अाॆ": "DEVANAGARI LETTER A, DEVANAGARI VOWEL SIGN AA, DEVANAGARI VOWEL SIGN SHORT E",
-	"अाे": "DEVANAGARI LETTER A, DEVANAGARI VOWEL SIGN AA, DEVANAGARI VOWEL SIGN E",
-	"अाै": "DEVANAGARI LETTER A, DEVANAGARI VOWEL SIGN AA, DEVANAGARI VOWEL SIGN AI",
-	"अॆ": "DEVANAGARI LETTER A, DEVANAGARI VOWEL SIGN SHORT E",
-	"अॉ": "DEVANAGARI LETTER A, DEVANAGARI VOWEL SIGN CANDRA O",
-	"आ": "DEVANAGARI LETTER AA",
-	"ई": "DEVANAGARI LETTER II",
-	"ऍ": "DEVANAGARI LETTER CANDRA E",
-	"ऎ": "DEVANAGARI LETTER SHORT E",
-	"एॅ": "DEVANAGARI LETTER E, DEVANAGARI VOWEL SIGN CANDRA E",
-	"एॆ": "DEVANAGARI LETTER E, DEVANAGARI VOWEL SIGN SHORT E",
-	"एे": "DEVANAGARI LETTER E, DEVANAGARI VOWEL SIGN E",
-	"ऐ": "DEVANAGARI LETTER AI",
-	"ऑ": "DEVANAGARI LETTER CANDRA O",
-	"ऒ": "DEVANAGARI LETTER SHORT O",
-	"ओ": "DEVANAGARI LETTER O",
-	"औ": "DEVANAGARI LETTER AU",
-	"र्इ": "DEVANAGARI LETTER RA, DEVANAGARI SIGN VIRAMA, DEVANAGARI LETTER I",
-	"ऺ": "DEVANAGARI VOWEL SIGN OE",
-	"़": "DEVANAGARI SIGN NUKTA",
-	"ऽ": "DEVANAGARI SIGN AVAGRAHA",
-	"ु": "DEVANAGARI VOWEL SIGN U",
-	"ू": "DEVANAGARI VOWEL SIGN UU",
-	"ॆ": "DEVANAGARI VOWEL SIGN SHORT E",
-	"्": "DEVANAGARI SIGN VIRAMA",
-	"॒": "DEVANAGARI STRESS SIGN ANUDATTA",
-	"॓": "DEVANAGARI GRAVE ACCENT",
-	"॔": "DEVANAGARI ACUTE ACCENT",
-	"।": "DEVANAGARI DANDA",
-	"।।": "DEVANAGARI DANDA, DEVANAGARI DANDA",
-	"॥": "DEVANAGARI DOUBLE DANDA",
-	"०": "DEVANAGARI DIGIT ZERO",
-	"१": "DEVANAGARI DIGIT ONE",
-	"२": "DEVANAGARI DIGIT TWO",
-	"३": "DEVANAGARI DIGIT THREE",
-	"४": "DEVANAGARI DIGIT FOUR",
-	"८": "DEVANAGARI DIGIT EIGHT",
-	"॰": "DEVANAGARI ABBREVIATION SIGN",
-	"ॱ": "DEVANAGARI SIGN HIGH SPACING DOT",
-	"ॽ": "DEVANAGARI LETTER GLOTTAL STOP",
-	"ঁ": "BENGALI SIGN CANDRABINDU",
-	"ঃ": "BENGALI SIGN VISARGA",
-	"অা": "BENGALI LETTER A, BENGALI VOWEL SIGN AA",
-	"আ": "BENGALI LETTER AA",
-	"ঋৃ": "BENGALI LETTER VOCALIC R, BENGALI VOWEL SIGN VOCALIC R",
-	"ঘ": "BENGALI LETTER GHA",
-	"চ": "BENGALI LETTER CA",
-	"জ": "BENGALI LETTER JA",
-	"ঞ": "BENGALI LETTER NYA",
-	"ট": "BENGALI LETTER TTA",
-	"ড": "BENGALI LETTER DDA",
-	"ণ": "BENGALI LETTER NNA",
-	"ত": "BENGALI LETTER TA",
-	"থ": "BENGALI LETTER THA",
-	"দ": "BENGALI LETTER DA",
-	"ধ": "BENGALI LETTER DHA",
-	"ন": "BENGALI LETTER NA",
-	"প": "BENGALI LETTER PA",
-	"ব": "BENGALI LETTER BA",
-	"ম": "BENGALI LETTER MA",
-	"য": "BENGALI LETTER YA",
-	"র": "BENGALI LETTER RA",
-	"ল": "BENGALI LETTER LA",
-	"ষ": "BENGALI LETTER SSA",
-	"স": "BENGALI LETTER SA",
-	"়": "BENGALI SIGN NUKTA",
-	"ঽ": "BENGALI SIGN AVAGRAHA",
-	"া": "BENGALI VOWEL SIGN AA",
-	"ি": "BENGALI VOWEL SIGN I",
-	"ে": "BENGALI VOWEL SIGN E",
-	"ো": "BENGALI VOWEL SIGN O",
-	"ৌ": "BENGALI VOWEL SIGN AU",
-	"্": "BENGALI SIGN VIRAMA",
-	"ৗ": "BENGALI AU LENGTH MARK",
-	"ৠ": "BENGALI LETTER VOCALIC RR",
-	"ৡ": "BENGALI LETTER VOCALIC LL",
-	"০": "BENGALI DIGIT ZERO",
-	"১": "BENGALI DIGIT ONE",
-	"২": "BENGALI DIGIT TWO",
-	"৪": "BENGALI DIGIT FOUR",
-	"৬": "BENGALI DIGIT SIX",
-	"৭": "BENGALI DIGIT SEVEN",
-	"ਂ": "GURMUKHI SIGN BINDI",
-	"ਃ": "GURMUKHI SIGN VISARGA",
-	"ਅਾ": "GURMUKHI LETTER A, GURMUKHI VOWEL SIGN AA",
-	"ਅੈ": "GURMUKHI LETTER A, GURMUKHI VOWEL SIGN AI",
-	"ਅੌ": "GURMUKHI LETTER A, GURMUKHI VOWEL SIGN AU",
-	"ਆ": "GURMUKHI LETTER AA",
-	"ਇ": "GURMUKHI LETTER I",
-	"ਈ": "GURMUKHI LETTER II",
-	"ਉ": "GURMUKHI LETTER U",
-	"ਊ": "GURMUKHI LETTER UU",
-	"ਏ": "GURMUKHI LETTER EE",
-	"ਐ": "GURMUKHI LETTER AI",
-	"ਔ": "GURMUKHI LETTER AU",
-	"਼": "GURMUKHI SIGN NUKTA",
-	"ੋ": "GURMUKHI VOWEL SIGN OO",
-	"੍": "GURMUKHI SIGN VIRAMA",
-	"੦": "GURMUKHI DIGIT ZERO",
-	"੧": "GURMUKHI DIGIT ONE",
-	"੪": "GURMUKHI DIGIT FOUR",
-	"ੲਿ": "GURMUKHI IRI, GURMUKHI VOWEL SIGN I",
-	"ੲੀ": "GURMUKHI IRI, GURMUKHI VOWEL SIGN II",
-	"ੲੇ": "GURMUKHI IRI, GURMUKHI VOWEL SIGN EE",
-	"ੳੁ": "GURMUKHI URA, GURMUKHI VOWEL SIGN U",
-	"ੳੂ": "GURMUKHI URA, GURMUKHI VOWEL SIGN UU",
-	"ઁ": "GUJARATI SIGN CANDRABINDU",
-	"ં": "GUJARATI SIGN ANUSVARA",
-	"ઃ": "GUJARATI SIGN VISARGA",
-	"અા": "GUJARATI LETTER A, GUJARATI VOWEL SIGN AA",
-	"અાૅ": "GUJARATI LETTER A, GUJARATI VOWEL SIGN AA, GUJARATI VOWEL SIGN CANDRA E",
-	"અાે": "GUJARATI LETTER A, GUJARATI VOWEL SIGN AA, GUJARATI VOWEL SIGN E",
-	"અાૈ": "GUJARATI LETTER A, GUJARATI VOWEL SIGN AA, GUJARATI VOWEL SIGN AI",
-	"અૅ": "GUJARATI LETTER A, GUJARATI VOWEL SIGN CANDRA E",
-	"અે": "GUJARATI LETTER A, GUJARATI VOWEL SIGN E",
-	"અૈ": "GUJARATI LETTER A, GUJARATI VOWEL SIGN AI",
-	"આ": "GUJARATI LETTER AA",
-	"ઍ": "GUJARATI VOWEL CANDRA E",
-	"એ": "GUJARATI LETTER E",
-	"ઐ": "GUJARATI LETTER AI",
-	"ઑ": "GUJARATI VOWEL CANDRA O",
-	"ઓ": "GUJARATI LETTER O",
-	"ઔ": "GUJARATI LETTER AU",
-	"઼": "GUJARATI SIGN NUKTA",
-	"ઽ": "GUJARATI SIGN AVAGRAHA",
-	"ુ": "GUJARATI VOWEL SIGN U",
-	"ૂ": "GUJARATI VOWEL SIGN UU",
-	"્": "GUJARATI SIGN VIRAMA",
-	"૦": "GUJARATI DIGIT ZERO",
-	"૨": "GUJARATI DIGIT TWO",
-	"૩": "GUJARATI DIGIT THREE",
-	"૪": "GUJARATI DIGIT FOUR",
-	"૮": "GUJARATI DIGIT EIGHT",
-	"૰": "GUJARATI ABBREVIATION SIGN",
-	"ଁ": "ORIYA SIGN CANDRABINDU",
-	"ଃ": "ORIYA SIGN VISARGA",
-	"ଅା": "ORIYA LETTER A, ORIYA VOWEL SIGN AA",
-	"ଆ": "ORIYA LETTER AA",
-	"ଠ": "ORIYA LETTER TTHA",
-	"଼": "ORIYA SIGN NUKTA",
-	"୦": "ORIYA DIGIT ZERO",
-	"୨": "ORIYA DIGIT TWO",
-	"ஂ": "TAMIL SIGN ANUSVARA",
-	"அ": "TAMIL LETTER A",
-	"ஈ": "TAMIL LETTER II",
-	"ஈு": "TAMIL LETTER II, TAMIL VOWEL SIGN U",
-	"உ": "TAMIL LETTER U",
-	"உள": "TAMIL LETTER U, TAMIL LETTER LLA",
-	"உൗ": "TAMIL LETTER U, MALAYALAM AU LENGTH MARK",
-	"ஊ": "TAMIL LETTER UU",
-	"எ": "TAMIL LETTER E",
-	"எவ": "TAMIL LETTER E, TAMIL LETTER VA",
-	"ஐ": "TAMIL LETTER AI",
-	"க": "TAMIL LETTER KA",
-	"ச": "TAMIL LETTER CA",
-	"சு": "TAMIL LETTER CA, TAMIL VOWEL SIGN U",
-	"சூ": "TAMIL LETTER CA, TAMIL VOWEL SIGN UU",
-	"ஜ": "TAMIL LETTER JA",
-	"டி": "TAMIL LETTER TTA, TAMIL VOWEL SIGN I",
-	"ண": "TAMIL LETTER NNA",
-	"நீ": "TAMIL LETTER NA, TAMIL VOWEL SIGN II",
-	"ன": "TAMIL LETTER NNNA",
-	"மீ": "TAMIL LETTER MA, TAMIL VOWEL SIGN II",
-	"ய": "TAMIL LETTER YA",
-	"ர": "TAMIL LETTER RA",
-	"ள": "TAMIL LETTER LLA",
-	"ழ": "TAMIL LETTER LLLA",
-	"ஶ": "TAMIL LETTER SHA",
-	"ஷ": "TAMIL LETTER SSA",
-	"ா": "TAMIL VOWEL SIGN AA",
-	"ி": "TAMIL VOWEL SIGN I",
-	"ெஈ": "TAMIL VOWEL SIGN E, TAMIL LETTER II",
-	"ெள": "TAMIL VOWEL SIGN E, TAMIL LETTER LLA",
-	"ேஈ": "TAMIL VOWEL SIGN EE, TAMIL LETTER II",
-	"ை": "TAMIL VOWEL SIGN AI",
-	"ொ": "TAMIL VOWEL SIGN O",
-	"ோ": "TAMIL VOWEL SIGN OO",
-	"ௌ": "TAMIL VOWEL SIGN AU",
-	"்": "TAMIL SIGN VIRAMA",
-	"ௗ": "TAMIL AU LENGTH MARK",
-	"௦": "TAMIL DIGIT ZERO",
-	"௧": "TAMIL DIGIT ONE",
-	"௨": "TAMIL DIGIT TWO",
-	"௪": "TAMIL DIGIT FOUR",
-	"௫": "TAMIL DIGIT FIVE",
-	"௬": "TAMIL DIGIT SIX",
-	"௭": "TAMIL DIGIT SEVEN",
-	"௮": "TAMIL DIGIT EIGHT",
-	"௰": "TAMIL NUMBER TEN",
-	"௲": "TAMIL NUMBER ONE THOUSAND",
-	"௳": "TAMIL DAY SIGN",
-	"௴": "TAMIL MONTH SIGN",
-	"௵": "TAMIL YEAR SIGN",
-	"௷": "TAMIL CREDIT SIGN",
-	"௸": "TAMIL AS ABOVE SIGN",
-	"௺": "TAMIL NUMBER SIGN",
-	"ఀ": "TELUGU SIGN COMBINING CANDRABINDU ABOVE",
-	"ం": "TELUGU SIGN ANUSVARA",
-	"ః": "TELUGU SIGN VISARGA",
-	"అ": "TELUGU LETTER A",
-	"ఆ": "TELUGU LETTER AA",
-	"ఇ": "TELUGU LETTER I",
-	"ఋా": "TELUGU LETTER VOCALIC R, TELUGU VOWEL SIGN AA",
-	"ఌా": "TELUGU LETTER VOCALIC L, TELUGU VOWEL SIGN AA",
-	"ఒ": "TELUGU LETTER O",
-	"ఒౌ": "TELUGU LETTER O, TELUGU VOWEL SIGN AU",
-	"ఒౕ": "TELUGU LETTER O, TELUGU LENGTH MARK",
-	"ఓ": "TELUGU LETTER OO",
-	"ఔ": "TELUGU LETTER AU",
-	"జ": "TELUGU LETTER JA",
-	"ఞ": "TELUGU LETTER NYA",
-	"ఠ": "TELUGU LETTER TTHA",
-	"డ̣": "TELUGU LETTER DDA, COMBINING DOT BELOW",
-	"ఢ": "TELUGU LETTER DDHA",
-	"ణ": "TELUGU LETTER NNA",
-	"థ": "TELUGU LETTER THA",
-	"ధּ": "TELUGU LETTER DHA, HEBREW POINT DAGESH OR MAPIQ",
-	"బ̣": "TELUGU LETTER BA, COMBINING DOT BELOW",
-	"భ": "TELUGU LETTER BHA",
-	"మ": "TELUGU LETTER MA",
-	"య": "TELUGU LETTER YA",
-	"రּ": "TELUGU LETTER RA, HEBREW POINT DAGESH OR MAPIQ",
-	"ఱ": "TELUGU LETTER RRA",
-	"ల": "TELUGU LETTER LA",
-	"వ̣": "TELUGU LETTER VA, COMBINING DOT BELOW",
-	"వా": "TELUGU LETTER VA, TELUGU VOWEL SIGN AA",
-	"వు": "TELUGU LETTER VA, TELUGU VOWEL SIGN U",
-	"ష": "TELUGU LETTER SSA",
-	"హ": "TELUGU LETTER HA",
-	"ుా": "TELUGU VOWEL SIGN U, TELUGU VOWEL SIGN AA",
-	"ూ": "TELUGU VOWEL SIGN UU",
-	"ృా": "TELUGU VOWEL SIGN VOCALIC R, TELUGU VOWEL SIGN AA",
-	"ౄ": "TELUGU VOWEL SIGN VOCALIC RR",
-	"ౠ": "TELUGU LETTER VOCALIC RR",
-	"ౡ": "TELUGU LETTER VOCALIC LL",
-	"౦": "TELUGU DIGIT ZERO",
-	"౧": "TELUGU DIGIT ONE",
-	"౨": "TELUGU DIGIT TWO",
-	"౯": "TELUGU DIGIT NINE",
-	"ಁ": "KANNADA SIGN CANDRABINDU",
-	"ಂ": "KANNADA SIGN ANUSVARA",
-	"ಃ": "KANNADA SIGN VISARGA",
-	"ಅ": "KANNADA LETTER A",
-	"ಆ": "KANNADA LETTER AA",
-	"ಇ": "KANNADA LETTER I",
-	"ಌಾ": "KANNADA LETTER VOCALIC L, KANNADA VOWEL SIGN AA",
-	"ಒ": "KANNADA LETTER O",
-	"ಓ": "KANNADA LETTER OO",
-	"ಔ": "KANNADA LETTER AU",
-	"ಜ": "KANNADA LETTER JA",
-	"ಞ": "KANNADA LETTER NYA",
-	"ಣ": "KANNADA LETTER NNA",
-	"ಯ": "KANNADA LETTER YA",
-	"ಱ": "KANNADA LETTER RRA",
-	"ಲ": "KANNADA LETTER LA",
-	"ೡ": "KANNADA LETTER VOCALIC LL",
-	"೦": "KANNADA DIGIT ZERO",
-	"೧": "KANNADA DIGIT ONE",
-	"೨": "KANNADA DIGIT TWO",
-	"೯": "KANNADA DIGIT NINE",
-	"ഁ": "MALAYALAM SIGN CANDRABINDU",
-	"ം": "MALAYALAM SIGN ANUSVARA",
-	"ഃ": "MALAYALAM SIGN VISARGA",
-	"ഇൗ": "MALAYALAM LETTER I, MALAYALAM AU LENGTH MARK",
-	"ഈ": "MALAYALAM LETTER II",
-	"ഉ": "MALAYALAM LETTER U",
-	"ഊ": "MALAYALAM LETTER UU",
-	"ഌ": "MALAYALAM LETTER VOCALIC L",
-	"എെ": "MALAYALAM LETTER E, MALAYALAM VOWEL SIGN E",
-	"ഐ": "MALAYALAM LETTER AI",
-	"ഒാ": "MALAYALAM LETTER O, MALAYALAM VOWEL SIGN AA",
-	"ഒൗ": "MALAYALAM LETTER O, MALAYALAM AU LENGTH MARK",
-	"ഓ": "MALAYALAM LETTER OO",
-	"ഔ": "MALAYALAM LETTER AU",
-	"ങ": "MALAYALAM LETTER NGA",
-	"ജ": "MALAYALAM LETTER JA",
-	"ഞ": "MALAYALAM LETTER NYA",
-	"ഠ": "MALAYALAM LETTER TTHA",
-	"ണ": "MALAYALAM LETTER NNA",
-	"ദ്ര": "MALAYALAM LETTER DA, MALAYALAM SIGN VIRAMA, MALAYALAM LETTER RA",
-	"നു": "MALAYALAM LETTER NA, MALAYALAM VOWEL SIGN U",
-	"ന്": "MALAYALAM LETTER NA, MALAYALAM SIGN VIRAMA",
-	"ന്ന": "MALAYALAM LETTER NA, MALAYALAM SIGN VIRAMA, MALAYALAM LETTER NA",
-	"ന്മ": "MALAYALAM LETTER NA, MALAYALAM SIGN VIRAMA, MALAYALAM LETTER MA",
-	"ര": "MALAYALAM LETTER RA",
-	"ര്": "MALAYALAM LETTER RA, MALAYALAM SIGN VIRAMA",
-	"റ": "MALAYALAM LETTER RRA",
-	"ഴ": "MALAYALAM LETTER LLLA",
-	"വ്ര": "MALAYALAM LETTER VA, MALAYALAM SIGN VIRAMA, MALAYALAM LETTER RA",
-	"ശ": "MALAYALAM LETTER SHA",
-	"ഹ്മ": "MALAYALAM LETTER HA, MALAYALAM SIGN VIRAMA, MALAYALAM LETTER MA",
-	"ഺ": "MALAYALAM LETTER TTTA",
-	"ി": "MALAYALAM VOWEL SIGN I",
-	"ീ": "MALAYALAM VOWEL SIGN II",
-	"ു": "MALAYALAM VOWEL SIGN U",
-	"ൂ": "MALAYALAM VOWEL SIGN UU",
-	"ൃ": "MALAYALAM VOWEL SIGN VOCALIC R",
-	"െെ": "MALAYALAM VOWEL SIGN E, MALAYALAM VOWEL SIGN E",
-	"ൈ": "MALAYALAM VOWEL SIGN AI",
-	"ൎ": "MALAYALAM LETTER DOT REPH",
-	"൚": "MALAYALAM FRACTION THREE EIGHTIETHS",
-	"ൟ": "MALAYALAM LETTER ARCHAIC II",
-	"ൡ": "MALAYALAM LETTER VOCALIC LL",
-	"൦": "MALAYALAM DIGIT ZERO",
-	"൪": "MALAYALAM DIGIT FOUR",
-	"൫": "MALAYALAM DIGIT FIVE",
-	"൬": "MALAYALAM DIGIT SIX",
-	"൭": "MALAYALAM DIGIT SEVEN",
-	"൮": "MALAYALAM DIGIT EIGHT",
-	"൯": "MALAYALAM DIGIT NINE",
-	"൶": "MALAYALAM FRACTION ONE SIXTEENTH",
-	"൹": "MALAYALAM DATE MARK",
-	"ൻ": "MALAYALAM LETTER CHILLU N",
-	"ർ": "MALAYALAM LETTER CHILLU RR",
-	"ං": "SINHALA SIGN ANUSVARAYA",
-	"ඃ": "SINHALA SIGN VISARGAYA",
-	"ජ": "SINHALA LETTER ALPAPRAANA JAYANNA",
-	"ද": "SINHALA LETTER ALPAPRAANA DAYANNA",
-	"෨ා": "SINHALA LITH DIGIT TWO, SINHALA VOWEL SIGN AELA-PILLA",
-	"෨ී": "SINHALA LITH DIGIT TWO, SINHALA VOWEL SIGN DIGA IS-PILLA",
-	"෩": "SINHALA LITH DIGIT THREE",
-	"෪": "SINHALA LITH DIGIT FOUR",
-	"෫": "SINHALA LITH DIGIT FIVE",
-	"෯": "SINHALA LITH DIGIT NINE",
-	"ข": "THAI CHARACTER KHO KHAI",
-	"ฃ": "THAI CHARACTER KHO KHUAT",
-	"ค": "THAI CHARACTER KHO KHWAI",
-	"ฆ": "THAI CHARACTER KHO RAKHANG",
-	"จ": "THAI CHARACTER CHO CHAN",
-	"ช": "THAI CHARACTER CHO CHANG",
-	"ซ": "THAI CHARACTER SO SO",
-	"ฎ": "THAI CHARACTER DO CHADA",
-	"ฏ": "THAI CHARACTER TO PATAK",
-	"ฑ": "THAI CHARACTER THO NANGMONTHO",
-	"ด": "THAI CHARACTER DO DEK",
-	"ต": "THAI CHARACTER TO TAO",
-	"ท": "THAI CHARACTER THO THAHAN",
-	"บ": "THAI CHARACTER BO BAIMAI",
-	"ป": "THAI CHARACTER PO PLA",
-	"ฝ": "THAI CHARACTER FO FA",
-	"พ": "THAI CHARACTER PHO PHAN",
-	"ฟ": "THAI CHARACTER FO FAN",
-	"ภ": "THAI CHARACTER PHO SAMPHAO",
-	"ม": "THAI CHARACTER MO MA",
-	"ย": "THAI CHARACTER YO YAK",
-	"ฦ": "THAI CHARACTER LU",
-	"ฯ": "THAI CHARACTER PAIYANNOI",
-	"า": "THAI CHARACTER SARA AA",
-	"ำ": "THAI CHARACTER SARA AM",
-	"ิ": "THAI CHARACTER SARA I",
-	"ี": "THAI CHARACTER SARA II",
-	"ึ": "THAI CHARACTER SARA UE",
-	"ื": "THAI CHARACTER SARA UEE",
-	"ุ": "THAI CHARACTER SARA U",
-	"ู": "THAI CHARACTER SARA UU",
-	"เเ": "THAI CHARACTER SARA E, THAI CHARACTER SARA E",
-	"แ": "THAI CHARACTER SARA AE",
-	"ๅ": "THAI CHARACTER LAKKHANGYAO",
-	"่": "THAI CHARACTER MAI EK",
-	"้": "THAI CHARACTER MAI THO",
-	"๊": "THAI CHARACTER MAI TRI",
-	"๋": "THAI CHARACTER MAI CHATTAWA",
-	"ํ": "THAI CHARACTER NIKHAHIT",
-	"๏": "THAI CHARACTER FONGMAN",
-	"๐": "THAI DIGIT ZERO",
-	"๚": "THAI CHARACTER ANGKHANKHU",
-	"๛": "THAI CHARACTER KHOMUT",
-	"ຈ": "LAO LETTER CO",
-	"ຍ": "LAO LETTER NYO",
-	"ບ": "LAO LETTER BO",
-	"ປ": "LAO LETTER PO",
-	"ຝ": "LAO LETTER FO TAM",
-	"ພ": "LAO LETTER PHO TAM",
-	"ຟ": "LAO LETTER FO SUNG",
-	"ຫນ": "LAO LETTER HO SUNG, LAO LETTER NO",
-	"ຫມ": "LAO LETTER HO SUNG, LAO LETTER MO",
-	"ຳ": "LAO VOWEL SIGN AM",
-	"ຸ": "LAO VOWEL SIGN U",
-	"ູ": "LAO VOWEL SIGN UU",
-	"່": "LAO TONE MAI EK",
-	"້": "LAO TONE MAI THO",
-	"໊": "LAO TONE MAI TI",
-	"໋": "LAO TONE MAI CATAWA",
-	"ໍ": "LAO NIGGAHITA",
-	"໐": "LAO DIGIT ZERO",
-	"ໜ": "LAO HO NO",
-	"ໝ": "LAO HO MO",
-	"ༀ": "TIBETAN SYLLABLE OM",
-	"༂": "TIBETAN MARK GTER YIG MGO -UM RNAM BCAD MA",
-	"༃": "TIBETAN MARK GTER YIG MGO -UM GTER TSHEG MA",
-	"་": "TIBETAN MARK INTERSYLLABIC TSHEG",
-	"༌": "TIBETAN MARK DELIMITER TSHEG BSTAR",
-	"།།": "TIBETAN MARK SHAD, TIBETAN MARK SHAD",
-	"༎": "TIBETAN MARK NYIS SHAD",
-	"༚༚": "TIBETAN SIGN RDEL DKAR GCIG, TIBETAN SIGN RDEL DKAR GCIG",
-	"༚༝": "TIBETAN SIGN RDEL DKAR GCIG, TIBETAN SIGN RDEL NAG GCIG",
-	"༛": "TIBETAN SIGN RDEL DKAR GNYIS",
-	"༝༚": "TIBETAN SIGN RDEL NAG GCIG, TIBETAN SIGN RDEL DKAR GCIG",
-	"༝༝": "TIBETAN SIGN RDEL NAG GCIG, TIBETAN SIGN RDEL NAG GCIG",
-	"༞": "TIBETAN SIGN RDEL NAG GNYIS",
-	"༟": "TIBETAN SIGN RDEL DKAR RDEL NAG",
-	"༷": "TIBETAN MARK NGAS BZUNG SGOR RTAGS",
-	"འུྂ༔": "TIBETAN LETTER -A, TIBETAN VOWEL SIGN U, TIBETAN SIGN NYI ZLA NAA DA, TIBETAN MARK GTER TSHEG",
-	"འུྂཿ": "TIBETAN LETTER -A, TIBETAN VOWEL SIGN U, TIBETAN SIGN NYI ZLA NAA DA, TIBETAN SIGN RNAM BCAD",
-	"ར": "TIBETAN LETTER RA",
-	"ཨོཾ": "TIBETAN LETTER A, TIBETAN VOWEL SIGN O, TIBETAN SIGN RJES SU NGA RO",
-	"ཪ": "TIBETAN LETTER FIXED-FORM RA",
-	"ཷ": "TIBETAN VOWEL SIGN VOCALIC RR",
-	"ཹ": "TIBETAN VOWEL SIGN VOCALIC LL",
-	"ྲཱྀ": "TIBETAN SUBJOINED LETTER RA, TIBETAN VOWEL SIGN AA, TIBETAN VOWEL SIGN REVERSED I",
-	"ླཱྀ": "TIBETAN SUBJOINED LETTER LA, TIBETAN VOWEL SIGN AA, TIBETAN VOWEL SIGN REVERSED I",
-	"࿎": "TIBETAN SIGN RDEL NAG RDEL DKAR",
-	"࿕": "RIGHT-FACING SVASTI SIGN",
-	"࿖": "LEFT-FACING SVASTI SIGN",
-	"က": "MYANMAR LETTER KA",
-	"ဂာ": "MYANMAR LETTER GA, MYANMAR VOWEL SIGN AA",
-	"ဂှ": "MYANMAR LETTER GA, MYANMAR CONSONANT SIGN MEDIAL HA",
-	"ဃှ": "MYANMAR LETTER GHA, MYANMAR CONSONANT SIGN MEDIAL HA",
-	"တ": "MYANMAR LETTER TA",
-	"ပာ": "MYANMAR LETTER PA, MYANMAR VOWEL SIGN AA",
-	"ပာှ": "MYANMAR LETTER PA, MYANMAR VOWEL SIGN AA, MYANMAR CONSONANT SIGN MEDIAL HA",
-	"ပှ": "MYANMAR LETTER PA, MYANMAR CONSONANT SIGN MEDIAL HA",
-	"ဝ": "MYANMAR LETTER WA",
-	"သြ": "MYANMAR LETTER SA, MYANMAR CONSONANT SIGN MEDIAL RA",
-	"သြော်": "MYANMAR LETTER SA, MYANMAR CONSONANT SIGN MEDIAL RA, MYANMAR VOWEL SIGN E, MYANMAR VOWEL SIGN AA, MYANMAR SIGN ASAT",
-	"ဟ": "MYANMAR LETTER HA",
-	"ဩ": "MYANMAR LETTER O",
-	"ဪ": "MYANMAR LETTER AU",
-	"ံ": "MYANMAR SIGN ANUSVARA",
-	"း": "MYANMAR SIGN VISARGA",
-	"၀": "MYANMAR DIGIT ZERO",
-	"၁": "MYANMAR DIGIT ONE",
-	"၊၊": "MYANMAR SIGN LITTLE SECTION, MYANMAR SIGN LITTLE SECTION",
-	"။": "MYANMAR SIGN SECTION",
-	"ၥ": "MYANMAR LETTER WESTERN PWO KAREN THA",
-	"ၦ": "MYANMAR LETTER WESTERN PWO KAREN PWA",
-	"ၯ": "MYANMAR LETTER EASTERN PWO KAREN YWA",
-	"ၰ": "MYANMAR LETTER EASTERN PWO KAREN GHWA",
-	"ၽှ": "MYANMAR LETTER SHAN PHA, MYANMAR CONSONANT SIGN MEDIAL HA",
-	"ၾ": "MYANMAR LETTER SHAN FA",
-	"ႁ": "MYANMAR LETTER SHAN HA",
-	"ႃ̊": "MYANMAR VOWEL SIGN SHAN AA, COMBINING RING ABOVE",
-	"႞": "MYANMAR SYMBOL SHAN ONE",
-	"Ⴀ": "GEORGIAN CAPITAL LETTER AN",
-	"ყ": "GEORGIAN LETTER QAR",
-	"ჳ": "GEORGIAN LETTER WE",
-	"ჿ": "GEORGIAN LETTER LABIAL SIGN",
-	"ᄀ": "HANGUL CHOSEONG KIYEOK",
-	"ᄀᄀ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG KIYEOK",
-	"ᄀᄂ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG NIEUN",
-	"ᄀᄃ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG TIKEUT",
-	"ᄀᄅ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG RIEUL",
-	"ᄀᄇ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG PIEUP",
-	"ᄀᄉ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG SIOS",
-	"ᄀᄉᄀ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG KIYEOK",
-	"ᄀᄎ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG CHIEUCH",
-	"ᄀᄏ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG KHIEUKH",
-	"ᄀᄒ": "HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG HIEUH",
-	"ᄁ": "HANGUL CHOSEONG SSANGKIYEOK",
-	"ᄂ": "HANGUL CHOSEONG NIEUN",
-	"ᄂᄀ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG KIYEOK",
-	"ᄂᄂ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG NIEUN",
-	"ᄂᄃ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG TIKEUT",
-	"ᄂᄅ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG RIEUL",
-	"ᄂᄇ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG PIEUP",
-	"ᄂᄉ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG SIOS",
-	"ᄂᄌ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG CIEUC",
-	"ᄂᄎ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG CHIEUCH",
-	"ᄂᄐ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG THIEUTH",
-	"ᄂᄒ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG HIEUH",
-	"ᄂᅀ": "HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG PANSIOS",
-	"ᄃ": "HANGUL CHOSEONG TIKEUT",
-	"ᄃᄀ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG KIYEOK",
-	"ᄃᄃ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG TIKEUT",
-	"ᄃᄃᄇ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG PIEUP",
-	"ᄃᄅ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG RIEUL",
-	"ᄃᄆ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG MIEUM",
-	"ᄃᄇ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG PIEUP",
-	"ᄃᄉ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG SIOS",
-	"ᄃᄉᄀ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG KIYEOK",
-	"ᄃᄌ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG CIEUC",
-	"ᄃᄎ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG CHIEUCH",
-	"ᄃᄐ": "HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG THIEUTH",
-	"ᄄ": "HANGUL CHOSEONG SSANGTIKEUT",
-	"ᄅ": "HANGUL CHOSEONG RIEUL",
-	"ᄅᄀ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG KIYEOK",
-	"ᄅᄀᄀ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG KIYEOK",
-	"ᄅᄀᄉ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG SIOS",
-	"ᄅᄀᄒ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG HIEUH",
-	"ᄅᄂ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG NIEUN",
-	"ᄅᄃ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG TIKEUT",
-	"ᄅᄃᄃ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG TIKEUT",
-	"ᄅᄃᄒ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG TIKEUT, HANGUL CHOSEONG HIEUH",
-	"ᄅᄅ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG RIEUL",
-	"ᄅᄅᄏ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG KHIEUKH",
-	"ᄅᄆ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG MIEUM",
-	"ᄅᄆᄀ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG KIYEOK",
-	"ᄅᄆᄉ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG SIOS",
-	"ᄅᄆᄒ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG HIEUH",
-	"ᄅᄇ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PIEUP",
-	"ᄅᄇᄃ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG TIKEUT",
-	"ᄅᄇᄇ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG PIEUP",
-	"ᄅᄇᄉ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG SIOS",
-	"ᄅᄇᄋ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG IEUNG",
-	"ᄅᄇᄑ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG PHIEUPH",
-	"ᄅᄇᄒ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG HIEUH",
-	"ᄅᄉ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG SIOS",
-	"ᄅᄉᄉ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG SIOS",
-	"ᄅᄋ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG IEUNG",
-	"ᄅᄌ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG CIEUC",
-	"ᄅᄏ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG KHIEUKH",
-	"ᄅᄐ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG THIEUTH",
-	"ᄅᄑ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PHIEUPH",
-	"ᄅᄒ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG HIEUH",
-	"ᄅᅀ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PANSIOS",
-	"ᄅᅌ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG YESIEUNG",
-	"ᄅᅙ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG YEORINHIEUH",
-	"ᄅᅙᄒ": "HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG YEORINHIEUH, HANGUL CHOSEONG HIEUH",
-	"ᄆ": "HANGUL CHOSEONG MIEUM",
-	"ᄆᄀ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG KIYEOK",
-	"ᄆᄂ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG NIEUN",
-	"ᄆᄂᄂ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG NIEUN, HANGUL CHOSEONG NIEUN",
-	"ᄆᄃ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG TIKEUT",
-	"ᄆᄅ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG RIEUL",
-	"ᄆᄆ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG MIEUM",
-	"ᄆᄇ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG PIEUP",
-	"ᄆᄇᄉ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG SIOS",
-	"ᄆᄉ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG SIOS",
-	"ᄆᄉᄉ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG SIOS",
-	"ᄆᄋ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG IEUNG",
-	"ᄆᄌ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG CIEUC",
-	"ᄆᄎ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG CHIEUCH",
-	"ᄆᄒ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG HIEUH",
-	"ᄆᅀ": "HANGUL CHOSEONG MIEUM, HANGUL CHOSEONG PANSIOS",
-	"ᄇ": "HANGUL CHOSEONG PIEUP",
-	"ᄇᄀ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG KIYEOK",
-	"ᄇᄂ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG NIEUN",
-	"ᄇᄃ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG TIKEUT",
-	"ᄇᄅ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG RIEUL",
-	"ᄇᄅᄑ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG RIEUL, HANGUL CHOSEONG PHIEUPH",
-	"ᄇᄆ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG MIEUM",
-	"ᄇᄇ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG PIEUP",
-	"ᄇᄇᄋ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG IEUNG",
-	"ᄇᄉ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG SIOS",
-	"ᄇᄉᄀ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG KIYEOK",
-	"ᄇᄉᄃ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG TIKEUT",
-	"ᄇᄉᄇ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG PIEUP",
-	"ᄇᄉᄉ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG SIOS",
-	"ᄇᄉᄌ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG CIEUC",
-	"ᄇᄉᄐ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG THIEUTH",
-	"ᄇᄋ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG IEUNG",
-	"ᄇᄌ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG CIEUC",
-	"ᄇᄎ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG CHIEUCH",
-	"ᄇᄏ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG KHIEUKH",
-	"ᄇᄐ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG THIEUTH",
-	"ᄇᄑ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG PHIEUPH",
-	"ᄇᄒ": "HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG HIEUH",
-	"ᄈ": "HANGUL CHOSEONG SSANGPIEUP",
-	"ᄉ": "HANGUL CHOSEONG SIOS",
-	"ᄉᄀ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG KIYEOK",
-	"ᄉᄂ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG NIEUN",
-	"ᄉᄃ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG TIKEUT",
-	"ᄉᄅ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG RIEUL",
-	"ᄉᄆ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG MIEUM",
-	"ᄉᄇ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG PIEUP",
-	"ᄉᄇᄀ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG KIYEOK",
-	"ᄉᄇᄋ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG IEUNG",
-	"ᄉᄉ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG SIOS",
-	"ᄉᄉᄀ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG KIYEOK",
-	"ᄉᄉᄃ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG TIKEUT",
-	"ᄉᄉᄇ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG PIEUP",
-	"ᄉᄉᄉ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG SIOS, HANGUL CHOSEONG SIOS",
-	"ᄉᄋ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG IEUNG",
-	"ᄉᄌ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG CIEUC",
-	"ᄉᄎ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG CHIEUCH",
-	"ᄉᄏ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG KHIEUKH",
-	"ᄉᄐ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG THIEUTH",
-	"ᄉᄑ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG PHIEUPH",
-	"ᄉᅀ": "HANGUL CHOSEONG SIOS, HANGUL CHOSEONG PANSIOS",
-	"ᄊ": "HANGUL CHOSEONG SSANGSIOS",
-	"ᄋ": "HANGUL CHOSEONG IEUNG",
-	"ᄋᄀ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG KIYEOK",
-	"ᄋᄀᄀ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG KIYEOK, HANGUL CHOSEONG KIYEOK",
-	"ᄋᄃ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG TIKEUT",
-	"ᄋᄅ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG RIEUL",
-	"ᄋᄆ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG MIEUM",
-	"ᄋᄇ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG PIEUP",
-	"ᄋᄉ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG SIOS",
-	"ᄋᄋ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG IEUNG",
-	"ᄋᄌ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG CIEUC",
-	"ᄋᄎ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG CHIEUCH",
-	"ᄋᄏ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG KHIEUKH",
-	"ᄋᄐ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG THIEUTH",
-	"ᄋᄑ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG PHIEUPH",
-	"ᄋᄒ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG HIEUH",
-	"ᄋᅀ": "HANGUL CHOSEONG IEUNG, HANGUL CHOSEONG PANSIOS",
-	"ᄌ": "HANGUL CHOSEONG CIEUC",
-	"ᄌᄇ": "HANGUL CHOSEONG CIEUC, HANGUL CHOSEONG PIEUP",
-	"ᄌᄇᄇ": "HANGUL CHOSEONG CIEUC, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG PIEUP",
-	"ᄌᄋ": "HANGUL CHOSEONG CIEUC, HANGUL CHOSEONG IEUNG",
-	"ᄌᄌ": "HANGUL CHOSEONG CIEUC, HANGUL CHOSEONG CIEUC",
-	"ᄌᄌᄒ": "HANGUL CHOSEONG CIEUC, HANGUL CHOSEONG CIEUC, HANGUL CHOSEONG HIEUH",
-	"ᄍ": "HANGUL CHOSEONG SSANGCIEUC",
-	"ᄎ": "HANGUL CHOSEONG CHIEUCH",
-	"ᄎᄏ": "HANGUL CHOSEONG CHIEUCH, HANGUL CHOSEONG KHIEUKH",
-	"ᄎᄒ": "HANGUL CHOSEONG CHIEUCH, HANGUL CHOSEONG HIEUH",
-	"ᄏ": "HANGUL CHOSEONG KHIEUKH",
-	"ᄐ": "HANGUL CHOSEONG THIEUTH",
-	"ᄐᄐ": "HANGUL CHOSEONG THIEUTH, HANGUL CHOSEONG THIEUTH",
-	"ᄑ": "HANGUL CHOSEONG PHIEUPH",
-	"ᄑᄇ": "HANGUL CHOSEONG PHIEUPH, HANGUL CHOSEONG PIEUP",
-	"ᄑᄉ": "HANGUL CHOSEONG PHIEUPH, HANGUL CHOSEONG SIOS",
-	"ᄑᄋ": "HANGUL CHOSEONG PHIEUPH, HANGUL CHOSEONG IEUNG",
-	"ᄑᄐ": "HANGUL CHOSEONG PHIEUPH, HANGUL CHOSEONG THIEUTH",
-	"ᄑᄒ": "HANGUL CHOSEONG PHIEUPH, HANGUL CHOSEONG HIEUH",
-	"ᄒ": "HANGUL CHOSEONG HIEUH",
-	"ᄒᄂ": "HANGUL CHOSEONG HIEUH, HANGUL CHOSEONG NIEUN",
-	"ᄒᄅ": "HANGUL CHOSEONG HIEUH, HANGUL CHOSEONG RIEUL",
-	"ᄒᄆ": "HANGUL CHOSEONG HIEUH, HANGUL CHOSEONG MIEUM",
-	"ᄒᄇ": "HANGUL CHOSEONG HIEUH, HANGUL CHOSEONG PIEUP",
-	"ᄒᄉ": "HANGUL CHOSEONG HIEUH, HANGUL CHOSEONG SIOS",
-	"ᄒᄒ": "HANGUL CHOSEONG HIEUH, HANGUL CHOSEONG HIEUH",
-	"ᄓ": "HANGUL CHOSEONG NIEUN-KIYEOK",
-	"ᄔ": "HANGUL CHOSEONG SSANGNIEUN",
-	"ᄕ": "HANGUL CHOSEONG NIEUN-TIKEUT",
-	"ᄖ": "HANGUL CHOSEONG NIEUN-PIEUP",
-	"ᄗ": "HANGUL CHOSEONG TIKEUT-KIYEOK",
-	"ᄘ": "HANGUL CHOSEONG RIEUL-NIEUN",
-	"ᄙ": "HANGUL CHOSEONG SSANGRIEUL",
-	"ᄚ": "HANGUL CHOSEONG RIEUL-HIEUH",
-	"ᄛ": "HANGUL CHOSEONG KAPYEOUNRIEUL",
-	"ᄜ": "HANGUL CHOSEONG MIEUM-PIEUP",
-	"ᄝ": "HANGUL CHOSEONG KAPYEOUNMIEUM",
-	"ᄞ": "HANGUL CHOSEONG PIEUP-KIYEOK",
-	"ᄟ": "HANGUL CHOSEONG PIEUP-NIEUN",
-	"ᄠ": "HANGUL CHOSEONG PIEUP-TIKEUT",
-	"ᄡ": "HANGUL CHOSEONG PIEUP-SIOS",
-	"ᄢ": "HANGUL CHOSEONG PIEUP-SIOS-KIYEOK",
-	"ᄣ": "HANGUL CHOSEONG PIEUP-SIOS-TIKEUT",
-	"ᄤ": "HANGUL CHOSEONG PIEUP-SIOS-PIEUP",
-	"ᄥ": "HANGUL CHOSEONG PIEUP-SSANGSIOS",
-	"ᄦ": "HANGUL CHOSEONG PIEUP-SIOS-CIEUC",
-	"ᄧ": "HANGUL CHOSEONG PIEUP-CIEUC",
-	"ᄨ": "HANGUL CHOSEONG PIEUP-CHIEUCH",
-	"ᄩ": "HANGUL CHOSEONG PIEUP-THIEUTH",
-	"ᄪ": "HANGUL CHOSEONG PIEUP-PHIEUPH",
-	"ᄫ": "HANGUL CHOSEONG KAPYEOUNPIEUP",
-	"ᄬ": "HANGUL CHOSEONG KAPYEOUNSSANGPIEUP",
-	"ᄭ": "HANGUL CHOSEONG SIOS-KIYEOK",
-	"ᄮ": "HANGUL CHOSEONG SIOS-NIEUN",
-	"ᄯ": "HANGUL CHOSEONG SIOS-TIKEUT",
-	"ᄰ": "HANGUL CHOSEONG SIOS-RIEUL",
-	"ᄱ": "HANGUL CHOSEONG SIOS-MIEUM",
-	"ᄲ": "HANGUL CHOSEONG SIOS-PIEUP",
-	"ᄳ": "HANGUL CHOSEONG SIOS-PIEUP-KIYEOK",
-	"ᄴ": "HANGUL CHOSEONG SIOS-SSANGSIOS",
-	"ᄵ": "HANGUL CHOSEONG SIOS-IEUNG",
-	"ᄶ": "HANGUL CHOSEONG SIOS-CIEUC",
-	"ᄷ": "HANGUL CHOSEONG SIOS-CHIEUCH",
-	"ᄸ": "HANGUL CHOSEONG SIOS-KHIEUKH",
-	"ᄹ": "HANGUL CHOSEONG SIOS-THIEUTH",
-	"ᄺ": "HANGUL CHOSEONG SIOS-PHIEUPH",
-	"ᄻ": "HANGUL CHOSEONG SIOS-HIEUH",
-	"ᄼᄼ": "HANGUL CHOSEONG CHITUEUMSIOS, HANGUL CHOSEONG CHITUEUMSIOS",
-	"ᄽ": "HANGUL CHOSEONG CHITUEUMSSANGSIOS",
-	"ᄾᄾ": "HANGUL CHOSEONG CEONGCHIEUMSIOS, HANGUL CHOSEONG CEONGCHIEUMSIOS",
-	"ᄿ": "HANGUL CHOSEONG CEONGCHIEUMSSANGSIOS",
-	"ᅀ": "HANGUL CHOSEONG PANSIOS",
-	"ᅀᄇ": "HANGUL CHOSEONG PANSIOS, HANGUL CHOSEONG PIEUP",
-	"ᅀᄇᄋ": "HANGUL CHOSEONG PANSIOS, HANGUL CHOSEONG PIEUP, HANGUL CHOSEONG IEUNG",
-	"ᅁ": "HANGUL CHOSEONG IEUNG-KIYEOK",
-	"ᅂ": "HANGUL CHOSEONG IEUNG-TIKEUT",
-	"ᅃ": "HANGUL CHOSEONG IEUNG-MIEUM",
-	"ᅄ": "HANGUL CHOSEONG IEUNG-PIEUP",
-	"ᅅ": "HANGUL CHOSEONG IEUNG-SIOS",
-	"ᅆ": "HANGUL CHOSEONG IEUNG-PANSIOS",
-	"ᅇ": "HANGUL CHOSEONG SSANGIEUNG",
-	"ᅈ": "HANGUL CHOSEONG IEUNG-CIEUC",
-	"ᅉ": "HANGUL CHOSEONG IEUNG-CHIEUCH",
-	"ᅊ": "HANGUL CHOSEONG IEUNG-THIEUTH",
-	"ᅋ": "HANGUL CHOSEONG IEUNG-PHIEUPH",
-	"ᅌ": "HANGUL CHOSEONG YESIEUNG",
-	"ᅌᄆ": "HANGUL CHOSEONG YESIEUNG, HANGUL CHOSEONG MIEUM",
-	"ᅌᄒ": "HANGUL CHOSEONG YESIEUNG, HANGUL CHOSEONG HIEUH",
-	"ᅍ": "HANGUL CHOSEONG CIEUC-IEUNG",
-	"ᅎᅎ": "HANGUL CHOSEONG CHITUEUMCIEUC, HANGUL CHOSEONG CHITUEUMCIEUC",
-	"ᅏ": "HANGUL CHOSEONG CHITUEUMSSANGCIEUC",
-	"ᅐᅐ": "HANGUL CHOSEONG CEONGCHIEUMCIEUC, HANGUL CHOSEONG CEONGCHIEUMCIEUC",
-	"ᅑ": "HANGUL CHOSEONG CEONGCHIEUMSSANGCIEUC",
-	"ᅒ": "HANGUL CHOSEONG CHIEUCH-KHIEUKH",
-	"ᅓ": "HANGUL CHOSEONG CHIEUCH-HIEUH",
-	"ᅖ": "HANGUL CHOSEONG PHIEUPH-PIEUP",
-	"ᅗ": "HANGUL CHOSEONG KAPYEOUNPHIEUPH",
-	"ᅘ": "HANGUL CHOSEONG SSANGHIEUH",
-	"ᅙ": "HANGUL CHOSEONG YEORINHIEUH",
-	"ᅙᅙ": "HANGUL CHOSEONG YEORINHIEUH, HANGUL CHOSEONG YEORINHIEUH",
-	"ᅚ": "HANGUL CHOSEONG KIYEOK-TIKEUT",
-	"ᅛ": "HANGUL CHOSEONG NIEUN-SIOS",
-	"ᅜ": "HANGUL CHOSEONG NIEUN-CIEUC",
-	"ᅝ": "HANGUL CHOSEONG NIEUN-HIEUH",
-	"ᅞ": "HANGUL CHOSEONG TIKEUT-RIEUL",
-	"ᅠ": "HANGUL JUNGSEONG FILLER",
-	"ᅡ": "HANGUL JUNGSEONG A",
-	"ᅡᅩ": "HANGUL JUNGSEONG A, HANGUL JUNGSEONG O",
-	"ᅡᅮ": "HANGUL JUNGSEONG A, HANGUL JUNGSEONG U",
-	"ᅡー": "HANGUL JUNGSEONG A, KATAKANA-HIRAGANA PROLONGED SOUND MARK",
-	"ᅡ丨": "HANGUL JUNGSEONG A, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅢ": "HANGUL JUNGSEONG AE",
-	"ᅣ": "HANGUL JUNGSEONG YA",
-	"ᅣᅩ": "HANGUL JUNGSEONG YA, HANGUL JUNGSEONG O",
-	"ᅣᅭ": "HANGUL JUNGSEONG YA, HANGUL JUNGSEONG YO",
-	"ᅣᅮ": "HANGUL JUNGSEONG YA, HANGUL JUNGSEONG U",
-	"ᅣ丨": "HANGUL JUNGSEONG YA, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅤ": "HANGUL JUNGSEONG YAE",
-	"ᅥ": "HANGUL JUNGSEONG EO",
-	"ᅥᅩ": "HANGUL JUNGSEONG EO, HANGUL JUNGSEONG O",
-	"ᅥᅮ": "HANGUL JUNGSEONG EO, HANGUL JUNGSEONG U",
-	"ᅥー": "HANGUL JUNGSEONG EO, KATAKANA-HIRAGANA PROLONGED SOUND MARK",
-	"ᅥ丨": "HANGUL JUNGSEONG EO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅦ": "HANGUL JUNGSEONG E",
-	"ᅧ": "HANGUL JUNGSEONG YEO",
-	"ᅧᅣ": "HANGUL JUNGSEONG YEO, HANGUL JUNGSEONG YA",
-	"ᅧᅩ": "HANGUL JUNGSEONG YEO, HANGUL JUNGSEONG O",
-	"ᅧᅮ": "HANGUL JUNGSEONG YEO, HANGUL JUNGSEONG U",
-	"ᅧ丨": "HANGUL JUNGSEONG YEO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅨ": "HANGUL JUNGSEONG YE",
-	"ᅩ": "HANGUL JUNGSEONG O",
-	"ᅩᅡ": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG A",
-	"ᅩᅡ丨": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG A, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅩᅣ": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG YA",
-	"ᅩᅣ丨": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG YA, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅩᅥ": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG EO",
-	"ᅩᅥ丨": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG EO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅩᅧ": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG YEO",
-	"ᅩᅧ丨": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG YEO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅩᅩ": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG O",
-	"ᅩᅩ丨": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG O, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅩᅮ": "HANGUL JUNGSEONG O, HANGUL JUNGSEONG U",
-	"ᅩ丨": "HANGUL JUNGSEONG O, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅪ": "HANGUL JUNGSEONG WA",
-	"ᅫ": "HANGUL JUNGSEONG WAE",
-	"ᅬ": "HANGUL JUNGSEONG OE",
-	"ᅭ": "HANGUL JUNGSEONG YO",
-	"ᅭᅡ": "HANGUL JUNGSEONG YO, HANGUL JUNGSEONG A",
-	"ᅭᅡ丨": "HANGUL JUNGSEONG YO, HANGUL JUNGSEONG A, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅭᅣ": "HANGUL JUNGSEONG YO, HANGUL JUNGSEONG YA",
-	"ᅭᅣ丨": "HANGUL JUNGSEONG YO, HANGUL JUNGSEONG YA, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅭᅥ": "HANGUL JUNGSEONG YO, HANGUL JUNGSEONG EO",
-	"ᅭᅩ": "HANGUL JUNGSEONG YO, HANGUL JUNGSEONG O",
-	"ᅭ丨": "HANGUL JUNGSEONG YO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅮ": "HANGUL JUNGSEONG U",
-	"ᅮᅡ": "HANGUL JUNGSEONG U, HANGUL JUNGSEONG A",
-	"ᅮᅡ丨": "HANGUL JUNGSEONG U, HANGUL JUNGSEONG A, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅮᅥ": "HANGUL JUNGSEONG U, HANGUL JUNGSEONG EO",
-	"ᅮᅥー": "HANGUL JUNGSEONG U, HANGUL JUNGSEONG EO, KATAKANA-HIRAGANA PROLONGED SOUND MARK",
-	"ᅮᅥ丨": "HANGUL JUNGSEONG U, HANGUL JUNGSEONG EO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅮᅧ": "HANGUL JUNGSEONG U, HANGUL JUNGSEONG YEO",
-	"ᅮᅧ丨": "HANGUL JUNGSEONG U, HANGUL JUNGSEONG YEO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅮᅮ": "HANGUL JUNGSEONG U, HANGUL JUNGSEONG U",
-	"ᅮ丨": "HANGUL JUNGSEONG U, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅮ丨丨": "HANGUL JUNGSEONG U, CJK UNIFIED IDEOGRAPH-4E28, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅯ": "HANGUL JUNGSEONG WEO",
-	"ᅰ": "HANGUL JUNGSEONG WE",
-	"ᅱ": "HANGUL JUNGSEONG WI",
-	"ᅲ": "HANGUL JUNGSEONG YU",
-	"ᅲᅡ": "HANGUL JUNGSEONG YU, HANGUL JUNGSEONG A",
-	"ᅲᅡ丨": "HANGUL JUNGSEONG YU, HANGUL JUNGSEONG A, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅲᅥ": "HANGUL JUNGSEONG YU, HANGUL JUNGSEONG EO",
-	"ᅲᅥ丨": "HANGUL JUNGSEONG YU, HANGUL JUNGSEONG EO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅲᅧ": "HANGUL JUNGSEONG YU, HANGUL JUNGSEONG YEO",
-	"ᅲᅧ丨": "HANGUL JUNGSEONG YU, HANGUL JUNGSEONG YEO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅲᅩ": "HANGUL JUNGSEONG YU, HANGUL JUNGSEONG O",
-	"ᅲᅮ": "HANGUL JUNGSEONG YU, HANGUL JUNGSEONG U",
-	"ᅲ丨": "HANGUL JUNGSEONG YU, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᅳ": "HANGUL JUNGSEONG EU",
-	"ᅴ": "HANGUL JUNGSEONG YI",
-	"ᅵ": "HANGUL JUNGSEONG I",
-	"ᅶ": "HANGUL JUNGSEONG A-O",
-	"ᅷ": "HANGUL JUNGSEONG A-U",
-	"ᅸ": "HANGUL JUNGSEONG YA-O",
-	"ᅹ": "HANGUL JUNGSEONG YA-YO",
-	"ᅺ": "HANGUL JUNGSEONG EO-O",
-	"ᅻ": "HANGUL JUNGSEONG EO-U",
-	"ᅼ": "HANGUL JUNGSEONG EO-EU",
-	"ᅽ": "HANGUL JUNGSEONG YEO-O",
-	"ᅾ": "HANGUL JUNGSEONG YEO-U",
-	"ᅿ": "HANGUL JUNGSEONG O-EO",
-	"ᆀ": "HANGUL JUNGSEONG O-E",
-	"ᆁ": "HANGUL JUNGSEONG O-YE",
-	"ᆂ": "HANGUL JUNGSEONG O-O",
-	"ᆃ": "HANGUL JUNGSEONG O-U",
-	"ᆄ": "HANGUL JUNGSEONG YO-YA",
-	"ᆅ": "HANGUL JUNGSEONG YO-YAE",
-	"ᆆ": "HANGUL JUNGSEONG YO-YEO",
-	"ᆇ": "HANGUL JUNGSEONG YO-O",
-	"ᆈ": "HANGUL JUNGSEONG YO-I",
-	"ᆉ": "HANGUL JUNGSEONG U-A",
-	"ᆊ": "HANGUL JUNGSEONG U-AE",
-	"ᆋ": "HANGUL JUNGSEONG U-EO-EU",
-	"ᆌ": "HANGUL JUNGSEONG U-YE",
-	"ᆍ": "HANGUL JUNGSEONG U-U",
-	"ᆎ": "HANGUL JUNGSEONG YU-A",
-	"ᆏ": "HANGUL JUNGSEONG YU-EO",
-	"ᆐ": "HANGUL JUNGSEONG YU-E",
-	"ᆑ": "HANGUL JUNGSEONG YU-YEO",
-	"ᆒ": "HANGUL JUNGSEONG YU-YE",
-	"ᆓ": "HANGUL JUNGSEONG YU-U",
-	"ᆔ": "HANGUL JUNGSEONG YU-I",
-	"ᆕ": "HANGUL JUNGSEONG EU-U",
-	"ᆖ": "HANGUL JUNGSEONG EU-EU",
-	"ᆗ": "HANGUL JUNGSEONG YI-U",
-	"ᆘ": "HANGUL JUNGSEONG I-A",
-	"ᆙ": "HANGUL JUNGSEONG I-YA",
-	"ᆚ": "HANGUL JUNGSEONG I-O",
-	"ᆛ": "HANGUL JUNGSEONG I-U",
-	"ᆜ": "HANGUL JUNGSEONG I-EU",
-	"ᆝ": "HANGUL JUNGSEONG I-ARAEA",
-	"ᆞ": "HANGUL JUNGSEONG ARAEA",
-	"ᆞᅡ": "HANGUL JUNGSEONG ARAEA, HANGUL JUNGSEONG A",
-	"ᆞᅥ": "HANGUL JUNGSEONG ARAEA, HANGUL JUNGSEONG EO",
-	"ᆞᅥ丨": "HANGUL JUNGSEONG ARAEA, HANGUL JUNGSEONG EO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᆞᅮ": "HANGUL JUNGSEONG ARAEA, HANGUL JUNGSEONG U",
-	"ᆞᆞ": "HANGUL JUNGSEONG ARAEA, HANGUL JUNGSEONG ARAEA",
-	"ᆞ丨": "HANGUL JUNGSEONG ARAEA, CJK UNIFIED IDEOGRAPH-4E28",
-	"ᆟ": "HANGUL JUNGSEONG ARAEA-EO",
-	"ᆠ": "HANGUL JUNGSEONG ARAEA-U",
-	"ᆡ": "HANGUL JUNGSEONG ARAEA-I",
-	"ᆢ": "HANGUL JUNGSEONG SSANGARAEA",
-	"ᆣ": "HANGUL JUNGSEONG A-EU",
-	"ᆤ": "HANGUL JUNGSEONG YA-U",
-	"ᆥ": "HANGUL JUNGSEONG YEO-YA",
-	"ᆦ": "HANGUL JUNGSEONG O-YA",
-	"ᆧ": "HANGUL JUNGSEONG O-YAE",
-	"ᆨ": "HANGUL JONGSEONG KIYEOK",
-	"ᆩ": "HANGUL JONGSEONG SSANGKIYEOK",
-	"ᆪ": "HANGUL JONGSEONG KIYEOK-SIOS",
-	"ᆫ": "HANGUL JONGSEONG NIEUN",
-	"ᆬ": "HANGUL JONGSEONG NIEUN-CIEUC",
-	"ᆭ": "HANGUL JONGSEONG NIEUN-HIEUH",
-	"ᆮ": "HANGUL JONGSEONG TIKEUT",
-	"ᆯ": "HANGUL JONGSEONG RIEUL",
-	"ᆰ": "HANGUL JONGSEONG RIEUL-KIYEOK",
-	"ᆱ": "HANGUL JONGSEONG RIEUL-MIEUM",
-	"ᆲ": "HANGUL JONGSEONG RIEUL-PIEUP",
-	"ᆳ": "HANGUL JONGSEONG RIEUL-SIOS",
-	"ᆴ": "HANGUL JONGSEONG RIEUL-THIEUTH",
-	"ᆵ": "HANGUL JONGSEONG RIEUL-PHIEUPH",
-	"ᆶ": "HANGUL JONGSEONG RIEUL-HIEUH",
-	"ᆷ": "HANGUL JONGSEONG MIEUM",
-	"ᆸ": "HANGUL JONGSEONG PIEUP",
-	"ᆹ": "HANGUL JONGSEONG PIEUP-SIOS",
-	"ᆺ": "HANGUL JONGSEONG SIOS",
-	"ᆻ": "HANGUL JONGSEONG SSANGSIOS",
-	"ᆼ": "HANGUL JONGSEONG IEUNG",
-	"ᆽ": "HANGUL JONGSEONG CIEUC",
-	"ᆾ": "HANGUL JONGSEONG CHIEUCH",
-	"ᆿ": "HANGUL JONGSEONG KHIEUKH",
-	"ᇀ": "HANGUL JONGSEONG THIEUTH",
-	"ᇁ": "HANGUL JONGSEONG PHIEUPH",
-	"ᇂ": "HANGUL JONGSEONG HIEUH",
-	"ᇃ": "HANGUL JONGSEONG KIYEOK-RIEUL",
-	"ᇄ": "HANGUL JONGSEONG KIYEOK-SIOS-KIYEOK",
-	"ᇅ": "HANGUL JONGSEONG NIEUN-KIYEOK",
-	"ᇆ": "HANGUL JONGSEONG NIEUN-TIKEUT",
-	"ᇇ": "HANGUL JONGSEONG NIEUN-SIOS",
-	"ᇈ": "HANGUL JONGSEONG NIEUN-PANSIOS",
-	"ᇉ": "HANGUL JONGSEONG NIEUN-THIEUTH",
-	"ᇊ": "HANGUL JONGSEONG TIKEUT-KIYEOK",
-	"ᇋ": "HANGUL JONGSEONG TIKEUT-RIEUL",
-	"ᇌ": "HANGUL JONGSEONG RIEUL-KIYEOK-SIOS",
-	"ᇍ": "HANGUL JONGSEONG RIEUL-NIEUN",
-	"ᇎ": "HANGUL JONGSEONG RIEUL-TIKEUT",
-	"ᇏ": "HANGUL JONGSEONG RIEUL-TIKEUT-HIEUH",
-	"ᇐ": "HANGUL JONGSEONG SSANGRIEUL",
-	"ᇑ": "HANGUL JONGSEONG RIEUL-MIEUM-KIYEOK",
-	"ᇒ": "HANGUL JONGSEONG RIEUL-MIEUM-SIOS",
-	"ᇓ": "HANGUL JONGSEONG RIEUL-PIEUP-SIOS",
-	"ᇔ": "HANGUL JONGSEONG RIEUL-PIEUP-HIEUH",
-	"ᇕ": "HANGUL JONGSEONG RIEUL-KAPYEOUNPIEUP",
-	"ᇖ": "HANGUL JONGSEONG RIEUL-SSANGSIOS",
-	"ᇗ": "HANGUL JONGSEONG RIEUL-PANSIOS",
-	"ᇘ": "HANGUL JONGSEONG RIEUL-KHIEUKH",
-	"ᇙ": "HANGUL JONGSEONG RIEUL-YEORINHIEUH",
-	"ᇚ": "HANGUL JONGSEONG MIEUM-KIYEOK",
-	"ᇛ": "HANGUL JONGSEONG MIEUM-RIEUL",
-	"ᇜ": "HANGUL JONGSEONG MIEUM-PIEUP",
-	"ᇝ": "HANGUL JONGSEONG MIEUM-SIOS",
-	"ᇞ": "HANGUL JONGSEONG MIEUM-SSANGSIOS",
-	"ᇟ": "HANGUL JONGSEONG MIEUM-PANSIOS",
-	"ᇠ": "HANGUL JONGSEONG MIEUM-CHIEUCH",
-	"ᇡ": "HANGUL JONGSEONG MIEUM-HIEUH",
-	"ᇢ": "HANGUL JONGSEONG KAPYEOUNMIEUM",
-	"ᇣ": "HANGUL JONGSEONG PIEUP-RIEUL",
-	"ᇤ": "HANGUL JONGSEONG PIEUP-PHIEUPH",
-	"ᇥ": "HANGUL JONGSEONG PIEUP-HIEUH",
-	"ᇦ": "HANGUL JONGSEONG KAPYEOUNPIEUP",
-	"ᇧ": "HANGUL JONGSEONG SIOS-KIYEOK",
-	"ᇨ": "HANGUL JONGSEONG SIOS-TIKEUT",
-	"ᇩ": "HANGUL JONGSEONG SIOS-RIEUL",
-	"ᇪ": "HANGUL JONGSEONG SIOS-PIEUP",
-	"ᇫ": "HANGUL JONGSEONG PANSIOS",
-	"ᇬ": "HANGUL JONGSEONG IEUNG-KIYEOK",
-	"ᇭ": "HANGUL JONGSEONG IEUNG-SSANGKIYEOK",
-	"ᇮ": "HANGUL JONGSEONG SSANGIEUNG",
-	"ᇯ": "HANGUL JONGSEONG IEUNG-KHIEUKH",
-	"ᇰ": "HANGUL JONGSEONG YESIEUNG",
-	"ᇱ": "HANGUL JONGSEONG YESIEUNG-SIOS",
-	"ᇲ": "HANGUL JONGSEONG YESIEUNG-PANSIOS",
-	"ᇳ": "HANGUL JONGSEONG PHIEUPH-PIEUP",
-	"ᇴ": "HANGUL JONGSEONG KAPYEOUNPHIEUPH",
-	"ᇵ": "HANGUL JONGSEONG HIEUH-NIEUN",
-	"ᇶ": "HANGUL JONGSEONG HIEUH-RIEUL",
-	"ᇷ": "HANGUL JONGSEONG HIEUH-MIEUM",
-	"ᇸ": "HANGUL JONGSEONG HIEUH-PIEUP",
-	"ᇹ": "HANGUL JONGSEONG YEORINHIEUH",
-	"ᇺ": "HANGUL JONGSEONG KIYEOK-NIEUN",
-	"ᇻ": "HANGUL JONGSEONG KIYEOK-PIEUP",
-	"ᇼ": "HANGUL JONGSEONG KIYEOK-CHIEUCH",
-	"ᇽ": "HANGUL JONGSEONG KIYEOK-KHIEUKH",
-	"ᇾ": "HANGUL JONGSEONG KIYEOK-HIEUH",
-	"ᇿ": "HANGUL JONGSEONG SSANGNIEUN",
-	"ሀ": "ETHIOPIC SYLLABLE HA",
-	"ሆ": "ETHIOPIC SYLLABLE HO",
-	"ሣ": "ETHIOPIC SYLLABLE SZAA",
-	"ቀ": "ETHIOPIC SYLLABLE QA",
-	"በ": "ETHIOPIC SYLLABLE BA",
-	"ቡ": "ETHIOPIC SYLLABLE BU",
-	"ኔ": "ETHIOPIC SYLLABLE NEE",
-	"ኮ": "ETHIOPIC SYLLABLE KO",
-	"ዐ": "ETHIOPIC SYLLABLE PHARYNGEAL A",
-	"ጣ": "ETHIOPIC SYLLABLE THAA",
-	"Ꭰ": "CHEROKEE LETTER A",
-	"Ꭱ": "CHEROKEE LETTER E",
-	"Ꭲ": "CHEROKEE LETTER I",
-	"Ꭴ": "CHEROKEE LETTER U",
-	"Ꭵ": "CHEROKEE LETTER V",
-	"Ꭸ": "CHEROKEE LETTER GE",
-	"Ꭹ": "CHEROKEE LETTER GI",
-	"Ꭺ": "CHEROKEE LETTER GO",
-	"Ꭻ": "CHEROKEE LETTER GU",
-	"Ꭼ": "CHEROKEE LETTER GV",
-	"Ꭾ": "CHEROKEE LETTER HE",
-	"Ꮀ": "CHEROKEE LETTER HO",
-	"Ꮁ": "CHEROKEE LETTER HU",
-	"Ꮃ": "CHEROKEE LETTER LA",
-	"Ꮇ": "CHEROKEE LETTER LU",
-	"Ꮋ": "CHEROKEE LETTER MI",
-	"Ꮍ": "CHEROKEE LETTER MU",
-	"Ꮎ": "CHEROKEE LETTER NA",
-	"Ꮏ": "CHEROKEE LETTER HNA",
-	"Ꮐ": "CHEROKEE LETTER NAH",
-	"Ꮒ": "CHEROKEE LETTER NI",
-	"Ꮓ": "CHEROKEE LETTER NO",
-	"Ꮗ": "CHEROKEE LETTER QUE",
-	"Ꮛ": "CHEROKEE LETTER QUV",
-	"Ꮜ": "CHEROKEE LETTER SA",
-	"Ꮞ": "CHEROKEE LETTER SE",
-	"Ꮟ": "CHEROKEE LETTER SI",
-	"Ꮢ": "CHEROKEE LETTER SV",
-	"Ꮤ": "CHEROKEE LETTER TA",
-	"Ꮥ": "CHEROKEE LETTER DE",
-	"Ꮩ": "CHEROKEE LETTER DO",
-	"Ꮪ": "CHEROKEE LETTER DU",
-	"Ꮮ": "CHEROKEE LETTER TLE",
-	"Ꮯ": "CHEROKEE LETTER TLI",
-	"Ꮲ": "CHEROKEE LETTER TLV",
-	"Ꮶ": "CHEROKEE LETTER TSO",
-	"Ꮷ": "CHEROKEE LETTER TSU",
-	"Ꮻ": "CHEROKEE LETTER WI",
-	"Ꮾ": "CHEROKEE LETTER WV",
-	"Ꮿ": "CHEROKEE LETTER YA",
-	"Ᏸ": "CHEROKEE LETTER YE",
-	"Ᏺ": "CHEROKEE LETTER YO",
-	"Ᏻ": "CHEROKEE LETTER YU",
-	"Ᏼ": "CHEROKEE LETTER YV",
-	"ᏻ": "CHEROKEE SMALL LETTER YU",
-	"ᏼ": "CHEROKEE SMALL LETTER YV",
-	"᐀": "CANADIAN SYLLABICS HYPHEN",
-	"ᐁ·": "CANADIAN SYLLABICS E, MIDDLE DOT",
-	"ᐁᐠ": "CANADIAN SYLLABICS E, CANADIAN SYLLABICS FINAL GRAVE",
-	"ᐃ": "CANADIAN SYLLABICS I",
-	"ᐄ·": "CANADIAN SYLLABICS II, MIDDLE DOT",
-	"ᐅ·": "CANADIAN SYLLABICS O, MIDDLE DOT",
-	"ᐅᐠ": "CANADIAN SYLLABICS O, CANADIAN SYLLABICS FINAL GRAVE",
-	"ᐆ·": "CANADIAN SYLLABICS OO, MIDDLE DOT",
-	"ᐊ·": "CANADIAN SYLLABICS A, MIDDLE DOT",
-	"ᐊᐠ": "CANADIAN SYLLABICS A, CANADIAN SYLLABICS FINAL GRAVE",
-	"ᐋ·": "CANADIAN SYLLABICS AA, MIDDLE DOT",
-	"ᐌ": "CANADIAN SYLLABICS WE",
-	"ᐍ": "CANADIAN SYLLABICS WEST-CREE WE",
-	"ᐎ": "CANADIAN SYLLABICS WI",
-	"ᐏ": "CANADIAN SYLLABICS WEST-CREE WI",
-	"ᐐ": "CANADIAN SYLLABICS WII",
-	"ᐑ": "CANADIAN SYLLABICS WEST-CREE WII",
-	"ᐒ": "CANADIAN SYLLABICS WO",
-	"ᐓ": "CANADIAN SYLLABICS WEST-CREE WO",
-	"ᐔ": "CANADIAN SYLLABICS WOO",
-	"ᐕ": "CANADIAN SYLLABICS WEST-CREE WOO",
-	"ᐗ": "CANADIAN SYLLABICS WA",
-	"ᐘ": "CANADIAN SYLLABICS WEST-CREE WA",
-	"ᐙ": "CANADIAN SYLLABICS WAA",
-	"ᐚ": "CANADIAN SYLLABICS WEST-CREE WAA",
-	"ᐞᣟ": "CANADIAN SYLLABICS GLOTTAL STOP, CANADIAN SYLLABICS FINAL RAISED DOT",
-	"ᐡ": "CANADIAN SYLLABICS FINAL BOTTOM HALF RING",
-	"ᐧ": "CANADIAN SYLLABICS FINAL MIDDLE DOT",
-	"ᐩ": "CANADIAN SYLLABICS FINAL PLUS",
-	"ᐫ": "CANADIAN SYLLABICS EN",
-	"ᐬ": "CANADIAN SYLLABICS IN",
-	"ᐭ": "CANADIAN SYLLABICS ON",
-	"ᐮ": "CANADIAN SYLLABICS AN",
-	"ᐯ": "CANADIAN SYLLABICS PE",
-	"ᐱ": "CANADIAN SYLLABICS PI",
-	"ᐲ·": "CANADIAN SYLLABICS PII, MIDDLE DOT",
-	"ᐳ": "CANADIAN SYLLABICS PO",
-	"ᐴ·": "CANADIAN SYLLABICS POO, MIDDLE DOT",
-	"ᐵ": "CANADIAN SYLLABICS Y-CREE POO",
-	"ᐷ": "CANADIAN SYLLABICS CARRIER HI",
-	"ᐸ": "CANADIAN SYLLABICS PA",
-	"ᐹ·": "CANADIAN SYLLABICS PAA, MIDDLE DOT",
-	"ᐺ": "CANADIAN SYLLABICS PWE",
-	"ᐻ": "CANADIAN SYLLABICS WEST-CREE PWE",
-	"ᐼ": "CANADIAN SYLLABICS PWI",
-	"ᐽ": "CANADIAN SYLLABICS WEST-CREE PWI",
-	"ᐾ": "CANADIAN SYLLABICS PWII",
-	"ᐿ": "CANADIAN SYLLABICS WEST-CREE PWII",
-	"ᑀ": "CANADIAN SYLLABICS PWO",
-	"ᑁ": "CANADIAN SYLLABICS WEST-CREE PWO",
-	"ᑂ": "CANADIAN SYLLABICS PWOO",
-	"ᑃ": "CANADIAN SYLLABICS WEST-CREE PWOO",
-	"ᑄ": "CANADIAN SYLLABICS PWA",
-	"ᑅ": "CANADIAN SYLLABICS WEST-CREE PWA",
-	"ᑆ": "CANADIAN SYLLABICS PWAA",
-	"ᑇ": "CANADIAN SYLLABICS WEST-CREE PWAA",
-	"ᑊ": "CANADIAN SYLLABICS WEST-CREE P",
-	"ᑌ": "CANADIAN SYLLABICS TE",
-	"ᑎ": "CANADIAN SYLLABICS TI",
-	"ᑏ·": "CANADIAN SYLLABICS TII, MIDDLE DOT",
-	"ᑐ": "CANADIAN SYLLABICS TO",
-	"ᑐ'": "CANADIAN SYLLABICS TO, APOSTROPHE",
-	"ᑐ/": "CANADIAN SYLLABICS TO, SOLIDUS",
-	"ᑐ·": "CANADIAN SYLLABICS TO, MIDDLE DOT",
-	"ᑐᑕ": "CANADIAN SYLLABICS TO, CANADIAN SYLLABICS TA",
-	"ᑑ·": "CANADIAN SYLLABICS TOO, MIDDLE DOT",
-	"ᑔ": "CANADIAN SYLLABICS CARRIER DI",
-	"ᑕ": "CANADIAN SYLLABICS TA",
-	"ᑕ'": "CANADIAN SYLLABICS TA, APOSTROPHE",
-	"ᑕ·": "CANADIAN SYLLABICS TA, MIDDLE DOT",
-	"ᑖ·": "CANADIAN SYLLABICS TAA, MIDDLE DOT",
-	"ᑗ": "CANADIAN SYLLABICS TWE",
-	"ᑘ": "CANADIAN SYLLABICS WEST-CREE TWE",
-	"ᑙ": "CANADIAN SYLLABICS TWI",
-	"ᑚ": "CANADIAN SYLLABICS WEST-CREE TWI",
-	"ᑛ": "CANADIAN SYLLABICS TWII",
-	"ᑜ": "CANADIAN SYLLABICS WEST-CREE TWII",
-	"ᑝ": "CANADIAN SYLLABICS TWO",
-	"ᑞ": "CANADIAN SYLLABICS WEST-CREE TWO",
-	"ᑟ": "CANADIAN SYLLABICS TWOO",
-	"ᑠ": "CANADIAN SYLLABICS WEST-CREE TWOO",
-	"ᑡ": "CANADIAN SYLLABICS TWA",
-	"ᑢ": "CANADIAN SYLLABICS WEST-CREE TWA",
-	"ᑣ": "CANADIAN SYLLABICS TWAA",
-	"ᑤ": "CANADIAN SYLLABICS WEST-CREE TWAA",
-	"ᑧ": "CANADIAN SYLLABICS TTE",
-	"ᑨ": "CANADIAN SYLLABICS TTI",
-	"ᑩ": "CANADIAN SYLLABICS TTO",
-	"ᑪ": "CANADIAN SYLLABICS TTA",
-	"ᑫ'": "CANADIAN SYLLABICS KE, APOSTROPHE",
-	"ᑫ·": "CANADIAN SYLLABICS KE, MIDDLE DOT",
-	"ᑭ": "CANADIAN SYLLABICS KI",
-	"ᑮ·": "CANADIAN SYLLABICS KII, MIDDLE DOT",
-	"ᑯ": "CANADIAN SYLLABICS KO",
-	"ᑰ·": "CANADIAN SYLLABICS KOO, MIDDLE DOT",
-	"ᑲ": "CANADIAN SYLLABICS KA",
-	"ᑳ": "CANADIAN SYLLABICS KAA",
-	"ᑴ": "CANADIAN SYLLABICS KWE",
-	"ᑵ": "CANADIAN SYLLABICS WEST-CREE KWE",
-	"ᑶ": "CANADIAN SYLLABICS KWI",
-	"ᑷ": "CANADIAN SYLLABICS WEST-CREE KWI",
-	"ᑸ": "CANADIAN SYLLABICS KWII",
-	"ᑹ": "CANADIAN SYLLABICS WEST-CREE KWII",
-	"ᑺ": "CANADIAN SYLLABICS KWO",
-	"ᑻ": "CANADIAN SYLLABICS WEST-CREE KWO",
-	"ᑼ": "CANADIAN SYLLABICS KWOO",
-	"ᑽ": "CANADIAN SYLLABICS WEST-CREE KWOO",
-	"ᑾ": "CANADIAN SYLLABICS KWA",
-	"ᑿ": "CANADIAN SYLLABICS WEST-CREE KWA",
-	"ᒀ": "CANADIAN SYLLABICS KWAA",
-	"ᒁ": "CANADIAN SYLLABICS WEST-CREE KWAA",
-	"ᒅ": "CANADIAN SYLLABICS SOUTH-SLAVEY KEH",
-	"ᒆ": "CANADIAN SYLLABICS SOUTH-SLAVEY KIH",
-	"ᒇ": "CANADIAN SYLLABICS SOUTH-SLAVEY KOH",
-	"ᒈ": "CANADIAN SYLLABICS SOUTH-SLAVEY KAH",
-	"ᒉ": "CANADIAN SYLLABICS CE",
-	"ᒉ·": "CANADIAN SYLLABICS CE, MIDDLE DOT",
-	"ᒋ·": "CANADIAN SYLLABICS CI, MIDDLE DOT",
-	"ᒌ·": "CANADIAN SYLLABICS CII, MIDDLE DOT",
-	"ᒍ": "CANADIAN SYLLABICS CO",
-	"ᒎ·": "CANADIAN SYLLABICS COO, MIDDLE DOT",
-	"ᒐ": "CANADIAN SYLLABICS CA",
-	"ᒐ·": "CANADIAN SYLLABICS CA, MIDDLE DOT",
-	"ᒑ·": "CANADIAN SYLLABICS CAA, MIDDLE DOT",
-	"ᒒ": "CANADIAN SYLLABICS CWE",
-	"ᒓ": "CANADIAN SYLLABICS WEST-CREE CWE",
-	"ᒔ": "CANADIAN SYLLABICS CWI",
-	"ᒕ": "CANADIAN SYLLABICS WEST-CREE CWI",
-	"ᒖ": "CANADIAN SYLLABICS CWII",
-	"ᒗ": "CANADIAN SYLLABICS WEST-CREE CWII",
-	"ᒘ": "CANADIAN SYLLABICS CWO",
-	"ᒙ": "CANADIAN SYLLABICS WEST-CREE CWO",
-	"ᒚ": "CANADIAN SYLLABICS CWOO",
-	"ᒛ": "CANADIAN SYLLABICS WEST-CREE CWOO",
-	"ᒜ": "CANADIAN SYLLABICS CWA",
-	"ᒝ": "CANADIAN SYLLABICS WEST-CREE CWA",
-	"ᒞ": "CANADIAN SYLLABICS CWAA",
-	"ᒟ": "CANADIAN SYLLABICS WEST-CREE CWAA",
-	"ᒣ·": "CANADIAN SYLLABICS ME, MIDDLE DOT",
-	"ᒥ": "CANADIAN SYLLABICS MI",
-	"ᒦ·": "CANADIAN SYLLABICS MII, MIDDLE DOT",
-	"ᒧ·": "CANADIAN SYLLABICS MO, MIDDLE DOT",
-	"ᒨ·": "CANADIAN SYLLABICS MOO, MIDDLE DOT",
-	"ᒪ": "CANADIAN SYLLABICS MA",
-	"ᒫ·": "CANADIAN SYLLABICS MAA, MIDDLE DOT",
-	"ᒬ": "CANADIAN SYLLABICS MWE",
-	"ᒭ": "CANADIAN SYLLABICS WEST-CREE MWE",
-	"ᒮ": "CANADIAN SYLLABICS MWI",
-	"ᒯ": "CANADIAN SYLLABICS WEST-CREE MWI",
-	"ᒰ": "CANADIAN SYLLABICS MWII",
-	"ᒱ": "CANADIAN SYLLABICS WEST-CREE MWII",
-	"ᒲ": "CANADIAN SYLLABICS MWO",
-	"ᒳ": "CANADIAN SYLLABICS WEST-CREE MWO",
-	"ᒴ": "CANADIAN SYLLABICS MWOO",
-	"ᒵ": "CANADIAN SYLLABICS WEST-CREE MWOO",
-	"ᒶ": "CANADIAN SYLLABICS MWA",
-	"ᒷ": "CANADIAN SYLLABICS WEST-CREE MWA",
-	"ᒸ": "CANADIAN SYLLABICS MWAA",
-	"ᒹ": "CANADIAN SYLLABICS WEST-CREE MWAA",
-	"ᒿ": "CANADIAN SYLLABICS SAYISI M",
-	"ᓀ·": "CANADIAN SYLLABICS NE, MIDDLE DOT",
-	"ᓂ·": "CANADIAN SYLLABICS NI, MIDDLE DOT",
-	"ᓃ·": "CANADIAN SYLLABICS NII, MIDDLE DOT",
-	"ᓄ·": "CANADIAN SYLLABICS NO, MIDDLE DOT",
-	"ᓅ·": "CANADIAN SYLLABICS NOO, MIDDLE DOT",
-	"ᓇ·": "CANADIAN SYLLABICS NA, MIDDLE DOT",
-	"ᓈ·": "CANADIAN SYLLABICS NAA, MIDDLE DOT",
-	"ᓉ": "CANADIAN SYLLABICS NWE",
-	"ᓊ": "CANADIAN SYLLABICS WEST-CREE NWE",
-	"ᓋ": "CANADIAN SYLLABICS NWA",
-	"ᓌ": "CANADIAN SYLLABICS WEST-CREE NWA",
-	"ᓍ": "CANADIAN SYLLABICS NWAA",
-	"ᓎ": "CANADIAN SYLLABICS WEST-CREE NWAA",
-	"ᓑ": "CANADIAN SYLLABICS CARRIER NG",
-	"ᓓ": "CANADIAN SYLLABICS LE",
-	"ᓓ·": "CANADIAN SYLLABICS LE, MIDDLE DOT",
-	"ᓕ·": "CANADIAN SYLLABICS LI, MIDDLE DOT",
-	"ᓖ·": "CANADIAN SYLLABICS LII, MIDDLE DOT",
-	"ᓗ·": "CANADIAN SYLLABICS LO, MIDDLE DOT",
-	"ᓘ·": "CANADIAN SYLLABICS LOO, MIDDLE DOT",
-	"ᓚ": "CANADIAN SYLLABICS LA",
-	"ᓚ·": "CANADIAN SYLLABICS LA, MIDDLE DOT",
-	"ᓛ·": "CANADIAN SYLLABICS LAA, MIDDLE DOT",
-	"ᓜ": "CANADIAN SYLLABICS LWE",
-	"ᓝ": "CANADIAN SYLLABICS WEST-CREE LWE",
-	"ᓞ": "CANADIAN SYLLABICS LWI",
-	"ᓟ": "CANADIAN SYLLABICS WEST-CREE LWI",
-	"ᓠ": "CANADIAN SYLLABICS LWII",
-	"ᓡ": "CANADIAN SYLLABICS WEST-CREE LWII",
-	"ᓢ": "CANADIAN SYLLABICS LWO",
-	"ᓣ": "CANADIAN SYLLABICS WEST-CREE LWO",
-	"ᓤ": "CANADIAN SYLLABICS LWOO",
-	"ᓥ": "CANADIAN SYLLABICS WEST-CREE LWOO",
-	"ᓦ": "CANADIAN SYLLABICS LWA",
-	"ᓧ": "CANADIAN SYLLABICS WEST-CREE LWA",
-	"ᓨ": "CANADIAN SYLLABICS LWAA",
-	"ᓩ": "CANADIAN SYLLABICS WEST-CREE LWAA",
-	"ᓭ·": "CANADIAN SYLLABICS SE, MIDDLE DOT",
-	"ᓯ·": "CANADIAN SYLLABICS SI, MIDDLE DOT",
-	"ᓰ·": "CANADIAN SYLLABICS SII, MIDDLE DOT",
-	"ᓱ·": "CANADIAN SYLLABICS SO, MIDDLE DOT",
-	"ᓲ·": "CANADIAN SYLLABICS SOO, MIDDLE DOT",
-	"ᓴ·": "CANADIAN SYLLABICS SA, MIDDLE DOT",
-	"ᓵ·": "CANADIAN SYLLABICS SAA, MIDDLE DOT",
-	"ᓶ": "CANADIAN SYLLABICS SWE",
-	"ᓷ": "CANADIAN SYLLABICS WEST-CREE SWE",
-	"ᓸ": "CANADIAN SYLLABICS SWI",
-	"ᓹ": "CANADIAN SYLLABICS WEST-CREE SWI",
-	"ᓺ": "CANADIAN SYLLABICS SWII",
-	"ᓻ": "CANADIAN SYLLABICS WEST-CREE SWII",
-	"ᓼ": "CANADIAN SYLLABICS SWO",
-	"ᓽ": "CANADIAN SYLLABICS WEST-CREE SWO",
-	"ᓾ": "CANADIAN SYLLABICS SWOO",
-	"ᓿ": "CANADIAN SYLLABICS WEST-CREE SWOO",
-	"ᔀ": "CANADIAN SYLLABICS SWA",
-	"ᔁ": "CANADIAN SYLLABICS WEST-CREE SWA",
-	"ᔂ": "CANADIAN SYLLABICS SWAA",
-	"ᔃ": "CANADIAN SYLLABICS WEST-CREE SWAA",
-	"ᔋ<": "CANADIAN SYLLABICS NASKAPI S-W, LESS-THAN SIGN",
-	"ᔋb": "CANADIAN SYLLABICS NASKAPI S-W, LATIN SMALL LETTER B",
-	"ᔋᑕ": "CANADIAN SYLLABICS NASKAPI S-W, CANADIAN SYLLABICS TA",
-	"ᔋᒐ": "CANADIAN SYLLABICS NASKAPI S-W, CANADIAN SYLLABICS CA",
-	"ᔌ": "CANADIAN SYLLABICS NASKAPI SPWA",
-	"ᔍ": "CANADIAN SYLLABICS NASKAPI STWA",
-	"ᔎ": "CANADIAN SYLLABICS NASKAPI SKWA",
-	"ᔏ": "CANADIAN SYLLABICS NASKAPI SCWA",
-	"ᔐ·": "CANADIAN SYLLABICS SHE, MIDDLE DOT",
-	"ᔑ·": "CANADIAN SYLLABICS SHI, MIDDLE DOT",
-	"ᔒ·": "CANADIAN SYLLABICS SHII, MIDDLE DOT",
-	"ᔓ·": "CANADIAN SYLLABICS SHO, MIDDLE DOT",
-	"ᔔ·": "CANADIAN SYLLABICS SHOO, MIDDLE DOT",
-	"ᔕ·": "CANADIAN SYLLABICS SHA, MIDDLE DOT",
-	"ᔖ·": "CANADIAN SYLLABICS SHAA, MIDDLE DOT",
-	"ᔗ": "CANADIAN SYLLABICS SHWE",
-	"ᔘ": "CANADIAN SYLLABICS WEST-CREE SHWE",
-	"ᔙ": "CANADIAN SYLLABICS SHWI",
-	"ᔚ": "CANADIAN SYLLABICS WEST-CREE SHWI",
-	"ᔛ": "CANADIAN SYLLABICS SHWII",
-	"ᔜ": "CANADIAN SYLLABICS WEST-CREE SHWII",
-	"ᔝ": "CANADIAN SYLLABICS SHWO",
-	"ᔞ": "CANADIAN SYLLABICS WEST-CREE SHWO",
-	"ᔟ": "CANADIAN SYLLABICS SHWOO",
-	"ᔠ": "CANADIAN SYLLABICS WEST-CREE SHWOO",
-	"ᔡ": "CANADIAN SYLLABICS SHWA",
-	"ᔢ": "CANADIAN SYLLABICS WEST-CREE SHWA",
-	"ᔣ": "CANADIAN SYLLABICS SHWAA",
-	"ᔤ": "CANADIAN SYLLABICS WEST-CREE SHWAA",
-	"ᔨ·": "CANADIAN SYLLABICS YI, MIDDLE DOT",
-	"ᔩ·": "CANADIAN SYLLABICS YII, MIDDLE DOT",
-	"ᔪ·": "CANADIAN SYLLABICS YO, MIDDLE DOT",
-	"ᔫ·": "CANADIAN SYLLABICS YOO, MIDDLE DOT",
-	"ᔭ·": "CANADIAN SYLLABICS YA, MIDDLE DOT",
-	"ᔮ·": "CANADIAN SYLLABICS YAA, MIDDLE DOT",
-	"ᔯ": "CANADIAN SYLLABICS YWE",
-	"ᔰ": "CANADIAN SYLLABICS WEST-CREE YWE",
-	"ᔱ": "CANADIAN SYLLABICS YWI",
-	"ᔲ": "CANADIAN SYLLABICS WEST-CREE YWI",
-	"ᔳ": "CANADIAN SYLLABICS YWII",
-	"ᔴ": "CANADIAN SYLLABICS WEST-CREE YWII",
-	"ᔵ": "CANADIAN SYLLABICS YWO",
-	"ᔶ": "CANADIAN SYLLABICS WEST-CREE YWO",
-	"ᔷ": "CANADIAN SYLLABICS YWOO",
-	"ᔸ": "CANADIAN SYLLABICS WEST-CREE YWOO",
-	"ᔹ": "CANADIAN SYLLABICS YWA",
-	"ᔺ": "CANADIAN SYLLABICS WEST-CREE YWA",
-	"ᔻ": "CANADIAN SYLLABICS YWAA",
-	"ᔼ": "CANADIAN SYLLABICS WEST-CREE YWAA",
-	"ᕀ": "CANADIAN SYLLABICS WEST-CREE Y",
-	"ᕁ": "CANADIAN SYLLABICS SAYISI YI",
-	"ᕃ": "CANADIAN SYLLABICS R-CREE RE",
-	"ᕃ·": "CANADIAN SYLLABICS R-CREE RE, MIDDLE DOT",
-	"ᕆ": "CANADIAN SYLLABICS RI",
-	"ᕊ": "CANADIAN SYLLABICS WEST-CREE LO",
-	"ᕌ·": "CANADIAN SYLLABICS RAA, MIDDLE DOT",
-	"ᕎ": "CANADIAN SYLLABICS RWAA",
-	"ᕏ": "CANADIAN SYLLABICS WEST-CREE RWAA",
-	"ᕐP": "CANADIAN SYLLABICS R, LATIN CAPITAL LETTER P",
-	"ᕐb": "CANADIAN SYLLABICS R, LATIN SMALL LETTER B",
-	"ᕐḃ": "CANADIAN SYLLABICS R, LATIN SMALL LETTER B, COMBINING DOT ABOVE",
-	"ᕐd": "CANADIAN SYLLABICS R, LATIN SMALL LETTER D",
-	"ᕐᑫ": "CANADIAN SYLLABICS R, CANADIAN SYLLABICS KE",
-	"ᕐᑬ": "CANADIAN SYLLABICS R, CANADIAN SYLLABICS KAAI",
-	"ᕐᑮ": "CANADIAN SYLLABICS R, CANADIAN SYLLABICS KII",
-	"ᕐᑰ": "CANADIAN SYLLABICS R, CANADIAN SYLLABICS KOO",
-	"ᕐᒃ": "CANADIAN SYLLABICS R, CANADIAN SYLLABICS K",
-	"ᕚ·": "CANADIAN SYLLABICS FAA, MIDDLE DOT",
-	"ᕛ": "CANADIAN SYLLABICS FWAA",
-	"ᕜ": "CANADIAN SYLLABICS WEST-CREE FWAA",
-	"ᕞ·": "CANADIAN SYLLABICS THE, MIDDLE DOT",
-	"ᕦ·": "CANADIAN SYLLABICS THA, MIDDLE DOT",
-	"ᕧ·": "CANADIAN SYLLABICS THAA, MIDDLE DOT",
-	"ᕨ": "CANADIAN SYLLABICS THWAA",
-	"ᕩ": "CANADIAN SYLLABICS WEST-CREE THWAA",
-	"ᕫ·": "CANADIAN SYLLABICS TTHE, MIDDLE DOT",
-	"ᕷ": "CANADIAN SYLLABICS NUNAVIK HO",
-	"ᕼ": "CANADIAN SYLLABICS NUNAVUT H",
-	"ᕽ": "CANADIAN SYLLABICS HK",
-	"ᕾ": "CANADIAN SYLLABICS QAAI",
-	"ᕿ": "CANADIAN SYLLABICS QI",
-	"ᖀ": "CANADIAN SYLLABICS QII",
-	"ᖁ": "CANADIAN SYLLABICS QO",
-	"ᖂ": "CANADIAN SYLLABICS QOO",
-	"ᖃ": "CANADIAN SYLLABICS QA",
-	"ᖄ": "CANADIAN SYLLABICS QAA",
-	"ᖅ": "CANADIAN SYLLABICS Q",
-	"ᖆ·": "CANADIAN SYLLABICS TLHE, MIDDLE DOT",
-	"ᖇ": "CANADIAN SYLLABICS TLHI",
-	"ᖎ": "CANADIAN SYLLABICS NGAAI",
-	"ᖏ": "CANADIAN SYLLABICS NGI",
-	"ᖐ": "CANADIAN SYLLABICS NGII",
-	"ᖑ": "CANADIAN SYLLABICS NGO",
-	"ᖒ": "CANADIAN SYLLABICS NGOO",
-	"ᖓ": "CANADIAN SYLLABICS NGA",
-	"ᖔ": "CANADIAN SYLLABICS NGAA",
-	"ᖕJ": "CANADIAN SYLLABICS NG, LATIN CAPITAL LETTER J",
-	"ᖕᒉ": "CANADIAN SYLLABICS NG, CANADIAN SYLLABICS CE",
-	"ᖕᒊ": "CANADIAN SYLLABICS NG, CANADIAN SYLLABICS CAAI",
-	"ᖕᒋ": "CANADIAN SYLLABICS NG, CANADIAN SYLLABICS CI",
-	"ᖕᒌ": "CANADIAN SYLLABICS NG, CANADIAN SYLLABICS CII",
-	"ᖕᒎ": "CANADIAN SYLLABICS NG, CANADIAN SYLLABICS COO",
-	"ᖕᒐ": "CANADIAN SYLLABICS NG, CANADIAN SYLLABICS CA",
-	"ᖕᒑ": "CANADIAN SYLLABICS NG, CANADIAN SYLLABICS CAA",
-	"ᖖJ": "CANADIAN SYLLABICS NNG, LATIN CAPITAL LETTER J",
-	"ᖖᒋ": "CANADIAN SYLLABICS NNG, CANADIAN SYLLABICS CI",
-	"ᖖᒌ": "CANADIAN SYLLABICS NNG, CANADIAN SYLLABICS CII",
-	"ᖖᒎ": "CANADIAN SYLLABICS NNG, CANADIAN SYLLABICS COO",
-	"ᖖᒐ": "CANADIAN SYLLABICS NNG, CANADIAN SYLLABICS CA",
-	"ᖖᒑ": "CANADIAN SYLLABICS NNG, CANADIAN SYLLABICS CAA",
-	"ᖗ·": "CANADIAN SYLLABICS SAYISI SHE, MIDDLE DOT",
-	"ᖧ·": "CANADIAN SYLLABICS TH-CREE THE, MIDDLE DOT",
-	"ᖨ·": "CANADIAN SYLLABICS TH-CREE THI, MIDDLE DOT",
-	"ᖩ·": "CANADIAN SYLLABICS TH-CREE THII, MIDDLE DOT",
-	"ᖪ·": "CANADIAN SYLLABICS TH-CREE THO, MIDDLE DOT",
-	"ᖫ·": "CANADIAN SYLLABICS TH-CREE THOO, MIDDLE DOT",
-	"ᖬ·": "CANADIAN SYLLABICS TH-CREE THA, MIDDLE DOT",
-	"ᖭ·": "CANADIAN SYLLABICS TH-CREE THAA, MIDDLE DOT",
-	"ᖯ": "CANADIAN SYLLABICS AIVILIK B",
-	"ᖴ": "CANADIAN SYLLABICS BLACKFOOT WE",
-	"ᖵ": "CANADIAN SYLLABICS BLACKFOOT WI",
-	"ᖷ": "CANADIAN SYLLABICS BLACKFOOT WA",
-	"ᗄ": "CANADIAN SYLLABICS CARRIER GHU",
-	"ᗅ": "CANADIAN SYLLABICS CARRIER GHO",
-	"ᗒ": "CANADIAN SYLLABICS CARRIER WE",
-	"ᗕ": "CANADIAN SYLLABICS CARRIER WA",
-	"ᗞ": "CANADIAN SYLLABICS CARRIER THE",
-	"ᗡ": "CANADIAN SYLLABICS CARRIER THA",
-	"ᗪ": "CANADIAN SYLLABICS CARRIER PE",
-	"ᗯ": "CANADIAN SYLLABICS CARRIER GU",
-	"ᗰ": "CANADIAN SYLLABICS CARRIER GO",
-	"ᗴ·": "CANADIAN SYLLABICS CARRIER GA, MIDDLE DOT",
-	"ᗷ": "CANADIAN SYLLABICS CARRIER KHE",
-	"ᘂ": "CANADIAN SYLLABICS CARRIER NU",
-	"ᘃ": "CANADIAN SYLLABICS CARRIER NO",
-	"ᘄ": "CANADIAN SYLLABICS CARRIER NE",
-	"ᘇ": "CANADIAN SYLLABICS CARRIER NA",
-	"ᘛ·": "CANADIAN SYLLABICS CARRIER JA, MIDDLE DOT",
-	"ᘢ": "CANADIAN SYLLABICS CARRIER LU",
-	"ᘣ": "CANADIAN SYLLABICS CARRIER LO",
-	"ᘤ": "CANADIAN SYLLABICS CARRIER LE",
-	"ᘮ": "CANADIAN SYLLABICS CARRIER LHU",
-	"ᘯ": "CANADIAN SYLLABICS CARRIER LHO",
-	"ᘴ": "CANADIAN SYLLABICS CARRIER TLHU",
-	"ᘵ": "CANADIAN SYLLABICS CARRIER TLHO",
-	"ᙆ": "CANADIAN SYLLABICS CARRIER Z",
-	"ᙠ": "CANADIAN SYLLABICS CARRIER TSA",
-	"᙭": "CANADIAN SYLLABICS CHI SIGN",
-	"᙮": "CANADIAN SYLLABICS FULL STOP",
-	"ᙯ": "CANADIAN SYLLABICS QAI",
-	"ᙰ": "CANADIAN SYLLABICS NGAI",
-	"ᙱ": "CANADIAN SYLLABICS NNGI",
-	"ᙲ": "CANADIAN SYLLABICS NNGII",
-	"ᙳ": "CANADIAN SYLLABICS NNGO",
-	"ᙴ": "CANADIAN SYLLABICS NNGOO",
-	"ᙵ": "CANADIAN SYLLABICS NNGA",
-	"ᙶ": "CANADIAN SYLLABICS NNGAA",
-	"ᙷ": "CANADIAN SYLLABICS WOODS-CREE THWEE",
-	"ᙸ": "CANADIAN SYLLABICS WOODS-CREE THWI",
-	"ᙹ": "CANADIAN SYLLABICS WOODS-CREE THWII",
-	"ᙺ": "CANADIAN SYLLABICS WOODS-CREE THWO",
-	"ᙻ": "CANADIAN SYLLABICS WOODS-CREE THWOO",
-	"ᙼ": "CANADIAN SYLLABICS WOODS-CREE THWA",
-	"ᙽ": "CANADIAN SYLLABICS WOODS-CREE THWAA",
-	"ᚲ": "RUNIC LETTER KAUNA",
-	"ᚷ": "RUNIC LETTER GEBO GYFU G",
-	"ᚹ": "RUNIC LETTER WUNJO WYNN W",
-	"ᚼ": "RUNIC LETTER LONG-BRANCH-HAGALL H",
-	"ᚽ": "RUNIC LETTER SHORT-TWIG-HAGALL H",
-	"ᛁ": "RUNIC LETTER ISAZ IS ISS I",
-	"ᛂ": "RUNIC LETTER E",
-	"ᛋ": "RUNIC LETTER SIGEL LONG-BRANCH-SOL S",
-	"ᛌ": "RUNIC LETTER SHORT-TWIG-SOL S",
-	"ᛏ": "RUNIC LETTER TIWAZ TIR TYR T",
-	"ᛐ": "RUNIC LETTER SHORT-TWIG-TYR T",
-	"ᛐᛚ": "RUNIC LETTER SHORT-TWIG-TYR T, RUNIC LETTER LAUKAZ LAGU LOGR L",
-	"ᛐ⇂": "RUNIC LETTER SHORT-TWIG-TYR T, DOWNWARDS HARPOON WITH BARB RIGHTWARDS",
-	"ᛕ": "RUNIC LETTER OPEN-P",
-	"ᛖ": "RUNIC LETTER EHWAZ EH E",
-	"ᛘ": "RUNIC LETTER LONG-BRANCH-MADR M",
-	"ᛚ": "RUNIC LETTER LAUKAZ LAGU LOGR L",
-	"ᛜ": "RUNIC LETTER INGWAZ",
-	"ᛜ̲": "RUNIC LETTER INGWAZ, COMBINING LOW LINE",
-	"ᛞ": "RUNIC LETTER DAGAZ DAEG D",
-	"ᛡ": "RUNIC LETTER IOR",
-	"ᛦ": "RUNIC LETTER LONG-BRANCH-YR",
-	"ᛨ": "RUNIC LETTER ICELANDIC-YR",
-	"᛫": "RUNIC SINGLE PUNCTUATION",
-	"᛬": "RUNIC MULTIPLE PUNCTUATION",
-	"᛭": "RUNIC CROSS PUNCTUATION",
-	"ᛯ": "RUNIC TVIMADUR SYMBOL",
-	"ᛰ": "RUNIC BELGTHOR SYMBOL",
-	"᜕": "TAGALOG SIGN PAMUDPOD",
-	"᜴": "HANUNOO SIGN PAMUDPOD",
-	"᜵": "PHILIPPINE SINGLE PUNCTUATION",
-	"អ": "KHMER LETTER QA",
-	"ឣ": "KHMER INDEPENDENT VOWEL QAQ",
-	"ិ": "KHMER VOWEL SIGN I",
-	"ី": "KHMER VOWEL SIGN II",
-	"ឹ": "KHMER VOWEL SIGN Y",
-	"ឺ": "KHMER VOWEL SIGN YY",
-	"ំ": "KHMER SIGN NIKAHIT",
-	"់": "KHMER SIGN BANTOC",
-	"៓": "KHMER SIGN BATHAMASAT",
-	"។": "KHMER SIGN KHAN",
-	"៕": "KHMER SIGN BARIYOOSAN",
-	"៙": "KHMER SIGN PHNAEK MUAN",
-	"៚": "KHMER SIGN KOOMUUT",
-	"᠃": "MONGOLIAN FULL STOP",
-	"᠉": "MONGOLIAN MANCHU FULL STOP",
-	"ᠵ": "MONGOLIAN LETTER JA",
-	"ᡕ": "MONGOLIAN LETTER TODO YA",
-	"ᡜ": "MONGOLIAN LETTER TODO DZA",
-	"ᢖ": "MONGOLIAN LETTER ALI GALI ZA",
-	"ᢳ": "CANADIAN SYLLABICS WAY",
-	"ᢶ": "CANADIAN SYLLABICS PWOY",
-	"ᢹ": "CANADIAN SYLLABICS KWAY",
-	"ᣂ": "CANADIAN SYLLABICS SHWOY",
-	"ᣆ": "CANADIAN SYLLABICS NWI",
-	"ᣇ": "CANADIAN SYLLABICS OJIBWAY NWI",
-	"ᣈ": "CANADIAN SYLLABICS NWII",
-	"ᣉ": "CANADIAN SYLLABICS OJIBWAY NWII",
-	"ᣊ": "CANADIAN SYLLABICS NWO",
-	"ᣋ": "CANADIAN SYLLABICS OJIBWAY NWO",
-	"ᣌ": "CANADIAN SYLLABICS NWOO",
-	"ᣍ": "CANADIAN SYLLABICS OJIBWAY NWOO",
-	"ᣎ": "CANADIAN SYLLABICS RWEE",
-	"ᣏ": "CANADIAN SYLLABICS RWI",
-	"ᣐ": "CANADIAN SYLLABICS RWII",
-	"ᣑ": "CANADIAN SYLLABICS RWO",
-	"ᣒ": "CANADIAN SYLLABICS RWOO",
-	"ᣓ": "CANADIAN SYLLABICS RWA",
-	"ᣔ": "CANADIAN SYLLABICS OJIBWAY P",
-	"ᣖ": "CANADIAN SYLLABICS OJIBWAY K",
-	"ᣛ": "CANADIAN SYLLABICS OJIBWAY SH",
-	"ᣜ": "CANADIAN SYLLABICS EASTERN W",
-	"ᣝ": "CANADIAN SYLLABICS WESTERN W",
-	"ᣟᐞ": "CANADIAN SYLLABICS FINAL RAISED DOT, CANADIAN SYLLABICS GLOTTAL STOP",
-	"ᣠ": "CANADIAN SYLLABICS R-CREE RWE",
-	"ᣣ": "CANADIAN SYLLABICS THWE",
-	"ᣤ": "CANADIAN SYLLABICS THWA",
-	"ᣥ": "CANADIAN SYLLABICS TTHWE",
-	"ᣨ": "CANADIAN SYLLABICS TLHWE",
-	"ᣪ": "CANADIAN SYLLABICS SAYISI SHWE",
-	"ᣭ": "CANADIAN SYLLABICS CARRIER GWU",
-	"ᣰ": "CANADIAN SYLLABICS CARRIER GWA",
-	"ᣲ": "CANADIAN SYLLABICS CARRIER JWA",
-	"ᣳ": "CANADIAN SYLLABICS BEAVER DENE L",
-	"ᣴ": "CANADIAN SYLLABICS BEAVER DENE R",
-	"ᣵ": "CANADIAN SYLLABICS CARRIER DENTAL S",
-	"ᦞ": "NEW TAI LUE LETTER LOW VA",
-	"ᦱ": "NEW TAI LUE VOWEL SIGN AA",
-	"᧐": "NEW TAI LUE DIGIT ZERO",
-	"᧑": "NEW TAI LUE DIGIT ONE",
-	"ᩅ": "TAI THAM LETTER WA",
-	"᪀": "TAI THAM HORA DIGIT ZERO",
-	"᪐": "TAI THAM THAM DIGIT ZERO",
-	"᪨᪨": "TAI THAM SIGN KAAN, TAI THAM SIGN KAAN",
-	"᪩": "TAI THAM SIGN KAANKUU",
-	"᪪᪨": "TAI THAM SIGN SATKAAN, TAI THAM SIGN KAAN",
-	"᪫": "TAI THAM SIGN SATKAANKUU",
-	"᪴": "COMBINING TRIPLE DOT",
-	"᪷": "COMBINING OPEN MARK BELOW",
-	"ᬍ": "BALINESE LETTER LA LENGA",
-	"ᬑ": "BALINESE LETTER OKARA",
-	"ᬨ": "BALINESE LETTER PA KAPAL",
-	"᭐": "BALINESE DIGIT ZERO",
-	"᭒": "BALINESE DIGIT TWO",
-	"᭓": "BALINESE DIGIT THREE",
-	"᭘": "BALINESE DIGIT EIGHT",
-	"᭜": "BALINESE WINDU",
-	"᭞᭞": "BALINESE CARIK SIKI, BALINESE CARIK SIKI",
-	"᭟": "BALINESE CARIK PAREREN",
-	"᰻᰻": "LEPCHA PUNCTUATION TA-ROL, LEPCHA PUNCTUATION TA-ROL",
-	"᰼": "LEPCHA PUNCTUATION NYET THYOOM TA-ROL",
-	"᱾᱾": "OL CHIKI PUNCTUATION MUCAAD, OL CHIKI PUNCTUATION MUCAAD",
-	"᱿": "OL CHIKI PUNCTUATION DOUBLE MUCAAD",
-	"᳐": "VEDIC TONE KARSHANA",
-	"᳒": "VEDIC TONE PRENKHA",
-	"᳓": "VEDIC SIGN NIHSHVASA",
-	"᳕": "VEDIC TONE YAJURVEDIC AGGRAVATED INDEPENDENT SVARITA",
-	"᳘": "VEDIC TONE CANDRA BELOW",
-	"᳙": "VEDIC TONE YAJURVEDIC KATHAKA INDEPENDENT SVARITA SCHROEDER",
-	"᳚": "VEDIC TONE DOUBLE SVARITA",
-	"᳜": "VEDIC TONE KATHAKA ANUDATTA",
-	"᳝": "VEDIC TONE DOT BELOW",
-	"᳞": "VEDIC TONE TWO DOTS BELOW",
-	"᳭": "VEDIC SIGN TIRYAK",
-	"ᴀ": "LATIN LETTER SMALL CAPITAL A",
-	"ᴄ": "LATIN LETTER SMALL CAPITAL C",
-	"ᴅ": "LATIN LETTER SMALL CAPITAL D",
-	"ᴇ": "LATIN LETTER SMALL CAPITAL E",
-	"ᴈ": "LATIN SMALL LETTER TURNED OPEN E",
-	"ᴊ": "LATIN LETTER SMALL CAPITAL J",
-	"ᴋ": "LATIN LETTER SMALL CAPITAL K",
-	"ᴍ": "LATIN LETTER SMALL CAPITAL M",
-	"ᴎ": "LATIN LETTER SMALL CAPITAL REVERSED N",
-	"ᴏ": "LATIN LETTER SMALL CAPITAL O",
-	"ᴐ": "LATIN LETTER SMALL CAPITAL OPEN O",
-	"ᴑ": "LATIN SMALL LETTER SIDEWAYS O",
-	"ᴔ": "LATIN SMALL LETTER TURNED OE",
-	"ᴘ": "LATIN LETTER SMALL CAPITAL P",
-	"ᴙ": "LATIN LETTER SMALL CAPITAL REVERSED R",
-	"ᴛ": "LATIN LETTER SMALL CAPITAL T",
-	"ᴛ̩": "LATIN LETTER SMALL CAPITAL T, COMBINING VERTICAL LINE BELOW",
-	"ᴜ": "LATIN LETTER SMALL CAPITAL U",
-	"ᴠ": "LATIN LETTER SMALL CAPITAL V",
-	"ᴡ": "LATIN LETTER SMALL CAPITAL W",
-	"ᴢ": "LATIN LETTER SMALL CAPITAL Z",
-	"ᴤ": "LATIN LETTER VOICED LARYNGEAL SPIRANT",
-	"ᴦ": "GREEK LETTER SMALL CAPITAL GAMMA",
-	"ᴧ": "GREEK LETTER SMALL CAPITAL LAMDA",
-	"ᴨ": "GREEK LETTER SMALL CAPITAL PI",
-	"ᴩ": "GREEK LETTER SMALL CAPITAL RHO",
-	"ᴫ": "CYRILLIC LETTER SMALL CAPITAL EL",
-	"ᴴ": "MODIFIER LETTER CAPITAL H",
-	"ᴾ": "MODIFIER LETTER CAPITAL P",
-	"ᵋ": "MODIFIER LETTER SMALL OPEN E",
-	"ᵍ": "MODIFIER LETTER SMALL G",
-	"ᵒ": "MODIFIER LETTER SMALL O",
-	"ᵫ": "LATIN SMALL LETTER UE",
-	"ᵮ": "LATIN SMALL LETTER F WITH MIDDLE TILDE",
-	"ᵯ": "LATIN SMALL LETTER M WITH MIDDLE TILDE",
-	"ᵰ": "LATIN SMALL LETTER N WITH MIDDLE TILDE",
-	"ᵲ": "LATIN SMALL LETTER R WITH MIDDLE TILDE",
-	"ᵳ": "LATIN SMALL LETTER R WITH FISHHOOK AND MIDDLE TILDE",
-	"ᵴ": "LATIN SMALL LETTER S WITH MIDDLE TILDE",
-	"ᵵ": "LATIN SMALL LETTER T WITH MIDDLE TILDE",
-	"ᵶ": "LATIN SMALL LETTER Z WITH MIDDLE TILDE",
-	"ᵸ": "MODIFIER LETTER CYRILLIC EN",
-	"ᵻ": "LATIN SMALL CAPITAL LETTER I WITH STROKE",
-	"ᵼ": "LATIN SMALL LETTER IOTA WITH STROKE",
-	"ᵽ": "LATIN SMALL LETTER P WITH STROKE",
-	"ᵾ": "LATIN SMALL CAPITAL LETTER U WITH STROKE",
-	"ᵿ": "LATIN SMALL LETTER UPSILON WITH STROKE",
-	"ᶃ": "LATIN SMALL LETTER G WITH PALATAL HOOK",
-	"ᶌ": "LATIN SMALL LETTER V WITH PALATAL HOOK",
-	"ᶐ": "LATIN SMALL LETTER ALPHA WITH RETROFLEX HOOK",
-	"ᶟ": "MODIFIER LETTER SMALL REVERSED OPEN E",
-	"ᶢ": "MODIFIER LETTER SMALL SCRIPT G",
-	"ᶺ": "MODIFIER LETTER SMALL TURNED V",
-	"ᶻ": "MODIFIER LETTER SMALL Z",
-	"ᷟ": "COMBINING LATIN LETTER SMALL CAPITAL M",
-	"ᷮ": "COMBINING LATIN SMALL LETTER P",
-	"ṃ": "LATIN SMALL LETTER M WITH DOT BELOW",
-	"ẚ": "LATIN SMALL LETTER A WITH RIGHT HALF RING",
-	"ẝ": "LATIN SMALL LETTER LONG S WITH HIGH STROKE",
-	"ẞ": "LATIN CAPITAL LETTER SHARP S",
-	"ẟ": "LATIN SMALL LETTER DELTA",
-	"ả": "LATIN SMALL LETTER A WITH HOOK ABOVE",
-	"ỿ": "LATIN SMALL LETTER Y WITH LOOP",
-	"ώ": "GREEK SMALL LETTER OMEGA WITH OXIA",
-	"᾽": "GREEK KORONIS",
-	"ι": "GREEK PROSGEGRAMMENI",
-	"᾿": "GREEK PSILI",
-	"῀": "GREEK PERISPOMENI",
-	"`": "GREEK VARIA",
-	"ῴ": "GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI",
-	"ῶ": "GREEK SMALL LETTER OMEGA WITH PERISPOMENI",
-	"´": "GREEK OXIA",
-	"῾": "GREEK DASIA",
-	"‐": "HYPHEN",
-	"‑": "NON-BREAKING HYPHEN",
-	"‒": "FIGURE DASH",
-	"–": "EN DASH",
-	"—": "EM DASH",
-	"―": "HORIZONTAL BAR",
-	"‖": "DOUBLE VERTICAL LINE",
-	"‘": "LEFT SINGLE QUOTATION MARK",
-	"’": "RIGHT SINGLE QUOTATION MARK",
-	"‚": "SINGLE LOW-9 QUOTATION MARK",
-	"‛": "SINGLE HIGH-REVERSED-9 QUOTATION MARK",
-	"“": "LEFT DOUBLE QUOTATION MARK",
-	"”": "RIGHT DOUBLE QUOTATION MARK",
-	"‟": "DOUBLE HIGH-REVERSED-9 QUOTATION MARK",
-	"•": "BULLET",
-	"․": "ONE DOT LEADER",
-	"‥": "TWO DOT LEADER",
-	"…": "HORIZONTAL ELLIPSIS",
-	"‧": "HYPHENATION POINT",
-	"‰": "PER MILLE SIGN",
-	"‱": "PER TEN THOUSAND SIGN",
-	"′": "PRIME",
-	"″": "DOUBLE PRIME",
-	"‴": "TRIPLE PRIME",
-	"‵": "REVERSED PRIME",
-	"‶": "REVERSED DOUBLE PRIME",
-	"‷": "REVERSED TRIPLE PRIME",
-	"‹": "SINGLE LEFT-POINTING ANGLE QUOTATION MARK",
-	"›": "SINGLE RIGHT-POINTING ANGLE QUOTATION MARK",
-	"‼": "DOUBLE EXCLAMATION MARK",
-	"‾": "OVERLINE",
-	"⁁": "CARET INSERTION POINT",
-	"⁃": "HYPHEN BULLET",
-	"⁄": "FRACTION SLASH",
-	"⁇": "DOUBLE QUESTION MARK",
-	"⁈": "QUESTION EXCLAMATION MARK",
-	"⁉": "EXCLAMATION QUESTION MARK",
-	"⁎": "LOW ASTERISK",
-	"⁒": "COMMERCIAL MINUS SIGN",
-	"⁓": "SWUNG DASH",
-	"⁗": "QUADRUPLE PRIME",
-	"⁚": "TWO DOT PUNCTUATION",
-	"⁝": "TRICOLON",
-	"⁞": "VERTICAL FOUR DOTS",
-	"⁰": "SUPERSCRIPT ZERO",
-	"⁹": "SUPERSCRIPT NINE",
-	"₁₀": "SUBSCRIPT ONE, SUBSCRIPT ZERO",
-	"₡": "COLON SIGN",
-	"₤": "LIRA SIGN",
-	"₥": "MILL SIGN",
-	"₨": "RUPEE SIGN",
-	"₩": "WON SIGN",
-	"₫": "DONG SIGN",
-	"€": "EURO SIGN",
-	"₭": "KIP SIGN",
-	"₮": "TUGRIK SIGN",
-	"₶": "LIVRE TOURNOIS SIGN",
-	"₸": "TENGE SIGN",
-	"₽": "RUBLE SIGN",
-	"⃛": "COMBINING THREE DOTS ABOVE",
-	"⃩": "COMBINING WIDE BRIDGE ABOVE",
-	"℀": "ACCOUNT OF",
-	"℁": "ADDRESSED TO THE SUBJECT",
-	"ℂ": "DOUBLE-STRUCK CAPITAL C",
-	"℃": "DEGREE CELSIUS",
-	"℅": "CARE OF",
-	"℆": "CADA UNA",
-	"ℇ": "EULER CONSTANT",
-	"℈": "SCRUPLE",
-	"℉": "DEGREE FAHRENHEIT",
-	"ℊ": "SCRIPT SMALL G",
-	"ℋ": "SCRIPT CAPITAL H",
-	"ℌ": "BLACK-LETTER CAPITAL H",
-	"ℍ": "DOUBLE-STRUCK CAPITAL H",
-	"ℎ": "PLANCK CONSTANT",
-	"ℏ": "PLANCK CONSTANT OVER TWO PI",
-	"ℐ": "SCRIPT CAPITAL I",
-	"ℑ": "BLACK-LETTER CAPITAL I",
-	"ℒ": "SCRIPT CAPITAL L",
-	"ℓ": "SCRIPT SMALL L",
-	"ℕ": "DOUBLE-STRUCK CAPITAL N",
-	"№": "NUMERO SIGN",
-	"℗": "SOUND RECORDING COPYRIGHT",
-	"ℙ": "DOUBLE-STRUCK CAPITAL P",
-	"ℚ": "DOUBLE-STRUCK CAPITAL Q",
-	"ℛ": "SCRIPT CAPITAL R",
-	"ℜ": "BLACK-LETTER CAPITAL R",
-	"ℝ": "DOUBLE-STRUCK CAPITAL R",
-	"℡": "TELEPHONE SIGN",
-	"ℤ": "DOUBLE-STRUCK CAPITAL Z",
-	"Ω": "OHM SIGN",
-	"℧": "INVERTED OHM SIGN",
-	"ℨ": "BLACK-LETTER CAPITAL Z",
-	"℩": "TURNED GREEK SMALL LETTER IOTA",
-	"K": "KELVIN SIGN",
-	"ℬ": "SCRIPT CAPITAL B",
-	"ℭ": "BLACK-LETTER CAPITAL C",
-	"℮": "ESTIMATED SYMBOL",
-	"ℯ": "SCRIPT SMALL E",
-	"ℰ": "SCRIPT CAPITAL E",
-	"ℱ": "SCRIPT CAPITAL F",
-	"Ⅎ": "TURNED CAPITAL F",
-	"ℳ": "SCRIPT CAPITAL M",
-	"ℴ": "SCRIPT SMALL O",
-	"ℵ": "ALEF SYMBOL",
-	"ℶ": "BET SYMBOL",
-	"ℷ": "GIMEL SYMBOL",
-	"ℸ": "DALET SYMBOL",
-	"ℹ": "INFORMATION SOURCE",
-	"℻": "FACSIMILE SIGN",
-	"ℼ": "DOUBLE-STRUCK SMALL PI",
-	"ℽ": "DOUBLE-STRUCK SMALL GAMMA",
-	"ℾ": "DOUBLE-STRUCK CAPITAL GAMMA",
-	"ℿ": "DOUBLE-STRUCK CAPITAL PI",
-	"⅀": "DOUBLE-STRUCK N-ARY SUMMATION",
-	"⅁": "TURNED SANS-SERIF CAPITAL G",
-	"⅂": "TURNED SANS-SERIF CAPITAL L",
-	"⅃": "REVERSED SANS-SERIF CAPITAL L",
-	"⅄": "TURNED SANS-SERIF CAPITAL Y",
-	"ⅅ": "DOUBLE-STRUCK ITALIC CAPITAL D",
-	"ⅆ": "DOUBLE-STRUCK ITALIC SMALL D",
-	"ⅇ": "DOUBLE-STRUCK ITALIC SMALL E",
-	"ⅈ": "DOUBLE-STRUCK ITALIC SMALL I",
-	"ⅉ": "DOUBLE-STRUCK ITALIC SMALL J",
-	"Ⅰ": "ROMAN NUMERAL ONE",
-	"Ⅱ": "ROMAN NUMERAL TWO",
-	"Ⅲ": "ROMAN NUMERAL THREE",
-	"Ⅳ": "ROMAN NUMERAL FOUR",
-	"Ⅴ": "ROMAN NUMERAL FIVE",
-	"Ⅵ": "ROMAN NUMERAL SIX",
-	"Ⅶ": "ROMAN NUMERAL SEVEN",
-	"Ⅷ": "ROMAN NUMERAL EIGHT",
-	"Ⅸ": "ROMAN NUMERAL NINE",
-	"Ⅹ": "ROMAN NUMERAL TEN",
-	"Ⅺ": "ROMAN NUMERAL ELEVEN",
-	"Ⅻ": "ROMAN NUMERAL TWELVE",
-	"Ⅼ": "ROMAN NUMERAL FIFTY",
-	"Ⅽ": "ROMAN NUMERAL ONE HUNDRED",
-	"Ⅾ": "ROMAN NUMERAL FIVE HUNDRED",
-	"Ⅿ": "ROMAN NUMERAL ONE THOUSAND",
-	"ⅰ": "SMALL ROMAN NUMERAL ONE",
-	"ⅱ": "SMALL ROMAN NUMERAL TWO",
-	"ⅲ": "SMALL ROMAN NUMERAL THREE",
-	"ⅳ": "SMALL ROMAN NUMERAL FOUR",
-	"ⅴ": "SMALL ROMAN NUMERAL FIVE",
-	"ⅵ": "SMALL ROMAN NUMERAL SIX",
-	"ⅶ": "SMALL ROMAN NUMERAL SEVEN",
-	"ⅷ": "SMALL ROMAN NUMERAL EIGHT",
-	"ⅸ": "SMALL ROMAN NUMERAL NINE",
-	"ⅹ": "SMALL ROMAN NUMERAL TEN",
-	"ⅺ": "SMALL ROMAN NUMERAL ELEVEN",
-	"ⅻ": "SMALL ROMAN NUMERAL TWELVE",
-	"ⅼ": "SMALL ROMAN NUMERAL FIFTY",
-	"ⅽ": "SMALL ROMAN NUMERAL ONE HUNDRED",
-	"ⅾ": "SMALL ROMAN NUMERAL FIVE HUNDRED",
-	"ⅿ": "SMALL ROMAN NUMERAL ONE THOUSAND",
-	"Ↄ": "ROMAN NUMERAL REVERSED ONE HUNDRED",
-	"ↄ": "LATIN SMALL LETTER REVERSED C",
-	"↑": "UPWARDS ARROW",
-	"↕": "UP DOWN ARROW",
-	"↞": "LEFTWARDS TWO HEADED ARROW",
-	"↟": "UPWARDS TWO HEADED ARROW",
-	"↠": "RIGHTWARDS TWO HEADED ARROW",
-	"↡": "DOWNWARDS TWO HEADED ARROW",
-	"↲": "DOWNWARDS ARROW WITH TIP LEFTWARDS",
-	"↵": "DOWNWARDS ARROW WITH CORNER LEFTWARDS",
-	"↺": "ANTICLOCKWISE OPEN CIRCLE ARROW",
-	"↾": "UPWARDS HARPOON WITH BARB RIGHTWARDS",
-	"↿": "UPWARDS HARPOON WITH BARB LEFTWARDS",
-	"⇃ᛚ": "DOWNWARDS HARPOON WITH BARB LEFTWARDS, RUNIC LETTER LAUKAZ LAGU LOGR L",
-	"⇃⇂": "DOWNWARDS HARPOON WITH BARB LEFTWARDS, DOWNWARDS HARPOON WITH BARB RIGHTWARDS",
-	"∀": "FOR ALL",
-	"∂": "PARTIAL DIFFERENTIAL",
-	"∂̵": "PARTIAL DIFFERENTIAL, COMBINING SHORT STROKE OVERLAY",
-	"∃": "THERE EXISTS",
-	"∅": "EMPTY SET",
-	"∆": "INCREMENT",
-	"∇": "NABLA",
-	"∇̈": "NABLA, COMBINING DIAERESIS",
-	"∇̴": "NABLA, COMBINING TILDE OVERLAY",
-	"∎": "END OF PROOF",
-	"∏": "N-ARY PRODUCT",
-	"∐": "N-ARY COPRODUCT",
-	"∑": "N-ARY SUMMATION",
-	"−": "MINUS SIGN",
-	"∔": "DOT PLUS",
-	"∕": "DIVISION SLASH",
-	"∖": "SET MINUS",
-	"∗": "ASTERISK OPERATOR",
-	"∘": "RING OPERATOR",
-	"∙": "BULLET OPERATOR",
-	"∞": "INFINITY",
-	"∠": "ANGLE",
-	"∣": "DIVIDES",
-	"∥": "PARALLEL TO",
-	"∧": "LOGICAL AND",
-	"∨": "LOGICAL OR",
-	"∩": "INTERSECTION",
-	"∪": "UNION",
-	"∫": "INTEGRAL",
-	"∬": "DOUBLE INTEGRAL",
-	"∭": "TRIPLE INTEGRAL",
-	"∮∮": "CONTOUR INTEGRAL, CONTOUR INTEGRAL",
-	"∮∮∮": "CONTOUR INTEGRAL, CONTOUR INTEGRAL, CONTOUR INTEGRAL",
-	"∯": "SURFACE INTEGRAL",
-	"∰": "VOLUME INTEGRAL",
-	"∴": "THEREFORE",
-	"∵": "BECAUSE",
-	"∶": "RATIO",
-	"∷": "PROPORTION",
-	"∸": "DOT MINUS",
-	"∼": "TILDE OPERATOR",
-	"≈": "ALMOST EQUAL TO",
-	"≏": "DIFFERENCE BETWEEN",
-	"≐": "APPROACHES THE LIMIT",
-	"≑": "GEOMETRICALLY EQUAL TO",
-	"≗": "RING EQUAL TO",
-	"≙": "ESTIMATES",
-	"≚": "EQUIANGULAR TO",
-	"≞": "MEASURED BY",
-	"≡": "IDENTICAL TO",
-	"≣": "STRICTLY EQUIVALENT TO",
-	"≪": "MUCH LESS-THAN",
-	"≫": "MUCH GREATER-THAN",
-	"⊂": "SUBSET OF",
-	"⊃": "SUPERSET OF",
-	"⊍": "MULTISET MULTIPLICATION",
-	"⊎": "MULTISET UNION",
-	"⊏": "SQUARE IMAGE OF",
-	"⊐": "SQUARE ORIGINAL OF",
-	"⊓": "SQUARE CAP",
-	"⊔": "SQUARE CUP",
-	"⊕": "CIRCLED PLUS",
-	"⊖": "CIRCLED MINUS",
-	"⊗": "CIRCLED TIMES",
-	"⊙": "CIRCLED DOT OPERATOR",
-	"⊛": "CIRCLED ASTERISK OPERATOR",
-	"⊝": "CIRCLED DASH",
-	"⊠": "SQUARED TIMES",
-	"⊡": "SQUARED DOT OPERATOR",
-	"⊤": "DOWN TACK",
-	"⊥": "UP TACK",
-	"⊲": "NORMAL SUBGROUP OF",
-	"⊳": "CONTAINS AS NORMAL SUBGROUP",
-	"⋀": "N-ARY LOGICAL AND",
-	"⋁": "N-ARY LOGICAL OR",
-	"⋂": "N-ARY INTERSECTION",
-	"⋃": "N-ARY UNION",
-	"⋄": "DIAMOND OPERATOR",
-	"⋅": "DOT OPERATOR",
-	"⋆̈": "STAR OPERATOR, COMBINING DIAERESIS",
-	"⋈": "BOWTIE",
-	"⋖": "LESS-THAN WITH DOT",
-	"⋗": "GREATER-THAN WITH DOT",
-	"⋘": "VERY MUCH LESS-THAN",
-	"⋙": "VERY MUCH GREATER-THAN",
-	"⋮": "VERTICAL ELLIPSIS",
-	"⋯": "MIDLINE HORIZONTAL ELLIPSIS",
-	"⋴": "SMALL ELEMENT OF WITH VERTICAL BAR AT END OF HORIZONTAL STROKE",
-	"⋿": "Z NOTATION BAG MEMBERSHIP",
-	"⌀": "DIAMETER SIGN",
-	"⌇": "WAVY LINE",
-	"⌒": "ARC",
-	"⌙": "TURNED NOT SIGN",
-	"⌤": "UP ARROWHEAD BETWEEN TWO HORIZONTAL BARS",
-	"⌥": "OPTION KEY",
-	"〈": "LEFT-POINTING ANGLE BRACKET",
-	"〉": "RIGHT-POINTING ANGLE BRACKET",
-	"⌻": "APL FUNCTIONAL SYMBOL QUAD JOT",
-	"⌾": "APL FUNCTIONAL SYMBOL CIRCLE JOT",
-	"⍁": "APL FUNCTIONAL SYMBOL QUAD SLASH",
-	"⍂": "APL FUNCTIONAL SYMBOL QUAD BACKSLASH",
-	"⍉": "APL FUNCTIONAL SYMBOL CIRCLE BACKSLASH",
-	"⍋": "APL FUNCTIONAL SYMBOL DELTA STILE",
-	"⍎": "APL FUNCTIONAL SYMBOL DOWN TACK JOT",
-	"⍕": "APL FUNCTIONAL SYMBOL UP TACK JOT",
-	"⍙": "APL FUNCTIONAL SYMBOL DELTA UNDERBAR",
-	"⍚": "APL FUNCTIONAL SYMBOL DIAMOND UNDERBAR",
-	"⍜": "APL FUNCTIONAL SYMBOL CIRCLE UNDERBAR",
-	"⍟": "APL FUNCTIONAL SYMBOL CIRCLE STAR",
-	"⍡": "APL FUNCTIONAL SYMBOL UP TACK DIAERESIS",
-	"⍢": "APL FUNCTIONAL SYMBOL DEL DIAERESIS",
-	"⍣": "APL FUNCTIONAL SYMBOL STAR DIAERESIS",
-	"⍤": "APL FUNCTIONAL SYMBOL JOT DIAERESIS",
-	"⍥": "APL FUNCTIONAL SYMBOL CIRCLE DIAERESIS",
-	"⍨": "APL FUNCTIONAL SYMBOL TILDE DIAERESIS",
-	"⍩": "APL FUNCTIONAL SYMBOL GREATER-THAN DIAERESIS",
-	"⍫": "APL FUNCTIONAL SYMBOL DEL TILDE",
-	"⍬": "APL FUNCTIONAL SYMBOL ZILDE",
-	"⍭": "APL FUNCTIONAL SYMBOL STILE TILDE",
-	"⍳": "APL FUNCTIONAL SYMBOL IOTA",
-	"⍴": "APL FUNCTIONAL SYMBOL RHO",
-	"⍵": "APL FUNCTIONAL SYMBOL OMEGA",
-	"⍶": "APL FUNCTIONAL SYMBOL ALPHA UNDERBAR",
-	"⍷": "APL FUNCTIONAL SYMBOL EPSILON UNDERBAR",
-	"⍸": "APL FUNCTIONAL SYMBOL IOTA UNDERBAR",
-	"⍹": "APL FUNCTIONAL SYMBOL OMEGA UNDERBAR",
-	"⍺": "APL FUNCTIONAL SYMBOL ALPHA",
-	"⍿": "VERTICAL LINE WITH MIDDLE DOT",
-	"⎈": "HELM SYMBOL",
-	"⎜": "LEFT PARENTHESIS EXTENSION",
-	"⎟": "RIGHT PARENTHESIS EXTENSION",
-	"⎢": "LEFT SQUARE BRACKET EXTENSION",
-	"⎥": "RIGHT SQUARE BRACKET EXTENSION",
-	"⎪": "CURLY BRACKET EXTENSION",
-	"⎮": "INTEGRAL EXTENSION",
-	"⏁": "DENTISTRY SYMBOL LIGHT DOWN AND HORIZONTAL WITH CIRCLE",
-	"⏂": "DENTISTRY SYMBOL LIGHT UP AND HORIZONTAL WITH CIRCLE",
-	"⏃": "DENTISTRY SYMBOL LIGHT VERTICAL WITH TRIANGLE",
-	"⏆": "DENTISTRY SYMBOL LIGHT VERTICAL AND WAVE",
-	"⏜": "TOP PARENTHESIS",
-	"⏝": "BOTTOM PARENTHESIS",
-	"⏞": "TOP CURLY BRACKET",
-	"⏟": "BOTTOM CURLY BRACKET",
-	"⏠": "TOP TORTOISE SHELL BRACKET",
-	"⏡": "BOTTOM TORTOISE SHELL BRACKET",
-	"⏥": "FLATNESS",
-	"⏨": "DECIMAL EXPONENT SYMBOL",
-	"⏻": "POWER SYMBOL",
-	"⏼": "POWER ON-OFF SYMBOL",
-	"⏽": "POWER ON SYMBOL",
-	"⏾": "POWER SLEEP SYMBOL",
-	"⑊": "OCR DOUBLE BACKSLASH",
-	"①": "CIRCLED DIGIT ONE",
-	"②": "CIRCLED DIGIT TWO",
-	"③": "CIRCLED DIGIT THREE",
-	"④": "CIRCLED DIGIT FOUR",
-	"⑤": "CIRCLED DIGIT FIVE",
-	"⑥": "CIRCLED DIGIT SIX",
-	"⑦": "CIRCLED DIGIT SEVEN",
-	"⑧": "CIRCLED DIGIT EIGHT",
-	"⑨": "CIRCLED DIGIT NINE",
-	"⑩": "CIRCLED NUMBER TEN",
-	"⑪": "CIRCLED NUMBER ELEVEN",
-	"⑫": "CIRCLED NUMBER TWELVE",
-	"⑬": "CIRCLED NUMBER THIRTEEN",
-	"⑭": "CIRCLED NUMBER FOURTEEN",
-	"⑮": "CIRCLED NUMBER FIFTEEN",
-	"⑯": "CIRCLED NUMBER SIXTEEN",
-	"⑰": "CIRCLED NUMBER SEVENTEEN",
-	"⑱": "CIRCLED NUMBER EIGHTEEN",
-	"⑲": "CIRCLED NUMBER NINETEEN",
-	"⑳": "CIRCLED NUMBER TWENTY",
-	"⑴": "PARENTHESIZED DIGIT ONE",
-	"⑵": "PARENTHESIZED DIGIT TWO",
-	"⑶": "PARENTHESIZED DIGIT THREE",
-	"⑷": "PARENTHESIZED DIGIT FOUR",
-	"⑸": "PARENTHESIZED DIGIT FIVE",
-	"⑹": "PARENTHESIZED DIGIT SIX",
-	"⑺": "PARENTHESIZED DIGIT SEVEN",
-	"⑻": "PARENTHESIZED DIGIT EIGHT",
-	"⑼": "PARENTHESIZED DIGIT NINE",
-	"⑽": "PARENTHESIZED NUMBER TEN",
-	"⑾": "PARENTHESIZED NUMBER ELEVEN",
-	"⑿": "PARENTHESIZED NUMBER TWELVE",
-	"⒀": "PARENTHESIZED NUMBER THIRTEEN",
-	"⒁": "PARENTHESIZED NUMBER FOURTEEN",
-	"⒂": "PARENTHESIZED NUMBER FIFTEEN",
-	"⒃": "PARENTHESIZED NUMBER SIXTEEN",
-	"⒄": "PARENTHESIZED NUMBER SEVENTEEN",
-	"⒅": "PARENTHESIZED NUMBER EIGHTEEN",
-	"⒆": "PARENTHESIZED NUMBER NINETEEN",
-	"⒇": "PARENTHESIZED NUMBER TWENTY",
-	"⒈": "DIGIT ONE FULL STOP",
-	"⒉": "DIGIT TWO FULL STOP",
-	"⒊": "DIGIT THREE FULL STOP",
-	"⒋": "DIGIT FOUR FULL STOP",
-	"⒌": "DIGIT FIVE FULL STOP",
-	"⒍": "DIGIT SIX FULL STOP",
-	"⒎": "DIGIT SEVEN FULL STOP",
-	"⒏": "DIGIT EIGHT FULL STOP",
-	"⒐": "DIGIT NINE FULL STOP",
-	"⒑": "NUMBER TEN FULL STOP",
-	"⒒": "NUMBER ELEVEN FULL STOP",
-	"⒓": "NUMBER TWELVE FULL STOP",
-	"⒔": "NUMBER THIRTEEN FULL STOP",
-	"⒕": "NUMBER FOURTEEN FULL STOP",
-	"⒖": "NUMBER FIFTEEN FULL STOP",
-	"⒗": "NUMBER SIXTEEN FULL STOP",
-	"⒘": "NUMBER SEVENTEEN FULL STOP",
-	"⒙": "NUMBER EIGHTEEN FULL STOP",
-	"⒚": "NUMBER NINETEEN FULL STOP",
-	"⒛": "NUMBER TWENTY FULL STOP",
-	"⒜": "PARENTHESIZED LATIN SMALL LETTER A",
-	"⒝": "PARENTHESIZED LATIN SMALL LETTER B",
-	"⒞": "PARENTHESIZED LATIN SMALL LETTER C",
-	"⒟": "PARENTHESIZED LATIN SMALL LETTER D",
-	"⒠": "PARENTHESIZED LATIN SMALL LETTER E",
-	"⒡": "PARENTHESIZED LATIN SMALL LETTER F",
-	"⒢": "PARENTHESIZED LATIN SMALL LETTER G",
-	"⒣": "PARENTHESIZED LATIN SMALL LETTER H",
-	"⒤": "PARENTHESIZED LATIN SMALL LETTER I",
-	"⒥": "PARENTHESIZED LATIN SMALL LETTER J",
-	"⒦": "PARENTHESIZED LATIN SMALL LETTER K",
-	"⒧": "PARENTHESIZED LATIN SMALL LETTER L",
-	"⒨": "PARENTHESIZED LATIN SMALL LETTER M",
-	"⒩": "PARENTHESIZED LATIN SMALL LETTER N",
-	"⒪": "PARENTHESIZED LATIN SMALL LETTER O",
-	"⒫": "PARENTHESIZED LATIN SMALL LETTER P",
-	"⒬": "PARENTHESIZED LATIN SMALL LETTER Q",
-	"⒭": "PARENTHESIZED LATIN SMALL LETTER R",
-	"⒮": "PARENTHESIZED LATIN SMALL LETTER S",
-	"⒯": "PARENTHESIZED LATIN SMALL LETTER T",
-	"⒰": "PARENTHESIZED LATIN SMALL LETTER U",
-	"⒱": "PARENTHESIZED LATIN SMALL LETTER V",
-	"⒲": "PARENTHESIZED LATIN SMALL LETTER W",
-	"⒳": "PARENTHESIZED LATIN SMALL LETTER X",
-	"⒴": "PARENTHESIZED LATIN SMALL LETTER Y",
-	"⒵": "PARENTHESIZED LATIN SMALL LETTER Z",
-	"Ⓒ": "CIRCLED LATIN CAPITAL LETTER C",
-	"Ⓘ": "CIRCLED LATIN CAPITAL LETTER I",
-	"Ⓟ": "CIRCLED LATIN CAPITAL LETTER P",
-	"Ⓡ": "CIRCLED LATIN CAPITAL LETTER R",
-	"ⓛ": "CIRCLED LATIN SMALL LETTER L",
-	"⓪": "CIRCLED DIGIT ZERO",
-	"⓫": "NEGATIVE CIRCLED NUMBER ELEVEN",
-	"⓬": "NEGATIVE CIRCLED NUMBER TWELVE",
-	"⓭": "NEGATIVE CIRCLED NUMBER THIRTEEN",
-	"⓮": "NEGATIVE CIRCLED NUMBER FOURTEEN",
-	"⓯": "NEGATIVE CIRCLED NUMBER FIFTEEN",
-	"⓰": "NEGATIVE CIRCLED NUMBER SIXTEEN",
-	"⓱": "NEGATIVE CIRCLED NUMBER SEVENTEEN",
-	"⓲": "NEGATIVE CIRCLED NUMBER EIGHTEEN",
-	"⓳": "NEGATIVE CIRCLED NUMBER NINETEEN",
-	"⓴": "NEGATIVE CIRCLED NUMBER TWENTY",
-	"⓵": "DOUBLE CIRCLED DIGIT ONE",
-	"⓶": "DOUBLE CIRCLED DIGIT TWO",
-	"⓷": "DOUBLE CIRCLED DIGIT THREE",
-	"⓸": "DOUBLE CIRCLED DIGIT FOUR",
-	"⓹": "DOUBLE CIRCLED DIGIT FIVE",
-	"⓺": "DOUBLE CIRCLED DIGIT SIX",
-	"⓻": "DOUBLE CIRCLED DIGIT SEVEN",
-	"⓼": "DOUBLE CIRCLED DIGIT EIGHT",
-	"⓽": "DOUBLE CIRCLED DIGIT NINE",
-	"⓾": "DOUBLE CIRCLED NUMBER TEN",
-	"⓿": "NEGATIVE CIRCLED DIGIT ZERO",
-	"─": "BOX DRAWINGS LIGHT HORIZONTAL",
-	"━": "BOX DRAWINGS HEAVY HORIZONTAL",
-	"│": "BOX DRAWINGS LIGHT VERTICAL",
-	"┃": "BOX DRAWINGS HEAVY VERTICAL",
-	"┌": "BOX DRAWINGS LIGHT DOWN AND RIGHT",
-	"┏": "BOX DRAWINGS HEAVY DOWN AND RIGHT",
-	"├": "BOX DRAWINGS LIGHT VERTICAL AND RIGHT",
-	"┣": "BOX DRAWINGS HEAVY VERTICAL AND RIGHT",
-	"╱": "BOX DRAWINGS LIGHT DIAGONAL UPPER RIGHT TO LOWER LEFT",
-	"╳": "BOX DRAWINGS LIGHT DIAGONAL CROSS",
-	"█": "FULL BLOCK",
-	"▌": "LEFT HALF BLOCK",
-	"▐": "RIGHT HALF BLOCK",
-	"▔": "UPPER ONE EIGHTH BLOCK",
-	"▖": "QUADRANT LOWER LEFT",
-	"▗": "QUADRANT LOWER RIGHT",
-	"▘": "QUADRANT UPPER LEFT",
-	"▝": "QUADRANT UPPER RIGHT",
-	"■": "BLACK SQUARE",
-	"□": "WHITE SQUARE",
-	"▪": "BLACK SMALL SQUARE",
-	"▱": "WHITE PARALLELOGRAM",
-	"△": "WHITE UP-POINTING TRIANGLE",
-	"▶": "BLACK RIGHT-POINTING TRIANGLE",
-	"▷": "WHITE RIGHT-POINTING TRIANGLE",
-	"▸": "BLACK RIGHT-POINTING SMALL TRIANGLE",
-	"►": "BLACK RIGHT-POINTING POINTER",
-	"▽": "WHITE DOWN-POINTING TRIANGLE",
-	"◁": "WHITE LEFT-POINTING TRIANGLE",
-	"◇": "WHITE DIAMOND",
-	"◊": "LOZENGE",
-	"○": "WHITE CIRCLE",
-	"◎": "BULLSEYE",
-	"◠": "UPPER HALF CIRCLE",
-	"◦": "WHITE BULLET",
-	"☉": "SUN",
-	"☐": "BALLOT BOX",
-	"☥": "ANKH",
-	"☧": "CHI RHO",
-	"☩": "CROSS OF JERUSALEM",
-	"☰": "TRIGRAM FOR HEAVEN",
-	"☸": "WHEEL OF DHARMA",
-	"☽": "FIRST QUARTER MOON",
-	"☾": "LAST QUARTER MOON",
-	"♎": "LIBRA",
-	"♢": "WHITE DIAMOND SUIT",
-	"♩": "QUARTER NOTE",
-	"♪": "EIGHTH NOTE",
-	"⚬": "MEDIUM SMALL WHITE CIRCLE",
-	"❨": "MEDIUM LEFT PARENTHESIS ORNAMENT",
-	"❩": "MEDIUM RIGHT PARENTHESIS ORNAMENT",
-	"❬": "MEDIUM LEFT-POINTING ANGLE BRACKET ORNAMENT",
-	"❭": "MEDIUM RIGHT-POINTING ANGLE BRACKET ORNAMENT",
-	"❮": "HEAVY LEFT-POINTING ANGLE QUOTATION MARK ORNAMENT",
-	"❯": "HEAVY RIGHT-POINTING ANGLE QUOTATION MARK ORNAMENT",
-	"❲": "LIGHT LEFT TORTOISE SHELL BRACKET ORNAMENT",
-	"❳": "LIGHT RIGHT TORTOISE SHELL BRACKET ORNAMENT",
-	"❴": "MEDIUM LEFT CURLY BRACKET ORNAMENT",
-	"❵": "MEDIUM RIGHT CURLY BRACKET ORNAMENT",
-	"❶": "DINGBAT NEGATIVE CIRCLED DIGIT ONE",
-	"❷": "DINGBAT NEGATIVE CIRCLED DIGIT TWO",
-	"❸": "DINGBAT NEGATIVE CIRCLED DIGIT THREE",
-	"❹": "DINGBAT NEGATIVE CIRCLED DIGIT FOUR",
-	"❺": "DINGBAT NEGATIVE CIRCLED DIGIT FIVE",
-	"❻": "DINGBAT NEGATIVE CIRCLED DIGIT SIX",
-	"❼": "DINGBAT NEGATIVE CIRCLED DIGIT SEVEN",
-	"❽": "DINGBAT NEGATIVE CIRCLED DIGIT EIGHT",
-	"❾": "DINGBAT NEGATIVE CIRCLED DIGIT NINE",
-	"❿": "DINGBAT NEGATIVE CIRCLED NUMBER TEN",
-	"➀": "DINGBAT CIRCLED SANS-SERIF DIGIT ONE",
-	"➁": "DINGBAT CIRCLED SANS-SERIF DIGIT TWO",
-	"➂": "DINGBAT CIRCLED SANS-SERIF DIGIT THREE",
-	"➃": "DINGBAT CIRCLED SANS-SERIF DIGIT FOUR",
-	"➄": "DINGBAT CIRCLED SANS-SERIF DIGIT FIVE",
-	"➅": "DINGBAT CIRCLED SANS-SERIF DIGIT SIX",
-	"➆": "DINGBAT CIRCLED SANS-SERIF DIGIT SEVEN",
-	"➇": "DINGBAT CIRCLED SANS-SERIF DIGIT EIGHT",
-	"➈": "DINGBAT CIRCLED SANS-SERIF DIGIT NINE",
-	"➉": "DINGBAT CIRCLED SANS-SERIF NUMBER TEN",
-	"➊": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT ONE",
-	"➋": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT TWO",
-	"➌": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT THREE",
-	"➍": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT FOUR",
-	"➎": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT FIVE",
-	"➏": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT SIX",
-	"➐": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT SEVEN",
-	"➑": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT EIGHT",
-	"➒": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT NINE",
-	"➓": "DINGBAT NEGATIVE CIRCLED SANS-SERIF NUMBER TEN",
-	"➕": "HEAVY PLUS SIGN",
-	"➖": "HEAVY MINUS SIGN",
-	"➗": "HEAVY DIVISION SIGN",
-	"⟂": "PERPENDICULAR",
-	"⟈": "REVERSE SOLIDUS PRECEDING SUBSET",
-	"⟉": "SUPERSET PRECEDING SOLIDUS",
-	"⟋": "MATHEMATICAL RISING DIAGONAL",
-	"⟍": "MATHEMATICAL FALLING DIAGONAL",
-	"⟙": "LARGE DOWN TACK",
-	"⟦": "MATHEMATICAL LEFT WHITE SQUARE BRACKET",
-	"⟧": "MATHEMATICAL RIGHT WHITE SQUARE BRACKET",
-	"⟨": "MATHEMATICAL LEFT ANGLE BRACKET",
-	"⟩": "MATHEMATICAL RIGHT ANGLE BRACKET",
-	"⤫": "RISING DIAGONAL CROSSING FALLING DIAGONAL",
-	"⤬": "FALLING DIAGONAL CROSSING RISING DIAGONAL",
-	"⥣": "UPWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT",
-	"⥥": "DOWNWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT",
-	"⥮": "UPWARDS HARPOON WITH BARB LEFT BESIDE DOWNWARDS HARPOON WITH BARB RIGHT",
-	"⥯": "DOWNWARDS HARPOON WITH BARB LEFT BESIDE UPWARDS HARPOON WITH BARB RIGHT",
-	"⦙": "DOTTED FENCE",
-	"⦚": "VERTICAL ZIGZAG LINE",
-	"⦰": "REVERSED EMPTY SET",
-	"⦾": "CIRCLED WHITE BULLET",
-	"⧄": "SQUARED RISING DIAGONAL SLASH",
-	"⧅": "SQUARED FALLING DIAGONAL SLASH",
-	"⧇": "SQUARED SMALL CIRCLE",
-	"⧖": "WHITE HOURGLASS",
-	"⧙": "RIGHT WIGGLY FENCE",
-	"⧟": "DOUBLE-ENDED MULTIMAP",
-	"⧴": "RULE-DELAYED",
-	"⧵": "REVERSE SOLIDUS OPERATOR",
-	"⧶": "SOLIDUS WITH OVERBAR",
-	"⧸": "BIG SOLIDUS",
-	"⧹": "BIG REVERSE SOLIDUS",
-	"⨀": "N-ARY CIRCLED DOT OPERATOR",
-	"⨁": "N-ARY CIRCLED PLUS OPERATOR",
-	"⨂": "N-ARY CIRCLED TIMES OPERATOR",
-	"⨃": "N-ARY UNION OPERATOR WITH DOT",
-	"⨄": "N-ARY UNION OPERATOR WITH PLUS",
-	"⨅": "N-ARY SQUARE INTERSECTION OPERATOR",
-	"⨆": "N-ARY SQUARE UNION OPERATOR",
-	"⨌": "QUADRUPLE INTEGRAL OPERATOR",
-	"⨝": "JOIN",
-	"⨟": "Z NOTATION SCHEMA COMPOSITION",
-	"⨠": "Z NOTATION SCHEMA PIPING",
-	"⨡": "Z NOTATION SCHEMA PROJECTION",
-	"⨢": "PLUS SIGN WITH SMALL CIRCLE ABOVE",
-	"⨣": "PLUS SIGN WITH CIRCUMFLEX ACCENT ABOVE",
-	"⨤": "PLUS SIGN WITH TILDE ABOVE",
-	"⨥": "PLUS SIGN WITH DOT BELOW",
-	"⨦": "PLUS SIGN WITH TILDE BELOW",
-	"⨧": "PLUS SIGN WITH SUBSCRIPT TWO",
-	"⨩": "MINUS SIGN WITH COMMA ABOVE",
-	"⨪": "MINUS SIGN WITH DOT BELOW",
-	"⨯": "VECTOR OR CROSS PRODUCT",
-	"⨰": "MULTIPLICATION SIGN WITH DOT ABOVE",
-	"⨽": "RIGHTHAND INTERIOR PRODUCT",
-	"⨾": "Z NOTATION RELATIONAL COMPOSITION",
-	"⨿": "AMALGAMATION OR COPRODUCT",
-	"⩪": "TILDE OPERATOR WITH DOT ABOVE",
-	"⩮": "EQUALS WITH ASTERISK",
-	"⩴": "DOUBLE COLON EQUAL",
-	"⩵": "TWO CONSECUTIVE EQUALS SIGNS",
-	"⩶": "THREE CONSECUTIVE EQUALS SIGNS",
-	"⪥": "GREATER-THAN BESIDE LESS-THAN",
-	"⪪": "SMALLER THAN",
-	"⪫": "LARGER THAN",
-	"⫗": "SUPERSET BESIDE SUBSET",
-	"⫻": "TRIPLE SOLIDUS BINARY RELATION",
-	"⫽": "DOUBLE SOLIDUS OPERATOR",
-	"⯬": "LEFTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS",
-	"⯭": "UPWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS",
-	"⯮": "RIGHTWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS",
-	"⯯": "DOWNWARDS TWO-HEADED ARROW WITH TRIANGLE ARROWHEADS",
-	"ⰿ": "GLAGOLITIC SMALL LETTER MYSLITE",
-	"Ⱨ": "LATIN CAPITAL LETTER H WITH DESCENDER",
-	"Ⱪ": "LATIN CAPITAL LETTER K WITH DESCENDER",
-	"Ɐ": "LATIN CAPITAL LETTER TURNED A",
-	"Ɒ": "LATIN CAPITAL LETTER TURNED ALPHA",
-	"Ⱶ": "LATIN CAPITAL LETTER HALF H",
-	"ⱶ": "LATIN SMALL LETTER HALF H",
-	"Ⲅ": "COPTIC CAPITAL LETTER GAMMA",
-	"ⲅ": "COPTIC SMALL LETTER GAMMA",
-	"Ⲇ": "COPTIC CAPITAL LETTER DALDA",
-	"Ⲉ": "COPTIC CAPITAL LETTER EIE",
-	"ⲉ": "COPTIC SMALL LETTER EIE",
-	"Ⲏ": "COPTIC CAPITAL LETTER HATE",
-	"Ⲓ": "COPTIC CAPITAL LETTER IAUDA",
-	"Ⲕ": "COPTIC CAPITAL LETTER KAPA",
-	"ⲕ": "COPTIC SMALL LETTER KAPA",
-	"Ⲗ": "COPTIC CAPITAL LETTER LAULA",
-	"Ⲙ": "COPTIC CAPITAL LETTER MI",
-	"Ⲛ": "COPTIC CAPITAL LETTER NI",
-	"Ⲟ": "COPTIC CAPITAL LETTER O",
-	"ⲟ": "COPTIC SMALL LETTER O",
-	"Ⲡ": "COPTIC CAPITAL LETTER PI",
-	"Ⲣ": "COPTIC CAPITAL LETTER RO",
-	"ⲣ": "COPTIC SMALL LETTER RO",
-	"Ⲥ": "COPTIC CAPITAL LETTER SIMA",
-	"ⲥ": "COPTIC SMALL LETTER SIMA",
-	"Ⲧ": "COPTIC CAPITAL LETTER TAU",
-	"Ⲩ": "COPTIC CAPITAL LETTER UA",
-	"Ⲫ": "COPTIC CAPITAL LETTER FI",
-	"ⲫ": "COPTIC SMALL LETTER FI",
-	"Ⲭ": "COPTIC CAPITAL LETTER KHI",
-	"ⲭ": "COPTIC SMALL LETTER KHI",
-	"Ⲯ": "COPTIC CAPITAL LETTER PSI",
-	"ⲱ": "COPTIC SMALL LETTER OOU",
-	"Ⲵ": "COPTIC CAPITAL LETTER OLD COPTIC AIN",
-	"Ⲷ": "COPTIC CAPITAL LETTER CRYPTOGRAMMIC EIE",
-	"Ⲻ": "COPTIC CAPITAL LETTER DIALECT-P NI",
-	"Ⲽ": "COPTIC CAPITAL LETTER CRYPTOGRAMMIC NI",
-	"ⲽ": "COPTIC SMALL LETTER CRYPTOGRAMMIC NI",
-	"Ⳇ": "COPTIC CAPITAL LETTER OLD COPTIC ESH",
-	"Ⳋ": "COPTIC CAPITAL LETTER DIALECT-P HORI",
-	"Ⳍ": "COPTIC CAPITAL LETTER OLD COPTIC HORI",
-	"ⳍ": "COPTIC SMALL LETTER OLD COPTIC HORI",
-	"Ⳑ": "COPTIC CAPITAL LETTER L-SHAPED HA",
-	"ⳑ": "COPTIC SMALL LETTER L-SHAPED HA",
-	"Ⳓ": "COPTIC CAPITAL LETTER OLD COPTIC HEI",
-	"Ⳝ": "COPTIC CAPITAL LETTER OLD NUBIAN SHIMA",
-	"ⳤ": "COPTIC SYMBOL KAI",
-	"⳨": "COPTIC SYMBOL TAU RO",
-	"⳩": "COPTIC SYMBOL KHI RO",
-	"⳹": "COPTIC OLD NUBIAN FULL STOP",
-	"ⴱ": "TIFINAGH LETTER YAB",
-	"ⴷ": "TIFINAGH LETTER YAD",
-	"ⴸ": "TIFINAGH LETTER YADH",
-	"ⴹ": "TIFINAGH LETTER YADD",
-	"ⴺ": "TIFINAGH LETTER YADDH",
-	"ⵀ": "TIFINAGH LETTER YAH",
-	"ⵁ": "TIFINAGH LETTER BERBER ACADEMY YAH",
-	"ⵂ": "TIFINAGH LETTER TUAREG YAH",
-	"ⵈ": "TIFINAGH LETTER TUAREG YAQ",
-	"ⵉ": "TIFINAGH LETTER YI",
-	"ⵏ": "TIFINAGH LETTER YAN",
-	"ⵑ": "TIFINAGH LETTER TUAREG YANG",
-	"ⵔ": "TIFINAGH LETTER YAR",
-	"ⵕ": "TIFINAGH LETTER YARR",
-	"ⵗ": "TIFINAGH LETTER TUAREG YAGH",
-	"ⵙ": "TIFINAGH LETTER YAS",
-	"ⵝ": "TIFINAGH LETTER YATH",
-	"ⵠ": "TIFINAGH LETTER YAV",
-	"ⵣ": "TIFINAGH LETTER YAZ",
-	"ⷨ": "COMBINING CYRILLIC LETTER EM",
-	"ⷪ": "COMBINING CYRILLIC LETTER O",
-	"ⷬ": "COMBINING CYRILLIC LETTER ER",
-	"ⷭ": "COMBINING CYRILLIC LETTER ES",
-	"ⷯ": "COMBINING CYRILLIC LETTER HA",
-	"ⷶ": "COMBINING CYRILLIC LETTER A",
-	"ⷷ": "COMBINING CYRILLIC LETTER IE",
-	"⸚": "HYPHEN WITH DIAERESIS",
-	"⸞": "TILDE WITH DOT ABOVE",
-	"⸟": "TILDE WITH DOT BELOW",
-	"⸦": "LEFT SIDEWAYS U BRACKET",
-	"⸧": "RIGHT SIDEWAYS U BRACKET",
-	"⸨": "LEFT DOUBLE PARENTHESIS",
-	"⸩": "RIGHT DOUBLE PARENTHESIS",
-	"⸪": "TWO DOTS OVER ONE DOT PUNCTUATION",
-	"⸫": "ONE DOT OVER TWO DOTS PUNCTUATION",
-	"⸬": "SQUARED FOUR DOT PUNCTUATION",
-	"⸮": "REVERSED QUESTION MARK",
-	"⸰": "RING POINT",
-	"⸱": "WORD SEPARATOR MIDDLE DOT",
-	"⸲": "TURNED COMMA",
-	"⸵": "TURNED SEMICOLON",
-	"⸹": "TOP HALF SECTION SIGN",
-	"⸽": "VERTICAL SIX DOTS",
-	"⸿": "CAPITULUM",
-	"⹀": "DOUBLE HYPHEN",
-	"⺂": "CJK RADICAL SECOND ONE",
-	"⺃": "CJK RADICAL SECOND TWO",
-	"⺅": "CJK RADICAL PERSON",
-	"⺉": "CJK RADICAL KNIFE TWO",
-	"⺋": "CJK RADICAL SEAL",
-	"⺎": "CJK RADICAL LAME ONE",
-	"⺏": "CJK RADICAL LAME TWO",
-	"⺐": "CJK RADICAL LAME THREE",
-	"⺒": "CJK RADICAL SNAKE",
-	"⺓": "CJK RADICAL THREAD",
-	"⺔": "CJK RADICAL SNOUT ONE",
-	"⺖": "CJK RADICAL HEART ONE",
-	"⺗": "CJK RADICAL HEART TWO",
-	"⺘": "CJK RADICAL HAND",
-	"⺙": "CJK RADICAL RAP",
-	"⺛": "CJK RADICAL CHOKE",
-	"⺞": "CJK RADICAL DEATH",
-	"⺟": "CJK RADICAL MOTHER",
-	"⺠": "CJK RADICAL CIVILIAN",
-	"⺡": "CJK RADICAL WATER ONE",
-	"⺢": "CJK RADICAL WATER TWO",
-	"⺣": "CJK RADICAL FIRE",
-	"⺤": "CJK RADICAL PAW ONE",
-	"⺦": "CJK RADICAL SIMPLIFIED HALF TREE TRUNK",
-	"⺨": "CJK RADICAL DOG",
-	"⺫": "CJK RADICAL EYE",
-	"⺭": "CJK RADICAL SPIRIT TWO",
-	"⺯": "CJK RADICAL SILK",
-	"⺱": "CJK RADICAL NET ONE",
-	"⺲": "CJK RADICAL NET TWO",
-	"⺹": "CJK RADICAL OLD",
-	"⺺": "CJK RADICAL BRUSH ONE",
-	"⺾": "CJK RADICAL GRASS ONE",
-	"⺿": "CJK RADICAL GRASS TWO",
-	"⻀": "CJK RADICAL GRASS THREE",
-	"⻁": "CJK RADICAL TIGER",
-	"⻂": "CJK RADICAL CLOTHES",
-	"⻃": "CJK RADICAL WEST ONE",
-	"⻄": "CJK RADICAL WEST TWO",
-	"⻅": "CJK RADICAL C-SIMPLIFIED SEE",
-	"⻈": "CJK RADICAL C-SIMPLIFIED SPEECH",
-	"⻉": "CJK RADICAL C-SIMPLIFIED SHELL",
-	"⻋": "CJK RADICAL C-SIMPLIFIED CART",
-	"⻌": "CJK RADICAL SIMPLIFIED WALK",
-	"⻍": "CJK RADICAL WALK ONE",
-	"⻏": "CJK RADICAL CITY",
-	"⻐": "CJK RADICAL C-SIMPLIFIED GOLD",
-	"⻑": "CJK RADICAL LONG ONE",
-	"⻒": "CJK RADICAL LONG TWO",
-	"⻓": "CJK RADICAL C-SIMPLIFIED LONG",
-	"⻔": "CJK RADICAL C-SIMPLIFIED GATE",
-	"⻖": "CJK RADICAL MOUND TWO",
-	"⻘": "CJK RADICAL BLUE",
-	"⻙": "CJK RADICAL C-SIMPLIFIED TANNED LEATHER",
-	"⻚": "CJK RADICAL C-SIMPLIFIED LEAF",
-	"⻛": "CJK RADICAL C-SIMPLIFIED WIND",
-	"⻜": "CJK RADICAL C-SIMPLIFIED FLY",
-	"⻝": "CJK RADICAL EAT ONE",
-	"⻟": "CJK RADICAL EAT THREE",
-	"⻠": "CJK RADICAL C-SIMPLIFIED EAT",
-	"⻢": "CJK RADICAL C-SIMPLIFIED HORSE",
-	"⻤": "CJK RADICAL GHOST",
-	"⻥": "CJK RADICAL C-SIMPLIFIED FISH",
-	"⻨": "CJK RADICAL SIMPLIFIED WHEAT",
-	"⻩": "CJK RADICAL SIMPLIFIED YELLOW",
-	"⻫": "CJK RADICAL J-SIMPLIFIED EVEN",
-	"⻬": "CJK RADICAL C-SIMPLIFIED EVEN",
-	"⻭": "CJK RADICAL J-SIMPLIFIED TOOTH",
-	"⻮": "CJK RADICAL C-SIMPLIFIED TOOTH",
-	"⻯": "CJK RADICAL J-SIMPLIFIED DRAGON",
-	"⻰": "CJK RADICAL C-SIMPLIFIED DRAGON",
-	"⻲": "CJK RADICAL J-SIMPLIFIED TURTLE",
-	"⻳": "CJK RADICAL C-SIMPLIFIED TURTLE",
-	"⼀": "KANGXI RADICAL ONE",
-	"⼁": "KANGXI RADICAL LINE",
-	"⼂": "KANGXI RADICAL DOT",
-	"⼃": "KANGXI RADICAL SLASH",
-	"⼄": "KANGXI RADICAL SECOND",
-	"⼅": "KANGXI RADICAL HOOK",
-	"⼆": "KANGXI RADICAL TWO",
-	"⼇": "KANGXI RADICAL LID",
-	"⼈": "KANGXI RADICAL MAN",
-	"⼉": "KANGXI RADICAL LEGS",
-	"⼊": "KANGXI RADICAL ENTER",
-	"⼋": "KANGXI RADICAL EIGHT",
-	"⼌": "KANGXI RADICAL DOWN BOX",
-	"⼍": "KANGXI RADICAL COVER",
-	"⼎": "KANGXI RADICAL ICE",
-	"⼏": "KANGXI RADICAL TABLE",
-	"⼐": "KANGXI RADICAL OPEN BOX",
-	"⼑": "KANGXI RADICAL KNIFE",
-	"⼒": "KANGXI RADICAL POWER",
-	"⼓": "KANGXI RADICAL WRAP",
-	"⼔": "KANGXI RADICAL SPOON",
-	"⼕": "KANGXI RADICAL RIGHT OPEN BOX",
-	"⼖": "KANGXI RADICAL HIDING ENCLOSURE",
-	"⼗": "KANGXI RADICAL TEN",
-	"⼘": "KANGXI RADICAL DIVINATION",
-	"⼙": "KANGXI RADICAL SEAL",
-	"⼚": "KANGXI RADICAL CLIFF",
-	"⼛": "KANGXI RADICAL PRIVATE",
-	"⼜": "KANGXI RADICAL AGAIN",
-	"⼝": "KANGXI RADICAL MOUTH",
-	"⼞": "KANGXI RADICAL ENCLOSURE",
-	"⼟": "KANGXI RADICAL EARTH",
-	"⼠": "KANGXI RADICAL SCHOLAR",
-	"⼡": "KANGXI RADICAL GO",
-	"⼢": "KANGXI RADICAL GO SLOWLY",
-	"⼣": "KANGXI RADICAL EVENING",
-	"⼤": "KANGXI RADICAL BIG",
-	"⼥": "KANGXI RADICAL WOMAN",
-	"⼦": "KANGXI RADICAL CHILD",
-	"⼧": "KANGXI RADICAL ROOF",
-	"⼨": "KANGXI RADICAL INCH",
-	"⼩": "KANGXI RADICAL SMALL",
-	"⼪": "KANGXI RADICAL LAME",
-	"⼫": "KANGXI RADICAL CORPSE",
-	"⼬": "KANGXI RADICAL SPROUT",
-	"⼭": "KANGXI RADICAL MOUNTAIN",
-	"⼮": "KANGXI RADICAL RIVER",
-	"⼯": "KANGXI RADICAL WORK",
-	"⼰": "KANGXI RADICAL ONESELF",
-	"⼱": "KANGXI RADICAL TURBAN",
-	"⼲": "KANGXI RADICAL DRY",
-	"⼳": "KANGXI RADICAL SHORT THREAD",
-	"⼴": "KANGXI RADICAL DOTTED CLIFF",
-	"⼵": "KANGXI RADICAL LONG STRIDE",
-	"⼶": "KANGXI RADICAL TWO HANDS",
-	"⼷": "KANGXI RADICAL SHOOT",
-	"⼸": "KANGXI RADICAL BOW",
-	"⼹": "KANGXI RADICAL SNOUT",
-	"⼺": "KANGXI RADICAL BRISTLE",
-	"⼻": "KANGXI RADICAL STEP",
-	"⼼": "KANGXI RADICAL HEART",
-	"⼽": "KANGXI RADICAL HALBERD",
-	"⼾": "KANGXI RADICAL DOOR",
-	"⼿": "KANGXI RADICAL HAND",
-	"⽀": "KANGXI RADICAL BRANCH",
-	"⽁": "KANGXI RADICAL RAP",
-	"⽂": "KANGXI RADICAL SCRIPT",
-	"⽃": "KANGXI RADICAL DIPPER",
-	"⽄": "KANGXI RADICAL AXE",
-	"⽅": "KANGXI RADICAL SQUARE",
-	"⽆": "KANGXI RADICAL NOT",
-	"⽇": "KANGXI RADICAL SUN",
-	"⽈": "KANGXI RADICAL SAY",
-	"⽉": "KANGXI RADICAL MOON",
-	"⽊": "KANGXI RADICAL TREE",
-	"⽋": "KANGXI RADICAL LACK",
-	"⽌": "KANGXI RADICAL STOP",
-	"⽍": "KANGXI RADICAL DEATH",
-	"⽎": "KANGXI RADICAL WEAPON",
-	"⽏": "KANGXI RADICAL DO NOT",
-	"⽐": "KANGXI RADICAL COMPARE",
-	"⽑": "KANGXI RADICAL FUR",
-	"⽒": "KANGXI RADICAL CLAN",
-	"⽓": "KANGXI RADICAL STEAM",
-	"⽔": "KANGXI RADICAL WATER",
-	"⽕": "KANGXI RADICAL FIRE",
-	"⽖": "KANGXI RADICAL CLAW",
-	"⽗": "KANGXI RADICAL FATHER",
-	"⽘": "KANGXI RADICAL DOUBLE X",
-	"⽙": "KANGXI RADICAL HALF TREE TRUNK",
-	"⽚": "KANGXI RADICAL SLICE",
-	"⽛": "KANGXI RADICAL FANG",
-	"⽜": "KANGXI RADICAL COW",
-	"⽝": "KANGXI RADICAL DOG",
-	"⽞": "KANGXI RADICAL PROFOUND",
-	"⽟": "KANGXI RADICAL JADE",
-	"⽠": "KANGXI RADICAL MELON",
-	"⽡": "KANGXI RADICAL TILE",
-	"⽢": "KANGXI RADICAL SWEET",
-	"⽣": "KANGXI RADICAL LIFE",
-	"⽤": "KANGXI RADICAL USE",
-	"⽥": "KANGXI RADICAL FIELD",
-	"⽦": "KANGXI RADICAL BOLT OF CLOTH",
-	"⽧": "KANGXI RADICAL SICKNESS",
-	"⽨": "KANGXI RADICAL DOTTED TENT",
-	"⽩": "KANGXI RADICAL WHITE",
-	"⽪": "KANGXI RADICAL SKIN",
-	"⽫": "KANGXI RADICAL DISH",
-	"⽬": "KANGXI RADICAL EYE",
-	"⽭": "KANGXI RADICAL SPEAR",
-	"⽮": "KANGXI RADICAL ARROW",
-	"⽯": "KANGXI RADICAL STONE",
-	"⽰": "KANGXI RADICAL SPIRIT",
-	"⽱": "KANGXI RADICAL TRACK",
-	"⽲": "KANGXI RADICAL GRAIN",
-	"⽳": "KANGXI RADICAL CAVE",
-	"⽴": "KANGXI RADICAL STAND",
-	"⽵": "KANGXI RADICAL BAMBOO",
-	"⽶": "KANGXI RADICAL RICE",
-	"⽷": "KANGXI RADICAL SILK",
-	"⽸": "KANGXI RADICAL JAR",
-	"⽹": "KANGXI RADICAL NET",
-	"⽺": "KANGXI RADICAL SHEEP",
-	"⽻": "KANGXI RADICAL FEATHER",
-	"⽼": "KANGXI RADICAL OLD",
-	"⽽": "KANGXI RADICAL AND",
-	"⽾": "KANGXI RADICAL PLOW",
-	"⽿": "KANGXI RADICAL EAR",
-	"⾀": "KANGXI RADICAL BRUSH",
-	"⾁": "KANGXI RADICAL MEAT",
-	"⾂": "KANGXI RADICAL MINISTER",
-	"⾃": "KANGXI RADICAL SELF",
-	"⾄": "KANGXI RADICAL ARRIVE",
-	"⾅": "KANGXI RADICAL MORTAR",
-	"⾆": "KANGXI RADICAL TONGUE",
-	"⾇": "KANGXI RADICAL OPPOSE",
-	"⾈": "KANGXI RADICAL BOAT",
-	"⾉": "KANGXI RADICAL STOPPING",
-	"⾊": "KANGXI RADICAL COLOR",
-	"⾋": "KANGXI RADICAL GRASS",
-	"⾌": "KANGXI RADICAL TIGER",
-	"⾍": "KANGXI RADICAL INSECT",
-	"⾎": "KANGXI RADICAL BLOOD",
-	"⾏": "KANGXI RADICAL WALK ENCLOSURE",
-	"⾐": "KANGXI RADICAL CLOTHES",
-	"⾑": "KANGXI RADICAL WEST",
-	"⾒": "KANGXI RADICAL SEE",
-	"⾓": "KANGXI RADICAL HORN",
-	"⾔": "KANGXI RADICAL SPEECH",
-	"⾕": "KANGXI RADICAL VALLEY",
-	"⾖": "KANGXI RADICAL BEAN",
-	"⾗": "KANGXI RADICAL PIG",
-	"⾘": "KANGXI RADICAL BADGER",
-	"⾙": "KANGXI RADICAL SHELL",
-	"⾚": "KANGXI RADICAL RED",
-	"⾛": "KANGXI RADICAL RUN",
-	"⾜": "KANGXI RADICAL FOOT",
-	"⾝": "KANGXI RADICAL BODY",
-	"⾞": "KANGXI RADICAL CART",
-	"⾟": "KANGXI RADICAL BITTER",
-	"⾠": "KANGXI RADICAL MORNING",
-	"⾡": "KANGXI RADICAL WALK",
-	"⾢": "KANGXI RADICAL CITY",
-	"⾣": "KANGXI RADICAL WINE",
-	"⾤": "KANGXI RADICAL DISTINGUISH",
-	"⾥": "KANGXI RADICAL VILLAGE",
-	"⾦": "KANGXI RADICAL GOLD",
-	"⾧": "KANGXI RADICAL LONG",
-	"⾨": "KANGXI RADICAL GATE",
-	"⾩": "KANGXI RADICAL MOUND",
-	"⾪": "KANGXI RADICAL SLAVE",
-	"⾫": "KANGXI RADICAL SHORT TAILED BIRD",
-	"⾬": "KANGXI RADICAL RAIN",
-	"⾭": "KANGXI RADICAL BLUE",
-	"⾮": "KANGXI RADICAL WRONG",
-	"⾯": "KANGXI RADICAL FACE",
-	"⾰": "KANGXI RADICAL LEATHER",
-	"⾱": "KANGXI RADICAL TANNED LEATHER",
-	"⾲": "KANGXI RADICAL LEEK",
-	"⾳": "KANGXI RADICAL SOUND",
-	"⾴": "KANGXI RADICAL LEAF",
-	"⾵": "KANGXI RADICAL WIND",
-	"⾶": "KANGXI RADICAL FLY",
-	"⾷": "KANGXI RADICAL EAT",
-	"⾸": "KANGXI RADICAL HEAD",
-	"⾹": "KANGXI RADICAL FRAGRANT",
-	"⾺": "KANGXI RADICAL HORSE",
-	"⾻": "KANGXI RADICAL BONE",
-	"⾼": "KANGXI RADICAL TALL",
-	"⾽": "KANGXI RADICAL HAIR",
-	"⾾": "KANGXI RADICAL FIGHT",
-	"⾿": "KANGXI RADICAL SACRIFICIAL WINE",
-	"⿀": "KANGXI RADICAL CAULDRON",
-	"⿁": "KANGXI RADICAL GHOST",
-	"⿂": "KANGXI RADICAL FISH",
-	"⿃": "KANGXI RADICAL BIRD",
-	"⿄": "KANGXI RADICAL SALT",
-	"⿅": "KANGXI RADICAL DEER",
-	"⿆": "KANGXI RADICAL WHEAT",
-	"⿇": "KANGXI RADICAL HEMP",
-	"⿈": "KANGXI RADICAL YELLOW",
-	"⿉": "KANGXI RADICAL MILLET",
-	"⿊": "KANGXI RADICAL BLACK",
-	"⿋": "KANGXI RADICAL EMBROIDERY",
-	"⿌": "KANGXI RADICAL FROG",
-	"⿍": "KANGXI RADICAL TRIPOD",
-	"⿎": "KANGXI RADICAL DRUM",
-	"⿏": "KANGXI RADICAL RAT",
-	"⿐": "KANGXI RADICAL NOSE",
-	"⿑": "KANGXI RADICAL EVEN",
-	"⿒": "KANGXI RADICAL TOOTH",
-	"⿓": "KANGXI RADICAL DRAGON",
-	"⿔": "KANGXI RADICAL TURTLE",
-	"⿕": "KANGXI RADICAL FLUTE",
-	"。": "IDEOGRAPHIC FULL STOP",
-	"〃": "DITTO MARK",
-	"〇": "IDEOGRAPHIC NUMBER ZERO",
-	"〈": "LEFT ANGLE BRACKET",
-	"〉": "RIGHT ANGLE BRACKET",
-	"〒": "POSTAL MARK",
-	"〔": "LEFT TORTOISE SHELL BRACKET",
-	"〕": "RIGHT TORTOISE SHELL BRACKET",
-	"〚": "LEFT WHITE SQUARE BRACKET",
-	"〛": "RIGHT WHITE SQUARE BRACKET",
-	"〜": "WAVE DASH",
-	"〬": "IDEOGRAPHIC DEPARTING TONE MARK",
-	"〭": "IDEOGRAPHIC ENTERING TONE MARK",
-	"〳": "VERTICAL KANA REPEAT MARK UPPER HALF",
-	"〶": "CIRCLED POSTAL MARK",
-	"〸": "HANGZHOU NUMERAL TEN",
-	"〹": "HANGZHOU NUMERAL TWENTY",
-	"〺": "HANGZHOU NUMERAL THIRTY",
-	"〼": "MASU MARK",
-	"く": "HIRAGANA LETTER KU",
-	"へ": "HIRAGANA LETTER HE",
-	"゚": "COMBINING KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK",
-	"゛": "KATAKANA-HIRAGANA VOICED SOUND MARK",
-	"゜": "KATAKANA-HIRAGANA SEMI-VOICED SOUND MARK",
-	"゠": "KATAKANA-HIRAGANA DOUBLE HYPHEN",
-	"イ": "KATAKANA LETTER I",
-	"エ": "KATAKANA LETTER E",
-	"カ": "KATAKANA LETTER KA",
-	"タ": "KATAKANA LETTER TA",
-	"ト": "KATAKANA LETTER TO",
-	"ニ": "KATAKANA LETTER NI",
-	"ノ": "KATAKANA LETTER NO",
-	"ハ": "KATAKANA LETTER HA",
-	"ヘ": "KATAKANA LETTER HE",
-	"ロ": "KATAKANA LETTER RO",
-	"・": "KATAKANA MIDDLE DOT",
-	"ー": "KATAKANA-HIRAGANA PROLONGED SOUND MARK",
-	"ーᅡ": "KATAKANA-HIRAGANA PROLONGED SOUND MARK, HANGUL JUNGSEONG A",
-	"ーᅥ": "KATAKANA-HIRAGANA PROLONGED SOUND MARK, HANGUL JUNGSEONG EO",
-	"ーᅥ丨": "KATAKANA-HIRAGANA PROLONGED SOUND MARK, HANGUL JUNGSEONG EO, CJK UNIFIED IDEOGRAPH-4E28",
-	"ーᅩ": "KATAKANA-HIRAGANA PROLONGED SOUND MARK, HANGUL JUNGSEONG O",
-	"ーᅮ": "KATAKANA-HIRAGANA PROLONGED SOUND MARK, HANGUL JUNGSEONG U",
-	"ーー": "KATAKANA-HIRAGANA PROLONGED SOUND MARK, KATAKANA-HIRAGANA PROLONGED SOUND MARK",
-	"ー丨": "KATAKANA-HIRAGANA PROLONGED SOUND MARK, CJK UNIFIED IDEOGRAPH-4E28",
-	"ー丨ᅮ": "KATAKANA-HIRAGANA PROLONGED SOUND MARK, CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG U",
-	"ㄦ": "BOPOMOFO LETTER ER",
-	"ㄱ": "HANGUL LETTER KIYEOK",
-	"ㄲ": "HANGUL LETTER SSANGKIYEOK",
-	"ㄳ": "HANGUL LETTER KIYEOK-SIOS",
-	"ㄴ": "HANGUL LETTER NIEUN",
-	"ㄵ": "HANGUL LETTER NIEUN-CIEUC",
-	"ㄶ": "HANGUL LETTER NIEUN-HIEUH",
-	"ㄷ": "HANGUL LETTER TIKEUT",
-	"ㄸ": "HANGUL LETTER SSANGTIKEUT",
-	"ㄹ": "HANGUL LETTER RIEUL",
-	"ㄺ": "HANGUL LETTER RIEUL-KIYEOK",
-	"ㄻ": "HANGUL LETTER RIEUL-MIEUM",
-	"ㄼ": "HANGUL LETTER RIEUL-PIEUP",
-	"ㄽ": "HANGUL LETTER RIEUL-SIOS",
-	"ㄾ": "HANGUL LETTER RIEUL-THIEUTH",
-	"ㄿ": "HANGUL LETTER RIEUL-PHIEUPH",
-	"ㅀ": "HANGUL LETTER RIEUL-HIEUH",
-	"ㅁ": "HANGUL LETTER MIEUM",
-	"ㅂ": "HANGUL LETTER PIEUP",
-	"ㅃ": "HANGUL LETTER SSANGPIEUP",
-	"ㅄ": "HANGUL LETTER PIEUP-SIOS",
-	"ㅅ": "HANGUL LETTER SIOS",
-	"ㅆ": "HANGUL LETTER SSANGSIOS",
-	"ㅇ": "HANGUL LETTER IEUNG",
-	"ㅈ": "HANGUL LETTER CIEUC",
-	"ㅉ": "HANGUL LETTER SSANGCIEUC",
-	"ㅊ": "HANGUL LETTER CHIEUCH",
-	"ㅋ": "HANGUL LETTER KHIEUKH",
-	"ㅌ": "HANGUL LETTER THIEUTH",
-	"ㅍ": "HANGUL LETTER PHIEUPH",
-	"ㅎ": "HANGUL LETTER HIEUH",
-	"ㅏ": "HANGUL LETTER A",
-	"ㅐ": "HANGUL LETTER AE",
-	"ㅑ": "HANGUL LETTER YA",
-	"ㅒ": "HANGUL LETTER YAE",
-	"ㅓ": "HANGUL LETTER EO",
-	"ㅔ": "HANGUL LETTER E",
-	"ㅕ": "HANGUL LETTER YEO",
-	"ㅖ": "HANGUL LETTER YE",
-	"ㅗ": "HANGUL LETTER O",
-	"ㅘ": "HANGUL LETTER WA",
-	"ㅙ": "HANGUL LETTER WAE",
-	"ㅚ": "HANGUL LETTER OE",
-	"ㅛ": "HANGUL LETTER YO",
-	"ㅜ": "HANGUL LETTER U",
-	"ㅝ": "HANGUL LETTER WEO",
-	"ㅞ": "HANGUL LETTER WE",
-	"ㅟ": "HANGUL LETTER WI",
-	"ㅠ": "HANGUL LETTER YU",
-	"ㅡ": "HANGUL LETTER EU",
-	"ㅢ": "HANGUL LETTER YI",
-	"ㅣ": "HANGUL LETTER I",
-	"ㅤ": "HANGUL FILLER",
-	"ㅥ": "HANGUL LETTER SSANGNIEUN",
-	"ㅦ": "HANGUL LETTER NIEUN-TIKEUT",
-	"ㅧ": "HANGUL LETTER NIEUN-SIOS",
-	"ㅨ": "HANGUL LETTER NIEUN-PANSIOS",
-	"ㅩ": "HANGUL LETTER RIEUL-KIYEOK-SIOS",
-	"ㅪ": "HANGUL LETTER RIEUL-TIKEUT",
-	"ㅫ": "HANGUL LETTER RIEUL-PIEUP-SIOS",
-	"ㅬ": "HANGUL LETTER RIEUL-PANSIOS",
-	"ㅭ": "HANGUL LETTER RIEUL-YEORINHIEUH",
-	"ㅮ": "HANGUL LETTER MIEUM-PIEUP",
-	"ㅯ": "HANGUL LETTER MIEUM-SIOS",
-	"ㅰ": "HANGUL LETTER MIEUM-PANSIOS",
-	"ㅱ": "HANGUL LETTER KAPYEOUNMIEUM",
-	"ㅲ": "HANGUL LETTER PIEUP-KIYEOK",
-	"ㅳ": "HANGUL LETTER PIEUP-TIKEUT",
-	"ㅴ": "HANGUL LETTER PIEUP-SIOS-KIYEOK",
-	"ㅵ": "HANGUL LETTER PIEUP-SIOS-TIKEUT",
-	"ㅶ": "HANGUL LETTER PIEUP-CIEUC",
-	"ㅷ": "HANGUL LETTER PIEUP-THIEUTH",
-	"ㅸ": "HANGUL LETTER KAPYEOUNPIEUP",
-	"ㅹ": "HANGUL LETTER KAPYEOUNSSANGPIEUP",
-	"ㅺ": "HANGUL LETTER SIOS-KIYEOK",
-	"ㅻ": "HANGUL LETTER SIOS-NIEUN",
-	"ㅼ": "HANGUL LETTER SIOS-TIKEUT",
-	"ㅽ": "HANGUL LETTER SIOS-PIEUP",
-	"ㅾ": "HANGUL LETTER SIOS-CIEUC",
-	"ㅿ": "HANGUL LETTER PANSIOS",
-	"ㆀ": "HANGUL LETTER SSANGIEUNG",
-	"ㆁ": "HANGUL LETTER YESIEUNG",
-	"ㆂ": "HANGUL LETTER YESIEUNG-SIOS",
-	"ㆃ": "HANGUL LETTER YESIEUNG-PANSIOS",
-	"ㆄ": "HANGUL LETTER KAPYEOUNPHIEUPH",
-	"ㆅ": "HANGUL LETTER SSANGHIEUH",
-	"ㆆ": "HANGUL LETTER YEORINHIEUH",
-	"ㆇ": "HANGUL LETTER YO-YA",
-	"ㆈ": "HANGUL LETTER YO-YAE",
-	"ㆉ": "HANGUL LETTER YO-I",
-	"ㆊ": "HANGUL LETTER YU-YEO",
-	"ㆋ": "HANGUL LETTER YU-YE",
-	"ㆌ": "HANGUL LETTER YU-I",
-	"ㆍ": "HANGUL LETTER ARAEA",
-	"ㆎ": "HANGUL LETTER ARAEAE",
-	"㇐": "CJK STROKE H",
-	"㇑": "CJK STROKE S",
-	"㇓": "CJK STROKE SP",
-	"㇔": "CJK STROKE D",
-	"㇖": "CJK STROKE HG",
-	"㇚": "CJK STROKE SG",
-	"㇛": "CJK STROKE PD",
-	"㇟": "CJK STROKE SWG",
-	"㇠": "CJK STROKE HXWG",
-	"㈀": "PARENTHESIZED HANGUL KIYEOK",
-	"㈁": "PARENTHESIZED HANGUL NIEUN",
-	"㈂": "PARENTHESIZED HANGUL TIKEUT",
-	"㈃": "PARENTHESIZED HANGUL RIEUL",
-	"㈄": "PARENTHESIZED HANGUL MIEUM",
-	"㈅": "PARENTHESIZED HANGUL PIEUP",
-	"㈆": "PARENTHESIZED HANGUL SIOS",
-	"㈇": "PARENTHESIZED HANGUL IEUNG",
-	"㈈": "PARENTHESIZED HANGUL CIEUC",
-	"㈉": "PARENTHESIZED HANGUL CHIEUCH",
-	"㈊": "PARENTHESIZED HANGUL KHIEUKH",
-	"㈋": "PARENTHESIZED HANGUL THIEUTH",
-	"㈌": "PARENTHESIZED HANGUL PHIEUPH",
-	"㈍": "PARENTHESIZED HANGUL HIEUH",
-	"㈎": "PARENTHESIZED HANGUL KIYEOK A",
-	"㈏": "PARENTHESIZED HANGUL NIEUN A",
-	"㈐": "PARENTHESIZED HANGUL TIKEUT A",
-	"㈑": "PARENTHESIZED HANGUL RIEUL A",
-	"㈒": "PARENTHESIZED HANGUL MIEUM A",
-	"㈓": "PARENTHESIZED HANGUL PIEUP A",
-	"㈔": "PARENTHESIZED HANGUL SIOS A",
-	"㈕": "PARENTHESIZED HANGUL IEUNG A",
-	"㈖": "PARENTHESIZED HANGUL CIEUC A",
-	"㈗": "PARENTHESIZED HANGUL CHIEUCH A",
-	"㈘": "PARENTHESIZED HANGUL KHIEUKH A",
-	"㈙": "PARENTHESIZED HANGUL THIEUTH A",
-	"㈚": "PARENTHESIZED HANGUL PHIEUPH A",
-	"㈛": "PARENTHESIZED HANGUL HIEUH A",
-	"㈜": "PARENTHESIZED HANGUL CIEUC U",
-	"㈝": "PARENTHESIZED KOREAN CHARACTER OJEON",
-	"㈞": "PARENTHESIZED KOREAN CHARACTER O HU",
-	"㈠": "PARENTHESIZED IDEOGRAPH ONE",
-	"㈡": "PARENTHESIZED IDEOGRAPH TWO",
-	"㈢": "PARENTHESIZED IDEOGRAPH THREE",
-	"㈣": "PARENTHESIZED IDEOGRAPH FOUR",
-	"㈤": "PARENTHESIZED IDEOGRAPH FIVE",
-	"㈥": "PARENTHESIZED IDEOGRAPH SIX",
-	"㈦": "PARENTHESIZED IDEOGRAPH SEVEN",
-	"㈧": "PARENTHESIZED IDEOGRAPH EIGHT",
-	"㈨": "PARENTHESIZED IDEOGRAPH NINE",
-	"㈩": "PARENTHESIZED IDEOGRAPH TEN",
-	"㈪": "PARENTHESIZED IDEOGRAPH MOON",
-	"㈫": "PARENTHESIZED IDEOGRAPH FIRE",
-	"㈬": "PARENTHESIZED IDEOGRAPH WATER",
-	"㈭": "PARENTHESIZED IDEOGRAPH WOOD",
-	"㈮": "PARENTHESIZED IDEOGRAPH METAL",
-	"㈯": "PARENTHESIZED IDEOGRAPH EARTH",
-	"㈰": "PARENTHESIZED IDEOGRAPH SUN",
-	"㈱": "PARENTHESIZED IDEOGRAPH STOCK",
-	"㈲": "PARENTHESIZED IDEOGRAPH HAVE",
-	"㈳": "PARENTHESIZED IDEOGRAPH SOCIETY",
-	"㈴": "PARENTHESIZED IDEOGRAPH NAME",
-	"㈵": "PARENTHESIZED IDEOGRAPH SPECIAL",
-	"㈶": "PARENTHESIZED IDEOGRAPH FINANCIAL",
-	"㈷": "PARENTHESIZED IDEOGRAPH CONGRATULATION",
-	"㈸": "PARENTHESIZED IDEOGRAPH LABOR",
-	"㈹": "PARENTHESIZED IDEOGRAPH REPRESENT",
-	"㈺": "PARENTHESIZED IDEOGRAPH CALL",
-	"㈻": "PARENTHESIZED IDEOGRAPH STUDY",
-	"㈼": "PARENTHESIZED IDEOGRAPH SUPERVISE",
-	"㈽": "PARENTHESIZED IDEOGRAPH ENTERPRISE",
-	"㈾": "PARENTHESIZED IDEOGRAPH RESOURCE",
-	"㈿": "PARENTHESIZED IDEOGRAPH ALLIANCE",
-	"㉀": "PARENTHESIZED IDEOGRAPH FESTIVAL",
-	"㉁": "PARENTHESIZED IDEOGRAPH REST",
-	"㉂": "PARENTHESIZED IDEOGRAPH SELF",
-	"㉃": "PARENTHESIZED IDEOGRAPH REACH",
-	"㋀": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR JANUARY",
-	"㋁": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR FEBRUARY",
-	"㋂": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR MARCH",
-	"㋃": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR APRIL",
-	"㋄": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR MAY",
-	"㋅": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR JUNE",
-	"㋆": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR JULY",
-	"㋇": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR AUGUST",
-	"㋈": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR SEPTEMBER",
-	"㋉": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR OCTOBER",
-	"㋊": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR NOVEMBER",
-	"㋋": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DECEMBER",
-	"㍘": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ZERO",
-	"㍙": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ONE",
-	"㍚": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWO",
-	"㍛": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR THREE",
-	"㍜": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FOUR",
-	"㍝": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FIVE",
-	"㍞": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SIX",
-	"㍟": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SEVEN",
-	"㍠": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR EIGHT",
-	"㍡": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR NINE",
-	"㍢": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TEN",
-	"㍣": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR ELEVEN",
-	"㍤": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWELVE",
-	"㍥": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR THIRTEEN",
-	"㍦": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FOURTEEN",
-	"㍧": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR FIFTEEN",
-	"㍨": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SIXTEEN",
-	"㍩": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR SEVENTEEN",
-	"㍪": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR EIGHTEEN",
-	"㍫": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR NINETEEN",
-	"㍬": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY",
-	"㍭": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-ONE",
-	"㍮": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-TWO",
-	"㍯": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-THREE",
-	"㍰": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR HOUR TWENTY-FOUR",
-	"㏄\t⃝": "SQUARE CC, <CHARACTER TABULATION>, COMBINING ENCLOSING CIRCLE",
-	"㏠": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ONE",
-	"㏡": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWO",
-	"㏢": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THREE",
-	"㏣": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FOUR",
-	"㏤": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FIVE",
-	"㏥": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SIX",
-	"㏦": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SEVEN",
-	"㏧": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY EIGHT",
-	"㏨": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY NINE",
-	"㏩": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TEN",
-	"㏪": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY ELEVEN",
-	"㏫": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWELVE",
-	"㏬": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTEEN",
-	"㏭": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FOURTEEN",
-	"㏮": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY FIFTEEN",
-	"㏯": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SIXTEEN",
-	"㏰": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY SEVENTEEN",
-	"㏱": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY EIGHTEEN",
-	"㏲": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY NINETEEN",
-	"㏳": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY",
-	"㏴": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-ONE",
-	"㏵": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-TWO",
-	"㏶": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-THREE",
-	"㏷": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-FOUR",
-	"㏸": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-FIVE",
-	"㏹": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-SIX",
-	"㏺": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-SEVEN",
-	"㏻": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-EIGHT",
-	"㏼": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY TWENTY-NINE",
-	"㏽": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTY",
-	"㏾": "IDEOGRAPHIC TELEGRAPH SYMBOL FOR DAY THIRTY-ONE",
-	"㒞": "CJK UNIFIED IDEOGRAPH-349E",
-	"㒹": "CJK UNIFIED IDEOGRAPH-34B9",
-	"㒻": "CJK UNIFIED IDEOGRAPH-34BB",
-	"㓟": "CJK UNIFIED IDEOGRAPH-34DF",
-	"㔕": "CJK UNIFIED IDEOGRAPH-3515",
-	"㔾": "CJK UNIFIED IDEOGRAPH-353E",
-	"㖈": "CJK UNIFIED IDEOGRAPH-3588",
-	"㘽": "CJK UNIFIED IDEOGRAPH-363D",
-	"㛮": "CJK UNIFIED IDEOGRAPH-36EE",
-	"㛼": "CJK UNIFIED IDEOGRAPH-36FC",
-	"㞁": "CJK UNIFIED IDEOGRAPH-3781",
-	"㠯": "CJK UNIFIED IDEOGRAPH-382F",
-	"㡢": "CJK UNIFIED IDEOGRAPH-3862",
-	"㡼": "CJK UNIFIED IDEOGRAPH-387C",
-	"㣇": "CJK UNIFIED IDEOGRAPH-38C7",
-	"㣣": "CJK UNIFIED IDEOGRAPH-38E3",
-	"㣺": "CJK UNIFIED IDEOGRAPH-38FA",
-	"㤜": "CJK UNIFIED IDEOGRAPH-391C",
-	"㤺": "CJK UNIFIED IDEOGRAPH-393A",
-	"㦳": "CJK UNIFIED IDEOGRAPH-39B3",
-	"㨮": "CJK UNIFIED IDEOGRAPH-3A2E",
-	"㩁": "CJK UNIFIED IDEOGRAPH-3A41",
-	"㩬": "CJK UNIFIED IDEOGRAPH-3A6C",
-	"㫚": "CJK UNIFIED IDEOGRAPH-3ADA",
-	"㫤": "CJK UNIFIED IDEOGRAPH-3AE4",
-	"㬈": "CJK UNIFIED IDEOGRAPH-3B08",
-	"㬙": "CJK UNIFIED IDEOGRAPH-3B19",
-	"㬵": "CJK UNIFIED IDEOGRAPH-3B35",
-	"㬺": "CJK UNIFIED IDEOGRAPH-3B3A",
-	"㬻": "CJK UNIFIED IDEOGRAPH-3B3B",
-	"㭉": "CJK UNIFIED IDEOGRAPH-3B49",
-	"㮝": "CJK UNIFIED IDEOGRAPH-3B9D",
-	"㮣": "CJK UNIFIED IDEOGRAPH-3BA3",
-	"㰘": "CJK UNIFIED IDEOGRAPH-3C18",
-	"㱎": "CJK UNIFIED IDEOGRAPH-3C4E",
-	"㴳": "CJK UNIFIED IDEOGRAPH-3D33",
-	"㶖": "CJK UNIFIED IDEOGRAPH-3D96",
-	"㺬": "CJK UNIFIED IDEOGRAPH-3EAC",
-	"㺸": "CJK UNIFIED IDEOGRAPH-3EB8",
-	"㼛": "CJK UNIFIED IDEOGRAPH-3F1B",
-	"㿼": "CJK UNIFIED IDEOGRAPH-3FFC",
-	"䀈": "CJK UNIFIED IDEOGRAPH-4008",
-	"䀘": "CJK UNIFIED IDEOGRAPH-4018",
-	"䀹": "CJK UNIFIED IDEOGRAPH-4039",
-	"䀿": "CJK UNIFIED IDEOGRAPH-403F",
-	"䁆": "CJK UNIFIED IDEOGRAPH-4046",
-	"䂖": "CJK UNIFIED IDEOGRAPH-4096",
-	"䃣": "CJK UNIFIED IDEOGRAPH-40E3",
-	"䄯": "CJK UNIFIED IDEOGRAPH-412F",
-	"䈂": "CJK UNIFIED IDEOGRAPH-4202",
-	"䈧": "CJK UNIFIED IDEOGRAPH-4227",
-	"䊠": "CJK UNIFIED IDEOGRAPH-42A0",
-	"䌁": "CJK UNIFIED IDEOGRAPH-4301",
-	"䌴": "CJK UNIFIED IDEOGRAPH-4334",
-	"䍙": "CJK UNIFIED IDEOGRAPH-4359",
-	"䎛": "CJK UNIFIED IDEOGRAPH-439B",
-	"䏕": "CJK UNIFIED IDEOGRAPH-43D5",
-	"䏙": "CJK UNIFIED IDEOGRAPH-43D9",
-	"䐋": "CJK UNIFIED IDEOGRAPH-440B",
-	"䐠": "CJK UNIFIED IDEOGRAPH-4420",
-	"䑃": "CJK UNIFIED IDEOGRAPH-4443",
-	"䑫": "CJK UNIFIED IDEOGRAPH-446B",
-	"䔫": "CJK UNIFIED IDEOGRAPH-452B",
-	"䕝": "CJK UNIFIED IDEOGRAPH-455D",
-	"䕡": "CJK UNIFIED IDEOGRAPH-4561",
-	"䕫": "CJK UNIFIED IDEOGRAPH-456B",
-	"䗗": "CJK UNIFIED IDEOGRAPH-45D7",
-	"䗹": "CJK UNIFIED IDEOGRAPH-45F9",
-	"䘵": "CJK UNIFIED IDEOGRAPH-4635",
-	"䚶": "CJK UNIFIED IDEOGRAPH-46B6",
-	"䚾": "CJK UNIFIED IDEOGRAPH-46BE",
-	"䛇": "CJK UNIFIED IDEOGRAPH-46C7",
-	"䦕": "CJK UNIFIED IDEOGRAPH-4995",
-	"䧦": "CJK UNIFIED IDEOGRAPH-49E6",
-	"䩮": "CJK UNIFIED IDEOGRAPH-4A6E",
-	"䩶": "CJK UNIFIED IDEOGRAPH-4A76",
-	"䪲": "CJK UNIFIED IDEOGRAPH-4AB2",
-	"䬳": "CJK UNIFIED IDEOGRAPH-4B33",
-	"䯎": "CJK UNIFIED IDEOGRAPH-4BCE",
-	"䳎": "CJK UNIFIED IDEOGRAPH-4CCE",
-	"䳭": "CJK UNIFIED IDEOGRAPH-4CED",
-	"䳸": "CJK UNIFIED IDEOGRAPH-4CF8",
-	"䵖": "CJK UNIFIED IDEOGRAPH-4D56",
-	"一": "CJK UNIFIED IDEOGRAPH-4E00",
-	"不": "CJK UNIFIED IDEOGRAPH-4E0D",
-	"並": "CJK UNIFIED IDEOGRAPH-4E26",
-	"丨": "CJK UNIFIED IDEOGRAPH-4E28",
-	"丨ᅡ": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG A",
-	"丨ᅣ": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG YA",
-	"丨ᅣᅩ": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG YA, HANGUL JUNGSEONG O",
-	"丨ᅣ丨": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG YA, CJK UNIFIED IDEOGRAPH-4E28",
-	"丨ᅧ": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG YEO",
-	"丨ᅧ丨": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG YEO, CJK UNIFIED IDEOGRAPH-4E28",
-	"丨ᅩ": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG O",
-	"丨ᅩ丨": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG O, CJK UNIFIED IDEOGRAPH-4E28",
-	"丨ᅭ": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG YO",
-	"丨ᅮ": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG U",
-	"丨ᅲ": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG YU",
-	"丨ᆞ": "CJK UNIFIED IDEOGRAPH-4E28, HANGUL JUNGSEONG ARAEA",
-	"丨ー": "CJK UNIFIED IDEOGRAPH-4E28, KATAKANA-HIRAGANA PROLONGED SOUND MARK",
-	"丨丨": "CJK UNIFIED IDEOGRAPH-4E28, CJK UNIFIED IDEOGRAPH-4E28",
-	"丬": "CJK UNIFIED IDEOGRAPH-4E2C",
-	"串": "CJK UNIFIED IDEOGRAPH-4E32",
-	"丶": "CJK UNIFIED IDEOGRAPH-4E36",
-	"丸": "CJK UNIFIED IDEOGRAPH-4E38",
-	"丹": "CJK UNIFIED IDEOGRAPH-4E39",
-	"丽": "CJK UNIFIED IDEOGRAPH-4E3D",
-	"丿": "CJK UNIFIED IDEOGRAPH-4E3F",
-	"乁": "CJK UNIFIED IDEOGRAPH-4E41",
-	"乙": "CJK UNIFIED IDEOGRAPH-4E59",
-	"乚": "CJK UNIFIED IDEOGRAPH-4E5A",
-	"乛": "CJK UNIFIED IDEOGRAPH-4E5B",
-	"亀": "CJK UNIFIED IDEOGRAPH-4E80",
-	"亂": "CJK UNIFIED IDEOGRAPH-4E82",
-	"亅": "CJK UNIFIED IDEOGRAPH-4E85",
-	"了": "CJK UNIFIED IDEOGRAPH-4E86",
-	"二": "CJK UNIFIED IDEOGRAPH-4E8C",
-	"亠": "CJK UNIFIED IDEOGRAPH-4EA0",
-	"亮": "CJK UNIFIED IDEOGRAPH-4EAE",
-	"人": "CJK UNIFIED IDEOGRAPH-4EBA",
-	"亻": "CJK UNIFIED IDEOGRAPH-4EBB",
-	"什": "CJK UNIFIED IDEOGRAPH-4EC0",
-	"仌": "CJK UNIFIED IDEOGRAPH-4ECC",
-	"令": "CJK UNIFIED IDEOGRAPH-4EE4",
-	"你": "CJK UNIFIED IDEOGRAPH-4F60",
-	"併": "CJK UNIFIED IDEOGRAPH-4F75",
-	"侀": "CJK UNIFIED IDEOGRAPH-4F80",
-	"來": "CJK UNIFIED IDEOGRAPH-4F86",
-	"例": "CJK UNIFIED IDEOGRAPH-4F8B",
-	"侮": "CJK UNIFIED IDEOGRAPH-4FAE",
-	"侻": "CJK UNIFIED IDEOGRAPH-4FBB",
-	"便": "CJK UNIFIED IDEOGRAPH-4FBF",
-	"倂": "CJK UNIFIED IDEOGRAPH-5002",
-	"値": "CJK UNIFIED IDEOGRAPH-5024",
-	"倫": "CJK UNIFIED IDEOGRAPH-502B",
-	"值": "CJK UNIFIED IDEOGRAPH-503C",
-	"偺": "CJK UNIFIED IDEOGRAPH-507A",
-	"備": "CJK UNIFIED IDEOGRAPH-5099",
-	"像": "CJK UNIFIED IDEOGRAPH-50CF",
-	"僚": "CJK UNIFIED IDEOGRAPH-50DA",
-	"僧": "CJK UNIFIED IDEOGRAPH-50E7",
-	"儿": "CJK UNIFIED IDEOGRAPH-513F",
-	"兀": "CJK UNIFIED IDEOGRAPH-5140",
-	"充": "CJK UNIFIED IDEOGRAPH-5145",
-	"免": "CJK UNIFIED IDEOGRAPH-514D",
-	"兔": "CJK UNIFIED IDEOGRAPH-5154",
-	"兤": "CJK UNIFIED IDEOGRAPH-5164",
-	"入": "CJK UNIFIED IDEOGRAPH-5165",
-	"內": "CJK UNIFIED IDEOGRAPH-5167",
-	"全": "CJK UNIFIED IDEOGRAPH-5168",
-	"兩": "CJK UNIFIED IDEOGRAPH-5169",
-	"八": "CJK UNIFIED IDEOGRAPH-516B",
-	"六": "CJK UNIFIED IDEOGRAPH-516D",
-	"具": "CJK UNIFIED IDEOGRAPH-5177",
-	"冀": "CJK UNIFIED IDEOGRAPH-5180",
-	"冂": "CJK UNIFIED IDEOGRAPH-5182",
-	"再": "CJK UNIFIED IDEOGRAPH-518D",
-	"冒": "CJK UNIFIED IDEOGRAPH-5192",
-	"冕": "CJK UNIFIED IDEOGRAPH-5195",
-	"冖": "CJK UNIFIED IDEOGRAPH-5196",
-	"冗": "CJK UNIFIED IDEOGRAPH-5197",
-	"冤": "CJK UNIFIED IDEOGRAPH-51A4",
-	"冫": "CJK UNIFIED IDEOGRAPH-51AB",
-	"冬": "CJK UNIFIED IDEOGRAPH-51AC",
-	"况": "CJK UNIFIED IDEOGRAPH-51B5",
-	"冷": "CJK UNIFIED IDEOGRAPH-51B7",
-	"凉": "CJK UNIFIED IDEOGRAPH-51C9",
-	"凌": "CJK UNIFIED IDEOGRAPH-51CC",
-	"凜": "CJK UNIFIED IDEOGRAPH-51DC",
-	"凞": "CJK UNIFIED IDEOGRAPH-51DE",
-	"几": "CJK UNIFIED IDEOGRAPH-51E0",
-	"凵": "CJK UNIFIED IDEOGRAPH-51F5",
-	"刀": "CJK UNIFIED IDEOGRAPH-5200",
-	"刂": "CJK UNIFIED IDEOGRAPH-5202",
-	"刃": "CJK UNIFIED IDEOGRAPH-5203",
-	"切": "CJK UNIFIED IDEOGRAPH-5207",
-	"列": "CJK UNIFIED IDEOGRAPH-5217",
-	"利": "CJK UNIFIED IDEOGRAPH-5229",
-	"刺": "CJK UNIFIED IDEOGRAPH-523A",
-	"刻": "CJK UNIFIED IDEOGRAPH-523B",
-	"剆": "CJK UNIFIED IDEOGRAPH-5246",
-	"割": "CJK UNIFIED IDEOGRAPH-5272",
-	"剷": "CJK UNIFIED IDEOGRAPH-5277",
-	"劉": "CJK UNIFIED IDEOGRAPH-5289",
-	"力": "CJK UNIFIED IDEOGRAPH-529B",
-	"劣": "CJK UNIFIED IDEOGRAPH-52A3",
-	"劳": "CJK UNIFIED IDEOGRAPH-52B3",
-	"勇": "CJK UNIFIED IDEOGRAPH-52C7",
-	"勉": "CJK UNIFIED IDEOGRAPH-52C9",
-	"勒": "CJK UNIFIED IDEOGRAPH-52D2",
-	"勞": "CJK UNIFIED IDEOGRAPH-52DE",
-	"勤": "CJK UNIFIED IDEOGRAPH-52E4",
-	"勵": "CJK UNIFIED IDEOGRAPH-52F5",
-	"勹": "CJK UNIFIED IDEOGRAPH-52F9",
-	"勺": "CJK UNIFIED IDEOGRAPH-52FA",
-	"包": "CJK UNIFIED IDEOGRAPH-5305",
-	"匆": "CJK UNIFIED IDEOGRAPH-5306",
-	"匕": "CJK UNIFIED IDEOGRAPH-5315",
-	"北": "CJK UNIFIED IDEOGRAPH-5317",
-	"匚": "CJK UNIFIED IDEOGRAPH-531A",
-	"匸": "CJK UNIFIED IDEOGRAPH-5338",
-	"匿": "CJK UNIFIED IDEOGRAPH-533F",
-	"十": "CJK UNIFIED IDEOGRAPH-5341",
-	"卄": "CJK UNIFIED IDEOGRAPH-5344",
-	"卅": "CJK UNIFIED IDEOGRAPH-5345",
-	"卉": "CJK UNIFIED IDEOGRAPH-5349",
-	"卍": "CJK UNIFIED IDEOGRAPH-534D",
-	"卐": "CJK UNIFIED IDEOGRAPH-5350",
-	"卑": "CJK UNIFIED IDEOGRAPH-5351",
-	"博": "CJK UNIFIED IDEOGRAPH-535A",
-	"卜": "CJK UNIFIED IDEOGRAPH-535C",
-	"卩": "CJK UNIFIED IDEOGRAPH-5369",
-	"即": "CJK UNIFIED IDEOGRAPH-5373",
-	"卵": "CJK UNIFIED IDEOGRAPH-5375",
-	"卽": "CJK UNIFIED IDEOGRAPH-537D",
-	"卿": "CJK UNIFIED IDEOGRAPH-537F",
-	"厂": "CJK UNIFIED IDEOGRAPH-5382",
-	"厶": "CJK UNIFIED IDEOGRAPH-53B6",
-	"參": "CJK UNIFIED IDEOGRAPH-53C3",
-	"又": "CJK UNIFIED IDEOGRAPH-53C8",
-	"及": "CJK UNIFIED IDEOGRAPH-53CA",
-	"叟": "CJK UNIFIED IDEOGRAPH-53DF",
-	"口": "CJK UNIFIED IDEOGRAPH-53E3",
-	"句": "CJK UNIFIED IDEOGRAPH-53E5",
-	"叫": "CJK UNIFIED IDEOGRAPH-53EB",
-	"叱": "CJK UNIFIED IDEOGRAPH-53F1",
-	"吆": "CJK UNIFIED IDEOGRAPH-5406",
-	"吏": "CJK UNIFIED IDEOGRAPH-540F",
-	"吝": "CJK UNIFIED IDEOGRAPH-541D",
-	"吸": "CJK UNIFIED IDEOGRAPH-5438",
-	"呂": "CJK UNIFIED IDEOGRAPH-5442",
-	"呈": "CJK UNIFIED IDEOGRAPH-5448",
-	"周": "CJK UNIFIED IDEOGRAPH-5468",
-	"咞": "CJK UNIFIED IDEOGRAPH-549E",
-	"咢": "CJK UNIFIED IDEOGRAPH-54A2",
-	"咽": "CJK UNIFIED IDEOGRAPH-54BD",
-	"哶": "CJK UNIFIED IDEOGRAPH-54F6",
-	"唐": "CJK UNIFIED IDEOGRAPH-5510",
-	"啓": "CJK UNIFIED IDEOGRAPH-5553",
-	"啕": "CJK UNIFIED IDEOGRAPH-5555",
-	"啟": "CJK UNIFIED IDEOGRAPH-555F",
-	"啣": "CJK UNIFIED IDEOGRAPH-5563",
-	"善": "CJK UNIFIED IDEOGRAPH-5584",
-	"喇": "CJK UNIFIED IDEOGRAPH-5587",
-	"喙": "CJK UNIFIED IDEOGRAPH-5599",
-	"喝": "CJK UNIFIED IDEOGRAPH-559D",
-	"喫": "CJK UNIFIED IDEOGRAPH-55AB",
-	"喳": "CJK UNIFIED IDEOGRAPH-55B3",
-	"嗀": "CJK UNIFIED IDEOGRAPH-55C0",
-	"嗂": "CJK UNIFIED IDEOGRAPH-55C2",
-	"嗢": "CJK UNIFIED IDEOGRAPH-55E2",
-	"嘆": "CJK UNIFIED IDEOGRAPH-5606",
-	"噑": "CJK UNIFIED IDEOGRAPH-5651",
-	"器": "CJK UNIFIED IDEOGRAPH-5668",
-	"噴": "CJK UNIFIED IDEOGRAPH-5674",
-	"囗": "CJK UNIFIED IDEOGRAPH-56D7",
-	"囹": "CJK UNIFIED IDEOGRAPH-56F9",
-	"圖": "CJK UNIFIED IDEOGRAPH-5716",
-	"圗": "CJK UNIFIED IDEOGRAPH-5717",
-	"土": "CJK UNIFIED IDEOGRAPH-571F",
-	"型": "CJK UNIFIED IDEOGRAPH-578B",
-	"城": "CJK UNIFIED IDEOGRAPH-57CE",
-	"埴": "CJK UNIFIED IDEOGRAPH-57F4",
-	"堍": "CJK UNIFIED IDEOGRAPH-580D",
-	"報": "CJK UNIFIED IDEOGRAPH-5831",
-	"堲": "CJK UNIFIED IDEOGRAPH-5832",
-	"塀": "CJK UNIFIED IDEOGRAPH-5840",
-	"塚": "CJK UNIFIED IDEOGRAPH-585A",
-	"塞": "CJK UNIFIED IDEOGRAPH-585E",
-	"塡": "CJK UNIFIED IDEOGRAPH-5861",
-	"填": "CJK UNIFIED IDEOGRAPH-586B",
-	"墨": "CJK UNIFIED IDEOGRAPH-58A8",
-	"墫": "CJK UNIFIED IDEOGRAPH-58AB",
-	"墬": "CJK UNIFIED IDEOGRAPH-58AC",
-	"墳": "CJK UNIFIED IDEOGRAPH-58B3",
-	"壘": "CJK UNIFIED IDEOGRAPH-58D8",
-	"壟": "CJK UNIFIED IDEOGRAPH-58DF",
-	"士": "CJK UNIFIED IDEOGRAPH-58EB",
-	"壮": "CJK UNIFIED IDEOGRAPH-58EE",
-	"売": "CJK UNIFIED IDEOGRAPH-58F2",
-	"壷": "CJK UNIFIED IDEOGRAPH-58F7",
-	"壿": "CJK UNIFIED IDEOGRAPH-58FF",
-	"夂": "CJK UNIFIED IDEOGRAPH-5902",
-	"夆": "CJK UNIFIED IDEOGRAPH-5906",
-	"夊": "CJK UNIFIED IDEOGRAPH-590A",
-	"夕": "CJK UNIFIED IDEOGRAPH-5915",
-	"多": "CJK UNIFIED IDEOGRAPH-591A",
-	"夢": "CJK UNIFIED IDEOGRAPH-5922",
-	"大": "CJK UNIFIED IDEOGRAPH-5927",
-	"奄": "CJK UNIFIED IDEOGRAPH-5944",
-	"奈": "CJK UNIFIED IDEOGRAPH-5948",
-	"契": "CJK UNIFIED IDEOGRAPH-5951",
-	"奔": "CJK UNIFIED IDEOGRAPH-5954",
-	"奢": "CJK UNIFIED IDEOGRAPH-5962",
-	"女": "CJK UNIFIED IDEOGRAPH-5973",
-	"姘": "CJK UNIFIED IDEOGRAPH-59D8",
-	"姬": "CJK UNIFIED IDEOGRAPH-59EC",
-	"娛": "CJK UNIFIED IDEOGRAPH-5A1B",
-	"娧": "CJK UNIFIED IDEOGRAPH-5A27",
-	"婢": "CJK UNIFIED IDEOGRAPH-5A62",
-	"婦": "CJK UNIFIED IDEOGRAPH-5A66",
-	"媯": "CJK UNIFIED IDEOGRAPH-5AAF",
-	"媵": "CJK UNIFIED IDEOGRAPH-5AB5",
-	"嬀": "CJK UNIFIED IDEOGRAPH-5B00",
-	"嬈": "CJK UNIFIED IDEOGRAPH-5B08",
-	"嬨": "CJK UNIFIED IDEOGRAPH-5B28",
-	"嬾": "CJK UNIFIED IDEOGRAPH-5B3E",
-	"子": "CJK UNIFIED IDEOGRAPH-5B50",
-	"宀": "CJK UNIFIED IDEOGRAPH-5B80",
-	"宅": "CJK UNIFIED IDEOGRAPH-5B85",
-	"寃": "CJK UNIFIED IDEOGRAPH-5BC3",
-	"寘": "CJK UNIFIED IDEOGRAPH-5BD8",
-	"寧": "CJK UNIFIED IDEOGRAPH-5BE7",
-	"寮": "CJK UNIFIED IDEOGRAPH-5BEE",
-	"寳": "CJK UNIFIED IDEOGRAPH-5BF3",
-	"寸": "CJK UNIFIED IDEOGRAPH-5BF8",
-	"寿": "CJK UNIFIED IDEOGRAPH-5BFF",
-	"将": "CJK UNIFIED IDEOGRAPH-5C06",
-	"小": "CJK UNIFIED IDEOGRAPH-5C0F",
-	"尢": "CJK UNIFIED IDEOGRAPH-5C22",
-	"尣": "CJK UNIFIED IDEOGRAPH-5C23",
-	"尸": "CJK UNIFIED IDEOGRAPH-5C38",
-	"尿": "CJK UNIFIED IDEOGRAPH-5C3F",
-	"屠": "CJK UNIFIED IDEOGRAPH-5C60",
-	"屢": "CJK UNIFIED IDEOGRAPH-5C62",
-	"層": "CJK UNIFIED IDEOGRAPH-5C64",
-	"履": "CJK UNIFIED IDEOGRAPH-5C65",
-	"屮": "CJK UNIFIED IDEOGRAPH-5C6E",
-	"山": "CJK UNIFIED IDEOGRAPH-5C71",
-	"岍": "CJK UNIFIED IDEOGRAPH-5C8D",
-	"峀": "CJK UNIFIED IDEOGRAPH-5CC0",
-	"崙": "CJK UNIFIED IDEOGRAPH-5D19",
-	"嵃": "CJK UNIFIED IDEOGRAPH-5D43",
-	"嵐": "CJK UNIFIED IDEOGRAPH-5D50",
-	"嵫": "CJK UNIFIED IDEOGRAPH-5D6B",
-	"嵮": "CJK UNIFIED IDEOGRAPH-5D6E",
-	"嵼": "CJK UNIFIED IDEOGRAPH-5D7C",
-	"嶲": "CJK UNIFIED IDEOGRAPH-5DB2",
-	"嶺": "CJK UNIFIED IDEOGRAPH-5DBA",
-	"巛": "CJK UNIFIED IDEOGRAPH-5DDB",
-	"巡": "CJK UNIFIED IDEOGRAPH-5DE1",
-	"巢": "CJK UNIFIED IDEOGRAPH-5DE2",
-	"工": "CJK UNIFIED IDEOGRAPH-5DE5",
-	"己": "CJK UNIFIED IDEOGRAPH-5DF1",
-	"巳": "CJK UNIFIED IDEOGRAPH-5DF3",
-	"巽": "CJK UNIFIED IDEOGRAPH-5DFD",
-	"巾": "CJK UNIFIED IDEOGRAPH-5DFE",
-	"帡": "CJK UNIFIED IDEOGRAPH-5E21",
-	"帨": "CJK UNIFIED IDEOGRAPH-5E28",
-	"帲": "CJK UNIFIED IDEOGRAPH-5E32",
-	"帽": "CJK UNIFIED IDEOGRAPH-5E3D",
-	"幐": "CJK UNIFIED IDEOGRAPH-5E50",
-	"幩": "CJK UNIFIED IDEOGRAPH-5E69",
-	"干": "CJK UNIFIED IDEOGRAPH-5E72",
-	"年": "CJK UNIFIED IDEOGRAPH-5E74",
-	"幺": "CJK UNIFIED IDEOGRAPH-5E7A",
-	"广": "CJK UNIFIED IDEOGRAPH-5E7F",
-	"度": "CJK UNIFIED IDEOGRAPH-5EA6",
-	"庰": "CJK UNIFIED IDEOGRAPH-5EB0",
-	"庳": "CJK UNIFIED IDEOGRAPH-5EB3",
-	"庶": "CJK UNIFIED IDEOGRAPH-5EB6",
-	"廉": "CJK UNIFIED IDEOGRAPH-5EC9",
-	"廊": "CJK UNIFIED IDEOGRAPH-5ECA",
-	"廒": "CJK UNIFIED IDEOGRAPH-5ED2",
-	"廓": "CJK UNIFIED IDEOGRAPH-5ED3",
-	"廙": "CJK UNIFIED IDEOGRAPH-5ED9",
-	"廬": "CJK UNIFIED IDEOGRAPH-5EEC",
-	"廴": "CJK UNIFIED IDEOGRAPH-5EF4",
-	"廾": "CJK UNIFIED IDEOGRAPH-5EFE",
-	"弄": "CJK UNIFIED IDEOGRAPH-5F04",
-	"弋": "CJK UNIFIED IDEOGRAPH-5F0B",
-	"弓": "CJK UNIFIED IDEOGRAPH-5F13",
-	"弢": "CJK UNIFIED IDEOGRAPH-5F22",
-	"彐": "CJK UNIFIED IDEOGRAPH-5F50",
-	"彑": "CJK UNIFIED IDEOGRAPH-5F51",
-	"当": "CJK UNIFIED IDEOGRAPH-5F53",
-	"彡": "CJK UNIFIED IDEOGRAPH-5F61",
-	"形": "CJK UNIFIED IDEOGRAPH-5F62",
-	"彩": "CJK UNIFIED IDEOGRAPH-5F69",
-	"彫": "CJK UNIFIED IDEOGRAPH-5F6B",
-	"彳": "CJK UNIFIED IDEOGRAPH-5F73",
-	"律": "CJK UNIFIED IDEOGRAPH-5F8B",
-	"徚": "CJK UNIFIED IDEOGRAPH-5F9A",
-	"復": "CJK UNIFIED IDEOGRAPH-5FA9",
-	"徭": "CJK UNIFIED IDEOGRAPH-5FAD",
-	"心": "CJK UNIFIED IDEOGRAPH-5FC3",
-	"忄": "CJK UNIFIED IDEOGRAPH-5FC4",
-	"忍": "CJK UNIFIED IDEOGRAPH-5FCD",
-	"志": "CJK UNIFIED IDEOGRAPH-5FD7",
-	"念": "CJK UNIFIED IDEOGRAPH-5FF5",
-	"忹": "CJK UNIFIED IDEOGRAPH-5FF9",
-	"怒": "CJK UNIFIED IDEOGRAPH-6012",
-	"怜": "CJK UNIFIED IDEOGRAPH-601C",
-	"恵": "CJK UNIFIED IDEOGRAPH-6075",
-	"悁": "CJK UNIFIED IDEOGRAPH-6081",
-	"悔": "CJK UNIFIED IDEOGRAPH-6094",
-	"惇": "CJK UNIFIED IDEOGRAPH-60C7",
-	"惘": "CJK UNIFIED IDEOGRAPH-60D8",
-	"惡": "CJK UNIFIED IDEOGRAPH-60E1",
-	"愈": "CJK UNIFIED IDEOGRAPH-6108",
-	"慄": "CJK UNIFIED IDEOGRAPH-6144",
-	"慈": "CJK UNIFIED IDEOGRAPH-6148",
-	"慌": "CJK UNIFIED IDEOGRAPH-614C",
-	"慎": "CJK UNIFIED IDEOGRAPH-614E",
-	"慠": "CJK UNIFIED IDEOGRAPH-6160",
-	"慨": "CJK UNIFIED IDEOGRAPH-6168",
-	"慺": "CJK UNIFIED IDEOGRAPH-617A",
-	"憎": "CJK UNIFIED IDEOGRAPH-618E",
-	"憐": "CJK UNIFIED IDEOGRAPH-6190",
-	"憤": "CJK UNIFIED IDEOGRAPH-61A4",
-	"憯": "CJK UNIFIED IDEOGRAPH-61AF",
-	"憲": "CJK UNIFIED IDEOGRAPH-61B2",
-	"懞": "CJK UNIFIED IDEOGRAPH-61DE",
-	"懲": "CJK UNIFIED IDEOGRAPH-61F2",
-	"懶": "CJK UNIFIED IDEOGRAPH-61F6",
-	"戀": "CJK UNIFIED IDEOGRAPH-6200",
-	"戈": "CJK UNIFIED IDEOGRAPH-6208",
-	"成": "CJK UNIFIED IDEOGRAPH-6210",
-	"戛": "CJK UNIFIED IDEOGRAPH-621B",
-	"戮": "CJK UNIFIED IDEOGRAPH-622E",
-	"戴": "CJK UNIFIED IDEOGRAPH-6234",
-	"戶": "CJK UNIFIED IDEOGRAPH-6236",
-	"戸": "CJK UNIFIED IDEOGRAPH-6238",
-	"手": "CJK UNIFIED IDEOGRAPH-624B",
-	"扌": "CJK UNIFIED IDEOGRAPH-624C",
-	"扝": "CJK UNIFIED IDEOGRAPH-625D",
-	"抱": "CJK UNIFIED IDEOGRAPH-62B1",
-	"拉": "CJK UNIFIED IDEOGRAPH-62C9",
-	"拏": "CJK UNIFIED IDEOGRAPH-62CF",
-	"拓": "CJK UNIFIED IDEOGRAPH-62D3",
-	"拔": "CJK UNIFIED IDEOGRAPH-62D4",
-	"拼": "CJK UNIFIED IDEOGRAPH-62FC",
-	"拾": "CJK UNIFIED IDEOGRAPH-62FE",
-	"挽": "CJK UNIFIED IDEOGRAPH-633D",
-	"捐": "CJK UNIFIED IDEOGRAPH-6350",
-	"捨": "CJK UNIFIED IDEOGRAPH-6368",
-	"捻": "CJK UNIFIED IDEOGRAPH-637B",
-	"掃": "CJK UNIFIED IDEOGRAPH-6383",
-	"掠": "CJK UNIFIED IDEOGRAPH-63A0",
-	"掩": "CJK UNIFIED IDEOGRAPH-63A9",
-	"揄": "CJK UNIFIED IDEOGRAPH-63C4",
-	"揅": "CJK UNIFIED IDEOGRAPH-63C5",
-	"揤": "CJK UNIFIED IDEOGRAPH-63E4",
-	"搉": "CJK UNIFIED IDEOGRAPH-6409",
-	"搜": "CJK UNIFIED IDEOGRAPH-641C",
-	"搢": "CJK UNIFIED IDEOGRAPH-6422",
-	"摒": "CJK UNIFIED IDEOGRAPH-6452",
-	"摩": "CJK UNIFIED IDEOGRAPH-6469",
-	"摷": "CJK UNIFIED IDEOGRAPH-6477",
-	"摾": "CJK UNIFIED IDEOGRAPH-647E",
-	"撚": "CJK UNIFIED IDEOGRAPH-649A",
-	"撝": "CJK UNIFIED IDEOGRAPH-649D",
-	"擄": "CJK UNIFIED IDEOGRAPH-64C4",
-	"支": "CJK UNIFIED IDEOGRAPH-652F",
-	"攴": "CJK UNIFIED IDEOGRAPH-6534",
-	"攵": "CJK UNIFIED IDEOGRAPH-6535",
-	"敏": "CJK UNIFIED IDEOGRAPH-654F",
-	"敖": "CJK UNIFIED IDEOGRAPH-6556",
-	"敬": "CJK UNIFIED IDEOGRAPH-656C",
-	"數": "CJK UNIFIED IDEOGRAPH-6578",
-	"文": "CJK UNIFIED IDEOGRAPH-6587",
-	"斉": "CJK UNIFIED IDEOGRAPH-6589",
-	"斗": "CJK UNIFIED IDEOGRAPH-6597",
-	"料": "CJK UNIFIED IDEOGRAPH-6599",
-	"斤": "CJK UNIFIED IDEOGRAPH-65A4",
-	"方": "CJK UNIFIED IDEOGRAPH-65B9",
-	"旅": "CJK UNIFIED IDEOGRAPH-65C5",
-	"无": "CJK UNIFIED IDEOGRAPH-65E0",
-	"旡": "CJK UNIFIED IDEOGRAPH-65E1",
-	"既": "CJK UNIFIED IDEOGRAPH-65E2",
-	"旣": "CJK UNIFIED IDEOGRAPH-65E3",
-	"日": "CJK UNIFIED IDEOGRAPH-65E5",
-	"易": "CJK UNIFIED IDEOGRAPH-6613",
-	"晉": "CJK UNIFIED IDEOGRAPH-6649",
-	"晚": "CJK UNIFIED IDEOGRAPH-665A",
-	"晣": "CJK UNIFIED IDEOGRAPH-6663",
-	"晩": "CJK UNIFIED IDEOGRAPH-6669",
-	"晴": "CJK UNIFIED IDEOGRAPH-6674",
-	"暈": "CJK UNIFIED IDEOGRAPH-6688",
-	"暑": "CJK UNIFIED IDEOGRAPH-6691",
-	"暜": "CJK UNIFIED IDEOGRAPH-669C",
-	"暴": "CJK UNIFIED IDEOGRAPH-66B4",
-	"曆": "CJK UNIFIED IDEOGRAPH-66C6",
-	"曰": "CJK UNIFIED IDEOGRAPH-66F0",
-	"更": "CJK UNIFIED IDEOGRAPH-66F4",
-	"曶": "CJK UNIFIED IDEOGRAPH-66F6",
-	"書": "CJK UNIFIED IDEOGRAPH-66F8",
-	"最": "CJK UNIFIED IDEOGRAPH-6700",
-	"月": "CJK UNIFIED IDEOGRAPH-6708",
-	"朌": "CJK UNIFIED IDEOGRAPH-670C",
-	"朏": "CJK UNIFIED IDEOGRAPH-670F",
-	"朐": "CJK UNIFIED IDEOGRAPH-6710",
-	"朓": "CJK UNIFIED IDEOGRAPH-6713",
-	"朗": "CJK UNIFIED IDEOGRAPH-6717",
-	"朘": "CJK UNIFIED IDEOGRAPH-6718",
-	"望": "CJK UNIFIED IDEOGRAPH-671B",
-	"朡": "CJK UNIFIED IDEOGRAPH-6721",
-	"朣": "CJK UNIFIED IDEOGRAPH-6723",
-	"朦": "CJK UNIFIED IDEOGRAPH-6726",
-	"木": "CJK UNIFIED IDEOGRAPH-6728",
-	"李": "CJK UNIFIED IDEOGRAPH-674E",
-	"杓": "CJK UNIFIED IDEOGRAPH-6753",
-	"杖": "CJK UNIFIED IDEOGRAPH-6756",
-	"杞": "CJK UNIFIED IDEOGRAPH-675E",
-	"杮": "CJK UNIFIED IDEOGRAPH-676E",
-	"杻": "CJK UNIFIED IDEOGRAPH-677B",
-	"枅": "CJK UNIFIED IDEOGRAPH-6785",
-	"林": "CJK UNIFIED IDEOGRAPH-6797",
-	"柳": "CJK UNIFIED IDEOGRAPH-67F3",
-	"柺": "CJK UNIFIED IDEOGRAPH-67FA",
-	"柿": "CJK UNIFIED IDEOGRAPH-67FF",
-	"栗": "CJK UNIFIED IDEOGRAPH-6817",
-	"栟": "CJK UNIFIED IDEOGRAPH-681F",
-	"桒": "CJK UNIFIED IDEOGRAPH-6852",
-	"梁": "CJK UNIFIED IDEOGRAPH-6881",
-	"梅": "CJK UNIFIED IDEOGRAPH-6885",
-	"梎": "CJK UNIFIED IDEOGRAPH-688E",
-	"梨": "CJK UNIFIED IDEOGRAPH-68A8",
-	"椔": "CJK UNIFIED IDEOGRAPH-6914",
-	"楂": "CJK UNIFIED IDEOGRAPH-6942",
-	"榝": "CJK UNIFIED IDEOGRAPH-699D",
-	"榣": "CJK UNIFIED IDEOGRAPH-69A3",
-	"槩": "CJK UNIFIED IDEOGRAPH-69E9",
-	"槪": "CJK UNIFIED IDEOGRAPH-69EA",
-	"樂": "CJK UNIFIED IDEOGRAPH-6A02",
-	"樓": "CJK UNIFIED IDEOGRAPH-6A13",
-	"樧": "CJK UNIFIED IDEOGRAPH-6A27",
-	"檨": "CJK UNIFIED IDEOGRAPH-6AA8",
-	"櫓": "CJK UNIFIED IDEOGRAPH-6AD3",
-	"櫛": "CJK UNIFIED IDEOGRAPH-6ADB",
-	"欄": "CJK UNIFIED IDEOGRAPH-6B04",
-	"欠": "CJK UNIFIED IDEOGRAPH-6B20",
-	"次": "CJK UNIFIED IDEOGRAPH-6B21",
-	"歔": "CJK UNIFIED IDEOGRAPH-6B54",
-	"止": "CJK UNIFIED IDEOGRAPH-6B62",
-	"歯": "CJK UNIFIED IDEOGRAPH-6B6F",
-	"歲": "CJK UNIFIED IDEOGRAPH-6B72",
-	"歷": "CJK UNIFIED IDEOGRAPH-6B77",
-	"歹": "CJK UNIFIED IDEOGRAPH-6B79",
-	"歺": "CJK UNIFIED IDEOGRAPH-6B7A",
-	"殟": "CJK UNIFIED IDEOGRAPH-6B9F",
-	"殮": "CJK UNIFIED IDEOGRAPH-6BAE",
-	"殳": "CJK UNIFIED IDEOGRAPH-6BB3",
-	"殺": "CJK UNIFIED IDEOGRAPH-6BBA",
-	"殻": "CJK UNIFIED IDEOGRAPH-6BBB",
-	"毋": "CJK UNIFIED IDEOGRAPH-6BCB",
-	"母": "CJK UNIFIED IDEOGRAPH-6BCD",
-	"比": "CJK UNIFIED IDEOGRAPH-6BD4",
-	"毛": "CJK UNIFIED IDEOGRAPH-6BDB",
-	"氏": "CJK UNIFIED IDEOGRAPH-6C0F",
-	"民": "CJK UNIFIED IDEOGRAPH-6C11",
-	"气": "CJK UNIFIED IDEOGRAPH-6C14",
-	"水": "CJK UNIFIED IDEOGRAPH-6C34",
-	"氵": "CJK UNIFIED IDEOGRAPH-6C35",
-	"氺": "CJK UNIFIED IDEOGRAPH-6C3A",
-	"汎": "CJK UNIFIED IDEOGRAPH-6C4E",
-	"汧": "CJK UNIFIED IDEOGRAPH-6C67",
-	"沈": "CJK UNIFIED IDEOGRAPH-6C88",
-	"沿": "CJK UNIFIED IDEOGRAPH-6CBF",
-	"泌": "CJK UNIFIED IDEOGRAPH-6CCC",
-	"泍": "CJK UNIFIED IDEOGRAPH-6CCD",
-	"泥": "CJK UNIFIED IDEOGRAPH-6CE5",
-	"洖": "CJK UNIFIED IDEOGRAPH-6D16",
-	"洛": "CJK UNIFIED IDEOGRAPH-6D1B",
-	"洞": "CJK UNIFIED IDEOGRAPH-6D1E",
-	"洴": "CJK UNIFIED IDEOGRAPH-6D34",
-	"派": "CJK UNIFIED IDEOGRAPH-6D3E",
-	"流": "CJK UNIFIED IDEOGRAPH-6D41",
-	"浩": "CJK UNIFIED IDEOGRAPH-6D69",
-	"浪": "CJK UNIFIED IDEOGRAPH-6D6A",
-	"海": "CJK UNIFIED IDEOGRAPH-6D77",
-	"浸": "CJK UNIFIED IDEOGRAPH-6D78",
-	"涅": "CJK UNIFIED IDEOGRAPH-6D85",
-	"淋": "CJK UNIFIED IDEOGRAPH-6DCB",
-	"淚": "CJK UNIFIED IDEOGRAPH-6DDA",
-	"淪": "CJK UNIFIED IDEOGRAPH-6DEA",
-	"淹": "CJK UNIFIED IDEOGRAPH-6DF9",
-	"渚": "CJK UNIFIED IDEOGRAPH-6E1A",
-	"港": "CJK UNIFIED IDEOGRAPH-6E2F",
-	"湮": "CJK UNIFIED IDEOGRAPH-6E6E",
-	"溈": "CJK UNIFIED IDEOGRAPH-6E88",
-	"溜": "CJK UNIFIED IDEOGRAPH-6E9C",
-	"溺": "CJK UNIFIED IDEOGRAPH-6EBA",
-	"滇": "CJK UNIFIED IDEOGRAPH-6EC7",
-	"滋": "CJK UNIFIED IDEOGRAPH-6ECB",
-	"滑": "CJK UNIFIED IDEOGRAPH-6ED1",
-	"滛": "CJK UNIFIED IDEOGRAPH-6EDB",
-	"漏": "CJK UNIFIED IDEOGRAPH-6F0F",
-	"漢": "CJK UNIFIED IDEOGRAPH-6F22",
-	"漣": "CJK UNIFIED IDEOGRAPH-6F23",
-	"潙": "CJK UNIFIED IDEOGRAPH-6F59",
-	"潮": "CJK UNIFIED IDEOGRAPH-6F6E",
-	"濆": "CJK UNIFIED IDEOGRAPH-6FC6",
-	"濫": "CJK UNIFIED IDEOGRAPH-6FEB",
-	"濾": "CJK UNIFIED IDEOGRAPH-6FFE",
-	"瀛": "CJK UNIFIED IDEOGRAPH-701B",
-	"瀞": "CJK UNIFIED IDEOGRAPH-701E",
-	"瀹": "CJK UNIFIED IDEOGRAPH-7039",
-	"灊": "CJK UNIFIED IDEOGRAPH-704A",
-	"火": "CJK UNIFIED IDEOGRAPH-706B",
-	"灬": "CJK UNIFIED IDEOGRAPH-706C",
-	"灰": "CJK UNIFIED IDEOGRAPH-7070",
-	"灷": "CJK UNIFIED IDEOGRAPH-7077",
-	"災": "CJK UNIFIED IDEOGRAPH-707D",
-	"炙": "CJK UNIFIED IDEOGRAPH-7099",
-	"炭": "CJK UNIFIED IDEOGRAPH-70AD",
-	"烈": "CJK UNIFIED IDEOGRAPH-70C8",
-	"烙": "CJK UNIFIED IDEOGRAPH-70D9",
-	"煅": "CJK UNIFIED IDEOGRAPH-7145",
-	"煉": "CJK UNIFIED IDEOGRAPH-7149",
-	"煮": "CJK UNIFIED IDEOGRAPH-716E",
-	"熜": "CJK UNIFIED IDEOGRAPH-719C",
-	"燎": "CJK UNIFIED IDEOGRAPH-71CE",
-	"燐": "CJK UNIFIED IDEOGRAPH-71D0",
-	"爐": "CJK UNIFIED IDEOGRAPH-7210",
-	"爛": "CJK UNIFIED IDEOGRAPH-721B",
-	"爨": "CJK UNIFIED IDEOGRAPH-7228",
-	"爪": "CJK UNIFIED IDEOGRAPH-722A",
-	"爫": "CJK UNIFIED IDEOGRAPH-722B",
-	"爵": "CJK UNIFIED IDEOGRAPH-7235",
-	"父": "CJK UNIFIED IDEOGRAPH-7236",
-	"爻": "CJK UNIFIED IDEOGRAPH-723B",
-	"爿": "CJK UNIFIED IDEOGRAPH-723F",
-	"片": "CJK UNIFIED IDEOGRAPH-7247",
-	"牐": "CJK UNIFIED IDEOGRAPH-7250",
-	"牙": "CJK UNIFIED IDEOGRAPH-7259",
-	"牛": "CJK UNIFIED IDEOGRAPH-725B",
-	"牢": "CJK UNIFIED IDEOGRAPH-7262",
-	"犀": "CJK UNIFIED IDEOGRAPH-7280",
-	"犕": "CJK UNIFIED IDEOGRAPH-7295",
-	"犬": "CJK UNIFIED IDEOGRAPH-72AC",
-	"犭": "CJK UNIFIED IDEOGRAPH-72AD",
-	"犯": "CJK UNIFIED IDEOGRAPH-72AF",
-	"狀": "CJK UNIFIED IDEOGRAPH-72C0",
-	"狼": "CJK UNIFIED IDEOGRAPH-72FC",
-	"猪": "CJK UNIFIED IDEOGRAPH-732A",
-	"獵": "CJK UNIFIED IDEOGRAPH-7375",
-	"獺": "CJK UNIFIED IDEOGRAPH-737A",
-	"玄": "CJK UNIFIED IDEOGRAPH-7384",
-	"率": "CJK UNIFIED IDEOGRAPH-7387",
-	"玉": "CJK UNIFIED IDEOGRAPH-7389",
-	"王": "CJK UNIFIED IDEOGRAPH-738B",
-	"玥": "CJK UNIFIED IDEOGRAPH-73A5",
-	"玲": "CJK UNIFIED IDEOGRAPH-73B2",
-	"珞": "CJK UNIFIED IDEOGRAPH-73DE",
-	"理": "CJK UNIFIED IDEOGRAPH-7406",
-	"琉": "CJK UNIFIED IDEOGRAPH-7409",
-	"琢": "CJK UNIFIED IDEOGRAPH-7422",
-	"瑇": "CJK UNIFIED IDEOGRAPH-7447",
-	"瑜": "CJK UNIFIED IDEOGRAPH-745C",
-	"瑩": "CJK UNIFIED IDEOGRAPH-7469",
-	"瑱": "CJK UNIFIED IDEOGRAPH-7471",
-	"璅": "CJK UNIFIED IDEOGRAPH-7485",
-	"璉": "CJK UNIFIED IDEOGRAPH-7489",
-	"璘": "CJK UNIFIED IDEOGRAPH-7498",
-	"瓊": "CJK UNIFIED IDEOGRAPH-74CA",
-	"瓜": "CJK UNIFIED IDEOGRAPH-74DC",
-	"瓦": "CJK UNIFIED IDEOGRAPH-74E6",
-	"甆": "CJK UNIFIED IDEOGRAPH-7506",
-	"甘": "CJK UNIFIED IDEOGRAPH-7518",
-	"生": "CJK UNIFIED IDEOGRAPH-751F",
-	"甤": "CJK UNIFIED IDEOGRAPH-7524",
-	"用": "CJK UNIFIED IDEOGRAPH-7528",
-	"田": "CJK UNIFIED IDEOGRAPH-7530",
-	"画": "CJK UNIFIED IDEOGRAPH-753B",
-	"甾": "CJK UNIFIED IDEOGRAPH-753E",
-	"留": "CJK UNIFIED IDEOGRAPH-7559",
-	"略": "CJK UNIFIED IDEOGRAPH-7565",
-	"異": "CJK UNIFIED IDEOGRAPH-7570",
-	"疋": "CJK UNIFIED IDEOGRAPH-758B",
-	"疒": "CJK UNIFIED IDEOGRAPH-7592",
-	"痢": "CJK UNIFIED IDEOGRAPH-75E2",
-	"瘐": "CJK UNIFIED IDEOGRAPH-7610",
-	"瘝": "CJK UNIFIED IDEOGRAPH-761D",
-	"瘟": "CJK UNIFIED IDEOGRAPH-761F",
-	"療": "CJK UNIFIED IDEOGRAPH-7642",
-	"癩": "CJK UNIFIED IDEOGRAPH-7669",
-	"癶": "CJK UNIFIED IDEOGRAPH-7676",
-	"白": "CJK UNIFIED IDEOGRAPH-767D",
-	"皮": "CJK UNIFIED IDEOGRAPH-76AE",
-	"皿": "CJK UNIFIED IDEOGRAPH-76BF",
-	"益": "CJK UNIFIED IDEOGRAPH-76CA",
-	"盛": "CJK UNIFIED IDEOGRAPH-76DB",
-	"盧": "CJK UNIFIED IDEOGRAPH-76E7",
-	"目": "CJK UNIFIED IDEOGRAPH-76EE",
-	"直": "CJK UNIFIED IDEOGRAPH-76F4",
-	"省": "CJK UNIFIED IDEOGRAPH-7701",
-	"眞": "CJK UNIFIED IDEOGRAPH-771E",
-	"真": "CJK UNIFIED IDEOGRAPH-771F",
-	"着": "CJK UNIFIED IDEOGRAPH-7740",
-	"睊": "CJK UNIFIED IDEOGRAPH-774A",
-	"瞋": "CJK UNIFIED IDEOGRAPH-778B",
-	"瞧": "CJK UNIFIED IDEOGRAPH-77A7",
-	"矛": "CJK UNIFIED IDEOGRAPH-77DB",
-	"矢": "CJK UNIFIED IDEOGRAPH-77E2",
-	"石": "CJK UNIFIED IDEOGRAPH-77F3",
-	"研": "CJK UNIFIED IDEOGRAPH-7814",
-	"硎": "CJK UNIFIED IDEOGRAPH-784E",
-	"硏": "CJK UNIFIED IDEOGRAPH-784F",
-	"硫": "CJK UNIFIED IDEOGRAPH-786B",
-	"碌": "CJK UNIFIED IDEOGRAPH-788C",
-	"碑": "CJK UNIFIED IDEOGRAPH-7891",
-	"磊": "CJK UNIFIED IDEOGRAPH-78CA",
-	"磌": "CJK UNIFIED IDEOGRAPH-78CC",
-	"磻": "CJK UNIFIED IDEOGRAPH-78FB",
-	"礪": "CJK UNIFIED IDEOGRAPH-792A",
-	"示": "CJK UNIFIED IDEOGRAPH-793A",
-	"礻": "CJK UNIFIED IDEOGRAPH-793B",
-	"礼": "CJK UNIFIED IDEOGRAPH-793C",
-	"社": "CJK UNIFIED IDEOGRAPH-793E",
-	"祈": "CJK UNIFIED IDEOGRAPH-7948",
-	"祉": "CJK UNIFIED IDEOGRAPH-7949",
-	"祐": "CJK UNIFIED IDEOGRAPH-7950",
-	"祖": "CJK UNIFIED IDEOGRAPH-7956",
-	"祝": "CJK UNIFIED IDEOGRAPH-795D",
-	"神": "CJK UNIFIED IDEOGRAPH-795E",
-	"祥": "CJK UNIFIED IDEOGRAPH-7965",
-	"祿": "CJK UNIFIED IDEOGRAPH-797F",
-	"禍": "CJK UNIFIED IDEOGRAPH-798D",
-	"禎": "CJK UNIFIED IDEOGRAPH-798E",
-	"福": "CJK UNIFIED IDEOGRAPH-798F",
-	"禮": "CJK UNIFIED IDEOGRAPH-79AE",
-	"禸": "CJK UNIFIED IDEOGRAPH-79B8",
-	"禾": "CJK UNIFIED IDEOGRAPH-79BE",
-	"秊": "CJK UNIFIED IDEOGRAPH-79CA",
-	"秫": "CJK UNIFIED IDEOGRAPH-79EB",
-	"稜": "CJK UNIFIED IDEOGRAPH-7A1C",
-	"穀": "CJK UNIFIED IDEOGRAPH-7A40",
-	"穊": "CJK UNIFIED IDEOGRAPH-7A4A",
-	"穏": "CJK UNIFIED IDEOGRAPH-7A4F",
-	"穴": "CJK UNIFIED IDEOGRAPH-7A74",
-	"突": "CJK UNIFIED IDEOGRAPH-7A81",
-	"窱": "CJK UNIFIED IDEOGRAPH-7AB1",
-	"立": "CJK UNIFIED IDEOGRAPH-7ACB",
-	"竜": "CJK UNIFIED IDEOGRAPH-7ADC",
-	"竮": "CJK UNIFIED IDEOGRAPH-7AEE",
-	"竹": "CJK UNIFIED IDEOGRAPH-7AF9",
-	"笠": "CJK UNIFIED IDEOGRAPH-7B20",
-	"節": "CJK UNIFIED IDEOGRAPH-7BC0",
-	"篆": "CJK UNIFIED IDEOGRAPH-7BC6",
-	"築": "CJK UNIFIED IDEOGRAPH-7BC9",
-	"簾": "CJK UNIFIED IDEOGRAPH-7C3E",
-	"籠": "CJK UNIFIED IDEOGRAPH-7C60",
-	"米": "CJK UNIFIED IDEOGRAPH-7C73",
-	"类": "CJK UNIFIED IDEOGRAPH-7C7B",
-	"粒": "CJK UNIFIED IDEOGRAPH-7C92",
-	"精": "CJK UNIFIED IDEOGRAPH-7CBE",
-	"糒": "CJK UNIFIED IDEOGRAPH-7CD2",
-	"糖": "CJK UNIFIED IDEOGRAPH-7CD6",
-	"糣": "CJK UNIFIED IDEOGRAPH-7CE3",
-	"糧": "CJK UNIFIED IDEOGRAPH-7CE7",
-	"糨": "CJK UNIFIED IDEOGRAPH-7CE8",
-	"糸": "CJK UNIFIED IDEOGRAPH-7CF8",
-	"糹": "CJK UNIFIED IDEOGRAPH-7CF9",
-	"紀": "CJK UNIFIED IDEOGRAPH-7D00",
-	"紐": "CJK UNIFIED IDEOGRAPH-7D10",
-	"索": "CJK UNIFIED IDEOGRAPH-7D22",
-	"累": "CJK UNIFIED IDEOGRAPH-7D2F",
-	"絕": "CJK UNIFIED IDEOGRAPH-7D55",
-	"絛": "CJK UNIFIED IDEOGRAPH-7D5B",
-	"絣": "CJK UNIFIED IDEOGRAPH-7D63",
-	"絶": "CJK UNIFIED IDEOGRAPH-7D76",
-	"綠": "CJK UNIFIED IDEOGRAPH-7DA0",
-	"綾": "CJK UNIFIED IDEOGRAPH-7DBE",
-	"緇": "CJK UNIFIED IDEOGRAPH-7DC7",
-	"練": "CJK UNIFIED IDEOGRAPH-7DF4",
-	"縂": "CJK UNIFIED IDEOGRAPH-7E02",
-	"縉": "CJK UNIFIED IDEOGRAPH-7E09",
-	"縷": "CJK UNIFIED IDEOGRAPH-7E37",
-	"繁": "CJK UNIFIED IDEOGRAPH-7E41",
-	"繅": "CJK UNIFIED IDEOGRAPH-7E45",
-	"缶": "CJK UNIFIED IDEOGRAPH-7F36",
-	"缾": "CJK UNIFIED IDEOGRAPH-7F3E",
-	"网": "CJK UNIFIED IDEOGRAPH-7F51",
-	"罒": "CJK UNIFIED IDEOGRAPH-7F52",
-	"罓": "CJK UNIFIED IDEOGRAPH-7F53",
-	"署": "CJK UNIFIED IDEOGRAPH-7F72",
-	"罹": "CJK UNIFIED IDEOGRAPH-7F79",
-	"罺": "CJK UNIFIED IDEOGRAPH-7F7A",
-	"羅": "CJK UNIFIED IDEOGRAPH-7F85",
-	"羊": "CJK UNIFIED IDEOGRAPH-7F8A",
-	"羕": "CJK UNIFIED IDEOGRAPH-7F95",
-	"羚": "CJK UNIFIED IDEOGRAPH-7F9A",
-	"羽": "CJK UNIFIED IDEOGRAPH-7FBD",
-	"翺": "CJK UNIFIED IDEOGRAPH-7FFA",
-	"老": "CJK UNIFIED IDEOGRAPH-8001",
-	"耂": "CJK UNIFIED IDEOGRAPH-8002",
-	"者": "CJK UNIFIED IDEOGRAPH-8005",
-	"而": "CJK UNIFIED IDEOGRAPH-800C",
-	"耒": "CJK UNIFIED IDEOGRAPH-8012",
-	"耳": "CJK UNIFIED IDEOGRAPH-8033",
-	"聆": "CJK UNIFIED IDEOGRAPH-8046",
-	"聠": "CJK UNIFIED IDEOGRAPH-8060",
-	"聯": "CJK UNIFIED IDEOGRAPH-806F",
-	"聰": "CJK UNIFIED IDEOGRAPH-8070",
-	"聾": "CJK UNIFIED IDEOGRAPH-807E",
-	"聿": "CJK UNIFIED IDEOGRAPH-807F",
-	"肀": "CJK UNIFIED IDEOGRAPH-8080",
-	"肉": "CJK UNIFIED IDEOGRAPH-8089",
-	"肋": "CJK UNIFIED IDEOGRAPH-808B",
-	"肦": "CJK UNIFIED IDEOGRAPH-80A6",
-	"肭": "CJK UNIFIED IDEOGRAPH-80AD",
-	"育": "CJK UNIFIED IDEOGRAPH-80B2",
-	"胊": "CJK UNIFIED IDEOGRAPH-80CA",
-	"胐": "CJK UNIFIED IDEOGRAPH-80D0",
-	"胶": "CJK UNIFIED IDEOGRAPH-80F6",
-	"胼": "CJK UNIFIED IDEOGRAPH-80FC",
-	"脁": "CJK UNIFIED IDEOGRAPH-8101",
-	"脃": "CJK UNIFIED IDEOGRAPH-8103",
-	"脧": "CJK UNIFIED IDEOGRAPH-8127",
-	"脾": "CJK UNIFIED IDEOGRAPH-813E",
-	"腁": "CJK UNIFIED IDEOGRAPH-8141",
-	"膧": "CJK UNIFIED IDEOGRAPH-81A7",
-	"臘": "CJK UNIFIED IDEOGRAPH-81D8",
-	"臣": "CJK UNIFIED IDEOGRAPH-81E3",
-	"臨": "CJK UNIFIED IDEOGRAPH-81E8",
-	"自": "CJK UNIFIED IDEOGRAPH-81EA",
-	"臭": "CJK UNIFIED IDEOGRAPH-81ED",
-	"至": "CJK UNIFIED IDEOGRAPH-81F3",
-	"臼": "CJK UNIFIED IDEOGRAPH-81FC",
-	"舁": "CJK UNIFIED IDEOGRAPH-8201",
-	"舄": "CJK UNIFIED IDEOGRAPH-8204",
-	"舌": "CJK UNIFIED IDEOGRAPH-820C",
-	"舘": "CJK UNIFIED IDEOGRAPH-8218",
-	"舛": "CJK UNIFIED IDEOGRAPH-821B",
-	"舟": "CJK UNIFIED IDEOGRAPH-821F",
-	"艮": "CJK UNIFIED IDEOGRAPH-826E",
-	"良": "CJK UNIFIED IDEOGRAPH-826F",
-	"色": "CJK UNIFIED IDEOGRAPH-8272",
-	"艸": "CJK UNIFIED IDEOGRAPH-8278",
-	"艹": "CJK UNIFIED IDEOGRAPH-8279",
-	"芋": "CJK UNIFIED IDEOGRAPH-828B",
-	"芑": "CJK UNIFIED IDEOGRAPH-8291",
-	"芝": "CJK UNIFIED IDEOGRAPH-829D",
-	"花": "CJK UNIFIED IDEOGRAPH-82B1",
-	"芳": "CJK UNIFIED IDEOGRAPH-82B3",
-	"芽": "CJK UNIFIED IDEOGRAPH-82BD",
-	"若": "CJK UNIFIED IDEOGRAPH-82E5",
-	"苦": "CJK UNIFIED IDEOGRAPH-82E6",
-	"茝": "CJK UNIFIED IDEOGRAPH-831D",
-	"茣": "CJK UNIFIED IDEOGRAPH-8323",
-	"茶": "CJK UNIFIED IDEOGRAPH-8336",
-	"荒": "CJK UNIFIED IDEOGRAPH-8352",
-	"荓": "CJK UNIFIED IDEOGRAPH-8353",
-	"荣": "CJK UNIFIED IDEOGRAPH-8363",
-	"莭": "CJK UNIFIED IDEOGRAPH-83AD",
-	"莽": "CJK UNIFIED IDEOGRAPH-83BD",
-	"菉": "CJK UNIFIED IDEOGRAPH-83C9",
-	"菊": "CJK UNIFIED IDEOGRAPH-83CA",
-	"菌": "CJK UNIFIED IDEOGRAPH-83CC",
-	"菜": "CJK UNIFIED IDEOGRAPH-83DC",
-	"菧": "CJK UNIFIED IDEOGRAPH-83E7",
-	"華": "CJK UNIFIED IDEOGRAPH-83EF",
-	"菱": "CJK UNIFIED IDEOGRAPH-83F1",
-	"落": "CJK UNIFIED IDEOGRAPH-843D",
-	"葉": "CJK UNIFIED IDEOGRAPH-8449",
-	"著": "CJK UNIFIED IDEOGRAPH-8457",
-	"蒍": "CJK UNIFIED IDEOGRAPH-848D",
-	"蓮": "CJK UNIFIED IDEOGRAPH-84EE",
-	"蓱": "CJK UNIFIED IDEOGRAPH-84F1",
-	"蓳": "CJK UNIFIED IDEOGRAPH-84F3",
-	"蓼": "CJK UNIFIED IDEOGRAPH-84FC",
-	"蔖": "CJK UNIFIED IDEOGRAPH-8516",
-	"蔿": "CJK UNIFIED IDEOGRAPH-853F",
-	"蕤": "CJK UNIFIED IDEOGRAPH-8564",
-	"藍": "CJK UNIFIED IDEOGRAPH-85CD",
-	"藺": "CJK UNIFIED IDEOGRAPH-85FA",
-	"蘆": "CJK UNIFIED IDEOGRAPH-8606",
-	"蘒": "CJK UNIFIED IDEOGRAPH-8612",
-	"蘭": "CJK UNIFIED IDEOGRAPH-862D",
-	"蘷": "CJK UNIFIED IDEOGRAPH-8637",
-	"蘿": "CJK UNIFIED IDEOGRAPH-863F",
-	"虁": "CJK UNIFIED IDEOGRAPH-8641",
-	"虍": "CJK UNIFIED IDEOGRAPH-864D",
-	"虎": "CJK UNIFIED IDEOGRAPH-864E",
-	"虐": "CJK UNIFIED IDEOGRAPH-8650",
-	"虜": "CJK UNIFIED IDEOGRAPH-865C",
-	"虧": "CJK UNIFIED IDEOGRAPH-8667",
-	"虩": "CJK UNIFIED IDEOGRAPH-8669",
-	"虫": "CJK UNIFIED IDEOGRAPH-866B",
-	"蚈": "CJK UNIFIED IDEOGRAPH-8688",
-	"蚩": "CJK UNIFIED IDEOGRAPH-86A9",
-	"蛢": "CJK UNIFIED IDEOGRAPH-86E2",
-	"蜎": "CJK UNIFIED IDEOGRAPH-870E",
-	"蜨": "CJK UNIFIED IDEOGRAPH-8728",
-	"蝫": "CJK UNIFIED IDEOGRAPH-876B",
-	"蝹": "CJK UNIFIED IDEOGRAPH-8779",
-	"螆": "CJK UNIFIED IDEOGRAPH-8786",
-	"螺": "CJK UNIFIED IDEOGRAPH-87BA",
-	"蟡": "CJK UNIFIED IDEOGRAPH-87E1",
-	"蠁": "CJK UNIFIED IDEOGRAPH-8801",
-	"蠟": "CJK UNIFIED IDEOGRAPH-881F",
-	"血": "CJK UNIFIED IDEOGRAPH-8840",
-	"行": "CJK UNIFIED IDEOGRAPH-884C",
-	"衠": "CJK UNIFIED IDEOGRAPH-8860",
-	"衣": "CJK UNIFIED IDEOGRAPH-8863",
-	"衤": "CJK UNIFIED IDEOGRAPH-8864",
-	"裂": "CJK UNIFIED IDEOGRAPH-88C2",
-	"裏": "CJK UNIFIED IDEOGRAPH-88CF",
-	"裗": "CJK UNIFIED IDEOGRAPH-88D7",
-	"裞": "CJK UNIFIED IDEOGRAPH-88DE",
-	"裡": "CJK UNIFIED IDEOGRAPH-88E1",
-	"裸": "CJK UNIFIED IDEOGRAPH-88F8",
-	"裺": "CJK UNIFIED IDEOGRAPH-88FA",
-	"褐": "CJK UNIFIED IDEOGRAPH-8910",
-	"襁": "CJK UNIFIED IDEOGRAPH-8941",
-	"襤": "CJK UNIFIED IDEOGRAPH-8964",
-	"襾": "CJK UNIFIED IDEOGRAPH-897E",
-	"西": "CJK UNIFIED IDEOGRAPH-897F",
-	"覀": "CJK UNIFIED IDEOGRAPH-8980",
-	"覆": "CJK UNIFIED IDEOGRAPH-8986",
-	"見": "CJK UNIFIED IDEOGRAPH-898B",
-	"視": "CJK UNIFIED IDEOGRAPH-8996",
-	"见": "CJK UNIFIED IDEOGRAPH-89C1",
-	"角": "CJK UNIFIED IDEOGRAPH-89D2",
-	"言": "CJK UNIFIED IDEOGRAPH-8A00",
-	"訞": "CJK UNIFIED IDEOGRAPH-8A1E",
-	"訮": "CJK UNIFIED IDEOGRAPH-8A2E",
-	"詽": "CJK UNIFIED IDEOGRAPH-8A7D",
-	"誠": "CJK UNIFIED IDEOGRAPH-8AA0",
-	"說": "CJK UNIFIED IDEOGRAPH-8AAA",
-	"調": "CJK UNIFIED IDEOGRAPH-8ABF",
-	"請": "CJK UNIFIED IDEOGRAPH-8ACB",
-	"諒": "CJK UNIFIED IDEOGRAPH-8AD2",
-	"論": "CJK UNIFIED IDEOGRAPH-8AD6",
-	"諭": "CJK UNIFIED IDEOGRAPH-8AED",
-	"諸": "CJK UNIFIED IDEOGRAPH-8AF8",
-	"諾": "CJK UNIFIED IDEOGRAPH-8AFE",
-	"謁": "CJK UNIFIED IDEOGRAPH-8B01",
-	"謹": "CJK UNIFIED IDEOGRAPH-8B39",
-	"識": "CJK UNIFIED IDEOGRAPH-8B58",
-	"讀": "CJK UNIFIED IDEOGRAPH-8B80",
-	"讆": "CJK UNIFIED IDEOGRAPH-8B86",
-	"變": "CJK UNIFIED IDEOGRAPH-8B8A",
-	"讏": "CJK UNIFIED IDEOGRAPH-8B8F",
-	"讠": "CJK UNIFIED IDEOGRAPH-8BA0",
-	"谷": "CJK UNIFIED IDEOGRAPH-8C37",
-	"豆": "CJK UNIFIED IDEOGRAPH-8C46",
-	"豈": "CJK UNIFIED IDEOGRAPH-8C48",
-	"豕": "CJK UNIFIED IDEOGRAPH-8C55",
-	"豜": "CJK UNIFIED IDEOGRAPH-8C5C",
-	"豣": "CJK UNIFIED IDEOGRAPH-8C63",
-	"豸": "CJK UNIFIED IDEOGRAPH-8C78",
-	"貝": "CJK UNIFIED IDEOGRAPH-8C9D",
-	"貫": "CJK UNIFIED IDEOGRAPH-8CAB",
-	"賁": "CJK UNIFIED IDEOGRAPH-8CC1",
-	"賂": "CJK UNIFIED IDEOGRAPH-8CC2",
-	"賈": "CJK UNIFIED IDEOGRAPH-8CC8",
-	"賓": "CJK UNIFIED IDEOGRAPH-8CD3",
-	"贈": "CJK UNIFIED IDEOGRAPH-8D08",
-	"贛": "CJK UNIFIED IDEOGRAPH-8D1B",
-	"贝": "CJK UNIFIED IDEOGRAPH-8D1D",
-	"赤": "CJK UNIFIED IDEOGRAPH-8D64",
-	"走": "CJK UNIFIED IDEOGRAPH-8D70",
-	"起": "CJK UNIFIED IDEOGRAPH-8D77",
-	"赿": "CJK UNIFIED IDEOGRAPH-8D7F",
-	"趆": "CJK UNIFIED IDEOGRAPH-8D86",
-	"足": "CJK UNIFIED IDEOGRAPH-8DB3",
-	"趼": "CJK UNIFIED IDEOGRAPH-8DBC",
-	"跋": "CJK UNIFIED IDEOGRAPH-8DCB",
-	"跥": "CJK UNIFIED IDEOGRAPH-8DE5",
-	"路": "CJK UNIFIED IDEOGRAPH-8DEF",
-	"跰": "CJK UNIFIED IDEOGRAPH-8DF0",
-	"跺": "CJK UNIFIED IDEOGRAPH-8DFA",
-	"躗": "CJK UNIFIED IDEOGRAPH-8E97",
-	"躛": "CJK UNIFIED IDEOGRAPH-8E9B",
-	"身": "CJK UNIFIED IDEOGRAPH-8EAB",
-	"車": "CJK UNIFIED IDEOGRAPH-8ECA",
-	"軔": "CJK UNIFIED IDEOGRAPH-8ED4",
-	"軿": "CJK UNIFIED IDEOGRAPH-8EFF",
-	"輦": "CJK UNIFIED IDEOGRAPH-8F26",
-	"輧": "CJK UNIFIED IDEOGRAPH-8F27",
-	"輪": "CJK UNIFIED IDEOGRAPH-8F2A",
-	"輸": "CJK UNIFIED IDEOGRAPH-8F38",
-	"輻": "CJK UNIFIED IDEOGRAPH-8F3B",
-	"轢": "CJK UNIFIED IDEOGRAPH-8F62",
-	"车": "CJK UNIFIED IDEOGRAPH-8F66",
-	"辛": "CJK UNIFIED IDEOGRAPH-8F9B",
-	"辞": "CJK UNIFIED IDEOGRAPH-8F9E",
-	"辰": "CJK UNIFIED IDEOGRAPH-8FB0",
-	"辵": "CJK UNIFIED IDEOGRAPH-8FB5",
-	"辶": "CJK UNIFIED IDEOGRAPH-8FB6",
-	"連": "CJK UNIFIED IDEOGRAPH-9023",
-	"逸": "CJK UNIFIED IDEOGRAPH-9038",
-	"遲": "CJK UNIFIED IDEOGRAPH-9072",
-	"遼": "CJK UNIFIED IDEOGRAPH-907C",
-	"邏": "CJK UNIFIED IDEOGRAPH-908F",
-	"邑": "CJK UNIFIED IDEOGRAPH-9091",
-	"邔": "CJK UNIFIED IDEOGRAPH-9094",
-	"郎": "CJK UNIFIED IDEOGRAPH-90CE",
-	"郞": "CJK UNIFIED IDEOGRAPH-90DE",
-	"郱": "CJK UNIFIED IDEOGRAPH-90F1",
-	"都": "CJK UNIFIED IDEOGRAPH-90FD",
-	"鄑": "CJK UNIFIED IDEOGRAPH-9111",
-	"鄛": "CJK UNIFIED IDEOGRAPH-911B",
-	"酉": "CJK UNIFIED IDEOGRAPH-9149",
-	"酪": "CJK UNIFIED IDEOGRAPH-916A",
-	"醙": "CJK UNIFIED IDEOGRAPH-9199",
-	"醴": "CJK UNIFIED IDEOGRAPH-91B4",
-	"釆": "CJK UNIFIED IDEOGRAPH-91C6",
-	"里": "CJK UNIFIED IDEOGRAPH-91CC",
-	"量": "CJK UNIFIED IDEOGRAPH-91CF",
-	"金": "CJK UNIFIED IDEOGRAPH-91D1",
-	"鈴": "CJK UNIFIED IDEOGRAPH-9234",
-	"鈸": "CJK UNIFIED IDEOGRAPH-9238",
-	"鉶": "CJK UNIFIED IDEOGRAPH-9276",
-	"鉼": "CJK UNIFIED IDEOGRAPH-927C",
-	"鋗": "CJK UNIFIED IDEOGRAPH-92D7",
-	"鋘": "CJK UNIFIED IDEOGRAPH-92D8",
-	"錄": "CJK UNIFIED IDEOGRAPH-9304",
-	"鍊": "CJK UNIFIED IDEOGRAPH-934A",
-	"鎭": "CJK UNIFIED IDEOGRAPH-93AD",
-	"鎮": "CJK UNIFIED IDEOGRAPH-93AE",
-	"鏹": "CJK UNIFIED IDEOGRAPH-93F9",
-	"鐕": "CJK UNIFIED IDEOGRAPH-9415",
-	"钅": "CJK UNIFIED IDEOGRAPH-9485",
-	"長": "CJK UNIFIED IDEOGRAPH-9577",
-	"镸": "CJK UNIFIED IDEOGRAPH-9578",
-	"长": "CJK UNIFIED IDEOGRAPH-957F",
-	"門": "CJK UNIFIED IDEOGRAPH-9580",
-	"開": "CJK UNIFIED IDEOGRAPH-958B",
-	"閭": "CJK UNIFIED IDEOGRAPH-95AD",
-	"閷": "CJK UNIFIED IDEOGRAPH-95B7",
-	"门": "CJK UNIFIED IDEOGRAPH-95E8",
-	"阜": "CJK UNIFIED IDEOGRAPH-961C",
-	"阝": "CJK UNIFIED IDEOGRAPH-961D",
-	"阮": "CJK UNIFIED IDEOGRAPH-962E",
-	"陋": "CJK UNIFIED IDEOGRAPH-964B",
-	"降": "CJK UNIFIED IDEOGRAPH-964D",
-	"陵": "CJK UNIFIED IDEOGRAPH-9675",
-	"陸": "CJK UNIFIED IDEOGRAPH-9678",
-	"陼": "CJK UNIFIED IDEOGRAPH-967C",
-	"隆": "CJK UNIFIED IDEOGRAPH-9686",
-	"隣": "CJK UNIFIED IDEOGRAPH-96A3",
-	"隶": "CJK UNIFIED IDEOGRAPH-96B6",
-	"隷": "CJK UNIFIED IDEOGRAPH-96B7",
-	"隸": "CJK UNIFIED IDEOGRAPH-96B8",
-	"隹": "CJK UNIFIED IDEOGRAPH-96B9",
-	"雃": "CJK UNIFIED IDEOGRAPH-96C3",
-	"離": "CJK UNIFIED IDEOGRAPH-96E2",
-	"難": "CJK UNIFIED IDEOGRAPH-96E3",
-	"雨": "CJK UNIFIED IDEOGRAPH-96E8",
-	"零": "CJK UNIFIED IDEOGRAPH-96F6",
-	"雷": "CJK UNIFIED IDEOGRAPH-96F7",
-	"霣": "CJK UNIFIED IDEOGRAPH-9723",
-	"露": "CJK UNIFIED IDEOGRAPH-9732",
-	"靈": "CJK UNIFIED IDEOGRAPH-9748",
-	"靑": "CJK UNIFIED IDEOGRAPH-9751",
-	"青": "CJK UNIFIED IDEOGRAPH-9752",
-	"靖": "CJK UNIFIED IDEOGRAPH-9756",
-	"非": "CJK UNIFIED IDEOGRAPH-975E",
-	"面": "CJK UNIFIED IDEOGRAPH-9762",
-	"革": "CJK UNIFIED IDEOGRAPH-9769",
-	"韋": "CJK UNIFIED IDEOGRAPH-97CB",
-	"韛": "CJK UNIFIED IDEOGRAPH-97DB",
-	"韠": "CJK UNIFIED IDEOGRAPH-97E0",
-	"韦": "CJK UNIFIED IDEOGRAPH-97E6",
-	"韭": "CJK UNIFIED IDEOGRAPH-97ED",
-	"音": "CJK UNIFIED IDEOGRAPH-97F3",
-	"響": "CJK UNIFIED IDEOGRAPH-97FF",
-	"頁": "CJK UNIFIED IDEOGRAPH-9801",
-	"頋": "CJK UNIFIED IDEOGRAPH-980B",
-	"領": "CJK UNIFIED IDEOGRAPH-9818",
-	"頩": "CJK UNIFIED IDEOGRAPH-9829",
-	"頻": "CJK UNIFIED IDEOGRAPH-983B",
-	"類": "CJK UNIFIED IDEOGRAPH-985E",
-	"页": "CJK UNIFIED IDEOGRAPH-9875",
-	"風": "CJK UNIFIED IDEOGRAPH-98A8",
-	"风": "CJK UNIFIED IDEOGRAPH-98CE",
-	"飛": "CJK UNIFIED IDEOGRAPH-98DB",
-	"飞": "CJK UNIFIED IDEOGRAPH-98DE",
-	"食": "CJK UNIFIED IDEOGRAPH-98DF",
-	"飠": "CJK UNIFIED IDEOGRAPH-98E0",
-	"飢": "CJK UNIFIED IDEOGRAPH-98E2",
-	"飯": "CJK UNIFIED IDEOGRAPH-98EF",
-	"飼": "CJK UNIFIED IDEOGRAPH-98FC",
-	"館": "CJK UNIFIED IDEOGRAPH-9928",
-	"餩": "CJK UNIFIED IDEOGRAPH-9929",
-	"饣": "CJK UNIFIED IDEOGRAPH-9963",
-	"首": "CJK UNIFIED IDEOGRAPH-9996",
-	"香": "CJK UNIFIED IDEOGRAPH-9999",
-	"馧": "CJK UNIFIED IDEOGRAPH-99A7",
-	"馬": "CJK UNIFIED IDEOGRAPH-99AC",
-	"駂": "CJK UNIFIED IDEOGRAPH-99C2",
-	"駱": "CJK UNIFIED IDEOGRAPH-99F1",
-	"駾": "CJK UNIFIED IDEOGRAPH-99FE",
-	"驪": "CJK UNIFIED IDEOGRAPH-9A6A",
-	"马": "CJK UNIFIED IDEOGRAPH-9A6C",
-	"骨": "CJK UNIFIED IDEOGRAPH-9AA8",
-	"高": "CJK UNIFIED IDEOGRAPH-9AD8",
-	"髟": "CJK UNIFIED IDEOGRAPH-9ADF",
-	"鬒": "CJK UNIFIED IDEOGRAPH-9B12",
-	"鬥": "CJK UNIFIED IDEOGRAPH-9B25",
-	"鬯": "CJK UNIFIED IDEOGRAPH-9B2F",
-	"鬲": "CJK UNIFIED IDEOGRAPH-9B32",
-	"鬼": "CJK UNIFIED IDEOGRAPH-9B3C",
-	"魚": "CJK UNIFIED IDEOGRAPH-9B5A",
-	"魯": "CJK UNIFIED IDEOGRAPH-9B6F",
-	"鱀": "CJK UNIFIED IDEOGRAPH-9C40",
-	"鱗": "CJK UNIFIED IDEOGRAPH-9C57",
-	"鱼": "CJK UNIFIED IDEOGRAPH-9C7C",
-	"鳥": "CJK UNIFIED IDEOGRAPH-9CE5",
-	"鳽": "CJK UNIFIED IDEOGRAPH-9CFD",
-	"鵧": "CJK UNIFIED IDEOGRAPH-9D67",
-	"鶴": "CJK UNIFIED IDEOGRAPH-9DB4",
-	"鷺": "CJK UNIFIED IDEOGRAPH-9DFA",
-	"鸞": "CJK UNIFIED IDEOGRAPH-9E1E",
-	"鹂": "CJK UNIFIED IDEOGRAPH-9E42",
-	"鹃": "CJK UNIFIED IDEOGRAPH-9E43",
-	"鹵": "CJK UNIFIED IDEOGRAPH-9E75",
-	"鹿": "CJK UNIFIED IDEOGRAPH-9E7F",
-	"麗": "CJK UNIFIED IDEOGRAPH-9E97",
-	"麟": "CJK UNIFIED IDEOGRAPH-9E9F",
-	"麥": "CJK UNIFIED IDEOGRAPH-9EA5",
-	"麦": "CJK UNIFIED IDEOGRAPH-9EA6",
-	"麻": "CJK UNIFIED IDEOGRAPH-9EBB",
-	"黃": "CJK UNIFIED IDEOGRAPH-9EC3",
-	"黄": "CJK UNIFIED IDEOGRAPH-9EC4",
-	"黍": "CJK UNIFIED IDEOGRAPH-9ECD",
-	"黎": "CJK UNIFIED IDEOGRAPH-9ECE",
-	"黑": "CJK UNIFIED IDEOGRAPH-9ED1",
-	"黒": "CJK UNIFIED IDEOGRAPH-9ED2",
-	"黹": "CJK UNIFIED IDEOGRAPH-9EF9",
-	"黽": "CJK UNIFIED IDEOGRAPH-9EFD",
-	"黾": "CJK UNIFIED IDEOGRAPH-9EFE",
-	"鼅": "CJK UNIFIED IDEOGRAPH-9F05",
-	"鼎": "CJK UNIFIED IDEOGRAPH-9F0E",
-	"鼏": "CJK UNIFIED IDEOGRAPH-9F0F",
-	"鼓": "CJK UNIFIED IDEOGRAPH-9F13",
-	"鼖": "CJK UNIFIED IDEOGRAPH-9F16",
-	"鼠": "CJK UNIFIED IDEOGRAPH-9F20",
-	"鼻": "CJK UNIFIED IDEOGRAPH-9F3B",
-	"齃": "CJK UNIFIED IDEOGRAPH-9F43",
-	"齊": "CJK UNIFIED IDEOGRAPH-9F4A",
-	"齐": "CJK UNIFIED IDEOGRAPH-9F50",
-	"齒": "CJK UNIFIED IDEOGRAPH-9F52",
-	"齿": "CJK UNIFIED IDEOGRAPH-9F7F",
-	"龍": "CJK UNIFIED IDEOGRAPH-9F8D",
-	"龎": "CJK UNIFIED IDEOGRAPH-9F8E",
-	"龙": "CJK UNIFIED IDEOGRAPH-9F99",
-	"龜": "CJK UNIFIED IDEOGRAPH-9F9C",
-	"龟": "CJK UNIFIED IDEOGRAPH-9F9F",
-	"龠": "CJK UNIFIED IDEOGRAPH-9FA0",
-	"鿃": "CJK UNIFIED IDEOGRAPH-9FC3",
-	"ꁊ": "YI SYLLABLE PUT",
-	"ꁐ": "YI SYLLABLE PYT",
-	"ꃀ": "YI SYLLABLE MOP",
-	"ꄲ": "YI SYLLABLE TU",
-	"ꉙ": "YI SYLLABLE HXOP",
-	"ꊱ": "YI SYLLABLE CIP",
-	"ꋍ": "YI SYLLABLE CYP",
-	"ꎫ": "YI SYLLABLE SHAT",
-	"ꎵ": "YI SYLLABLE SHOP",
-	"ꎿ": "YI SYLLABLE SHUR",
-	"ꏂ": "YI SYLLABLE SHY",
-	"ꑘ": "YI SYLLABLE NYOP",
-	"꒔": "YI RADICAL CYP",
-	"꒜": "YI RADICAL MOP",
-	"꒞": "YI RADICAL PUT",
-	"꒧": "YI RADICAL NYOP",
-	"꒨": "YI RADICAL TU",
-	"꒬": "YI RADICAL PYT",
-	"꒰": "YI RADICAL SHY",
-	"꒺": "YI RADICAL SHUR",
-	"꒾": "YI RADICAL CIP",
-	"꒿": "YI RADICAL HXOP",
-	"꓀": "YI RADICAL SHAT",
-	"꓂": "YI RADICAL SHOP",
-	"ꓐ": "LISU LETTER BA",
-	"ꓑ": "LISU LETTER PA",
-	"ꓒ": "LISU LETTER PHA",
-	"ꓓ": "LISU LETTER DA",
-	"ꓔ": "LISU LETTER TA",
-	"ꓕ": "LISU LETTER THA",
-	"ꓖ": "LISU LETTER GA",
-	"ꓗ": "LISU LETTER KA",
-	"ꓙ": "LISU LETTER JA",
-	"ꓚ": "LISU LETTER CA",
-	"ꓛ": "LISU LETTER CHA",
-	"ꓜ": "LISU LETTER DZA",
-	"ꓝ": "LISU LETTER TSA",
-	"ꓞ": "LISU LETTER TSHA",
-	"ꓟ": "LISU LETTER MA",
-	"ꓠ": "LISU LETTER NA",
-	"ꓡ": "LISU LETTER LA",
-	"ꓢ": "LISU LETTER SA",
-	"ꓣ": "LISU LETTER ZHA",
-	"ꓤ": "LISU LETTER ZA",
-	"ꓥ": "LISU LETTER NGA",
-	"ꓦ": "LISU LETTER HA",
-	"ꓧ": "LISU LETTER XA",
-	"ꓨ": "LISU LETTER HHA",
-	"ꓪ": "LISU LETTER WA",
-	"ꓫ": "LISU LETTER SHA",
-	"ꓬ": "LISU LETTER YA",
-	"ꓭ": "LISU LETTER GHA",
-	"ꓮ": "LISU LETTER A",
-	"ꓯ": "LISU LETTER AE",
-	"ꓰ": "LISU LETTER E",
-	"ꓱ": "LISU LETTER EU",
-	"ꓲ": "LISU LETTER I",
-	"ꓳ": "LISU LETTER O",
-	"ꓴ": "LISU LETTER U",
-	"ꓵ": "LISU LETTER UE",
-	"ꓶ": "LISU LETTER UH",
-	"ꓷ": "LISU LETTER OE",
-	"ꓸ": "LISU LETTER TONE MYA TI",
-	"ꓹ": "LISU LETTER TONE NA PO",
-	"ꓺ": "LISU LETTER TONE MYA CYA",
-	"ꓻ": "LISU LETTER TONE MYA BO",
-	"ꓽ": "LISU LETTER TONE MYA JEU",
-	"꓾": "LISU PUNCTUATION COMMA",
-	"꓿": "LISU PUNCTUATION FULL STOP",
-	"꘎": "VAI FULL STOP",
-	"Ꙅ": "CYRILLIC CAPITAL LETTER REVERSED DZE",
-	"ꙅ": "CYRILLIC SMALL LETTER REVERSED DZE",
-	"ꙇ": "CYRILLIC SMALL LETTER IOTA",
-	"Ꙍ": "CYRILLIC CAPITAL LETTER BROAD OMEGA",
-	"ꙍ": "CYRILLIC SMALL LETTER BROAD OMEGA",
-	"Ꙑ": "CYRILLIC CAPITAL LETTER YERU WITH BACK YER",
-	"ꙑ": "CYRILLIC SMALL LETTER YERU WITH BACK YER",
-	"Ꙙ": "CYRILLIC CAPITAL LETTER CLOSED LITTLE YUS",
-	"Ꙩ": "CYRILLIC CAPITAL LETTER MONOCULAR O",
-	"ꙩ": "CYRILLIC SMALL LETTER MONOCULAR O",
-	"꙯": "COMBINING CYRILLIC VZMET",
-	"꙼": "COMBINING CYRILLIC KAVYKA",
-	"꙾": "CYRILLIC KAVYKA",
-	"ꚕ": "CYRILLIC SMALL LETTER HWE",
-	"Ꚙ": "CYRILLIC CAPITAL LETTER DOUBLE O",
-	"ꚙ": "CYRILLIC SMALL LETTER DOUBLE O",
-	"Ꚛ": "CYRILLIC CAPITAL LETTER CROSSED O",
-	"ꚡ": "BAMUM LETTER KA",
-	"ꚰ": "BAMUM LETTER TAA",
-	"ꚱ": "BAMUM LETTER NDAA",
-	"ꛍ": "BAMUM LETTER LU",
-	"ꛎ": "BAMUM LETTER MI",
-	"ꛛ": "BAMUM LETTER NA",
-	"ꛟ": "BAMUM LETTER KO",
-	"ꛫ": "BAMUM LETTER NTUU",
-	"ꛯ": "BAMUM LETTER KOGHOM",
-	"꛰": "BAMUM COMBINING MARK KOQNDON",
-	"꛱": "BAMUM COMBINING MARK TUKWENTIS",
-	"꛳꛳": "BAMUM FULL STOP, BAMUM FULL STOP",
-	"꛴": "BAMUM COLON",
-	"꜔": "MODIFIER LETTER MID LEFT-STEM TONE BAR",
-	"꜖": "MODIFIER LETTER EXTRA-LOW LEFT-STEM TONE BAR",
-	"ꜧ": "LATIN SMALL LETTER HENG",
-	"Ꜩ": "LATIN CAPITAL LETTER TZ",
-	"ꜩ": "LATIN SMALL LETTER TZ",
-	"ꜱ": "LATIN LETTER SMALL CAPITAL S",
-	"Ꜳ": "LATIN CAPITAL LETTER AA",
-	"ꜳ": "LATIN SMALL LETTER AA",
-	"Ꜵ": "LATIN CAPITAL LETTER AO",
-	"ꜵ": "LATIN SMALL LETTER AO",
-	"Ꜷ": "LATIN CAPITAL LETTER AU",
-	"ꜷ": "LATIN SMALL LETTER AU",
-	"Ꜹ": "LATIN CAPITAL LETTER AV",
-	"ꜹ": "LATIN SMALL LETTER AV",
-	"Ꜻ": "LATIN CAPITAL LETTER AV WITH HORIZONTAL BAR",
-	"ꜻ": "LATIN SMALL LETTER AV WITH HORIZONTAL BAR",
-	"Ꜽ": "LATIN CAPITAL LETTER AY",
-	"ꜽ": "LATIN SMALL LETTER AY",
-	"Ꜿ": "LATIN CAPITAL LETTER REVERSED C WITH DOT",
-	"ꜿ": "LATIN SMALL LETTER REVERSED C WITH DOT",
-	"Ꝁ": "LATIN CAPITAL LETTER K WITH STROKE",
-	"Ꝋ": "LATIN CAPITAL LETTER O WITH LONG STROKE OVERLAY",
-	"ꝋ": "LATIN SMALL LETTER O WITH LONG STROKE OVERLAY",
-	"Ꝏ": "LATIN CAPITAL LETTER OO",
-	"ꝏ": "LATIN SMALL LETTER OO",
-	"Ꝛ": "LATIN CAPITAL LETTER R ROTUNDA",
-	"ꝡ": "LATIN SMALL LETTER VY",
-	"Ꝫ": "LATIN CAPITAL LETTER ET",
-	"ꝫ": "LATIN SMALL LETTER ET",
-	"Ꝯ": "LATIN CAPITAL LETTER CON",
-	"ꝰ": "MODIFIER LETTER US",
-	"ꝷ": "LATIN SMALL LETTER TUM",
-	"ꝸ": "LATIN SMALL LETTER UM",
-	"Ꝺ": "LATIN CAPITAL LETTER INSULAR D",
-	"ꝺ": "LATIN SMALL LETTER INSULAR D",
-	"Ꞇ": "LATIN CAPITAL LETTER INSULAR T",
-	"꞉": "MODIFIER LETTER COLON",
-	"ꞌ": "LATIN SMALL LETTER SALTILLO",
-	"ꞏ": "LATIN LETTER SINOLOGICAL DOT",
-	"Ꞓ": "LATIN CAPITAL LETTER C WITH BAR",
-	"ꞓ": "LATIN SMALL LETTER C WITH BAR",
-	"ꞓ̲": "LATIN SMALL LETTER C WITH BAR, COMBINING LOW LINE",
-	"ꞕ": "LATIN SMALL LETTER H WITH PALATAL HOOK",
-	"Ꞙ": "LATIN CAPITAL LETTER F WITH STROKE",
-	"ꞙ": "LATIN SMALL LETTER F WITH STROKE",
-	"Ꞛ": "LATIN CAPITAL LETTER VOLAPUK AE",
-	"ꞛ": "LATIN SMALL LETTER VOLAPUK AE",
-	"ꞝ": "LATIN SMALL LETTER VOLAPUK OE",
-	"Ꞟ": "LATIN CAPITAL LETTER VOLAPUK UE",
-	"ꞟ": "LATIN SMALL LETTER VOLAPUK UE",
-	"Ɜ": "LATIN CAPITAL LETTER REVERSED OPEN E",
-	"Ʇ": "LATIN CAPITAL LETTER TURNED T",
-	"Ʝ": "LATIN CAPITAL LETTER J WITH CROSSED-TAIL",
-	"Ꭓ": "LATIN CAPITAL LETTER CHI",
-	"Ꞵ": "LATIN CAPITAL LETTER BETA",
-	"ꞵ": "LATIN SMALL LETTER BETA",
-	"Ꞷ": "LATIN CAPITAL LETTER OMEGA",
-	"ꞷ": "LATIN SMALL LETTER OMEGA",
-	"Ꟗ": "LATIN CAPITAL LETTER MIDDLE SCOTS S",
-	"ꟷ": "LATIN EPIGRAPHIC LETTER SIDEWAYS I",
-	"ꟻ": "LATIN EPIGRAPHIC LETTER REVERSED F",
-	"꠰": "NORTH INDIC FRACTION ONE QUARTER",
-	"ꣻ": "DEVANAGARI HEADSTROKE",
-	"꣼": "DEVANAGARI SIGN SIDDHAM",
-	"ꥠ": "HANGUL CHOSEONG TIKEUT-MIEUM",
-	"ꥡ": "HANGUL CHOSEONG TIKEUT-PIEUP",
-	"ꥢ": "HANGUL CHOSEONG TIKEUT-SIOS",
-	"ꥣ": "HANGUL CHOSEONG TIKEUT-CIEUC",
-	"ꥤ": "HANGUL CHOSEONG RIEUL-KIYEOK",
-	"ꥥ": "HANGUL CHOSEONG RIEUL-SSANGKIYEOK",
-	"ꥦ": "HANGUL CHOSEONG RIEUL-TIKEUT",
-	"ꥧ": "HANGUL CHOSEONG RIEUL-SSANGTIKEUT",
-	"ꥨ": "HANGUL CHOSEONG RIEUL-MIEUM",
-	"ꥩ": "HANGUL CHOSEONG RIEUL-PIEUP",
-	"ꥪ": "HANGUL CHOSEONG RIEUL-SSANGPIEUP",
-	"ꥫ": "HANGUL CHOSEONG RIEUL-KAPYEOUNPIEUP",
-	"ꥬ": "HANGUL CHOSEONG RIEUL-SIOS",
-	"ꥭ": "HANGUL CHOSEONG RIEUL-CIEUC",
-	"ꥮ": "HANGUL CHOSEONG RIEUL-KHIEUKH",
-	"ꥯ": "HANGUL CHOSEONG MIEUM-KIYEOK",
-	"ꥰ": "HANGUL CHOSEONG MIEUM-TIKEUT",
-	"ꥱ": "HANGUL CHOSEONG MIEUM-SIOS",
-	"ꥲ": "HANGUL CHOSEONG PIEUP-SIOS-THIEUTH",
-	"ꥳ": "HANGUL CHOSEONG PIEUP-KHIEUKH",
-	"ꥴ": "HANGUL CHOSEONG PIEUP-HIEUH",
-	"ꥵ": "HANGUL CHOSEONG SSANGSIOS-PIEUP",
-	"ꥶ": "HANGUL CHOSEONG IEUNG-RIEUL",
-	"ꥷ": "HANGUL CHOSEONG IEUNG-HIEUH",
-	"ꥸ": "HANGUL CHOSEONG SSANGCIEUC-HIEUH",
-	"ꥹ": "HANGUL CHOSEONG SSANGTHIEUTH",
-	"ꥺ": "HANGUL CHOSEONG PHIEUPH-HIEUH",
-	"ꥻ": "HANGUL CHOSEONG HIEUH-SIOS",
-	"ꥼ": "HANGUL CHOSEONG SSANGYEORINHIEUH",
-	"ꦒ": "JAVANESE LETTER GA",
-	"ꦝ": "JAVANESE LETTER DDA",
-	"ꦣ": "JAVANESE LETTER DA MAHAPRANA",
-	"꧆": "JAVANESE PADA WINDU",
-	"ꧏ": "JAVANESE PANGRANGKEP",
-	"꧐": "JAVANESE DIGIT ZERO",
-	"ꨁ": "CHAM LETTER I",
-	"ꨣ": "CHAM LETTER RA",
-	"꩓": "CHAM DIGIT THREE",
-	"꩖": "CHAM DIGIT SIX",
-	"ꬲ": "LATIN SMALL LETTER BLACKLETTER E",
-	"ꬵ": "LATIN SMALL LETTER LENIS F",
-	"ꬽ": "LATIN SMALL LETTER BLACKLETTER O",
-	"ꬾ": "LATIN SMALL LETTER BLACKLETTER O WITH STROKE",
-	"ꬿ": "LATIN SMALL LETTER OPEN O WITH STROKE",
-	"ꭁ": "LATIN SMALL LETTER TURNED OE WITH STROKE",
-	"ꭂ": "LATIN SMALL LETTER TURNED OE WITH HORIZONTAL STROKE",
-	"ꭇ": "LATIN SMALL LETTER R WITHOUT HANDLE",
-	"ꭈ": "LATIN SMALL LETTER DOUBLE R",
-	"ꭍ": "LATIN SMALL LETTER BASELINE ESH",
-	"ꭎ": "LATIN SMALL LETTER U WITH SHORT RIGHT LEG",
-	"ꭑ": "LATIN SMALL LETTER TURNED UI",
-	"ꭒ": "LATIN SMALL LETTER U WITH LEFT HOOK",
-	"ꭓ": "LATIN SMALL LETTER CHI",
-	"ꭕ": "LATIN SMALL LETTER CHI WITH LOW LEFT SERIF",
-	"ꭚ": "LATIN SMALL LETTER Y WITH SHORT RIGHT LEG",
-	"ꭠ": "LATIN SMALL LETTER SAKHA YAT",
-	"ꭢ": "LATIN SMALL LETTER OPEN OE",
-	"ꭣ": "LATIN SMALL LETTER UO",
-	"ꭰ": "CHEROKEE SMALL LETTER A",
-	"ꭱ": "CHEROKEE SMALL LETTER E",
-	"ꭲ": "CHEROKEE SMALL LETTER I",
-	"ꭴ": "CHEROKEE SMALL LETTER U",
-	"ꭵ": "CHEROKEE SMALL LETTER V",
-	"ꭺ": "CHEROKEE SMALL LETTER GO",
-	"ꭻ": "CHEROKEE SMALL LETTER GU",
-	"ꭼ": "CHEROKEE SMALL LETTER GV",
-	"ꭾ": "CHEROKEE SMALL LETTER HE",
-	"ꮀ": "CHEROKEE SMALL LETTER HO",
-	"ꮁ": "CHEROKEE SMALL LETTER HU",
-	"ꮃ": "CHEROKEE SMALL LETTER LA",
-	"ꮇ": "CHEROKEE SMALL LETTER LU",
-	"ꮋ": "CHEROKEE SMALL LETTER MI",
-	"ꮎ": "CHEROKEE SMALL LETTER NA",
-	"ꮐ": "CHEROKEE SMALL LETTER NAH",
-	"ꮓ": "CHEROKEE SMALL LETTER NO",
-	"ꮛ": "CHEROKEE SMALL LETTER QUV",
-	"ꮜ": "CHEROKEE SMALL LETTER SA",
-	"ꮟ": "CHEROKEE SMALL LETTER SI",
-	"ꮢ": "CHEROKEE SMALL LETTER SV",
-	"ꮩ": "CHEROKEE SMALL LETTER DO",
-	"ꮪ": "CHEROKEE SMALL LETTER DU",
-	"ꮮ": "CHEROKEE SMALL LETTER TLE",
-	"ꮯ": "CHEROKEE SMALL LETTER TLI",
-	"ꮲ": "CHEROKEE SMALL LETTER TLV",
-	"ꮶ": "CHEROKEE SMALL LETTER TSO",
-	"ꮻ": "CHEROKEE SMALL LETTER WI",
-	"ힰ": "HANGUL JUNGSEONG O-YEO",
-	"ힱ": "HANGUL JUNGSEONG O-O-I",
-	"ힲ": "HANGUL JUNGSEONG YO-A",
-	"ힳ": "HANGUL JUNGSEONG YO-AE",
-	"ힴ": "HANGUL JUNGSEONG YO-EO",
-	"ힵ": "HANGUL JUNGSEONG U-YEO",
-	"ힶ": "HANGUL JUNGSEONG U-I-I",
-	"ힷ": "HANGUL JUNGSEONG YU-AE",
-	"ힸ": "HANGUL JUNGSEONG YU-O",
-	"ힹ": "HANGUL JUNGSEONG EU-A",
-	"ힺ": "HANGUL JUNGSEONG EU-EO",
-	"ힻ": "HANGUL JUNGSEONG EU-E",
-	"ힼ": "HANGUL JUNGSEONG EU-O",
-	"ힽ": "HANGUL JUNGSEONG I-YA-O",
-	"ힾ": "HANGUL JUNGSEONG I-YAE",
-	"ힿ": "HANGUL JUNGSEONG I-YEO",
-	"ퟀ": "HANGUL JUNGSEONG I-YE",
-	"ퟁ": "HANGUL JUNGSEONG I-O-I",
-	"ퟂ": "HANGUL JUNGSEONG I-YO",
-	"ퟃ": "HANGUL JUNGSEONG I-YU",
-	"ퟄ": "HANGUL JUNGSEONG I-I",
-	"ퟅ": "HANGUL JUNGSEONG ARAEA-A",
-	"ퟆ": "HANGUL JUNGSEONG ARAEA-E",
-	"ퟋ": "HANGUL JONGSEONG NIEUN-RIEUL",
-	"ퟌ": "HANGUL JONGSEONG NIEUN-CHIEUCH",
-	"ퟍ": "HANGUL JONGSEONG SSANGTIKEUT",
-	"ퟎ": "HANGUL JONGSEONG SSANGTIKEUT-PIEUP",
-	"ퟏ": "HANGUL JONGSEONG TIKEUT-PIEUP",
-	"ퟐ": "HANGUL JONGSEONG TIKEUT-SIOS",
-	"ퟑ": "HANGUL JONGSEONG TIKEUT-SIOS-KIYEOK",
-	"ퟒ": "HANGUL JONGSEONG TIKEUT-CIEUC",
-	"ퟓ": "HANGUL JONGSEONG TIKEUT-CHIEUCH",
-	"ퟔ": "HANGUL JONGSEONG TIKEUT-THIEUTH",
-	"ퟕ": "HANGUL JONGSEONG RIEUL-SSANGKIYEOK",
-	"ퟖ": "HANGUL JONGSEONG RIEUL-KIYEOK-HIEUH",
-	"ퟗ": "HANGUL JONGSEONG SSANGRIEUL-KHIEUKH",
-	"ퟘ": "HANGUL JONGSEONG RIEUL-MIEUM-HIEUH",
-	"ퟙ": "HANGUL JONGSEONG RIEUL-PIEUP-TIKEUT",
-	"ퟚ": "HANGUL JONGSEONG RIEUL-PIEUP-PHIEUPH",
-	"ퟛ": "HANGUL JONGSEONG RIEUL-YESIEUNG",
-	"ퟜ": "HANGUL JONGSEONG RIEUL-YEORINHIEUH-HIEUH",
-	"ퟝ": "HANGUL JONGSEONG KAPYEOUNRIEUL",
-	"ퟞ": "HANGUL JONGSEONG MIEUM-NIEUN",
-	"ퟟ": "HANGUL JONGSEONG MIEUM-SSANGNIEUN",
-	"ퟠ": "HANGUL JONGSEONG SSANGMIEUM",
-	"ퟡ": "HANGUL JONGSEONG MIEUM-PIEUP-SIOS",
-	"ퟢ": "HANGUL JONGSEONG MIEUM-CIEUC",
-	"ퟣ": "HANGUL JONGSEONG PIEUP-TIKEUT",
-	"ퟤ": "HANGUL JONGSEONG PIEUP-RIEUL-PHIEUPH",
-	"ퟥ": "HANGUL JONGSEONG PIEUP-MIEUM",
-	"ퟦ": "HANGUL JONGSEONG SSANGPIEUP",
-	"ퟧ": "HANGUL JONGSEONG PIEUP-SIOS-TIKEUT",
-	"ퟨ": "HANGUL JONGSEONG PIEUP-CIEUC",
-	"ퟩ": "HANGUL JONGSEONG PIEUP-CHIEUCH",
-	"ퟪ": "HANGUL JONGSEONG SIOS-MIEUM",
-	"ퟫ": "HANGUL JONGSEONG SIOS-KAPYEOUNPIEUP",
-	"ퟬ": "HANGUL JONGSEONG SSANGSIOS-KIYEOK",
-	"ퟭ": "HANGUL JONGSEONG SSANGSIOS-TIKEUT",
-	"ퟮ": "HANGUL JONGSEONG SIOS-PANSIOS",
-	"ퟯ": "HANGUL JONGSEONG SIOS-CIEUC",
-	"ퟰ": "HANGUL JONGSEONG SIOS-CHIEUCH",
-	"ퟱ": "HANGUL JONGSEONG SIOS-THIEUTH",
-	"ퟲ": "HANGUL JONGSEONG SIOS-HIEUH",
-	"ퟳ": "HANGUL JONGSEONG PANSIOS-PIEUP",
-	"ퟴ": "HANGUL JONGSEONG PANSIOS-KAPYEOUNPIEUP",
-	"ퟵ": "HANGUL JONGSEONG YESIEUNG-MIEUM",
-	"ퟶ": "HANGUL JONGSEONG YESIEUNG-HIEUH",
-	"ퟷ": "HANGUL JONGSEONG CIEUC-PIEUP",
-	"ퟸ": "HANGUL JONGSEONG CIEUC-SSANGPIEUP",
-	"ퟹ": "HANGUL JONGSEONG SSANGCIEUC",
-	"ퟺ": "HANGUL JONGSEONG PHIEUPH-SIOS",
-	"ퟻ": "HANGUL JONGSEONG PHIEUPH-THIEUTH",
-	"豈": "CJK COMPATIBILITY IDEOGRAPH-F900",
-	"更": "CJK COMPATIBILITY IDEOGRAPH-F901",
-	"車": "CJK COMPATIBILITY IDEOGRAPH-F902",
-	"賈": "CJK COMPATIBILITY IDEOGRAPH-F903",
-	"滑": "CJK COMPATIBILITY IDEOGRAPH-F904",
-	"串": "CJK COMPATIBILITY IDEOGRAPH-F905",
-	"句": "CJK COMPATIBILITY IDEOGRAPH-F906",
-	"龜": "CJK COMPATIBILITY IDEOGRAPH-F907",
-	"龜": "CJK COMPATIBILITY IDEOGRAPH-F908",
-	"契": "CJK COMPATIBILITY IDEOGRAPH-F909",
-	"金": "CJK COMPATIBILITY IDEOGRAPH-F90A",
-	"喇": "CJK COMPATIBILITY IDEOGRAPH-F90B",
-	"奈": "CJK COMPATIBILITY IDEOGRAPH-F90C",
-	"懶": "CJK COMPATIBILITY IDEOGRAPH-F90D",
-	"癩": "CJK COMPATIBILITY IDEOGRAPH-F90E",
-	"羅": "CJK COMPATIBILITY IDEOGRAPH-F90F",
-	"蘿": "CJK COMPATIBILITY IDEOGRAPH-F910",
-	"螺": "CJK COMPATIBILITY IDEOGRAPH-F911",
-	"裸": "CJK COMPATIBILITY IDEOGRAPH-F912",
-	"邏": "CJK COMPATIBILITY IDEOGRAPH-F913",
-	"樂": "CJK COMPATIBILITY IDEOGRAPH-F914",
-	"洛": "CJK COMPATIBILITY IDEOGRAPH-F915",
-	"烙": "CJK COMPATIBILITY IDEOGRAPH-F916",
-	"珞": "CJK COMPATIBILITY IDEOGRAPH-F917",
-	"落": "CJK COMPATIBILITY IDEOGRAPH-F918",
-	"酪": "CJK COMPATIBILITY IDEOGRAPH-F919",
-	"駱": "CJK COMPATIBILITY IDEOGRAPH-F91A",
-	"亂": "CJK COMPATIBILITY IDEOGRAPH-F91B",
-	"卵": "CJK COMPATIBILITY IDEOGRAPH-F91C",
-	"欄": "CJK COMPATIBILITY IDEOGRAPH-F91D",
-	"爛": "CJK COMPATIBILITY IDEOGRAPH-F91E",
-	"蘭": "CJK COMPATIBILITY IDEOGRAPH-F91F",
-	"鸞": "CJK COMPATIBILITY IDEOGRAPH-F920",
-	"嵐": "CJK COMPATIBILITY IDEOGRAPH-F921",
-	"濫": "CJK COMPATIBILITY IDEOGRAPH-F922",
-	"藍": "CJK COMPATIBILITY IDEOGRAPH-F923",
-	"襤": "CJK COMPATIBILITY IDEOGRAPH-F924",
-	"拉": "CJK COMPATIBILITY IDEOGRAPH-F925",
-	"臘": "CJK COMPATIBILITY IDEOGRAPH-F926",
-	"蠟": "CJK COMPATIBILITY IDEOGRAPH-F927",
-	"廊": "CJK COMPATIBILITY IDEOGRAPH-F928",
-	"朗": "CJK COMPATIBILITY IDEOGRAPH-F929",
-	"浪": "CJK COMPATIBILITY IDEOGRAPH-F92A",
-	"狼": "CJK COMPATIBILITY IDEOGRAPH-F92B",
-	"郎": "CJK COMPATIBILITY IDEOGRAPH-F92C",
-	"來": "CJK COMPATIBILITY IDEOGRAPH-F92D",
-	"冷": "CJK COMPATIBILITY IDEOGRAPH-F92E",
-	"勞": "CJK COMPATIBILITY IDEOGRAPH-F92F",
-	"擄": "CJK COMPATIBILITY IDEOGRAPH-F930",
-	"櫓": "CJK COMPATIBILITY IDEOGRAPH-F931",
-	"爐": "CJK COMPATIBILITY IDEOGRAPH-F932",
-	"盧": "CJK COMPATIBILITY IDEOGRAPH-F933",
-	"老": "CJK COMPATIBILITY IDEOGRAPH-F934",
-	"蘆": "CJK COMPATIBILITY IDEOGRAPH-F935",
-	"虜": "CJK COMPATIBILITY IDEOGRAPH-F936",
-	"路": "CJK COMPATIBILITY IDEOGRAPH-F937",
-	"露": "CJK COMPATIBILITY IDEOGRAPH-F938",
-	"魯": "CJK COMPATIBILITY IDEOGRAPH-F939",
-	"鷺": "CJK COMPATIBILITY IDEOGRAPH-F93A",
-	"碌": "CJK COMPATIBILITY IDEOGRAPH-F93B",
-	"祿": "CJK COMPATIBILITY IDEOGRAPH-F93C",
-	"綠": "CJK COMPATIBILITY IDEOGRAPH-F93D",
-	"菉": "CJK COMPATIBILITY IDEOGRAPH-F93E",
-	"錄": "CJK COMPATIBILITY IDEOGRAPH-F93F",
-	"鹿": "CJK COMPATIBILITY IDEOGRAPH-F940",
-	"論": "CJK COMPATIBILITY IDEOGRAPH-F941",
-	"壟": "CJK COMPATIBILITY IDEOGRAPH-F942",
-	"弄": "CJK COMPATIBILITY IDEOGRAPH-F943",
-	"籠": "CJK COMPATIBILITY IDEOGRAPH-F944",
-	"聾": "CJK COMPATIBILITY IDEOGRAPH-F945",
-	"牢": "CJK COMPATIBILITY IDEOGRAPH-F946",
-	"磊": "CJK COMPATIBILITY IDEOGRAPH-F947",
-	"賂": "CJK COMPATIBILITY IDEOGRAPH-F948",
-	"雷": "CJK COMPATIBILITY IDEOGRAPH-F949",
-	"壘": "CJK COMPATIBILITY IDEOGRAPH-F94A",
-	"屢": "CJK COMPATIBILITY IDEOGRAPH-F94B",
-	"樓": "CJK COMPATIBILITY IDEOGRAPH-F94C",
-	"淚": "CJK COMPATIBILITY IDEOGRAPH-F94D",
-	"漏": "CJK COMPATIBILITY IDEOGRAPH-F94E",
-	"累": "CJK COMPATIBILITY IDEOGRAPH-F94F",
-	"縷": "CJK COMPATIBILITY IDEOGRAPH-F950",
-	"陋": "CJK COMPATIBILITY IDEOGRAPH-F951",
-	"勒": "CJK COMPATIBILITY IDEOGRAPH-F952",
-	"肋": "CJK COMPATIBILITY IDEOGRAPH-F953",
-	"凜": "CJK COMPATIBILITY IDEOGRAPH-F954",
-	"凌": "CJK COMPATIBILITY IDEOGRAPH-F955",
-	"稜": "CJK COMPATIBILITY IDEOGRAPH-F956",
-	"綾": "CJK COMPATIBILITY IDEOGRAPH-F957",
-	"菱": "CJK COMPATIBILITY IDEOGRAPH-F958",
-	"陵": "CJK COMPATIBILITY IDEOGRAPH-F959",
-	"讀": "CJK COMPATIBILITY IDEOGRAPH-F95A",
-	"拏": "CJK COMPATIBILITY IDEOGRAPH-F95B",
-	"樂": "CJK COMPATIBILITY IDEOGRAPH-F95C",
-	"諾": "CJK COMPATIBILITY IDEOGRAPH-F95D",
-	"丹": "CJK COMPATIBILITY IDEOGRAPH-F95E",
-	"寧": "CJK COMPATIBILITY IDEOGRAPH-F95F",
-	"怒": "CJK COMPATIBILITY IDEOGRAPH-F960",
-	"率": "CJK COMPATIBILITY IDEOGRAPH-F961",
-	"異": "CJK COMPATIBILITY IDEOGRAPH-F962",
-	"北": "CJK COMPATIBILITY IDEOGRAPH-F963",
-	"磻": "CJK COMPATIBILITY IDEOGRAPH-F964",
-	"便": "CJK COMPATIBILITY IDEOGRAPH-F965",
-	"復": "CJK COMPATIBILITY IDEOGRAPH-F966",
-	"不": "CJK COMPATIBILITY IDEOGRAPH-F967",
-	"泌": "CJK COMPATIBILITY IDEOGRAPH-F968",
-	"數": "CJK COMPATIBILITY IDEOGRAPH-F969",
-	"索": "CJK COMPATIBILITY IDEOGRAPH-F96A",
-	"參": "CJK COMPATIBILITY IDEOGRAPH-F96B",
-	"塞": "CJK COMPATIBILITY IDEOGRAPH-F96C",
-	"省": "CJK COMPATIBILITY IDEOGRAPH-F96D",
-	"葉": "CJK COMPATIBILITY IDEOGRAPH-F96E",
-	"說": "CJK COMPATIBILITY IDEOGRAPH-F96F",
-	"殺": "CJK COMPATIBILITY IDEOGRAPH-F970",
-	"辰": "CJK COMPATIBILITY IDEOGRAPH-F971",
-	"沈": "CJK COMPATIBILITY IDEOGRAPH-F972",
-	"拾": "CJK COMPATIBILITY IDEOGRAPH-F973",
-	"若": "CJK COMPATIBILITY IDEOGRAPH-F974",
-	"掠": "CJK COMPATIBILITY IDEOGRAPH-F975",
-	"略": "CJK COMPATIBILITY IDEOGRAPH-F976",
-	"亮": "CJK COMPATIBILITY IDEOGRAPH-F977",
-	"兩": "CJK COMPATIBILITY IDEOGRAPH-F978",
-	"凉": "CJK COMPATIBILITY IDEOGRAPH-F979",
-	"梁": "CJK COMPATIBILITY IDEOGRAPH-F97A",
-	"糧": "CJK COMPATIBILITY IDEOGRAPH-F97B",
-	"良": "CJK COMPATIBILITY IDEOGRAPH-F97C",
-	"諒": "CJK COMPATIBILITY IDEOGRAPH-F97D",
-	"量": "CJK COMPATIBILITY IDEOGRAPH-F97E",
-	"勵": "CJK COMPATIBILITY IDEOGRAPH-F97F",
-	"呂": "CJK COMPATIBILITY IDEOGRAPH-F980",
-	"女": "CJK COMPATIBILITY IDEOGRAPH-F981",
-	"廬": "CJK COMPATIBILITY IDEOGRAPH-F982",
-	"旅": "CJK COMPATIBILITY IDEOGRAPH-F983",
-	"濾": "CJK COMPATIBILITY IDEOGRAPH-F984",
-	"礪": "CJK COMPATIBILITY IDEOGRAPH-F985",
-	"閭": "CJK COMPATIBILITY IDEOGRAPH-F986",
-	"驪": "CJK COMPATIBILITY IDEOGRAPH-F987",
-	"麗": "CJK COMPATIBILITY IDEOGRAPH-F988",
-	"黎": "CJK COMPATIBILITY IDEOGRAPH-F989",
-	"力": "CJK COMPATIBILITY IDEOGRAPH-F98A",
-	"曆": "CJK COMPATIBILITY IDEOGRAPH-F98B",
-	"歷": "CJK COMPATIBILITY IDEOGRAPH-F98C",
-	"轢": "CJK COMPATIBILITY IDEOGRAPH-F98D",
-	"年": "CJK COMPATIBILITY IDEOGRAPH-F98E",
-	"憐": "CJK COMPATIBILITY IDEOGRAPH-F98F",
-	"戀": "CJK COMPATIBILITY IDEOGRAPH-F990",
-	"撚": "CJK COMPATIBILITY IDEOGRAPH-F991",
-	"漣": "CJK COMPATIBILITY IDEOGRAPH-F992",
-	"煉": "CJK COMPATIBILITY IDEOGRAPH-F993",
-	"璉": "CJK COMPATIBILITY IDEOGRAPH-F994",
-	"秊": "CJK COMPATIBILITY IDEOGRAPH-F995",
-	"練": "CJK COMPATIBILITY IDEOGRAPH-F996",
-	"聯": "CJK COMPATIBILITY IDEOGRAPH-F997",
-	"輦": "CJK COMPATIBILITY IDEOGRAPH-F998",
-	"蓮": "CJK COMPATIBILITY IDEOGRAPH-F999",
-	"連": "CJK COMPATIBILITY IDEOGRAPH-F99A",
-	"鍊": "CJK COMPATIBILITY IDEOGRAPH-F99B",
-	"列": "CJK COMPATIBILITY IDEOGRAPH-F99C",
-	"劣": "CJK COMPATIBILITY IDEOGRAPH-F99D",
-	"咽": "CJK COMPATIBILITY IDEOGRAPH-F99E",
-	"烈": "CJK COMPATIBILITY IDEOGRAPH-F99F",
-	"裂": "CJK COMPATIBILITY IDEOGRAPH-F9A0",
-	"說": "CJK COMPATIBILITY IDEOGRAPH-F9A1",
-	"廉": "CJK COMPATIBILITY IDEOGRAPH-F9A2",
-	"念": "CJK COMPATIBILITY IDEOGRAPH-F9A3",
-	"捻": "CJK COMPATIBILITY IDEOGRAPH-F9A4",
-	"殮": "CJK COMPATIBILITY IDEOGRAPH-F9A5",
-	"簾": "CJK COMPATIBILITY IDEOGRAPH-F9A6",
-	"獵": "CJK COMPATIBILITY IDEOGRAPH-F9A7",
-	"令": "CJK COMPATIBILITY IDEOGRAPH-F9A8",
-	"囹": "CJK COMPATIBILITY IDEOGRAPH-F9A9",
-	"寧": "CJK COMPATIBILITY IDEOGRAPH-F9AA",
-	"嶺": "CJK COMPATIBILITY IDEOGRAPH-F9AB",
-	"怜": "CJK COMPATIBILITY IDEOGRAPH-F9AC",
-	"玲": "CJK COMPATIBILITY IDEOGRAPH-F9AD",
-	"瑩": "CJK COMPATIBILITY IDEOGRAPH-F9AE",
-	"羚": "CJK COMPATIBILITY IDEOGRAPH-F9AF",
-	"聆": "CJK COMPATIBILITY IDEOGRAPH-F9B0",
-	"鈴": "CJK COMPATIBILITY IDEOGRAPH-F9B1",
-	"零": "CJK COMPATIBILITY IDEOGRAPH-F9B2",
-	"靈": "CJK COMPATIBILITY IDEOGRAPH-F9B3",
-	"領": "CJK COMPATIBILITY IDEOGRAPH-F9B4",
-	"例": "CJK COMPATIBILITY IDEOGRAPH-F9B5",
-	"禮": "CJK COMPATIBILITY IDEOGRAPH-F9B6",
-	"醴": "CJK COMPATIBILITY IDEOGRAPH-F9B7",
-	"隸": "CJK COMPATIBILITY IDEOGRAPH-F9B8",
-	"惡": "CJK COMPATIBILITY IDEOGRAPH-F9B9",
-	"了": "CJK COMPATIBILITY IDEOGRAPH-F9BA",
-	"僚": "CJK COMPATIBILITY IDEOGRAPH-F9BB",
-	"寮": "CJK COMPATIBILITY IDEOGRAPH-F9BC",
-	"尿": "CJK COMPATIBILITY IDEOGRAPH-F9BD",
-	"料": "CJK COMPATIBILITY IDEOGRAPH-F9BE",
-	"樂": "CJK COMPATIBILITY IDEOGRAPH-F9BF",
-	"燎": "CJK COMPATIBILITY IDEOGRAPH-F9C0",
-	"療": "CJK COMPATIBILITY IDEOGRAPH-F9C1",
-	"蓼": "CJK COMPATIBILITY IDEOGRAPH-F9C2",
-	"遼": "CJK COMPATIBILITY IDEOGRAPH-F9C3",
-	"龍": "CJK COMPATIBILITY IDEOGRAPH-F9C4",
-	"暈": "CJK COMPATIBILITY IDEOGRAPH-F9C5",
-	"阮": "CJK COMPATIBILITY IDEOGRAPH-F9C6",
-	"劉": "CJK COMPATIBILITY IDEOGRAPH-F9C7",
-	"杻": "CJK COMPATIBILITY IDEOGRAPH-F9C8",
-	"柳": "CJK COMPATIBILITY IDEOGRAPH-F9C9",
-	"流": "CJK COMPATIBILITY IDEOGRAPH-F9CA",
-	"溜": "CJK COMPATIBILITY IDEOGRAPH-F9CB",
-	"琉": "CJK COMPATIBILITY IDEOGRAPH-F9CC",
-	"留": "CJK COMPATIBILITY IDEOGRAPH-F9CD",
-	"硫": "CJK COMPATIBILITY IDEOGRAPH-F9CE",
-	"紐": "CJK COMPATIBILITY IDEOGRAPH-F9CF",
-	"類": "CJK COMPATIBILITY IDEOGRAPH-F9D0",
-	"六": "CJK COMPATIBILITY IDEOGRAPH-F9D1",
-	"戮": "CJK COMPATIBILITY IDEOGRAPH-F9D2",
-	"陸": "CJK COMPATIBILITY IDEOGRAPH-F9D3",
-	"倫": "CJK COMPATIBILITY IDEOGRAPH-F9D4",
-	"崙": "CJK COMPATIBILITY IDEOGRAPH-F9D5",
-	"淪": "CJK COMPATIBILITY IDEOGRAPH-F9D6",
-	"輪": "CJK COMPATIBILITY IDEOGRAPH-F9D7",
-	"律": "CJK COMPATIBILITY IDEOGRAPH-F9D8",
-	"慄": "CJK COMPATIBILITY IDEOGRAPH-F9D9",
-	"栗": "CJK COMPATIBILITY IDEOGRAPH-F9DA",
-	"率": "CJK COMPATIBILITY IDEOGRAPH-F9DB",
-	"隆": "CJK COMPATIBILITY IDEOGRAPH-F9DC",
-	"利": "CJK COMPATIBILITY IDEOGRAPH-F9DD",
-	"吏": "CJK COMPATIBILITY IDEOGRAPH-F9DE",
-	"履": "CJK COMPATIBILITY IDEOGRAPH-F9DF",
-	"易": "CJK COMPATIBILITY IDEOGRAPH-F9E0",
-	"李": "CJK COMPATIBILITY IDEOGRAPH-F9E1",
-	"梨": "CJK COMPATIBILITY IDEOGRAPH-F9E2",
-	"泥": "CJK COMPATIBILITY IDEOGRAPH-F9E3",
-	"理": "CJK COMPATIBILITY IDEOGRAPH-F9E4",
-	"痢": "CJK COMPATIBILITY IDEOGRAPH-F9E5",
-	"罹": "CJK COMPATIBILITY IDEOGRAPH-F9E6",
-	"裏": "CJK COMPATIBILITY IDEOGRAPH-F9E7",
-	"裡": "CJK COMPATIBILITY IDEOGRAPH-F9E8",
-	"里": "CJK COMPATIBILITY IDEOGRAPH-F9E9",
-	"離": "CJK COMPATIBILITY IDEOGRAPH-F9EA",
-	"匿": "CJK COMPATIBILITY IDEOGRAPH-F9EB",
-	"溺": "CJK COMPATIBILITY IDEOGRAPH-F9EC",
-	"吝": "CJK COMPATIBILITY IDEOGRAPH-F9ED",
-	"燐": "CJK COMPATIBILITY IDEOGRAPH-F9EE",
-	"璘": "CJK COMPATIBILITY IDEOGRAPH-F9EF",
-	"藺": "CJK COMPATIBILITY IDEOGRAPH-F9F0",
-	"隣": "CJK COMPATIBILITY IDEOGRAPH-F9F1",
-	"鱗": "CJK COMPATIBILITY IDEOGRAPH-F9F2",
-	"麟": "CJK COMPATIBILITY IDEOGRAPH-F9F3",
-	"林": "CJK COMPATIBILITY IDEOGRAPH-F9F4",
-	"淋": "CJK COMPATIBILITY IDEOGRAPH-F9F5",
-	"臨": "CJK COMPATIBILITY IDEOGRAPH-F9F6",
-	"立": "CJK COMPATIBILITY IDEOGRAPH-F9F7",
-	"笠": "CJK COMPATIBILITY IDEOGRAPH-F9F8",
-	"粒": "CJK COMPATIBILITY IDEOGRAPH-F9F9",
-	"狀": "CJK COMPATIBILITY IDEOGRAPH-F9FA",
-	"炙": "CJK COMPATIBILITY IDEOGRAPH-F9FB",
-	"識": "CJK COMPATIBILITY IDEOGRAPH-F9FC",
-	"什": "CJK COMPATIBILITY IDEOGRAPH-F9FD",
-	"茶": "CJK COMPATIBILITY IDEOGRAPH-F9FE",
-	"刺": "CJK COMPATIBILITY IDEOGRAPH-F9FF",
-	"切": "CJK COMPATIBILITY IDEOGRAPH-FA00",
-	"度": "CJK COMPATIBILITY IDEOGRAPH-FA01",
-	"拓": "CJK COMPATIBILITY IDEOGRAPH-FA02",
-	"糖": "CJK COMPATIBILITY IDEOGRAPH-FA03",
-	"宅": "CJK COMPATIBILITY IDEOGRAPH-FA04",
-	"洞": "CJK COMPATIBILITY IDEOGRAPH-FA05",
-	"暴": "CJK COMPATIBILITY IDEOGRAPH-FA06",
-	"輻": "CJK COMPATIBILITY IDEOGRAPH-FA07",
-	"行": "CJK COMPATIBILITY IDEOGRAPH-FA08",
-	"降": "CJK COMPATIBILITY IDEOGRAPH-FA09",
-	"見": "CJK COMPATIBILITY IDEOGRAPH-FA0A",
-	"廓": "CJK COMPATIBILITY IDEOGRAPH-FA0B",
-	"兀": "CJK COMPATIBILITY IDEOGRAPH-FA0C",
-	"嗀": "CJK COMPATIBILITY IDEOGRAPH-FA0D",
-	"塚": "CJK COMPATIBILITY IDEOGRAPH-FA10",
-	"晴": "CJK COMPATIBILITY IDEOGRAPH-FA12",
-	"凞": "CJK COMPATIBILITY IDEOGRAPH-FA15",
-	"猪": "CJK COMPATIBILITY IDEOGRAPH-FA16",
-	"益": "CJK COMPATIBILITY IDEOGRAPH-FA17",
-	"礼": "CJK COMPATIBILITY IDEOGRAPH-FA18",
-	"神": "CJK COMPATIBILITY IDEOGRAPH-FA19",
-	"祥": "CJK COMPATIBILITY IDEOGRAPH-FA1A",
-	"福": "CJK COMPATIBILITY IDEOGRAPH-FA1B",
-	"靖": "CJK COMPATIBILITY IDEOGRAPH-FA1C",
-	"精": "CJK COMPATIBILITY IDEOGRAPH-FA1D",
-	"羽": "CJK COMPATIBILITY IDEOGRAPH-FA1E",
-	"蘒": "CJK COMPATIBILITY IDEOGRAPH-FA20",
-	"諸": "CJK COMPATIBILITY IDEOGRAPH-FA22",
-	"逸": "CJK COMPATIBILITY IDEOGRAPH-FA25",
-	"都": "CJK COMPATIBILITY IDEOGRAPH-FA26",
-	"飯": "CJK COMPATIBILITY IDEOGRAPH-FA2A",
-	"飼": "CJK COMPATIBILITY IDEOGRAPH-FA2B",
-	"館": "CJK COMPATIBILITY IDEOGRAPH-FA2C",
-	"鶴": "CJK COMPATIBILITY IDEOGRAPH-FA2D",
-	"郞": "CJK COMPATIBILITY IDEOGRAPH-FA2E",
-	"隷": "CJK COMPATIBILITY IDEOGRAPH-FA2F",
-	"侮": "CJK COMPATIBILITY IDEOGRAPH-FA30",
-	"僧": "CJK COMPATIBILITY IDEOGRAPH-FA31",
-	"免": "CJK COMPATIBILITY IDEOGRAPH-FA32",
-	"勉": "CJK COMPATIBILITY IDEOGRAPH-FA33",
-	"勤": "CJK COMPATIBILITY IDEOGRAPH-FA34",
-	"卑": "CJK COMPATIBILITY IDEOGRAPH-FA35",
-	"喝": "CJK COMPATIBILITY IDEOGRAPH-FA36",
-	"嘆": "CJK COMPATIBILITY IDEOGRAPH-FA37",
-	"器": "CJK COMPATIBILITY IDEOGRAPH-FA38",
-	"塀": "CJK COMPATIBILITY IDEOGRAPH-FA39",
-	"墨": "CJK COMPATIBILITY IDEOGRAPH-FA3A",
-	"層": "CJK COMPATIBILITY IDEOGRAPH-FA3B",
-	"屮": "CJK COMPATIBILITY IDEOGRAPH-FA3C",
-	"悔": "CJK COMPATIBILITY IDEOGRAPH-FA3D",
-	"慨": "CJK COMPATIBILITY IDEOGRAPH-FA3E",
-	"憎": "CJK COMPATIBILITY IDEOGRAPH-FA3F",
-	"懲": "CJK COMPATIBILITY IDEOGRAPH-FA40",
-	"敏": "CJK COMPATIBILITY IDEOGRAPH-FA41",
-	"既": "CJK COMPATIBILITY IDEOGRAPH-FA42",
-	"暑": "CJK COMPATIBILITY IDEOGRAPH-FA43",
-	"梅": "CJK COMPATIBILITY IDEOGRAPH-FA44",
-	"海": "CJK COMPATIBILITY IDEOGRAPH-FA45",
-	"渚": "CJK COMPATIBILITY IDEOGRAPH-FA46",
-	"漢": "CJK COMPATIBILITY IDEOGRAPH-FA47",
-	"煮": "CJK COMPATIBILITY IDEOGRAPH-FA48",
-	"爫": "CJK COMPATIBILITY IDEOGRAPH-FA49",
-	"琢": "CJK COMPATIBILITY IDEOGRAPH-FA4A",
-	"碑": "CJK COMPATIBILITY IDEOGRAPH-FA4B",
-	"社": "CJK COMPATIBILITY IDEOGRAPH-FA4C",
-	"祉": "CJK COMPATIBILITY IDEOGRAPH-FA4D",
-	"祈": "CJK COMPATIBILITY IDEOGRAPH-FA4E",
-	"祐": "CJK COMPATIBILITY IDEOGRAPH-FA4F",
-	"祖": "CJK COMPATIBILITY IDEOGRAPH-FA50",
-	"祝": "CJK COMPATIBILITY IDEOGRAPH-FA51",
-	"禍": "CJK COMPATIBILITY IDEOGRAPH-FA52",
-	"禎": "CJK COMPATIBILITY IDEOGRAPH-FA53",
-	"穀": "CJK COMPATIBILITY IDEOGRAPH-FA54",
-	"突": "CJK COMPATIBILITY IDEOGRAPH-FA55",
-	"節": "CJK COMPATIBILITY IDEOGRAPH-FA56",
-	"練": "CJK COMPATIBILITY IDEOGRAPH-FA57",
-	"縉": "CJK COMPATIBILITY IDEOGRAPH-FA58",
-	"繁": "CJK COMPATIBILITY IDEOGRAPH-FA59",
-	"署": "CJK COMPATIBILITY IDEOGRAPH-FA5A",
-	"者": "CJK COMPATIBILITY IDEOGRAPH-FA5B",
-	"臭": "CJK COMPATIBILITY IDEOGRAPH-FA5C",
-	"艹": "CJK COMPATIBILITY IDEOGRAPH-FA5D",
-	"艹": "CJK COMPATIBILITY IDEOGRAPH-FA5E",
-	"著": "CJK COMPATIBILITY IDEOGRAPH-FA5F",
-	"褐": "CJK COMPATIBILITY IDEOGRAPH-FA60",
-	"視": "CJK COMPATIBILITY IDEOGRAPH-FA61",
-	"謁": "CJK COMPATIBILITY IDEOGRAPH-FA62",
-	"謹": "CJK COMPATIBILITY IDEOGRAPH-FA63",
-	"賓": "CJK COMPATIBILITY IDEOGRAPH-FA64",
-	"贈": "CJK COMPATIBILITY IDEOGRAPH-FA65",
-	"辶": "CJK COMPATIBILITY IDEOGRAPH-FA66",
-	"逸": "CJK COMPATIBILITY IDEOGRAPH-FA67",
-	"難": "CJK COMPATIBILITY IDEOGRAPH-FA68",
-	"響": "CJK COMPATIBILITY IDEOGRAPH-FA69",
-	"頻": "CJK COMPATIBILITY IDEOGRAPH-FA6A",
-	"恵": "CJK COMPATIBILITY IDEOGRAPH-FA6B",
-	"𤋮": "CJK COMPATIBILITY IDEOGRAPH-FA6C",
-	"舘": "CJK COMPATIBILITY IDEOGRAPH-FA6D",
-	"並": "CJK COMPATIBILITY IDEOGRAPH-FA70",
-	"况": "CJK COMPATIBILITY IDEOGRAPH-FA71",
-	"全": "CJK COMPATIBILITY IDEOGRAPH-FA72",
-	"侀": "CJK COMPATIBILITY IDEOGRAPH-FA73",
-	"充": "CJK COMPATIBILITY IDEOGRAPH-FA74",
-	"冀": "CJK COMPATIBILITY IDEOGRAPH-FA75",
-	"勇": "CJK COMPATIBILITY IDEOGRAPH-FA76",
-	"勺": "CJK COMPATIBILITY IDEOGRAPH-FA77",
-	"喝": "CJK COMPATIBILITY IDEOGRAPH-FA78",
-	"啕": "CJK COMPATIBILITY IDEOGRAPH-FA79",
-	"喙": "CJK COMPATIBILITY IDEOGRAPH-FA7A",
-	"嗢": "CJK COMPATIBILITY IDEOGRAPH-FA7B",
-	"塚": "CJK COMPATIBILITY IDEOGRAPH-FA7C",
-	"墳": "CJK COMPATIBILITY IDEOGRAPH-FA7D",
-	"奄": "CJK COMPATIBILITY IDEOGRAPH-FA7E",
-	"奔": "CJK COMPATIBILITY IDEOGRAPH-FA7F",
-	"婢": "CJK COMPATIBILITY IDEOGRAPH-FA80",
-	"嬨": "CJK COMPATIBILITY IDEOGRAPH-FA81",
-	"廒": "CJK COMPATIBILITY IDEOGRAPH-FA82",
-	"廙": "CJK COMPATIBILITY IDEOGRAPH-FA83",
-	"彩": "CJK COMPATIBILITY IDEOGRAPH-FA84",
-	"徭": "CJK COMPATIBILITY IDEOGRAPH-FA85",
-	"惘": "CJK COMPATIBILITY IDEOGRAPH-FA86",
-	"慎": "CJK COMPATIBILITY IDEOGRAPH-FA87",
-	"愈": "CJK COMPATIBILITY IDEOGRAPH-FA88",
-	"憎": "CJK COMPATIBILITY IDEOGRAPH-FA89",
-	"慠": "CJK COMPATIBILITY IDEOGRAPH-FA8A",
-	"懲": "CJK COMPATIBILITY IDEOGRAPH-FA8B",
-	"戴": "CJK COMPATIBILITY IDEOGRAPH-FA8C",
-	"揄": "CJK COMPATIBILITY IDEOGRAPH-FA8D",
-	"搜": "CJK COMPATIBILITY IDEOGRAPH-FA8E",
-	"摒": "CJK COMPATIBILITY IDEOGRAPH-FA8F",
-	"敖": "CJK COMPATIBILITY IDEOGRAPH-FA90",
-	"晴": "CJK COMPATIBILITY IDEOGRAPH-FA91",
-	"朗": "CJK COMPATIBILITY IDEOGRAPH-FA92",
-	"望": "CJK COMPATIBILITY IDEOGRAPH-FA93",
-	"杖": "CJK COMPATIBILITY IDEOGRAPH-FA94",
-	"歹": "CJK COMPATIBILITY IDEOGRAPH-FA95",
-	"殺": "CJK COMPATIBILITY IDEOGRAPH-FA96",
-	"流": "CJK COMPATIBILITY IDEOGRAPH-FA97",
-	"滛": "CJK COMPATIBILITY IDEOGRAPH-FA98",
-	"滋": "CJK COMPATIBILITY IDEOGRAPH-FA99",
-	"漢": "CJK COMPATIBILITY IDEOGRAPH-FA9A",
-	"瀞": "CJK COMPATIBILITY IDEOGRAPH-FA9B",
-	"煮": "CJK COMPATIBILITY IDEOGRAPH-FA9C",
-	"瞧": "CJK COMPATIBILITY IDEOGRAPH-FA9D",
-	"爵": "CJK COMPATIBILITY IDEOGRAPH-FA9E",
-	"犯": "CJK COMPATIBILITY IDEOGRAPH-FA9F",
-	"猪": "CJK COMPATIBILITY IDEOGRAPH-FAA0",
-	"瑱": "CJK COMPATIBILITY IDEOGRAPH-FAA1",
-	"甆": "CJK COMPATIBILITY IDEOGRAPH-FAA2",
-	"画": "CJK COMPATIBILITY IDEOGRAPH-FAA3",
-	"瘝": "CJK COMPATIBILITY IDEOGRAPH-FAA4",
-	"瘟": "CJK COMPATIBILITY IDEOGRAPH-FAA5",
-	"益": "CJK COMPATIBILITY IDEOGRAPH-FAA6",
-	"盛": "CJK COMPATIBILITY IDEOGRAPH-FAA7",
-	"直": "CJK COMPATIBILITY IDEOGRAPH-FAA8",
-	"睊": "CJK COMPATIBILITY IDEOGRAPH-FAA9",
-	"着": "CJK COMPATIBILITY IDEOGRAPH-FAAA",
-	"磌": "CJK COMPATIBILITY IDEOGRAPH-FAAB",
-	"窱": "CJK COMPATIBILITY IDEOGRAPH-FAAC",
-	"節": "CJK COMPATIBILITY IDEOGRAPH-FAAD",
-	"类": "CJK COMPATIBILITY IDEOGRAPH-FAAE",
-	"絛": "CJK COMPATIBILITY IDEOGRAPH-FAAF",
-	"練": "CJK COMPATIBILITY IDEOGRAPH-FAB0",
-	"缾": "CJK COMPATIBILITY IDEOGRAPH-FAB1",
-	"者": "CJK COMPATIBILITY IDEOGRAPH-FAB2",
-	"荒": "CJK COMPATIBILITY IDEOGRAPH-FAB3",
-	"華": "CJK COMPATIBILITY IDEOGRAPH-FAB4",
-	"蝹": "CJK COMPATIBILITY IDEOGRAPH-FAB5",
-	"襁": "CJK COMPATIBILITY IDEOGRAPH-FAB6",
-	"覆": "CJK COMPATIBILITY IDEOGRAPH-FAB7",
-	"視": "CJK COMPATIBILITY IDEOGRAPH-FAB8",
-	"調": "CJK COMPATIBILITY IDEOGRAPH-FAB9",
-	"諸": "CJK COMPATIBILITY IDEOGRAPH-FABA",
-	"請": "CJK COMPATIBILITY IDEOGRAPH-FABB",
-	"謁": "CJK COMPATIBILITY IDEOGRAPH-FABC",
-	"諾": "CJK COMPATIBILITY IDEOGRAPH-FABD",
-	"諭": "CJK COMPATIBILITY IDEOGRAPH-FABE",
-	"謹": "CJK COMPATIBILITY IDEOGRAPH-FABF",
-	"變": "CJK COMPATIBILITY IDEOGRAPH-FAC0",
-	"贈": "CJK COMPATIBILITY IDEOGRAPH-FAC1",
-	"輸": "CJK COMPATIBILITY IDEOGRAPH-FAC2",
-	"遲": "CJK COMPATIBILITY IDEOGRAPH-FAC3",
-	"醙": "CJK COMPATIBILITY IDEOGRAPH-FAC4",
-	"鉶": "CJK COMPATIBILITY IDEOGRAPH-FAC5",
-	"陼": "CJK COMPATIBILITY IDEOGRAPH-FAC6",
-	"難": "CJK COMPATIBILITY IDEOGRAPH-FAC7",
-	"靖": "CJK COMPATIBILITY IDEOGRAPH-FAC8",
-	"韛": "CJK COMPATIBILITY IDEOGRAPH-FAC9",
-	"響": "CJK COMPATIBILITY IDEOGRAPH-FACA",
-	"頋": "CJK COMPATIBILITY IDEOGRAPH-FACB",
-	"頻": "CJK COMPATIBILITY IDEOGRAPH-FACC",
-	"鬒": "CJK COMPATIBILITY IDEOGRAPH-FACD",
-	"龜": "CJK COMPATIBILITY IDEOGRAPH-FACE",
-	"𢡊": "CJK COMPATIBILITY IDEOGRAPH-FACF",
-	"𢡄": "CJK COMPATIBILITY IDEOGRAPH-FAD0",
-	"𣏕": "CJK COMPATIBILITY IDEOGRAPH-FAD1",
-	"㮝": "CJK COMPATIBILITY IDEOGRAPH-FAD2",
-	"䀘": "CJK COMPATIBILITY IDEOGRAPH-FAD3",
-	"䀹": "CJK COMPATIBILITY IDEOGRAPH-FAD4",
-	"𥉉": "CJK COMPATIBILITY IDEOGRAPH-FAD5",
-	"𥳐": "CJK COMPATIBILITY IDEOGRAPH-FAD6",
-	"𧻓": "CJK COMPATIBILITY IDEOGRAPH-FAD7",
-	"齃": "CJK COMPATIBILITY IDEOGRAPH-FAD8",
-	"龎": "CJK COMPATIBILITY IDEOGRAPH-FAD9",
-	"ﬀ": "LATIN SMALL LIGATURE FF",
-	"ﬁ": "LATIN SMALL LIGATURE FI",
-	"ﬂ": "LATIN SMALL LIGATURE FL",
-	"ﬃ": "LATIN SMALL LIGATURE FFI",
-	"ﬄ": "LATIN SMALL LIGATURE FFL",
-	"ﬆ": "LATIN SMALL LIGATURE ST",
-	"ﬓ": "ARMENIAN SMALL LIGATURE MEN NOW",
-	"ﬔ": "ARMENIAN SMALL LIGATURE MEN ECH",
-	"ﬕ": "ARMENIAN SMALL LIGATURE MEN INI",
-	"ﬖ": "ARMENIAN SMALL LIGATURE VEW NOW",
-	"ﬗ": "ARMENIAN SMALL LIGATURE MEN XEH",
-	"יִ": "HEBREW LETTER YOD WITH HIRIQ",
-	"ﬠ": "HEBREW LETTER ALTERNATIVE AYIN",
-	"ﬡ": "HEBREW LETTER WIDE ALEF",
-	"ﬢ": "HEBREW LETTER WIDE DALET",
-	"ﬣ": "HEBREW LETTER WIDE HE",
-	"ﬤ": "HEBREW LETTER WIDE KAF",
-	"ﬥ": "HEBREW LETTER WIDE LAMED",
-	"ﬦ": "HEBREW LETTER WIDE FINAL MEM",
-	"ﬧ": "HEBREW LETTER WIDE RESH",
-	"ﬨ": "HEBREW LETTER WIDE TAV",
-	"﬩": "HEBREW LETTER ALTERNATIVE PLUS SIGN",
-	"שׁ": "HEBREW LETTER SHIN WITH SHIN DOT",
-	"שׂ": "HEBREW LETTER SHIN WITH SIN DOT",
-	"שּׁ": "HEBREW LETTER SHIN WITH DAGESH AND SHIN DOT",
-	"שּׂ": "HEBREW LETTER SHIN WITH DAGESH AND SIN DOT",
-	"אַ": "HEBREW LETTER ALEF WITH PATAH",
-	"אָ": "HEBREW LETTER ALEF WITH QAMATS",
-	"אּ": "HEBREW LETTER ALEF WITH MAPIQ",
-	"יּ": "HEBREW LETTER YOD WITH DAGESH",
-	"שּ": "HEBREW LETTER SHIN WITH DAGESH",
-	"ﭏ": "HEBREW LIGATURE ALEF LAMED",
-	"ﭐ": "ARABIC LETTER ALEF WASLA ISOLATED FORM",
-	"ﭑ": "ARABIC LETTER ALEF WASLA FINAL FORM",
-	"ﭒ": "ARABIC LETTER BEEH ISOLATED FORM",
-	"ﭓ": "ARABIC LETTER BEEH FINAL FORM",
-	"ﭔ": "ARABIC LETTER BEEH INITIAL FORM",
-	"ﭕ": "ARABIC LETTER BEEH MEDIAL FORM",
-	"ﭖ": "ARABIC LETTER PEH ISOLATED FORM",
-	"ﭗ": "ARABIC LETTER PEH FINAL FORM",
-	"ﭘ": "ARABIC LETTER PEH INITIAL FORM",
-	"ﭙ": "ARABIC LETTER PEH MEDIAL FORM",
-	"ﭚ": "ARABIC LETTER BEHEH ISOLATED FORM",
-	"ﭛ": "ARABIC LETTER BEHEH FINAL FORM",
-	"ﭜ": "ARABIC LETTER BEHEH INITIAL FORM",
-	"ﭝ": "ARABIC LETTER BEHEH MEDIAL FORM",
-	"ﭞ": "ARABIC LETTER TTEHEH ISOLATED FORM",
-	"ﭟ": "ARABIC LETTER TTEHEH FINAL FORM",
-	"ﭠ": "ARABIC LETTER TTEHEH INITIAL FORM",
-	"ﭡ": "ARABIC LETTER TTEHEH MEDIAL FORM",
-	"ﭢ": "ARABIC LETTER TEHEH ISOLATED FORM",
-	"ﭣ": "ARABIC LETTER TEHEH FINAL FORM",
-	"ﭤ": "ARABIC LETTER TEHEH INITIAL FORM",
-	"ﭥ": "ARABIC LETTER TEHEH MEDIAL FORM",
-	"ﭦ": "ARABIC LETTER TTEH ISOLATED FORM",
-	"ﭧ": "ARABIC LETTER TTEH FINAL FORM",
-	"ﭨ": "ARABIC LETTER TTEH INITIAL FORM",
-	"ﭩ": "ARABIC LETTER TTEH MEDIAL FORM",
-	"ﭪ": "ARABIC LETTER VEH ISOLATED FORM",
-	"ﭫ": "ARABIC LETTER VEH FINAL FORM",
-	"ﭬ": "ARABIC LETTER VEH INITIAL FORM",
-	"ﭭ": "ARABIC LETTER VEH MEDIAL FORM",
-	"ﭮ": "ARABIC LETTER PEHEH ISOLATED FORM",
-	"ﭯ": "ARABIC LETTER PEHEH FINAL FORM",
-	"ﭰ": "ARABIC LETTER PEHEH INITIAL FORM",
-	"ﭱ": "ARABIC LETTER PEHEH MEDIAL FORM",
-	"ﭲ": "ARABIC LETTER DYEH ISOLATED FORM",
-	"ﭳ": "ARABIC LETTER DYEH FINAL FORM",
-	"ﭴ": "ARABIC LETTER DYEH INITIAL FORM",
-	"ﭵ": "ARABIC LETTER DYEH MEDIAL FORM",
-	"ﭶ": "ARABIC LETTER NYEH ISOLATED FORM",
-	"ﭷ": "ARABIC LETTER NYEH FINAL FORM",
-	"ﭸ": "ARABIC LETTER NYEH INITIAL FORM",
-	"ﭹ": "ARABIC LETTER NYEH MEDIAL FORM",
-	"ﭺ": "ARABIC LETTER TCHEH ISOLATED FORM",
-	"ﭻ": "ARABIC LETTER TCHEH FINAL FORM",
-	"ﭼ": "ARABIC LETTER TCHEH INITIAL FORM",
-	"ﭽ": "ARABIC LETTER TCHEH MEDIAL FORM",
-	"ﭾ": "ARABIC LETTER TCHEHEH ISOLATED FORM",
-	"ﭿ": "ARABIC LETTER TCHEHEH FINAL FORM",
-	"ﮀ": "ARABIC LETTER TCHEHEH INITIAL FORM",
-	"ﮁ": "ARABIC LETTER TCHEHEH MEDIAL FORM",
-	"ﮂ": "ARABIC LETTER DDAHAL ISOLATED FORM",
-	"ﮃ": "ARABIC LETTER DDAHAL FINAL FORM",
-	"ﮄ": "ARABIC LETTER DAHAL ISOLATED FORM",
-	"ﮅ": "ARABIC LETTER DAHAL FINAL FORM",
-	"ﮆ": "ARABIC LETTER DUL ISOLATED FORM",
-	"ﮇ": "ARABIC LETTER DUL FINAL FORM",
-	"ﮈ": "ARABIC LETTER DDAL ISOLATED FORM",
-	"ﮉ": "ARABIC LETTER DDAL FINAL FORM",
-	"ﮊ": "ARABIC LETTER JEH ISOLATED FORM",
-	"ﮋ": "ARABIC LETTER JEH FINAL FORM",
-	"ﮌ": "ARABIC LETTER RREH ISOLATED FORM",
-	"ﮍ": "ARABIC LETTER RREH FINAL FORM",
-	"ﮎ": "ARABIC LETTER KEHEH ISOLATED FORM",
-	"ﮏ": "ARABIC LETTER KEHEH FINAL FORM",
-	"ﮐ": "ARABIC LETTER KEHEH INITIAL FORM",
-	"ﮑ": "ARABIC LETTER KEHEH MEDIAL FORM",
-	"ﮒ": "ARABIC LETTER GAF ISOLATED FORM",
-	"ﮓ": "ARABIC LETTER GAF FINAL FORM",
-	"ﮔ": "ARABIC LETTER GAF INITIAL FORM",
-	"ﮕ": "ARABIC LETTER GAF MEDIAL FORM",
-	"ﮖ": "ARABIC LETTER GUEH ISOLATED FORM",
-	"ﮗ": "ARABIC LETTER GUEH FINAL FORM",
-	"ﮘ": "ARABIC LETTER GUEH INITIAL FORM",
-	"ﮙ": "ARABIC LETTER GUEH MEDIAL FORM",
-	"ﮚ": "ARABIC LETTER NGOEH ISOLATED FORM",
-	"ﮛ": "ARABIC LETTER NGOEH FINAL FORM",
-	"ﮜ": "ARABIC LETTER NGOEH INITIAL FORM",
-	"ﮝ": "ARABIC LETTER NGOEH MEDIAL FORM",
-	"ﮞ": "ARABIC LETTER NOON GHUNNA ISOLATED FORM",
-	"ﮟ": "ARABIC LETTER NOON GHUNNA FINAL FORM",
-	"ﮠ": "ARABIC LETTER RNOON ISOLATED FORM",
-	"ﮡ": "ARABIC LETTER RNOON FINAL FORM",
-	"ﮢ": "ARABIC LETTER RNOON INITIAL FORM",
-	"ﮣ": "ARABIC LETTER RNOON MEDIAL FORM",
-	"ﮤ": "ARABIC LETTER HEH WITH YEH ABOVE ISOLATED FORM",
-	"ﮥ": "ARABIC LETTER HEH WITH YEH ABOVE FINAL FORM",
-	"ﮦ": "ARABIC LETTER HEH GOAL ISOLATED FORM",
-	"ﮧ": "ARABIC LETTER HEH GOAL FINAL FORM",
-	"ﮨ": "ARABIC LETTER HEH GOAL INITIAL FORM",
-	"ﮩ": "ARABIC LETTER HEH GOAL MEDIAL FORM",
-	"ﮪ": "ARABIC LETTER HEH DOACHASHMEE ISOLATED FORM",
-	"ﮫ": "ARABIC LETTER HEH DOACHASHMEE FINAL FORM",
-	"ﮬ": "ARABIC LETTER HEH DOACHASHMEE INITIAL FORM",
-	"ﮭ": "ARABIC LETTER HEH DOACHASHMEE MEDIAL FORM",
-	"ﮮ": "ARABIC LETTER YEH BARREE ISOLATED FORM",
-	"ﮯ": "ARABIC LETTER YEH BARREE FINAL FORM",
-	"ﮰ": "ARABIC LETTER YEH BARREE WITH HAMZA ABOVE ISOLATED FORM",
-	"ﮱ": "ARABIC LETTER YEH BARREE WITH HAMZA ABOVE FINAL FORM",
-	"ﯓ": "ARABIC LETTER NG ISOLATED FORM",
-	"ﯔ": "ARABIC LETTER NG FINAL FORM",
-	"ﯕ": "ARABIC LETTER NG INITIAL FORM",
-	"ﯖ": "ARABIC LETTER NG MEDIAL FORM",
-	"ﯗ": "ARABIC LETTER U ISOLATED FORM",
-	"ﯘ": "ARABIC LETTER U FINAL FORM",
-	"ﯙ": "ARABIC LETTER OE ISOLATED FORM",
-	"ﯚ": "ARABIC LETTER OE FINAL FORM",
-	"ﯛ": "ARABIC LETTER YU ISOLATED FORM",
-	"ﯜ": "ARABIC LETTER YU FINAL FORM",
-	"ﯝ": "ARABIC LETTER U WITH HAMZA ABOVE ISOLATED FORM",
-	"ﯞ": "ARABIC LETTER VE ISOLATED FORM",
-	"ﯟ": "ARABIC LETTER VE FINAL FORM",
-	"ﯠ": "ARABIC LETTER KIRGHIZ OE ISOLATED FORM",
-	"ﯡ": "ARABIC LETTER KIRGHIZ OE FINAL FORM",
-	"ﯢ": "ARABIC LETTER KIRGHIZ YU ISOLATED FORM",
-	"ﯣ": "ARABIC LETTER KIRGHIZ YU FINAL FORM",
-	"ﯤ": "ARABIC LETTER E ISOLATED FORM",
-	"ﯥ": "ARABIC LETTER E FINAL FORM",
-	"ﯦ": "ARABIC LETTER E INITIAL FORM",
-	"ﯧ": "ARABIC LETTER E MEDIAL FORM",
-	"ﯨ": "ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA INITIAL FORM",
-	"ﯩ": "ARABIC LETTER UIGHUR KAZAKH KIRGHIZ ALEF MAKSURA MEDIAL FORM",
-	"ﯪ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF ISOLATED FORM",
-	"ﯫ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF FINAL FORM",
-	"ﯬ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH AE ISOLATED FORM",
-	"ﯭ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH AE FINAL FORM",
-	"ﯮ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH WAW ISOLATED FORM",
-	"ﯯ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH WAW FINAL FORM",
-	"ﯰ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH U ISOLATED FORM",
-	"ﯱ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH U FINAL FORM",
-	"ﯲ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH OE ISOLATED FORM",
-	"ﯳ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH OE FINAL FORM",
-	"ﯴ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YU ISOLATED FORM",
-	"ﯵ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YU FINAL FORM",
-	"ﯶ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E ISOLATED FORM",
-	"ﯷ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E FINAL FORM",
-	"ﯸ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH E INITIAL FORM",
-	"ﯹ": "ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA ISOLATED FORM",
-	"ﯺ": "ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA FINAL FORM",
-	"ﯻ": "ARABIC LIGATURE UIGHUR KIRGHIZ YEH WITH HAMZA ABOVE WITH ALEF MAKSURA INITIAL FORM",
-	"ﯼ": "ARABIC LETTER FARSI YEH ISOLATED FORM",
-	"ﯽ": "ARABIC LETTER FARSI YEH FINAL FORM",
-	"ﯾ": "ARABIC LETTER FARSI YEH INITIAL FORM",
-	"ﯿ": "ARABIC LETTER FARSI YEH MEDIAL FORM",
-	"ﰀ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH JEEM ISOLATED FORM",
-	"ﰁ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HAH ISOLATED FORM",
-	"ﰂ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM ISOLATED FORM",
-	"ﰃ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF MAKSURA ISOLATED FORM",
-	"ﰄ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YEH ISOLATED FORM",
-	"ﰅ": "ARABIC LIGATURE BEH WITH JEEM ISOLATED FORM",
-	"ﰆ": "ARABIC LIGATURE BEH WITH HAH ISOLATED FORM",
-	"ﰇ": "ARABIC LIGATURE BEH WITH KHAH ISOLATED FORM",
-	"ﰈ": "ARABIC LIGATURE BEH WITH MEEM ISOLATED FORM",
-	"ﰉ": "ARABIC LIGATURE BEH WITH ALEF MAKSURA ISOLATED FORM",
-	"ﰊ": "ARABIC LIGATURE BEH WITH YEH ISOLATED FORM",
-	"ﰋ": "ARABIC LIGATURE TEH WITH JEEM ISOLATED FORM",
-	"ﰌ": "ARABIC LIGATURE TEH WITH HAH ISOLATED FORM",
-	"ﰍ": "ARABIC LIGATURE TEH WITH KHAH ISOLATED FORM",
-	"ﰎ": "ARABIC LIGATURE TEH WITH MEEM ISOLATED FORM",
-	"ﰏ": "ARABIC LIGATURE TEH WITH ALEF MAKSURA ISOLATED FORM",
-	"ﰐ": "ARABIC LIGATURE TEH WITH YEH ISOLATED FORM",
-	"ﰑ": "ARABIC LIGATURE THEH WITH JEEM ISOLATED FORM",
-	"ﰒ": "ARABIC LIGATURE THEH WITH MEEM ISOLATED FORM",
-	"ﰓ": "ARABIC LIGATURE THEH WITH ALEF MAKSURA ISOLATED FORM",
-	"ﰔ": "ARABIC LIGATURE THEH WITH YEH ISOLATED FORM",
-	"ﰕ": "ARABIC LIGATURE JEEM WITH HAH ISOLATED FORM",
-	"ﰖ": "ARABIC LIGATURE JEEM WITH MEEM ISOLATED FORM",
-	"ﰗ": "ARABIC LIGATURE HAH WITH JEEM ISOLATED FORM",
-	"ﰘ": "ARABIC LIGATURE HAH WITH MEEM ISOLATED FORM",
-	"ﰙ": "ARABIC LIGATURE KHAH WITH JEEM ISOLATED FORM",
-	"ﰚ": "ARABIC LIGATURE KHAH WITH HAH ISOLATED FORM",
-	"ﰛ": "ARABIC LIGATURE KHAH WITH MEEM ISOLATED FORM",
-	"ﰜ": "ARABIC LIGATURE SEEN WITH JEEM ISOLATED FORM",
-	"ﰝ": "ARABIC LIGATURE SEEN WITH HAH ISOLATED FORM",
-	"ﰞ": "ARABIC LIGATURE SEEN WITH KHAH ISOLATED FORM",
-	"ﰟ": "ARABIC LIGATURE SEEN WITH MEEM ISOLATED FORM",
-	"ﰠ": "ARABIC LIGATURE SAD WITH HAH ISOLATED FORM",
-	"ﰡ": "ARABIC LIGATURE SAD WITH MEEM ISOLATED FORM",
-	"ﰢ": "ARABIC LIGATURE DAD WITH JEEM ISOLATED FORM",
-	"ﰣ": "ARABIC LIGATURE DAD WITH HAH ISOLATED FORM",
-	"ﰤ": "ARABIC LIGATURE DAD WITH KHAH ISOLATED FORM",
-	"ﰥ": "ARABIC LIGATURE DAD WITH MEEM ISOLATED FORM",
-	"ﰦ": "ARABIC LIGATURE TAH WITH HAH ISOLATED FORM",
-	"ﰧ": "ARABIC LIGATURE TAH WITH MEEM ISOLATED FORM",
-	"ﰨ": "ARABIC LIGATURE ZAH WITH MEEM ISOLATED FORM",
-	"ﰩ": "ARABIC LIGATURE AIN WITH JEEM ISOLATED FORM",
-	"ﰪ": "ARABIC LIGATURE AIN WITH MEEM ISOLATED FORM",
-	"ﰫ": "ARABIC LIGATURE GHAIN WITH JEEM ISOLATED FORM",
-	"ﰬ": "ARABIC LIGATURE GHAIN WITH MEEM ISOLATED FORM",
-	"ﰭ": "ARABIC LIGATURE FEH WITH JEEM ISOLATED FORM",
-	"ﰮ": "ARABIC LIGATURE FEH WITH HAH ISOLATED FORM",
-	"ﰯ": "ARABIC LIGATURE FEH WITH KHAH ISOLATED FORM",
-	"ﰰ": "ARABIC LIGATURE FEH WITH MEEM ISOLATED FORM",
-	"ﰱ": "ARABIC LIGATURE FEH WITH ALEF MAKSURA ISOLATED FORM",
-	"ﰲ": "ARABIC LIGATURE FEH WITH YEH ISOLATED FORM",
-	"ﰳ": "ARABIC LIGATURE QAF WITH HAH ISOLATED FORM",
-	"ﰴ": "ARABIC LIGATURE QAF WITH MEEM ISOLATED FORM",
-	"ﰵ": "ARABIC LIGATURE QAF WITH ALEF MAKSURA ISOLATED FORM",
-	"ﰶ": "ARABIC LIGATURE QAF WITH YEH ISOLATED FORM",
-	"ﰷ": "ARABIC LIGATURE KAF WITH ALEF ISOLATED FORM",
-	"ﰸ": "ARABIC LIGATURE KAF WITH JEEM ISOLATED FORM",
-	"ﰹ": "ARABIC LIGATURE KAF WITH HAH ISOLATED FORM",
-	"ﰺ": "ARABIC LIGATURE KAF WITH KHAH ISOLATED FORM",
-	"ﰻ": "ARABIC LIGATURE KAF WITH LAM ISOLATED FORM",
-	"ﰼ": "ARABIC LIGATURE KAF WITH MEEM ISOLATED FORM",
-	"ﰽ": "ARABIC LIGATURE KAF WITH ALEF MAKSURA ISOLATED FORM",
-	"ﰾ": "ARABIC LIGATURE KAF WITH YEH ISOLATED FORM",
-	"ﰿ": "ARABIC LIGATURE LAM WITH JEEM ISOLATED FORM",
-	"ﱀ": "ARABIC LIGATURE LAM WITH HAH ISOLATED FORM",
-	"ﱁ": "ARABIC LIGATURE LAM WITH KHAH ISOLATED FORM",
-	"ﱂ": "ARABIC LIGATURE LAM WITH MEEM ISOLATED FORM",
-	"ﱃ": "ARABIC LIGATURE LAM WITH ALEF MAKSURA ISOLATED FORM",
-	"ﱄ": "ARABIC LIGATURE LAM WITH YEH ISOLATED FORM",
-	"ﱅ": "ARABIC LIGATURE MEEM WITH JEEM ISOLATED FORM",
-	"ﱆ": "ARABIC LIGATURE MEEM WITH HAH ISOLATED FORM",
-	"ﱇ": "ARABIC LIGATURE MEEM WITH KHAH ISOLATED FORM",
-	"ﱈ": "ARABIC LIGATURE MEEM WITH MEEM ISOLATED FORM",
-	"ﱉ": "ARABIC LIGATURE MEEM WITH ALEF MAKSURA ISOLATED FORM",
-	"ﱊ": "ARABIC LIGATURE MEEM WITH YEH ISOLATED FORM",
-	"ﱋ": "ARABIC LIGATURE NOON WITH JEEM ISOLATED FORM",
-	"ﱌ": "ARABIC LIGATURE NOON WITH HAH ISOLATED FORM",
-	"ﱍ": "ARABIC LIGATURE NOON WITH KHAH ISOLATED FORM",
-	"ﱎ": "ARABIC LIGATURE NOON WITH MEEM ISOLATED FORM",
-	"ﱏ": "ARABIC LIGATURE NOON WITH ALEF MAKSURA ISOLATED FORM",
-	"ﱐ": "ARABIC LIGATURE NOON WITH YEH ISOLATED FORM",
-	"ﱑ": "ARABIC LIGATURE HEH WITH JEEM ISOLATED FORM",
-	"ﱒ": "ARABIC LIGATURE HEH WITH MEEM ISOLATED FORM",
-	"ﱓ": "ARABIC LIGATURE HEH WITH ALEF MAKSURA ISOLATED FORM",
-	"ﱔ": "ARABIC LIGATURE HEH WITH YEH ISOLATED FORM",
-	"ﱕ": "ARABIC LIGATURE YEH WITH JEEM ISOLATED FORM",
-	"ﱖ": "ARABIC LIGATURE YEH WITH HAH ISOLATED FORM",
-	"ﱗ": "ARABIC LIGATURE YEH WITH KHAH ISOLATED FORM",
-	"ﱘ": "ARABIC LIGATURE YEH WITH MEEM ISOLATED FORM",
-	"ﱙ": "ARABIC LIGATURE YEH WITH ALEF MAKSURA ISOLATED FORM",
-	"ﱚ": "ARABIC LIGATURE YEH WITH YEH ISOLATED FORM",
-	"ﱛ": "ARABIC LIGATURE THAL WITH SUPERSCRIPT ALEF ISOLATED FORM",
-	"ﱜ": "ARABIC LIGATURE REH WITH SUPERSCRIPT ALEF ISOLATED FORM",
-	"ﱝ": "ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF ISOLATED FORM",
-	"ﱞ": "ARABIC LIGATURE SHADDA WITH DAMMATAN ISOLATED FORM",
-	"ﱟ": "ARABIC LIGATURE SHADDA WITH KASRATAN ISOLATED FORM",
-	"ﱠ": "ARABIC LIGATURE SHADDA WITH FATHA ISOLATED FORM",
-	"ﱡ": "ARABIC LIGATURE SHADDA WITH DAMMA ISOLATED FORM",
-	"ﱢ": "ARABIC LIGATURE SHADDA WITH KASRA ISOLATED FORM",
-	"ﱣ": "ARABIC LIGATURE SHADDA WITH SUPERSCRIPT ALEF ISOLATED FORM",
-	"ﱤ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH REH FINAL FORM",
-	"ﱥ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ZAIN FINAL FORM",
-	"ﱦ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM FINAL FORM",
-	"ﱧ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH NOON FINAL FORM",
-	"ﱨ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH ALEF MAKSURA FINAL FORM",
-	"ﱩ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH YEH FINAL FORM",
-	"ﱪ": "ARABIC LIGATURE BEH WITH REH FINAL FORM",
-	"ﱫ": "ARABIC LIGATURE BEH WITH ZAIN FINAL FORM",
-	"ﱬ": "ARABIC LIGATURE BEH WITH MEEM FINAL FORM",
-	"ﱭ": "ARABIC LIGATURE BEH WITH NOON FINAL FORM",
-	"ﱮ": "ARABIC LIGATURE BEH WITH ALEF MAKSURA FINAL FORM",
-	"ﱯ": "ARABIC LIGATURE BEH WITH YEH FINAL FORM",
-	"ﱰ": "ARABIC LIGATURE TEH WITH REH FINAL FORM",
-	"ﱱ": "ARABIC LIGATURE TEH WITH ZAIN FINAL FORM",
-	"ﱲ": "ARABIC LIGATURE TEH WITH MEEM FINAL FORM",
-	"ﱳ": "ARABIC LIGATURE TEH WITH NOON FINAL FORM",
-	"ﱴ": "ARABIC LIGATURE TEH WITH ALEF MAKSURA FINAL FORM",
-	"ﱵ": "ARABIC LIGATURE TEH WITH YEH FINAL FORM",
-	"ﱶ": "ARABIC LIGATURE THEH WITH REH FINAL FORM",
-	"ﱷ": "ARABIC LIGATURE THEH WITH ZAIN FINAL FORM",
-	"ﱸ": "ARABIC LIGATURE THEH WITH MEEM FINAL FORM",
-	"ﱹ": "ARABIC LIGATURE THEH WITH NOON FINAL FORM",
-	"ﱺ": "ARABIC LIGATURE THEH WITH ALEF MAKSURA FINAL FORM",
-	"ﱻ": "ARABIC LIGATURE THEH WITH YEH FINAL FORM",
-	"ﱼ": "ARABIC LIGATURE FEH WITH ALEF MAKSURA FINAL FORM",
-	"ﱽ": "ARABIC LIGATURE FEH WITH YEH FINAL FORM",
-	"ﱾ": "ARABIC LIGATURE QAF WITH ALEF MAKSURA FINAL FORM",
-	"ﱿ": "ARABIC LIGATURE QAF WITH YEH FINAL FORM",
-	"ﲀ": "ARABIC LIGATURE KAF WITH ALEF FINAL FORM",
-	"ﲁ": "ARABIC LIGATURE KAF WITH LAM FINAL FORM",
-	"ﲂ": "ARABIC LIGATURE KAF WITH MEEM FINAL FORM",
-	"ﲃ": "ARABIC LIGATURE KAF WITH ALEF MAKSURA FINAL FORM",
-	"ﲄ": "ARABIC LIGATURE KAF WITH YEH FINAL FORM",
-	"ﲅ": "ARABIC LIGATURE LAM WITH MEEM FINAL FORM",
-	"ﲆ": "ARABIC LIGATURE LAM WITH ALEF MAKSURA FINAL FORM",
-	"ﲇ": "ARABIC LIGATURE LAM WITH YEH FINAL FORM",
-	"ﲈ": "ARABIC LIGATURE MEEM WITH ALEF FINAL FORM",
-	"ﲉ": "ARABIC LIGATURE MEEM WITH MEEM FINAL FORM",
-	"ﲊ": "ARABIC LIGATURE NOON WITH REH FINAL FORM",
-	"ﲋ": "ARABIC LIGATURE NOON WITH ZAIN FINAL FORM",
-	"ﲌ": "ARABIC LIGATURE NOON WITH MEEM FINAL FORM",
-	"ﲍ": "ARABIC LIGATURE NOON WITH NOON FINAL FORM",
-	"ﲎ": "ARABIC LIGATURE NOON WITH ALEF MAKSURA FINAL FORM",
-	"ﲏ": "ARABIC LIGATURE NOON WITH YEH FINAL FORM",
-	"ﲐ": "ARABIC LIGATURE ALEF MAKSURA WITH SUPERSCRIPT ALEF FINAL FORM",
-	"ﲑ": "ARABIC LIGATURE YEH WITH REH FINAL FORM",
-	"ﲒ": "ARABIC LIGATURE YEH WITH ZAIN FINAL FORM",
-	"ﲓ": "ARABIC LIGATURE YEH WITH MEEM FINAL FORM",
-	"ﲔ": "ARABIC LIGATURE YEH WITH NOON FINAL FORM",
-	"ﲕ": "ARABIC LIGATURE YEH WITH ALEF MAKSURA FINAL FORM",
-	"ﲖ": "ARABIC LIGATURE YEH WITH YEH FINAL FORM",
-	"ﲗ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH JEEM INITIAL FORM",
-	"ﲘ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HAH INITIAL FORM",
-	"ﲙ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH KHAH INITIAL FORM",
-	"ﲚ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM INITIAL FORM",
-	"ﲛ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HEH INITIAL FORM",
-	"ﲜ": "ARABIC LIGATURE BEH WITH JEEM INITIAL FORM",
-	"ﲝ": "ARABIC LIGATURE BEH WITH HAH INITIAL FORM",
-	"ﲞ": "ARABIC LIGATURE BEH WITH KHAH INITIAL FORM",
-	"ﲟ": "ARABIC LIGATURE BEH WITH MEEM INITIAL FORM",
-	"ﲠ": "ARABIC LIGATURE BEH WITH HEH INITIAL FORM",
-	"ﲡ": "ARABIC LIGATURE TEH WITH JEEM INITIAL FORM",
-	"ﲢ": "ARABIC LIGATURE TEH WITH HAH INITIAL FORM",
-	"ﲣ": "ARABIC LIGATURE TEH WITH KHAH INITIAL FORM",
-	"ﲤ": "ARABIC LIGATURE TEH WITH MEEM INITIAL FORM",
-	"ﲥ": "ARABIC LIGATURE TEH WITH HEH INITIAL FORM",
-	"ﲦ": "ARABIC LIGATURE THEH WITH MEEM INITIAL FORM",
-	"ﲧ": "ARABIC LIGATURE JEEM WITH HAH INITIAL FORM",
-	"ﲨ": "ARABIC LIGATURE JEEM WITH MEEM INITIAL FORM",
-	"ﲩ": "ARABIC LIGATURE HAH WITH JEEM INITIAL FORM",
-	"ﲪ": "ARABIC LIGATURE HAH WITH MEEM INITIAL FORM",
-	"ﲫ": "ARABIC LIGATURE KHAH WITH JEEM INITIAL FORM",
-	"ﲬ": "ARABIC LIGATURE KHAH WITH MEEM INITIAL FORM",
-	"ﲭ": "ARABIC LIGATURE SEEN WITH JEEM INITIAL FORM",
-	"ﲮ": "ARABIC LIGATURE SEEN WITH HAH INITIAL FORM",
-	"ﲯ": "ARABIC LIGATURE SEEN WITH KHAH INITIAL FORM",
-	"ﲰ": "ARABIC LIGATURE SEEN WITH MEEM INITIAL FORM",
-	"ﲱ": "ARABIC LIGATURE SAD WITH HAH INITIAL FORM",
-	"ﲲ": "ARABIC LIGATURE SAD WITH KHAH INITIAL FORM",
-	"ﲳ": "ARABIC LIGATURE SAD WITH MEEM INITIAL FORM",
-	"ﲴ": "ARABIC LIGATURE DAD WITH JEEM INITIAL FORM",
-	"ﲵ": "ARABIC LIGATURE DAD WITH HAH INITIAL FORM",
-	"ﲶ": "ARABIC LIGATURE DAD WITH KHAH INITIAL FORM",
-	"ﲷ": "ARABIC LIGATURE DAD WITH MEEM INITIAL FORM",
-	"ﲸ": "ARABIC LIGATURE TAH WITH HAH INITIAL FORM",
-	"ﲹ": "ARABIC LIGATURE ZAH WITH MEEM INITIAL FORM",
-	"ﲺ": "ARABIC LIGATURE AIN WITH JEEM INITIAL FORM",
-	"ﲻ": "ARABIC LIGATURE AIN WITH MEEM INITIAL FORM",
-	"ﲼ": "ARABIC LIGATURE GHAIN WITH JEEM INITIAL FORM",
-	"ﲽ": "ARABIC LIGATURE GHAIN WITH MEEM INITIAL FORM",
-	"ﲾ": "ARABIC LIGATURE FEH WITH JEEM INITIAL FORM",
-	"ﲿ": "ARABIC LIGATURE FEH WITH HAH INITIAL FORM",
-	"ﳀ": "ARABIC LIGATURE FEH WITH KHAH INITIAL FORM",
-	"ﳁ": "ARABIC LIGATURE FEH WITH MEEM INITIAL FORM",
-	"ﳂ": "ARABIC LIGATURE QAF WITH HAH INITIAL FORM",
-	"ﳃ": "ARABIC LIGATURE QAF WITH MEEM INITIAL FORM",
-	"ﳄ": "ARABIC LIGATURE KAF WITH JEEM INITIAL FORM",
-	"ﳅ": "ARABIC LIGATURE KAF WITH HAH INITIAL FORM",
-	"ﳆ": "ARABIC LIGATURE KAF WITH KHAH INITIAL FORM",
-	"ﳇ": "ARABIC LIGATURE KAF WITH LAM INITIAL FORM",
-	"ﳈ": "ARABIC LIGATURE KAF WITH MEEM INITIAL FORM",
-	"ﳉ": "ARABIC LIGATURE LAM WITH JEEM INITIAL FORM",
-	"ﳊ": "ARABIC LIGATURE LAM WITH HAH INITIAL FORM",
-	"ﳋ": "ARABIC LIGATURE LAM WITH KHAH INITIAL FORM",
-	"ﳌ": "ARABIC LIGATURE LAM WITH MEEM INITIAL FORM",
-	"ﳍ": "ARABIC LIGATURE LAM WITH HEH INITIAL FORM",
-	"ﳎ": "ARABIC LIGATURE MEEM WITH JEEM INITIAL FORM",
-	"ﳏ": "ARABIC LIGATURE MEEM WITH HAH INITIAL FORM",
-	"ﳐ": "ARABIC LIGATURE MEEM WITH KHAH INITIAL FORM",
-	"ﳑ": "ARABIC LIGATURE MEEM WITH MEEM INITIAL FORM",
-	"ﳒ": "ARABIC LIGATURE NOON WITH JEEM INITIAL FORM",
-	"ﳓ": "ARABIC LIGATURE NOON WITH HAH INITIAL FORM",
-	"ﳔ": "ARABIC LIGATURE NOON WITH KHAH INITIAL FORM",
-	"ﳕ": "ARABIC LIGATURE NOON WITH MEEM INITIAL FORM",
-	"ﳖ": "ARABIC LIGATURE NOON WITH HEH INITIAL FORM",
-	"ﳗ": "ARABIC LIGATURE HEH WITH JEEM INITIAL FORM",
-	"ﳘ": "ARABIC LIGATURE HEH WITH MEEM INITIAL FORM",
-	"ﳙ": "ARABIC LIGATURE HEH WITH SUPERSCRIPT ALEF INITIAL FORM",
-	"ﳚ": "ARABIC LIGATURE YEH WITH JEEM INITIAL FORM",
-	"ﳛ": "ARABIC LIGATURE YEH WITH HAH INITIAL FORM",
-	"ﳜ": "ARABIC LIGATURE YEH WITH KHAH INITIAL FORM",
-	"ﳝ": "ARABIC LIGATURE YEH WITH MEEM INITIAL FORM",
-	"ﳞ": "ARABIC LIGATURE YEH WITH HEH INITIAL FORM",
-	"ﳟ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH MEEM MEDIAL FORM",
-	"ﳠ": "ARABIC LIGATURE YEH WITH HAMZA ABOVE WITH HEH MEDIAL FORM",
-	"ﳡ": "ARABIC LIGATURE BEH WITH MEEM MEDIAL FORM",
-	"ﳢ": "ARABIC LIGATURE BEH WITH HEH MEDIAL FORM",
-	"ﳣ": "ARABIC LIGATURE TEH WITH MEEM MEDIAL FORM",
-	"ﳤ": "ARABIC LIGATURE TEH WITH HEH MEDIAL FORM",
-	"ﳥ": "ARABIC LIGATURE THEH WITH MEEM MEDIAL FORM",
-	"ﳦ": "ARABIC LIGATURE THEH WITH HEH MEDIAL FORM",
-	"ﳧ": "ARABIC LIGATURE SEEN WITH MEEM MEDIAL FORM",
-	"ﳨ": "ARABIC LIGATURE SEEN WITH HEH MEDIAL FORM",
-	"ﳩ": "ARABIC LIGATURE SHEEN WITH MEEM MEDIAL FORM",
-	"ﳪ": "ARABIC LIGATURE SHEEN WITH HEH MEDIAL FORM",
-	"ﳫ": "ARABIC LIGATURE KAF WITH LAM MEDIAL FORM",
-	"ﳬ": "ARABIC LIGATURE KAF WITH MEEM MEDIAL FORM",
-	"ﳭ": "ARABIC LIGATURE LAM WITH MEEM MEDIAL FORM",
-	"ﳮ": "ARABIC LIGATURE NOON WITH MEEM MEDIAL FORM",
-	"ﳯ": "ARABIC LIGATURE NOON WITH HEH MEDIAL FORM",
-	"ﳰ": "ARABIC LIGATURE YEH WITH MEEM MEDIAL FORM",
-	"ﳱ": "ARABIC LIGATURE YEH WITH HEH MEDIAL FORM",
-	"ﳲ": "ARABIC LIGATURE SHADDA WITH FATHA MEDIAL FORM",
-	"ﳳ": "ARABIC LIGATURE SHADDA WITH DAMMA MEDIAL FORM",
-	"ﳴ": "ARABIC LIGATURE SHADDA WITH KASRA MEDIAL FORM",
-	"ﳵ": "ARABIC LIGATURE TAH WITH ALEF MAKSURA ISOLATED FORM",
-	"ﳶ": "ARABIC LIGATURE TAH WITH YEH ISOLATED FORM",
-	"ﳷ": "ARABIC LIGATURE AIN WITH ALEF MAKSURA ISOLATED FORM",
-	"ﳸ": "ARABIC LIGATURE AIN WITH YEH ISOLATED FORM",
-	"ﳹ": "ARABIC LIGATURE GHAIN WITH ALEF MAKSURA ISOLATED FORM",
-	"ﳺ": "ARABIC LIGATURE GHAIN WITH YEH ISOLATED FORM",
-	"ﳻ": "ARABIC LIGATURE SEEN WITH ALEF MAKSURA ISOLATED FORM",
-	"ﳼ": "ARABIC LIGATURE SEEN WITH YEH ISOLATED FORM",
-	"ﳽ": "ARABIC LIGATURE SHEEN WITH ALEF MAKSURA ISOLATED FORM",
-	"ﳾ": "ARABIC LIGATURE SHEEN WITH YEH ISOLATED FORM",
-	"ﳿ": "ARABIC LIGATURE HAH WITH ALEF MAKSURA ISOLATED FORM",
-	"ﴀ": "ARABIC LIGATURE HAH WITH YEH ISOLATED FORM",
-	"ﴁ": "ARABIC LIGATURE JEEM WITH ALEF MAKSURA ISOLATED FORM",
-	"ﴂ": "ARABIC LIGATURE JEEM WITH YEH ISOLATED FORM",
-	"ﴃ": "ARABIC LIGATURE KHAH WITH ALEF MAKSURA ISOLATED FORM",
-	"ﴄ": "ARABIC LIGATURE KHAH WITH YEH ISOLATED FORM",
-	"ﴅ": "ARABIC LIGATURE SAD WITH ALEF MAKSURA ISOLATED FORM",
-	"ﴆ": "ARABIC LIGATURE SAD WITH YEH ISOLATED FORM",
-	"ﴇ": "ARABIC LIGATURE DAD WITH ALEF MAKSURA ISOLATED FORM",
-	"ﴈ": "ARABIC LIGATURE DAD WITH YEH ISOLATED FORM",
-	"ﴉ": "ARABIC LIGATURE SHEEN WITH JEEM ISOLATED FORM",
-	"ﴊ": "ARABIC LIGATURE SHEEN WITH HAH ISOLATED FORM",
-	"ﴋ": "ARABIC LIGATURE SHEEN WITH KHAH ISOLATED FORM",
-	"ﴌ": "ARABIC LIGATURE SHEEN WITH MEEM ISOLATED FORM",
-	"ﴍ": "ARABIC LIGATURE SHEEN WITH REH ISOLATED FORM",
-	"ﴎ": "ARABIC LIGATURE SEEN WITH REH ISOLATED FORM",
-	"ﴏ": "ARABIC LIGATURE SAD WITH REH ISOLATED FORM",
-	"ﴐ": "ARABIC LIGATURE DAD WITH REH ISOLATED FORM",
-	"ﴑ": "ARABIC LIGATURE TAH WITH ALEF MAKSURA FINAL FORM",
-	"ﴒ": "ARABIC LIGATURE TAH WITH YEH FINAL FORM",
-	"ﴓ": "ARABIC LIGATURE AIN WITH ALEF MAKSURA FINAL FORM",
-	"ﴔ": "ARABIC LIGATURE AIN WITH YEH FINAL FORM",
-	"ﴕ": "ARABIC LIGATURE GHAIN WITH ALEF MAKSURA FINAL FORM",
-	"ﴖ": "ARABIC LIGATURE GHAIN WITH YEH FINAL FORM",
-	"ﴗ": "ARABIC LIGATURE SEEN WITH ALEF MAKSURA FINAL FORM",
-	"ﴘ": "ARABIC LIGATURE SEEN WITH YEH FINAL FORM",
-	"ﴙ": "ARABIC LIGATURE SHEEN WITH ALEF MAKSURA FINAL FORM",
-	"ﴚ": "ARABIC LIGATURE SHEEN WITH YEH FINAL FORM",
-	"ﴛ": "ARABIC LIGATURE HAH WITH ALEF MAKSURA FINAL FORM",
-	"ﴜ": "ARABIC LIGATURE HAH WITH YEH FINAL FORM",
-	"ﴝ": "ARABIC LIGATURE JEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﴞ": "ARABIC LIGATURE JEEM WITH YEH FINAL FORM",
-	"ﴟ": "ARABIC LIGATURE KHAH WITH ALEF MAKSURA FINAL FORM",
-	"ﴠ": "ARABIC LIGATURE KHAH WITH YEH FINAL FORM",
-	"ﴡ": "ARABIC LIGATURE SAD WITH ALEF MAKSURA FINAL FORM",
-	"ﴢ": "ARABIC LIGATURE SAD WITH YEH FINAL FORM",
-	"ﴣ": "ARABIC LIGATURE DAD WITH ALEF MAKSURA FINAL FORM",
-	"ﴤ": "ARABIC LIGATURE DAD WITH YEH FINAL FORM",
-	"ﴥ": "ARABIC LIGATURE SHEEN WITH JEEM FINAL FORM",
-	"ﴦ": "ARABIC LIGATURE SHEEN WITH HAH FINAL FORM",
-	"ﴧ": "ARABIC LIGATURE SHEEN WITH KHAH FINAL FORM",
-	"ﴨ": "ARABIC LIGATURE SHEEN WITH MEEM FINAL FORM",
-	"ﴩ": "ARABIC LIGATURE SHEEN WITH REH FINAL FORM",
-	"ﴪ": "ARABIC LIGATURE SEEN WITH REH FINAL FORM",
-	"ﴫ": "ARABIC LIGATURE SAD WITH REH FINAL FORM",
-	"ﴬ": "ARABIC LIGATURE DAD WITH REH FINAL FORM",
-	"ﴭ": "ARABIC LIGATURE SHEEN WITH JEEM INITIAL FORM",
-	"ﴮ": "ARABIC LIGATURE SHEEN WITH HAH INITIAL FORM",
-	"ﴯ": "ARABIC LIGATURE SHEEN WITH KHAH INITIAL FORM",
-	"ﴰ": "ARABIC LIGATURE SHEEN WITH MEEM INITIAL FORM",
-	"ﴱ": "ARABIC LIGATURE SEEN WITH HEH INITIAL FORM",
-	"ﴲ": "ARABIC LIGATURE SHEEN WITH HEH INITIAL FORM",
-	"ﴳ": "ARABIC LIGATURE TAH WITH MEEM INITIAL FORM",
-	"ﴴ": "ARABIC LIGATURE SEEN WITH JEEM MEDIAL FORM",
-	"ﴵ": "ARABIC LIGATURE SEEN WITH HAH MEDIAL FORM",
-	"ﴶ": "ARABIC LIGATURE SEEN WITH KHAH MEDIAL FORM",
-	"ﴷ": "ARABIC LIGATURE SHEEN WITH JEEM MEDIAL FORM",
-	"ﴸ": "ARABIC LIGATURE SHEEN WITH HAH MEDIAL FORM",
-	"ﴹ": "ARABIC LIGATURE SHEEN WITH KHAH MEDIAL FORM",
-	"ﴺ": "ARABIC LIGATURE TAH WITH MEEM MEDIAL FORM",
-	"ﴻ": "ARABIC LIGATURE ZAH WITH MEEM MEDIAL FORM",
-	"ﴼ": "ARABIC LIGATURE ALEF WITH FATHATAN FINAL FORM",
-	"ﴽ": "ARABIC LIGATURE ALEF WITH FATHATAN ISOLATED FORM",
-	"﴾": "ORNATE LEFT PARENTHESIS",
-	"﴿": "ORNATE RIGHT PARENTHESIS",
-	"ﵐ": "ARABIC LIGATURE TEH WITH JEEM WITH MEEM INITIAL FORM",
-	"ﵑ": "ARABIC LIGATURE TEH WITH HAH WITH JEEM FINAL FORM",
-	"ﵒ": "ARABIC LIGATURE TEH WITH HAH WITH JEEM INITIAL FORM",
-	"ﵓ": "ARABIC LIGATURE TEH WITH HAH WITH MEEM INITIAL FORM",
-	"ﵔ": "ARABIC LIGATURE TEH WITH KHAH WITH MEEM INITIAL FORM",
-	"ﵕ": "ARABIC LIGATURE TEH WITH MEEM WITH JEEM INITIAL FORM",
-	"ﵖ": "ARABIC LIGATURE TEH WITH MEEM WITH HAH INITIAL FORM",
-	"ﵗ": "ARABIC LIGATURE TEH WITH MEEM WITH KHAH INITIAL FORM",
-	"ﵘ": "ARABIC LIGATURE JEEM WITH MEEM WITH HAH FINAL FORM",
-	"ﵙ": "ARABIC LIGATURE JEEM WITH MEEM WITH HAH INITIAL FORM",
-	"ﵚ": "ARABIC LIGATURE HAH WITH MEEM WITH YEH FINAL FORM",
-	"ﵛ": "ARABIC LIGATURE HAH WITH MEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﵜ": "ARABIC LIGATURE SEEN WITH HAH WITH JEEM INITIAL FORM",
-	"ﵝ": "ARABIC LIGATURE SEEN WITH JEEM WITH HAH INITIAL FORM",
-	"ﵞ": "ARABIC LIGATURE SEEN WITH JEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﵟ": "ARABIC LIGATURE SEEN WITH MEEM WITH HAH FINAL FORM",
-	"ﵠ": "ARABIC LIGATURE SEEN WITH MEEM WITH HAH INITIAL FORM",
-	"ﵡ": "ARABIC LIGATURE SEEN WITH MEEM WITH JEEM INITIAL FORM",
-	"ﵢ": "ARABIC LIGATURE SEEN WITH MEEM WITH MEEM FINAL FORM",
-	"ﵣ": "ARABIC LIGATURE SEEN WITH MEEM WITH MEEM INITIAL FORM",
-	"ﵤ": "ARABIC LIGATURE SAD WITH HAH WITH HAH FINAL FORM",
-	"ﵥ": "ARABIC LIGATURE SAD WITH HAH WITH HAH INITIAL FORM",
-	"ﵦ": "ARABIC LIGATURE SAD WITH MEEM WITH MEEM FINAL FORM",
-	"ﵧ": "ARABIC LIGATURE SHEEN WITH HAH WITH MEEM FINAL FORM",
-	"ﵨ": "ARABIC LIGATURE SHEEN WITH HAH WITH MEEM INITIAL FORM",
-	"ﵩ": "ARABIC LIGATURE SHEEN WITH JEEM WITH YEH FINAL FORM",
-	"ﵪ": "ARABIC LIGATURE SHEEN WITH MEEM WITH KHAH FINAL FORM",
-	"ﵫ": "ARABIC LIGATURE SHEEN WITH MEEM WITH KHAH INITIAL FORM",
-	"ﵬ": "ARABIC LIGATURE SHEEN WITH MEEM WITH MEEM FINAL FORM",
-	"ﵭ": "ARABIC LIGATURE SHEEN WITH MEEM WITH MEEM INITIAL FORM",
-	"ﵮ": "ARABIC LIGATURE DAD WITH HAH WITH ALEF MAKSURA FINAL FORM",
-	"ﵯ": "ARABIC LIGATURE DAD WITH KHAH WITH MEEM FINAL FORM",
-	"ﵰ": "ARABIC LIGATURE DAD WITH KHAH WITH MEEM INITIAL FORM",
-	"ﵱ": "ARABIC LIGATURE TAH WITH MEEM WITH HAH FINAL FORM",
-	"ﵲ": "ARABIC LIGATURE TAH WITH MEEM WITH HAH INITIAL FORM",
-	"ﵳ": "ARABIC LIGATURE TAH WITH MEEM WITH MEEM INITIAL FORM",
-	"ﵴ": "ARABIC LIGATURE TAH WITH MEEM WITH YEH FINAL FORM",
-	"ﵵ": "ARABIC LIGATURE AIN WITH JEEM WITH MEEM FINAL FORM",
-	"ﵶ": "ARABIC LIGATURE AIN WITH MEEM WITH MEEM FINAL FORM",
-	"ﵷ": "ARABIC LIGATURE AIN WITH MEEM WITH MEEM INITIAL FORM",
-	"ﵸ": "ARABIC LIGATURE AIN WITH MEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﵹ": "ARABIC LIGATURE GHAIN WITH MEEM WITH MEEM FINAL FORM",
-	"ﵺ": "ARABIC LIGATURE GHAIN WITH MEEM WITH YEH FINAL FORM",
-	"ﵻ": "ARABIC LIGATURE GHAIN WITH MEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﵼ": "ARABIC LIGATURE FEH WITH KHAH WITH MEEM FINAL FORM",
-	"ﵽ": "ARABIC LIGATURE FEH WITH KHAH WITH MEEM INITIAL FORM",
-	"ﵾ": "ARABIC LIGATURE QAF WITH MEEM WITH HAH FINAL FORM",
-	"ﵿ": "ARABIC LIGATURE QAF WITH MEEM WITH MEEM FINAL FORM",
-	"ﶀ": "ARABIC LIGATURE LAM WITH HAH WITH MEEM FINAL FORM",
-	"ﶁ": "ARABIC LIGATURE LAM WITH HAH WITH YEH FINAL FORM",
-	"ﶂ": "ARABIC LIGATURE LAM WITH HAH WITH ALEF MAKSURA FINAL FORM",
-	"ﶃ": "ARABIC LIGATURE LAM WITH JEEM WITH JEEM INITIAL FORM",
-	"ﶄ": "ARABIC LIGATURE LAM WITH JEEM WITH JEEM FINAL FORM",
-	"ﶅ": "ARABIC LIGATURE LAM WITH KHAH WITH MEEM FINAL FORM",
-	"ﶆ": "ARABIC LIGATURE LAM WITH KHAH WITH MEEM INITIAL FORM",
-	"ﶇ": "ARABIC LIGATURE LAM WITH MEEM WITH HAH FINAL FORM",
-	"ﶈ": "ARABIC LIGATURE LAM WITH MEEM WITH HAH INITIAL FORM",
-	"ﶉ": "ARABIC LIGATURE MEEM WITH HAH WITH JEEM INITIAL FORM",
-	"ﶊ": "ARABIC LIGATURE MEEM WITH HAH WITH MEEM INITIAL FORM",
-	"ﶋ": "ARABIC LIGATURE MEEM WITH HAH WITH YEH FINAL FORM",
-	"ﶌ": "ARABIC LIGATURE MEEM WITH JEEM WITH HAH INITIAL FORM",
-	"ﶍ": "ARABIC LIGATURE MEEM WITH JEEM WITH MEEM INITIAL FORM",
-	"ﶎ": "ARABIC LIGATURE MEEM WITH KHAH WITH JEEM INITIAL FORM",
-	"ﶏ": "ARABIC LIGATURE MEEM WITH KHAH WITH MEEM INITIAL FORM",
-	"ﶒ": "ARABIC LIGATURE MEEM WITH JEEM WITH KHAH INITIAL FORM",
-	"ﶓ": "ARABIC LIGATURE HEH WITH MEEM WITH JEEM INITIAL FORM",
-	"ﶔ": "ARABIC LIGATURE HEH WITH MEEM WITH MEEM INITIAL FORM",
-	"ﶕ": "ARABIC LIGATURE NOON WITH HAH WITH MEEM INITIAL FORM",
-	"ﶖ": "ARABIC LIGATURE NOON WITH HAH WITH ALEF MAKSURA FINAL FORM",
-	"ﶗ": "ARABIC LIGATURE NOON WITH JEEM WITH MEEM FINAL FORM",
-	"ﶘ": "ARABIC LIGATURE NOON WITH JEEM WITH MEEM INITIAL FORM",
-	"ﶙ": "ARABIC LIGATURE NOON WITH JEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﶚ": "ARABIC LIGATURE NOON WITH MEEM WITH YEH FINAL FORM",
-	"ﶛ": "ARABIC LIGATURE NOON WITH MEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﶜ": "ARABIC LIGATURE YEH WITH MEEM WITH MEEM FINAL FORM",
-	"ﶝ": "ARABIC LIGATURE YEH WITH MEEM WITH MEEM INITIAL FORM",
-	"ﶞ": "ARABIC LIGATURE BEH WITH KHAH WITH YEH FINAL FORM",
-	"ﶟ": "ARABIC LIGATURE TEH WITH JEEM WITH YEH FINAL FORM",
-	"ﶠ": "ARABIC LIGATURE TEH WITH JEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﶡ": "ARABIC LIGATURE TEH WITH KHAH WITH YEH FINAL FORM",
-	"ﶢ": "ARABIC LIGATURE TEH WITH KHAH WITH ALEF MAKSURA FINAL FORM",
-	"ﶣ": "ARABIC LIGATURE TEH WITH MEEM WITH YEH FINAL FORM",
-	"ﶤ": "ARABIC LIGATURE TEH WITH MEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﶥ": "ARABIC LIGATURE JEEM WITH MEEM WITH YEH FINAL FORM",
-	"ﶦ": "ARABIC LIGATURE JEEM WITH HAH WITH ALEF MAKSURA FINAL FORM",
-	"ﶧ": "ARABIC LIGATURE JEEM WITH MEEM WITH ALEF MAKSURA FINAL FORM",
-	"ﶨ": "ARABIC LIGATURE SEEN WITH KHAH WITH ALEF MAKSURA FINAL FORM",
-	"ﶩ": "ARABIC LIGATURE SAD WITH HAH WITH YEH FINAL FORM",
-	"ﶪ": "ARABIC LIGATURE SHEEN WITH HAH WITH YEH FINAL FORM",
-	"ﶫ": "ARABIC LIGATURE DAD WITH HAH WITH YEH FINAL FORM",
-	"ﶬ": "ARABIC LIGATURE LAM WITH JEEM WITH YEH FINAL FORM",
-	"ﶭ": "ARABIC LIGATURE LAM WITH MEEM WITH YEH FINAL FORM",
-	"ﶮ": "ARABIC LIGATURE YEH WITH HAH WITH YEH FINAL FORM",
-	"ﶯ": "ARABIC LIGATURE YEH WITH JEEM WITH YEH FINAL FORM",
-	"ﶰ": "ARABIC LIGATURE YEH WITH MEEM WITH YEH FINAL FORM",
-	"ﶱ": "ARABIC LIGATURE MEEM WITH MEEM WITH YEH FINAL FORM",
-	"ﶲ": "ARABIC LIGATURE QAF WITH MEEM WITH YEH FINAL FORM",
-	"ﶳ": "ARABIC LIGATURE NOON WITH HAH WITH YEH FINAL FORM",
-	"ﶴ": "ARABIC LIGATURE QAF WITH MEEM WITH HAH INITIAL FORM",
-	"ﶵ": "ARABIC LIGATURE LAM WITH HAH WITH MEEM INITIAL FORM",
-	"ﶶ": "ARABIC LIGATURE AIN WITH MEEM WITH YEH FINAL FORM",
-	"ﶷ": "ARABIC LIGATURE KAF WITH MEEM WITH YEH FINAL FORM",
-	"ﶸ": "ARABIC LIGATURE NOON WITH JEEM WITH HAH INITIAL FORM",
-	"ﶹ": "ARABIC LIGATURE MEEM WITH KHAH WITH YEH FINAL FORM",
-	"ﶺ": "ARABIC LIGATURE LAM WITH JEEM WITH MEEM INITIAL FORM",
-	"ﶻ": "ARABIC LIGATURE KAF WITH MEEM WITH MEEM FINAL FORM",
-	"ﶼ": "ARABIC LIGATURE LAM WITH JEEM WITH MEEM FINAL FORM",
-	"ﶽ": "ARABIC LIGATURE NOON WITH JEEM WITH HAH FINAL FORM",
-	"ﶾ": "ARABIC LIGATURE JEEM WITH HAH WITH YEH FINAL FORM",
-	"ﶿ": "ARABIC LIGATURE HAH WITH JEEM WITH YEH FINAL FORM",
-	"ﷀ": "ARABIC LIGATURE MEEM WITH JEEM WITH YEH FINAL FORM",
-	"ﷁ": "ARABIC LIGATURE FEH WITH MEEM WITH YEH FINAL FORM",
-	"ﷂ": "ARABIC LIGATURE BEH WITH HAH WITH YEH FINAL FORM",
-	"ﷃ": "ARABIC LIGATURE KAF WITH MEEM WITH MEEM INITIAL FORM",
-	"ﷄ": "ARABIC LIGATURE AIN WITH JEEM WITH MEEM INITIAL FORM",
-	"ﷅ": "ARABIC LIGATURE SAD WITH MEEM WITH MEEM INITIAL FORM",
-	"ﷆ": "ARABIC LIGATURE SEEN WITH KHAH WITH YEH FINAL FORM",
-	"ﷇ": "ARABIC LIGATURE NOON WITH JEEM WITH YEH FINAL FORM",
-	"ﷰ": "ARABIC LIGATURE SALLA USED AS KORANIC STOP SIGN ISOLATED FORM",
-	"ﷱ": "ARABIC LIGATURE QALA USED AS KORANIC STOP SIGN ISOLATED FORM",
-	"ﷲ": "ARABIC LIGATURE ALLAH ISOLATED FORM",
-	"ﷳ": "ARABIC LIGATURE AKBAR ISOLATED FORM",
-	"ﷴ": "ARABIC LIGATURE MOHAMMAD ISOLATED FORM",
-	"ﷵ": "ARABIC LIGATURE SALAM ISOLATED FORM",
-	"ﷶ": "ARABIC LIGATURE RASOUL ISOLATED FORM",
-	"ﷷ": "ARABIC LIGATURE ALAYHE ISOLATED FORM",
-	"ﷸ": "ARABIC LIGATURE WASALLAM ISOLATED FORM",
-	"ﷹ": "ARABIC LIGATURE SALLA ISOLATED FORM",
-	"ﷺ": "ARABIC LIGATURE SALLALLAHOU ALAYHE WASALLAM",
-	"ﷻ": "ARABIC LIGATURE JALLAJALALOUHOU",
-	"﷼": "RIAL SIGN",
-	"︙": "PRESENTATION FORM FOR VERTICAL HORIZONTAL ELLIPSIS",
-	"︰": "PRESENTATION FORM FOR VERTICAL TWO DOT LEADER",
-	"︱": "PRESENTATION FORM FOR VERTICAL EM DASH",
-	"︴": "PRESENTATION FORM FOR VERTICAL WAVY LOW LINE",
-	"︵": "PRESENTATION FORM FOR VERTICAL LEFT PARENTHESIS",
-	"︶": "PRESENTATION FORM FOR VERTICAL RIGHT PARENTHESIS",
-	"︷": "PRESENTATION FORM FOR VERTICAL LEFT CURLY BRACKET",
-	"︸": "PRESENTATION FORM FOR VERTICAL RIGHT CURLY BRACKET",
-	"︹": "PRESENTATION FORM FOR VERTICAL LEFT TORTOISE SHELL BRACKET",
-	"︺": "PRESENTATION FORM FOR VERTICAL RIGHT TORTOISE SHELL BRACKET",
-	"︿": "PRESENTATION FORM FOR VERTICAL LEFT ANGLE BRACKET",
-	"﹉": "DASHED OVERLINE",
-	"﹊": "CENTRELINE OVERLINE",
-	"﹋": "WAVY OVERLINE",
-	"﹌": "DOUBLE WAVY OVERLINE",
-	"﹍": "DASHED LOW LINE",
-	"﹎": "CENTRELINE LOW LINE",
-	"﹏": "WAVY LOW LINE",
-	"﹘": "SMALL EM DASH",
-	"﹨": "SMALL REVERSE SOLIDUS",
-	"ﹲّ": "ARABIC DAMMATAN ISOLATED FORM, ARABIC SHADDA",
-	"ﹴّ": "ARABIC KASRATAN ISOLATED FORM, ARABIC SHADDA",
-	"ﹶّ": "ARABIC FATHA ISOLATED FORM, ARABIC SHADDA",
-	"ﹷّ": "ARABIC FATHA MEDIAL FORM, ARABIC SHADDA",
-	"ﹸّ": "ARABIC DAMMA ISOLATED FORM, ARABIC SHADDA",
-	"ﹹّ": "ARABIC DAMMA MEDIAL FORM, ARABIC SHADDA",
-	"ﹺّ": "ARABIC KASRA ISOLATED FORM, ARABIC SHADDA",
-	"ﹻّ": "ARABIC KASRA MEDIAL FORM, ARABIC SHADDA",
-	"ﹼٰ": "ARABIC SHADDA ISOLATED FORM, ARABIC LETTER SUPERSCRIPT ALEF",
-	"ﺀ": "ARABIC LETTER HAMZA ISOLATED FORM",
-	"ﺁ": "ARABIC LETTER ALEF WITH MADDA ABOVE ISOLATED FORM",
-	"ﺂ": "ARABIC LETTER ALEF WITH MADDA ABOVE FINAL FORM",
-	"ﺃ": "ARABIC LETTER ALEF WITH HAMZA ABOVE ISOLATED FORM",
-	"ﺄ": "ARABIC LETTER ALEF WITH HAMZA ABOVE FINAL FORM",
-	"ﺅ": "ARABIC LETTER WAW WITH HAMZA ABOVE ISOLATED FORM",
-	"ﺆ": "ARABIC LETTER WAW WITH HAMZA ABOVE FINAL FORM",
-	"ﺇ": "ARABIC LETTER ALEF WITH HAMZA BELOW ISOLATED FORM",
-	"ﺈ": "ARABIC LETTER ALEF WITH HAMZA BELOW FINAL FORM",
-	"ﺉ": "ARABIC LETTER YEH WITH HAMZA ABOVE ISOLATED FORM",
-	"ﺊ": "ARABIC LETTER YEH WITH HAMZA ABOVE FINAL FORM",
-	"ﺋ": "ARABIC LETTER YEH WITH HAMZA ABOVE INITIAL FORM",
-	"ﺌ": "ARABIC LETTER YEH WITH HAMZA ABOVE MEDIAL FORM",
-	"ﺍ": "ARABIC LETTER ALEF ISOLATED FORM",
-	"ﺎ": "ARABIC LETTER ALEF FINAL FORM",
-	"ﺏ": "ARABIC LETTER BEH ISOLATED FORM",
-	"ﺐ": "ARABIC LETTER BEH FINAL FORM",
-	"ﺑ": "ARABIC LETTER BEH INITIAL FORM",
-	"ﺒ": "ARABIC LETTER BEH MEDIAL FORM",
-	"ﺓ": "ARABIC LETTER TEH MARBUTA ISOLATED FORM",
-	"ﺔ": "ARABIC LETTER TEH MARBUTA FINAL FORM",
-	"ﺕ": "ARABIC LETTER TEH ISOLATED FORM",
-	"ﺖ": "ARABIC LETTER TEH FINAL FORM",
-	"ﺗ": "ARABIC LETTER TEH INITIAL FORM",
-	"ﺘ": "ARABIC LETTER TEH MEDIAL FORM",
-	"ﺙ": "ARABIC LETTER THEH ISOLATED FORM",
-	"ﺚ": "ARABIC LETTER THEH FINAL FORM",
-	"ﺛ": "ARABIC LETTER THEH INITIAL FORM",
-	"ﺜ": "ARABIC LETTER THEH MEDIAL FORM",
-	"ﺝ": "ARABIC LETTER JEEM ISOLATED FORM",
-	"ﺞ": "ARABIC LETTER JEEM FINAL FORM",
-	"ﺟ": "ARABIC LETTER JEEM INITIAL FORM",
-	"ﺠ": "ARABIC LETTER JEEM MEDIAL FORM",
-	"ﺡ": "ARABIC LETTER HAH ISOLATED FORM",
-	"ﺢ": "ARABIC LETTER HAH FINAL FORM",
-	"ﺣ": "ARABIC LETTER HAH INITIAL FORM",
-	"ﺤ": "ARABIC LETTER HAH MEDIAL FORM",
-	"ﺥ": "ARABIC LETTER KHAH ISOLATED FORM",
-	"ﺦ": "ARABIC LETTER KHAH FINAL FORM",
-	"ﺧ": "ARABIC LETTER KHAH INITIAL FORM",
-	"ﺨ": "ARABIC LETTER KHAH MEDIAL FORM",
-	"ﺩ": "ARABIC LETTER DAL ISOLATED FORM",
-	"ﺪ": "ARABIC LETTER DAL FINAL FORM",
-	"ﺫ": "ARABIC LETTER THAL ISOLATED FORM",
-	"ﺬ": "ARABIC LETTER THAL FINAL FORM",
-	"ﺭ": "ARABIC LETTER REH ISOLATED FORM",
-	"ﺮ": "ARABIC LETTER REH FINAL FORM",
-	"ﺯ": "ARABIC LETTER ZAIN ISOLATED FORM",
-	"ﺰ": "ARABIC LETTER ZAIN FINAL FORM",
-	"ﺱ": "ARABIC LETTER SEEN ISOLATED FORM",
-	"ﺲ": "ARABIC LETTER SEEN FINAL FORM",
-	"ﺳ": "ARABIC LETTER SEEN INITIAL FORM",
-	"ﺴ": "ARABIC LETTER SEEN MEDIAL FORM",
-	"ﺵ": "ARABIC LETTER SHEEN ISOLATED FORM",
-	"ﺶ": "ARABIC LETTER SHEEN FINAL FORM",
-	"ﺷ": "ARABIC LETTER SHEEN INITIAL FORM",
-	"ﺸ": "ARABIC LETTER SHEEN MEDIAL FORM",
-	"ﺹ": "ARABIC LETTER SAD ISOLATED FORM",
-	"ﺺ": "ARABIC LETTER SAD FINAL FORM",
-	"ﺻ": "ARABIC LETTER SAD INITIAL FORM",
-	"ﺼ": "ARABIC LETTER SAD MEDIAL FORM",
-	"ﺽ": "ARABIC LETTER DAD ISOLATED FORM",
-	"ﺾ": "ARABIC LETTER DAD FINAL FORM",
-	"ﺿ": "ARABIC LETTER DAD INITIAL FORM",
-	"ﻀ": "ARABIC LETTER DAD MEDIAL FORM",
-	"ﻁ": "ARABIC LETTER TAH ISOLATED FORM",
-	"ﻂ": "ARABIC LETTER TAH FINAL FORM",
-	"ﻃ": "ARABIC LETTER TAH INITIAL FORM",
-	"ﻄ": "ARABIC LETTER TAH MEDIAL FORM",
-	"ﻅ": "ARABIC LETTER ZAH ISOLATED FORM",
-	"ﻆ": "ARABIC LETTER ZAH FINAL FORM",
-	"ﻇ": "ARABIC LETTER ZAH INITIAL FORM",
-	"ﻈ": "ARABIC LETTER ZAH MEDIAL FORM",
-	"ﻉ": "ARABIC LETTER AIN ISOLATED FORM",
-	"ﻊ": "ARABIC LETTER AIN FINAL FORM",
-	"ﻋ": "ARABIC LETTER AIN INITIAL FORM",
-	"ﻌ": "ARABIC LETTER AIN MEDIAL FORM",
-	"ﻍ": "ARABIC LETTER GHAIN ISOLATED FORM",
-	"ﻎ": "ARABIC LETTER GHAIN FINAL FORM",
-	"ﻏ": "ARABIC LETTER GHAIN INITIAL FORM",
-	"ﻐ": "ARABIC LETTER GHAIN MEDIAL FORM",
-	"ﻑ": "ARABIC LETTER FEH ISOLATED FORM",
-	"ﻒ": "ARABIC LETTER FEH FINAL FORM",
-	"ﻓ": "ARABIC LETTER FEH INITIAL FORM",
-	"ﻔ": "ARABIC LETTER FEH MEDIAL FORM",
-	"ﻕ": "ARABIC LETTER QAF ISOLATED FORM",
-	"ﻖ": "ARABIC LETTER QAF FINAL FORM",
-	"ﻗ": "ARABIC LETTER QAF INITIAL FORM",
-	"ﻘ": "ARABIC LETTER QAF MEDIAL FORM",
-	"ﻙ": "ARABIC LETTER KAF ISOLATED FORM",
-	"ﻚ": "ARABIC LETTER KAF FINAL FORM",
-	"ﻛ": "ARABIC LETTER KAF INITIAL FORM",
-	"ﻜ": "ARABIC LETTER KAF MEDIAL FORM",
-	"ﻝ": "ARABIC LETTER LAM ISOLATED FORM",
-	"ﻞ": "ARABIC LETTER LAM FINAL FORM",
-	"ﻟ": "ARABIC LETTER LAM INITIAL FORM",
-	"ﻠ": "ARABIC LETTER LAM MEDIAL FORM",
-	"ﻡ": "ARABIC LETTER MEEM ISOLATED FORM",
-	"ﻢ": "ARABIC LETTER MEEM FINAL FORM",
-	"ﻣ": "ARABIC LETTER MEEM INITIAL FORM",
-	"ﻤ": "ARABIC LETTER MEEM MEDIAL FORM",
-	"ﻥ": "ARABIC LETTER NOON ISOLATED FORM",
-	"ﻦ": "ARABIC LETTER NOON FINAL FORM",
-	"ﻧ": "ARABIC LETTER NOON INITIAL FORM",
-	"ﻨ": "ARABIC LETTER NOON MEDIAL FORM",
-	"ﻩ": "ARABIC LETTER HEH ISOLATED FORM",
-	"ﻪ": "ARABIC LETTER HEH FINAL FORM",
-	"ﻫ": "ARABIC LETTER HEH INITIAL FORM",
-	"ﻬ": "ARABIC LETTER HEH MEDIAL FORM",
-	"ﻭ": "ARABIC LETTER WAW ISOLATED FORM",
-	"ﻮ": "ARABIC LETTER WAW FINAL FORM",
-	"ﻯ": "ARABIC LETTER ALEF MAKSURA ISOLATED FORM",
-	"ﻰ": "ARABIC LETTER ALEF MAKSURA FINAL FORM",
-	"ﻱ": "ARABIC LETTER YEH ISOLATED FORM",
-	"ﻲ": "ARABIC LETTER YEH FINAL FORM",
-	"ﻳ": "ARABIC LETTER YEH INITIAL FORM",
-	"ﻴ": "ARABIC LETTER YEH MEDIAL FORM",
-	"ﻵ": "ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE ISOLATED FORM",
-	"ﻶ": "ARABIC LIGATURE LAM WITH ALEF WITH MADDA ABOVE FINAL FORM",
-	"ﻷ": "ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE ISOLATED FORM",
-	"ﻸ": "ARABIC LIGATURE LAM WITH ALEF WITH HAMZA ABOVE FINAL FORM",
-	"ﻹ": "ARABIC LIGATURE LAM WITH ALEF WITH HAMZA BELOW ISOLATED FORM",
-	"ﻺ": "ARABIC LIGATURE LAM WITH ALEF WITH HAMZA BELOW FINAL FORM",
-	"ﻻ": "ARABIC LIGATURE LAM WITH ALEF ISOLATED FORM",
-	"ﻼ": "ARABIC LIGATURE LAM WITH ALEF FINAL FORM",
-	"！": "FULLWIDTH EXCLAMATION MARK",
-	"＂": "FULLWIDTH QUOTATION MARK",
-	"＇": "FULLWIDTH APOSTROPHE",
-	"－": "FULLWIDTH HYPHEN-MINUS",
-	"：": "FULLWIDTH COLON",
-	"Ａ": "FULLWIDTH LATIN CAPITAL LETTER A",
-	"Ｂ": "FULLWIDTH LATIN CAPITAL LETTER B",
-	"Ｃ": "FULLWIDTH LATIN CAPITAL LETTER C",
-	"Ｅ": "FULLWIDTH LATIN CAPITAL LETTER E",
-	"Ｈ": "FULLWIDTH LATIN CAPITAL LETTER H",
-	"Ｉ": "FULLWIDTH LATIN CAPITAL LETTER I",
-	"Ｊ": "FULLWIDTH LATIN CAPITAL LETTER J",
-	"Ｋ": "FULLWIDTH LATIN CAPITAL LETTER K",
-	"Ｍ": "FULLWIDTH LATIN CAPITAL LETTER M",
-	"Ｎ": "FULLWIDTH LATIN CAPITAL LETTER N",
-	"Ｏ": "FULLWIDTH LATIN CAPITAL LETTER O",
-	"Ｐ": "FULLWIDTH LATIN CAPITAL LETTER P",
-	"Ｓ": "FULLWIDTH LATIN CAPITAL LETTER S",
-	"Ｔ": "FULLWIDTH LATIN CAPITAL LETTER T",
-	"Ｘ": "FULLWIDTH LATIN CAPITAL LETTER X",
-	"Ｙ": "FULLWIDTH LATIN CAPITAL LETTER Y",
-	"Ｚ": "FULLWIDTH LATIN CAPITAL LETTER Z",
-	"［": "FULLWIDTH LEFT SQUARE BRACKET",
-	"＼": "FULLWIDTH REVERSE SOLIDUS",
-	"］": "FULLWIDTH RIGHT SQUARE BRACKET",
-	"＾": "FULLWIDTH CIRCUMFLEX ACCENT",
-	"｀": "FULLWIDTH GRAVE ACCENT",
-	"ａ": "FULLWIDTH LATIN SMALL LETTER A",
-	"ｃ": "FULLWIDTH LATIN SMALL LETTER C",
-	"ｅ": "FULLWIDTH LATIN SMALL LETTER E",
-	"ｇ": "FULLWIDTH LATIN SMALL LETTER G",
-	"ｈ": "FULLWIDTH LATIN SMALL LETTER H",
-	"ｉ": "FULLWIDTH LATIN SMALL LETTER I",
-	"ｊ": "FULLWIDTH LATIN SMALL LETTER J",
-	"ｌ": "FULLWIDTH LATIN SMALL LETTER L",
-	"ｏ": "FULLWIDTH LATIN SMALL LETTER O",
-	"ｐ": "FULLWIDTH LATIN SMALL LETTER P",
-	"ｓ": "FULLWIDTH LATIN SMALL LETTER S",
-	"ｖ": "FULLWIDTH LATIN SMALL LETTER V",
-	"ｘ": "FULLWIDTH LATIN SMALL LETTER X",
-	"ｙ": "FULLWIDTH LATIN SMALL LETTER Y",
-	"｜": "FULLWIDTH VERTICAL LINE",
-	"～": "FULLWIDTH TILDE",
-	"･": "HALFWIDTH KATAKANA MIDDLE DOT",
-	"ﾞ": "HALFWIDTH KATAKANA VOICED SOUND MARK",
-	"ﾟ": "HALFWIDTH KATAKANA SEMI-VOICED SOUND MARK",
-	"￣": "FULLWIDTH MACRON",
-	"￨": "HALFWIDTH FORMS LIGHT VERTICAL",
-	"￭": "HALFWIDTH BLACK SQUARE",
-	"𐄁": "AEGEAN WORD SEPARATOR DOT",
-	"𐆎": "NOMISMA SIGN",
-	"𐆖": "ROMAN DENARIUS SIGN",
-	"𐆗": "ROMAN QUINARIUS SIGN",
-	"𐆘": "ROMAN SESTERTIUS SIGN",
-	"𐆙": "ROMAN DUPONDIUS SIGN",
-	"𐆠": "GREEK SYMBOL TAU RHO",
-	"𐊂": "LYCIAN LETTER B",
-	"𐊅": "LYCIAN LETTER D",
-	"𐊆": "LYCIAN LETTER I",
-	"𐊇": "LYCIAN LETTER W",
-	"𐊊": "LYCIAN LETTER J",
-	"𐊍": "LYCIAN LETTER L",
-	"𐊐": "LYCIAN LETTER MM",
-	"𐊒": "LYCIAN LETTER U",
-	"𐊔": "LYCIAN LETTER KK",
-	"𐊕": "LYCIAN LETTER R",
-	"𐊖": "LYCIAN LETTER S",
-	"𐊗": "LYCIAN LETTER T",
-	"𐊛": "LYCIAN LETTER H",
-	"𐊠": "CARIAN LETTER A",
-	"𐊡": "CARIAN LETTER P2",
-	"𐊢": "CARIAN LETTER D",
-	"𐊣": "CARIAN LETTER L",
-	"𐊥": "CARIAN LETTER R",
-	"𐊨": "CARIAN LETTER Q",
-	"𐊫": "CARIAN LETTER O",
-	"𐊭": "CARIAN LETTER T",
-	"𐊰": "CARIAN LETTER S",
-	"𐊱": "CARIAN LETTER C-18",
-	"𐊲": "CARIAN LETTER U",
-	"𐊳": "CARIAN LETTER NN",
-	"𐊴": "CARIAN LETTER X",
-	"𐊵": "CARIAN LETTER N",
-	"𐊶": "CARIAN LETTER TT2",
-	"𐊸": "CARIAN LETTER SS",
-	"𐊼": "CARIAN LETTER K",
-	"𐋀": "CARIAN LETTER G",
-	"𐋏": "CARIAN LETTER E2",
-	"𐋡": "COPTIC EPACT DIGIT ONE",
-	"𐋤": "COPTIC EPACT DIGIT FOUR",
-	"𐋨": "COPTIC EPACT DIGIT EIGHT",
-	"𐋲": "COPTIC EPACT NUMBER NINETY",
-	"𐋵": "COPTIC EPACT NUMBER THREE HUNDRED",
-	"𐌁": "OLD ITALIC LETTER BE",
-	"𐌂": "OLD ITALIC LETTER KE",
-	"𐌉": "OLD ITALIC LETTER I",
-	"𐌑": "OLD ITALIC LETTER SHE",
-	"𐌒": "OLD ITALIC LETTER KU",
-	"𐌕": "OLD ITALIC LETTER TE",
-	"𐌗": "OLD ITALIC LETTER EKS",
-	"𐌚": "OLD ITALIC LETTER EF",
-	"𐌟": "OLD ITALIC LETTER ESS",
-	"𐌠": "OLD ITALIC NUMERAL ONE",
-	"𐌢": "OLD ITALIC NUMERAL TEN",
-	"𐎂": "UGARITIC LETTER GAMLA",
-	"𐎓": "UGARITIC LETTER AIN",
-	"𐎚": "UGARITIC LETTER TO",
-	"𐏑": "OLD PERSIAN NUMBER ONE",
-	"𐏓": "OLD PERSIAN NUMBER TEN",
-	"𐐁": "DESERET CAPITAL LETTER LONG E",
-	"𐐄": "DESERET CAPITAL LETTER LONG O",
-	"𐐑": "DESERET CAPITAL LETTER PEE",
-	"𐐒": "DESERET CAPITAL LETTER BEE",
-	"𐐕": "DESERET CAPITAL LETTER CHEE",
-	"𐐛": "DESERET CAPITAL LETTER ETH",
-	"𐐟": "DESERET CAPITAL LETTER ESH",
-	"𐐠": "DESERET CAPITAL LETTER ZHEE",
-	"𐐣": "DESERET CAPITAL LETTER EM",
-	"𐐥": "DESERET CAPITAL LETTER ENG",
-	"𐐩": "DESERET SMALL LETTER LONG E",
-	"𐐪": "DESERET SMALL LETTER LONG A",
-	"𐐬": "DESERET SMALL LETTER LONG O",
-	"𐐺": "DESERET SMALL LETTER BEE",
-	"𐐽": "DESERET SMALL LETTER CHEE",
-	"𐐿": "DESERET SMALL LETTER KAY",
-	"𐑂": "DESERET SMALL LETTER VEE",
-	"𐑃": "DESERET SMALL LETTER ETH",
-	"𐑈": "DESERET SMALL LETTER ZHEE",
-	"𐑋": "DESERET SMALL LETTER EM",
-	"𐑍": "DESERET SMALL LETTER ENG",
-	"𐒆": "OSMANYA LETTER DEEL",
-	"𐒠": "OSMANYA DIGIT ZERO",
-	"𐒰": "OSAGE CAPITAL LETTER A",
-	"𐒴": "OSAGE CAPITAL LETTER BRA",
-	"𐒼": "OSAGE CAPITAL LETTER KA",
-	"𐓂": "OSAGE CAPITAL LETTER O",
-	"𐓃": "OSAGE CAPITAL LETTER OIN",
-	"𐓄": "OSAGE CAPITAL LETTER PA",
-	"𐓍": "OSAGE CAPITAL LETTER DHA",
-	"𐓎": "OSAGE CAPITAL LETTER U",
-	"𐓐": "OSAGE CAPITAL LETTER KHA",
-	"𐓑": "OSAGE CAPITAL LETTER GHA",
-	"𐓒": "OSAGE CAPITAL LETTER ZA",
-	"𐓘": "OSAGE SMALL LETTER A",
-	"𐓛": "OSAGE SMALL LETTER AH",
-	"𐓪": "OSAGE SMALL LETTER O",
-	"𐓫": "OSAGE SMALL LETTER OIN",
-	"𐓶": "OSAGE SMALL LETTER U",
-	"𐓹": "OSAGE SMALL LETTER GHA",
-	"𐔓": "ELBASAN LETTER NE",
-	"𐔖": "ELBASAN LETTER O",
-	"𐔘": "ELBASAN LETTER QE",
-	"𐔜": "ELBASAN LETTER SHE",
-	"𐔝": "ELBASAN LETTER TE",
-	"𐔥": "ELBASAN LETTER GHE",
-	"𐔦": "ELBASAN LETTER GHAMMA",
-	"𐔧": "ELBASAN LETTER KHE",
-	"𐦞": "MEROITIC HIEROGLYPHIC SYMBOL VIDJ",
-	"𐨺": "KHAROSHTHI SIGN DOT BELOW",
-	"𐩐": "KHAROSHTHI PUNCTUATION DOT",
-	"𐩖𐩖": "KHAROSHTHI PUNCTUATION DANDA, KHAROSHTHI PUNCTUATION DANDA",
-	"𐩗": "KHAROSHTHI PUNCTUATION DOUBLE DANDA",
-	"𐲂": "OLD HUNGARIAN CAPITAL LETTER EB",
-	"𐲥": "OLD HUNGARIAN CAPITAL LETTER ESZ",
-	"𐳺": "OLD HUNGARIAN NUMBER ONE",
-	"𐳼": "OLD HUNGARIAN NUMBER TEN",
-	"𑂻": "KAITHI ABBREVIATION SIGN",
-	"𑇇": "SHARADA ABBREVIATION SIGN",
-	"𑇊": "SHARADA SIGN NUKTA",
-	"𑇋": "SHARADA VOWEL MODIFIER MARK",
-	"𑇛": "SHARADA SIGN SIDDHAM",
-	"𑇜": "SHARADA HEADSTROKE",
-	"𑇞": "SHARADA SECTION MARK-1",
-	"𑌀": "GRANTHA SIGN COMBINING ANUSVARA ABOVE",
-	"𑐓": "NEWA LETTER NGHA",
-	"𑐙": "NEWA LETTER NYHA",
-	"𑐤": "NEWA LETTER NHA",
-	"𑐪": "NEWA LETTER MHA",
-	"𑐭": "NEWA LETTER RHA",
-	"𑐯": "NEWA LETTER LHA",
-	"𑐴𑑂𑐒": "NEWA LETTER HA, NEWA SIGN VIRAMA, NEWA LETTER NGA",
-	"𑐴𑑂𑐘": "NEWA LETTER HA, NEWA SIGN VIRAMA, NEWA LETTER NYA",
-	"𑐴𑑂𑐣": "NEWA LETTER HA, NEWA SIGN VIRAMA, NEWA LETTER NA",
-	"𑐴𑑂𑐩": "NEWA LETTER HA, NEWA SIGN VIRAMA, NEWA LETTER MA",
-	"𑐴𑑂𑐬": "NEWA LETTER HA, NEWA SIGN VIRAMA, NEWA LETTER RA",
-	"𑐴𑑂𑐮": "NEWA LETTER HA, NEWA SIGN VIRAMA, NEWA LETTER LA",
-	"𑑋𑑋": "NEWA DANDA, NEWA DANDA",
-	"𑑌": "NEWA DOUBLE DANDA",
-	"𑒒": "TIRHUTA LETTER GHA",
-	"𑒔": "TIRHUTA LETTER CA",
-	"𑒖": "TIRHUTA LETTER JA",
-	"𑒘": "TIRHUTA LETTER NYA",
-	"𑒙": "TIRHUTA LETTER TTA",
-	"𑒛": "TIRHUTA LETTER DDA",
-	"𑒝": "TIRHUTA LETTER NNA",
-	"𑒞": "TIRHUTA LETTER TA",
-	"𑒟": "TIRHUTA LETTER THA",
-	"𑒠": "TIRHUTA LETTER DA",
-	"𑒡": "TIRHUTA LETTER DHA",
-	"𑒢": "TIRHUTA LETTER NA",
-	"𑒣": "TIRHUTA LETTER PA",
-	"𑒧": "TIRHUTA LETTER MA",
-	"𑒨": "TIRHUTA LETTER YA",
-	"𑒩": "TIRHUTA LETTER RA",
-	"𑒪": "TIRHUTA LETTER LA",
-	"𑒫": "TIRHUTA LETTER VA",
-	"𑒭": "TIRHUTA LETTER SSA",
-	"𑒮": "TIRHUTA LETTER SA",
-	"𑒰": "TIRHUTA VOWEL SIGN AA",
-	"𑒱": "TIRHUTA VOWEL SIGN I",
-	"𑒹": "TIRHUTA VOWEL SIGN E",
-	"𑒼": "TIRHUTA VOWEL SIGN O",
-	"𑒽": "TIRHUTA VOWEL SIGN SHORT O",
-	"𑒾": "TIRHUTA VOWEL SIGN AU",
-	"𑒿": "TIRHUTA SIGN CANDRABINDU",
-	"𑓁": "TIRHUTA SIGN VISARGA",
-	"𑓂": "TIRHUTA SIGN VIRAMA",
-	"𑓃": "TIRHUTA SIGN NUKTA",
-	"𑓄": "TIRHUTA SIGN AVAGRAHA",
-	"𑓅": "TIRHUTA GVANG",
-	"𑓐": "TIRHUTA DIGIT ZERO",
-	"𑓑": "TIRHUTA DIGIT ONE",
-	"𑓒": "TIRHUTA DIGIT TWO",
-	"𑓖": "TIRHUTA DIGIT SIX",
-	"𑖂": "SIDDHAM LETTER I",
-	"𑖃": "SIDDHAM LETTER II",
-	"𑖄": "SIDDHAM LETTER U",
-	"𑖲": "SIDDHAM VOWEL SIGN U",
-	"𑖳": "SIDDHAM VOWEL SIGN UU",
-	"𑗘": "SIDDHAM LETTER THREE-CIRCLE ALTERNATE I",
-	"𑗙": "SIDDHAM LETTER TWO-CIRCLE ALTERNATE I",
-	"𑗚": "SIDDHAM LETTER TWO-CIRCLE ALTERNATE II",
-	"𑗛": "SIDDHAM LETTER ALTERNATE U",
-	"𑗜": "SIDDHAM VOWEL SIGN ALTERNATE U",
-	"𑗝": "SIDDHAM VOWEL SIGN ALTERNATE UU",
-	"𑙁𑙁": "MODI DANDA, MODI DANDA",
-	"𑙂": "MODI DOUBLE DANDA",
-	"𑜀": "AHOM LETTER KA",
-	"𑜆": "AHOM LETTER PA",
-	"𑜊": "AHOM LETTER JA",
-	"𑜎": "AHOM LETTER LA",
-	"𑜏": "AHOM LETTER SA",
-	"𑢠": "WARANG CITI CAPITAL LETTER NGAA",
-	"𑢢": "WARANG CITI CAPITAL LETTER WI",
-	"𑢣": "WARANG CITI CAPITAL LETTER YU",
-	"𑢤": "WARANG CITI CAPITAL LETTER YA",
-	"𑢦": "WARANG CITI CAPITAL LETTER II",
-	"𑢨": "WARANG CITI CAPITAL LETTER E",
-	"𑢩": "WARANG CITI CAPITAL LETTER O",
-	"𑢬": "WARANG CITI CAPITAL LETTER KO",
-	"𑢮": "WARANG CITI CAPITAL LETTER YUJ",
-	"𑢯": "WARANG CITI CAPITAL LETTER UC",
-	"𑢲": "WARANG CITI CAPITAL LETTER TTE",
-	"𑢵": "WARANG CITI CAPITAL LETTER AT",
-	"𑢷": "WARANG CITI CAPITAL LETTER BU",
-	"𑢸": "WARANG CITI CAPITAL LETTER PU",
-	"𑢻": "WARANG CITI CAPITAL LETTER HORR",
-	"𑢼": "WARANG CITI CAPITAL LETTER HAR",
-	"𑣀": "WARANG CITI SMALL LETTER NGAA",
-	"𑣁": "WARANG CITI SMALL LETTER A",
-	"𑣂": "WARANG CITI SMALL LETTER WI",
-	"𑣃": "WARANG CITI SMALL LETTER YU",
-	"𑣄": "WARANG CITI SMALL LETTER YA",
-	"𑣆": "WARANG CITI SMALL LETTER II",
-	"𑣈": "WARANG CITI SMALL LETTER E",
-	"𑣊": "WARANG CITI SMALL LETTER ANG",
-	"𑣌": "WARANG CITI SMALL LETTER KO",
-	"𑣎": "WARANG CITI SMALL LETTER YUJ",
-	"𑣕": "WARANG CITI SMALL LETTER AT",
-	"𑣖": "WARANG CITI SMALL LETTER AM",
-	"𑣗": "WARANG CITI SMALL LETTER BU",
-	"𑣘": "WARANG CITI SMALL LETTER PU",
-	"𑣜": "WARANG CITI SMALL LETTER HAR",
-	"𑣠": "WARANG CITI DIGIT ZERO",
-	"𑣣": "WARANG CITI DIGIT THREE",
-	"𑣤": "WARANG CITI DIGIT FOUR",
-	"𑣥": "WARANG CITI DIGIT FIVE",
-	"𑣦": "WARANG CITI DIGIT SIX",
-	"𑣩": "WARANG CITI DIGIT NINE",
-	"𑣬": "WARANG CITI NUMBER THIRTY",
-	"𑣯": "WARANG CITI NUMBER SIXTY",
-	"𑣲": "WARANG CITI NUMBER NINETY",
-	"𑫥𑫥": "PAU CIN HAU RISING TONE LONG, PAU CIN HAU RISING TONE LONG",
-	"𑫥𑫥𑫯": "PAU CIN HAU RISING TONE LONG, PAU CIN HAU RISING TONE LONG, PAU CIN HAU MID-LEVEL TONE",
-	"𑫥𑫥𑫰": "PAU CIN HAU RISING TONE LONG, PAU CIN HAU RISING TONE LONG, PAU CIN HAU GLOTTAL STOP VARIANT",
-	"𑫥𑫯": "PAU CIN HAU RISING TONE LONG, PAU CIN HAU MID-LEVEL TONE",
-	"𑫥𑫰": "PAU CIN HAU RISING TONE LONG, PAU CIN HAU GLOTTAL STOP VARIANT",
-	"𑫦": "PAU CIN HAU RISING TONE",
-	"𑫧": "PAU CIN HAU SANDHI GLOTTAL STOP",
-	"𑫨": "PAU CIN HAU RISING TONE LONG FINAL",
-	"𑫩": "PAU CIN HAU RISING TONE FINAL",
-	"𑫪": "PAU CIN HAU SANDHI GLOTTAL STOP FINAL",
-	"𑫫𑫫": "PAU CIN HAU SANDHI TONE LONG, PAU CIN HAU SANDHI TONE LONG",
-	"𑫫𑫫𑫯": "PAU CIN HAU SANDHI TONE LONG, PAU CIN HAU SANDHI TONE LONG, PAU CIN HAU MID-LEVEL TONE",
-	"𑫫𑫯": "PAU CIN HAU SANDHI TONE LONG, PAU CIN HAU MID-LEVEL TONE",
-	"𑫬": "PAU CIN HAU SANDHI TONE",
-	"𑫭": "PAU CIN HAU SANDHI TONE LONG FINAL",
-	"𑫮": "PAU CIN HAU SANDHI TONE FINAL",
-	"𑫳𑫯": "PAU CIN HAU LOW-FALLING TONE LONG, PAU CIN HAU MID-LEVEL TONE",
-	"𑫳𑫰": "PAU CIN HAU LOW-FALLING TONE LONG, PAU CIN HAU GLOTTAL STOP VARIANT",
-	"𑫳𑫳": "PAU CIN HAU LOW-FALLING TONE LONG, PAU CIN HAU LOW-FALLING TONE LONG",
-	"𑫳𑫳𑫯": "PAU CIN HAU LOW-FALLING TONE LONG, PAU CIN HAU LOW-FALLING TONE LONG, PAU CIN HAU MID-LEVEL TONE",
-	"𑫳𑫳𑫰": "PAU CIN HAU LOW-FALLING TONE LONG, PAU CIN HAU LOW-FALLING TONE LONG, PAU CIN HAU GLOTTAL STOP VARIANT",
-	"𑫴": "PAU CIN HAU LOW-FALLING TONE",
-	"𑫵": "PAU CIN HAU GLOTTAL STOP",
-	"𑫶": "PAU CIN HAU LOW-FALLING TONE LONG FINAL",
-	"𑫷": "PAU CIN HAU LOW-FALLING TONE FINAL",
-	"𑫸": "PAU CIN HAU GLOTTAL STOP FINAL",
-	"𑱁𑱁": "BHAIKSUKI DANDA, BHAIKSUKI DANDA",
-	"𑱂": "BHAIKSUKI DOUBLE DANDA",
-	"𑲪": "MARCHEN SUBJOINED LETTER RA",
-	"𑲲": "MARCHEN VOWEL SIGN U",
-	"𒀸": "CUNEIFORM SIGN ASH",
-	"𓋹": "EGYPTIAN HIEROGLYPH S034",
-	"𖼀": "MIAO LETTER PA",
-	"𖼇": "MIAO LETTER FA",
-	"𖼈": "MIAO LETTER VA",
-	"𖼊": "MIAO LETTER TA",
-	"𖼖": "MIAO LETTER LA",
-	"𖼚": "MIAO LETTER TLHA",
-	"𖼜": "MIAO LETTER TLHYA",
-	"𖼦": "MIAO LETTER HA",
-	"𖼨": "MIAO LETTER GHA",
-	"𖼭": "MIAO LETTER NYHA",
-	"𖼵": "MIAO LETTER ZHA",
-	"𖼺": "MIAO LETTER SA",
-	"𖼻": "MIAO LETTER ZA",
-	"𖼽": "MIAO LETTER ZZA",
-	"𖼿": "MIAO LETTER ARCHAIC ZZA",
-	"𖽀": "MIAO LETTER ZZYA",
-	"𖽂": "MIAO LETTER WA",
-	"𖽃": "MIAO LETTER AH",
-	"𖽑": "MIAO SIGN ASPIRATION",
-	"𖽒": "MIAO SIGN REFORMED VOICING",
-	"𝄔": "MUSICAL SYMBOL BRACE",
-	"𝅘𝅥": "MUSICAL SYMBOL NOTEHEAD BLACK, MUSICAL SYMBOL COMBINING STEM",
-	"𝅘𝅥𝅮": "MUSICAL SYMBOL NOTEHEAD BLACK, MUSICAL SYMBOL COMBINING STEM, MUSICAL SYMBOL COMBINING FLAG-1",
-	"𝅭": "MUSICAL SYMBOL COMBINING AUGMENTATION DOT",
-	"𝈂": "GREEK VOCAL NOTATION SYMBOL-3",
-	"𝈆": "GREEK VOCAL NOTATION SYMBOL-7",
-	"𝈋": "GREEK VOCAL NOTATION SYMBOL-12",
-	"𝈍": "GREEK VOCAL NOTATION SYMBOL-14",
-	"𝈏": "GREEK VOCAL NOTATION SYMBOL-16",
-	"𝈒": "GREEK VOCAL NOTATION SYMBOL-19",
-	"𝈓": "GREEK VOCAL NOTATION SYMBOL-20",
-	"𝈔": "GREEK VOCAL NOTATION SYMBOL-21",
-	"𝈕": "GREEK VOCAL NOTATION SYMBOL-22",
-	"𝈖": "GREEK VOCAL NOTATION SYMBOL-23",
-	"𝈗": "GREEK VOCAL NOTATION SYMBOL-24",
-	"𝈚": "GREEK VOCAL NOTATION SYMBOL-52",
-	"𝈛": "GREEK VOCAL NOTATION SYMBOL-53",
-	"𝈜": "GREEK VOCAL NOTATION SYMBOL-54",
-	"𝈡": "GREEK INSTRUMENTAL NOTATION SYMBOL-7",
-	"𝈢": "GREEK INSTRUMENTAL NOTATION SYMBOL-8",
-	"𝈪": "GREEK INSTRUMENTAL NOTATION SYMBOL-23",
-	"𝈫": "GREEK INSTRUMENTAL NOTATION SYMBOL-24",
-	"𝈰": "GREEK INSTRUMENTAL NOTATION SYMBOL-30",
-	"𝈶": "GREEK INSTRUMENTAL NOTATION SYMBOL-40",
-	"𝈷": "GREEK INSTRUMENTAL NOTATION SYMBOL-42",
-	"𝈸": "GREEK INSTRUMENTAL NOTATION SYMBOL-43",
-	"𝈹": "GREEK INSTRUMENTAL NOTATION SYMBOL-45",
-	"𝈺": "GREEK INSTRUMENTAL NOTATION SYMBOL-47",
-	"𝈻": "GREEK INSTRUMENTAL NOTATION SYMBOL-48",
-	"𝈿": "GREEK INSTRUMENTAL NOTATION SYMBOL-52",
-	"𝉅": "GREEK MUSICAL LEIMMA",
-	"𝐀": "MATHEMATICAL BOLD CAPITAL A",
-	"𝐁": "MATHEMATICAL BOLD CAPITAL B",
-	"𝐂": "MATHEMATICAL BOLD CAPITAL C",
-	"𝐃": "MATHEMATICAL BOLD CAPITAL D",
-	"𝐄": "MATHEMATICAL BOLD CAPITAL E",
-	"𝐅": "MATHEMATICAL BOLD CAPITAL F",
-	"𝐆": "MATHEMATICAL BOLD CAPITAL G",
-	"𝐇": "MATHEMATICAL BOLD CAPITAL H",
-	"𝐈": "MATHEMATICAL BOLD CAPITAL I",
-	"𝐉": "MATHEMATICAL BOLD CAPITAL J",
-	"𝐊": "MATHEMATICAL BOLD CAPITAL K",
-	"𝐋": "MATHEMATICAL BOLD CAPITAL L",
-	"𝐌": "MATHEMATICAL BOLD CAPITAL M",
-	"𝐍": "MATHEMATICAL BOLD CAPITAL N",
-	"𝐎": "MATHEMATICAL BOLD CAPITAL O",
-	"𝐏": "MATHEMATICAL BOLD CAPITAL P",
-	"𝐐": "MATHEMATICAL BOLD CAPITAL Q",
-	"𝐑": "MATHEMATICAL BOLD CAPITAL R",
-	"𝐒": "MATHEMATICAL BOLD CAPITAL S",
-	"𝐓": "MATHEMATICAL BOLD CAPITAL T",
-	"𝐔": "MATHEMATICAL BOLD CAPITAL U",
-	"𝐕": "MATHEMATICAL BOLD CAPITAL V",
-	"𝐖": "MATHEMATICAL BOLD CAPITAL W",
-	"𝐗": "MATHEMATICAL BOLD CAPITAL X",
-	"𝐘": "MATHEMATICAL BOLD CAPITAL Y",
-	"𝐙": "MATHEMATICAL BOLD CAPITAL Z",
-	"𝐚": "MATHEMATICAL BOLD SMALL A",
-	"𝐛": "MATHEMATICAL BOLD SMALL B",
-	"𝐜": "MATHEMATICAL BOLD SMALL C",
-	"𝐝": "MATHEMATICAL BOLD SMALL D",
-	"𝐞": "MATHEMATICAL BOLD SMALL E",
-	"𝐟": "MATHEMATICAL BOLD SMALL F",
-	"𝐠": "MATHEMATICAL BOLD SMALL G",
-	"𝐡": "MATHEMATICAL BOLD SMALL H",
-	"𝐢": "MATHEMATICAL BOLD SMALL I",
-	"𝐣": "MATHEMATICAL BOLD SMALL J",
-	"𝐤": "MATHEMATICAL BOLD SMALL K",
-	"𝐥": "MATHEMATICAL BOLD SMALL L",
-	"𝐦": "MATHEMATICAL BOLD SMALL M",
-	"𝐧": "MATHEMATICAL BOLD SMALL N",
-	"𝐨": "MATHEMATICAL BOLD SMALL O",
-	"𝐩": "MATHEMATICAL BOLD SMALL P",
-	"𝐪": "MATHEMATICAL BOLD SMALL Q",
-	"𝐫": "MATHEMATICAL BOLD SMALL R",
-	"𝐬": "MATHEMATICAL BOLD SMALL S",
-	"𝐭": "MATHEMATICAL BOLD SMALL T",
-	"𝐮": "MATHEMATICAL BOLD SMALL U",
-	"𝐯": "MATHEMATICAL BOLD SMALL V",
-	"𝐰": "MATHEMATICAL BOLD SMALL W",
-	"𝐱": "MATHEMATICAL BOLD SMALL X",
-	"𝐲": "MATHEMATICAL BOLD SMALL Y",
-	"𝐳": "MATHEMATICAL BOLD SMALL Z",
-	"𝐴": "MATHEMATICAL ITALIC CAPITAL A",
-	"𝐵": "MATHEMATICAL ITALIC CAPITAL B",
-	"𝐶": "MATHEMATICAL ITALIC CAPITAL C",
-	"𝐷": "MATHEMATICAL ITALIC CAPITAL D",
-	"𝐸": "MATHEMATICAL ITALIC CAPITAL E",
-	"𝐹": "MATHEMATICAL ITALIC CAPITAL F",
-	"𝐺": "MATHEMATICAL ITALIC CAPITAL G",
-	"𝐻": "MATHEMATICAL ITALIC CAPITAL H",
-	"𝐼": "MATHEMATICAL ITALIC CAPITAL I",
-	"𝐽": "MATHEMATICAL ITALIC CAPITAL J",
-	"𝐾": "MATHEMATICAL ITALIC CAPITAL K",
-	"𝐿": "MATHEMATICAL ITALIC CAPITAL L",
-	"𝑀": "MATHEMATICAL ITALIC CAPITAL M",
-	"𝑁": "MATHEMATICAL ITALIC CAPITAL N",
-	"𝑂": "MATHEMATICAL ITALIC CAPITAL O",
-	"𝑃": "MATHEMATICAL ITALIC CAPITAL P",
-	"𝑄": "MATHEMATICAL ITALIC CAPITAL Q",
-	"𝑅": "MATHEMATICAL ITALIC CAPITAL R",
-	"𝑆": "MATHEMATICAL ITALIC CAPITAL S",
-	"𝑇": "MATHEMATICAL ITALIC CAPITAL T",
-	"𝑈": "MATHEMATICAL ITALIC CAPITAL U",
-	"𝑉": "MATHEMATICAL ITALIC CAPITAL V",
-	"𝑊": "MATHEMATICAL ITALIC CAPITAL W",
-	"𝑋": "MATHEMATICAL ITALIC CAPITAL X",
-	"𝑌": "MATHEMATICAL ITALIC CAPITAL Y",
-	"𝑍": "MATHEMATICAL ITALIC CAPITAL Z",
-	"𝑎": "MATHEMATICAL ITALIC SMALL A",
-	"𝑏": "MATHEMATICAL ITALIC SMALL B",
-	"𝑐": "MATHEMATICAL ITALIC SMALL C",
-	"𝑑": "MATHEMATICAL ITALIC SMALL D",
-	"𝑒": "MATHEMATICAL ITALIC SMALL E",
-	"𝑓": "MATHEMATICAL ITALIC SMALL F",
-	"𝑔": "MATHEMATICAL ITALIC SMALL G",
-	"𝑖": "MATHEMATICAL ITALIC SMALL I",
-	"𝑗": "MATHEMATICAL ITALIC SMALL J",
-	"𝑘": "MATHEMATICAL ITALIC SMALL K",
-	"𝑙": "MATHEMATICAL ITALIC SMALL L",
-	"𝑚": "MATHEMATICAL ITALIC SMALL M",
-	"𝑛": "MATHEMATICAL ITALIC SMALL N",
-	"𝑜": "MATHEMATICAL ITALIC SMALL O",
-	"𝑝": "MATHEMATICAL ITALIC SMALL P",
-	"𝑞": "MATHEMATICAL ITALIC SMALL Q",
-	"𝑟": "MATHEMATICAL ITALIC SMALL R",
-	"𝑠": "MATHEMATICAL ITALIC SMALL S",
-	"𝑡": "MATHEMATICAL ITALIC SMALL T",
-	"𝑢": "MATHEMATICAL ITALIC SMALL U",
-	"𝑣": "MATHEMATICAL ITALIC SMALL V",
-	"𝑤": "MATHEMATICAL ITALIC SMALL W",
-	"𝑥": "MATHEMATICAL ITALIC SMALL X",
-	"𝑦": "MATHEMATICAL ITALIC SMALL Y",
-	"𝑧": "MATHEMATICAL ITALIC SMALL Z",
-	"𝑨": "MATHEMATICAL BOLD ITALIC CAPITAL A",
-	"𝑩": "MATHEMATICAL BOLD ITALIC CAPITAL B",
-	"𝑪": "MATHEMATICAL BOLD ITALIC CAPITAL C",
-	"𝑫": "MATHEMATICAL BOLD ITALIC CAPITAL D",
-	"𝑬": "MATHEMATICAL BOLD ITALIC CAPITAL E",
-	"𝑭": "MATHEMATICAL BOLD ITALIC CAPITAL F",
-	"𝑮": "MATHEMATICAL BOLD ITALIC CAPITAL G",
-	"𝑯": "MATHEMATICAL BOLD ITALIC CAPITAL H",
-	"𝑰": "MATHEMATICAL BOLD ITALIC CAPITAL I",
-	"𝑱": "MATHEMATICAL BOLD ITALIC CAPITAL J",
-	"𝑲": "MATHEMATICAL BOLD ITALIC CAPITAL K",
-	"𝑳": "MATHEMATICAL BOLD ITALIC CAPITAL L",
-	"𝑴": "MATHEMATICAL BOLD ITALIC CAPITAL M",
-	"𝑵": "MATHEMATICAL BOLD ITALIC CAPITAL N",
-	"𝑶": "MATHEMATICAL BOLD ITALIC CAPITAL O",
-	"𝑷": "MATHEMATICAL BOLD ITALIC CAPITAL P",
-	"𝑸": "MATHEMATICAL BOLD ITALIC CAPITAL Q",
-	"𝑹": "MATHEMATICAL BOLD ITALIC CAPITAL R",
-	"𝑺": "MATHEMATICAL BOLD ITALIC CAPITAL S",
-	"𝑻": "MATHEMATICAL BOLD ITALIC CAPITAL T",
-	"𝑼": "MATHEMATICAL BOLD ITALIC CAPITAL U",
-	"𝑽": "MATHEMATICAL BOLD ITALIC CAPITAL V",
-	"𝑾": "MATHEMATICAL BOLD ITALIC CAPITAL W",
-	"𝑿": "MATHEMATICAL BOLD ITALIC CAPITAL X",
-	"𝒀": "MATHEMATICAL BOLD ITALIC CAPITAL Y",
-	"𝒁": "MATHEMATICAL BOLD ITALIC CAPITAL Z",
-	"𝒂": "MATHEMATICAL BOLD ITALIC SMALL A",
-	"𝒃": "MATHEMATICAL BOLD ITALIC SMALL B",
-	"𝒄": "MATHEMATICAL BOLD ITALIC SMALL C",
-	"𝒅": "MATHEMATICAL BOLD ITALIC SMALL D",
-	"𝒆": "MATHEMATICAL BOLD ITALIC SMALL E",
-	"𝒇": "MATHEMATICAL BOLD ITALIC SMALL F",
-	"𝒈": "MATHEMATICAL BOLD ITALIC SMALL G",
-	"𝒉": "MATHEMATICAL BOLD ITALIC SMALL H",
-	"𝒊": "MATHEMATICAL BOLD ITALIC SMALL I",
-	"𝒋": "MATHEMATICAL BOLD ITALIC SMALL J",
-	"𝒌": "MATHEMATICAL BOLD ITALIC SMALL K",
-	"𝒍": "MATHEMATICAL BOLD ITALIC SMALL L",
-	"𝒎": "MATHEMATICAL BOLD ITALIC SMALL M",
-	"𝒏": "MATHEMATICAL BOLD ITALIC SMALL N",
-	"𝒐": "MATHEMATICAL BOLD ITALIC SMALL O",
-	"𝒑": "MATHEMATICAL BOLD ITALIC SMALL P",
-	"𝒒": "MATHEMATICAL BOLD ITALIC SMALL Q",
-	"𝒓": "MATHEMATICAL BOLD ITALIC SMALL R",
-	"𝒔": "MATHEMATICAL BOLD ITALIC SMALL S",
-	"𝒕": "MATHEMATICAL BOLD ITALIC SMALL T",
-	"𝒖": "MATHEMATICAL BOLD ITALIC SMALL U",
-	"𝒗": "MATHEMATICAL BOLD ITALIC SMALL V",
-	"𝒘": "MATHEMATICAL BOLD ITALIC SMALL W",
-	"𝒙": "MATHEMATICAL BOLD ITALIC SMALL X",
-	"𝒚": "MATHEMATICAL BOLD ITALIC SMALL Y",
-	"𝒛": "MATHEMATICAL BOLD ITALIC SMALL Z",
-	"𝒜": "MATHEMATICAL SCRIPT CAPITAL A",
-	"𝒞": "MATHEMATICAL SCRIPT CAPITAL C",
-	"𝒟": "MATHEMATICAL SCRIPT CAPITAL D",
-	"𝒢": "MATHEMATICAL SCRIPT CAPITAL G",
-	"𝒥": "MATHEMATICAL SCRIPT CAPITAL J",
-	"𝒦": "MATHEMATICAL SCRIPT CAPITAL K",
-	"𝒩": "MATHEMATICAL SCRIPT CAPITAL N",
-	"𝒪": "MATHEMATICAL SCRIPT CAPITAL O",
-	"𝒫": "MATHEMATICAL SCRIPT CAPITAL P",
-	"𝒬": "MATHEMATICAL SCRIPT CAPITAL Q",
-	"𝒮": "MATHEMATICAL SCRIPT CAPITAL S",
-	"𝒯": "MATHEMATICAL SCRIPT CAPITAL T",
-	"𝒰": "MATHEMATICAL SCRIPT CAPITAL U",
-	"𝒱": "MATHEMATICAL SCRIPT CAPITAL V",
-	"𝒲": "MATHEMATICAL SCRIPT CAPITAL W",
-	"𝒳": "MATHEMATICAL SCRIPT CAPITAL X",
-	"𝒴": "MATHEMATICAL SCRIPT CAPITAL Y",
-	"𝒵": "MATHEMATICAL SCRIPT CAPITAL Z",
-	"𝒶": "MATHEMATICAL SCRIPT SMALL A",
-	"𝒷": "MATHEMATICAL SCRIPT SMALL B",
-	"𝒸": "MATHEMATICAL SCRIPT SMALL C",
-	"𝒹": "MATHEMATICAL SCRIPT SMALL D",
-	"𝒻": "MATHEMATICAL SCRIPT SMALL F",
-	"𝒽": "MATHEMATICAL SCRIPT SMALL H",
-	"𝒾": "MATHEMATICAL SCRIPT SMALL I",
-	"𝒿": "MATHEMATICAL SCRIPT SMALL J",
-	"𝓀": "MATHEMATICAL SCRIPT SMALL K",
-	"𝓁": "MATHEMATICAL SCRIPT SMALL L",
-	"𝓂": "MATHEMATICAL SCRIPT SMALL M",
-	"𝓃": "MATHEMATICAL SCRIPT SMALL N",
-	"𝓅": "MATHEMATICAL SCRIPT SMALL P",
-	"𝓆": "MATHEMATICAL SCRIPT SMALL Q",
-	"𝓇": "MATHEMATICAL SCRIPT SMALL R",
-	"𝓈": "MATHEMATICAL SCRIPT SMALL S",
-	"𝓉": "MATHEMATICAL SCRIPT SMALL T",
-	"𝓊": "MATHEMATICAL SCRIPT SMALL U",
-	"𝓋": "MATHEMATICAL SCRIPT SMALL V",
-	"𝓌": "MATHEMATICAL SCRIPT SMALL W",
-	"𝓍": "MATHEMATICAL SCRIPT SMALL X",
-	"𝓎": "MATHEMATICAL SCRIPT SMALL Y",
-	"𝓏": "MATHEMATICAL SCRIPT SMALL Z",
-	"𝓐": "MATHEMATICAL BOLD SCRIPT CAPITAL A",
-	"𝓑": "MATHEMATICAL BOLD SCRIPT CAPITAL B",
-	"𝓒": "MATHEMATICAL BOLD SCRIPT CAPITAL C",
-	"𝓓": "MATHEMATICAL BOLD SCRIPT CAPITAL D",
-	"𝓔": "MATHEMATICAL BOLD SCRIPT CAPITAL E",
-	"𝓕": "MATHEMATICAL BOLD SCRIPT CAPITAL F",
-	"𝓖": "MATHEMATICAL BOLD SCRIPT CAPITAL G",
-	"𝓗": "MATHEMATICAL BOLD SCRIPT CAPITAL H",
-	"𝓘": "MATHEMATICAL BOLD SCRIPT CAPITAL I",
-	"𝓙": "MATHEMATICAL BOLD SCRIPT CAPITAL J",
-	"𝓚": "MATHEMATICAL BOLD SCRIPT CAPITAL K",
-	"𝓛": "MATHEMATICAL BOLD SCRIPT CAPITAL L",
-	"𝓜": "MATHEMATICAL BOLD SCRIPT CAPITAL M",
-	"𝓝": "MATHEMATICAL BOLD SCRIPT CAPITAL N",
-	"𝓞": "MATHEMATICAL BOLD SCRIPT CAPITAL O",
-	"𝓟": "MATHEMATICAL BOLD SCRIPT CAPITAL P",
-	"𝓠": "MATHEMATICAL BOLD SCRIPT CAPITAL Q",
-	"𝓡": "MATHEMATICAL BOLD SCRIPT CAPITAL R",
-	"𝓢": "MATHEMATICAL BOLD SCRIPT CAPITAL S",
-	"𝓣": "MATHEMATICAL BOLD SCRIPT CAPITAL T",
-	"𝓤": "MATHEMATICAL BOLD SCRIPT CAPITAL U",
-	"𝓥": "MATHEMATICAL BOLD SCRIPT CAPITAL V",
-	"𝓦": "MATHEMATICAL BOLD SCRIPT CAPITAL W",
-	"𝓧": "MATHEMATICAL BOLD SCRIPT CAPITAL X",
-	"𝓨": "MATHEMATICAL BOLD SCRIPT CAPITAL Y",
-	"𝓩": "MATHEMATICAL BOLD SCRIPT CAPITAL Z",
-	"𝓪": "MATHEMATICAL BOLD SCRIPT SMALL A",
-	"𝓫": "MATHEMATICAL BOLD SCRIPT SMALL B",
-	"𝓬": "MATHEMATICAL BOLD SCRIPT SMALL C",
-	"𝓭": "MATHEMATICAL BOLD SCRIPT SMALL D",
-	"𝓮": "MATHEMATICAL BOLD SCRIPT SMALL E",
-	"𝓯": "MATHEMATICAL BOLD SCRIPT SMALL F",
-	"𝓰": "MATHEMATICAL BOLD SCRIPT SMALL G",
-	"𝓱": "MATHEMATICAL BOLD SCRIPT SMALL H",
-	"𝓲": "MATHEMATICAL BOLD SCRIPT SMALL I",
-	"𝓳": "MATHEMATICAL BOLD SCRIPT SMALL J",
-	"𝓴": "MATHEMATICAL BOLD SCRIPT SMALL K",
-	"𝓵": "MATHEMATICAL BOLD SCRIPT SMALL L",
-	"𝓶": "MATHEMATICAL BOLD SCRIPT SMALL M",
-	"𝓷": "MATHEMATICAL BOLD SCRIPT SMALL N",
-	"𝓸": "MATHEMATICAL BOLD SCRIPT SMALL O",
-	"𝓹": "MATHEMATICAL BOLD SCRIPT SMALL P",
-	"𝓺": "MATHEMATICAL BOLD SCRIPT SMALL Q",
-	"𝓻": "MATHEMATICAL BOLD SCRIPT SMALL R",
-	"𝓼": "MATHEMATICAL BOLD SCRIPT SMALL S",
-	"𝓽": "MATHEMATICAL BOLD SCRIPT SMALL T",
-	"𝓾": "MATHEMATICAL BOLD SCRIPT SMALL U",
-	"𝓿": "MATHEMATICAL BOLD SCRIPT SMALL V",
-	"𝔀": "MATHEMATICAL BOLD SCRIPT SMALL W",
-	"𝔁": "MATHEMATICAL BOLD SCRIPT SMALL X",
-	"𝔂": "MATHEMATICAL BOLD SCRIPT SMALL Y",
-	"𝔃": "MATHEMATICAL BOLD SCRIPT SMALL Z",
-	"𝔄": "MATHEMATICAL FRAKTUR CAPITAL A",
-	"𝔅": "MATHEMATICAL FRAKTUR CAPITAL B",
-	"𝔇": "MATHEMATICAL FRAKTUR CAPITAL D",
-	"𝔈": "MATHEMATICAL FRAKTUR CAPITAL E",
-	"𝔉": "MATHEMATICAL FRAKTUR CAPITAL F",
-	"𝔊": "MATHEMATICAL FRAKTUR CAPITAL G",
-	"𝔍": "MATHEMATICAL FRAKTUR CAPITAL J",
-	"𝔎": "MATHEMATICAL FRAKTUR CAPITAL K",
-	"𝔏": "MATHEMATICAL FRAKTUR CAPITAL L",
-	"𝔐": "MATHEMATICAL FRAKTUR CAPITAL M",
-	"𝔑": "MATHEMATICAL FRAKTUR CAPITAL N",
-	"𝔒": "MATHEMATICAL FRAKTUR CAPITAL O",
-	"𝔓": "MATHEMATICAL FRAKTUR CAPITAL P",
-	"𝔔": "MATHEMATICAL FRAKTUR CAPITAL Q",
-	"𝔖": "MATHEMATICAL FRAKTUR CAPITAL S",
-	"𝔗": "MATHEMATICAL FRAKTUR CAPITAL T",
-	"𝔘": "MATHEMATICAL FRAKTUR CAPITAL U",
-	"𝔙": "MATHEMATICAL FRAKTUR CAPITAL V",
-	"𝔚": "MATHEMATICAL FRAKTUR CAPITAL W",
-	"𝔛": "MATHEMATICAL FRAKTUR CAPITAL X",
-	"𝔜": "MATHEMATICAL FRAKTUR CAPITAL Y",
-	"𝔞": "MATHEMATICAL FRAKTUR SMALL A",
-	"𝔟": "MATHEMATICAL FRAKTUR SMALL B",
-	"𝔠": "MATHEMATICAL FRAKTUR SMALL C",
-	"𝔡": "MATHEMATICAL FRAKTUR SMALL D",
-	"𝔢": "MATHEMATICAL FRAKTUR SMALL E",
-	"𝔣": "MATHEMATICAL FRAKTUR SMALL F",
-	"𝔤": "MATHEMATICAL FRAKTUR SMALL G",
-	"𝔥": "MATHEMATICAL FRAKTUR SMALL H",
-	"𝔦": "MATHEMATICAL FRAKTUR SMALL I",
-	"𝔧": "MATHEMATICAL FRAKTUR SMALL J",
-	"𝔨": "MATHEMATICAL FRAKTUR SMALL K",
-	"𝔩": "MATHEMATICAL FRAKTUR SMALL L",
-	"𝔪": "MATHEMATICAL FRAKTUR SMALL M",
-	"𝔫": "MATHEMATICAL FRAKTUR SMALL N",
-	"𝔬": "MATHEMATICAL FRAKTUR SMALL O",
-	"𝔭": "MATHEMATICAL FRAKTUR SMALL P",
-	"𝔮": "MATHEMATICAL FRAKTUR SMALL Q",
-	"𝔯": "MATHEMATICAL FRAKTUR SMALL R",
-	"𝔰": "MATHEMATICAL FRAKTUR SMALL S",
-	"𝔱": "MATHEMATICAL FRAKTUR SMALL T",
-	"𝔲": "MATHEMATICAL FRAKTUR SMALL U",
-	"𝔳": "MATHEMATICAL FRAKTUR SMALL V",
-	"𝔴": "MATHEMATICAL FRAKTUR SMALL W",
-	"𝔵": "MATHEMATICAL FRAKTUR SMALL X",
-	"𝔶": "MATHEMATICAL FRAKTUR SMALL Y",
-	"𝔷": "MATHEMATICAL FRAKTUR SMALL Z",
-	"𝔸": "MATHEMATICAL DOUBLE-STRUCK CAPITAL A",
-	"𝔹": "MATHEMATICAL DOUBLE-STRUCK CAPITAL B",
-	"𝔻": "MATHEMATICAL DOUBLE-STRUCK CAPITAL D",
-	"𝔼": "MATHEMATICAL DOUBLE-STRUCK CAPITAL E",
-	"𝔽": "MATHEMATICAL DOUBLE-STRUCK CAPITAL F",
-	"𝔾": "MATHEMATICAL DOUBLE-STRUCK CAPITAL G",
-	"𝕀": "MATHEMATICAL DOUBLE-STRUCK CAPITAL I",
-	"𝕁": "MATHEMATICAL DOUBLE-STRUCK CAPITAL J",
-	"𝕂": "MATHEMATICAL DOUBLE-STRUCK CAPITAL K",
-	"𝕃": "MATHEMATICAL DOUBLE-STRUCK CAPITAL L",
-	"𝕄": "MATHEMATICAL DOUBLE-STRUCK CAPITAL M",
-	"𝕆": "MATHEMATICAL DOUBLE-STRUCK CAPITAL O",
-	"𝕊": "MATHEMATICAL DOUBLE-STRUCK CAPITAL S",
-	"𝕋": "MATHEMATICAL DOUBLE-STRUCK CAPITAL T",
-	"𝕌": "MATHEMATICAL DOUBLE-STRUCK CAPITAL U",
-	"𝕍": "MATHEMATICAL DOUBLE-STRUCK CAPITAL V",
-	"𝕎": "MATHEMATICAL DOUBLE-STRUCK CAPITAL W",
-	"𝕏": "MATHEMATICAL DOUBLE-STRUCK CAPITAL X",
-	"𝕐": "MATHEMATICAL DOUBLE-STRUCK CAPITAL Y",
-	"𝕒": "MATHEMATICAL DOUBLE-STRUCK SMALL A",
-	"𝕓": "MATHEMATICAL DOUBLE-STRUCK SMALL B",
-	"𝕔": "MATHEMATICAL DOUBLE-STRUCK SMALL C",
-	"𝕕": "MATHEMATICAL DOUBLE-STRUCK SMALL D",
-	"𝕖": "MATHEMATICAL DOUBLE-STRUCK SMALL E",
-	"𝕗": "MATHEMATICAL DOUBLE-STRUCK SMALL F",
-	"𝕘": "MATHEMATICAL DOUBLE-STRUCK SMALL G",
-	"𝕙": "MATHEMATICAL DOUBLE-STRUCK SMALL H",
-	"𝕚": "MATHEMATICAL DOUBLE-STRUCK SMALL I",
-	"𝕛": "MATHEMATICAL DOUBLE-STRUCK SMALL J",
-	"𝕜": "MATHEMATICAL DOUBLE-STRUCK SMALL K",
-	"𝕝": "MATHEMATICAL DOUBLE-STRUCK SMALL L",
-	"𝕞": "MATHEMATICAL DOUBLE-STRUCK SMALL M",
-	"𝕟": "MATHEMATICAL DOUBLE-STRUCK SMALL N",
-	"𝕠": "MATHEMATICAL DOUBLE-STRUCK SMALL O",
-	"𝕡": "MATHEMATICAL DOUBLE-STRUCK SMALL P",
-	"𝕢": "MATHEMATICAL DOUBLE-STRUCK SMALL Q",
-	"𝕣": "MATHEMATICAL DOUBLE-STRUCK SMALL R",
-	"𝕤": "MATHEMATICAL DOUBLE-STRUCK SMALL S",
-	"𝕥": "MATHEMATICAL DOUBLE-STRUCK SMALL T",
-	"𝕦": "MATHEMATICAL DOUBLE-STRUCK SMALL U",
-	"𝕧": "MATHEMATICAL DOUBLE-STRUCK SMALL V",
-	"𝕨": "MATHEMATICAL DOUBLE-STRUCK SMALL W",
-	"𝕩": "MATHEMATICAL DOUBLE-STRUCK SMALL X",
-	"𝕪": "MATHEMATICAL DOUBLE-STRUCK SMALL Y",
-	"𝕫": "MATHEMATICAL DOUBLE-STRUCK SMALL Z",
-	"𝕬": "MATHEMATICAL BOLD FRAKTUR CAPITAL A",
-	"𝕭": "MATHEMATICAL BOLD FRAKTUR CAPITAL B",
-	"𝕮": "MATHEMATICAL BOLD FRAKTUR CAPITAL C",
-	"𝕯": "MATHEMATICAL BOLD FRAKTUR CAPITAL D",
-	"𝕰": "MATHEMATICAL BOLD FRAKTUR CAPITAL E",
-	"𝕱": "MATHEMATICAL BOLD FRAKTUR CAPITAL F",
-	"𝕲": "MATHEMATICAL BOLD FRAKTUR CAPITAL G",
-	"𝕳": "MATHEMATICAL BOLD FRAKTUR CAPITAL H",
-	"𝕴": "MATHEMATICAL BOLD FRAKTUR CAPITAL I",
-	"𝕵": "MATHEMATICAL BOLD FRAKTUR CAPITAL J",
-	"𝕶": "MATHEMATICAL BOLD FRAKTUR CAPITAL K",
-	"𝕷": "MATHEMATICAL BOLD FRAKTUR CAPITAL L",
-	"𝕸": "MATHEMATICAL BOLD FRAKTUR CAPITAL M",
-	"𝕹": "MATHEMATICAL BOLD FRAKTUR CAPITAL N",
-	"𝕺": "MATHEMATICAL BOLD FRAKTUR CAPITAL O",
-	"𝕻": "MATHEMATICAL BOLD FRAKTUR CAPITAL P",
-	"𝕼": "MATHEMATICAL BOLD FRAKTUR CAPITAL Q",
-	"𝕽": "MATHEMATICAL BOLD FRAKTUR CAPITAL R",
-	"𝕾": "MATHEMATICAL BOLD FRAKTUR CAPITAL S",
-	"𝕿": "MATHEMATICAL BOLD FRAKTUR CAPITAL T",
-	"𝖀": "MATHEMATICAL BOLD FRAKTUR CAPITAL U",
-	"𝖁": "MATHEMATICAL BOLD FRAKTUR CAPITAL V",
-	"𝖂": "MATHEMATICAL BOLD FRAKTUR CAPITAL W",
-	"𝖃": "MATHEMATICAL BOLD FRAKTUR CAPITAL X",
-	"𝖄": "MATHEMATICAL BOLD FRAKTUR CAPITAL Y",
-	"𝖅": "MATHEMATICAL BOLD FRAKTUR CAPITAL Z",
-	"𝖆": "MATHEMATICAL BOLD FRAKTUR SMALL A",
-	"𝖇": "MATHEMATICAL BOLD FRAKTUR SMALL B",
-	"𝖈": "MATHEMATICAL BOLD FRAKTUR SMALL C",
-	"𝖉": "MATHEMATICAL BOLD FRAKTUR SMALL D",
-	"𝖊": "MATHEMATICAL BOLD FRAKTUR SMALL E",
-	"𝖋": "MATHEMATICAL BOLD FRAKTUR SMALL F",
-	"𝖌": "MATHEMATICAL BOLD FRAKTUR SMALL G",
-	"𝖍": "MATHEMATICAL BOLD FRAKTUR SMALL H",
-	"𝖎": "MATHEMATICAL BOLD FRAKTUR SMALL I",
-	"𝖏": "MATHEMATICAL BOLD FRAKTUR SMALL J",
-	"𝖐": "MATHEMATICAL BOLD FRAKTUR SMALL K",
-	"𝖑": "MATHEMATICAL BOLD FRAKTUR SMALL L",
-	"𝖒": "MATHEMATICAL BOLD FRAKTUR SMALL M",
-	"𝖓": "MATHEMATICAL BOLD FRAKTUR SMALL N",
-	"𝖔": "MATHEMATICAL BOLD FRAKTUR SMALL O",
-	"𝖕": "MATHEMATICAL BOLD FRAKTUR SMALL P",
-	"𝖖": "MATHEMATICAL BOLD FRAKTUR SMALL Q",
-	"𝖗": "MATHEMATICAL BOLD FRAKTUR SMALL R",
-	"𝖘": "MATHEMATICAL BOLD FRAKTUR SMALL S",
-	"𝖙": "MATHEMATICAL BOLD FRAKTUR SMALL T",
-	"𝖚": "MATHEMATICAL BOLD FRAKTUR SMALL U",
-	"𝖛": "MATHEMATICAL BOLD FRAKTUR SMALL V",
-	"𝖜": "MATHEMATICAL BOLD FRAKTUR SMALL W",
-	"𝖝": "MATHEMATICAL BOLD FRAKTUR SMALL X",
-	"𝖞": "MATHEMATICAL BOLD FRAKTUR SMALL Y",
-	"𝖟": "MATHEMATICAL BOLD FRAKTUR SMALL Z",
-	"𝖠": "MATHEMATICAL SANS-SERIF CAPITAL A",
-	"𝖡": "MATHEMATICAL SANS-SERIF CAPITAL B",
-	"𝖢": "MATHEMATICAL SANS-SERIF CAPITAL C",
-	"𝖣": "MATHEMATICAL SANS-SERIF CAPITAL D",
-	"𝖤": "MATHEMATICAL SANS-SERIF CAPITAL E",
-	"𝖥": "MATHEMATICAL SANS-SERIF CAPITAL F",
-	"𝖦": "MATHEMATICAL SANS-SERIF CAPITAL G",
-	"𝖧": "MATHEMATICAL SANS-SERIF CAPITAL H",
-	"𝖨": "MATHEMATICAL SANS-SERIF CAPITAL I",
-	"𝖩": "MATHEMATICAL SANS-SERIF CAPITAL J",
-	"𝖪": "MATHEMATICAL SANS-SERIF CAPITAL K",
-	"𝖫": "MATHEMATICAL SANS-SERIF CAPITAL L",
-	"𝖬": "MATHEMATICAL SANS-SERIF CAPITAL M",
-	"𝖭": "MATHEMATICAL SANS-SERIF CAPITAL N",
-	"𝖮": "MATHEMATICAL SANS-SERIF CAPITAL O",
-	"𝖯": "MATHEMATICAL SANS-SERIF CAPITAL P",
-	"𝖰": "MATHEMATICAL SANS-SERIF CAPITAL Q",
-	"𝖱": "MATHEMATICAL SANS-SERIF CAPITAL R",
-	"𝖲": "MATHEMATICAL SANS-SERIF CAPITAL S",
-	"𝖳": "MATHEMATICAL SANS-SERIF CAPITAL T",
-	"𝖴": "MATHEMATICAL SANS-SERIF CAPITAL U",
-	"𝖵": "MATHEMATICAL SANS-SERIF CAPITAL V",
-	"𝖶": "MATHEMATICAL SANS-SERIF CAPITAL W",
-	"𝖷": "MATHEMATICAL SANS-SERIF CAPITAL X",
-	"𝖸": "MATHEMATICAL SANS-SERIF CAPITAL Y",
-	"𝖹": "MATHEMATICAL SANS-SERIF CAPITAL Z",
-	"𝖺": "MATHEMATICAL SANS-SERIF SMALL A",
-	"𝖻": "MATHEMATICAL SANS-SERIF SMALL B",
-	"𝖼": "MATHEMATICAL SANS-SERIF SMALL C",
-	"𝖽": "MATHEMATICAL SANS-SERIF SMALL D",
-	"𝖾": "MATHEMATICAL SANS-SERIF SMALL E",
-	"𝖿": "MATHEMATICAL SANS-SERIF SMALL F",
-	"𝗀": "MATHEMATICAL SANS-SERIF SMALL G",
-	"𝗁": "MATHEMATICAL SANS-SERIF SMALL H",
-	"𝗂": "MATHEMATICAL SANS-SERIF SMALL I",
-	"𝗃": "MATHEMATICAL SANS-SERIF SMALL J",
-	"𝗄": "MATHEMATICAL SANS-SERIF SMALL K",
-	"𝗅": "MATHEMATICAL SANS-SERIF SMALL L",
-	"𝗆": "MATHEMATICAL SANS-SERIF SMALL M",
-	"𝗇": "MATHEMATICAL SANS-SERIF SMALL N",
-	"𝗈": "MATHEMATICAL SANS-SERIF SMALL O",
-	"𝗉": "MATHEMATICAL SANS-SERIF SMALL P",
-	"𝗊": "MATHEMATICAL SANS-SERIF SMALL Q",
-	"𝗋": "MATHEMATICAL SANS-SERIF SMALL R",
-	"𝗌": "MATHEMATICAL SANS-SERIF SMALL S",
-	"𝗍": "MATHEMATICAL SANS-SERIF SMALL T",
-	"𝗎": "MATHEMATICAL SANS-SERIF SMALL U",
-	"𝗏": "MATHEMATICAL SANS-SERIF SMALL V",
-	"𝗐": "MATHEMATICAL SANS-SERIF SMALL W",
-	"𝗑": "MATHEMATICAL SANS-SERIF SMALL X",
-	"𝗒": "MATHEMATICAL SANS-SERIF SMALL Y",
-	"𝗓": "MATHEMATICAL SANS-SERIF SMALL Z",
-	"𝗔": "MATHEMATICAL SANS-SERIF BOLD CAPITAL A",
-	"𝗕": "MATHEMATICAL SANS-SERIF BOLD CAPITAL B",
-	"𝗖": "MATHEMATICAL SANS-SERIF BOLD CAPITAL C",
-	"𝗗": "MATHEMATICAL SANS-SERIF BOLD CAPITAL D",
-	"𝗘": "MATHEMATICAL SANS-SERIF BOLD CAPITAL E",
-	"𝗙": "MATHEMATICAL SANS-SERIF BOLD CAPITAL F",
-	"𝗚": "MATHEMATICAL SANS-SERIF BOLD CAPITAL G",
-	"𝗛": "MATHEMATICAL SANS-SERIF BOLD CAPITAL H",
-	"𝗜": "MATHEMATICAL SANS-SERIF BOLD CAPITAL I",
-	"𝗝": "MATHEMATICAL SANS-SERIF BOLD CAPITAL J",
-	"𝗞": "MATHEMATICAL SANS-SERIF BOLD CAPITAL K",
-	"𝗟": "MATHEMATICAL SANS-SERIF BOLD CAPITAL L",
-	"𝗠": "MATHEMATICAL SANS-SERIF BOLD CAPITAL M",
-	"𝗡": "MATHEMATICAL SANS-SERIF BOLD CAPITAL N",
-	"𝗢": "MATHEMATICAL SANS-SERIF BOLD CAPITAL O",
-	"𝗣": "MATHEMATICAL SANS-SERIF BOLD CAPITAL P",
-	"𝗤": "MATHEMATICAL SANS-SERIF BOLD CAPITAL Q",
-	"𝗥": "MATHEMATICAL SANS-SERIF BOLD CAPITAL R",
-	"𝗦": "MATHEMATICAL SANS-SERIF BOLD CAPITAL S",
-	"𝗧": "MATHEMATICAL SANS-SERIF BOLD CAPITAL T",
-	"𝗨": "MATHEMATICAL SANS-SERIF BOLD CAPITAL U",
-	"𝗩": "MATHEMATICAL SANS-SERIF BOLD CAPITAL V",
-	"𝗪": "MATHEMATICAL SANS-SERIF BOLD CAPITAL W",
-	"𝗫": "MATHEMATICAL SANS-SERIF BOLD CAPITAL X",
-	"𝗬": "MATHEMATICAL SANS-SERIF BOLD CAPITAL Y",
-	"𝗭": "MATHEMATICAL SANS-SERIF BOLD CAPITAL Z",
-	"𝗮": "MATHEMATICAL SANS-SERIF BOLD SMALL A",
-	"𝗯": "MATHEMATICAL SANS-SERIF BOLD SMALL B",
-	"𝗰": "MATHEMATICAL SANS-SERIF BOLD SMALL C",
-	"𝗱": "MATHEMATICAL SANS-SERIF BOLD SMALL D",
-	"𝗲": "MATHEMATICAL SANS-SERIF BOLD SMALL E",
-	"𝗳": "MATHEMATICAL SANS-SERIF BOLD SMALL F",
-	"𝗴": "MATHEMATICAL SANS-SERIF BOLD SMALL G",
-	"𝗵": "MATHEMATICAL SANS-SERIF BOLD SMALL H",
-	"𝗶": "MATHEMATICAL SANS-SERIF BOLD SMALL I",
-	"𝗷": "MATHEMATICAL SANS-SERIF BOLD SMALL J",
-	"𝗸": "MATHEMATICAL SANS-SERIF BOLD SMALL K",
-	"𝗹": "MATHEMATICAL SANS-SERIF BOLD SMALL L",
-	"𝗺": "MATHEMATICAL SANS-SERIF BOLD SMALL M",
-	"𝗻": "MATHEMATICAL SANS-SERIF BOLD SMALL N",
-	"𝗼": "MATHEMATICAL SANS-SERIF BOLD SMALL O",
-	"𝗽": "MATHEMATICAL SANS-SERIF BOLD SMALL P",
-	"𝗾": "MATHEMATICAL SANS-SERIF BOLD SMALL Q",
-	"𝗿": "MATHEMATICAL SANS-SERIF BOLD SMALL R",
-	"𝘀": "MATHEMATICAL SANS-SERIF BOLD SMALL S",
-	"𝘁": "MATHEMATICAL SANS-SERIF BOLD SMALL T",
-	"𝘂": "MATHEMATICAL SANS-SERIF BOLD SMALL U",
-	"𝘃": "MATHEMATICAL SANS-SERIF BOLD SMALL V",
-	"𝘄": "MATHEMATICAL SANS-SERIF BOLD SMALL W",
-	"𝘅": "MATHEMATICAL SANS-SERIF BOLD SMALL X",
-	"𝘆": "MATHEMATICAL SANS-SERIF BOLD SMALL Y",
-	"𝘇": "MATHEMATICAL SANS-SERIF BOLD SMALL Z",
-	"𝘈": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL A",
-	"𝘉": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL B",
-	"𝘊": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL C",
-	"𝘋": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL D",
-	"𝘌": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL E",
-	"𝘍": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL F",
-	"𝘎": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL G",
-	"𝘏": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL H",
-	"𝘐": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL I",
-	"𝘑": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL J",
-	"𝘒": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL K",
-	"𝘓": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL L",
-	"𝘔": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL M",
-	"𝘕": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL N",
-	"𝘖": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL O",
-	"𝘗": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL P",
-	"𝘘": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL Q",
-	"𝘙": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL R",
-	"𝘚": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL S",
-	"𝘛": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL T",
-	"𝘜": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL U",
-	"𝘝": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL V",
-	"𝘞": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL W",
-	"𝘟": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL X",
-	"𝘠": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL Y",
-	"𝘡": "MATHEMATICAL SANS-SERIF ITALIC CAPITAL Z",
-	"𝘢": "MATHEMATICAL SANS-SERIF ITALIC SMALL A",
-	"𝘣": "MATHEMATICAL SANS-SERIF ITALIC SMALL B",
-	"𝘤": "MATHEMATICAL SANS-SERIF ITALIC SMALL C",
-	"𝘥": "MATHEMATICAL SANS-SERIF ITALIC SMALL D",
-	"𝘦": "MATHEMATICAL SANS-SERIF ITALIC SMALL E",
-	"𝘧": "MATHEMATICAL SANS-SERIF ITALIC SMALL F",
-	"𝘨": "MATHEMATICAL SANS-SERIF ITALIC SMALL G",
-	"𝘩": "MATHEMATICAL SANS-SERIF ITALIC SMALL H",
-	"𝘪": "MATHEMATICAL SANS-SERIF ITALIC SMALL I",
-	"𝘫": "MATHEMATICAL SANS-SERIF ITALIC SMALL J",
-	"𝘬": "MATHEMATICAL SANS-SERIF ITALIC SMALL K",
-	"𝘭": "MATHEMATICAL SANS-SERIF ITALIC SMALL L",
-	"𝘮": "MATHEMATICAL SANS-SERIF ITALIC SMALL M",
-	"𝘯": "MATHEMATICAL SANS-SERIF ITALIC SMALL N",
-	"𝘰": "MATHEMATICAL SANS-SERIF ITALIC SMALL O",
-	"𝘱": "MATHEMATICAL SANS-SERIF ITALIC SMALL P",
-	"𝘲": "MATHEMATICAL SANS-SERIF ITALIC SMALL Q",
-	"𝘳": "MATHEMATICAL SANS-SERIF ITALIC SMALL R",
-	"𝘴": "MATHEMATICAL SANS-SERIF ITALIC SMALL S",
-	"𝘵": "MATHEMATICAL SANS-SERIF ITALIC SMALL T",
-	"𝘶": "MATHEMATICAL SANS-SERIF ITALIC SMALL U",
-	"𝘷": "MATHEMATICAL SANS-SERIF ITALIC SMALL V",
-	"𝘸": "MATHEMATICAL SANS-SERIF ITALIC SMALL W",
-	"𝘹": "MATHEMATICAL SANS-SERIF ITALIC SMALL X",
-	"𝘺": "MATHEMATICAL SANS-SERIF ITALIC SMALL Y",
-	"𝘻": "MATHEMATICAL SANS-SERIF ITALIC SMALL Z",
-	"𝘼": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL A",
-	"𝘽": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL B",
-	"𝘾": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL C",
-	"𝘿": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL D",
-	"𝙀": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL E",
-	"𝙁": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL F",
-	"𝙂": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL G",
-	"𝙃": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL H",
-	"𝙄": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL I",
-	"𝙅": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL J",
-	"𝙆": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL K",
-	"𝙇": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL L",
-	"𝙈": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL M",
-	"𝙉": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL N",
-	"𝙊": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL O",
-	"𝙋": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL P",
-	"𝙌": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Q",
-	"𝙍": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL R",
-	"𝙎": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL S",
-	"𝙏": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL T",
-	"𝙐": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL U",
-	"𝙑": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL V",
-	"𝙒": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL W",
-	"𝙓": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL X",
-	"𝙔": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Y",
-	"𝙕": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL Z",
-	"𝙖": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL A",
-	"𝙗": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL B",
-	"𝙘": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL C",
-	"𝙙": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL D",
-	"𝙚": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL E",
-	"𝙛": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL F",
-	"𝙜": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL G",
-	"𝙝": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL H",
-	"𝙞": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL I",
-	"𝙟": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL J",
-	"𝙠": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL K",
-	"𝙡": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL L",
-	"𝙢": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL M",
-	"𝙣": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL N",
-	"𝙤": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL O",
-	"𝙥": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL P",
-	"𝙦": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Q",
-	"𝙧": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL R",
-	"𝙨": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL S",
-	"𝙩": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL T",
-	"𝙪": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL U",
-	"𝙫": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL V",
-	"𝙬": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL W",
-	"𝙭": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL X",
-	"𝙮": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Y",
-	"𝙯": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL Z",
-	"𝙰": "MATHEMATICAL MONOSPACE CAPITAL A",
-	"𝙱": "MATHEMATICAL MONOSPACE CAPITAL B",
-	"𝙲": "MATHEMATICAL MONOSPACE CAPITAL C",
-	"𝙳": "MATHEMATICAL MONOSPACE CAPITAL D",
-	"𝙴": "MATHEMATICAL MONOSPACE CAPITAL E",
-	"𝙵": "MATHEMATICAL MONOSPACE CAPITAL F",
-	"𝙶": "MATHEMATICAL MONOSPACE CAPITAL G",
-	"𝙷": "MATHEMATICAL MONOSPACE CAPITAL H",
-	"𝙸": "MATHEMATICAL MONOSPACE CAPITAL I",
-	"𝙹": "MATHEMATICAL MONOSPACE CAPITAL J",
-	"𝙺": "MATHEMATICAL MONOSPACE CAPITAL K",
-	"𝙻": "MATHEMATICAL MONOSPACE CAPITAL L",
-	"𝙼": "MATHEMATICAL MONOSPACE CAPITAL M",
-	"𝙽": "MATHEMATICAL MONOSPACE CAPITAL N",
-	"𝙾": "MATHEMATICAL MONOSPACE CAPITAL O",
-	"𝙿": "MATHEMATICAL MONOSPACE CAPITAL P",
-	"𝚀": "MATHEMATICAL MONOSPACE CAPITAL Q",
-	"𝚁": "MATHEMATICAL MONOSPACE CAPITAL R",
-	"𝚂": "MATHEMATICAL MONOSPACE CAPITAL S",
-	"𝚃": "MATHEMATICAL MONOSPACE CAPITAL T",
-	"𝚄": "MATHEMATICAL MONOSPACE CAPITAL U",
-	"𝚅": "MATHEMATICAL MONOSPACE CAPITAL V",
-	"𝚆": "MATHEMATICAL MONOSPACE CAPITAL W",
-	"𝚇": "MATHEMATICAL MONOSPACE CAPITAL X",
-	"𝚈": "MATHEMATICAL MONOSPACE CAPITAL Y",
-	"𝚉": "MATHEMATICAL MONOSPACE CAPITAL Z",
-	"𝚊": "MATHEMATICAL MONOSPACE SMALL A",
-	"𝚋": "MATHEMATICAL MONOSPACE SMALL B",
-	"𝚌": "MATHEMATICAL MONOSPACE SMALL C",
-	"𝚍": "MATHEMATICAL MONOSPACE SMALL D",
-	"𝚎": "MATHEMATICAL MONOSPACE SMALL E",
-	"𝚏": "MATHEMATICAL MONOSPACE SMALL F",
-	"𝚐": "MATHEMATICAL MONOSPACE SMALL G",
-	"𝚑": "MATHEMATICAL MONOSPACE SMALL H",
-	"𝚒": "MATHEMATICAL MONOSPACE SMALL I",
-	"𝚓": "MATHEMATICAL MONOSPACE SMALL J",
-	"𝚔": "MATHEMATICAL MONOSPACE SMALL K",
-	"𝚕": "MATHEMATICAL MONOSPACE SMALL L",
-	"𝚖": "MATHEMATICAL MONOSPACE SMALL M",
-	"𝚗": "MATHEMATICAL MONOSPACE SMALL N",
-	"𝚘": "MATHEMATICAL MONOSPACE SMALL O",
-	"𝚙": "MATHEMATICAL MONOSPACE SMALL P",
-	"𝚚": "MATHEMATICAL MONOSPACE SMALL Q",
-	"𝚛": "MATHEMATICAL MONOSPACE SMALL R",
-	"𝚜": "MATHEMATICAL MONOSPACE SMALL S",
-	"𝚝": "MATHEMATICAL MONOSPACE SMALL T",
-	"𝚞": "MATHEMATICAL MONOSPACE SMALL U",
-	"𝚟": "MATHEMATICAL MONOSPACE SMALL V",
-	"𝚠": "MATHEMATICAL MONOSPACE SMALL W",
-	"𝚡": "MATHEMATICAL MONOSPACE SMALL X",
-	"𝚢": "MATHEMATICAL MONOSPACE SMALL Y",
-	"𝚣": "MATHEMATICAL MONOSPACE SMALL Z",
-	"𝚤": "MATHEMATICAL ITALIC SMALL DOTLESS I",
-	"𝚥": "MATHEMATICAL ITALIC SMALL DOTLESS J",
-	"𝚨": "MATHEMATICAL BOLD CAPITAL ALPHA",
-	"𝚩": "MATHEMATICAL BOLD CAPITAL BETA",
-	"𝚪": "MATHEMATICAL BOLD CAPITAL GAMMA",
-	"𝚫": "MATHEMATICAL BOLD CAPITAL DELTA",
-	"𝚬": "MATHEMATICAL BOLD CAPITAL EPSILON",
-	"𝚭": "MATHEMATICAL BOLD CAPITAL ZETA",
-	"𝚮": "MATHEMATICAL BOLD CAPITAL ETA",
-	"𝚯": "MATHEMATICAL BOLD CAPITAL THETA",
-	"𝚰": "MATHEMATICAL BOLD CAPITAL IOTA",
-	"𝚱": "MATHEMATICAL BOLD CAPITAL KAPPA",
-	"𝚲": "MATHEMATICAL BOLD CAPITAL LAMDA",
-	"𝚳": "MATHEMATICAL BOLD CAPITAL MU",
-	"𝚴": "MATHEMATICAL BOLD CAPITAL NU",
-	"𝚵": "MATHEMATICAL BOLD CAPITAL XI",
-	"𝚶": "MATHEMATICAL BOLD CAPITAL OMICRON",
-	"𝚷": "MATHEMATICAL BOLD CAPITAL PI",
-	"𝚸": "MATHEMATICAL BOLD CAPITAL RHO",
-	"𝚹": "MATHEMATICAL BOLD CAPITAL THETA SYMBOL",
-	"𝚺": "MATHEMATICAL BOLD CAPITAL SIGMA",
-	"𝚻": "MATHEMATICAL BOLD CAPITAL TAU",
-	"𝚼": "MATHEMATICAL BOLD CAPITAL UPSILON",
-	"𝚽": "MATHEMATICAL BOLD CAPITAL PHI",
-	"𝚾": "MATHEMATICAL BOLD CAPITAL CHI",
-	"𝚿": "MATHEMATICAL BOLD CAPITAL PSI",
-	"𝛀": "MATHEMATICAL BOLD CAPITAL OMEGA",
-	"𝛁": "MATHEMATICAL BOLD NABLA",
-	"𝛂": "MATHEMATICAL BOLD SMALL ALPHA",
-	"𝛃": "MATHEMATICAL BOLD SMALL BETA",
-	"𝛄": "MATHEMATICAL BOLD SMALL GAMMA",
-	"𝛅": "MATHEMATICAL BOLD SMALL DELTA",
-	"𝛆": "MATHEMATICAL BOLD SMALL EPSILON",
-	"𝛇": "MATHEMATICAL BOLD SMALL ZETA",
-	"𝛈": "MATHEMATICAL BOLD SMALL ETA",
-	"𝛉": "MATHEMATICAL BOLD SMALL THETA",
-	"𝛊": "MATHEMATICAL BOLD SMALL IOTA",
-	"𝛋": "MATHEMATICAL BOLD SMALL KAPPA",
-	"𝛌": "MATHEMATICAL BOLD SMALL LAMDA",
-	"𝛍": "MATHEMATICAL BOLD SMALL MU",
-	"𝛎": "MATHEMATICAL BOLD SMALL NU",
-	"𝛏": "MATHEMATICAL BOLD SMALL XI",
-	"𝛐": "MATHEMATICAL BOLD SMALL OMICRON",
-	"𝛑": "MATHEMATICAL BOLD SMALL PI",
-	"𝛒": "MATHEMATICAL BOLD SMALL RHO",
-	"𝛓": "MATHEMATICAL BOLD SMALL FINAL SIGMA",
-	"𝛔": "MATHEMATICAL BOLD SMALL SIGMA",
-	"𝛕": "MATHEMATICAL BOLD SMALL TAU",
-	"𝛖": "MATHEMATICAL BOLD SMALL UPSILON",
-	"𝛗": "MATHEMATICAL BOLD SMALL PHI",
-	"𝛘": "MATHEMATICAL BOLD SMALL CHI",
-	"𝛙": "MATHEMATICAL BOLD SMALL PSI",
-	"𝛚": "MATHEMATICAL BOLD SMALL OMEGA",
-	"𝛛": "MATHEMATICAL BOLD PARTIAL DIFFERENTIAL",
-	"𝛜": "MATHEMATICAL BOLD EPSILON SYMBOL",
-	"𝛝": "MATHEMATICAL BOLD THETA SYMBOL",
-	"𝛞": "MATHEMATICAL BOLD KAPPA SYMBOL",
-	"𝛟": "MATHEMATICAL BOLD PHI SYMBOL",
-	"𝛠": "MATHEMATICAL BOLD RHO SYMBOL",
-	"𝛡": "MATHEMATICAL BOLD PI SYMBOL",
-	"𝛢": "MATHEMATICAL ITALIC CAPITAL ALPHA",
-	"𝛣": "MATHEMATICAL ITALIC CAPITAL BETA",
-	"𝛤": "MATHEMATICAL ITALIC CAPITAL GAMMA",
-	"𝛥": "MATHEMATICAL ITALIC CAPITAL DELTA",
-	"𝛦": "MATHEMATICAL ITALIC CAPITAL EPSILON",
-	"𝛧": "MATHEMATICAL ITALIC CAPITAL ZETA",
-	"𝛨": "MATHEMATICAL ITALIC CAPITAL ETA",
-	"𝛩": "MATHEMATICAL ITALIC CAPITAL THETA",
-	"𝛪": "MATHEMATICAL ITALIC CAPITAL IOTA",
-	"𝛫": "MATHEMATICAL ITALIC CAPITAL KAPPA",
-	"𝛬": "MATHEMATICAL ITALIC CAPITAL LAMDA",
-	"𝛭": "MATHEMATICAL ITALIC CAPITAL MU",
-	"𝛮": "MATHEMATICAL ITALIC CAPITAL NU",
-	"𝛯": "MATHEMATICAL ITALIC CAPITAL XI",
-	"𝛰": "MATHEMATICAL ITALIC CAPITAL OMICRON",
-	"𝛱": "MATHEMATICAL ITALIC CAPITAL PI",
-	"𝛲": "MATHEMATICAL ITALIC CAPITAL RHO",
-	"𝛳": "MATHEMATICAL ITALIC CAPITAL THETA SYMBOL",
-	"𝛴": "MATHEMATICAL ITALIC CAPITAL SIGMA",
-	"𝛵": "MATHEMATICAL ITALIC CAPITAL TAU",
-	"𝛶": "MATHEMATICAL ITALIC CAPITAL UPSILON",
-	"𝛷": "MATHEMATICAL ITALIC CAPITAL PHI",
-	"𝛸": "MATHEMATICAL ITALIC CAPITAL CHI",
-	"𝛹": "MATHEMATICAL ITALIC CAPITAL PSI",
-	"𝛺": "MATHEMATICAL ITALIC CAPITAL OMEGA",
-	"𝛻": "MATHEMATICAL ITALIC NABLA",
-	"𝛼": "MATHEMATICAL ITALIC SMALL ALPHA",
-	"𝛽": "MATHEMATICAL ITALIC SMALL BETA",
-	"𝛾": "MATHEMATICAL ITALIC SMALL GAMMA",
-	"𝛿": "MATHEMATICAL ITALIC SMALL DELTA",
-	"𝜀": "MATHEMATICAL ITALIC SMALL EPSILON",
-	"𝜁": "MATHEMATICAL ITALIC SMALL ZETA",
-	"𝜂": "MATHEMATICAL ITALIC SMALL ETA",
-	"𝜃": "MATHEMATICAL ITALIC SMALL THETA",
-	"𝜄": "MATHEMATICAL ITALIC SMALL IOTA",
-	"𝜅": "MATHEMATICAL ITALIC SMALL KAPPA",
-	"𝜆": "MATHEMATICAL ITALIC SMALL LAMDA",
-	"𝜇": "MATHEMATICAL ITALIC SMALL MU",
-	"𝜈": "MATHEMATICAL ITALIC SMALL NU",
-	"𝜉": "MATHEMATICAL ITALIC SMALL XI",
-	"𝜊": "MATHEMATICAL ITALIC SMALL OMICRON",
-	"𝜋": "MATHEMATICAL ITALIC SMALL PI",
-	"𝜌": "MATHEMATICAL ITALIC SMALL RHO",
-	"𝜍": "MATHEMATICAL ITALIC SMALL FINAL SIGMA",
-	"𝜎": "MATHEMATICAL ITALIC SMALL SIGMA",
-	"𝜏": "MATHEMATICAL ITALIC SMALL TAU",
-	"𝜐": "MATHEMATICAL ITALIC SMALL UPSILON",
-	"𝜑": "MATHEMATICAL ITALIC SMALL PHI",
-	"𝜒": "MATHEMATICAL ITALIC SMALL CHI",
-	"𝜓": "MATHEMATICAL ITALIC SMALL PSI",
-	"𝜔": "MATHEMATICAL ITALIC SMALL OMEGA",
-	"𝜕": "MATHEMATICAL ITALIC PARTIAL DIFFERENTIAL",
-	"𝜖": "MATHEMATICAL ITALIC EPSILON SYMBOL",
-	"𝜗": "MATHEMATICAL ITALIC THETA SYMBOL",
-	"𝜘": "MATHEMATICAL ITALIC KAPPA SYMBOL",
-	"𝜙": "MATHEMATICAL ITALIC PHI SYMBOL",
-	"𝜚": "MATHEMATICAL ITALIC RHO SYMBOL",
-	"𝜛": "MATHEMATICAL ITALIC PI SYMBOL",
-	"𝜜": "MATHEMATICAL BOLD ITALIC CAPITAL ALPHA",
-	"𝜝": "MATHEMATICAL BOLD ITALIC CAPITAL BETA",
-	"𝜞": "MATHEMATICAL BOLD ITALIC CAPITAL GAMMA",
-	"𝜟": "MATHEMATICAL BOLD ITALIC CAPITAL DELTA",
-	"𝜠": "MATHEMATICAL BOLD ITALIC CAPITAL EPSILON",
-	"𝜡": "MATHEMATICAL BOLD ITALIC CAPITAL ZETA",
-	"𝜢": "MATHEMATICAL BOLD ITALIC CAPITAL ETA",
-	"𝜣": "MATHEMATICAL BOLD ITALIC CAPITAL THETA",
-	"𝜤": "MATHEMATICAL BOLD ITALIC CAPITAL IOTA",
-	"𝜥": "MATHEMATICAL BOLD ITALIC CAPITAL KAPPA",
-	"𝜦": "MATHEMATICAL BOLD ITALIC CAPITAL LAMDA",
-	"𝜧": "MATHEMATICAL BOLD ITALIC CAPITAL MU",
-	"𝜨": "MATHEMATICAL BOLD ITALIC CAPITAL NU",
-	"𝜩": "MATHEMATICAL BOLD ITALIC CAPITAL XI",
-	"𝜪": "MATHEMATICAL BOLD ITALIC CAPITAL OMICRON",
-	"𝜫": "MATHEMATICAL BOLD ITALIC CAPITAL PI",
-	"𝜬": "MATHEMATICAL BOLD ITALIC CAPITAL RHO",
-	"𝜭": "MATHEMATICAL BOLD ITALIC CAPITAL THETA SYMBOL",
-	"𝜮": "MATHEMATICAL BOLD ITALIC CAPITAL SIGMA",
-	"𝜯": "MATHEMATICAL BOLD ITALIC CAPITAL TAU",
-	"𝜰": "MATHEMATICAL BOLD ITALIC CAPITAL UPSILON",
-	"𝜱": "MATHEMATICAL BOLD ITALIC CAPITAL PHI",
-	"𝜲": "MATHEMATICAL BOLD ITALIC CAPITAL CHI",
-	"𝜳": "MATHEMATICAL BOLD ITALIC CAPITAL PSI",
-	"𝜴": "MATHEMATICAL BOLD ITALIC CAPITAL OMEGA",
-	"𝜵": "MATHEMATICAL BOLD ITALIC NABLA",
-	"𝜶": "MATHEMATICAL BOLD ITALIC SMALL ALPHA",
-	"𝜷": "MATHEMATICAL BOLD ITALIC SMALL BETA",
-	"𝜸": "MATHEMATICAL BOLD ITALIC SMALL GAMMA",
-	"𝜹": "MATHEMATICAL BOLD ITALIC SMALL DELTA",
-	"𝜺": "MATHEMATICAL BOLD ITALIC SMALL EPSILON",
-	"𝜻": "MATHEMATICAL BOLD ITALIC SMALL ZETA",
-	"𝜼": "MATHEMATICAL BOLD ITALIC SMALL ETA",
-	"𝜽": "MATHEMATICAL BOLD ITALIC SMALL THETA",
-	"𝜾": "MATHEMATICAL BOLD ITALIC SMALL IOTA",
-	"𝜿": "MATHEMATICAL BOLD ITALIC SMALL KAPPA",
-	"𝝀": "MATHEMATICAL BOLD ITALIC SMALL LAMDA",
-	"𝝁": "MATHEMATICAL BOLD ITALIC SMALL MU",
-	"𝝂": "MATHEMATICAL BOLD ITALIC SMALL NU",
-	"𝝃": "MATHEMATICAL BOLD ITALIC SMALL XI",
-	"𝝄": "MATHEMATICAL BOLD ITALIC SMALL OMICRON",
-	"𝝅": "MATHEMATICAL BOLD ITALIC SMALL PI",
-	"𝝆": "MATHEMATICAL BOLD ITALIC SMALL RHO",
-	"𝝇": "MATHEMATICAL BOLD ITALIC SMALL FINAL SIGMA",
-	"𝝈": "MATHEMATICAL BOLD ITALIC SMALL SIGMA",
-	"𝝉": "MATHEMATICAL BOLD ITALIC SMALL TAU",
-	"𝝊": "MATHEMATICAL BOLD ITALIC SMALL UPSILON",
-	"𝝋": "MATHEMATICAL BOLD ITALIC SMALL PHI",
-	"𝝌": "MATHEMATICAL BOLD ITALIC SMALL CHI",
-	"𝝍": "MATHEMATICAL BOLD ITALIC SMALL PSI",
-	"𝝎": "MATHEMATICAL BOLD ITALIC SMALL OMEGA",
-	"𝝏": "MATHEMATICAL BOLD ITALIC PARTIAL DIFFERENTIAL",
-	"𝝐": "MATHEMATICAL BOLD ITALIC EPSILON SYMBOL",
-	"𝝑": "MATHEMATICAL BOLD ITALIC THETA SYMBOL",
-	"𝝒": "MATHEMATICAL BOLD ITALIC KAPPA SYMBOL",
-	"𝝓": "MATHEMATICAL BOLD ITALIC PHI SYMBOL",
-	"𝝔": "MATHEMATICAL BOLD ITALIC RHO SYMBOL",
-	"𝝕": "MATHEMATICAL BOLD ITALIC PI SYMBOL",
-	"𝝖": "MATHEMATICAL SANS-SERIF BOLD CAPITAL ALPHA",
-	"𝝗": "MATHEMATICAL SANS-SERIF BOLD CAPITAL BETA",
-	"𝝘": "MATHEMATICAL SANS-SERIF BOLD CAPITAL GAMMA",
-	"𝝙": "MATHEMATICAL SANS-SERIF BOLD CAPITAL DELTA",
-	"𝝚": "MATHEMATICAL SANS-SERIF BOLD CAPITAL EPSILON",
-	"𝝛": "MATHEMATICAL SANS-SERIF BOLD CAPITAL ZETA",
-	"𝝜": "MATHEMATICAL SANS-SERIF BOLD CAPITAL ETA",
-	"𝝝": "MATHEMATICAL SANS-SERIF BOLD CAPITAL THETA",
-	"𝝞": "MATHEMATICAL SANS-SERIF BOLD CAPITAL IOTA",
-	"𝝟": "MATHEMATICAL SANS-SERIF BOLD CAPITAL KAPPA",
-	"𝝠": "MATHEMATICAL SANS-SERIF BOLD CAPITAL LAMDA",
-	"𝝡": "MATHEMATICAL SANS-SERIF BOLD CAPITAL MU",
-	"𝝢": "MATHEMATICAL SANS-SERIF BOLD CAPITAL NU",
-	"𝝣": "MATHEMATICAL SANS-SERIF BOLD CAPITAL XI",
-	"𝝤": "MATHEMATICAL SANS-SERIF BOLD CAPITAL OMICRON",
-	"𝝥": "MATHEMATICAL SANS-SERIF BOLD CAPITAL PI",
-	"𝝦": "MATHEMATICAL SANS-SERIF BOLD CAPITAL RHO",
-	"𝝧": "MATHEMATICAL SANS-SERIF BOLD CAPITAL THETA SYMBOL",
-	"𝝨": "MATHEMATICAL SANS-SERIF BOLD CAPITAL SIGMA",
-	"𝝩": "MATHEMATICAL SANS-SERIF BOLD CAPITAL TAU",
-	"𝝪": "MATHEMATICAL SANS-SERIF BOLD CAPITAL UPSILON",
-	"𝝫": "MATHEMATICAL SANS-SERIF BOLD CAPITAL PHI",
-	"𝝬": "MATHEMATICAL SANS-SERIF BOLD CAPITAL CHI",
-	"𝝭": "MATHEMATICAL SANS-SERIF BOLD CAPITAL PSI",
-	"𝝮": "MATHEMATICAL SANS-SERIF BOLD CAPITAL OMEGA",
-	"𝝯": "MATHEMATICAL SANS-SERIF BOLD NABLA",
-	"𝝰": "MATHEMATICAL SANS-SERIF BOLD SMALL ALPHA",
-	"𝝱": "MATHEMATICAL SANS-SERIF BOLD SMALL BETA",
-	"𝝲": "MATHEMATICAL SANS-SERIF BOLD SMALL GAMMA",
-	"𝝳": "MATHEMATICAL SANS-SERIF BOLD SMALL DELTA",
-	"𝝴": "MATHEMATICAL SANS-SERIF BOLD SMALL EPSILON",
-	"𝝵": "MATHEMATICAL SANS-SERIF BOLD SMALL ZETA",
-	"𝝶": "MATHEMATICAL SANS-SERIF BOLD SMALL ETA",
-	"𝝷": "MATHEMATICAL SANS-SERIF BOLD SMALL THETA",
-	"𝝸": "MATHEMATICAL SANS-SERIF BOLD SMALL IOTA",
-	"𝝹": "MATHEMATICAL SANS-SERIF BOLD SMALL KAPPA",
-	"𝝺": "MATHEMATICAL SANS-SERIF BOLD SMALL LAMDA",
-	"𝝻": "MATHEMATICAL SANS-SERIF BOLD SMALL MU",
-	"𝝼": "MATHEMATICAL SANS-SERIF BOLD SMALL NU",
-	"𝝽": "MATHEMATICAL SANS-SERIF BOLD SMALL XI",
-	"𝝾": "MATHEMATICAL SANS-SERIF BOLD SMALL OMICRON",
-	"𝝿": "MATHEMATICAL SANS-SERIF BOLD SMALL PI",
-	"𝞀": "MATHEMATICAL SANS-SERIF BOLD SMALL RHO",
-	"𝞁": "MATHEMATICAL SANS-SERIF BOLD SMALL FINAL SIGMA",
-	"𝞂": "MATHEMATICAL SANS-SERIF BOLD SMALL SIGMA",
-	"𝞃": "MATHEMATICAL SANS-SERIF BOLD SMALL TAU",
-	"𝞄": "MATHEMATICAL SANS-SERIF BOLD SMALL UPSILON",
-	"𝞅": "MATHEMATICAL SANS-SERIF BOLD SMALL PHI",
-	"𝞆": "MATHEMATICAL SANS-SERIF BOLD SMALL CHI",
-	"𝞇": "MATHEMATICAL SANS-SERIF BOLD SMALL PSI",
-	"𝞈": "MATHEMATICAL SANS-SERIF BOLD SMALL OMEGA",
-	"𝞉": "MATHEMATICAL SANS-SERIF BOLD PARTIAL DIFFERENTIAL",
-	"𝞊": "MATHEMATICAL SANS-SERIF BOLD EPSILON SYMBOL",
-	"𝞋": "MATHEMATICAL SANS-SERIF BOLD THETA SYMBOL",
-	"𝞌": "MATHEMATICAL SANS-SERIF BOLD KAPPA SYMBOL",
-	"𝞍": "MATHEMATICAL SANS-SERIF BOLD PHI SYMBOL",
-	"𝞎": "MATHEMATICAL SANS-SERIF BOLD RHO SYMBOL",
-	"𝞏": "MATHEMATICAL SANS-SERIF BOLD PI SYMBOL",
-	"𝞐": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ALPHA",
-	"𝞑": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL BETA",
-	"𝞒": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL GAMMA",
-	"𝞓": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL DELTA",
-	"𝞔": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL EPSILON",
-	"𝞕": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ZETA",
-	"𝞖": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL ETA",
-	"𝞗": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL THETA",
-	"𝞘": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL IOTA",
-	"𝞙": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL KAPPA",
-	"𝞚": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL LAMDA",
-	"𝞛": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL MU",
-	"𝞜": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL NU",
-	"𝞝": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL XI",
-	"𝞞": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL OMICRON",
-	"𝞟": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PI",
-	"𝞠": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL RHO",
-	"𝞡": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL THETA SYMBOL",
-	"𝞢": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL SIGMA",
-	"𝞣": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL TAU",
-	"𝞤": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL UPSILON",
-	"𝞥": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PHI",
-	"𝞦": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL CHI",
-	"𝞧": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL PSI",
-	"𝞨": "MATHEMATICAL SANS-SERIF BOLD ITALIC CAPITAL OMEGA",
-	"𝞩": "MATHEMATICAL SANS-SERIF BOLD ITALIC NABLA",
-	"𝞪": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ALPHA",
-	"𝞫": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL BETA",
-	"𝞬": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL GAMMA",
-	"𝞭": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL DELTA",
-	"𝞮": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL EPSILON",
-	"𝞯": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ZETA",
-	"𝞰": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL ETA",
-	"𝞱": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL THETA",
-	"𝞲": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL IOTA",
-	"𝞳": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL KAPPA",
-	"𝞴": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL LAMDA",
-	"𝞵": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL MU",
-	"𝞶": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL NU",
-	"𝞷": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL XI",
-	"𝞸": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL OMICRON",
-	"𝞹": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PI",
-	"𝞺": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL RHO",
-	"𝞻": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL FINAL SIGMA",
-	"𝞼": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL SIGMA",
-	"𝞽": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL TAU",
-	"𝞾": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL UPSILON",
-	"𝞿": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PHI",
-	"𝟀": "MATHEMATICAL BOLD DIGIT TWO",
-	"𝟁": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL PSI",
-	"𝟂": "MATHEMATICAL SANS-SERIF BOLD ITALIC SMALL OMEGA",
-	"𝟃": "MATHEMATICAL SANS-SERIF BOLD ITALIC PARTIAL DIFFERENTIAL",
-	"𝟄": "MATHEMATICAL SANS-SERIF BOLD ITALIC EPSILON SYMBOL",
-	"𝟅": "MATHEMATICAL SANS-SERIF BOLD ITALIC THETA SYMBOL",
-	"𝟆": "MATHEMATICAL SANS-SERIF BOLD ITALIC KAPPA SYMBOL",
-	"𝟇": "MATHEMATICAL SANS-SERIF BOLD ITALIC PHI SYMBOL",
-	"𝟈": "MATHEMATICAL SANS-SERIF BOLD ITALIC RHO SYMBOL",
-	"𝟉": "MATHEMATICAL SANS-SERIF BOLD ITALIC PI SYMBOL",
-	"𝟊": "MATHEMATICAL BOLD CAPITAL DIGAMMA",
-	"𝟋": "MATHEMATICAL BOLD SMALL DIGAMMA",
-	"𝟎": "MATHEMATICAL BOLD DIGIT ZERO",
-	"𝟏": "MATHEMATICAL BOLD DIGIT ONE",
-	"𝟐": "MATHEMATICAL BOLD DIGIT TWO",
-	"𝟑": "MATHEMATICAL BOLD DIGIT THREE",
-	"𝟒": "MATHEMATICAL BOLD DIGIT FOUR",
-	"𝟓": "MATHEMATICAL BOLD DIGIT FIVE",
-	"𝟔": "MATHEMATICAL BOLD DIGIT SIX",
-	"𝟕": "MATHEMATICAL BOLD DIGIT SEVEN",
-	"𝟖": "MATHEMATICAL BOLD DIGIT EIGHT",
-	"𝟗": "MATHEMATICAL BOLD DIGIT NINE",
-	"𝟘": "MATHEMATICAL DOUBLE-STRUCK DIGIT ZERO",
-	"𝟙": "MATHEMATICAL DOUBLE-STRUCK DIGIT ONE",
-	"𝟚": "MATHEMATICAL DOUBLE-STRUCK DIGIT TWO",
-	"𝟛": "MATHEMATICAL DOUBLE-STRUCK DIGIT THREE",
-	"𝟜": "MATHEMATICAL DOUBLE-STRUCK DIGIT FOUR",
-	"𝟝": "MATHEMATICAL DOUBLE-STRUCK DIGIT FIVE",
-	"𝟞": "MATHEMATICAL DOUBLE-STRUCK DIGIT SIX",
-	"𝟟": "MATHEMATICAL DOUBLE-STRUCK DIGIT SEVEN",
-	"𝟠": "MATHEMATICAL DOUBLE-STRUCK DIGIT EIGHT",
-	"𝟡": "MATHEMATICAL DOUBLE-STRUCK DIGIT NINE",
-	"𝟢": "MATHEMATICAL SANS-SERIF DIGIT ZERO",
-	"𝟣": "MATHEMATICAL SANS-SERIF DIGIT ONE",
-	"𝟤": "MATHEMATICAL SANS-SERIF DIGIT TWO",
-	"𝟥": "MATHEMATICAL SANS-SERIF DIGIT THREE",
-	"𝟦": "MATHEMATICAL SANS-SERIF DIGIT FOUR",
-	"𝟧": "MATHEMATICAL SANS-SERIF DIGIT FIVE",
-	"𝟨": "MATHEMATICAL SANS-SERIF DIGIT SIX",
-	"𝟩": "MATHEMATICAL SANS-SERIF DIGIT SEVEN",
-	"𝟪": "MATHEMATICAL SANS-SERIF DIGIT EIGHT",
-	"𝟫": "MATHEMATICAL SANS-SERIF DIGIT NINE",
-	"𝟬": "MATHEMATICAL SANS-SERIF BOLD DIGIT ZERO",
-	"𝟭": "MATHEMATICAL SANS-SERIF BOLD DIGIT ONE",
-	"𝟮": "MATHEMATICAL SANS-SERIF BOLD DIGIT TWO",
-	"𝟯": "MATHEMATICAL SANS-SERIF BOLD DIGIT THREE",
-	"𝟰": "MATHEMATICAL SANS-SERIF BOLD DIGIT FOUR",
-	"𝟱": "MATHEMATICAL SANS-SERIF BOLD DIGIT FIVE",
-	"𝟲": "MATHEMATICAL SANS-SERIF BOLD DIGIT SIX",
-	"𝟳": "MATHEMATICAL SANS-SERIF BOLD DIGIT SEVEN",
-	"𝟴": "MATHEMATICAL SANS-SERIF BOLD DIGIT EIGHT",
-	"𝟵": "MATHEMATICAL SANS-SERIF BOLD DIGIT NINE",
-	"𝟶": "MATHEMATICAL MONOSPACE DIGIT ZERO",
-	"𝟷": "MATHEMATICAL MONOSPACE DIGIT ONE",
-	"𝟸": "MATHEMATICAL MONOSPACE DIGIT TWO",
-	"𝟹": "MATHEMATICAL MONOSPACE DIGIT THREE",
-	"𝟺": "MATHEMATICAL MONOSPACE DIGIT FOUR",
-	"𝟻": "MATHEMATICAL MONOSPACE DIGIT FIVE",
-	"𝟼": "MATHEMATICAL MONOSPACE DIGIT SIX",
-	"𝟽": "MATHEMATICAL MONOSPACE DIGIT SEVEN",
-	"𝟾": "MATHEMATICAL MONOSPACE DIGIT EIGHT",
-	"𝟿": "MATHEMATICAL MONOSPACE DIGIT NINE",
-	"𞣇": "MENDE KIKAKUI DIGIT ONE",
-	"𞣈": "MENDE KIKAKUI DIGIT TWO",
-	"𞣉": "MENDE KIKAKUI DIGIT THREE",
-	"𞣋": "MENDE KIKAKUI DIGIT FIVE",
-	"𞣌": "MENDE KIKAKUI DIGIT SIX",
-	"𞣍": "MENDE KIKAKUI DIGIT SEVEN",
-	"𞸀": "ARABIC MATHEMATICAL ALEF",
-	"𞸁": "ARABIC MATHEMATICAL BEH",
-	"𞸂": "ARABIC MATHEMATICAL JEEM",
-	"𞸃": "ARABIC MATHEMATICAL DAL",
-	"𞸅": "ARABIC MATHEMATICAL WAW",
-	"𞸆": "ARABIC MATHEMATICAL ZAIN",
-	"𞸇": "ARABIC MATHEMATICAL HAH",
-	"𞸈": "ARABIC MATHEMATICAL TAH",
-	"𞸉": "ARABIC MATHEMATICAL YEH",
-	"𞸊": "ARABIC MATHEMATICAL KAF",
-	"𞸋": "ARABIC MATHEMATICAL LAM",
-	"𞸌": "ARABIC MATHEMATICAL MEEM",
-	"𞸍": "ARABIC MATHEMATICAL NOON",
-	"𞸎": "ARABIC MATHEMATICAL SEEN",
-	"𞸏": "ARABIC MATHEMATICAL AIN",
-	"𞸐": "ARABIC MATHEMATICAL FEH",
-	"𞸑": "ARABIC MATHEMATICAL SAD",
-	"𞸒": "ARABIC MATHEMATICAL QAF",
-	"𞸓": "ARABIC MATHEMATICAL REH",
-	"𞸔": "ARABIC MATHEMATICAL SHEEN",
-	"𞸕": "ARABIC MATHEMATICAL TEH",
-	"𞸖": "ARABIC MATHEMATICAL THEH",
-	"𞸗": "ARABIC MATHEMATICAL KHAH",
-	"𞸘": "ARABIC MATHEMATICAL THAL",
-	"𞸙": "ARABIC MATHEMATICAL DAD",
-	"𞸚": "ARABIC MATHEMATICAL ZAH",
-	"𞸛": "ARABIC MATHEMATICAL GHAIN",
-	"𞸜": "ARABIC MATHEMATICAL DOTLESS BEH",
-	"𞸝": "ARABIC MATHEMATICAL DOTLESS NOON",
-	"𞸞": "ARABIC MATHEMATICAL DOTLESS FEH",
-	"𞸟": "ARABIC MATHEMATICAL DOTLESS QAF",
-	"𞸡": "ARABIC MATHEMATICAL INITIAL BEH",
-	"𞸢": "ARABIC MATHEMATICAL INITIAL JEEM",
-	"𞸤": "ARABIC MATHEMATICAL INITIAL HEH",
-	"𞸧": "ARABIC MATHEMATICAL INITIAL HAH",
-	"𞸩": "ARABIC MATHEMATICAL INITIAL YEH",
-	"𞸪": "ARABIC MATHEMATICAL INITIAL KAF",
-	"𞸫": "ARABIC MATHEMATICAL INITIAL LAM",
-	"𞸬": "ARABIC MATHEMATICAL INITIAL MEEM",
-	"𞸭": "ARABIC MATHEMATICAL INITIAL NOON",
-	"𞸮": "ARABIC MATHEMATICAL INITIAL SEEN",
-	"𞸯": "ARABIC MATHEMATICAL INITIAL AIN",
-	"𞸰": "ARABIC MATHEMATICAL INITIAL FEH",
-	"𞸱": "ARABIC MATHEMATICAL INITIAL SAD",
-	"𞸲": "ARABIC MATHEMATICAL INITIAL QAF",
-	"𞸴": "ARABIC MATHEMATICAL INITIAL SHEEN",
-	"𞸵": "ARABIC MATHEMATICAL INITIAL TEH",
-	"𞸶": "ARABIC MATHEMATICAL INITIAL THEH",
-	"𞸷": "ARABIC MATHEMATICAL INITIAL KHAH",
-	"𞸹": "ARABIC MATHEMATICAL INITIAL DAD",
-	"𞸻": "ARABIC MATHEMATICAL INITIAL GHAIN",
-	"𞹂": "ARABIC MATHEMATICAL TAILED JEEM",
-	"𞹇": "ARABIC MATHEMATICAL TAILED HAH",
-	"𞹉": "ARABIC MATHEMATICAL TAILED YEH",
-	"𞹋": "ARABIC MATHEMATICAL TAILED LAM",
-	"𞹍": "ARABIC MATHEMATICAL TAILED NOON",
-	"𞹎": "ARABIC MATHEMATICAL TAILED SEEN",
-	"𞹏": "ARABIC MATHEMATICAL TAILED AIN",
-	"𞹑": "ARABIC MATHEMATICAL TAILED SAD",
-	"𞹒": "ARABIC MATHEMATICAL TAILED QAF",
-	"𞹔": "ARABIC MATHEMATICAL TAILED SHEEN",
-	"𞹗": "ARABIC MATHEMATICAL TAILED KHAH",
-	"𞹙": "ARABIC MATHEMATICAL TAILED DAD",
-	"𞹛": "ARABIC MATHEMATICAL TAILED GHAIN",
-	"𞹝": "ARABIC MATHEMATICAL TAILED DOTLESS NOON",
-	"𞹟": "ARABIC MATHEMATICAL TAILED DOTLESS QAF",
-	"𞹡": "ARABIC MATHEMATICAL STRETCHED BEH",
-	"𞹢": "ARABIC MATHEMATICAL STRETCHED JEEM",
-	"𞹤": "ARABIC MATHEMATICAL STRETCHED HEH",
-	"𞹧": "ARABIC MATHEMATICAL STRETCHED HAH",
-	"𞹨": "ARABIC MATHEMATICAL STRETCHED TAH",
-	"𞹩": "ARABIC MATHEMATICAL STRETCHED YEH",
-	"𞹪": "ARABIC MATHEMATICAL STRETCHED KAF",
-	"𞹬": "ARABIC MATHEMATICAL STRETCHED MEEM",
-	"𞹭": "ARABIC MATHEMATICAL STRETCHED NOON",
-	"𞹮": "ARABIC MATHEMATICAL STRETCHED SEEN",
-	"𞹯": "ARABIC MATHEMATICAL STRETCHED AIN",
-	"𞹰": "ARABIC MATHEMATICAL STRETCHED FEH",
-	"𞹱": "ARABIC MATHEMATICAL STRETCHED SAD",
-	"𞹲": "ARABIC MATHEMATICAL STRETCHED QAF",
-	"𞹴": "ARABIC MATHEMATICAL STRETCHED SHEEN",
-	"𞹵": "ARABIC MATHEMATICAL STRETCHED TEH",
-	"𞹶": "ARABIC MATHEMATICAL STRETCHED THEH",
-	"𞹷": "ARABIC MATHEMATICAL STRETCHED KHAH",
-	"𞹹": "ARABIC MATHEMATICAL STRETCHED DAD",
-	"𞹺": "ARABIC MATHEMATICAL STRETCHED ZAH",
-	"𞹻": "ARABIC MATHEMATICAL STRETCHED GHAIN",
-	"𞹼": "ARABIC MATHEMATICAL STRETCHED DOTLESS BEH",
-	"𞹾": "ARABIC MATHEMATICAL STRETCHED DOTLESS FEH",
-	"𞺀": "ARABIC MATHEMATICAL LOOPED ALEF",
-	"𞺁": "ARABIC MATHEMATICAL LOOPED BEH",
-	"𞺂": "ARABIC MATHEMATICAL LOOPED JEEM",
-	"𞺃": "ARABIC MATHEMATICAL LOOPED DAL",
-	"𞺄": "ARABIC MATHEMATICAL LOOPED HEH",
-	"𞺅": "ARABIC MATHEMATICAL LOOPED WAW",
-	"𞺆": "ARABIC MATHEMATICAL LOOPED ZAIN",
-	"𞺇": "ARABIC MATHEMATICAL LOOPED HAH",
-	"𞺈": "ARABIC MATHEMATICAL LOOPED TAH",
-	"𞺉": "ARABIC MATHEMATICAL LOOPED YEH",
-	"𞺋": "ARABIC MATHEMATICAL LOOPED LAM",
-	"𞺌": "ARABIC MATHEMATICAL LOOPED MEEM",
-	"𞺍": "ARABIC MATHEMATICAL LOOPED NOON",
-	"𞺎": "ARABIC MATHEMATICAL LOOPED SEEN",
-	"𞺏": "ARABIC MATHEMATICAL LOOPED AIN",
-	"𞺐": "ARABIC MATHEMATICAL LOOPED FEH",
-	"𞺑": "ARABIC MATHEMATICAL LOOPED SAD",
-	"𞺒": "ARABIC MATHEMATICAL LOOPED QAF",
-	"𞺓": "ARABIC MATHEMATICAL LOOPED REH",
-	"𞺔": "ARABIC MATHEMATICAL LOOPED SHEEN",
-	"𞺕": "ARABIC MATHEMATICAL LOOPED TEH",
-	"𞺖": "ARABIC MATHEMATICAL LOOPED THEH",
-	"𞺗": "ARABIC MATHEMATICAL LOOPED KHAH",
-	"𞺘": "ARABIC MATHEMATICAL LOOPED THAL",
-	"𞺙": "ARABIC MATHEMATICAL LOOPED DAD",
-	"𞺚": "ARABIC MATHEMATICAL LOOPED ZAH",
-	"𞺛": "ARABIC MATHEMATICAL LOOPED GHAIN",
-	"𞺡": "ARABIC MATHEMATICAL DOUBLE-STRUCK BEH",
-	"𞺢": "ARABIC MATHEMATICAL DOUBLE-STRUCK JEEM",
-	"𞺣": "ARABIC MATHEMATICAL DOUBLE-STRUCK DAL",
-	"𞺥": "ARABIC MATHEMATICAL DOUBLE-STRUCK WAW",
-	"𞺦": "ARABIC MATHEMATICAL DOUBLE-STRUCK ZAIN",
-	"𞺧": "ARABIC MATHEMATICAL DOUBLE-STRUCK HAH",
-	"𞺨": "ARABIC MATHEMATICAL DOUBLE-STRUCK TAH",
-	"𞺩": "ARABIC MATHEMATICAL DOUBLE-STRUCK YEH",
-	"𞺫": "ARABIC MATHEMATICAL DOUBLE-STRUCK LAM",
-	"𞺬": "ARABIC MATHEMATICAL DOUBLE-STRUCK MEEM",
-	"𞺭": "ARABIC MATHEMATICAL DOUBLE-STRUCK NOON",
-	"𞺮": "ARABIC MATHEMATICAL DOUBLE-STRUCK SEEN",
-	"𞺯": "ARABIC MATHEMATICAL DOUBLE-STRUCK AIN",
-	"𞺰": "ARABIC MATHEMATICAL DOUBLE-STRUCK FEH",
-	"𞺱": "ARABIC MATHEMATICAL DOUBLE-STRUCK SAD",
-	"𞺲": "ARABIC MATHEMATICAL DOUBLE-STRUCK QAF",
-	"𞺳": "ARABIC MATHEMATICAL DOUBLE-STRUCK REH",
-	"𞺴": "ARABIC MATHEMATICAL DOUBLE-STRUCK SHEEN",
-	"𞺵": "ARABIC MATHEMATICAL DOUBLE-STRUCK TEH",
-	"𞺶": "ARABIC MATHEMATICAL DOUBLE-STRUCK THEH",
-	"𞺷": "ARABIC MATHEMATICAL DOUBLE-STRUCK KHAH",
-	"𞺸": "ARABIC MATHEMATICAL DOUBLE-STRUCK THAL",
-	"𞺹": "ARABIC MATHEMATICAL DOUBLE-STRUCK DAD",
-	"𞺺": "ARABIC MATHEMATICAL DOUBLE-STRUCK ZAH",
-	"𞺻": "ARABIC MATHEMATICAL DOUBLE-STRUCK GHAIN",
-	"🄀": "DIGIT ZERO FULL STOP",
-	"🄁": "DIGIT ZERO COMMA",
-	"🄂": "DIGIT ONE COMMA",
-	"🄃": "DIGIT TWO COMMA",
-	"🄄": "DIGIT THREE COMMA",
-	"🄅": "DIGIT FOUR COMMA",
-	"🄆": "DIGIT FIVE COMMA",
-	"🄇": "DIGIT SIX COMMA",
-	"🄈": "DIGIT SEVEN COMMA",
-	"🄉": "DIGIT EIGHT COMMA",
-	"🄊": "DIGIT NINE COMMA",
-	"🄋": "DINGBAT CIRCLED SANS-SERIF DIGIT ZERO",
-	"🄌": "DINGBAT NEGATIVE CIRCLED SANS-SERIF DIGIT ZERO",
-	"🄍": "CIRCLED ZERO WITH SLASH",
-	"🄎": "CIRCLED ANTICLOCKWISE ARROW",
-	"🄏": "CIRCLED DOLLAR SIGN WITH OVERLAID BACKSLASH",
-	"🄐": "PARENTHESIZED LATIN CAPITAL LETTER A",
-	"🄑": "PARENTHESIZED LATIN CAPITAL LETTER B",
-	"🄒": "PARENTHESIZED LATIN CAPITAL LETTER C",
-	"🄓": "PARENTHESIZED LATIN CAPITAL LETTER D",
-	"🄔": "PARENTHESIZED LATIN CAPITAL LETTER E",
-	"🄕": "PARENTHESIZED LATIN CAPITAL LETTER F",
-	"🄖": "PARENTHESIZED LATIN CAPITAL LETTER G",
-	"🄗": "PARENTHESIZED LATIN CAPITAL LETTER H",
-	"🄘": "PARENTHESIZED LATIN CAPITAL LETTER I",
-	"🄙": "PARENTHESIZED LATIN CAPITAL LETTER J",
-	"🄚": "PARENTHESIZED LATIN CAPITAL LETTER K",
-	"🄛": "PARENTHESIZED LATIN CAPITAL LETTER L",
-	"🄜": "PARENTHESIZED LATIN CAPITAL LETTER M",
-	"🄝": "PARENTHESIZED LATIN CAPITAL LETTER N",
-	"🄞": "PARENTHESIZED LATIN CAPITAL LETTER O",
-	"🄟": "PARENTHESIZED LATIN CAPITAL LETTER P",
-	"🄠": "PARENTHESIZED LATIN CAPITAL LETTER Q",
-	"🄡": "PARENTHESIZED LATIN CAPITAL LETTER R",
-	"🄢": "PARENTHESIZED LATIN CAPITAL LETTER S",
-	"🄣": "PARENTHESIZED LATIN CAPITAL LETTER T",
-	"🄤": "PARENTHESIZED LATIN CAPITAL LETTER U",
-	"🄥": "PARENTHESIZED LATIN CAPITAL LETTER V",
-	"🄦": "PARENTHESIZED LATIN CAPITAL LETTER W",
-	"🄧": "PARENTHESIZED LATIN CAPITAL LETTER X",
-	"🄨": "PARENTHESIZED LATIN CAPITAL LETTER Y",
-	"🄩": "PARENTHESIZED LATIN CAPITAL LETTER Z",
-	"🄪": "TORTOISE SHELL BRACKETED LATIN CAPITAL LETTER S",
-	"🅭": "CIRCLED CC",
-	"🅮": "CIRCLED C WITH OVERLAID BACKSLASH",
-	"🉀": "TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-672C",
-	"🉁": "TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-4E09",
-	"🉂": "TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-4E8C",
-	"🉃": "TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-5B89",
-	"🉄": "TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-70B9",
-	"🉅": "TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-6253",
-	"🉆": "TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-76D7",
-	"🉇": "TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-52DD",
-	"🉈": "TORTOISE SHELL BRACKETED CJK UNIFIED IDEOGRAPH-6557",
-	"🌒": "WAXING CRESCENT MOON SYMBOL",
-	"🌘": "WANING CRESCENT MOON SYMBOL",
-	"🌙": "CRESCENT MOON",
-	"🜀": "ALCHEMICAL SYMBOL FOR QUINTESSENCE",
-	"🜁": "ALCHEMICAL SYMBOL FOR AIR",
-	"🜂": "ALCHEMICAL SYMBOL FOR FIRE",
-	"🜄": "ALCHEMICAL SYMBOL FOR WATER",
-	"🜇": "ALCHEMICAL SYMBOL FOR AQUA REGIA-2",
-	"🜈": "ALCHEMICAL SYMBOL FOR AQUA VITAE",
-	"🜊": "ALCHEMICAL SYMBOL FOR VINEGAR",
-	"🜔": "ALCHEMICAL SYMBOL FOR SALT",
-	"🜨": "ALCHEMICAL SYMBOL FOR VERDIGRIS",
-	"🜺": "ALCHEMICAL SYMBOL FOR ARSENIC",
-	"🝌": "ALCHEMICAL SYMBOL FOR CALX",
-	"🝔": "ALCHEMICAL SYMBOL FOR SOAP",
-	"🝕": "ALCHEMICAL SYMBOL FOR URINE",
-	"🝜": "ALCHEMICAL SYMBOL FOR STRATUM SUPER STRATUM",
-	"🝞": "ALCHEMICAL SYMBOL FOR SUBLIMATION",
-	"🝨": "ALCHEMICAL SYMBOL FOR CRUCIBLE-4",
-	"🝫": "ALCHEMICAL SYMBOL FOR BATH OF MARY",
-	"🝬": "ALCHEMICAL SYMBOL FOR BATH OF VAPOURS",
-	"🝱": "ALCHEMICAL SYMBOL FOR MONTH",
-	"🯰": "SEGMENTED DIGIT ZERO",
-	"🯱": "SEGMENTED DIGIT ONE",
-	"🯲": "SEGMENTED DIGIT TWO",
-	"🯳": "SEGMENTED DIGIT THREE",
-	"🯴": "SEGMENTED DIGIT FOUR",
-	"🯵": "SEGMENTED DIGIT FIVE",
-	"🯶": "SEGMENTED DIGIT SIX",
-	"🯷": "SEGMENTED DIGIT SEVEN",
-	"🯸": "SEGMENTED DIGIT EIGHT",
-	"🯹": "SEGMENTED DIGIT NINE",
-	"𠄢": "CJK UNIFIED IDEOGRAPH-20122",
-	"𠔜": "CJK UNIFIED IDEOGRAPH-2051C",
-	"𠔥": "CJK UNIFIED IDEOGRAPH-20525",
-	"𠕋": "CJK UNIFIED IDEOGRAPH-2054B",
-	"𠘺": "CJK UNIFIED IDEOGRAPH-2063A",
-	"𠠄": "CJK UNIFIED IDEOGRAPH-20804",
-	"𠣞": "CJK UNIFIED IDEOGRAPH-208DE",
-	"𠨬": "CJK UNIFIED IDEOGRAPH-20A2C",
-	"𠭣": "CJK UNIFIED IDEOGRAPH-20B63",
-	"𡓤": "CJK UNIFIED IDEOGRAPH-214E4",
-	"𡚨": "CJK UNIFIED IDEOGRAPH-216A8",
-	"𡛪": "CJK UNIFIED IDEOGRAPH-216EA",
-	"𡧈": "CJK UNIFIED IDEOGRAPH-219C8",
-	"𡬘": "CJK UNIFIED IDEOGRAPH-21B18",
-	"𡴋": "CJK UNIFIED IDEOGRAPH-21D0B",
-	"𡷤": "CJK UNIFIED IDEOGRAPH-21DE4",
-	"𡷦": "CJK UNIFIED IDEOGRAPH-21DE6",
-	"𡿨": "CJK UNIFIED IDEOGRAPH-21FE8",
-	"𢆃": "CJK UNIFIED IDEOGRAPH-22183",
-	"𢆟": "CJK UNIFIED IDEOGRAPH-2219F",
-	"𢌱": "CJK UNIFIED IDEOGRAPH-22331",
-	"𢛔": "CJK UNIFIED IDEOGRAPH-226D4",
-	"𢡄": "CJK UNIFIED IDEOGRAPH-22844",
-	"𢡊": "CJK UNIFIED IDEOGRAPH-2284A",
-	"𢬌": "CJK UNIFIED IDEOGRAPH-22B0C",
-	"𢯱": "CJK UNIFIED IDEOGRAPH-22BF1",
-	"𣀊": "CJK UNIFIED IDEOGRAPH-2300A",
-	"𣊸": "CJK UNIFIED IDEOGRAPH-232B8",
-	"𣍟": "CJK UNIFIED IDEOGRAPH-2335F",
-	"𣎓": "CJK UNIFIED IDEOGRAPH-23393",
-	"𣎜": "CJK UNIFIED IDEOGRAPH-2339C",
-	"𣏃": "CJK UNIFIED IDEOGRAPH-233C3",
-	"𣏕": "CJK UNIFIED IDEOGRAPH-233D5",
-	"𣑭": "CJK UNIFIED IDEOGRAPH-2346D",
-	"𣚣": "CJK UNIFIED IDEOGRAPH-236A3",
-	"𣢧": "CJK UNIFIED IDEOGRAPH-238A7",
-	"𣪍": "CJK UNIFIED IDEOGRAPH-23A8D",
-	"𣫺": "CJK UNIFIED IDEOGRAPH-23AFA",
-	"𣲼": "CJK UNIFIED IDEOGRAPH-23CBC",
-	"𣴞": "CJK UNIFIED IDEOGRAPH-23D1E",
-	"𣻑": "CJK UNIFIED IDEOGRAPH-23ED1",
-	"𣽞": "CJK UNIFIED IDEOGRAPH-23F5E",
-	"𣾎": "CJK UNIFIED IDEOGRAPH-23F8E",
-	"𤉣": "CJK UNIFIED IDEOGRAPH-24263",
-	"𤋮": "CJK UNIFIED IDEOGRAPH-242EE",
-	"𤎫": "CJK UNIFIED IDEOGRAPH-243AB",
-	"𤘈": "CJK UNIFIED IDEOGRAPH-24608",
-	"𤜵": "CJK UNIFIED IDEOGRAPH-24735",
-	"𤠔": "CJK UNIFIED IDEOGRAPH-24814",
-	"𤰶": "CJK UNIFIED IDEOGRAPH-24C36",
-	"𤲒": "CJK UNIFIED IDEOGRAPH-24C92",
-	"𤾡": "CJK UNIFIED IDEOGRAPH-24FA1",
-	"𤾸": "CJK UNIFIED IDEOGRAPH-24FB8",
-	"𥁄": "CJK UNIFIED IDEOGRAPH-25044",
-	"𥃲": "CJK UNIFIED IDEOGRAPH-250F2",
-	"𥃳": "CJK UNIFIED IDEOGRAPH-250F3",
-	"𥄙": "CJK UNIFIED IDEOGRAPH-25119",
-	"𥄳": "CJK UNIFIED IDEOGRAPH-25133",
-	"𥉉": "CJK UNIFIED IDEOGRAPH-25249",
-	"𥐝": "CJK UNIFIED IDEOGRAPH-2541D",
-	"𥘦": "CJK UNIFIED IDEOGRAPH-25626",
-	"𥚚": "CJK UNIFIED IDEOGRAPH-2569A",
-	"𥛅": "CJK UNIFIED IDEOGRAPH-256C5",
-	"𥥼": "CJK UNIFIED IDEOGRAPH-2597C",
-	"𥪧": "CJK UNIFIED IDEOGRAPH-25AA7",
-	"𥮫": "CJK UNIFIED IDEOGRAPH-25BAB",
-	"𥲀": "CJK UNIFIED IDEOGRAPH-25C80",
-	"𥳐": "CJK UNIFIED IDEOGRAPH-25CD0",
-	"𥾆": "CJK UNIFIED IDEOGRAPH-25F86",
-	"𦇚": "CJK UNIFIED IDEOGRAPH-261DA",
-	"𦈨": "CJK UNIFIED IDEOGRAPH-26228",
-	"𦉇": "CJK UNIFIED IDEOGRAPH-26247",
-	"𦋙": "CJK UNIFIED IDEOGRAPH-262D9",
-	"𦌾": "CJK UNIFIED IDEOGRAPH-2633E",
-	"𦓚": "CJK UNIFIED IDEOGRAPH-264DA",
-	"𦔣": "CJK UNIFIED IDEOGRAPH-26523",
-	"𦖨": "CJK UNIFIED IDEOGRAPH-265A8",
-	"𦞧": "CJK UNIFIED IDEOGRAPH-267A7",
-	"𦞵": "CJK UNIFIED IDEOGRAPH-267B5",
-	"𦬼": "CJK UNIFIED IDEOGRAPH-26B3C",
-	"𦰶": "CJK UNIFIED IDEOGRAPH-26C36",
-	"𦳕": "CJK UNIFIED IDEOGRAPH-26CD5",
-	"𦵫": "CJK UNIFIED IDEOGRAPH-26D6B",
-	"𦼬": "CJK UNIFIED IDEOGRAPH-26F2C",
-	"𦾱": "CJK UNIFIED IDEOGRAPH-26FB1",
-	"𧃒": "CJK UNIFIED IDEOGRAPH-270D2",
-	"𧏊": "CJK UNIFIED IDEOGRAPH-273CA",
-	"𧙧": "CJK UNIFIED IDEOGRAPH-27667",
-	"𧢮": "CJK UNIFIED IDEOGRAPH-278AE",
-	"𧥦": "CJK UNIFIED IDEOGRAPH-27966",
-	"𧲨": "CJK UNIFIED IDEOGRAPH-27CA8",
-	"𧻓": "CJK UNIFIED IDEOGRAPH-27ED3",
-	"𧼯": "CJK UNIFIED IDEOGRAPH-27F2F",
-	"𨗒": "CJK UNIFIED IDEOGRAPH-285D2",
-	"𨗭": "CJK UNIFIED IDEOGRAPH-285ED",
-	"𨜮": "CJK UNIFIED IDEOGRAPH-2872E",
-	"𨯺": "CJK UNIFIED IDEOGRAPH-28BFA",
-	"𨵷": "CJK UNIFIED IDEOGRAPH-28D77",
-	"𩅅": "CJK UNIFIED IDEOGRAPH-29145",
-	"𩇟": "CJK UNIFIED IDEOGRAPH-291DF",
-	"𩈚": "CJK UNIFIED IDEOGRAPH-2921A",
-	"𩐊": "CJK UNIFIED IDEOGRAPH-2940A",
-	"𩒖": "CJK UNIFIED IDEOGRAPH-29496",
-	"𩖶": "CJK UNIFIED IDEOGRAPH-295B6",
-	"𩬰": "CJK UNIFIED IDEOGRAPH-29B30",
-	"𪃎": "CJK UNIFIED IDEOGRAPH-2A0CE",
-	"𪄅": "CJK UNIFIED IDEOGRAPH-2A105",
-	"𪈎": "CJK UNIFIED IDEOGRAPH-2A20E",
-	"𪊑": "CJK UNIFIED IDEOGRAPH-2A291",
-	"𪎒": "CJK UNIFIED IDEOGRAPH-2A392",
-	"𪘀": "CJK UNIFIED IDEOGRAPH-2A600",
-	"丽": "CJK COMPATIBILITY IDEOGRAPH-2F800",
-	"丸": "CJK COMPATIBILITY IDEOGRAPH-2F801",
-	"乁": "CJK COMPATIBILITY IDEOGRAPH-2F802",
-	"𠄢": "CJK COMPATIBILITY IDEOGRAPH-2F803",
-	"你": "CJK COMPATIBILITY IDEOGRAPH-2F804",
-	"侮": "CJK COMPATIBILITY IDEOGRAPH-2F805",
-	"侻": "CJK COMPATIBILITY IDEOGRAPH-2F806",
-	"倂": "CJK COMPATIBILITY IDEOGRAPH-2F807",
-	"偺": "CJK COMPATIBILITY IDEOGRAPH-2F808",
-	"備": "CJK COMPATIBILITY IDEOGRAPH-2F809",
-	"僧": "CJK COMPATIBILITY IDEOGRAPH-2F80A",
-	"像": "CJK COMPATIBILITY IDEOGRAPH-2F80B",
-	"㒞": "CJK COMPATIBILITY IDEOGRAPH-2F80C",
-	"𠘺": "CJK COMPATIBILITY IDEOGRAPH-2F80D",
-	"免": "CJK COMPATIBILITY IDEOGRAPH-2F80E",
-	"兔": "CJK COMPATIBILITY IDEOGRAPH-2F80F",
-	"兤": "CJK COMPATIBILITY IDEOGRAPH-2F810",
-	"具": "CJK COMPATIBILITY IDEOGRAPH-2F811",
-	"𠔜": "CJK COMPATIBILITY IDEOGRAPH-2F812",
-	"㒹": "CJK COMPATIBILITY IDEOGRAPH-2F813",
-	"內": "CJK COMPATIBILITY IDEOGRAPH-2F814",
-	"再": "CJK COMPATIBILITY IDEOGRAPH-2F815",
-	"𠕋": "CJK COMPATIBILITY IDEOGRAPH-2F816",
-	"冗": "CJK COMPATIBILITY IDEOGRAPH-2F817",
-	"冤": "CJK COMPATIBILITY IDEOGRAPH-2F818",
-	"仌": "CJK COMPATIBILITY IDEOGRAPH-2F819",
-	"冬": "CJK COMPATIBILITY IDEOGRAPH-2F81A",
-	"况": "CJK COMPATIBILITY IDEOGRAPH-2F81B",
-	"𩇟": "CJK COMPATIBILITY IDEOGRAPH-2F81C",
-	"凵": "CJK COMPATIBILITY IDEOGRAPH-2F81D",
-	"刃": "CJK COMPATIBILITY IDEOGRAPH-2F81E",
-	"㓟": "CJK COMPATIBILITY IDEOGRAPH-2F81F",
-	"刻": "CJK COMPATIBILITY IDEOGRAPH-2F820",
-	"剆": "CJK COMPATIBILITY IDEOGRAPH-2F821",
-	"割": "CJK COMPATIBILITY IDEOGRAPH-2F822",
-	"剷": "CJK COMPATIBILITY IDEOGRAPH-2F823",
-	"㔕": "CJK COMPATIBILITY IDEOGRAPH-2F824",
-	"勇": "CJK COMPATIBILITY IDEOGRAPH-2F825",
-	"勉": "CJK COMPATIBILITY IDEOGRAPH-2F826",
-	"勤": "CJK COMPATIBILITY IDEOGRAPH-2F827",
-	"勺": "CJK COMPATIBILITY IDEOGRAPH-2F828",
-	"包": "CJK COMPATIBILITY IDEOGRAPH-2F829",
-	"匆": "CJK COMPATIBILITY IDEOGRAPH-2F82A",
-	"北": "CJK COMPATIBILITY IDEOGRAPH-2F82B",
-	"卉": "CJK COMPATIBILITY IDEOGRAPH-2F82C",
-	"卑": "CJK COMPATIBILITY IDEOGRAPH-2F82D",
-	"博": "CJK COMPATIBILITY IDEOGRAPH-2F82E",
-	"即": "CJK COMPATIBILITY IDEOGRAPH-2F82F",
-	"卽": "CJK COMPATIBILITY IDEOGRAPH-2F830",
-	"卿": "CJK COMPATIBILITY IDEOGRAPH-2F831",
-	"卿": "CJK COMPATIBILITY IDEOGRAPH-2F832",
-	"卿": "CJK COMPATIBILITY IDEOGRAPH-2F833",
-	"𠨬": "CJK COMPATIBILITY IDEOGRAPH-2F834",
-	"灰": "CJK COMPATIBILITY IDEOGRAPH-2F835",
-	"及": "CJK COMPATIBILITY IDEOGRAPH-2F836",
-	"叟": "CJK COMPATIBILITY IDEOGRAPH-2F837",
-	"𠭣": "CJK COMPATIBILITY IDEOGRAPH-2F838",
-	"叫": "CJK COMPATIBILITY IDEOGRAPH-2F839",
-	"叱": "CJK COMPATIBILITY IDEOGRAPH-2F83A",
-	"吆": "CJK COMPATIBILITY IDEOGRAPH-2F83B",
-	"咞": "CJK COMPATIBILITY IDEOGRAPH-2F83C",
-	"吸": "CJK COMPATIBILITY IDEOGRAPH-2F83D",
-	"呈": "CJK COMPATIBILITY IDEOGRAPH-2F83E",
-	"周": "CJK COMPATIBILITY IDEOGRAPH-2F83F",
-	"咢": "CJK COMPATIBILITY IDEOGRAPH-2F840",
-	"哶": "CJK COMPATIBILITY IDEOGRAPH-2F841",
-	"唐": "CJK COMPATIBILITY IDEOGRAPH-2F842",
-	"啓": "CJK COMPATIBILITY IDEOGRAPH-2F843",
-	"啣": "CJK COMPATIBILITY IDEOGRAPH-2F844",
-	"善": "CJK COMPATIBILITY IDEOGRAPH-2F845",
-	"善": "CJK COMPATIBILITY IDEOGRAPH-2F846",
-	"喙": "CJK COMPATIBILITY IDEOGRAPH-2F847",
-	"喫": "CJK COMPATIBILITY IDEOGRAPH-2F848",
-	"喳": "CJK COMPATIBILITY IDEOGRAPH-2F849",
-	"嗂": "CJK COMPATIBILITY IDEOGRAPH-2F84A",
-	"圖": "CJK COMPATIBILITY IDEOGRAPH-2F84B",
-	"嘆": "CJK COMPATIBILITY IDEOGRAPH-2F84C",
-	"圗": "CJK COMPATIBILITY IDEOGRAPH-2F84D",
-	"噑": "CJK COMPATIBILITY IDEOGRAPH-2F84E",
-	"噴": "CJK COMPATIBILITY IDEOGRAPH-2F84F",
-	"切": "CJK COMPATIBILITY IDEOGRAPH-2F850",
-	"壮": "CJK COMPATIBILITY IDEOGRAPH-2F851",
-	"城": "CJK COMPATIBILITY IDEOGRAPH-2F852",
-	"埴": "CJK COMPATIBILITY IDEOGRAPH-2F853",
-	"堍": "CJK COMPATIBILITY IDEOGRAPH-2F854",
-	"型": "CJK COMPATIBILITY IDEOGRAPH-2F855",
-	"堲": "CJK COMPATIBILITY IDEOGRAPH-2F856",
-	"報": "CJK COMPATIBILITY IDEOGRAPH-2F857",
-	"墬": "CJK COMPATIBILITY IDEOGRAPH-2F858",
-	"𡓤": "CJK COMPATIBILITY IDEOGRAPH-2F859",
-	"売": "CJK COMPATIBILITY IDEOGRAPH-2F85A",
-	"壷": "CJK COMPATIBILITY IDEOGRAPH-2F85B",
-	"夆": "CJK COMPATIBILITY IDEOGRAPH-2F85C",
-	"多": "CJK COMPATIBILITY IDEOGRAPH-2F85D",
-	"夢": "CJK COMPATIBILITY IDEOGRAPH-2F85E",
-	"奢": "CJK COMPATIBILITY IDEOGRAPH-2F85F",
-	"𡚨": "CJK COMPATIBILITY IDEOGRAPH-2F860",
-	"𡛪": "CJK COMPATIBILITY IDEOGRAPH-2F861",
-	"姬": "CJK COMPATIBILITY IDEOGRAPH-2F862",
-	"娛": "CJK COMPATIBILITY IDEOGRAPH-2F863",
-	"娧": "CJK COMPATIBILITY IDEOGRAPH-2F864",
-	"姘": "CJK COMPATIBILITY IDEOGRAPH-2F865",
-	"婦": "CJK COMPATIBILITY IDEOGRAPH-2F866",
-	"㛮": "CJK COMPATIBILITY IDEOGRAPH-2F867",
-	"㛼": "CJK COMPATIBILITY IDEOGRAPH-2F868",
-	"嬈": "CJK COMPATIBILITY IDEOGRAPH-2F869",
-	"嬾": "CJK COMPATIBILITY IDEOGRAPH-2F86A",
-	"嬾": "CJK COMPATIBILITY IDEOGRAPH-2F86B",
-	"𡧈": "CJK COMPATIBILITY IDEOGRAPH-2F86C",
-	"寃": "CJK COMPATIBILITY IDEOGRAPH-2F86D",
-	"寘": "CJK COMPATIBILITY IDEOGRAPH-2F86E",
-	"寧": "CJK COMPATIBILITY IDEOGRAPH-2F86F",
-	"寳": "CJK COMPATIBILITY IDEOGRAPH-2F870",
-	"𡬘": "CJK COMPATIBILITY IDEOGRAPH-2F871",
-	"寿": "CJK COMPATIBILITY IDEOGRAPH-2F872",
-	"将": "CJK COMPATIBILITY IDEOGRAPH-2F873",
-	"当": "CJK COMPATIBILITY IDEOGRAPH-2F874",
-	"尢": "CJK COMPATIBILITY IDEOGRAPH-2F875",
-	"㞁": "CJK COMPATIBILITY IDEOGRAPH-2F876",
-	"屠": "CJK COMPATIBILITY IDEOGRAPH-2F877",
-	"屮": "CJK COMPATIBILITY IDEOGRAPH-2F878",
-	"峀": "CJK COMPATIBILITY IDEOGRAPH-2F879",
-	"岍": "CJK COMPATIBILITY IDEOGRAPH-2F87A",
-	"𡷤": "CJK COMPATIBILITY IDEOGRAPH-2F87B",
-	"嵃": "CJK COMPATIBILITY IDEOGRAPH-2F87C",
-	"𡷦": "CJK COMPATIBILITY IDEOGRAPH-2F87D",
-	"嵮": "CJK COMPATIBILITY IDEOGRAPH-2F87E",
-	"嵫": "CJK COMPATIBILITY IDEOGRAPH-2F87F",
-	"嵼": "CJK COMPATIBILITY IDEOGRAPH-2F880",
-	"巡": "CJK COMPATIBILITY IDEOGRAPH-2F881",
-	"巢": "CJK COMPATIBILITY IDEOGRAPH-2F882",
-	"㠯": "CJK COMPATIBILITY IDEOGRAPH-2F883",
-	"巽": "CJK COMPATIBILITY IDEOGRAPH-2F884",
-	"帨": "CJK COMPATIBILITY IDEOGRAPH-2F885",
-	"帽": "CJK COMPATIBILITY IDEOGRAPH-2F886",
-	"幩": "CJK COMPATIBILITY IDEOGRAPH-2F887",
-	"㡢": "CJK COMPATIBILITY IDEOGRAPH-2F888",
-	"𢆃": "CJK COMPATIBILITY IDEOGRAPH-2F889",
-	"㡼": "CJK COMPATIBILITY IDEOGRAPH-2F88A",
-	"庰": "CJK COMPATIBILITY IDEOGRAPH-2F88B",
-	"庳": "CJK COMPATIBILITY IDEOGRAPH-2F88C",
-	"庶": "CJK COMPATIBILITY IDEOGRAPH-2F88D",
-	"廊": "CJK COMPATIBILITY IDEOGRAPH-2F88E",
-	"𪎒": "CJK COMPATIBILITY IDEOGRAPH-2F88F",
-	"廾": "CJK COMPATIBILITY IDEOGRAPH-2F890",
-	"𢌱": "CJK COMPATIBILITY IDEOGRAPH-2F891",
-	"𢌱": "CJK COMPATIBILITY IDEOGRAPH-2F892",
-	"舁": "CJK COMPATIBILITY IDEOGRAPH-2F893",
-	"弢": "CJK COMPATIBILITY IDEOGRAPH-2F894",
-	"弢": "CJK COMPATIBILITY IDEOGRAPH-2F895",
-	"㣇": "CJK COMPATIBILITY IDEOGRAPH-2F896",
-	"𣊸": "CJK COMPATIBILITY IDEOGRAPH-2F897",
-	"𦇚": "CJK COMPATIBILITY IDEOGRAPH-2F898",
-	"形": "CJK COMPATIBILITY IDEOGRAPH-2F899",
-	"彫": "CJK COMPATIBILITY IDEOGRAPH-2F89A",
-	"㣣": "CJK COMPATIBILITY IDEOGRAPH-2F89B",
-	"徚": "CJK COMPATIBILITY IDEOGRAPH-2F89C",
-	"忍": "CJK COMPATIBILITY IDEOGRAPH-2F89D",
-	"志": "CJK COMPATIBILITY IDEOGRAPH-2F89E",
-	"忹": "CJK COMPATIBILITY IDEOGRAPH-2F89F",
-	"悁": "CJK COMPATIBILITY IDEOGRAPH-2F8A0",
-	"㤺": "CJK COMPATIBILITY IDEOGRAPH-2F8A1",
-	"㤜": "CJK COMPATIBILITY IDEOGRAPH-2F8A2",
-	"悔": "CJK COMPATIBILITY IDEOGRAPH-2F8A3",
-	"𢛔": "CJK COMPATIBILITY IDEOGRAPH-2F8A4",
-	"惇": "CJK COMPATIBILITY IDEOGRAPH-2F8A5",
-	"慈": "CJK COMPATIBILITY IDEOGRAPH-2F8A6",
-	"慌": "CJK COMPATIBILITY IDEOGRAPH-2F8A7",
-	"慎": "CJK COMPATIBILITY IDEOGRAPH-2F8A8",
-	"慌": "CJK COMPATIBILITY IDEOGRAPH-2F8A9",
-	"慺": "CJK COMPATIBILITY IDEOGRAPH-2F8AA",
-	"憎": "CJK COMPATIBILITY IDEOGRAPH-2F8AB",
-	"憲": "CJK COMPATIBILITY IDEOGRAPH-2F8AC",
-	"憤": "CJK COMPATIBILITY IDEOGRAPH-2F8AD",
-	"憯": "CJK COMPATIBILITY IDEOGRAPH-2F8AE",
-	"懞": "CJK COMPATIBILITY IDEOGRAPH-2F8AF",
-	"懲": "CJK COMPATIBILITY IDEOGRAPH-2F8B0",
-	"懶": "CJK COMPATIBILITY IDEOGRAPH-2F8B1",
-	"成": "CJK COMPATIBILITY IDEOGRAPH-2F8B2",
-	"戛": "CJK COMPATIBILITY IDEOGRAPH-2F8B3",
-	"扝": "CJK COMPATIBILITY IDEOGRAPH-2F8B4",
-	"抱": "CJK COMPATIBILITY IDEOGRAPH-2F8B5",
-	"拔": "CJK COMPATIBILITY IDEOGRAPH-2F8B6",
-	"捐": "CJK COMPATIBILITY IDEOGRAPH-2F8B7",
-	"𢬌": "CJK COMPATIBILITY IDEOGRAPH-2F8B8",
-	"挽": "CJK COMPATIBILITY IDEOGRAPH-2F8B9",
-	"拼": "CJK COMPATIBILITY IDEOGRAPH-2F8BA",
-	"捨": "CJK COMPATIBILITY IDEOGRAPH-2F8BB",
-	"掃": "CJK COMPATIBILITY IDEOGRAPH-2F8BC",
-	"揤": "CJK COMPATIBILITY IDEOGRAPH-2F8BD",
-	"𢯱": "CJK COMPATIBILITY IDEOGRAPH-2F8BE",
-	"搢": "CJK COMPATIBILITY IDEOGRAPH-2F8BF",
-	"揅": "CJK COMPATIBILITY IDEOGRAPH-2F8C0",
-	"掩": "CJK COMPATIBILITY IDEOGRAPH-2F8C1",
-	"㨮": "CJK COMPATIBILITY IDEOGRAPH-2F8C2",
-	"摩": "CJK COMPATIBILITY IDEOGRAPH-2F8C3",
-	"摾": "CJK COMPATIBILITY IDEOGRAPH-2F8C4",
-	"撝": "CJK COMPATIBILITY IDEOGRAPH-2F8C5",
-	"摷": "CJK COMPATIBILITY IDEOGRAPH-2F8C6",
-	"㩬": "CJK COMPATIBILITY IDEOGRAPH-2F8C7",
-	"敏": "CJK COMPATIBILITY IDEOGRAPH-2F8C8",
-	"敬": "CJK COMPATIBILITY IDEOGRAPH-2F8C9",
-	"𣀊": "CJK COMPATIBILITY IDEOGRAPH-2F8CA",
-	"旣": "CJK COMPATIBILITY IDEOGRAPH-2F8CB",
-	"書": "CJK COMPATIBILITY IDEOGRAPH-2F8CC",
-	"晉": "CJK COMPATIBILITY IDEOGRAPH-2F8CD",
-	"㬙": "CJK COMPATIBILITY IDEOGRAPH-2F8CE",
-	"暑": "CJK COMPATIBILITY IDEOGRAPH-2F8CF",
-	"㬈": "CJK COMPATIBILITY IDEOGRAPH-2F8D0",
-	"㫤": "CJK COMPATIBILITY IDEOGRAPH-2F8D1",
-	"冒": "CJK COMPATIBILITY IDEOGRAPH-2F8D2",
-	"冕": "CJK COMPATIBILITY IDEOGRAPH-2F8D3",
-	"最": "CJK COMPATIBILITY IDEOGRAPH-2F8D4",
-	"暜": "CJK COMPATIBILITY IDEOGRAPH-2F8D5",
-	"肭": "CJK COMPATIBILITY IDEOGRAPH-2F8D6",
-	"䏙": "CJK COMPATIBILITY IDEOGRAPH-2F8D7",
-	"朗": "CJK COMPATIBILITY IDEOGRAPH-2F8D8",
-	"望": "CJK COMPATIBILITY IDEOGRAPH-2F8D9",
-	"朡": "CJK COMPATIBILITY IDEOGRAPH-2F8DA",
-	"杞": "CJK COMPATIBILITY IDEOGRAPH-2F8DB",
-	"杓": "CJK COMPATIBILITY IDEOGRAPH-2F8DC",
-	"𣏃": "CJK COMPATIBILITY IDEOGRAPH-2F8DD",
-	"㭉": "CJK COMPATIBILITY IDEOGRAPH-2F8DE",
-	"柺": "CJK COMPATIBILITY IDEOGRAPH-2F8DF",
-	"枅": "CJK COMPATIBILITY IDEOGRAPH-2F8E0",
-	"桒": "CJK COMPATIBILITY IDEOGRAPH-2F8E1",
-	"梅": "CJK COMPATIBILITY IDEOGRAPH-2F8E2",
-	"𣑭": "CJK COMPATIBILITY IDEOGRAPH-2F8E3",
-	"梎": "CJK COMPATIBILITY IDEOGRAPH-2F8E4",
-	"栟": "CJK COMPATIBILITY IDEOGRAPH-2F8E5",
-	"椔": "CJK COMPATIBILITY IDEOGRAPH-2F8E6",
-	"㮝": "CJK COMPATIBILITY IDEOGRAPH-2F8E7",
-	"楂": "CJK COMPATIBILITY IDEOGRAPH-2F8E8",
-	"榣": "CJK COMPATIBILITY IDEOGRAPH-2F8E9",
-	"槪": "CJK COMPATIBILITY IDEOGRAPH-2F8EA",
-	"檨": "CJK COMPATIBILITY IDEOGRAPH-2F8EB",
-	"𣚣": "CJK COMPATIBILITY IDEOGRAPH-2F8EC",
-	"櫛": "CJK COMPATIBILITY IDEOGRAPH-2F8ED",
-	"㰘": "CJK COMPATIBILITY IDEOGRAPH-2F8EE",
-	"次": "CJK COMPATIBILITY IDEOGRAPH-2F8EF",
-	"𣢧": "CJK COMPATIBILITY IDEOGRAPH-2F8F0",
-	"歔": "CJK COMPATIBILITY IDEOGRAPH-2F8F1",
-	"㱎": "CJK COMPATIBILITY IDEOGRAPH-2F8F2",
-	"歲": "CJK COMPATIBILITY IDEOGRAPH-2F8F3",
-	"殟": "CJK COMPATIBILITY IDEOGRAPH-2F8F4",
-	"殺": "CJK COMPATIBILITY IDEOGRAPH-2F8F5",
-	"殻": "CJK COMPATIBILITY IDEOGRAPH-2F8F6",
-	"𣪍": "CJK COMPATIBILITY IDEOGRAPH-2F8F7",
-	"𡴋": "CJK COMPATIBILITY IDEOGRAPH-2F8F8",
-	"𣫺": "CJK COMPATIBILITY IDEOGRAPH-2F8F9",
-	"汎": "CJK COMPATIBILITY IDEOGRAPH-2F8FA",
-	"𣲼": "CJK COMPATIBILITY IDEOGRAPH-2F8FB",
-	"沿": "CJK COMPATIBILITY IDEOGRAPH-2F8FC",
-	"泍": "CJK COMPATIBILITY IDEOGRAPH-2F8FD",
-	"汧": "CJK COMPATIBILITY IDEOGRAPH-2F8FE",
-	"洖": "CJK COMPATIBILITY IDEOGRAPH-2F8FF",
-	"派": "CJK COMPATIBILITY IDEOGRAPH-2F900",
-	"海": "CJK COMPATIBILITY IDEOGRAPH-2F901",
-	"流": "CJK COMPATIBILITY IDEOGRAPH-2F902",
-	"浩": "CJK COMPATIBILITY IDEOGRAPH-2F903",
-	"浸": "CJK COMPATIBILITY IDEOGRAPH-2F904",
-	"涅": "CJK COMPATIBILITY IDEOGRAPH-2F905",
-	"𣴞": "CJK COMPATIBILITY IDEOGRAPH-2F906",
-	"洴": "CJK COMPATIBILITY IDEOGRAPH-2F907",
-	"港": "CJK COMPATIBILITY IDEOGRAPH-2F908",
-	"湮": "CJK COMPATIBILITY IDEOGRAPH-2F909",
-	"㴳": "CJK COMPATIBILITY IDEOGRAPH-2F90A",
-	"滋": "CJK COMPATIBILITY IDEOGRAPH-2F90B",
-	"滇": "CJK COMPATIBILITY IDEOGRAPH-2F90C",
-	"𣻑": "CJK COMPATIBILITY IDEOGRAPH-2F90D",
-	"淹": "CJK COMPATIBILITY IDEOGRAPH-2F90E",
-	"潮": "CJK COMPATIBILITY IDEOGRAPH-2F90F",
-	"𣽞": "CJK COMPATIBILITY IDEOGRAPH-2F910",
-	"𣾎": "CJK COMPATIBILITY IDEOGRAPH-2F911",
-	"濆": "CJK COMPATIBILITY IDEOGRAPH-2F912",
-	"瀹": "CJK COMPATIBILITY IDEOGRAPH-2F913",
-	"瀞": "CJK COMPATIBILITY IDEOGRAPH-2F914",
-	"瀛": "CJK COMPATIBILITY IDEOGRAPH-2F915",
-	"㶖": "CJK COMPATIBILITY IDEOGRAPH-2F916",
-	"灊": "CJK COMPATIBILITY IDEOGRAPH-2F917",
-	"災": "CJK COMPATIBILITY IDEOGRAPH-2F918",
-	"灷": "CJK COMPATIBILITY IDEOGRAPH-2F919",
-	"炭": "CJK COMPATIBILITY IDEOGRAPH-2F91A",
-	"𠔥": "CJK COMPATIBILITY IDEOGRAPH-2F91B",
-	"煅": "CJK COMPATIBILITY IDEOGRAPH-2F91C",
-	"𤉣": "CJK COMPATIBILITY IDEOGRAPH-2F91D",
-	"熜": "CJK COMPATIBILITY IDEOGRAPH-2F91E",
-	"𤎫": "CJK COMPATIBILITY IDEOGRAPH-2F91F",
-	"爨": "CJK COMPATIBILITY IDEOGRAPH-2F920",
-	"爵": "CJK COMPATIBILITY IDEOGRAPH-2F921",
-	"牐": "CJK COMPATIBILITY IDEOGRAPH-2F922",
-	"𤘈": "CJK COMPATIBILITY IDEOGRAPH-2F923",
-	"犀": "CJK COMPATIBILITY IDEOGRAPH-2F924",
-	"犕": "CJK COMPATIBILITY IDEOGRAPH-2F925",
-	"𤜵": "CJK COMPATIBILITY IDEOGRAPH-2F926",
-	"𤠔": "CJK COMPATIBILITY IDEOGRAPH-2F927",
-	"獺": "CJK COMPATIBILITY IDEOGRAPH-2F928",
-	"王": "CJK COMPATIBILITY IDEOGRAPH-2F929",
-	"㺬": "CJK COMPATIBILITY IDEOGRAPH-2F92A",
-	"玥": "CJK COMPATIBILITY IDEOGRAPH-2F92B",
-	"㺸": "CJK COMPATIBILITY IDEOGRAPH-2F92C",
-	"㺸": "CJK COMPATIBILITY IDEOGRAPH-2F92D",
-	"瑇": "CJK COMPATIBILITY IDEOGRAPH-2F92E",
-	"瑜": "CJK COMPATIBILITY IDEOGRAPH-2F92F",
-	"瑱": "CJK COMPATIBILITY IDEOGRAPH-2F930",
-	"璅": "CJK COMPATIBILITY IDEOGRAPH-2F931",
-	"瓊": "CJK COMPATIBILITY IDEOGRAPH-2F932",
-	"㼛": "CJK COMPATIBILITY IDEOGRAPH-2F933",
-	"甤": "CJK COMPATIBILITY IDEOGRAPH-2F934",
-	"𤰶": "CJK COMPATIBILITY IDEOGRAPH-2F935",
-	"甾": "CJK COMPATIBILITY IDEOGRAPH-2F936",
-	"𤲒": "CJK COMPATIBILITY IDEOGRAPH-2F937",
-	"異": "CJK COMPATIBILITY IDEOGRAPH-2F938",
-	"𢆟": "CJK COMPATIBILITY IDEOGRAPH-2F939",
-	"瘐": "CJK COMPATIBILITY IDEOGRAPH-2F93A",
-	"𤾡": "CJK COMPATIBILITY IDEOGRAPH-2F93B",
-	"𤾸": "CJK COMPATIBILITY IDEOGRAPH-2F93C",
-	"𥁄": "CJK COMPATIBILITY IDEOGRAPH-2F93D",
-	"㿼": "CJK COMPATIBILITY IDEOGRAPH-2F93E",
-	"䀈": "CJK COMPATIBILITY IDEOGRAPH-2F93F",
-	"直": "CJK COMPATIBILITY IDEOGRAPH-2F940",
-	"𥃳": "CJK COMPATIBILITY IDEOGRAPH-2F941",
-	"𥃲": "CJK COMPATIBILITY IDEOGRAPH-2F942",
-	"𥄙": "CJK COMPATIBILITY IDEOGRAPH-2F943",
-	"𥄳": "CJK COMPATIBILITY IDEOGRAPH-2F944",
-	"眞": "CJK COMPATIBILITY IDEOGRAPH-2F945",
-	"真": "CJK COMPATIBILITY IDEOGRAPH-2F946",
-	"真": "CJK COMPATIBILITY IDEOGRAPH-2F947",
-	"睊": "CJK COMPATIBILITY IDEOGRAPH-2F948",
-	"䀹": "CJK COMPATIBILITY IDEOGRAPH-2F949",
-	"瞋": "CJK COMPATIBILITY IDEOGRAPH-2F94A",
-	"䁆": "CJK COMPATIBILITY IDEOGRAPH-2F94B",
-	"䂖": "CJK COMPATIBILITY IDEOGRAPH-2F94C",
-	"𥐝": "CJK COMPATIBILITY IDEOGRAPH-2F94D",
-	"硎": "CJK COMPATIBILITY IDEOGRAPH-2F94E",
-	"碌": "CJK COMPATIBILITY IDEOGRAPH-2F94F",
-	"磌": "CJK COMPATIBILITY IDEOGRAPH-2F950",
-	"䃣": "CJK COMPATIBILITY IDEOGRAPH-2F951",
-	"𥘦": "CJK COMPATIBILITY IDEOGRAPH-2F952",
-	"祖": "CJK COMPATIBILITY IDEOGRAPH-2F953",
-	"𥚚": "CJK COMPATIBILITY IDEOGRAPH-2F954",
-	"𥛅": "CJK COMPATIBILITY IDEOGRAPH-2F955",
-	"福": "CJK COMPATIBILITY IDEOGRAPH-2F956",
-	"秫": "CJK COMPATIBILITY IDEOGRAPH-2F957",
-	"䄯": "CJK COMPATIBILITY IDEOGRAPH-2F958",
-	"穀": "CJK COMPATIBILITY IDEOGRAPH-2F959",
-	"穊": "CJK COMPATIBILITY IDEOGRAPH-2F95A",
-	"穏": "CJK COMPATIBILITY IDEOGRAPH-2F95B",
-	"𥥼": "CJK COMPATIBILITY IDEOGRAPH-2F95C",
-	"𥪧": "CJK COMPATIBILITY IDEOGRAPH-2F95D",
-	"𥪧": "CJK COMPATIBILITY IDEOGRAPH-2F95E",
-	"竮": "CJK COMPATIBILITY IDEOGRAPH-2F95F",
-	"䈂": "CJK COMPATIBILITY IDEOGRAPH-2F960",
-	"𥮫": "CJK COMPATIBILITY IDEOGRAPH-2F961",
-	"篆": "CJK COMPATIBILITY IDEOGRAPH-2F962",
-	"築": "CJK COMPATIBILITY IDEOGRAPH-2F963",
-	"䈧": "CJK COMPATIBILITY IDEOGRAPH-2F964",
-	"𥲀": "CJK COMPATIBILITY IDEOGRAPH-2F965",
-	"糒": "CJK COMPATIBILITY IDEOGRAPH-2F966",
-	"䊠": "CJK COMPATIBILITY IDEOGRAPH-2F967",
-	"糨": "CJK COMPATIBILITY IDEOGRAPH-2F968",
-	"糣": "CJK COMPATIBILITY IDEOGRAPH-2F969",
-	"紀": "CJK COMPATIBILITY IDEOGRAPH-2F96A",
-	"𥾆": "CJK COMPATIBILITY IDEOGRAPH-2F96B",
-	"絣": "CJK COMPATIBILITY IDEOGRAPH-2F96C",
-	"䌁": "CJK COMPATIBILITY IDEOGRAPH-2F96D",
-	"緇": "CJK COMPATIBILITY IDEOGRAPH-2F96E",
-	"縂": "CJK COMPATIBILITY IDEOGRAPH-2F96F",
-	"繅": "CJK COMPATIBILITY IDEOGRAPH-2F970",
-	"䌴": "CJK COMPATIBILITY IDEOGRAPH-2F971",
-	"𦈨": "CJK COMPATIBILITY IDEOGRAPH-2F972",
-	"𦉇": "CJK COMPATIBILITY IDEOGRAPH-2F973",
-	"䍙": "CJK COMPATIBILITY IDEOGRAPH-2F974",
-	"𦋙": "CJK COMPATIBILITY IDEOGRAPH-2F975",
-	"罺": "CJK COMPATIBILITY IDEOGRAPH-2F976",
-	"𦌾": "CJK COMPATIBILITY IDEOGRAPH-2F977",
-	"羕": "CJK COMPATIBILITY IDEOGRAPH-2F978",
-	"翺": "CJK COMPATIBILITY IDEOGRAPH-2F979",
-	"者": "CJK COMPATIBILITY IDEOGRAPH-2F97A",
-	"𦓚": "CJK COMPATIBILITY IDEOGRAPH-2F97B",
-	"𦔣": "CJK COMPATIBILITY IDEOGRAPH-2F97C",
-	"聠": "CJK COMPATIBILITY IDEOGRAPH-2F97D",
-	"𦖨": "CJK COMPATIBILITY IDEOGRAPH-2F97E",
-	"聰": "CJK COMPATIBILITY IDEOGRAPH-2F97F",
-	"𣍟": "CJK COMPATIBILITY IDEOGRAPH-2F980",
-	"䏕": "CJK COMPATIBILITY IDEOGRAPH-2F981",
-	"育": "CJK COMPATIBILITY IDEOGRAPH-2F982",
-	"脃": "CJK COMPATIBILITY IDEOGRAPH-2F983",
-	"䐋": "CJK COMPATIBILITY IDEOGRAPH-2F984",
-	"脾": "CJK COMPATIBILITY IDEOGRAPH-2F985",
-	"媵": "CJK COMPATIBILITY IDEOGRAPH-2F986",
-	"𦞧": "CJK COMPATIBILITY IDEOGRAPH-2F987",
-	"𦞵": "CJK COMPATIBILITY IDEOGRAPH-2F988",
-	"𣎓": "CJK COMPATIBILITY IDEOGRAPH-2F989",
-	"𣎜": "CJK COMPATIBILITY IDEOGRAPH-2F98A",
-	"舁": "CJK COMPATIBILITY IDEOGRAPH-2F98B",
-	"舄": "CJK COMPATIBILITY IDEOGRAPH-2F98C",
-	"辞": "CJK COMPATIBILITY IDEOGRAPH-2F98D",
-	"䑫": "CJK COMPATIBILITY IDEOGRAPH-2F98E",
-	"芑": "CJK COMPATIBILITY IDEOGRAPH-2F98F",
-	"芋": "CJK COMPATIBILITY IDEOGRAPH-2F990",
-	"芝": "CJK COMPATIBILITY IDEOGRAPH-2F991",
-	"劳": "CJK COMPATIBILITY IDEOGRAPH-2F992",
-	"花": "CJK COMPATIBILITY IDEOGRAPH-2F993",
-	"芳": "CJK COMPATIBILITY IDEOGRAPH-2F994",
-	"芽": "CJK COMPATIBILITY IDEOGRAPH-2F995",
-	"苦": "CJK COMPATIBILITY IDEOGRAPH-2F996",
-	"𦬼": "CJK COMPATIBILITY IDEOGRAPH-2F997",
-	"若": "CJK COMPATIBILITY IDEOGRAPH-2F998",
-	"茝": "CJK COMPATIBILITY IDEOGRAPH-2F999",
-	"荣": "CJK COMPATIBILITY IDEOGRAPH-2F99A",
-	"莭": "CJK COMPATIBILITY IDEOGRAPH-2F99B",
-	"茣": "CJK COMPATIBILITY IDEOGRAPH-2F99C",
-	"莽": "CJK COMPATIBILITY IDEOGRAPH-2F99D",
-	"菧": "CJK COMPATIBILITY IDEOGRAPH-2F99E",
-	"著": "CJK COMPATIBILITY IDEOGRAPH-2F99F",
-	"荓": "CJK COMPATIBILITY IDEOGRAPH-2F9A0",
-	"菊": "CJK COMPATIBILITY IDEOGRAPH-2F9A1",
-	"菌": "CJK COMPATIBILITY IDEOGRAPH-2F9A2",
-	"菜": "CJK COMPATIBILITY IDEOGRAPH-2F9A3",
-	"𦰶": "CJK COMPATIBILITY IDEOGRAPH-2F9A4",
-	"𦵫": "CJK COMPATIBILITY IDEOGRAPH-2F9A5",
-	"𦳕": "CJK COMPATIBILITY IDEOGRAPH-2F9A6",
-	"䔫": "CJK COMPATIBILITY IDEOGRAPH-2F9A7",
-	"蓱": "CJK COMPATIBILITY IDEOGRAPH-2F9A8",
-	"蓳": "CJK COMPATIBILITY IDEOGRAPH-2F9A9",
-	"蔖": "CJK COMPATIBILITY IDEOGRAPH-2F9AA",
-	"𧏊": "CJK COMPATIBILITY IDEOGRAPH-2F9AB",
-	"蕤": "CJK COMPATIBILITY IDEOGRAPH-2F9AC",
-	"𦼬": "CJK COMPATIBILITY IDEOGRAPH-2F9AD",
-	"䕝": "CJK COMPATIBILITY IDEOGRAPH-2F9AE",
-	"䕡": "CJK COMPATIBILITY IDEOGRAPH-2F9AF",
-	"𦾱": "CJK COMPATIBILITY IDEOGRAPH-2F9B0",
-	"𧃒": "CJK COMPATIBILITY IDEOGRAPH-2F9B1",
-	"䕫": "CJK COMPATIBILITY IDEOGRAPH-2F9B2",
-	"虐": "CJK COMPATIBILITY IDEOGRAPH-2F9B3",
-	"虜": "CJK COMPATIBILITY IDEOGRAPH-2F9B4",
-	"虧": "CJK COMPATIBILITY IDEOGRAPH-2F9B5",
-	"虩": "CJK COMPATIBILITY IDEOGRAPH-2F9B6",
-	"蚩": "CJK COMPATIBILITY IDEOGRAPH-2F9B7",
-	"蚈": "CJK COMPATIBILITY IDEOGRAPH-2F9B8",
-	"蜎": "CJK COMPATIBILITY IDEOGRAPH-2F9B9",
-	"蛢": "CJK COMPATIBILITY IDEOGRAPH-2F9BA",
-	"蝹": "CJK COMPATIBILITY IDEOGRAPH-2F9BB",
-	"蜨": "CJK COMPATIBILITY IDEOGRAPH-2F9BC",
-	"蝫": "CJK COMPATIBILITY IDEOGRAPH-2F9BD",
-	"螆": "CJK COMPATIBILITY IDEOGRAPH-2F9BE",
-	"䗗": "CJK COMPATIBILITY IDEOGRAPH-2F9BF",
-	"蟡": "CJK COMPATIBILITY IDEOGRAPH-2F9C0",
-	"蠁": "CJK COMPATIBILITY IDEOGRAPH-2F9C1",
-	"䗹": "CJK COMPATIBILITY IDEOGRAPH-2F9C2",
-	"衠": "CJK COMPATIBILITY IDEOGRAPH-2F9C3",
-	"衣": "CJK COMPATIBILITY IDEOGRAPH-2F9C4",
-	"𧙧": "CJK COMPATIBILITY IDEOGRAPH-2F9C5",
-	"裗": "CJK COMPATIBILITY IDEOGRAPH-2F9C6",
-	"裞": "CJK COMPATIBILITY IDEOGRAPH-2F9C7",
-	"䘵": "CJK COMPATIBILITY IDEOGRAPH-2F9C8",
-	"裺": "CJK COMPATIBILITY IDEOGRAPH-2F9C9",
-	"㒻": "CJK COMPATIBILITY IDEOGRAPH-2F9CA",
-	"𧢮": "CJK COMPATIBILITY IDEOGRAPH-2F9CB",
-	"𧥦": "CJK COMPATIBILITY IDEOGRAPH-2F9CC",
-	"䚾": "CJK COMPATIBILITY IDEOGRAPH-2F9CD",
-	"䛇": "CJK COMPATIBILITY IDEOGRAPH-2F9CE",
-	"誠": "CJK COMPATIBILITY IDEOGRAPH-2F9CF",
-	"諭": "CJK COMPATIBILITY IDEOGRAPH-2F9D0",
-	"變": "CJK COMPATIBILITY IDEOGRAPH-2F9D1",
-	"豕": "CJK COMPATIBILITY IDEOGRAPH-2F9D2",
-	"𧲨": "CJK COMPATIBILITY IDEOGRAPH-2F9D3",
-	"貫": "CJK COMPATIBILITY IDEOGRAPH-2F9D4",
-	"賁": "CJK COMPATIBILITY IDEOGRAPH-2F9D5",
-	"贛": "CJK COMPATIBILITY IDEOGRAPH-2F9D6",
-	"起": "CJK COMPATIBILITY IDEOGRAPH-2F9D7",
-	"𧼯": "CJK COMPATIBILITY IDEOGRAPH-2F9D8",
-	"𠠄": "CJK COMPATIBILITY IDEOGRAPH-2F9D9",
-	"跋": "CJK COMPATIBILITY IDEOGRAPH-2F9DA",
-	"趼": "CJK COMPATIBILITY IDEOGRAPH-2F9DB",
-	"跰": "CJK COMPATIBILITY IDEOGRAPH-2F9DC",
-	"𠣞": "CJK COMPATIBILITY IDEOGRAPH-2F9DD",
-	"軔": "CJK COMPATIBILITY IDEOGRAPH-2F9DE",
-	"輸": "CJK COMPATIBILITY IDEOGRAPH-2F9DF",
-	"𨗒": "CJK COMPATIBILITY IDEOGRAPH-2F9E0",
-	"𨗭": "CJK COMPATIBILITY IDEOGRAPH-2F9E1",
-	"邔": "CJK COMPATIBILITY IDEOGRAPH-2F9E2",
-	"郱": "CJK COMPATIBILITY IDEOGRAPH-2F9E3",
-	"鄑": "CJK COMPATIBILITY IDEOGRAPH-2F9E4",
-	"𨜮": "CJK COMPATIBILITY IDEOGRAPH-2F9E5",
-	"鄛": "CJK COMPATIBILITY IDEOGRAPH-2F9E6",
-	"鈸": "CJK COMPATIBILITY IDEOGRAPH-2F9E7",
-	"鋗": "CJK COMPATIBILITY IDEOGRAPH-2F9E8",
-	"鋘": "CJK COMPATIBILITY IDEOGRAPH-2F9E9",
-	"鉼": "CJK COMPATIBILITY IDEOGRAPH-2F9EA",
-	"鏹": "CJK COMPATIBILITY IDEOGRAPH-2F9EB",
-	"鐕": "CJK COMPATIBILITY IDEOGRAPH-2F9EC",
-	"𨯺": "CJK COMPATIBILITY IDEOGRAPH-2F9ED",
-	"開": "CJK COMPATIBILITY IDEOGRAPH-2F9EE",
-	"䦕": "CJK COMPATIBILITY IDEOGRAPH-2F9EF",
-	"閷": "CJK COMPATIBILITY IDEOGRAPH-2F9F0",
-	"𨵷": "CJK COMPATIBILITY IDEOGRAPH-2F9F1",
-	"䧦": "CJK COMPATIBILITY IDEOGRAPH-2F9F2",
-	"雃": "CJK COMPATIBILITY IDEOGRAPH-2F9F3",
-	"嶲": "CJK COMPATIBILITY IDEOGRAPH-2F9F4",
-	"霣": "CJK COMPATIBILITY IDEOGRAPH-2F9F5",
-	"𩅅": "CJK COMPATIBILITY IDEOGRAPH-2F9F6",
-	"𩈚": "CJK COMPATIBILITY IDEOGRAPH-2F9F7",
-	"䩮": "CJK COMPATIBILITY IDEOGRAPH-2F9F8",
-	"䩶": "CJK COMPATIBILITY IDEOGRAPH-2F9F9",
-	"韠": "CJK COMPATIBILITY IDEOGRAPH-2F9FA",
-	"𩐊": "CJK COMPATIBILITY IDEOGRAPH-2F9FB",
-	"䪲": "CJK COMPATIBILITY IDEOGRAPH-2F9FC",
-	"𩒖": "CJK COMPATIBILITY IDEOGRAPH-2F9FD",
-	"頋": "CJK COMPATIBILITY IDEOGRAPH-2F9FE",
-	"頋": "CJK COMPATIBILITY IDEOGRAPH-2F9FF",
-	"頩": "CJK COMPATIBILITY IDEOGRAPH-2FA00",
-	"𩖶": "CJK COMPATIBILITY IDEOGRAPH-2FA01",
-	"飢": "CJK COMPATIBILITY IDEOGRAPH-2FA02",
-	"䬳": "CJK COMPATIBILITY IDEOGRAPH-2FA03",
-	"餩": "CJK COMPATIBILITY IDEOGRAPH-2FA04",
-	"馧": "CJK COMPATIBILITY IDEOGRAPH-2FA05",
-	"駂": "CJK COMPATIBILITY IDEOGRAPH-2FA06",
-	"駾": "CJK COMPATIBILITY IDEOGRAPH-2FA07",
-	"䯎": "CJK COMPATIBILITY IDEOGRAPH-2FA08",
-	"𩬰": "CJK COMPATIBILITY IDEOGRAPH-2FA09",
-	"鬒": "CJK COMPATIBILITY IDEOGRAPH-2FA0A",
-	"鱀": "CJK COMPATIBILITY IDEOGRAPH-2FA0B",
-	"鳽": "CJK COMPATIBILITY IDEOGRAPH-2FA0C",
-	"䳎": "CJK COMPATIBILITY IDEOGRAPH-2FA0D",
-	"䳭": "CJK COMPATIBILITY IDEOGRAPH-2FA0E",
-	"鵧": "CJK COMPATIBILITY IDEOGRAPH-2FA0F",
-	"𪃎": "CJK COMPATIBILITY IDEOGRAPH-2FA10",
-	"䳸": "CJK COMPATIBILITY IDEOGRAPH-2FA11",
-	"𪄅": "CJK COMPATIBILITY IDEOGRAPH-2FA12",
-	"𪈎": "CJK COMPATIBILITY IDEOGRAPH-2FA13",
-	"𪊑": "CJK COMPATIBILITY IDEOGRAPH-2FA14",
-	"麻": "CJK COMPATIBILITY IDEOGRAPH-2FA15",
-	"䵖": "CJK COMPATIBILITY IDEOGRAPH-2FA16",
-	"黹": "CJK COMPATIBILITY IDEOGRAPH-2FA17",
-	"黾": "CJK COMPATIBILITY IDEOGRAPH-2FA18",
-	"鼅": "CJK COMPATIBILITY IDEOGRAPH-2FA19",
-	"鼏": "CJK COMPATIBILITY IDEOGRAPH-2FA1A",
-	"鼖": "CJK COMPATIBILITY IDEOGRAPH-2FA1B",
-	"鼻": "CJK COMPATIBILITY IDEOGRAPH-2FA1C",
-	"𪘀": "CJK COMPATIBILITY IDEOGRAPH-2FA1D",
+func init() {
+	registerTable("confusables")
 }
@@ -0,0 +1,19 @@
+// Package v15 provides the confusables tables generated from version 15.0.0 of Unicode's
+// confusables.txt, for use with confusables.WithTables.
+package v15
+
+// THIS FILE WAS AUTOGENERATED - DO NOT EDIT
+
+// Version: 15.0.0
+
+import (
+	_ "embed"
+
+	"github.com/eskriett/confusables"
+)
+
+//go:embed tables.bin
+var data string
+
+// Tables holds the tables generated from version 15.0.0 of confusables.txt.
+var Tables = confusables.NewTables(data)
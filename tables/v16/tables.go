@@ -0,0 +1,20 @@
+// Package v16 provides the confusables tables generated from version 16.0.0 of Unicode's
+// confusables.txt, for use with confusables.WithTables.
+package v16
+
+// THIS FILE WAS AUTOGENERATED - DO NOT EDIT
+
+// Date: 2024-08-14, 23:39:57 GMT
+// Version: 16.0.0
+
+import (
+	_ "embed"
+
+	"github.com/eskriett/confusables"
+)
+
+//go:embed tables.bin
+var data string
+
+// Tables holds the tables generated from version 16.0.0 of confusables.txt.
+var Tables = confusables.NewTables(data)
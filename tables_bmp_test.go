@@ -0,0 +1,24 @@
+//go:build confusables_bmp_only && !confusables_latin_only && !confusables_raw
+
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTables(t *testing.T) {
+	t.Parallel()
+
+	// The tables hold no mappings from outside the Basic Multilingual Plane, though they hold mappings to other
+	// scripts.
+	assert.Equal(t, "𝐞", confusables.ToSkeleton("𝐞"))
+	assert.Equal(t, "exarnple", confusables.ToSkeleton("ех⍺ⅿрІꬲ"))
+	assert.Equal(t, "μ", confusables.ToSkeleton("µ"))
+	assert.False(t, confusables.IsConfusable("example", "𝐞х⍺𝓂𝕡Іꬲ"))
+
+	assert.Contains(t, confusables.Capabilities().Tables, "confusables-bmp")
+	assert.NotContains(t, confusables.Capabilities().Tables, "confusables")
+}
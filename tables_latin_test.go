@@ -0,0 +1,22 @@
+//go:build confusables_latin_only && !confusables_raw
+
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTables(t *testing.T) {
+	t.Parallel()
+
+	// The tables hold mappings to Latin alone, including those from outside the Basic Multilingual Plane.
+	assert.Equal(t, "e", confusables.ToSkeleton("𝐞"))
+	assert.Equal(t, "exarnple", confusables.ToSkeleton("𝐞х⍺𝓂𝕡Іꬲ"))
+	assert.Equal(t, "µ", confusables.ToSkeleton("µ"))
+
+	assert.Contains(t, confusables.Capabilities().Tables, "confusables-latin")
+	assert.NotContains(t, confusables.Capabilities().Tables, "confusables")
+}
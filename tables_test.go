@@ -0,0 +1,62 @@
+//go:build (!confusables_latin_only && !confusables_bmp_only) || confusables_raw
+
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTables(t *testing.T) {
+	t.Parallel()
+
+	// The full tables hold mappings from outside the Basic Multilingual Plane and to scripts other than Latin.
+	assert.Equal(t, "e", confusables.ToSkeleton("𝐞"))
+	assert.Equal(t, "μ", confusables.ToSkeleton("µ"))
+
+	assert.Contains(t, confusables.Capabilities().Tables, "confusables")
+	assert.NotContains(t, confusables.Capabilities().Tables, "confusables-bmp")
+}
+
+func TestToASCIISupplementary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		confusable, ascii string
+	}{
+		{"🄀,⒈,⒉,⒊,⒋,⒌,⒍,⒎,⒏,⒐,⒑,⒒,⒓,⒔,⒕,⒖,⒗,⒘,⒙,⒚,⒛", "0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20"},
+		{"𝟎,𝟏,𝟐,𝟑,𝟒,𝟓,𝟔,𝟕,𝟖,𝟗", "0,1,2,3,4,5,6,7,8,9"},
+		{"𝟘,𝟙,𝟚,𝟛,𝟜,𝟝,𝟞,𝟟,𝟠,𝟡", "0,1,2,3,4,5,6,7,8,9"},
+		{"𝟢,𝟣,𝟤,𝟥,𝟦,𝟧,𝟨,𝟩,𝟪,𝟫", "0,1,2,3,4,5,6,7,8,9"},
+		{"𝟬,𝟭,𝟮,𝟯,𝟰,𝟱,𝟲,𝟳,𝟴,𝟵", "0,1,2,3,4,5,6,7,8,9"},
+		{"𝟶,𝟷,𝟸,𝟹,𝟺,𝟻,𝟼,𝟽,𝟾,𝟿", "0,1,2,3,4,5,6,7,8,9"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.ascii, confusables.ToASCII(test.confusable))
+	}
+
+	assert.Equal(t, "201", confusables.ToNumber("2𝘖l"))
+}
+
+func TestIsConfusableSupplementary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s1, s2       string
+		isConfusable bool
+	}{
+		{"example", "𝐞х⍺𝓂𝕡Іꬲ", true},
+		{"example", "𝐞х⍺𝓂𝕡І", false},
+		{"example", "𝐞х⍺𝓂𝕡Іe", true},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.isConfusable, confusables.IsConfusable(test.s1, test.s2),
+			"IsConfusable(%q, %q)", test.s1, test.s2)
+	}
+
+	assert.Equal(t, "exarnple", confusables.ToSkeleton("𝐞х⍺𝓂𝕡Іꬲ"))
+}
@@ -0,0 +1,59 @@
+package confusables
+
+import (
+	"strings"
+	"unicode"
+)
+
+// transliterations holds ASCII transliterations of letters which have no decomposition, and so are not converted to
+// ASCII by removing their marks.
+var transliterations = map[rune]string{
+	'ß': "ss",
+	'ẞ': "SS",
+	'æ': "ae",
+	'Æ': "AE",
+	'ð': "d",
+	'Ð': "D",
+	'đ': "d",
+	'Đ': "D",
+	'ħ': "h",
+	'Ħ': "H",
+	'ı': "i",
+	'ĳ': "ij",
+	'Ĳ': "IJ",
+	'ł': "l",
+	'Ł': "L",
+	'ŋ': "ng",
+	'Ŋ': "NG",
+	'ø': "o",
+	'Ø': "O",
+	'œ': "oe",
+	'Œ': "OE",
+	'ſ': "s",
+	'ŧ': "t",
+	'Ŧ': "T",
+	'þ': "th",
+	'Þ': "Th",
+}
+
+// Transliterate the non-ASCII runes of s, reporting whether every one of them has a transliteration.
+func transliterate(s string) (string, bool) {
+	var b strings.Builder
+
+	for _, r := range s {
+		if r <= unicode.MaxASCII {
+			b.WriteRune(r)
+
+			continue
+		}
+
+		v, ok := transliterations[r]
+		if !ok {
+			return "", false
+		}
+
+		b.WriteString(v)
+	}
+
+	return b.String(), true
+}
@@ -0,0 +1,32 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTransliteration(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		s, ascii string
+	}{
+		{"", ""},
+		{"Straße", "Strasse"},
+		{"Ærøskøbing", "AEroskobing"},
+		{"Þórður", "Thordur"},
+		{"Đorđe Łukasz", "Dorde Lukasz"},
+		{"ǿ", "o"},
+		{"東京", "東京"},
+	}
+
+	c := confusables.New(confusables.WithTransliteration())
+
+	for _, test := range tests {
+		assert.Equal(t, test.ascii, c.ToASCII(test.s), "ToASCII(%q)", test.s)
+	}
+
+	assert.Equal(t, "Straße", confusables.ToASCII("Straße"), "transliteration should be optional")
+}
@@ -0,0 +1,127 @@
+package confusables
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// URLComponent details the issues found within a label of a URL's host or a segment of its path.
+type URLComponent struct {
+	// BidiControls lists the bidirectional formatting characters within the component.
+	BidiControls []rune
+	// HasConfusables reports whether the component contains a rune with a confusable mapping.
+	HasConfusables bool
+	// Mixed reports whether the component mixes scripts.
+	Mixed bool
+	// Scripts lists the scripts used by the component, as Scripts.
+	Scripts []string
+	// Text is the component, decoded from punycode or percent-encoding.
+	Text string
+}
+
+// URLReport details the issues found within a URL by AnalyzeURL.
+type URLReport struct {
+	// Host is the URL's host, without any port, with its labels decoded to Unicode.
+	Host string
+	// Impersonates lists the protected domains which the host looks like, but is not, nor is a subdomain of.
+	Impersonates []string
+	// Labels details each label of the host.
+	Labels []URLComponent
+	// Path details each non-empty segment of the path.
+	Path []URLComponent
+}
+
+// AnalyzeURL parses a URL, which may omit its scheme, e.g. "pаypal.com/login", and reports the confusables, mixed
+// scripts and bidirectional controls within the labels of its host and the segments of its path. The host is compared
+// with each of the protected domains, e.g. "paypal.com", by skeleton: a host impersonates a protected domain when its
+// labels include those of the domain once converted to skeletons, such as "login.pаypal.com" and "paypal.com.example",
+// but it is neither the domain nor a subdomain of it.
+func AnalyzeURL(raw string, protected ...string) (URLReport, error) {
+	if !strings.Contains(raw, "://") {
+		raw = "http://" + raw
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return URLReport{}, err
+	}
+
+	labels, err := DomainLabels(strings.ToLower(u.Hostname()))
+	if err != nil {
+		return URLReport{}, err
+	}
+
+	report := URLReport{
+		Host: strings.Join(labels, "."),
+	}
+
+	for _, label := range labels {
+		report.Labels = append(report.Labels, newURLComponent(label))
+	}
+
+	for _, segment := range strings.Split(u.Path, "/") {
+		if segment != "" {
+			report.Path = append(report.Path, newURLComponent(segment))
+		}
+	}
+
+	hostSkeletons := strings.Split(domainSkeleton(labels), ".")
+
+	for _, domain := range protected {
+		domainLabels, err := DomainLabels(strings.ToLower(domain))
+		if err != nil {
+			return URLReport{}, err
+		}
+
+		if isSubdomain(labels, domainLabels) {
+			continue
+		}
+
+		if containsLabels(hostSkeletons, strings.Split(domainSkeleton(domainLabels), ".")) {
+			report.Impersonates = append(report.Impersonates, domain)
+		}
+	}
+
+	return report, nil
+}
+
+// Suspicious reports whether the URL impersonates a protected domain, or any component of it contains bidirectional
+// controls or mixes scripts.
+func (r URLReport) Suspicious() bool {
+	if len(r.Impersonates) > 0 {
+		return true
+	}
+
+	suspicious := func(c URLComponent) bool {
+		return c.Mixed || len(c.BidiControls) > 0
+	}
+
+	return slices.ContainsFunc(r.Labels, suspicious) || slices.ContainsFunc(r.Path, suspicious)
+}
+
+func newURLComponent(s string) URLComponent {
+	return URLComponent{
+		BidiControls:   bidiControls(s),
+		HasConfusables: ContainsConfusable(s),
+		Mixed:          ResolvedScriptSet(s).IsEmpty(),
+		Scripts:        Scripts(s),
+		Text:           s,
+	}
+}
+
+// Check whether labels contains sub as a contiguous run.
+func containsLabels(labels, sub []string) bool {
+	for i := 0; i+len(sub) <= len(labels); i++ {
+		if slices.Equal(labels[i:i+len(sub)], sub) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Check whether the domain with labels is the domain with the labels of parent, or a subdomain of it.
+func isSubdomain(labels, parent []string) bool {
+	return len(labels) >= len(parent) && slices.Equal(labels[len(labels)-len(parent):], parent)
+}
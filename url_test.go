@@ -0,0 +1,53 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		raw          string
+		impersonates []string
+		suspicious   bool
+	}{
+		{"https://paypal.com/login", nil, false},
+		{"https://www.PayPal.com:443/login", nil, false},
+		{"https://pаypal.com/login", []string{"paypal.com"}, true},
+		{"https://login.xn--pypal-4ve.com/", []string{"paypal.com"}, true},
+		{"http://paypal.com.example.net/", []string{"paypal.com"}, true},
+		{"раураl.com", []string{"paypal.com"}, true},
+		{"https://example.com/", nil, false},
+		{"https://example.com/files/invoice\u202egpj.exe", nil, true},
+		{"https://example.com/p%D0%B0ypal", nil, true},
+	}
+
+	for _, test := range tests {
+		report, err := confusables.AnalyzeURL(test.raw, "paypal.com", "example.org")
+		assert.NoError(t, err)
+		assert.Equal(t, test.impersonates, report.Impersonates, "AnalyzeURL(%q)", test.raw)
+
+		assert.Equal(t, test.suspicious, report.Suspicious(), "AnalyzeURL(%q)", test.raw)
+	}
+
+	report, err := confusables.AnalyzeURL("https://xn--pypal-4ve.com/p%D0%B0y/")
+	assert.NoError(t, err)
+	assert.Equal(t, "pаypal.com", report.Host)
+	assert.Len(t, report.Labels, 2)
+	assert.True(t, report.Labels[0].HasConfusables)
+	assert.True(t, report.Labels[0].Mixed)
+	assert.Equal(t, []string{"Latin", "Cyrillic"}, report.Labels[0].Scripts)
+	assert.False(t, report.Labels[1].HasConfusables)
+	assert.Len(t, report.Path, 1)
+	assert.Equal(t, "pаy", report.Path[0].Text)
+
+	_, err = confusables.AnalyzeURL("https://xn--ab!.com/")
+	assert.Error(t, err)
+
+	_, err = confusables.AnalyzeURL("https://exa mple.com/")
+	assert.Error(t, err)
+}
@@ -0,0 +1,64 @@
+package confusables
+
+import "sync"
+
+// Tables holds a version of the generated tables, such as those provided by the packages under tables/, for use with
+// WithTables. The tables are decoded on first use.
+type Tables struct {
+	// amended holds the tables with their amendments applied, and upstream the tables alone.
+	amended   *tableSet
+	generated func() *generatedTables
+	upstream  *tableSet
+}
+
+// NewTables creates Tables from data in the binary format written by scripts/build-tables.go. It is intended for the
+// generated packages under tables/, which embed such data; malformed data causes a panic once the tables are used.
+func NewTables(data string) *Tables {
+	return newTables(func() *generatedTables {
+		return mustDecodeTables(data)
+	})
+}
+
+// Create Tables from the generated tables returned by decode. The amended tables are decoded separately, as applying
+// the amendments modifies them.
+func newTables(decode func() *generatedTables) *Tables {
+	generated := sync.OnceValue(decode)
+
+	return &Tables{
+		amended: &tableSet{
+			init: func() *tables {
+				g := decode()
+				if err := g.tables.addAmendments(g.amendments); err != nil {
+					panic(err)
+				}
+
+				return g.tables
+			},
+		},
+		generated: generated,
+		upstream: &tableSet{
+			init: func() *tables {
+				return generated().tables
+			},
+		},
+	}
+}
+
+// Date returns the date of the Unicode data, confusables.txt, which the tables are generated from.
+func (t *Tables) Date() string {
+	return t.generated().date
+}
+
+// Version returns the version of the Unicode data, confusables.txt, which the tables are generated from.
+func (t *Tables) Version() string {
+	return t.generated().version
+}
+
+// Return the set of the tables with their amendments applied, or without.
+func (t *Tables) tableSet(amended bool) *tableSet {
+	if amended {
+		return t.amended
+	}
+
+	return t.upstream
+}
@@ -0,0 +1,54 @@
+package confusables_test
+
+import (
+	"testing"
+
+	"github.com/eskriett/confusables"
+	v15 "github.com/eskriett/confusables/tables/v15"
+	v16 "github.com/eskriett/confusables/tables/v16"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTables(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "16.0.0", v16.Tables.Version())
+	assert.NotEmpty(t, v16.Tables.Date())
+
+	c := confusables.New(confusables.WithTables(v16.Tables))
+
+	for _, s := range []string{"", "example", "ехаmрlе", "раураl", "①②"} {
+		assert.Equal(t, confusables.ToSkeleton(s), c.ToSkeleton(s), "ToSkeleton(%q)", s)
+		assert.Equal(t, confusables.ToASCII(s), c.ToASCII(s), "ToASCII(%q)", s)
+	}
+
+	upstream := confusables.New(confusables.WithTables(v16.Tables), confusables.WithAmendments(false))
+	assert.Equal(t, confusables.New(confusables.WithAmendments(false)).ToASCII("①"), upstream.ToASCII("①"))
+
+	safe := confusables.NewSafe(confusables.WithAmendments(false), confusables.WithTables(v16.Tables))
+	safe.AddMapping('ꙟ', "x")
+	assert.Equal(t, "x", safe.ToASCII("ꙟ"))
+	assert.NotEqual(t, "x", c.ToASCII("ꙟ"), "mappings added to an instance should not change the tables")
+	assert.NoError(t, safe.LoadAmendments())
+
+	assert.Panics(t, func() {
+		confusables.New(confusables.WithTables(confusables.NewTables("bad"))).ToASCII("а")
+	})
+}
+
+func TestWithTablesVersion(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "15.0.0", v15.Tables.Version())
+
+	c15 := confusables.New(confusables.WithTables(v15.Tables))
+	c16 := confusables.New(confusables.WithTables(v16.Tables))
+
+	assert.Equal(t, c16.ToSkeleton("раураl"), c15.ToSkeleton("раураl"))
+
+	// OUTLINED LATIN CAPITAL LETTER A, and its mapping to "A", were added in Unicode 16.0.
+	assert.Equal(t, "\U0001CCD6", c15.ToSkeleton("\U0001CCD6"))
+	assert.Equal(t, "A", c16.ToSkeleton("\U0001CCD6"))
+	assert.False(t, c15.IsConfusable("A", "\U0001CCD6"))
+	assert.True(t, c16.IsConfusable("A", "\U0001CCD6"))
+}